@@ -0,0 +1,158 @@
+// twitch-eventsub manages the Twitch EventSub webhook subscriptions of the broadcaster channel.
+//
+//	go run ./cmd/twitch-eventsub -env .env.dev list|subscribe|unsubscribe
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/client"
+	"github.com/caliecode/la-clipasa/internal/envvar"
+	"github.com/caliecode/la-clipasa/internal/models"
+	"github.com/caliecode/la-clipasa/internal/twitchsync"
+)
+
+const (
+	statusEnabled             = "enabled"
+	statusVerificationPending = "webhook_callback_verification_pending"
+)
+
+func main() {
+	var env, callback string
+
+	flag.StringVar(&env, "env", "", "Environment Variables filename")
+	flag.StringVar(&callback, "callback", "", "Webhook callback URL (default https://$DOMAIN/webhooks/twitch/eventsub)")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] list|subscribe|unsubscribe\n", flag.CommandLine.Name())
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	var errs []string
+	if env == "" {
+		errs = append(errs, "    - env is required but unset")
+	}
+	if flag.NArg() != 1 {
+		errs = append(errs, "    - a single command is required")
+	}
+
+	if len(errs) > 0 {
+		flag.Usage()
+		log.Fatal("error: \n" + strings.Join(errs, "\n"))
+	}
+
+	if err := envvar.Load(env); err != nil {
+		log.Fatalf("Couldn't load env: %s", err)
+	}
+
+	if callback == "" {
+		callback = "https://" + internal.Config.Domain + "/webhooks/twitch/eventsub"
+	}
+
+	ctx := context.Background()
+	eventSub := client.NewTwitchEventSubClient(client.NewAppTokenSource())
+
+	var err error
+	switch cmd := flag.Arg(0); cmd {
+	case "list":
+		err = list(ctx, eventSub)
+	case "subscribe":
+		err = subscribe(ctx, eventSub, callback)
+	case "unsubscribe":
+		err = unsubscribe(ctx, eventSub, callback)
+	default:
+		flag.Usage()
+		log.Fatalf("unknown command: %s", cmd)
+	}
+	if err != nil {
+		log.Fatalf("%s: %s", flag.Arg(0), err)
+	}
+}
+
+func list(ctx context.Context, eventSub *client.TwitchEventSubClient) error {
+	subs, err := eventSub.Subscriptions(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, sub := range subs {
+		fmt.Printf("%s\t%s\tv%s\t%s\t%s\n", sub.ID, sub.Type, sub.Version, sub.Status, sub.Transport.Callback)
+	}
+
+	return nil
+}
+
+// subscribe creates missing subscriptions to callback and replaces failed ones.
+func subscribe(ctx context.Context, eventSub *client.TwitchEventSubClient, callback string) error {
+	secret := internal.Config.Twitch.EventSubSecret
+	if secret == nil || *secret == "" {
+		return fmt.Errorf("TWITCH_EVENTSUB_SECRET is unset")
+	}
+
+	subs, err := eventSub.Subscriptions(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, es := range twitchsync.EventSubscriptions {
+		active := false
+		for _, sub := range callbackSubscriptions(subs, callback) {
+			if sub.Type != es.Type || sub.Version != es.Version {
+				continue
+			}
+			if sub.Status == statusEnabled || sub.Status == statusVerificationPending {
+				active = true
+				continue
+			}
+			if err := eventSub.Unsubscribe(ctx, sub.ID); err != nil {
+				return fmt.Errorf("could not delete %s subscription %s: %w", sub.Type, sub.ID, err)
+			}
+			fmt.Printf("deleted %s subscription %s (%s)\n", sub.Type, sub.ID, sub.Status)
+		}
+		if active {
+			fmt.Printf("%s already subscribed\n", es.Type)
+			continue
+		}
+
+		sub, err := eventSub.Subscribe(ctx, es.Type, es.Version, es.Condition(), callback, *secret)
+		if err != nil {
+			return fmt.Errorf("could not subscribe to %s: %w", es.Type, err)
+		}
+		fmt.Printf("subscribed to %s: %s (%s)\n", sub.Type, sub.ID, sub.Status)
+	}
+
+	return nil
+}
+
+// unsubscribe deletes all subscriptions to callback.
+func unsubscribe(ctx context.Context, eventSub *client.TwitchEventSubClient, callback string) error {
+	subs, err := eventSub.Subscriptions(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, sub := range callbackSubscriptions(subs, callback) {
+		if err := eventSub.Unsubscribe(ctx, sub.ID); err != nil {
+			return fmt.Errorf("could not delete %s subscription %s: %w", sub.Type, sub.ID, err)
+		}
+		fmt.Printf("deleted %s subscription %s\n", sub.Type, sub.ID)
+	}
+
+	return nil
+}
+
+func callbackSubscriptions(subs []models.TwitchEventSubSubscription, callback string) []models.TwitchEventSubSubscription {
+	var result []models.TwitchEventSubSubscription
+	for _, sub := range subs {
+		if sub.Transport.Method == "webhook" && sub.Transport.Callback == callback {
+			result = append(result, sub)
+		}
+	}
+
+	return result
+}
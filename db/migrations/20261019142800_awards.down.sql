@@ -0,0 +1,10 @@
+-- reverse: create index "useraward_owner_id_award_id" to table: "user_awards"
+DROP INDEX "useraward_owner_id_award_id";
+-- reverse: create "user_awards" table
+DROP TABLE "user_awards";
+-- reverse: create index "award_definitions_name_key" to table: "award_definitions"
+DROP INDEX "award_definitions_name_key";
+-- reverse: create "award_definitions" table
+DROP TABLE "award_definitions";
+-- reverse: modify "users" table
+ALTER TABLE "users" ADD COLUMN "awards" jsonb NULL;
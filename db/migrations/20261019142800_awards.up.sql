@@ -0,0 +1,16 @@
+-- modify "users" table
+ALTER TABLE "users" DROP COLUMN "awards";
+-- create "award_definitions" table
+CREATE TABLE "award_definitions" ("id" uuid NOT NULL, "updated_at" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, "name" character varying NOT NULL, "description" character varying NULL, "icon" character varying NOT NULL, "rule" character varying NOT NULL, "rule_category" character varying NULL, "rule_threshold" bigint NOT NULL DEFAULT 1, PRIMARY KEY ("id"));
+-- create index "award_definitions_name_key" to table: "award_definitions"
+CREATE UNIQUE INDEX "award_definitions_name_key" ON "award_definitions" ("name");
+-- create "user_awards" table
+CREATE TABLE "user_awards" ("id" uuid NOT NULL, "updated_at" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, "granted_at" timestamptz NOT NULL, "award_id" uuid NOT NULL, "owner_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "user_awards_award_definitions_grants" FOREIGN KEY ("award_id") REFERENCES "award_definitions" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "user_awards_users_awards" FOREIGN KEY ("owner_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- create index "useraward_owner_id_award_id" to table: "user_awards"
+CREATE UNIQUE INDEX "useraward_owner_id_award_id" ON "user_awards" ("owner_id", "award_id");
+-- seed default award definitions, granted by the next awards backfill
+INSERT INTO "award_definitions" ("id", "updated_at", "created_at", "name", "description", "icon", "rule", "rule_category", "rule_threshold") VALUES
+  (gen_random_uuid(), now(), now(), 'Orfebre', '10 posts categorized ORO', '🥇', 'POSTS_IN_CATEGORY', 'ORO', 10),
+  (gen_random_uuid(), now(), now(), 'Primer diamante', 'First post categorized DIAMANTE', '💎', 'POSTS_IN_CATEGORY', 'DIAMANTE', 1),
+  (gen_random_uuid(), now(), now(), 'Veterano', '1 year member', '🎂', 'MEMBERSHIP_DAYS', NULL, 365),
+  (gen_random_uuid(), now(), now(), 'Querido', '100 likes received', '❤️', 'LIKES_RECEIVED', NULL, 100);
@@ -0,0 +1,2 @@
+-- reverse: create materialized view "leaderboard_entries"
+DROP MATERIALIZED VIEW "leaderboard_entries";
@@ -0,0 +1,31 @@
+-- create materialized view "leaderboard_entries"
+-- likes have no timestamp, so they are attributed to the period the liked post was created in.
+CREATE MATERIALIZED VIEW "leaderboard_entries" AS
+WITH "periods" ("period", "since") AS (
+  VALUES
+    ('WEEK', now() - interval '7 days'),
+    ('MONTH', now() - interval '1 month'),
+    ('ALL_TIME', '-infinity'::timestamptz)
+)
+SELECT pr."period", 'LIKES_RECEIVED' AS "metric", p."owner_id" AS "user_id", count(*) AS "score"
+FROM "periods" pr
+JOIN "posts" p ON p."created_at" >= pr."since" AND p."deleted_at" IS NULL
+JOIN "user_liked_posts" ulp ON ulp."post_id" = p."id"
+GROUP BY pr."period", p."owner_id"
+UNION ALL
+SELECT pr."period", 'ORO_DIAMANTE_POSTS' AS "metric", p."owner_id" AS "user_id", count(DISTINCT p."id") AS "score"
+FROM "periods" pr
+JOIN "posts" p ON p."created_at" >= pr."since" AND p."deleted_at" IS NULL
+JOIN "post_categories" pc ON pc."post_categories" = p."id" AND pc."category" IN ('ORO', 'DIAMANTE')
+GROUP BY pr."period", p."owner_id"
+UNION ALL
+SELECT pr."period", 'COMMENTS' AS "metric", c."owner_id" AS "user_id", count(*) AS "score"
+FROM "periods" pr
+JOIN "comments" c ON c."created_at" >= pr."since" AND c."deleted_at" IS NULL
+GROUP BY pr."period", c."owner_id"
+WITH DATA;
+-- create index "leaderboard_entries_period_metric_user_id_key" to materialized view: "leaderboard_entries"
+-- required to refresh concurrently
+CREATE UNIQUE INDEX "leaderboard_entries_period_metric_user_id_key" ON "leaderboard_entries" ("period", "metric", "user_id");
+-- create index "leaderboard_entries_period_metric_score" to materialized view: "leaderboard_entries"
+CREATE INDEX "leaderboard_entries_period_metric_score" ON "leaderboard_entries" ("period", "metric", "score" DESC);
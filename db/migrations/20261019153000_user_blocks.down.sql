@@ -0,0 +1,4 @@
+-- reverse: create "user_muted_users" table
+DROP TABLE "user_muted_users";
+-- reverse: create "user_blocked_users" table
+DROP TABLE "user_blocked_users";
@@ -0,0 +1,4 @@
+-- create "user_blocked_users" table
+CREATE TABLE "user_blocked_users" ("user_id" uuid NOT NULL, "blocked_user_id" uuid NOT NULL, PRIMARY KEY ("user_id", "blocked_user_id"), CONSTRAINT "user_blocked_users_blocked_user_id" FOREIGN KEY ("blocked_user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "user_blocked_users_user_id" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create "user_muted_users" table
+CREATE TABLE "user_muted_users" ("user_id" uuid NOT NULL, "muted_user_id" uuid NOT NULL, PRIMARY KEY ("user_id", "muted_user_id"), CONSTRAINT "user_muted_users_muted_user_id" FOREIGN KEY ("muted_user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "user_muted_users_user_id" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
@@ -0,0 +1,4 @@
+-- reverse: create index "identity_provider_subject" to table: "identities"
+DROP INDEX "identity_provider_subject";
+-- reverse: create "identities" table
+DROP TABLE "identities";
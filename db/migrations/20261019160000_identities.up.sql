@@ -0,0 +1,7 @@
+-- create "identities" table
+CREATE TABLE "identities" ("id" uuid NOT NULL, "updated_at" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, "provider" character varying NOT NULL, "subject" character varying NOT NULL, "email" character varying NULL, "owner_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "identities_users_identities" FOREIGN KEY ("owner_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- create index "identity_provider_subject" to table: "identities"
+CREATE UNIQUE INDEX "identity_provider_subject" ON "identities" ("provider", "subject");
+-- link existing users to the identity they registered with
+INSERT INTO "identities" ("id", "updated_at", "created_at", "provider", "subject", "owner_id")
+SELECT gen_random_uuid(), now(), now(), "auth_provider", "external_id", "id" FROM "users";
@@ -0,0 +1,6 @@
+-- reverse: create index "refreshtoken_parent_id" to table: "refresh_tokens"
+DROP INDEX "refreshtoken_parent_id";
+-- reverse: create index "refreshtoken_family_id" to table: "refresh_tokens"
+DROP INDEX "refreshtoken_family_id";
+-- reverse: modify "refresh_tokens" table
+ALTER TABLE "refresh_tokens" DROP COLUMN "parent_id", DROP COLUMN "family_id";
@@ -0,0 +1,9 @@
+-- modify "refresh_tokens" table
+ALTER TABLE "refresh_tokens" ADD COLUMN "family_id" uuid NULL, ADD COLUMN "parent_id" uuid NULL;
+-- existing tokens start their own family
+UPDATE "refresh_tokens" SET "family_id" = "id";
+ALTER TABLE "refresh_tokens" ALTER COLUMN "family_id" SET NOT NULL;
+-- create index "refreshtoken_family_id" to table: "refresh_tokens"
+CREATE INDEX "refreshtoken_family_id" ON "refresh_tokens" ("family_id");
+-- create index "refreshtoken_parent_id" to table: "refresh_tokens"
+CREATE INDEX "refreshtoken_parent_id" ON "refresh_tokens" ("parent_id");
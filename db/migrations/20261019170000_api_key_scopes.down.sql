@@ -0,0 +1,10 @@
+-- reverse: create index "api_keys_key_hash_key" to table: "api_keys"
+DROP INDEX "api_keys_key_hash_key";
+-- reverse: modify "api_keys" table
+-- plaintext keys cannot be restored, existing keys are removed
+DELETE FROM "api_keys";
+ALTER TABLE "api_keys" ADD COLUMN "api_key" character varying NOT NULL;
+-- reverse: drop index "api_keys_api_key_key" from table: "api_keys"
+CREATE UNIQUE INDEX "api_keys_api_key_key" ON "api_keys" ("api_key");
+-- reverse: modify "api_keys" table
+ALTER TABLE "api_keys" DROP COLUMN "last_used_at", DROP COLUMN "scopes", DROP COLUMN "key_hash", DROP COLUMN "prefix", DROP COLUMN "name";
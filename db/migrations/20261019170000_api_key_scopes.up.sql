@@ -0,0 +1,15 @@
+-- modify "api_keys" table
+ALTER TABLE "api_keys" ADD COLUMN "name" character varying NULL, ADD COLUMN "prefix" character varying NULL, ADD COLUMN "key_hash" character varying NULL, ADD COLUMN "scopes" jsonb NULL, ADD COLUMN "last_used_at" timestamptz NULL;
+-- existing keys are hashed and keep full access
+UPDATE "api_keys" SET
+  "name" = 'default',
+  "prefix" = left("api_key", 12),
+  "key_hash" = translate(encode(sha256(convert_to("api_key", 'UTF8')), 'base64'), '+/', '-_'),
+  "scopes" = '["posts:read", "posts:write", "comments:write", "moderate"]';
+ALTER TABLE "api_keys" ALTER COLUMN "name" SET NOT NULL, ALTER COLUMN "prefix" SET NOT NULL, ALTER COLUMN "key_hash" SET NOT NULL, ALTER COLUMN "scopes" SET NOT NULL;
+-- drop index "api_keys_api_key_key" from table: "api_keys"
+DROP INDEX "api_keys_api_key_key";
+-- modify "api_keys" table
+ALTER TABLE "api_keys" DROP COLUMN "api_key";
+-- create index "api_keys_key_hash_key" to table: "api_keys"
+CREATE UNIQUE INDEX "api_keys_key_hash_key" ON "api_keys" ("key_hash");
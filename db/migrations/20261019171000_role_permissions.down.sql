@@ -0,0 +1,4 @@
+-- reverse: create index "rolepermission_role_permission" to table: "role_permissions"
+DROP INDEX "rolepermission_role_permission";
+-- reverse: create "role_permissions" table
+DROP TABLE "role_permissions";
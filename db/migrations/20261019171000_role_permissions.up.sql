@@ -0,0 +1,18 @@
+-- create "role_permissions" table
+CREATE TABLE "role_permissions" ("id" uuid NOT NULL, "updated_at" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, "role" character varying NOT NULL, "permission" character varying NOT NULL, PRIMARY KEY ("id"));
+-- create index "rolepermission_role_permission" to table: "role_permissions"
+CREATE UNIQUE INDEX "rolepermission_role_permission" ON "role_permissions" ("role", "permission");
+-- default permissions equivalent to role ranks
+INSERT INTO "role_permissions" ("id", "updated_at", "created_at", "role", "permission")
+SELECT gen_random_uuid(), now(), now(), r.role, r.permission
+FROM (VALUES
+  ('MODERATOR', 'POSTS_CATEGORIZE'),
+  ('MODERATOR', 'POSTS_MODERATE'),
+  ('MODERATOR', 'POSTS_DELETE'),
+  ('ADMIN', 'POSTS_CATEGORIZE'),
+  ('ADMIN', 'POSTS_MODERATE'),
+  ('ADMIN', 'POSTS_DELETE'),
+  ('ADMIN', 'USERS_MANAGE'),
+  ('ADMIN', 'AWARDS_MANAGE'),
+  ('ADMIN', 'ROLES_MANAGE')
+) AS r(role, permission);
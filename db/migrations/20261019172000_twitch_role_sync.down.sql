@@ -0,0 +1,6 @@
+-- reverse: create index "rolechange_user_id_created_at" to table: "role_changes"
+DROP INDEX "rolechange_user_id_created_at";
+-- reverse: create "role_changes" table
+DROP TABLE "role_changes";
+-- reverse: modify "users" table
+ALTER TABLE "users" DROP COLUMN "twitch_vip", DROP COLUMN "role_source";
@@ -0,0 +1,8 @@
+-- modify "users" table
+ALTER TABLE "users" ADD COLUMN "role_source" character varying NULL, ADD COLUMN "twitch_vip" boolean NOT NULL DEFAULT false;
+-- existing elevated roles were assigned manually
+UPDATE "users" SET "role_source" = 'MANUAL' WHERE "role" IN ('ADMIN', 'MODERATOR');
+-- create "role_changes" table
+CREATE TABLE "role_changes" ("id" uuid NOT NULL, "updated_at" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, "attribute" character varying NOT NULL, "old_value" character varying NOT NULL, "new_value" character varying NOT NULL, "source" character varying NOT NULL, "changed_by_id" uuid NULL, "user_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "role_changes_users_role_changes" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- create index "rolechange_user_id_created_at" to table: "role_changes"
+CREATE INDEX "rolechange_user_id_created_at" ON "role_changes" ("user_id", "created_at");
@@ -0,0 +1,4 @@
+-- reverse: create index "twitch_tokens_twitch_user_id_key" to table: "twitch_tokens"
+DROP INDEX "twitch_tokens_twitch_user_id_key";
+-- reverse: create "twitch_tokens" table
+DROP TABLE "twitch_tokens";
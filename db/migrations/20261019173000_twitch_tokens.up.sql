@@ -0,0 +1,4 @@
+-- create "twitch_tokens" table
+CREATE TABLE "twitch_tokens" ("id" uuid NOT NULL, "updated_at" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, "twitch_user_id" character varying NOT NULL, "access_token" bytea NOT NULL, "refresh_token" bytea NOT NULL, "expires_at" timestamptz NOT NULL, "scopes" jsonb NULL, PRIMARY KEY ("id"));
+-- create index "twitch_tokens_twitch_user_id_key" to table: "twitch_tokens"
+CREATE UNIQUE INDEX "twitch_tokens_twitch_user_id_key" ON "twitch_tokens" ("twitch_user_id");
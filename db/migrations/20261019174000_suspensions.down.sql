@@ -0,0 +1,6 @@
+-- reverse: moderators and admins can suspend users
+DELETE FROM "role_permissions" WHERE "permission" = 'USERS_SUSPEND';
+-- reverse: create index "suspension_user_id_ends_at" to table: "suspensions"
+DROP INDEX "suspension_user_id_ends_at";
+-- reverse: create "suspensions" table
+DROP TABLE "suspensions";
@@ -0,0 +1,9 @@
+-- create "suspensions" table
+CREATE TABLE "suspensions" ("id" uuid NOT NULL, "updated_at" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, "reason" character varying NOT NULL, "scope" character varying NOT NULL DEFAULT 'FULL', "starts_at" timestamptz NOT NULL, "ends_at" timestamptz NULL, "issuer_id" uuid NULL, "user_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "suspensions_users_issuer" FOREIGN KEY ("issuer_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL, CONSTRAINT "suspensions_users_suspensions" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- create index "suspension_user_id_ends_at" to table: "suspensions"
+CREATE INDEX "suspension_user_id_ends_at" ON "suspensions" ("user_id", "ends_at");
+-- moderators and admins can suspend users
+INSERT INTO "role_permissions" ("id", "updated_at", "created_at", "role", "permission")
+SELECT gen_random_uuid(), now(), now(), r.role, 'USERS_SUSPEND'
+FROM (VALUES ('MODERATOR'), ('ADMIN')) AS r(role)
+ON CONFLICT ("role", "permission") DO NOTHING;
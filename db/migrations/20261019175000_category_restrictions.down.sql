@@ -0,0 +1,6 @@
+-- reverse: admins can restrict categories
+DELETE FROM "role_permissions" WHERE "permission" = 'CATEGORIES_MANAGE';
+-- reverse: create index "categoryrestriction_category" to table: "category_restrictions"
+DROP INDEX "categoryrestriction_category";
+-- reverse: create "category_restrictions" table
+DROP TABLE "category_restrictions";
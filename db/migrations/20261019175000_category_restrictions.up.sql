@@ -0,0 +1,8 @@
+-- create "category_restrictions" table
+CREATE TABLE "category_restrictions" ("id" uuid NOT NULL, "updated_at" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, "category" character varying NOT NULL, "subscriber_only_post" boolean NOT NULL DEFAULT false, "subscriber_only_view" boolean NOT NULL DEFAULT false, PRIMARY KEY ("id"));
+-- create index "categoryrestriction_category" to table: "category_restrictions"
+CREATE UNIQUE INDEX "categoryrestriction_category" ON "category_restrictions" ("category");
+-- admins can restrict categories
+INSERT INTO "role_permissions" ("id", "updated_at", "created_at", "role", "permission")
+VALUES (gen_random_uuid(), now(), now(), 'ADMIN', 'CATEGORIES_MANAGE')
+ON CONFLICT ("role", "permission") DO NOTHING;
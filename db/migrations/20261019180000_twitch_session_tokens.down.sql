@@ -0,0 +1,10 @@
+-- reverse: create index "twitchtoken_twitch_user_id" to table: "twitch_tokens"
+DROP INDEX "twitchtoken_twitch_user_id";
+-- reverse: create index "twitch_tokens_session_id_key" to table: "twitch_tokens"
+DROP INDEX "twitch_tokens_session_id_key";
+-- session tokens are stored in cookies again
+DELETE FROM "twitch_tokens" WHERE "session_id" IS NOT NULL;
+-- reverse: modify "twitch_tokens" table
+ALTER TABLE "twitch_tokens" DROP COLUMN "session_id";
+-- reverse: drop index "twitch_tokens_twitch_user_id_key" from table: "twitch_tokens"
+CREATE UNIQUE INDEX "twitch_tokens_twitch_user_id_key" ON "twitch_tokens" ("twitch_user_id");
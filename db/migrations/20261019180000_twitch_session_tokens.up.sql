@@ -0,0 +1,8 @@
+-- drop index "twitch_tokens_twitch_user_id_key" from table: "twitch_tokens"
+DROP INDEX "twitch_tokens_twitch_user_id_key";
+-- modify "twitch_tokens" table
+ALTER TABLE "twitch_tokens" ADD COLUMN "session_id" uuid NULL;
+-- create index "twitch_tokens_session_id_key" to table: "twitch_tokens"
+CREATE UNIQUE INDEX "twitch_tokens_session_id_key" ON "twitch_tokens" ("session_id");
+-- create index "twitchtoken_twitch_user_id" to table: "twitch_tokens"
+CREATE UNIQUE INDEX "twitchtoken_twitch_user_id" ON "twitch_tokens" ("twitch_user_id") WHERE (session_id IS NULL);
@@ -162,6 +162,41 @@ export type ApiKeyWhereInput = {
   updatedAtNotIn?: InputMaybe<Array<Scalars['Time']['input']>>
 }
 
+export type AwardDefinition = Node & {
+  __typename?: 'AwardDefinition'
+  createdAt: Scalars['Time']['output']
+  description?: Maybe<Scalars['String']['output']>
+  grants?: Maybe<Array<UserAward>>
+  /** emoji or image URL shown alongside the award */
+  icon: Scalars['String']['output']
+  id: Scalars['ID']['output']
+  name: Scalars['String']['output']
+  /** the rule evaluated to grant the award */
+  rule: AwardDefinitionRule
+  /** the post category counted by POSTS_IN_CATEGORY rules */
+  ruleCategory?: Maybe<AwardDefinitionRuleCategory>
+  /** the minimum count (or days for MEMBERSHIP_DAYS) required to grant the award */
+  ruleThreshold: Scalars['Int']['output']
+  updatedAt: Scalars['Time']['output']
+}
+
+/** AwardDefinitionRule is enum for the field rule */
+export type AwardDefinitionRule = 'LIKES_RECEIVED' | 'MEMBERSHIP_DAYS' | 'POSTS_IN_CATEGORY' | 'POSTS_PUBLISHED'
+
+/** AwardDefinitionRuleCategory is enum for the field rule_category */
+export type AwardDefinitionRuleCategory =
+  | 'ALERTA_GLONETILLO'
+  | 'DIAMANTE'
+  | 'ENSORDECEDOR'
+  | 'GRR'
+  | 'MEH'
+  | 'MEME_ARTESANAL'
+  | 'NO_SE_YO'
+  | 'ORO'
+  | 'RAGUUUL'
+  | 'RANA'
+  | 'SIN_SONIDO'
+
 export type Comment = Node & {
   __typename?: 'Comment'
   content: Scalars['String']['output']
@@ -393,7 +428,6 @@ export type CreateUserInput = {
   alias?: InputMaybe<Scalars['String']['input']>
   apiKeyIDs?: InputMaybe<Array<Scalars['ID']['input']>>
   authProvider?: InputMaybe<UserAuthProvider>
-  awards?: InputMaybe<Array<Scalars['String']['input']>>
   commentIDs?: InputMaybe<Array<Scalars['ID']['input']>>
   displayName: Scalars['String']['input']
   /** cursor for last post seen */
@@ -1404,10 +1438,7 @@ export type UpdateApiKeyInput = {
  * Input was generated by ent.
  */
 export type UpdateCommentInput = {
-  clearPost?: InputMaybe<Scalars['Boolean']['input']>
   content?: InputMaybe<Scalars['String']['input']>
-  ownerID?: InputMaybe<Scalars['ID']['input']>
-  postID?: InputMaybe<Scalars['ID']['input']>
 }
 
 /**
@@ -1479,12 +1510,9 @@ export type UpdateUserInput = {
   addSavedPostIDs?: InputMaybe<Array<Scalars['ID']['input']>>
   /** the alias of the user is shown alongside the display name */
   alias?: InputMaybe<Scalars['String']['input']>
-  appendAwards?: InputMaybe<Array<Scalars['String']['input']>>
   authProvider?: InputMaybe<UserAuthProvider>
-  awards?: InputMaybe<Array<Scalars['String']['input']>>
   clearAPIKeys?: InputMaybe<Scalars['Boolean']['input']>
   clearAlias?: InputMaybe<Scalars['Boolean']['input']>
-  clearAwards?: InputMaybe<Scalars['Boolean']['input']>
   clearComments?: InputMaybe<Scalars['Boolean']['input']>
   clearLastPostSeenCursor?: InputMaybe<Scalars['Boolean']['input']>
   clearLastSeenAt?: InputMaybe<Scalars['Boolean']['input']>
@@ -1513,7 +1541,7 @@ export type User = Node & {
   alias?: Maybe<Scalars['String']['output']>
   apiKeys?: Maybe<Array<ApiKey>>
   authProvider: UserAuthProvider
-  awards?: Maybe<Array<Scalars['String']['output']>>
+  awards?: Maybe<Array<UserAward>>
   comments?: Maybe<Array<Comment>>
   createdAt: Scalars['Time']['output']
   deletedAt?: Maybe<Scalars['Time']['output']>
@@ -1534,6 +1562,16 @@ export type User = Node & {
   updatedAt: Scalars['Time']['output']
 }
 
+export type UserAward = Node & {
+  __typename?: 'UserAward'
+  award: AwardDefinition
+  createdAt: Scalars['Time']['output']
+  grantedAt: Scalars['Time']['output']
+  id: Scalars['ID']['output']
+  owner: User
+  updatedAt: Scalars['Time']['output']
+}
+
 /** UserAuthProvider is enum for the field auth_provider */
 export type UserAuthProvider = 'TWITCH'
 
@@ -1804,7 +1842,12 @@ export type PostFragment = {
     id: string
     alias?: string | null
     displayName: string
-    awards?: Array<string> | null
+    awards?: Array<{
+      __typename?: 'UserAward'
+      id: string
+      grantedAt: any
+      award: { __typename?: 'AwardDefinition'; id: string; name: string; icon: string }
+    }> | null
     profileImage?: string | null
   }
   likedBy: { __typename?: 'UserConnection'; totalCount: number }
@@ -1835,7 +1878,12 @@ export type UserFragment = {
   displayName: string
   profileImage?: string | null
   alias?: string | null
-  awards?: Array<string> | null
+  awards?: Array<{
+      __typename?: 'UserAward'
+      id: string
+      grantedAt: any
+      award: { __typename?: 'AwardDefinition'; id: string; name: string; icon: string }
+    }> | null
   lastPostSeenCursor?: string | null
 }
 
@@ -1887,7 +1935,12 @@ export type PostsQuery = {
           id: string
           alias?: string | null
           displayName: string
-          awards?: Array<string> | null
+          awards?: Array<{
+      __typename?: 'UserAward'
+      id: string
+      grantedAt: any
+      award: { __typename?: 'AwardDefinition'; id: string; name: string; icon: string }
+    }> | null
           profileImage?: string | null
         }
         likedBy: { __typename?: 'UserConnection'; totalCount: number }
@@ -1938,7 +1991,12 @@ export type PinnedPostsQuery = {
           id: string
           alias?: string | null
           displayName: string
-          awards?: Array<string> | null
+          awards?: Array<{
+      __typename?: 'UserAward'
+      id: string
+      grantedAt: any
+      award: { __typename?: 'AwardDefinition'; id: string; name: string; icon: string }
+    }> | null
           profileImage?: string | null
         }
         likedBy: { __typename?: 'UserConnection'; totalCount: number }
@@ -1988,7 +2046,12 @@ export type UpdatePostMutation = {
         id: string
         alias?: string | null
         displayName: string
-        awards?: Array<string> | null
+        awards?: Array<{
+      __typename?: 'UserAward'
+      id: string
+      grantedAt: any
+      award: { __typename?: 'AwardDefinition'; id: string; name: string; icon: string }
+    }> | null
         profileImage?: string | null
       }
       likedBy: { __typename?: 'UserConnection'; totalCount: number }
@@ -2030,7 +2093,12 @@ export type CreatePostMutation = {
         id: string
         alias?: string | null
         displayName: string
-        awards?: Array<string> | null
+        awards?: Array<{
+      __typename?: 'UserAward'
+      id: string
+      grantedAt: any
+      award: { __typename?: 'AwardDefinition'; id: string; name: string; icon: string }
+    }> | null
         profileImage?: string | null
       }
       likedBy: { __typename?: 'UserConnection'; totalCount: number }
@@ -2128,7 +2196,12 @@ export type MeQuery = {
     displayName: string
     profileImage?: string | null
     alias?: string | null
-    awards?: Array<string> | null
+    awards?: Array<{
+      __typename?: 'UserAward'
+      id: string
+      grantedAt: any
+      award: { __typename?: 'AwardDefinition'; id: string; name: string; icon: string }
+    }> | null
     lastPostSeenCursor?: string | null
     savedPosts?: Array<{ __typename?: 'Post'; id: string }> | null
     likedPosts?: Array<{ __typename?: 'Post'; id: string }> | null
@@ -2174,7 +2247,12 @@ export type UsersQuery = {
         displayName: string
         profileImage?: string | null
         alias?: string | null
-        awards?: Array<string> | null
+        awards?: Array<{
+      __typename?: 'UserAward'
+      id: string
+      grantedAt: any
+      award: { __typename?: 'AwardDefinition'; id: string; name: string; icon: string }
+    }> | null
         lastPostSeenCursor?: string | null
       } | null
     } | null> | null
@@ -2184,7 +2262,6 @@ export type UsersQuery = {
 export type UpdateUserAuthMutationVariables = Exact<{
   id: Scalars['ID']['input']
   role?: InputMaybe<UserRole>
-  awards?: InputMaybe<Array<Scalars['String']['input']> | Scalars['String']['input']>
 }>
 
 export type UpdateUserAuthMutation = {
@@ -2198,7 +2275,12 @@ export type UpdateUserAuthMutation = {
       displayName: string
       profileImage?: string | null
       alias?: string | null
-      awards?: Array<string> | null
+      awards?: Array<{
+      __typename?: 'UserAward'
+      id: string
+      grantedAt: any
+      award: { __typename?: 'AwardDefinition'; id: string; name: string; icon: string }
+    }> | null
       lastPostSeenCursor?: string | null
     }
   }
@@ -2220,7 +2302,12 @@ export type UpdateUserMutation = {
       displayName: string
       profileImage?: string | null
       alias?: string | null
-      awards?: Array<string> | null
+      awards?: Array<{
+      __typename?: 'UserAward'
+      id: string
+      grantedAt: any
+      award: { __typename?: 'AwardDefinition'; id: string; name: string; icon: string }
+    }> | null
       lastPostSeenCursor?: string | null
     }
   }
@@ -2245,7 +2332,15 @@ export const PostFragmentDoc = gql`
       id
       alias
       displayName
-      awards
+      awards {
+        id
+        grantedAt
+        award {
+          id
+          name
+          icon
+        }
+      }
       profileImage
     }
     likedBy {
@@ -2291,7 +2386,15 @@ export const UserFragmentDoc = gql`
     displayName
     profileImage
     alias
-    awards
+    awards {
+      id
+      grantedAt
+      award {
+        id
+        name
+        icon
+      }
+    }
     lastPostSeenCursor
   }
 `
@@ -2579,8 +2682,8 @@ export function useUsersQuery(options?: Omit<Urql.UseQueryArgs<UsersQueryVariabl
   return Urql.useQuery<UsersQuery, UsersQueryVariables>({ query: UsersDocument, ...options })
 }
 export const UpdateUserAuthDocument = gql`
-  mutation UpdateUserAuth($id: ID!, $role: UserRole, $awards: [String!]) {
-    updateUser(id: $id, input: { role: $role, awards: $awards }) {
+  mutation UpdateUserAuth($id: ID!, $role: UserRole) {
+    updateUser(id: $id, input: { role: $role }) {
       user {
         ...User
       }
@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/apikey"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+)
+
+// APIKeyScope restricts the operations allowed with an API key.
+type APIKeyScope = string
+
+const (
+	ScopePostsRead     APIKeyScope = "posts:read"
+	ScopePostsWrite    APIKeyScope = "posts:write"
+	ScopeCommentsWrite APIKeyScope = "comments:write"
+	// ScopeModerate allows using the owner's moderator or admin role.
+	// Without it, the owner is treated as a regular user.
+	ScopeModerate APIKeyScope = "moderate"
+)
+
+// APIKeyScopes are all valid API key scopes.
+var APIKeyScopes = []APIKeyScope{ScopePostsRead, ScopePostsWrite, ScopeCommentsWrite, ScopeModerate}
+
+const (
+	apiKeyPrefix       = "lcp_"
+	apiKeyBytes        = 32
+	apiKeyVisibleChars = len(apiKeyPrefix) + 8
+	// apiKeyLastUsedResolution limits last used updates to one per interval.
+	apiKeyLastUsedResolution = time.Minute
+)
+
+// ValidateAPIKeyScopes checks all scopes are known.
+func ValidateAPIKeyScopes(scopes []string) error {
+	for _, s := range scopes {
+		if !slices.Contains(APIKeyScopes, s) {
+			return fmt.Errorf("invalid scope %q", s)
+		}
+	}
+
+	return nil
+}
+
+// HashAPIKey returns the stored hash of an API key.
+func HashAPIKey(key string) string {
+	return HashRefreshToken(key)
+}
+
+// NewAPIKey returns a random API key and its visible prefix.
+func NewAPIKey() (key string, prefix string, err error) {
+	b := make([]byte, apiKeyBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("failed to generate api key bytes: %w", err)
+	}
+	key = apiKeyPrefix + base64.RawURLEncoding.EncodeToString(b)
+
+	return key, key[:apiKeyVisibleChars], nil
+}
+
+type ctxKeyAPIKey struct{}
+
+// WithAPIKey marks the context as authenticated via an API key.
+func WithAPIKey(ctx context.Context, ak *generated.ApiKey) context.Context {
+	return context.WithValue(ctx, ctxKeyAPIKey{}, ak)
+}
+
+// APIKeyFromCtx returns the API key the request was authenticated with, if any.
+func APIKeyFromCtx(ctx context.Context) *generated.ApiKey {
+	ak, _ := ctx.Value(ctxKeyAPIKey{}).(*generated.ApiKey)
+
+	return ak
+}
+
+// HasScope reports whether the context is allowed the scope.
+// Requests not authenticated via an API key have all scopes.
+func HasScope(ctx context.Context, scope APIKeyScope) bool {
+	ak := APIKeyFromCtx(ctx)
+
+	return ak == nil || slices.Contains(ak.Scopes, scope)
+}
+
+// GetUserFromAPIKey returns the owner of an API key and the key itself.
+// The owner role is limited to user unless the key has the moderate scope.
+func (a *Authentication) GetUserFromAPIKey(ctx context.Context, key string) (*generated.User, *generated.ApiKey, error) {
+	entclt := generated.FromContext(ctx)
+
+	ak, err := entclt.ApiKey.Query().
+		Where(apikey.KeyHash(HashAPIKey(key))).
+		WithOwner().
+		Only(ctx)
+	if err != nil {
+		return nil, nil, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "user from api key not found")
+	}
+	if ak.ExpiresOn.Before(time.Now()) {
+		return nil, nil, internal.NewErrorf(internal.ErrorCodeUnauthorized, "api key expired")
+	}
+
+	u := ak.Edges.Owner
+	if u == nil {
+		return nil, nil, internal.NewErrorf(internal.ErrorCodeNotFound, "api key owner not found")
+	}
+	if !slices.Contains(ak.Scopes, ScopeModerate) && IsAuthorized(u, user.RoleMODERATOR) {
+		scoped := *u
+		scoped.Role = user.RoleUSER
+		u = &scoped
+	}
+
+	if ak.LastUsedAt == nil || time.Since(*ak.LastUsedAt) > apiKeyLastUsedResolution {
+		if err := entclt.ApiKey.UpdateOne(ak).SetLastUsedAt(time.Now()).Exec(ctx); err != nil {
+			return nil, nil, fmt.Errorf("could not update api key last used time: %w", err)
+		}
+	}
+
+	return u, ak, nil
+}
+
+// CreateAPIKeyForUser creates a new API key for a user.
+// The returned key is not stored and cannot be retrieved later.
+func (a *Authentication) CreateAPIKeyForUser(ctx context.Context, create *generated.ApiKeyCreate) (*generated.ApiKey, string, error) {
+	key, prefix, err := NewAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	ak, err := create.
+		SetKeyHash(HashAPIKey(key)).
+		SetPrefix(prefix).
+		Save(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not create api key: %w", err)
+	}
+
+	return ak, key, nil
+}
@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+)
+
+func TestNewAPIKey(t *testing.T) {
+	t.Parallel()
+
+	key, prefix, err := NewAPIKey()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(key, prefix))
+	assert.Len(t, prefix, apiKeyVisibleChars)
+
+	other, _, err := NewAPIKey()
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other)
+	assert.NotEqual(t, HashAPIKey(key), HashAPIKey(other))
+}
+
+func TestAPIKeyScopes(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateAPIKeyScopes(APIKeyScopes))
+	require.Error(t, ValidateAPIKeyScopes([]string{ScopePostsRead, "admin"}))
+
+	ctx := context.Background()
+	assert.True(t, HasScope(ctx, ScopeModerate), "requests without api key have all scopes")
+
+	ctx = WithAPIKey(ctx, &generated.ApiKey{Scopes: []string{ScopePostsRead}})
+	assert.True(t, HasScope(ctx, ScopePostsRead))
+	assert.False(t, HasScope(ctx, ScopePostsWrite))
+}
@@ -13,10 +13,11 @@ import (
 	"github.com/caliecode/la-clipasa/internal"
 	"github.com/caliecode/la-clipasa/internal/client"
 	"github.com/caliecode/la-clipasa/internal/ent/generated"
-	"github.com/caliecode/la-clipasa/internal/ent/generated/apikey"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/refreshtoken"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
 	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
 	"github.com/gin-gonic/gin"
@@ -31,6 +32,9 @@ const (
 	RefreshTokenBytes       = 32
 	AccessTokenHeaderName   = "Authorization"
 	AccessTokenBearerPrefix = "Bearer "
+
+	// RefreshTokenReuseGracePeriod allows concurrent requests to present a token that was just rotated.
+	RefreshTokenReuseGracePeriod = 10 * time.Second
 )
 
 var (
@@ -38,6 +42,7 @@ var (
 	ErrRefreshTokenNotFound = errors.New("refresh token not found")
 	ErrRefreshTokenExpired  = errors.New("refresh token expired")
 	ErrRefreshTokenRevoked  = errors.New("refresh token revoked")
+	ErrRefreshTokenReused   = errors.New("refresh token reused")
 	ErrInvalidSigningMethod = errors.New("invalid signing method")
 	ErrInvalidTokenClaims   = errors.New("invalid token claims")
 	ErrParseToken           = errors.New("could not parse token")
@@ -53,27 +58,33 @@ type AppClaims struct {
 type TokenPair struct {
 	AccessToken  string
 	RefreshToken string
+	// SessionID is the refresh token family of the session.
+	SessionID uuid.UUID
 }
 
 type Authentication struct {
 	twitch *client.TwitchHandlers
 	entc   *generated.Client
 
-	signingKey []byte
-	issuer     string
+	keyring *Keyring
+	issuer  string
 }
 
 // NewAuthentication returns a new authentication service.
-func NewAuthentication(entc *generated.Client) *Authentication {
-	twitch := client.NewTwitchHandlers(entc)
+func NewAuthentication(entc *generated.Client, twitch *client.TwitchHandlers) (*Authentication, error) {
 	cfg := internal.Config
 
-	return &Authentication{
-		twitch:     twitch,
-		entc:       entc,
-		signingKey: []byte(cfg.SigningKey),
-		issuer:     cfg.TwitchOIDC.Issuer,
+	keyring, err := DefaultKeyring()
+	if err != nil {
+		return nil, fmt.Errorf("could not load access token keyring: %w", err)
 	}
+
+	return &Authentication{
+		twitch:  twitch,
+		entc:    entc,
+		keyring: keyring,
+		issuer:  cfg.TwitchOIDC.Issuer,
+	}, nil
 }
 
 // GetUserFromAccessToken returns a user from a token.
@@ -92,28 +103,11 @@ func (a *Authentication) GetUserFromAccessToken(ctx context.Context, token strin
 	return user, nil
 }
 
-// GetUserFromAPIKey returns a user from an api key.
-func (a *Authentication) GetUserFromAPIKey(ctx context.Context, key string) (*generated.User, error) {
-	entclt := generated.FromContext(ctx)
-	u, err := entclt.User.Query().Where(user.HasAPIKeysWith(apikey.APIKey(key))).WithAPIKeys().Only(ctx)
-	if err != nil {
-		return nil, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "user from api key not found")
-	}
-	ak, err := u.Edges.APIKeysOrErr()
-	if err != nil {
-		return nil, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "api key edge")
-	}
-	if ak[0].ExpiresOn.Before(time.Now()) {
-		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnauthorized, "api key expired")
-	}
-
-	return u, nil
-}
-
 const defaultRole = user.RoleGUEST
 
-// GetOrRegisterUserFromUserInfo returns a user from user info.
-func (a *Authentication) GetOrRegisterUserFromUserInfo(c *gin.Context, userinfo *oidc.UserInfo) (*generated.User, error) {
+// GetOrRegisterUserFromUserInfo returns the user linked to a provider identity,
+// registering a new user on first login.
+func (a *Authentication) GetOrRegisterUserFromUserInfo(c *gin.Context, provider identity.Provider, userinfo *oidc.UserInfo) (*generated.User, error) {
 	ctx := c.Request.Context()
 	entclt := generated.FromContext(ctx)
 
@@ -122,21 +116,26 @@ func (a *Authentication) GetOrRegisterUserFromUserInfo(c *gin.Context, userinfo
 		role = defaultRole
 	}
 
-	u, err := entclt.User.Query().Where(user.ExternalID(userinfo.Subject)).Only(ctx)
+	u, err := a.GetUserFromIdentity(ctx, provider, userinfo.Subject)
 	if err != nil && !generated.IsNotFound(err) {
 		return nil, internal.WrapErrorf(err, internal.ErrorCodePrivate, "could not query user %s", userinfo.PreferredUsername)
 	}
 
-	twitchUser, err := a.twitch.GetUser(c)
-	if err != nil {
-		return nil, err
+	profileImage := userinfo.Picture
+	// the picture claim is only returned when explicitly requested
+	if provider == identity.ProviderTWITCH && profileImage == "" {
+		twitchUser, err := a.twitch.GetUser(c)
+		if err != nil {
+			return nil, err
+		}
+		profileImage = twitchUser.Data[0].ProfileImageURL
 	}
-	profileImage := twitchUser.Data[0].ProfileImageURL
 
 	// create user on first login
 	if u == nil {
 		u, err = entclt.User.Create().
-			SetExternalID(userinfo.Subject).
+			SetExternalID(ExternalID(provider, userinfo.Subject)).
+			SetAuthProvider(user.AuthProvider(provider)).
 			SetProfileImage(profileImage).
 			SetDisplayName(userinfo.PreferredUsername).
 			SetRole(role).
@@ -145,19 +144,28 @@ func (a *Authentication) GetOrRegisterUserFromUserInfo(c *gin.Context, userinfo
 			return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "could not create user from provider")
 		}
 	}
+
+	if err := a.LinkIdentity(ctx, u, provider, userinfo); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "could not link identity")
+	}
+
 	userUpdate := u.Update()
 	if u.Role == defaultRole && userinfo.EmailVerified {
 		userUpdate.SetRole(user.RoleUSER)
 	}
-	// update out of sync non editable fields
+	// update out of sync non editable fields from the provider the user registered with only
 	// userinfo.Email is empty for some reason with user:read:email scope
-	if u.DisplayName != userinfo.PreferredUsername {
-		userUpdate.SetDisplayName(userinfo.PreferredUsername)
-	}
-	if u.ProfileImage == nil || *u.ProfileImage != profileImage {
-		userUpdate.SetProfileImage(profileImage)
+	if string(u.AuthProvider) == string(provider) {
+		if u.DisplayName != userinfo.PreferredUsername {
+			userUpdate.SetDisplayName(userinfo.PreferredUsername)
+		}
+		if u.ProfileImage == nil || *u.ProfileImage != profileImage {
+			userUpdate.SetProfileImage(profileImage)
+		}
 	}
-	if u, err = userUpdate.Save(ctx); err != nil {
+	// login promotions are not manual assignments and must not block Twitch syncs
+	updateCtx := internal.SetRoleChangeSourceCtx(ctx, rolechange.SourceSYSTEM)
+	if u, err = userUpdate.Save(updateCtx); err != nil {
 		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "could not update user from provider")
 	}
 
@@ -178,9 +186,7 @@ func (a *Authentication) CreateAccessTokenForUser(ctx context.Context, user *gen
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	// see https://github.com/dgrijalva/jwt-go/issues/65
-	ss, err := token.SignedString(a.signingKey)
+	ss, err := a.keyring.Sign(claims)
 	if err != nil {
 		return "", fmt.Errorf("could not sign access token: %w", err)
 	}
@@ -188,25 +194,14 @@ func (a *Authentication) CreateAccessTokenForUser(ctx context.Context, user *gen
 	return ss, nil
 }
 
-// CreateAPIKeyForUser creates a new API key for a user.
-func (a *Authentication) CreateAPIKeyForUser(ctx context.Context, user *generated.User, expiresOn time.Time) (*generated.ApiKey, error) {
-	entclt := generated.FromContext(ctx)
-	uak, err := entclt.ApiKey.Create().SetExpiresOn(expiresOn).SetOwner(user).Save(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("usvc.CreateAPIKey: %w", err)
-	}
-
-	return uak, nil
+// Keyring returns the access token keyring.
+func (a *Authentication) Keyring() *Keyring {
+	return a.keyring
 }
 
 // ParseToken parses and validates the JWT access token.
 func (a *Authentication) ParseToken(ctx context.Context, token string) (*AppClaims, error) {
-	jwtToken, err := jwt.ParseWithClaims(token, &AppClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("%w: unexpected signing method: %v", ErrInvalidSigningMethod, token.Header["alg"])
-		}
-		return a.signingKey, nil
-	})
+	jwtToken, err := jwt.ParseWithClaims(token, &AppClaims{}, a.keyring.Keyfunc)
 	// check specific JWT errors before claims or validity
 	if err != nil {
 		// return the original error so downstream can check it
@@ -224,8 +219,7 @@ func (a *Authentication) ParseToken(ctx context.Context, token string) (*AppClai
 // ValidateAndRotateRefreshToken validates an old refresh token, revokes it,
 // issues a new pair, and returns the associated user and the new token pair.
 func (a *Authentication) ValidateAndRotateRefreshToken(ctx context.Context, oldRefreshTokenString string) (*generated.User, *TokenPair, error) {
-	refreshTokenHash := sha256.Sum256([]byte(oldRefreshTokenString))
-	refreshTokenHashString := base64.URLEncoding.EncodeToString(refreshTokenHash[:])
+	refreshTokenHashString := HashRefreshToken(oldRefreshTokenString)
 
 	txClient, err := a.entc.BeginTx(ctx, nil)
 	if err != nil {
@@ -263,10 +257,20 @@ func (a *Authentication) ValidateAndRotateRefreshToken(ctx context.Context, oldR
 	}
 
 	if rt.Revoked {
-		return nil, nil, ErrRefreshTokenRevoked
+		err = a.detectRefreshTokenReuse(sysCtx, entTx, rt)
+		if errors.Is(err, ErrRefreshTokenReused) {
+			// family revocation must persist
+			if commitErr := txClient.Commit(); commitErr != nil {
+				return nil, nil, fmt.Errorf("failed to commit transaction: %w", commitErr)
+			}
+		}
+
+		return nil, nil, err
 	}
 	if rt.ExpiresAt.Before(time.Now()) {
-		return nil, nil, ErrRefreshTokenExpired
+		err = ErrRefreshTokenExpired
+
+		return nil, nil, err
 	}
 
 	user := rt.Edges.Owner
@@ -291,8 +295,7 @@ func (a *Authentication) ValidateAndRotateRefreshToken(ctx context.Context, oldR
 	ipAddress := ginCtx.ClientIP()
 	userAgent := ginCtx.Request.UserAgent()
 
-	// retain old token createdAt for session management reference
-	tp, err := a.IssueNewTokenPair(ctxWithUser, entTx, user, ipAddress, userAgent, &rt.CreatedAt)
+	tp, err := a.IssueNewTokenPair(ctxWithUser, entTx, user, ipAddress, userAgent, rt)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to issue new token pair: %w", err)
 	}
@@ -304,8 +307,45 @@ func (a *Authentication) ValidateAndRotateRefreshToken(ctx context.Context, oldR
 	return user, tp, nil
 }
 
+// detectRefreshTokenReuse returns ErrRefreshTokenReused and revokes the token family
+// if a revoked token that was already rotated is presented again outside the grace period.
+func (a *Authentication) detectRefreshTokenReuse(ctx context.Context, client *generated.Client, rt *generated.RefreshToken) error {
+	rotated, err := client.RefreshToken.Query().Where(refreshtoken.ParentID(rt.ID)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query rotated refresh token: %w", err)
+	}
+	// concurrent requests may present the same token while it is being rotated
+	if !rotated || time.Since(rt.UpdatedAt) < RefreshTokenReuseGracePeriod {
+		return ErrRefreshTokenRevoked
+	}
+
+	n, err := client.RefreshToken.Update().
+		Where(refreshtoken.FamilyID(rt.FamilyID), refreshtoken.RevokedEQ(false)).
+		SetRevoked(true).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to revoke refresh token family: %w", err)
+	}
+
+	if err := deleteSessionTwitchTokens(ctx, client, rt.FamilyID); err != nil {
+		return err
+	}
+
+	if logger := internal.GetLoggerFromCtx(ctx); logger != nil {
+		logger.Warnw("Security event: refresh token reuse detected, revoked token family",
+			"user_id", rt.OwnerID,
+			"family_id", rt.FamilyID,
+			"refresh_token_id", rt.ID,
+			"revoked_tokens", n,
+		)
+	}
+
+	return ErrRefreshTokenReused
+}
+
 // IssueNewTokenPair creates a new token pair.
-func (a *Authentication) IssueNewTokenPair(ctx context.Context, client *generated.Client, user *generated.User, ipAddress, userAgent string, createdAt *time.Time) (*TokenPair, error) {
+// The refresh token is rotated from parent if not nil, retaining its session family and creation time.
+func (a *Authentication) IssueNewTokenPair(ctx context.Context, client *generated.Client, user *generated.User, ipAddress, userAgent string, parent *generated.RefreshToken) (*TokenPair, error) {
 	accessToken, err := a.CreateAccessTokenForUser(ctx, user)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create access token: %w", err)
@@ -316,19 +356,30 @@ func (a *Authentication) IssueNewTokenPair(ctx context.Context, client *generate
 		return nil, fmt.Errorf("failed to generate refresh token bytes: %w", err)
 	}
 	refreshTokenString := base64.URLEncoding.EncodeToString(rb)
-	refreshTokenHash := sha256.Sum256([]byte(refreshTokenString))
-	refreshTokenHashString := base64.URLEncoding.EncodeToString(refreshTokenHash[:])
+	refreshTokenHashString := HashRefreshToken(refreshTokenString)
 	refreshExpiresAt := time.Now().Add(RefreshTokenLifeTime)
 
+	familyID := uuid.New()
+	if parent != nil {
+		familyID = parent.FamilyID
+	}
+
 	creator := client.RefreshToken.Create().
 		SetOwner(user).
 		SetTokenHash(refreshTokenHashString).
 		SetExpiresAt(refreshExpiresAt).
 		SetUserAgent(userAgent).
-		SetRevoked(false)
+		SetRevoked(false).
+		SetFamilyID(familyID)
 
-	if createdAt != nil {
-		creator.SetCreatedAt(*createdAt)
+	if ipAddress != "" {
+		creator.SetIPAddress(ipAddress)
+	}
+
+	if parent != nil {
+		creator.
+			SetParentID(parent.ID).
+			SetCreatedAt(parent.CreatedAt)
 	}
 
 	if _, err := creator.Save(internal.SetUserCtx(ctx, user)); err != nil {
@@ -338,20 +389,26 @@ func (a *Authentication) IssueNewTokenPair(ctx context.Context, client *generate
 	return &TokenPair{
 		AccessToken:  accessToken,
 		RefreshToken: refreshTokenString,
+		SessionID:    familyID,
 	}, nil
 }
 
-// CleanupExpiredAndRevokedTokens removes old tokens to prevent database bloat
+// HashRefreshToken returns the stored hash of a refresh token.
+func HashRefreshToken(refreshToken string) string {
+	h := sha256.Sum256([]byte(refreshToken))
+
+	return base64.URLEncoding.EncodeToString(h[:])
+}
+
+// CleanupExpiredAndRevokedTokens removes the tokens of revoked or expired sessions to prevent database bloat.
 func (a *Authentication) CleanupExpiredAndRevokedTokens(ctx context.Context, userIDs ...uuid.UUID) {
 	a.entc.Logger.Info("Cleaning up expired and revoked tokens")
 	ctx = token.NewContextWithSystemCallToken(ctx)
 	ctx = privacy.DecisionContext(ctx, privacy.Allow)
 
+	// rotated tokens are kept until their session ends, to detect their reuse
 	pp := []predicate.RefreshToken{
-		refreshtoken.Or(
-			refreshtoken.RevokedEQ(true),
-			refreshtoken.ExpiresAtLT(time.Now()),
-		),
+		inactiveSessions(),
 	}
 
 	if len(userIDs) > 0 {
@@ -362,6 +419,10 @@ func (a *Authentication) CleanupExpiredAndRevokedTokens(ctx context.Context, use
 		Where(pp...).
 		Exec(ctx)
 	if err != nil {
-		fmt.Printf("Error cleaning up tokens: %v\n", err)
+		a.entc.Logger.Errorf("Error cleaning up tokens: %v", err)
+	}
+
+	if err := deleteInactiveSessionTwitchTokens(ctx, a.entc); err != nil {
+		a.entc.Logger.Errorf("Error cleaning up twitch tokens: %v", err)
 	}
 }
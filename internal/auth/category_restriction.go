@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+	"github.com/caliecode/la-clipasa/internal/utils/cache"
+)
+
+// categoryRestrictionsTTL bounds the delay for changes to apply if an invalidation is missed.
+const categoryRestrictionsTTL = time.Minute
+
+const categoryRestrictionsCacheKey = "category-restrictions"
+
+// InvalidateCategoryRestrictions clears the category restrictions cached by all instances.
+func InvalidateCategoryRestrictions(ctx context.Context) {
+	if store := cache.FromContext(ctx); store != nil {
+		_ = store.Delete(ctx, categoryRestrictionsCacheKey)
+	}
+}
+
+// CategoryRestrictions returns the restrictions of post categories, by category.
+// Unrestricted categories may have no entry.
+// Restrictions are cached in the store of the context, if any.
+func CategoryRestrictions(ctx context.Context, entclt *generated.Client) (map[string]*generated.CategoryRestriction, error) {
+	return cache.Memoize(ctx, cache.FromContext(ctx), categoryRestrictionsCacheKey, categoryRestrictionsTTL, func() (map[string]*generated.CategoryRestriction, error) {
+		sysCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+		crs, err := entclt.CategoryRestriction.Query().All(sysCtx)
+		if err != nil {
+			return nil, fmt.Errorf("could not load category restrictions: %w", err)
+		}
+
+		byCategory := make(map[string]*generated.CategoryRestriction, len(crs))
+		for _, cr := range crs {
+			byCategory[cr.Category.String()] = cr
+		}
+
+		return byCategory, nil
+	})
+}
@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+)
+
+var (
+	ErrIdentityLinkedToOtherUser  = errors.New("identity is linked to another user")
+	ErrUnlinkRegistrationIdentity = errors.New("the identity used to register cannot be unlinked")
+)
+
+// ExternalID returns the user external id for a provider identity.
+// Twitch subjects are used as is for backwards compatibility.
+func ExternalID(provider identity.Provider, subject string) string {
+	if provider == identity.ProviderTWITCH {
+		return subject
+	}
+
+	return strings.ToLower(string(provider)) + ":" + subject
+}
+
+// GetUserFromIdentity returns the user linked to a provider identity.
+func (a *Authentication) GetUserFromIdentity(ctx context.Context, provider identity.Provider, subject string) (*generated.User, error) {
+	sysCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+	entclt := generated.FromContext(ctx)
+
+	u, err := entclt.Identity.Query().
+		Where(identity.ProviderEQ(provider), identity.Subject(subject)).
+		QueryOwner().
+		Only(sysCtx)
+	if err == nil || !generated.IsNotFound(err) {
+		return u, err
+	}
+
+	// users without identities, e.g. seeded users
+	return entclt.User.Query().Where(user.ExternalID(ExternalID(provider, subject))).Only(sysCtx)
+}
+
+// LinkIdentity links a provider identity to a user.
+// Linking an identity that is already linked to the user is a no-op.
+func (a *Authentication) LinkIdentity(ctx context.Context, u *generated.User, provider identity.Provider, userinfo *oidc.UserInfo) error {
+	sysCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+	entclt := generated.FromContext(ctx)
+
+	owner, err := a.GetUserFromIdentity(ctx, provider, userinfo.Subject)
+	if err != nil && !generated.IsNotFound(err) {
+		return fmt.Errorf("could not query identity: %w", err)
+	}
+	if owner != nil && owner.ID != u.ID {
+		return ErrIdentityLinkedToOtherUser
+	}
+
+	exists, err := entclt.Identity.Query().
+		Where(identity.ProviderEQ(provider), identity.Subject(userinfo.Subject)).
+		Exist(sysCtx)
+	if err != nil {
+		return fmt.Errorf("could not query identity: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	create := entclt.Identity.Create().
+		SetOwnerID(u.ID).
+		SetProvider(provider).
+		SetSubject(userinfo.Subject)
+	if userinfo.Email != "" {
+		create.SetEmail(userinfo.Email)
+	}
+	if err := create.Exec(sysCtx); err != nil {
+		return fmt.Errorf("could not create identity: %w", err)
+	}
+
+	return nil
+}
+
+// UnlinkIdentity removes a provider identity from a user.
+func (a *Authentication) UnlinkIdentity(ctx context.Context, u *generated.User, id uuid.UUID) error {
+	entclt := generated.FromContext(ctx)
+
+	ident, err := entclt.Identity.Query().
+		Where(identity.ID(id), identity.OwnerID(u.ID)).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	if string(ident.Provider) == string(u.AuthProvider) && ExternalID(ident.Provider, ident.Subject) == u.ExternalID {
+		return internal.WrapErrorf(ErrUnlinkRegistrationIdentity, internal.ErrorCodeInvalidArgument, "unlink identity")
+	}
+
+	return entclt.Identity.DeleteOne(ident).Exec(ctx)
+}
@@ -0,0 +1,252 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/caliecode/la-clipasa/internal"
+)
+
+var ErrUnknownKeyID = errors.New("unknown key id")
+
+// KeyringKey is an access token key. Keys without a private key are used for verification only.
+type KeyringKey struct {
+	ID         string
+	Method     jwt.SigningMethod
+	PrivateKey crypto.Signer
+	PublicKey  crypto.PublicKey
+}
+
+// Keyring signs access tokens with a single active key and verifies them
+// with any of its keys, so that keys can be rotated without invalidating issued tokens:
+// add the new key, switch the signing key id and remove the old key once
+// its tokens have expired.
+type Keyring struct {
+	signingKey *KeyringKey
+	keys       map[string]*KeyringKey
+	// legacyHMACKey verifies tokens issued before asymmetric signing until legacyHMACUntil.
+	// Such tokens have all expired by then, so that HS256 tokens are not accepted indefinitely.
+	// TODO: remove once asymmetric signing is rolled out.
+	legacyHMACKey   []byte
+	legacyHMACUntil time.Time
+}
+
+// NewKeyring returns a keyring signing with the key identified by signingKeyID.
+func NewKeyring(signingKeyID string, keys ...*KeyringKey) (*Keyring, error) {
+	kr := &Keyring{keys: make(map[string]*KeyringKey, len(keys))}
+	for _, k := range keys {
+		if _, ok := kr.keys[k.ID]; ok {
+			return nil, fmt.Errorf("duplicate key id %q", k.ID)
+		}
+		kr.keys[k.ID] = k
+	}
+
+	signingKey, ok := kr.keys[signingKeyID]
+	if !ok {
+		return nil, fmt.Errorf("signing key %q: %w", signingKeyID, ErrUnknownKeyID)
+	}
+	if signingKey.PrivateKey == nil {
+		return nil, fmt.Errorf("signing key %q has no private key", signingKeyID)
+	}
+	kr.signingKey = signingKey
+
+	return kr, nil
+}
+
+// NewKeyringKey returns a key for an RSA or Ed25519 private or public key.
+// The key id defaults to the key thumbprint.
+func NewKeyringKey(id string, key any) (*KeyringKey, error) {
+	k := &KeyringKey{ID: id}
+
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		k.Method, k.PrivateKey, k.PublicKey = jwt.SigningMethodRS256, key, key.Public()
+	case ed25519.PrivateKey:
+		k.Method, k.PrivateKey, k.PublicKey = jwt.SigningMethodEdDSA, key, key.Public()
+	case *rsa.PublicKey:
+		k.Method, k.PublicKey = jwt.SigningMethodRS256, key
+	case ed25519.PublicKey:
+		k.Method, k.PublicKey = jwt.SigningMethodEdDSA, key
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", key)
+	}
+
+	if k.ID == "" {
+		thumbprint, err := (&jose.JSONWebKey{Key: k.PublicKey}).Thumbprint(crypto.SHA256)
+		if err != nil {
+			return nil, fmt.Errorf("could not compute key thumbprint: %w", err)
+		}
+		k.ID = base64.RawURLEncoding.EncodeToString(thumbprint)
+	}
+
+	return k, nil
+}
+
+// Sign returns a signed token with the active key id in its header.
+func (kr *Keyring) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(kr.signingKey.Method, claims)
+	token.Header["kid"] = kr.signingKey.ID
+
+	return token.SignedString(kr.signingKey.PrivateKey)
+}
+
+// Keyfunc returns the verification key for a token.
+func (kr *Keyring) Keyfunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok && len(kr.legacyHMACKey) > 0 && time.Now().Before(kr.legacyHMACUntil) {
+			return kr.legacyHMACKey, nil
+		}
+
+		return nil, fmt.Errorf("%w: missing kid header", ErrInvalidSigningMethod)
+	}
+
+	key, ok := kr.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKeyID, kid)
+	}
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, fmt.Errorf("%w: unexpected signing method: %v", ErrInvalidSigningMethod, token.Header["alg"])
+	}
+
+	return key.PublicKey, nil
+}
+
+// JWKS returns the public verification keys.
+func (kr *Keyring) JWKS() jose.JSONWebKeySet {
+	ids := make([]string, 0, len(kr.keys))
+	for id := range kr.keys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	jwks := jose.JSONWebKeySet{Keys: make([]jose.JSONWebKey, 0, len(ids))}
+	for _, id := range ids {
+		k := kr.keys[id]
+		jwks.Keys = append(jwks.Keys, jose.JSONWebKey{
+			Key:       k.PublicKey,
+			KeyID:     k.ID,
+			Algorithm: k.Method.Alg(),
+			Use:       "sig",
+		})
+	}
+
+	return jwks
+}
+
+var (
+	defaultKeyring     *Keyring
+	defaultKeyringErr  error
+	defaultKeyringOnce sync.Once
+)
+
+// DefaultKeyring returns the keyring loaded from the app config.
+func DefaultKeyring() (*Keyring, error) {
+	defaultKeyringOnce.Do(func() {
+		defaultKeyring, defaultKeyringErr = LoadKeyring(internal.Config)
+	})
+
+	return defaultKeyring, defaultKeyringErr
+}
+
+// LoadKeyring loads the keyring from JWT_KEYS_DIR.
+// If unset outside production, a single Ed25519 key derived from SIGNING_KEY is used.
+func LoadKeyring(cfg *internal.AppConfig) (*Keyring, error) {
+	var kr *Keyring
+	var err error
+
+	if cfg.JWT.KeysDir == nil || *cfg.JWT.KeysDir == "" {
+		if cfg.AppEnv == internal.AppEnvProd {
+			return nil, errors.New("JWT_KEYS_DIR and JWT_SIGNING_KEY_ID are required in production")
+		}
+
+		seed := sha256.Sum256([]byte(cfg.SigningKey))
+		k, err := NewKeyringKey("", ed25519.NewKeyFromSeed(seed[:]))
+		if err != nil {
+			return nil, err
+		}
+
+		kr, err = NewKeyring(k.ID, k)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if cfg.JWT.SigningKeyID == nil || *cfg.JWT.SigningKeyID == "" {
+			return nil, errors.New("JWT_SIGNING_KEY_ID is required with JWT_KEYS_DIR")
+		}
+
+		kr, err = loadKeyringDir(*cfg.JWT.KeysDir, *cfg.JWT.SigningKeyID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// tokens issued by previous deployments expire within their lifetime
+	kr.legacyHMACKey = []byte(cfg.SigningKey)
+	kr.legacyHMACUntil = time.Now().Add(AccessTokenLifeTime)
+
+	return kr, nil
+}
+
+// loadKeyringDir loads PEM encoded keys named <kid>.pem.
+func loadKeyringDir(dir string, signingKeyID string) (*Keyring, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, fmt.Errorf("could not list keys: %w", err)
+	}
+
+	keys := make([]*KeyringKey, 0, len(paths))
+	for _, path := range paths {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("could not read key: %w", err)
+		}
+
+		key, err := parsePEMKey(b)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		k, err := NewKeyringKey(strings.TrimSuffix(filepath.Base(path), ".pem"), key)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		keys = append(keys, k)
+	}
+
+	return NewKeyring(signingKeyID, keys...)
+}
+
+func parsePEMKey(b []byte) (any, error) {
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		return x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+}
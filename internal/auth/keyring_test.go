@@ -0,0 +1,177 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/caliecode/la-clipasa/internal"
+)
+
+func testClaims() jwt.Claims {
+	return AppClaims{
+		Username: "user",
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "1",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		},
+	}
+}
+
+func parse(kr *Keyring, token string) error {
+	_, err := jwt.ParseWithClaims(token, &AppClaims{}, kr.Keyfunc)
+
+	return err
+}
+
+func TestKeyringRotation(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	oldKey, err := NewKeyringKey("old", rsaKey)
+	require.NoError(t, err)
+	newKey, err := NewKeyringKey("new", edKey)
+	require.NoError(t, err)
+	oldPublicKey, err := NewKeyringKey("old", rsaKey.Public())
+	require.NoError(t, err)
+
+	oldKr, err := NewKeyring("old", oldKey)
+	require.NoError(t, err)
+	oldToken, err := oldKr.Sign(testClaims())
+	require.NoError(t, err)
+
+	// rotated keyring keeps verifying tokens signed by the retired key
+	kr, err := NewKeyring("new", newKey, oldPublicKey)
+	require.NoError(t, err)
+	newToken, err := kr.Sign(testClaims())
+	require.NoError(t, err)
+
+	require.NoError(t, parse(kr, oldToken))
+	require.NoError(t, parse(kr, newToken))
+
+	parsed, _, err := jwt.NewParser().ParseUnverified(newToken, &AppClaims{})
+	require.NoError(t, err)
+	assert.Equal(t, "new", parsed.Header["kid"])
+	assert.Equal(t, jwt.SigningMethodEdDSA.Alg(), parsed.Header["alg"])
+
+	// old keyring does not know the new key
+	require.ErrorIs(t, parse(oldKr, newToken), ErrUnknownKeyID)
+
+	_, err = NewKeyring("old", newKey, oldPublicKey)
+	require.Error(t, err, "verification only keys cannot sign")
+
+	jwks := kr.JWKS()
+	require.Len(t, jwks.Keys, 2)
+	b, err := json.Marshal(jwks)
+	require.NoError(t, err)
+	var decoded jose.JSONWebKeySet
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	require.Len(t, decoded.Key("new"), 1)
+	assert.Equal(t, edKey.Public(), decoded.Key("new")[0].Key)
+	_, isPrivate := decoded.Key("old")[0].Key.(*rsa.PrivateKey)
+	assert.False(t, isPrivate, "private keys must not be exposed")
+}
+
+func TestKeyringRejectsForgedTokens(t *testing.T) {
+	t.Parallel()
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	k, err := NewKeyringKey("", edKey)
+	require.NoError(t, err)
+	assert.NotEmpty(t, k.ID, "kid defaults to thumbprint")
+
+	kr, err := NewKeyring(k.ID, k)
+	require.NoError(t, err)
+	kr.legacyHMACKey = []byte("legacy")
+	kr.legacyHMACUntil = time.Now().Add(time.Minute)
+
+	// legacy tokens without kid
+	legacy, err := jwt.NewWithClaims(jwt.SigningMethodHS256, testClaims()).SignedString([]byte("legacy"))
+	require.NoError(t, err)
+	require.NoError(t, parse(kr, legacy))
+
+	kr.legacyHMACUntil = time.Now().Add(-time.Second)
+	require.ErrorIs(t, parse(kr, legacy), ErrInvalidSigningMethod, "legacy tokens are only accepted during the migration window")
+	kr.legacyHMACUntil = time.Now().Add(time.Minute)
+
+	forged, err := jwt.NewWithClaims(jwt.SigningMethodHS256, testClaims()).SignedString([]byte("other"))
+	require.NoError(t, err)
+	require.Error(t, parse(kr, forged))
+
+	// HMAC signed with the public key as secret
+	confused := jwt.NewWithClaims(jwt.SigningMethodHS256, testClaims())
+	confused.Header["kid"] = k.ID
+	confusedToken, err := confused.SignedString([]byte(edKey.Public().(ed25519.PublicKey)))
+	require.NoError(t, err)
+	require.ErrorIs(t, parse(kr, confusedToken), ErrInvalidSigningMethod)
+}
+
+func TestLoadKeyring(t *testing.T) {
+	t.Parallel()
+
+	t.Run("derived from signing key", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &internal.AppConfig{SigningKey: "secret"}
+		kr1, err := LoadKeyring(cfg)
+		require.NoError(t, err)
+		kr2, err := LoadKeyring(cfg)
+		require.NoError(t, err)
+
+		token, err := kr1.Sign(testClaims())
+		require.NoError(t, err)
+		require.NoError(t, parse(kr2, token), "instances sharing the signing key must verify each other's tokens")
+	})
+
+	t.Run("derived key refused in production", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := LoadKeyring(&internal.AppConfig{SigningKey: "secret", AppEnv: internal.AppEnvProd})
+		require.Error(t, err)
+	})
+
+	t.Run("keys dir", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		der, err := x509.MarshalPKCS8PrivateKey(rsaKey)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "2026-10.pem"), pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+
+		edPub, _, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		der, err = x509.MarshalPKIXPublicKey(edPub)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "2026-09.pem"), pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+
+		keysDir, signingKeyID := dir, "2026-10"
+		kr, err := LoadKeyring(&internal.AppConfig{JWT: internal.JWTConfig{KeysDir: &keysDir, SigningKeyID: &signingKeyID}})
+		require.NoError(t, err)
+		assert.Len(t, kr.JWKS().Keys, 2)
+		assert.Equal(t, jwt.SigningMethodRS256, kr.signingKey.Method)
+
+		signingKeyID = "2026-09"
+		_, err = LoadKeyring(&internal.AppConfig{JWT: internal.JWTConfig{KeysDir: &keysDir, SigningKeyID: &signingKeyID}})
+		require.Error(t, err)
+	})
+}
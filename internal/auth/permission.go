@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+	"github.com/caliecode/la-clipasa/internal/utils/cache"
+)
+
+// DefaultRolePermissions are the role permissions seeded on migration,
+// equivalent to role ranks.
+var DefaultRolePermissions = map[user.Role][]rolepermission.Permission{
+	user.RoleGUEST: {},
+	user.RoleUSER:  {},
+	user.RoleMODERATOR: {
+		rolepermission.PermissionPostsCategorize,
+		rolepermission.PermissionPostsModerate,
+		rolepermission.PermissionPostsDelete,
+		rolepermission.PermissionUsersSuspend,
+	},
+	user.RoleADMIN: rolepermission.AllPermissions(),
+}
+
+// rolePermissionsTTL bounds the delay for changes to apply if an invalidation is missed.
+const rolePermissionsTTL = time.Minute
+
+const rolePermissionsCacheKey = "role-permissions"
+
+// InvalidateRolePermissions clears the role permissions cached by all instances.
+func InvalidateRolePermissions(ctx context.Context) {
+	if store := cache.FromContext(ctx); store != nil {
+		_ = store.Delete(ctx, rolePermissionsCacheKey)
+	}
+}
+
+// RolePermissions returns the permissions granted to a role.
+// Permissions are cached in the store of the context, if any.
+func RolePermissions(ctx context.Context, role user.Role) ([]rolepermission.Permission, error) {
+	byRole, err := cache.Memoize(ctx, cache.FromContext(ctx), rolePermissionsCacheKey, rolePermissionsTTL, func() (map[user.Role][]rolepermission.Permission, error) {
+		return loadRolePermissions(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return byRole[role], nil
+}
+
+func loadRolePermissions(ctx context.Context) (map[user.Role][]rolepermission.Permission, error) {
+	entclt := generated.FromContext(ctx)
+	if entclt == nil {
+		return nil, fmt.Errorf("could not load role permissions: no ent client in context")
+	}
+
+	sysCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+	rps, err := entclt.RolePermission.Query().All(sysCtx)
+	if err != nil {
+		return nil, fmt.Errorf("could not load role permissions: %w", err)
+	}
+
+	byRole := make(map[user.Role][]rolepermission.Permission)
+	for _, rp := range rps {
+		role := user.Role(rp.Role)
+		byRole[role] = append(byRole[role], rp.Permission)
+	}
+
+	return byRole, nil
+}
+
+// HasPermission reports whether the user role has been granted a permission.
+func HasPermission(ctx context.Context, u *generated.User, permission rolepermission.Permission) bool {
+	if u == nil {
+		return false
+	}
+
+	permissions, err := RolePermissions(ctx, u.Role)
+	if err != nil {
+		return false
+	}
+
+	return slices.Contains(permissions, permission)
+}
+
+// SetRolePermissions replaces the permissions granted to a role.
+func (a *Authentication) SetRolePermissions(ctx context.Context, role user.Role, permissions []rolepermission.Permission) ([]rolepermission.Permission, error) {
+	if role == user.RoleADMIN && !slices.Contains(permissions, rolepermission.PermissionRolesManage) {
+		return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "admins must keep the %s permission", rolepermission.PermissionRolesManage)
+	}
+
+	slices.Sort(permissions)
+	permissions = slices.Compact(permissions)
+
+	txClient, err := a.entc.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = txClient.Rollback()
+		}
+	}()
+
+	entTx := txClient.Client()
+
+	if _, err = entTx.RolePermission.Delete().Where(rolepermission.RoleEQ(rolepermission.Role(role))).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("could not delete role permissions: %w", err)
+	}
+
+	creates := make([]*generated.RolePermissionCreate, 0, len(permissions))
+	for _, p := range permissions {
+		creates = append(creates, entTx.RolePermission.Create().SetRole(rolepermission.Role(role)).SetPermission(p))
+	}
+	if _, err = entTx.RolePermission.CreateBulk(creates...).Save(ctx); err != nil {
+		return nil, fmt.Errorf("could not create role permissions: %w", err)
+	}
+
+	if err = txClient.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	InvalidateRolePermissions(ctx)
+
+	return permissions, nil
+}
@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/refreshtoken"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/twitchtoken"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+)
+
+// ActiveSessions returns the predicates of refresh tokens that can still be rotated.
+func ActiveSessions() []predicate.RefreshToken {
+	return []predicate.RefreshToken{
+		refreshtoken.RevokedEQ(false),
+		refreshtoken.ExpiresAtGT(time.Now()),
+	}
+}
+
+// RevokeSession revokes an active session of a user by its refresh token family,
+// which is stable across rotations.
+func (a *Authentication) RevokeSession(ctx context.Context, userID uuid.UUID, familyID uuid.UUID) error {
+	n, err := a.revokeSessions(ctx, refreshtoken.FamilyID(familyID), refreshtoken.OwnerID(userID))
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return internal.NewErrorf(internal.ErrorCodeNotFound, "session not found")
+	}
+
+	return nil
+}
+
+// RevokeSessions revokes all active sessions of a user, except the session
+// with the given refresh token hash if not empty.
+// Access tokens already issued remain valid until they expire.
+func (a *Authentication) RevokeSessions(ctx context.Context, userID uuid.UUID, exceptTokenHash string) (int, error) {
+	pp := []predicate.RefreshToken{refreshtoken.OwnerID(userID)}
+	if exceptTokenHash != "" {
+		pp = append(pp, refreshtoken.TokenHashNEQ(exceptTokenHash))
+	}
+
+	return a.revokeSessions(ctx, pp...)
+}
+
+func (a *Authentication) revokeSessions(ctx context.Context, pp ...predicate.RefreshToken) (int, error) {
+	// callers scope predicates to the owner
+	sysCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+	pp = append(pp, ActiveSessions()...)
+
+	var families []struct {
+		FamilyID uuid.UUID `json:"family_id"`
+	}
+	if err := a.entc.RefreshToken.Query().
+		Where(pp...).
+		Unique(true).
+		Select(refreshtoken.FieldFamilyID).
+		Scan(sysCtx, &families); err != nil {
+		return 0, fmt.Errorf("could not get sessions: %w", err)
+	}
+
+	n, err := a.entc.RefreshToken.Update().
+		Where(pp...).
+		SetRevoked(true).
+		Save(sysCtx)
+	if err != nil {
+		return 0, fmt.Errorf("could not revoke sessions: %w", err)
+	}
+
+	familyIDs := make([]uuid.UUID, len(families))
+	for i, f := range families {
+		familyIDs[i] = f.FamilyID
+	}
+	if err := deleteSessionTwitchTokens(sysCtx, a.entc, familyIDs...); err != nil {
+		return n, err
+	}
+
+	return n, nil
+}
+
+// activeFamilyIDs selects the family ids of active sessions.
+func activeFamilyIDs() *sql.Selector {
+	t := sql.Table(refreshtoken.Table).As("active_refresh_tokens")
+
+	return sql.Select(t.C(refreshtoken.FieldFamilyID)).
+		From(t).
+		Where(sql.And(
+			sql.EQ(t.C(refreshtoken.FieldRevoked), false),
+			sql.GT(t.C(refreshtoken.FieldExpiresAt), time.Now()),
+		))
+}
+
+// inactiveSessions returns the predicate of refresh tokens of sessions without active tokens,
+// i.e. revoked or expired sessions.
+func inactiveSessions() predicate.RefreshToken {
+	return func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(refreshtoken.FieldFamilyID), activeFamilyIDs()))
+	}
+}
+
+// deleteSessionTwitchTokens deletes the Twitch tokens of the given sessions if they have
+// no active refresh tokens. ctx must allow system calls.
+func deleteSessionTwitchTokens(ctx context.Context, client *generated.Client, familyIDs ...uuid.UUID) error {
+	if len(familyIDs) == 0 {
+		return nil
+	}
+
+	return deleteInactiveSessionTwitchTokens(ctx, client, twitchtoken.SessionIDIn(familyIDs...))
+}
+
+// deleteInactiveSessionTwitchTokens deletes the Twitch tokens of sessions without active refresh tokens.
+// ctx must allow system calls.
+func deleteInactiveSessionTwitchTokens(ctx context.Context, client *generated.Client, pp ...predicate.TwitchToken) error {
+	_, err := client.TwitchToken.Delete().
+		Where(append(pp,
+			twitchtoken.SessionIDNotNil(),
+			func(s *sql.Selector) {
+				s.Where(sql.NotIn(s.C(twitchtoken.FieldSessionID), activeFamilyIDs()))
+			},
+		)...).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("could not delete session twitch tokens: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+)
+
+// SuspendedError is returned when a user is suspended from the requested action.
+type SuspendedError struct {
+	Scope  suspension.Scope
+	Reason string
+	// EndsAt is nil for permanent suspensions.
+	EndsAt *time.Time
+}
+
+// Error returns the SuspendedError in string format.
+func (e *SuspendedError) Error() string {
+	what := "suspended"
+	switch e.Scope {
+	case suspension.ScopePOST:
+		what = "suspended from posting"
+	case suspension.ScopeCOMMENT:
+		what = "suspended from commenting"
+	}
+
+	if e.EndsAt == nil {
+		return fmt.Sprintf("you are %s indefinitely: %s", what, e.Reason)
+	}
+
+	return fmt.Sprintf("you are %s until %s: %s", what, e.EndsAt.UTC().Format(time.RFC3339), e.Reason)
+}
+
+// NewSuspendedError returns the error for an active suspension.
+func NewSuspendedError(s *generated.Suspension) *SuspendedError {
+	return &SuspendedError{
+		Scope:  s.Scope,
+		Reason: s.Reason,
+		EndsAt: s.EndsAt,
+	}
+}
+
+type ctxKeySuspensions struct{}
+
+// WithSuspensions stores the active suspensions of the caller in the context.
+func WithSuspensions(ctx context.Context, suspensions []*generated.Suspension) context.Context {
+	return context.WithValue(ctx, ctxKeySuspensions{}, suspensions)
+}
+
+// SuspensionsFromCtx returns the active suspensions of the caller, if any.
+func SuspensionsFromCtx(ctx context.Context) []*generated.Suspension {
+	suspensions, _ := ctx.Value(ctxKeySuspensions{}).([]*generated.Suspension)
+
+	return suspensions
+}
+
+// ActiveSuspension returns the active suspension of the caller for a scope, if any.
+// Full suspensions apply to all scopes.
+// Suspensions that expired since they were loaded are ignored.
+func ActiveSuspension(ctx context.Context, scope suspension.Scope) *generated.Suspension {
+	now := time.Now()
+	var active *generated.Suspension
+	for _, s := range SuspensionsFromCtx(ctx) {
+		if s.StartsAt.After(now) || (s.EndsAt != nil && !s.EndsAt.After(now)) {
+			continue
+		}
+		if s.Scope != scope && s.Scope != suspension.ScopeFULL {
+			continue
+		}
+		// report the longest suspension
+		if active == nil || active.EndsAt != nil && (s.EndsAt == nil || s.EndsAt.After(*active.EndsAt)) {
+			active = s
+		}
+	}
+
+	return active
+}
+
+// ActiveSuspensions returns the suspensions of a user in effect now.
+// Expired suspensions lift without further action.
+func (a *Authentication) ActiveSuspensions(ctx context.Context, userID uuid.UUID) ([]*generated.Suspension, error) {
+	now := time.Now()
+	sysCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+
+	suspensions, err := a.entc.Suspension.Query().
+		Where(
+			suspension.UserID(userID),
+			suspension.StartsAtLTE(now),
+			suspension.Or(suspension.EndsAtIsNil(), suspension.EndsAtGT(now)),
+		).
+		All(sysCtx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get active suspensions: %w", err)
+	}
+
+	return suspensions, nil
+}
@@ -0,0 +1,167 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/client"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+	"github.com/caliecode/la-clipasa/internal/utils/cache"
+)
+
+// TwitchBanTTL bounds the delay for Twitch bans and unbans to apply.
+const TwitchBanTTL = 5 * time.Minute
+
+// TwitchBanChecker checks bans in the broadcaster Twitch channel.
+type TwitchBanChecker interface {
+	// UserBan returns whether a user is banned or timed out,
+	// and the timeout expiry, which is zero for permanent bans.
+	UserBan(ctx context.Context, twitchUserID string) (bool, time.Time, error)
+}
+
+// TwitchBans checks bans in the broadcaster Twitch channel, caching them in a store shared by instances.
+// A nil TwitchBans never reports bans.
+type TwitchBans struct {
+	checker TwitchBanChecker
+	store   cache.Store
+}
+
+// NewTwitchBans returns a new TwitchBans. Bans are not checked without a checker.
+func NewTwitchBans(checker TwitchBanChecker, store cache.Store) *TwitchBans {
+	return &TwitchBans{
+		checker: checker,
+		store:   store,
+	}
+}
+
+func twitchBanCacheKey(twitchUserID string) string {
+	return "twitch-ban:" + twitchUserID
+}
+
+// UserBanned reports whether a Twitch user is banned or timed out in the broadcaster channel.
+// Ban status is cached up to TwitchBanTTL.
+func (b *TwitchBans) UserBanned(ctx context.Context, twitchUserID string) (bool, error) {
+	if b == nil || b.checker == nil {
+		return false, nil
+	}
+	if banned, ok, err := cache.GetJSON[bool](ctx, b.store, twitchBanCacheKey(twitchUserID)); err == nil && ok {
+		return banned, nil
+	}
+
+	return b.Refresh(ctx, twitchUserID)
+}
+
+// Refresh checks the ban status of a Twitch user regardless of the cache.
+func (b *TwitchBans) Refresh(ctx context.Context, twitchUserID string) (bool, error) {
+	if b == nil || b.checker == nil {
+		return false, nil
+	}
+
+	banned, timeoutExpiry, err := b.checker.UserBan(ctx, twitchUserID)
+	if err != nil {
+		return false, fmt.Errorf("could not check twitch ban: %w", err)
+	}
+
+	b.Cache(ctx, twitchUserID, banned, timeoutExpiry)
+
+	return banned, nil
+}
+
+// Cache caches a ban status obtained elsewhere, e.g. from channel ban notifications.
+// timeoutExpiry is zero for permanent bans and unbans.
+func (b *TwitchBans) Cache(ctx context.Context, twitchUserID string, banned bool, timeoutExpiry time.Time) {
+	if b == nil {
+		return
+	}
+
+	ttl := TwitchBanTTL
+	// timeouts are lifted without a ban change
+	if banned && !timeoutExpiry.IsZero() {
+		ttl = min(ttl, time.Until(timeoutExpiry))
+	}
+	if ttl <= 0 {
+		_ = b.store.Delete(ctx, twitchBanCacheKey(twitchUserID))
+		return
+	}
+
+	if err := cache.SetJSON(ctx, b.store, twitchBanCacheKey(twitchUserID), banned, ttl); err != nil {
+		if l := internal.GetLoggerFromCtx(ctx); l != nil {
+			l.Warnf("Could not cache twitch ban status: %v", err)
+		}
+	}
+}
+
+// IsBanned reports whether the user is banned or timed out in the broadcaster Twitch channel.
+// Users without a Twitch identity are never banned, and failed checks are ignored.
+func (b *TwitchBans) IsBanned(ctx context.Context, u *generated.User) bool {
+	if b == nil || u == nil {
+		return false
+	}
+
+	twitchUserID, err := TwitchUserID(ctx, u)
+	if err != nil || twitchUserID == "" {
+		return false
+	}
+
+	banned, err := b.UserBanned(ctx, twitchUserID)
+	if err != nil {
+		// not retried until the cache expires
+		b.Cache(ctx, twitchUserID, false, time.Time{})
+		if l := internal.GetLoggerFromCtx(ctx); l != nil && !errors.Is(err, client.ErrBroadcasterTokenNotFound) {
+			l.Warnf("Ignoring twitch ban status of user %s: %v", u.ID, err)
+		}
+		return false
+	}
+
+	return banned
+}
+
+type ctxKeyTwitchBans struct{}
+
+// WithTwitchBans stores the Twitch ban checks in the context.
+func WithTwitchBans(ctx context.Context, b *TwitchBans) context.Context {
+	return context.WithValue(ctx, ctxKeyTwitchBans{}, b)
+}
+
+// TwitchBansFromCtx returns the Twitch ban checks, or nil if there are none.
+func TwitchBansFromCtx(ctx context.Context) *TwitchBans {
+	b, _ := ctx.Value(ctxKeyTwitchBans{}).(*TwitchBans)
+
+	return b
+}
+
+// TwitchUserID returns the Twitch user id of a user, or an empty string if the user has no Twitch identity.
+func TwitchUserID(ctx context.Context, u *generated.User) (string, error) {
+	if u.AuthProvider == user.AuthProviderTWITCH {
+		return u.ExternalID, nil
+	}
+
+	entclt := generated.FromContext(ctx)
+	if entclt == nil {
+		return "", fmt.Errorf("could not get twitch identity: no ent client in context")
+	}
+
+	sysCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+	subjects, err := entclt.Identity.Query().
+		Where(
+			identity.HasOwnerWith(user.ID(u.ID)),
+			identity.ProviderEQ(identity.ProviderTWITCH),
+		).
+		Select(identity.FieldSubject).
+		Strings(sysCtx)
+	if err != nil {
+		return "", fmt.Errorf("could not get twitch identity: %w", err)
+	}
+	if len(subjects) == 0 {
+		return "", nil
+	}
+
+	return subjects[0], nil
+}
@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/caliecode/la-clipasa/internal/utils/cache"
+)
+
+type fakeBanChecker struct {
+	calls     int
+	banned    bool
+	expiresAt time.Time
+	err       error
+}
+
+func (f *fakeBanChecker) UserBan(ctx context.Context, twitchUserID string) (bool, time.Time, error) {
+	f.calls++
+
+	return f.banned, f.expiresAt, f.err
+}
+
+func TestTwitchUserBanned(t *testing.T) {
+	ctx := context.Background()
+	checker := &fakeBanChecker{banned: true}
+	bans := NewTwitchBans(checker, cache.NewMemory(0))
+
+	banned, err := bans.UserBanned(ctx, "1")
+	require.NoError(t, err)
+	assert.True(t, banned)
+
+	checker.banned = false
+	banned, err = bans.UserBanned(ctx, "1")
+	require.NoError(t, err)
+	assert.True(t, banned, "ban status is cached")
+	assert.Equal(t, 1, checker.calls)
+
+	banned, err = bans.Refresh(ctx, "1")
+	require.NoError(t, err)
+	assert.False(t, banned)
+
+	// expired timeouts are checked again
+	checker.banned, checker.expiresAt = true, time.Now().Add(-time.Second)
+	_, err = bans.Refresh(ctx, "2")
+	require.NoError(t, err)
+	checker.banned = false
+	banned, err = bans.UserBanned(ctx, "2")
+	require.NoError(t, err)
+	assert.False(t, banned)
+
+	checker.err = errors.New("twitch unavailable")
+	_, err = bans.UserBanned(ctx, "3")
+	require.Error(t, err)
+
+	// shared by instances
+	store := cache.NewMemory(0)
+	NewTwitchBans(nil, store).Cache(ctx, "4", true, time.Time{})
+	banned, err = NewTwitchBans(checker, store).UserBanned(ctx, "4")
+	require.NoError(t, err)
+	assert.True(t, banned)
+
+	banned, err = NewTwitchBans(nil, store).UserBanned(ctx, "4")
+	require.NoError(t, err)
+	assert.False(t, banned, "bans are not checked without a checker")
+
+	var noBans *TwitchBans
+	banned, err = noBans.UserBanned(ctx, "1")
+	require.NoError(t, err)
+	assert.False(t, banned)
+}
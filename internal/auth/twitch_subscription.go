@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/client"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/utils/cache"
+)
+
+// TwitchSubscriptionTTL bounds the delay for new and expired subscriptions to apply.
+const TwitchSubscriptionTTL = 5 * time.Minute
+
+// TwitchSubscriptionChecker checks subscriptions to the broadcaster Twitch channel.
+type TwitchSubscriptionChecker interface {
+	UserSubscribed(ctx context.Context, twitchUserID string) (bool, error)
+}
+
+// SubscriptionRequiredError is returned when an action requires a subscription to the broadcaster Twitch channel.
+type SubscriptionRequiredError struct {
+	// Action describes what requires a subscription, e.g. "post in RANA".
+	Action string
+}
+
+// Error returns the SubscriptionRequiredError in string format.
+func (e *SubscriptionRequiredError) Error() string {
+	return fmt.Sprintf("a Twitch subscription to the channel is required to %s", e.Action)
+}
+
+// TwitchSubscriptions checks subscriptions to the broadcaster Twitch channel,
+// caching them in a store shared by instances.
+// A nil TwitchSubscriptions never reports subscriptions.
+type TwitchSubscriptions struct {
+	checker TwitchSubscriptionChecker
+	store   cache.Store
+}
+
+// NewTwitchSubscriptions returns a new TwitchSubscriptions.
+// Only cached subscriptions are reported without a checker.
+func NewTwitchSubscriptions(checker TwitchSubscriptionChecker, store cache.Store) *TwitchSubscriptions {
+	return &TwitchSubscriptions{
+		checker: checker,
+		store:   store,
+	}
+}
+
+func twitchSubscriptionCacheKey(twitchUserID string) string {
+	return "twitch-subscription:" + twitchUserID
+}
+
+// Cache caches a subscription status obtained elsewhere, e.g. with the user's own token.
+func (s *TwitchSubscriptions) Cache(ctx context.Context, twitchUserID string, subscribed bool) {
+	if s == nil {
+		return
+	}
+
+	if err := cache.SetJSON(ctx, s.store, twitchSubscriptionCacheKey(twitchUserID), subscribed, TwitchSubscriptionTTL); err != nil {
+		if l := internal.GetLoggerFromCtx(ctx); l != nil {
+			l.Warnf("Could not cache twitch subscription: %v", err)
+		}
+	}
+}
+
+// UserSubscribed reports whether a Twitch user is subscribed to the broadcaster channel.
+// Subscription status is cached up to TwitchSubscriptionTTL.
+func (s *TwitchSubscriptions) UserSubscribed(ctx context.Context, twitchUserID string) (bool, error) {
+	if s == nil {
+		return false, nil
+	}
+	if subscribed, ok, err := cache.GetJSON[bool](ctx, s.store, twitchSubscriptionCacheKey(twitchUserID)); err == nil && ok {
+		return subscribed, nil
+	}
+	if s.checker == nil {
+		return false, nil
+	}
+
+	subscribed, err := s.checker.UserSubscribed(ctx, twitchUserID)
+	if err != nil {
+		return false, fmt.Errorf("could not check twitch subscription: %w", err)
+	}
+
+	s.Cache(ctx, twitchUserID, subscribed)
+
+	return subscribed, nil
+}
+
+// IsSubscriber reports whether the user is subscribed to the broadcaster Twitch channel.
+// The broadcaster is always considered a subscriber. Failed checks are treated as not subscribed.
+func (s *TwitchSubscriptions) IsSubscriber(ctx context.Context, u *generated.User) bool {
+	if s == nil || u == nil {
+		return false
+	}
+
+	twitchUserID, err := TwitchUserID(ctx, u)
+	if err != nil || twitchUserID == "" {
+		return false
+	}
+	if twitchUserID == internal.Config.Twitch.BroadcasterID {
+		return true
+	}
+
+	subscribed, err := s.UserSubscribed(ctx, twitchUserID)
+	if err != nil {
+		// not retried until the cache expires
+		s.Cache(ctx, twitchUserID, false)
+		if l := internal.GetLoggerFromCtx(ctx); l != nil && !errors.Is(err, client.ErrBroadcasterTokenNotFound) {
+			l.Warnf("Ignoring twitch subscription of user %s: %v", u.ID, err)
+		}
+		return false
+	}
+
+	return subscribed
+}
+
+type ctxKeyTwitchSubscriptions struct{}
+
+// WithTwitchSubscriptions stores the Twitch subscription checks in the context.
+func WithTwitchSubscriptions(ctx context.Context, s *TwitchSubscriptions) context.Context {
+	return context.WithValue(ctx, ctxKeyTwitchSubscriptions{}, s)
+}
+
+// TwitchSubscriptionsFromCtx returns the Twitch subscription checks, or nil if there are none.
+func TwitchSubscriptionsFromCtx(ctx context.Context) *TwitchSubscriptions {
+	s, _ := ctx.Value(ctxKeyTwitchSubscriptions{}).(*TwitchSubscriptions)
+
+	return s
+}
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/caliecode/la-clipasa/internal/utils/cache"
+)
+
+type fakeSubscriptionChecker struct {
+	calls      int
+	subscribed bool
+	err        error
+}
+
+func (f *fakeSubscriptionChecker) UserSubscribed(ctx context.Context, twitchUserID string) (bool, error) {
+	f.calls++
+
+	return f.subscribed, f.err
+}
+
+func TestTwitchUserSubscribed(t *testing.T) {
+	ctx := context.Background()
+	checker := &fakeSubscriptionChecker{subscribed: true}
+	subscriptions := NewTwitchSubscriptions(checker, cache.NewMemory(0))
+
+	subscribed, err := subscriptions.UserSubscribed(ctx, "1")
+	require.NoError(t, err)
+	assert.True(t, subscribed)
+
+	checker.subscribed = false
+	subscribed, err = subscriptions.UserSubscribed(ctx, "1")
+	require.NoError(t, err)
+	assert.True(t, subscribed, "subscription status is cached")
+	assert.Equal(t, 1, checker.calls)
+
+	// shared with the twitch info obtained with the user token
+	subscriptions.Cache(ctx, "2", true)
+	subscribed, err = subscriptions.UserSubscribed(ctx, "2")
+	require.NoError(t, err)
+	assert.True(t, subscribed)
+	assert.Equal(t, 1, checker.calls)
+
+	checker.err = errors.New("twitch unavailable")
+	_, err = subscriptions.UserSubscribed(ctx, "3")
+	require.Error(t, err)
+
+	subscribed, err = NewTwitchSubscriptions(nil, cache.NewMemory(0)).UserSubscribed(ctx, "1")
+	require.NoError(t, err)
+	assert.False(t, subscribed, "subscriptions are not checked without a checker")
+}
@@ -0,0 +1,224 @@
+package awards
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/post"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/postcategory"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+)
+
+const backfillBatchSize = 100
+
+// Evaluator grants awards to users whose activity satisfies an award definition rule.
+// Granted awards are never revoked.
+type Evaluator struct {
+	entc *generated.Client
+}
+
+// NewEvaluator returns a new awards evaluator.
+func NewEvaluator(entc *generated.Client) *Evaluator {
+	return &Evaluator{
+		entc: entc,
+	}
+}
+
+// userStats represents the user activity evaluated by award rules.
+type userStats struct {
+	publishedPosts  int
+	postsByCategory map[postcategory.Category]int
+	likesReceived   int
+	membershipDays  int
+}
+
+// meets returns whether the stats satisfy the award definition rule.
+func (s userStats) meets(def *generated.AwardDefinition) bool {
+	switch def.Rule {
+	case awarddefinition.RulePOSTS_PUBLISHED:
+		return s.publishedPosts >= def.RuleThreshold
+	case awarddefinition.RulePOSTS_IN_CATEGORY:
+		if def.RuleCategory == nil {
+			return false
+		}
+		return s.postsByCategory[postcategory.Category(*def.RuleCategory)] >= def.RuleThreshold
+	case awarddefinition.RuleLIKES_RECEIVED:
+		return s.likesReceived >= def.RuleThreshold
+	case awarddefinition.RuleMEMBERSHIP_DAYS:
+		return s.membershipDays >= def.RuleThreshold
+	default:
+		return false
+	}
+}
+
+// EvaluateUsers grants pending awards to the given users.
+// It is idempotent, already granted awards are skipped.
+func (e *Evaluator) EvaluateUsers(ctx context.Context, userIDs ...uuid.UUID) error {
+	ctx = token.NewContextWithSystemCallToken(ctx)
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	defs, err := e.entc.AwardDefinition.Query().All(ctx)
+	if err != nil {
+		return fmt.Errorf("could not query award definitions: %w", err)
+	}
+	if len(defs) == 0 {
+		return nil
+	}
+
+	for _, userID := range userIDs {
+		if err := e.evaluateUser(ctx, userID, defs); err != nil {
+			return fmt.Errorf("user %s: %w", userID, err)
+		}
+	}
+
+	return nil
+}
+
+// Backfill evaluates awards for all users.
+// Required for time-based rules and for newly created award definitions.
+func (e *Evaluator) Backfill(ctx context.Context) {
+	e.entc.Logger.Info("Backfilling user awards")
+
+	ctx = token.NewContextWithSystemCallToken(ctx)
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	var lastID uuid.UUID
+	for {
+		ids, err := e.entc.User.Query().
+			Where(user.IDGT(lastID)).
+			Order(user.ByID()).
+			Limit(backfillBatchSize).
+			IDs(ctx)
+		if err != nil {
+			e.entc.Logger.Errorf("Error backfilling user awards: %v", err)
+			return
+		}
+		if len(ids) == 0 {
+			return
+		}
+
+		if err := e.EvaluateUsers(ctx, ids...); err != nil {
+			e.entc.Logger.Errorf("Error backfilling user awards: %v", err)
+			return
+		}
+
+		lastID = ids[len(ids)-1]
+	}
+}
+
+func (e *Evaluator) evaluateUser(ctx context.Context, userID uuid.UUID, defs []*generated.AwardDefinition) error {
+	granted, err := e.entc.UserAward.Query().
+		Where(useraward.OwnerID(userID)).
+		Select(useraward.FieldAwardID).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("could not query granted awards: %w", err)
+	}
+
+	grantedAwards := make(map[uuid.UUID]bool, len(granted))
+	for _, ua := range granted {
+		grantedAwards[ua.AwardID] = true
+	}
+
+	pending := make([]*generated.AwardDefinition, 0, len(defs))
+	for _, def := range defs {
+		if !grantedAwards[def.ID] {
+			pending = append(pending, def)
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	stats, err := e.userStats(ctx, userID)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			// deleted users are not granted awards
+			return nil
+		}
+		return err
+	}
+
+	now := time.Now()
+	for _, def := range pending {
+		if !stats.meets(def) {
+			continue
+		}
+
+		// awards may be granted concurrently by another evaluation. Conflicts must not be
+		// constraint errors, which would abort the transaction of the evaluated mutation
+		err := e.entc.UserAward.Create().
+			SetOwnerID(userID).
+			SetAwardID(def.ID).
+			SetGrantedAt(now).
+			OnConflictColumns(useraward.FieldOwnerID, useraward.FieldAwardID).
+			DoNothing().
+			Exec(ctx)
+		// no id is returned when already granted
+		if err != nil && !errors.Is(err, stdsql.ErrNoRows) {
+			return fmt.Errorf("could not grant award %q: %w", def.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (e *Evaluator) userStats(ctx context.Context, userID uuid.UUID) (userStats, error) {
+	stats := userStats{
+		postsByCategory: make(map[postcategory.Category]int),
+	}
+
+	u, err := e.entc.User.Get(ctx, userID)
+	if err != nil {
+		return stats, err
+	}
+	stats.membershipDays = int(time.Since(u.CreatedAt).Hours() / 24)
+
+	stats.publishedPosts, err = e.entc.Post.Query().
+		Where(post.OwnerID(userID)).
+		Count(ctx)
+	if err != nil {
+		return stats, fmt.Errorf("could not count published posts: %w", err)
+	}
+
+	var categoryCounts []struct {
+		Category postcategory.Category `json:"category"`
+		Count    int                   `json:"count"`
+	}
+	err = e.entc.PostCategory.Query().
+		Where(postcategory.HasPostWith(post.OwnerID(userID), post.DeletedAtIsNil())).
+		GroupBy(postcategory.FieldCategory).
+		Aggregate(generated.Count()).
+		Scan(ctx, &categoryCounts)
+	if err != nil {
+		return stats, fmt.Errorf("could not count posts by category: %w", err)
+	}
+	for _, cc := range categoryCounts {
+		stats.postsByCategory[cc.Category] = cc.Count
+	}
+
+	stats.likesReceived, err = e.entc.Post.Query().
+		Where(post.OwnerID(userID)).
+		Modify(func(s *sql.Selector) {
+			t := sql.Table(user.LikedPostsTable)
+			s.Join(t).On(s.C(post.FieldID), t.C(user.LikedPostsPrimaryKey[1]))
+			s.Select(sql.Count("*"))
+		}).
+		Int(ctx)
+	if err != nil {
+		return stats, fmt.Errorf("could not count received likes: %w", err)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,95 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/caliecode/la-clipasa/internal/models"
+)
+
+// StreamsParams filters the streams returned by Streams.
+type StreamsParams struct {
+	UserIDs []string
+	// Type is either all or live. Defaults to all.
+	Type string
+}
+
+// Streams returns the streams of the given users, only including channels that are live.
+func (c *Client) Streams(ctx context.Context, params StreamsParams) ([]models.TwitchStream, error) {
+	q := url.Values{"user_id": params.UserIDs}
+	if params.Type != "" {
+		q.Set("type", params.Type)
+	}
+
+	var result models.TwitchStreamResponse
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/streams", params: q}, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// ClipsParams filters the clips returned by Clips.
+// Either IDs or BroadcasterID is required.
+type ClipsParams struct {
+	IDs           []string
+	BroadcasterID string
+	// StartedAt only returns clips created since, if set.
+	StartedAt time.Time
+	// First is the maximum number of clips, up to 100.
+	First int
+}
+
+// Clips returns clips by id, or the most viewed clips of a broadcaster.
+func (c *Client) Clips(ctx context.Context, params ClipsParams) ([]models.TwitchClip, error) {
+	q := url.Values{"id": params.IDs}
+	if params.BroadcasterID != "" {
+		q.Set("broadcaster_id", params.BroadcasterID)
+	}
+	if !params.StartedAt.IsZero() {
+		q.Set("started_at", params.StartedAt.UTC().Format(time.RFC3339))
+	}
+	if params.First > 0 {
+		q.Set("first", strconv.Itoa(min(params.First, maxPageSize)))
+	}
+
+	p, err := getPage[models.TwitchClip](ctx, c, "/clips", q, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Data, nil
+}
+
+// Moderators returns all moderators of a channel.
+// Requires a token of the broadcaster with the moderation:read scope.
+func (c *Client) Moderators(ctx context.Context, broadcasterID string) ([]models.TwitchChannelUser, error) {
+	return getAll[models.TwitchChannelUser](ctx, c, "/moderation/moderators", url.Values{"broadcaster_id": {broadcasterID}}, maxPageSize)
+}
+
+// VIPs returns all VIPs of a channel.
+// Requires a token of the broadcaster with the channel:read:vips scope.
+func (c *Client) VIPs(ctx context.Context, broadcasterID string) ([]models.TwitchChannelUser, error) {
+	return getAll[models.TwitchChannelUser](ctx, c, "/channels/vips", url.Values{"broadcaster_id": {broadcasterID}}, maxPageSize)
+}
+
+// BannedUsers returns the bans and timeouts of the given users in a channel, or all of them if none.
+// Requires a token of the broadcaster with the moderation:read scope.
+func (c *Client) BannedUsers(ctx context.Context, broadcasterID string, userIDs ...string) ([]models.BanData, error) {
+	return getAll[models.BanData](ctx, c, "/moderation/banned", url.Values{
+		"broadcaster_id": {broadcasterID},
+		"user_id":        userIDs,
+	}, maxPageSize)
+}
+
+// BroadcasterSubscriptions returns the subscriptions of the given users to a channel, or all of them if none.
+// Requires a token of the broadcaster with the channel:read:subscriptions scope.
+func (c *Client) BroadcasterSubscriptions(ctx context.Context, broadcasterID string, userIDs ...string) ([]models.TwitchUserSubscription, error) {
+	return getAll[models.TwitchUserSubscription](ctx, c, "/subscriptions", url.Values{
+		"broadcaster_id": {broadcasterID},
+		"user_id":        userIDs,
+	}, maxPageSize)
+}
@@ -0,0 +1,36 @@
+package helix
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/caliecode/la-clipasa/internal/models"
+)
+
+const eventSubPath = "/eventsub/subscriptions"
+
+// EventSubSubscriptions returns all EventSub subscriptions of the app.
+// Requires an app access token for webhook subscriptions.
+func (c *Client) EventSubSubscriptions(ctx context.Context) ([]models.TwitchEventSubSubscription, error) {
+	return getAll[models.TwitchEventSubSubscription](ctx, c, eventSubPath, nil, 0)
+}
+
+// CreateEventSubSubscription creates an EventSub subscription and returns it.
+func (c *Client) CreateEventSubSubscription(ctx context.Context, sub models.TwitchEventSubSubscription) (models.TwitchEventSubSubscription, error) {
+	var result models.TwitchEventSubSubscriptionsResponse
+	if err := c.do(ctx, request{method: http.MethodPost, path: eventSubPath, body: sub}, &result); err != nil {
+		return models.TwitchEventSubSubscription{}, err
+	}
+	if len(result.Data) == 0 {
+		return models.TwitchEventSubSubscription{}, errors.New("twitch eventsub: empty subscription response")
+	}
+
+	return result.Data[0], nil
+}
+
+// DeleteEventSubSubscription deletes an EventSub subscription.
+func (c *Client) DeleteEventSubSubscription(ctx context.Context, id string) error {
+	return c.do(ctx, request{method: http.MethodDelete, path: eventSubPath, params: url.Values{"id": {id}}}, nil)
+}
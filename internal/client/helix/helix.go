@@ -0,0 +1,287 @@
+// Package helix implements a client of the Twitch Helix API with typed endpoints,
+// cursor pagination, rate limit aware throttling and retries.
+//
+// see https://dev.twitch.tv/docs/api/reference/
+package helix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// DefaultBaseURL is the base URL of the Helix API.
+const DefaultBaseURL = "https://api.twitch.tv/helix"
+
+const (
+	defaultTimeout    = 10 * time.Second
+	defaultMaxRetries = 2
+	defaultBackoff    = 250 * time.Millisecond
+	// maxBackoff caps the exponential backoff between retries.
+	maxBackoff = 5 * time.Second
+)
+
+// TokenSource provides the access tokens requests are authorized with.
+type TokenSource interface {
+	// Token returns a valid access token.
+	Token(ctx context.Context) (string, error)
+	// Refresh returns a new access token after Twitch rejected the given one,
+	// unless it was already replaced.
+	Refresh(ctx context.Context, rejected string) (string, error)
+}
+
+// Error is returned for responses with an unexpected status code.
+type Error struct {
+	Method     string
+	Path       string
+	StatusCode int
+	// Message is the error message returned by Twitch, if any.
+	Message string
+}
+
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("twitch %s %s: unexpected status code: %d", e.Method, e.Path, e.StatusCode)
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+
+	return msg
+}
+
+// IsStatus reports whether err is an Error with the given status code.
+func IsStatus(err error, statusCode int) bool {
+	var herr *Error
+
+	return errors.As(err, &herr) && herr.StatusCode == statusCode
+}
+
+// Client calls Helix endpoints with the tokens of a TokenSource.
+// It is safe for concurrent use.
+type Client struct {
+	baseURL    string
+	clientID   string
+	httpClient *http.Client
+	tokens     TokenSource
+	limiter    *RateLimiter
+	maxRetries int
+	backoff    time.Duration
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithBaseURL sets the base URL of the API, e.g. of a test server.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = baseURL
+	}
+}
+
+// WithHTTPClient sets the HTTP client requests are sent with.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithRetries sets the maximum number of retries of failed requests
+// and the initial backoff between them, doubled on each retry.
+func WithRetries(maxRetries int, backoff time.Duration) Option {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+		c.backoff = backoff
+	}
+}
+
+// WithRateLimiter sets the limiter tracking the rate limit bucket of the tokens,
+// shared with other clients using the same bucket, e.g. clients created per request for a user.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(c *Client) {
+		c.limiter = limiter
+	}
+}
+
+// New returns a client for the app clientID authorized with tokens.
+func New(clientID string, tokens TokenSource, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    DefaultBaseURL,
+		clientID:   clientID,
+		httpClient: &http.Client{Timeout: defaultTimeout},
+		tokens:     tokens,
+		limiter:    &RateLimiter{},
+		maxRetries: defaultMaxRetries,
+		backoff:    defaultBackoff,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// request is a call to a Helix endpoint.
+type request struct {
+	method string
+	path   string
+	params url.Values
+	body   any
+}
+
+// idempotent reports whether the request can be retried after it may have been processed.
+func (r request) idempotent() bool {
+	return r.method == http.MethodGet || r.method == http.MethodDelete
+}
+
+// do calls a Helix endpoint and decodes the response into result, if not nil.
+// Requests wait for the rate limit to reset when exhausted, are retried once with
+// a refreshed token if unauthorized and retried with backoff on rate limit,
+// server and network errors, the latter only for idempotent requests.
+func (c *Client) do(ctx context.Context, r request, result any) error {
+	reqURL := c.baseURL + r.path
+	if len(r.params) > 0 {
+		reqURL += "?" + r.params.Encode()
+	}
+
+	var body []byte
+	if r.body != nil {
+		b, err := json.Marshal(r.body)
+		if err != nil {
+			return fmt.Errorf("could not marshal request body: %w", err)
+		}
+		body = b
+	}
+
+	accessToken, err := c.tokens.Token(ctx)
+	if err != nil {
+		return err
+	}
+
+	refreshed := false
+	for retries := 0; ; {
+		if err := c.limiter.wait(ctx); err != nil {
+			return err
+		}
+
+		resp, err := c.send(ctx, r.method, reqURL, body, accessToken)
+		if err != nil {
+			if ctx.Err() != nil || !r.idempotent() || retries >= c.maxRetries {
+				return fmt.Errorf("twitch request failed: %w", err)
+			}
+			if err := sleep(ctx, c.backoffDelay(retries)); err != nil {
+				return err
+			}
+			retries++
+
+			continue
+		}
+		c.limiter.update(resp, c.backoffDelay(retries))
+
+		switch {
+		case resp.StatusCode == http.StatusUnauthorized && !refreshed:
+			drain(resp)
+			refreshed = true
+			if accessToken, err = c.tokens.Refresh(ctx, accessToken); err != nil {
+				return fmt.Errorf("could not refresh twitch token: %w", err)
+			}
+
+			continue
+		case resp.StatusCode == http.StatusTooManyRequests && retries < c.maxRetries:
+			// the limiter waits for the reset before the retry
+			drain(resp)
+			retries++
+
+			continue
+		case resp.StatusCode >= http.StatusInternalServerError && r.idempotent() && retries < c.maxRetries:
+			drain(resp)
+			if err := sleep(ctx, c.backoffDelay(retries)); err != nil {
+				return err
+			}
+			retries++
+
+			continue
+		}
+
+		return decodeResponse(resp, r, result)
+	}
+}
+
+func (c *Client) send(ctx context.Context, method, reqURL string, body []byte, accessToken string) (*http.Response, error) {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Client-Id", c.clientID)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return c.httpClient.Do(req)
+}
+
+// backoffDelay returns the exponential backoff before a retry, with jitter.
+func (c *Client) backoffDelay(retries int) time.Duration {
+	d := min(c.backoff<<retries, maxBackoff)
+	if d <= 0 {
+		return 0
+	}
+
+	return d/2 + rand.N(d/2+1) // nolint: gosec // jitter
+}
+
+func decodeResponse(resp *http.Response, r request, result any) error {
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var twitchErr struct {
+			Message string `json:"message"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&twitchErr)
+
+		return &Error{Method: r.method, Path: r.path, StatusCode: resp.StatusCode, Message: twitchErr.Message}
+	}
+
+	if result == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode twitch %s response: %w", r.path, err)
+	}
+
+	return nil
+}
+
+// drain discards the response so that the connection can be reused.
+func drain(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	resp.Body.Close()
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,261 @@
+package helix
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/caliecode/la-clipasa/internal/models"
+)
+
+type testTokenSource struct {
+	mu        sync.Mutex
+	token     int
+	refreshed int
+}
+
+func (s *testTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return "token-" + strconv.Itoa(s.token), nil
+}
+
+func (s *testTokenSource) Refresh(ctx context.Context, rejected string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token++
+	s.refreshed++
+
+	return "token-" + strconv.Itoa(s.token), nil
+}
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *testTokenSource) {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	tokens := &testTokenSource{}
+
+	return New("client-id", tokens, WithBaseURL(srv.URL), WithRetries(2, time.Millisecond)), tokens
+}
+
+func TestClientPagination(t *testing.T) {
+	t.Parallel()
+
+	pages := map[string]page[models.TwitchChannelUser]{
+		"":   {Data: []models.TwitchChannelUser{{UserID: "1"}, {UserID: "2"}}, Pagination: models.TwitchPagination{Cursor: "c1"}},
+		"c1": {Data: []models.TwitchChannelUser{{UserID: "3"}}, Pagination: models.TwitchPagination{Cursor: "c2"}},
+		"c2": {Data: []models.TwitchChannelUser{{UserID: "4"}}},
+	}
+
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/moderation/moderators", r.URL.Path)
+		assert.Equal(t, "Bearer token-0", r.Header.Get("Authorization"))
+		assert.Equal(t, "client-id", r.Header.Get("Client-Id"))
+		assert.Equal(t, "52341091", r.URL.Query().Get("broadcaster_id"))
+		assert.Equal(t, "100", r.URL.Query().Get("first"))
+
+		_ = json.NewEncoder(w).Encode(pages[r.URL.Query().Get("after")])
+	})
+
+	mods, err := c.Moderators(context.Background(), "52341091")
+	require.NoError(t, err)
+
+	var ids []string
+	for _, m := range mods {
+		ids = append(ids, m.UserID)
+	}
+	assert.Equal(t, []string{"1", "2", "3", "4"}, ids)
+}
+
+func TestClientPaginationCursorNotAdvanced(t *testing.T) {
+	t.Parallel()
+
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(page[models.TwitchChannelUser]{
+			Data:       []models.TwitchChannelUser{{UserID: "1"}},
+			Pagination: models.TwitchPagination{Cursor: "same"},
+		})
+	})
+
+	_, err := c.VIPs(context.Background(), "52341091")
+	require.ErrorIs(t, err, ErrCursorNotAdvanced)
+}
+
+func TestClientRetries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("server errors of idempotent requests", func(t *testing.T) {
+		t.Parallel()
+
+		var requests atomic.Int32
+		c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if requests.Add(1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(models.TwitchStreamResponse{Data: []models.TwitchStream{{Title: "jugando"}}})
+		})
+
+		streams, err := c.Streams(context.Background(), StreamsParams{UserIDs: []string{"52341091"}})
+		require.NoError(t, err)
+		require.Len(t, streams, 1)
+		assert.Equal(t, "jugando", streams[0].Title)
+		assert.EqualValues(t, 3, requests.Load())
+	})
+
+	t.Run("retries are limited", func(t *testing.T) {
+		t.Parallel()
+
+		var requests atomic.Int32
+		c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			w.WriteHeader(http.StatusBadGateway)
+		})
+
+		_, err := c.Streams(context.Background(), StreamsParams{})
+		require.Error(t, err)
+		assert.True(t, IsStatus(err, http.StatusBadGateway))
+		assert.EqualValues(t, 3, requests.Load())
+	})
+
+	t.Run("server errors of non-idempotent requests", func(t *testing.T) {
+		t.Parallel()
+
+		var requests atomic.Int32
+		c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"error":"Internal Server Error","status":500,"message":"oops"}`))
+		})
+
+		_, err := c.CreateEventSubSubscription(context.Background(), models.TwitchEventSubSubscription{Type: "stream.online"})
+		require.ErrorContains(t, err, "oops")
+		assert.True(t, IsStatus(err, http.StatusInternalServerError))
+		assert.EqualValues(t, 1, requests.Load(), "may have been processed")
+	})
+}
+
+func TestClientRateLimit(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Ratelimit-Reset", strconv.FormatInt(time.Now().Unix(), 10))
+		if requests.Add(1) == 1 {
+			w.Header().Set("Ratelimit-Remaining", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Ratelimit-Remaining", "799")
+		_ = json.NewEncoder(w).Encode(models.TwitchUserResponse{Data: []models.TwitchUser{{ID: "1"}}})
+	})
+
+	users, err := c.Users(context.Background())
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.EqualValues(t, 2, requests.Load(), "rate limited requests are retried after the reset")
+}
+
+func TestClientSharedRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Ratelimit-Remaining", "0")
+		w.Header().Set("Ratelimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+		_ = json.NewEncoder(w).Encode(models.TwitchUserResponse{Data: []models.TwitchUser{{ID: "1"}}})
+	}))
+	t.Cleanup(srv.Close)
+
+	limiter := &RateLimiter{}
+	_, err := New("client-id", &testTokenSource{}, WithBaseURL(srv.URL), WithRateLimiter(limiter)).Users(context.Background())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = New("client-id", &testTokenSource{}, WithBaseURL(srv.URL), WithRateLimiter(limiter)).Users(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded, "clients sharing a limiter wait for the exhausted bucket")
+	assert.EqualValues(t, 1, requests.Load())
+}
+
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	l := &RateLimiter{}
+	require.NoError(t, l.wait(context.Background()), "unknown buckets do not wait")
+
+	resp := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	resp.Header.Set("Ratelimit-Remaining", "1")
+	resp.Header.Set("Ratelimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+	l.update(resp, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	require.NoError(t, l.wait(ctx), "a point remains")
+	require.ErrorIs(t, l.wait(ctx), context.DeadlineExceeded, "exhausted buckets wait for the reset")
+
+	l.update(&http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}, time.Millisecond)
+	time.Sleep(2 * time.Millisecond)
+	require.NoError(t, l.wait(context.Background()), "rate limited responses without headers wait for the fallback")
+}
+
+func TestClientRefresh(t *testing.T) {
+	t.Parallel()
+
+	t.Run("refreshes rejected tokens once", func(t *testing.T) {
+		t.Parallel()
+
+		c, tokens := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer token-1" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+		require.NoError(t, c.DeleteEventSubSubscription(context.Background(), "sub-id"))
+		assert.Equal(t, 1, tokens.refreshed)
+	})
+
+	t.Run("refreshed tokens rejected", func(t *testing.T) {
+		t.Parallel()
+
+		c, tokens := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		})
+
+		_, err := c.Users(context.Background())
+		assert.True(t, IsStatus(err, http.StatusUnauthorized))
+		assert.Equal(t, 1, tokens.refreshed)
+	})
+}
+
+func TestClientUserSubscriptionNotFound(t *testing.T) {
+	t.Parallel()
+
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/subscriptions/user", r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	sub, err := c.UserSubscription(context.Background(), "52341091", "1")
+	require.NoError(t, err)
+	assert.Nil(t, sub)
+}
@@ -0,0 +1,69 @@
+package helix
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/caliecode/la-clipasa/internal/models"
+)
+
+// maxPageSize is the maximum page size of paginated endpoints.
+const maxPageSize = 100
+
+// ErrCursorNotAdvanced is returned when a paginated endpoint returns the same cursor again.
+var ErrCursorNotAdvanced = errors.New("twitch pagination cursor did not advance")
+
+// page is a page of a paginated Helix response.
+type page[T any] struct {
+	Data       []T                     `json:"data"`
+	Pagination models.TwitchPagination `json:"pagination"`
+}
+
+// getPage requests a single page of an endpoint, starting after cursor if set.
+func getPage[T any](ctx context.Context, c *Client, path string, params url.Values, cursor string) (page[T], error) {
+	q := url.Values{}
+	for k, v := range params {
+		q[k] = v
+	}
+	if cursor != "" {
+		q.Set("after", cursor)
+	}
+
+	var p page[T]
+	err := c.do(ctx, request{method: http.MethodGet, path: path, params: q}, &p)
+
+	return p, err
+}
+
+// getAll requests all pages of a paginated endpoint.
+// pageSize is the number of items per page, or zero for endpoints with a fixed page size.
+func getAll[T any](ctx context.Context, c *Client, path string, params url.Values, pageSize int) ([]T, error) {
+	q := url.Values{}
+	for k, v := range params {
+		q[k] = v
+	}
+	if pageSize > 0 {
+		q.Set("first", strconv.Itoa(pageSize))
+	}
+
+	var all []T
+	cursor := ""
+	for {
+		p, err := getPage[T](ctx, c, path, q, cursor)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, p.Data...)
+
+		if p.Pagination.Cursor == "" {
+			return all, nil
+		}
+		if p.Pagination.Cursor == cursor {
+			return nil, ErrCursorNotAdvanced
+		}
+		cursor = p.Pagination.Cursor
+	}
+}
@@ -0,0 +1,70 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// maxRateLimitWait caps waits for a rate limit reset, since buckets refill within a minute
+// and reset times may be skewed.
+const maxRateLimitWait = time.Minute
+
+// RateLimiter throttles requests once the token bucket reported by Twitch is exhausted.
+// Buckets are per client ID for app tokens and per user for user tokens,
+// so clients using the same bucket should share a RateLimiter.
+// The zero value is ready to use.
+//
+// see https://dev.twitch.tv/docs/api/guide/#twitch-rate-limits
+type RateLimiter struct {
+	mu sync.Mutex
+	// known is set once a response reported the bucket state.
+	known     bool
+	remaining int
+	reset     time.Time
+}
+
+// wait blocks until a request can be sent without exceeding the rate limit,
+// reserving a point of the bucket.
+func (l *RateLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	var d time.Duration
+	if l.known {
+		if l.remaining <= 0 {
+			d = min(time.Until(l.reset), maxRateLimitWait)
+			if d <= 0 {
+				// refilled, until the next response reports the new state
+				l.known = false
+			}
+		} else {
+			// concurrent requests must not exceed the remaining points either
+			l.remaining--
+		}
+	}
+	l.mu.Unlock()
+
+	return sleep(ctx, d)
+}
+
+// update records the bucket state reported by a response.
+// Rate limited responses without the headers wait for fallback.
+func (l *RateLimiter) update(resp *http.Response, fallback time.Duration) {
+	remaining, errRemaining := strconv.Atoi(resp.Header.Get("Ratelimit-Remaining"))
+	reset, errReset := strconv.ParseInt(resp.Header.Get("Ratelimit-Reset"), 10, 64)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	switch {
+	case errRemaining == nil && errReset == nil:
+		l.known = true
+		l.remaining = remaining
+		l.reset = time.Unix(reset, 0)
+	case resp.StatusCode == http.StatusTooManyRequests:
+		l.known = true
+		l.remaining = 0
+		l.reset = time.Now().Add(fallback)
+	}
+}
@@ -0,0 +1,63 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/caliecode/la-clipasa/internal/models"
+)
+
+// Users returns the users with the given ids, or the user of the token if none.
+func (c *Client) Users(ctx context.Context, ids ...string) ([]models.TwitchUser, error) {
+	var result models.TwitchUserResponse
+	err := c.do(ctx, request{method: http.MethodGet, path: "/users", params: url.Values{"id": ids}}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// UserSubscription returns the subscription of a user to a channel, or nil if not subscribed.
+// Requires a token of the user with the user:read:subscriptions scope.
+func (c *Client) UserSubscription(ctx context.Context, broadcasterID, userID string) (*models.TwitchUserSubscription, error) {
+	params := url.Values{
+		"broadcaster_id": {broadcasterID},
+		"user_id":        {userID},
+	}
+
+	var result models.TwitchUserSubscriptionResponse
+	err := c.do(ctx, request{method: http.MethodGet, path: "/subscriptions/user", params: params}, &result)
+	if err != nil {
+		if IsStatus(err, http.StatusNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(result.Data) == 0 {
+		return nil, nil
+	}
+
+	return &result.Data[0], nil
+}
+
+// FollowedChannel returns the follow of a user to a channel, or nil if not following.
+// Requires a token of the user with the user:read:follows scope.
+func (c *Client) FollowedChannel(ctx context.Context, userID, broadcasterID string) (*models.TwitchUserFollow, error) {
+	params := url.Values{
+		"user_id":        {userID},
+		"broadcaster_id": {broadcasterID},
+	}
+
+	var result models.TwitchUserFollowResponse
+	err := c.do(ctx, request{method: http.MethodGet, path: "/channels/followed", params: params}, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Data) == 0 {
+		return nil, nil
+	}
+
+	return &result.Data[0], nil
+}
@@ -0,0 +1,17 @@
+package client
+
+import (
+	"os"
+	"testing"
+
+	"github.com/caliecode/la-clipasa/internal"
+)
+
+func TestMain(m *testing.M) {
+	internal.Config = &internal.AppConfig{}
+	internal.Config.TwitchOIDC.ClientID = "client-id"
+	internal.Config.TwitchOIDC.ClientSecret = "client-secret"
+	internal.Config.Twitch.BroadcasterID = "52341091"
+
+	os.Exit(m.Run())
+}
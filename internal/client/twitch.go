@@ -1,281 +1,325 @@
 package client
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
-	"net/url"
-	"strings"
+	"slices"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	lru "github.com/hashicorp/golang-lru/v2"
 
 	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/client/helix"
 	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
 	"github.com/caliecode/la-clipasa/internal/http/httputil"
 	"github.com/caliecode/la-clipasa/internal/models"
 )
 
 const (
-	twitchAPIBase     = "https://api.twitch.tv/helix"
 	twitchValidateURL = "https://id.twitch.tv/oauth2/validate"
 	twitchRefreshURL  = "https://id.twitch.tv/oauth2/token"
-	maxRetries        = 2
 )
 
+// userRateLimitersSize bounds the Twitch users whose rate limits are tracked.
+const userRateLimitersSize = 1000
+
 type TwitchHandlers struct {
-	client *generated.Client
+	client     *generated.Client
+	httpClient *http.Client
+	helixOpts  []helix.Option
+	// userLimiters are the rate limiters of user clients by Twitch user id.
+	userLimiters *lru.Cache[string, *helix.RateLimiter]
+	users        *UserTokenSource
+	app          *TwitchAppClient
+	broadcaster  *TwitchBroadcasterClient
 }
 
-func NewTwitchHandlers(client *generated.Client) *TwitchHandlers {
+// NewTwitchHandlers returns new TwitchHandlers. Token sources and clients should be shared
+// by the whole app, so that their tokens and rate limits are. Options apply to user Helix clients.
+func NewTwitchHandlers(client *generated.Client, users *UserTokenSource, app *TwitchAppClient, broadcaster *TwitchBroadcasterClient, opts ...helix.Option) *TwitchHandlers {
+	userLimiters, _ := lru.New[string, *helix.RateLimiter](userRateLimitersSize)
+
 	return &TwitchHandlers{
-		client: client,
+		client:       client,
+		httpClient:   &http.Client{Timeout: 10 * time.Second},
+		helixOpts:    opts,
+		userLimiters: userLimiters,
+		users:        users,
+		app:          app,
+		broadcaster:  broadcaster,
 	}
 }
 
-func (h *TwitchHandlers) getTwitchToken(c *gin.Context) (*models.TwitchTokenInfo, error) {
-	tokenJSON, exists := c.Get("twitch_auth_info")
-	if !exists {
-		// for calls not part of oidc flow
-		cookieVal, err := c.Cookie(internal.Config.Twitch.AuthInfoCookieKey)
-		if err != nil {
-			httputil.SignOutUser(c, *h.client) // if unset then we cannot refresh twitch tokens, etc. so must do oidc flow
-			return nil, fmt.Errorf("failed to get twitch token cookie: %w", err)
-		}
-		tokenJSON, err = base64.URLEncoding.DecodeString(cookieVal)
-		if err != nil {
-			httputil.SignOutUser(c, *h.client) // migrated cookie structure, etc
-			return nil, fmt.Errorf("failed to decode twitch token cookie: %w", err)
+// userClient returns a Helix client authorized with the Twitch tokens of the requesting user.
+// Clients are created per request with the tokens of the request, sharing the rate limiter
+// of the Twitch user since rate limits of user tokens are per user.
+func (h *TwitchHandlers) userClient(c *gin.Context) (*helix.Client, error) {
+	tokenInfo, refresh, err := h.userToken(c)
+	if err != nil {
+		if errors.Is(err, ErrTwitchTokenNotFound) {
+			httputil.SignOutUser(c, *h.client) // must log in with twitch again
 		}
+		return nil, fmt.Errorf("could not get twitch token: %w", err)
 	}
-	var twitchTokenInfo models.TwitchTokenInfo
-	if err := json.Unmarshal(tokenJSON.([]byte), &twitchTokenInfo); err != nil {
-		return nil, fmt.Errorf("could not unmarshal twitch token: %w", err)
+
+	tokens := &userTokens{
+		accessToken: tokenInfo.AccessToken,
+		refresh: func(rejected string) (string, error) {
+			tokenInfo, err := refresh(rejected)
+			if err != nil {
+				httputil.SignOutUser(c, *h.client)
+				return "", err
+			}
+
+			return tokenInfo.AccessToken, nil
+		},
 	}
-	return &twitchTokenInfo, nil
-}
 
-// see https://dev.twitch.tv/docs/authentication/refresh-tokens/
-func (h *TwitchHandlers) refreshTwitchToken(c *gin.Context, tokenInfo *models.TwitchTokenInfo) (*models.TwitchTokenInfo, error) {
-	logger := internal.GetLoggerFromCtx(c.Request.Context())
+	opts := h.helixOpts
+	if twitchUserID, err := h.twitchUserID(c.Request.Context()); err == nil {
+		opts = append(slices.Clip(opts), helix.WithRateLimiter(h.userRateLimiter(twitchUserID)))
+	}
 
-	form := url.Values{}
-	form.Set("grant_type", "refresh_token")
-	form.Set("refresh_token", tokenInfo.RefreshToken)
-	form.Set("client_id", internal.Config.TwitchOIDC.ClientID)
-	form.Set("client_secret", internal.Config.TwitchOIDC.ClientSecret)
+	return helix.New(internal.Config.TwitchOIDC.ClientID, tokens, opts...), nil
+}
 
-	req, err := http.NewRequest(http.MethodPost, twitchRefreshURL, strings.NewReader(form.Encode()))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create twitch token request: %w", err)
+// userRateLimiter returns the rate limiter of a Twitch user.
+func (h *TwitchHandlers) userRateLimiter(twitchUserID string) *helix.RateLimiter {
+	if l, ok := h.userLimiters.Get(twitchUserID); ok {
+		return l
+	}
+	l := &helix.RateLimiter{}
+	if prev, ok, _ := h.userLimiters.PeekOrAdd(twitchUserID, l); ok {
+		return prev
 	}
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
-	resp, err := http.DefaultClient.Do(req)
+	return l
+}
+
+// userRequest calls Helix with the Twitch tokens of the requesting user,
+// signing them out if the tokens are rejected after a refresh.
+func (h *TwitchHandlers) userRequest(c *gin.Context, fn func(hc *helix.Client) error) error {
+	hc, err := h.userClient(c)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	err = fn(hc)
+	if helix.IsStatus(err, http.StatusUnauthorized) {
+		httputil.SignOutUser(c, *h.client)
 	}
 
-	var newToken models.TwitchTokenInfo
-	if err := json.NewDecoder(resp.Body).Decode(&newToken); err != nil {
-		return nil, fmt.Errorf("failed to decode twitch token response: %w", err)
-	}
+	return err
+}
 
-	tokenJSON, err := json.Marshal(newToken)
-	if err == nil {
-		httputil.SetTwitchAuthCookie(c, base64.URLEncoding.EncodeToString(tokenJSON))
-	} else {
-		logger.Warnf("failed to marshal twitch token: %v", err)
-	}
-	return &newToken, nil
+// userTokens adapts the tokens of a user request to helix.TokenSource.
+type userTokens struct {
+	accessToken string
+	refresh     func(rejected string) (string, error)
 }
 
-// ValidateTwitchToken validates the Twitch token without attempting refresh on failure.
-func (h *TwitchHandlers) ValidateTwitchToken(c *gin.Context) (*models.TwitchTokenValidateResponse, error) {
-	tokenInfo, err := h.getTwitchToken(c)
-	if err != nil {
-		return nil, err
+func (t *userTokens) Token(context.Context) (string, error) {
+	return t.accessToken, nil
+}
+
+func (t *userTokens) Refresh(_ context.Context, rejected string) (string, error) {
+	return t.refresh(rejected)
+}
+
+// userToken returns the Twitch token of the requesting user and a function to refresh it once rejected.
+func (h *TwitchHandlers) userToken(c *gin.Context) (*models.TwitchTokenInfo, func(rejected string) (*models.TwitchTokenInfo, error), error) {
+	ctx := c.Request.Context()
+
+	// tokens of a login in progress are stored once the session is created
+	if tokenJSON, exists := c.Get("twitch_auth_info"); exists {
+		var tokenInfo models.TwitchTokenInfo
+		if err := json.Unmarshal(tokenJSON.([]byte), &tokenInfo); err != nil {
+			return nil, nil, fmt.Errorf("could not unmarshal twitch token: %w", err)
+		}
+		noRefresh := func(string) (*models.TwitchTokenInfo, error) {
+			return nil, errors.New("twitch token of login in progress cannot be refreshed")
+		}
+
+		return &tokenInfo, noRefresh, nil
 	}
 
-	req, err := http.NewRequest(http.MethodGet, twitchValidateURL, nil)
+	sessionID, err := h.users.SessionID(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	req.Header.Set("Authorization", "OAuth "+tokenInfo.AccessToken)
 
-	resp, err := http.DefaultClient.Do(req)
+	tokenInfo, err := h.users.Token(ctx, sessionID)
+	if errors.Is(err, ErrTwitchTokenNotFound) {
+		tokenInfo, err = h.migrateTwitchAuthCookie(c, sessionID)
+	}
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusUnauthorized {
-		httputil.SignOutUser(c, *h.client)
-		return nil, errors.New("twitch token invalid; user signed out")
+	refresh := func(rejected string) (*models.TwitchTokenInfo, error) {
+		return h.users.Refresh(ctx, sessionID, rejected)
 	}
 
-	var result models.TwitchTokenValidateResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
-	}
-	return &result, nil
+	return tokenInfo, refresh, nil
 }
 
-func (h *TwitchHandlers) makeUserTwitchRequest(c *gin.Context, endpoint string, queryParams map[string]string) (*http.Response, error) {
-	tokenInfo, err := h.getTwitchToken(c)
+// migrateTwitchAuthCookie stores the tokens of sessions started while they were kept
+// in a cookie, and removes the cookie.
+func (h *TwitchHandlers) migrateTwitchAuthCookie(c *gin.Context, sessionID uuid.UUID) (*models.TwitchTokenInfo, error) {
+	ctx := c.Request.Context()
+
+	cookieVal, err := c.Cookie(internal.Config.Twitch.AuthInfoCookieKey)
 	if err != nil {
-		return nil, err
+		return nil, ErrTwitchTokenNotFound
 	}
+	httputil.ClearTwitchAuthCookie(c)
 
-	if time.Now().After(tokenInfo.Expiry) {
-		tokenInfo, err = h.refreshTwitchToken(c, tokenInfo)
-		if err != nil {
-			httputil.SignOutUser(c, *h.client)
-			return nil, fmt.Errorf("error refreshing expired twitch token: %w", err)
-		}
+	tokenJSON, err := base64.URLEncoding.DecodeString(cookieVal)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode twitch token cookie: %w", err)
 	}
-
-	reqURL := endpoint
-	if len(queryParams) > 0 {
-		q := url.Values{}
-		for key, val := range queryParams {
-			q.Set(key, val)
-		}
-		reqURL += "?" + q.Encode()
+	var tokenInfo models.TwitchTokenInfo
+	if err := json.Unmarshal(tokenJSON, &tokenInfo); err != nil {
+		return nil, fmt.Errorf("could not unmarshal twitch token cookie: %w", err)
 	}
 
-	var resp *http.Response
-	for attempt := 0; attempt <= maxRetries; attempt++ {
-		req, err := http.NewRequest(http.MethodGet, reqURL, nil)
-		if err != nil {
-			return nil, err
-		}
-		req.Header.Set("Authorization", "Bearer "+tokenInfo.AccessToken)
-		req.Header.Set("Client-Id", internal.Config.TwitchOIDC.ClientID)
+	twitchUserID, err := h.twitchUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-		resp, err = http.DefaultClient.Do(req)
-		if err != nil {
-			return nil, err
-		}
+	if err := h.users.Store(ctx, sessionID, twitchUserID, &tokenInfo); err != nil {
+		return nil, err
+	}
 
-		if resp.StatusCode != http.StatusUnauthorized {
-			return resp, nil
-		}
+	return h.users.Token(ctx, sessionID)
+}
 
-		resp.Body.Close()
+// twitchUserID returns the Twitch user id of the requesting user.
+func (h *TwitchHandlers) twitchUserID(ctx context.Context) (string, error) {
+	u := internal.GetUserFromCtx(ctx)
+	if u == nil {
+		return "", ErrTwitchTokenNotFound
+	}
+	if u.AuthProvider == user.AuthProviderTWITCH {
+		return u.ExternalID, nil
+	}
 
-		if attempt < maxRetries {
-			tokenInfo, err = h.refreshTwitchToken(c, tokenInfo)
-			if err != nil {
-				httputil.SignOutUser(c, *h.client)
-				return nil, fmt.Errorf("error refreshing twitch token on retry: %w", err)
-			}
+	sysCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+	id, err := h.client.Identity.Query().
+		Where(
+			identity.OwnerID(u.ID),
+			identity.ProviderEQ(identity.ProviderTWITCH),
+		).
+		Only(sysCtx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return "", ErrTwitchTokenNotFound
 		}
+		return "", fmt.Errorf("could not get twitch identity: %w", err)
 	}
 
-	httputil.SignOutUser(c, *h.client)
-	return nil, errors.New("twitch API unauthorized after token refresh")
+	return id.Subject, nil
 }
 
-func (h *TwitchHandlers) makeBroadcasterTwitchRequest(c *gin.Context, endpoint string, queryParams map[string]string) (*http.Response, error) {
-	// TODO: broadcaster uses code flow method "get broadcaster token" before app released and we store refresh token, etc in secrets
-	// and call twitch's validate every hour or on every app startup (gocron)
-	return nil, fmt.Errorf("not implemented")
-}
-
-func (h *TwitchHandlers) GetUser(c *gin.Context) (models.TwitchUserResponse, error) {
-	resp, err := h.makeUserTwitchRequest(c, twitchAPIBase+"/users", nil)
+// ValidateTwitchToken validates the Twitch token without attempting refresh on failure.
+func (h *TwitchHandlers) ValidateTwitchToken(c *gin.Context) (*models.TwitchTokenValidateResponse, error) {
+	tokenInfo, _, err := h.userToken(c)
 	if err != nil {
-		return models.TwitchUserResponse{}, err
+		if errors.Is(err, ErrTwitchTokenNotFound) {
+			httputil.SignOutUser(c, *h.client) // must log in with twitch again
+		}
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	var result models.TwitchUserResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return result, err
+	req, err := http.NewRequestWithContext(c.Request.Context(), http.MethodGet, twitchValidateURL, nil)
+	if err != nil {
+		return nil, err
 	}
-	return result, nil
-}
+	req.Header.Set("Authorization", "OAuth "+tokenInfo.AccessToken)
 
-func (h *TwitchHandlers) GetUserSubscription(c *gin.Context, twitchUserID string) (models.TwitchUserSubscriptionResponse, error) {
-	params := map[string]string{
-		"user_id":        twitchUserID,
-		"broadcaster_id": internal.Config.Twitch.BroadcasterID,
-	}
-	resp, err := h.makeUserTwitchRequest(c, twitchAPIBase+"/subscriptions/user", params)
+	resp, err := h.httpClient.Do(req)
 	if err != nil {
-		return models.TwitchUserSubscriptionResponse{}, err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
-	var result models.TwitchUserSubscriptionResponse
+	if resp.StatusCode == http.StatusUnauthorized {
+		httputil.SignOutUser(c, *h.client)
+		return nil, errors.New("twitch token invalid; user signed out")
+	}
+
+	var result models.TwitchTokenValidateResponse
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return result, err
+		return nil, err
 	}
-	return result, nil
+	return &result, nil
 }
 
-func (h *TwitchHandlers) GetUserFollower(c *gin.Context, twitchUserID string) (models.TwitchUserFollowResponse, error) {
-	params := map[string]string{
-		"broadcaster_id": internal.Config.Twitch.BroadcasterID,
-		"user_id":        twitchUserID,
-	}
+func (h *TwitchHandlers) GetUser(c *gin.Context) (models.TwitchUserResponse, error) {
+	var result models.TwitchUserResponse
+	err := h.userRequest(c, func(hc *helix.Client) error {
+		users, err := hc.Users(c.Request.Context())
+		result.Data = users
 
-	resp, err := h.makeUserTwitchRequest(c, twitchAPIBase+"/channels/followed", params)
-	if err != nil {
-		return models.TwitchUserFollowResponse{}, err
-	}
-	defer resp.Body.Close()
+		return err
+	})
+
+	return result, err
+}
+
+func (h *TwitchHandlers) GetUserSubscription(c *gin.Context, twitchUserID string) (models.TwitchUserSubscriptionResponse, error) {
+	var result models.TwitchUserSubscriptionResponse
+	err := h.userRequest(c, func(hc *helix.Client) error {
+		sub, err := hc.UserSubscription(c.Request.Context(), internal.Config.Twitch.BroadcasterID, twitchUserID)
+		if sub != nil {
+			result.Data = append(result.Data, *sub)
+		}
+
+		return err
+	})
 
+	return result, err
+}
+
+func (h *TwitchHandlers) GetUserFollower(c *gin.Context, twitchUserID string) (models.TwitchUserFollowResponse, error) {
 	var result models.TwitchUserFollowResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return result, err
-	}
+	err := h.userRequest(c, func(hc *helix.Client) error {
+		follow, err := hc.FollowedChannel(c.Request.Context(), twitchUserID, internal.Config.Twitch.BroadcasterID)
+		if follow != nil {
+			result.Data = append(result.Data, *follow)
+		}
 
-	return result, nil
+		return err
+	})
+
+	return result, err
 }
 
 func (h *TwitchHandlers) GetBroadcasterLive(c *gin.Context) (models.TwitchStreamResponse, error) {
-	params := map[string]string{
-		"user_id": internal.Config.Twitch.BroadcasterID,
-	}
-	resp, err := h.makeUserTwitchRequest(c, twitchAPIBase+"/streams", params)
-	if err != nil {
-		return models.TwitchStreamResponse{}, err
-	}
-	defer resp.Body.Close()
+	streams, err := h.app.helix.Streams(c.Request.Context(), helix.StreamsParams{
+		UserIDs: []string{internal.Config.Twitch.BroadcasterID},
+	})
 
-	var result models.TwitchStreamResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return result, err
-	}
-	return result, nil
+	return models.TwitchStreamResponse{Data: streams}, err
 }
 
 // GetUserBanStatus checks if a user is banned from the broadcaster's channel
 func (h *TwitchHandlers) GetUserBanStatus(c *gin.Context, userID string) (models.TwitchBanResponse, error) {
-	params := map[string]string{
-		"broadcaster_id": internal.Config.Twitch.BroadcasterID,
-		"user_id":        userID,
-	}
-
-	resp, err := h.makeUserTwitchRequest(c, twitchAPIBase+"/moderation/banned", params)
+	bans, err := h.broadcaster.helix.BannedUsers(c.Request.Context(), internal.Config.Twitch.BroadcasterID, userID)
 	if err != nil {
-		return models.TwitchBanResponse{}, err
-	}
-	defer resp.Body.Close()
-
-	var result models.TwitchBanResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return result, fmt.Errorf("failed to decode ban status response: %w", err)
+		return models.TwitchBanResponse{}, fmt.Errorf("ban status: %w", err)
 	}
 
-	return result, nil
+	return models.TwitchBanResponse{Data: bans}, nil
 }
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/client/helix"
+	"github.com/caliecode/la-clipasa/internal/models"
+)
+
+// TwitchAppClient calls Helix with an app access token, for public data
+// that is shared by all visitors and background jobs.
+type TwitchAppClient struct {
+	helix *helix.Client
+}
+
+// NewTwitchAppClient returns a client authenticated with app access tokens.
+func NewTwitchAppClient(tokens *AppTokenSource, opts ...helix.Option) *TwitchAppClient {
+	return &TwitchAppClient{
+		helix: helix.New(internal.Config.TwitchOIDC.ClientID, tokens, opts...),
+	}
+}
+
+// BroadcasterStream returns the live stream of the broadcaster, or nil if offline.
+func (a *TwitchAppClient) BroadcasterStream(ctx context.Context) (*models.TwitchStream, error) {
+	streams, err := a.helix.Streams(ctx, helix.StreamsParams{
+		UserIDs: []string{internal.Config.Twitch.BroadcasterID},
+		Type:    "live",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(streams) == 0 {
+		return nil, nil
+	}
+
+	return &streams[0], nil
+}
+
+// Clip returns a clip by ID, or nil if it does not exist.
+func (a *TwitchAppClient) Clip(ctx context.Context, id string) (*models.TwitchClip, error) {
+	clips, err := a.helix.Clips(ctx, helix.ClipsParams{IDs: []string{id}})
+	if err != nil {
+		return nil, err
+	}
+	if len(clips) == 0 {
+		return nil, nil
+	}
+
+	return &clips[0], nil
+}
+
+// TopClips returns the most viewed clips of the broadcaster created since startedAt.
+func (a *TwitchAppClient) TopClips(ctx context.Context, startedAt time.Time, first int) ([]models.TwitchClip, error) {
+	return a.helix.Clips(ctx, helix.ClipsParams{
+		BroadcasterID: internal.Config.Twitch.BroadcasterID,
+		StartedAt:     startedAt,
+		First:         first,
+	})
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/models"
+)
+
+// AppTokenSource provides app access tokens from the client credentials grant,
+// for server-to-server calls that do not act on behalf of a user.
+// Tokens are cached and requested again before expiry. It is safe for concurrent use.
+type AppTokenSource struct {
+	httpClient *http.Client
+	tokenURL   string
+
+	// mu is held while requesting tokens so that concurrent callers share a single request.
+	mu    sync.Mutex
+	token *models.TwitchTokenInfo
+}
+
+// NewAppTokenSource returns a new AppTokenSource.
+func NewAppTokenSource() *AppTokenSource {
+	return &AppTokenSource{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		tokenURL:   twitchRefreshURL,
+	}
+}
+
+// Token returns a valid app access token, requesting a new one when about to expire.
+func (s *AppTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if tokenValid(s.token) {
+		return s.token.AccessToken, nil
+	}
+
+	tokenInfo, err := s.request(ctx)
+	if err != nil {
+		return "", err
+	}
+	s.token = tokenInfo
+
+	return tokenInfo.AccessToken, nil
+}
+
+// Invalidate discards a token rejected by Twitch, e.g. after it was revoked.
+// Tokens already replaced by a concurrent caller are kept.
+func (s *AppTokenSource) Invalidate(accessToken string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && s.token.AccessToken == accessToken {
+		s.token = nil
+	}
+}
+
+// Refresh invalidates a token rejected by Twitch and returns a valid one.
+func (s *AppTokenSource) Refresh(ctx context.Context, rejected string) (string, error) {
+	s.Invalidate(rejected)
+
+	return s.Token(ctx)
+}
+
+// see https://dev.twitch.tv/docs/authentication/getting-tokens-oauth/#client-credentials-grant-flow
+func (s *AppTokenSource) request(ctx context.Context) (*models.TwitchTokenInfo, error) {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", internal.Config.TwitchOIDC.ClientID)
+	form.Set("client_secret", internal.Config.TwitchOIDC.ClientSecret)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create twitch token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("twitch token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("twitch app token request: unexpected status code: %d", resp.StatusCode)
+	}
+
+	var tr models.TwitchTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tr); err != nil {
+		return nil, fmt.Errorf("failed to decode twitch token response: %w", err)
+	}
+	if tr.AccessToken == "" {
+		return nil, fmt.Errorf("twitch app token request: empty access token")
+	}
+
+	return &models.TwitchTokenInfo{
+		AccessToken: tr.AccessToken,
+		Expiry:      time.Now().Add(time.Duration(tr.ExpiresIn) * time.Second),
+		TokenType:   tr.TokenType,
+	}, nil
+}
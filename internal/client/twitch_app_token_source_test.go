@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/caliecode/la-clipasa/internal/client/helix"
+	"github.com/caliecode/la-clipasa/internal/models"
+)
+
+// newTestTokenServer returns a token endpoint issuing numbered app tokens.
+func newTestTokenServer(t *testing.T, expiresIn int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("grant_type") != "client_credentials" || r.FormValue("client_secret") != "client-secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		n := requests.Add(1)
+		_ = json.NewEncoder(w).Encode(models.TwitchTokenResponse{
+			AccessToken: fmt.Sprintf("app-token-%d", n),
+			ExpiresIn:   expiresIn,
+			TokenType:   "bearer",
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &requests
+}
+
+func newTestAppTokenSource(tokenURL string) *AppTokenSource {
+	s := NewAppTokenSource()
+	s.tokenURL = tokenURL
+
+	return s
+}
+
+func TestAppTokenSource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv, requests := newTestTokenServer(t, 5000000)
+	tokens := newTestAppTokenSource(srv.URL)
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			token, err := tokens.Token(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, "app-token-1", token)
+		}()
+	}
+	wg.Wait()
+	assert.EqualValues(t, 1, requests.Load(), "concurrent callers share a single request")
+
+	tokens.Invalidate("app-token-1")
+	token, err := tokens.Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "app-token-2", token)
+
+	tokens.Invalidate("app-token-1")
+	token, err = tokens.Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "app-token-2", token, "tokens replaced concurrently are kept")
+}
+
+func TestAppTokenSourceExpiry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv, requests := newTestTokenServer(t, int(twitchTokenExpiryLeeway.Seconds())-1)
+	tokens := newTestAppTokenSource(srv.URL)
+
+	_, err := tokens.Token(ctx)
+	require.NoError(t, err)
+	token, err := tokens.Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "app-token-2", token, "tokens about to expire are requested again")
+	assert.EqualValues(t, 2, requests.Load())
+}
+
+func TestTwitchAppClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tokenSrv, _ := newTestTokenServer(t, 5000000)
+
+	// the first token is revoked
+	apiSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer app-token-2" || r.Header.Get("Client-Id") != "client-id" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		assert.Equal(t, "/streams", r.URL.Path)
+		assert.Equal(t, "52341091", r.URL.Query().Get("user_id"))
+		_ = json.NewEncoder(w).Encode(models.TwitchStreamResponse{
+			Data: []models.TwitchStream{{UserID: "52341091", Title: "jugando"}},
+		})
+	}))
+	t.Cleanup(apiSrv.Close)
+
+	app := NewTwitchAppClient(newTestAppTokenSource(tokenSrv.URL), helix.WithBaseURL(apiSrv.URL))
+
+	stream, err := app.BroadcasterStream(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, stream)
+	assert.Equal(t, "jugando", stream.Title)
+}
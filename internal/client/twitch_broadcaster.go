@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/client/helix"
+	"github.com/caliecode/la-clipasa/internal/models"
+)
+
+// TwitchBroadcasterClient calls Helix on behalf of the broadcaster.
+type TwitchBroadcasterClient struct {
+	helix *helix.Client
+}
+
+// NewTwitchBroadcasterClient returns a client authenticated with the broadcaster tokens.
+func NewTwitchBroadcasterClient(tokens *BroadcasterTokenSource, opts ...helix.Option) *TwitchBroadcasterClient {
+	return &TwitchBroadcasterClient{
+		helix: helix.New(internal.Config.TwitchOIDC.ClientID, broadcasterTokens{tokens}, opts...),
+	}
+}
+
+// broadcasterTokens adapts BroadcasterTokenSource to helix.TokenSource.
+type broadcasterTokens struct {
+	s *BroadcasterTokenSource
+}
+
+func (t broadcasterTokens) Token(ctx context.Context) (string, error) {
+	tokenInfo, err := t.s.Token(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return tokenInfo.AccessToken, nil
+}
+
+func (t broadcasterTokens) Refresh(ctx context.Context, _ string) (string, error) {
+	tokenInfo, err := t.s.Refresh(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error refreshing broadcaster token: %w", err)
+	}
+
+	return tokenInfo.AccessToken, nil
+}
+
+func channelUserIDs(users []models.TwitchChannelUser) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.UserID)
+	}
+
+	return ids
+}
+
+// ChannelModerators returns the Twitch user ids of the broadcaster channel moderators.
+// Requires the moderation:read scope.
+func (b *TwitchBroadcasterClient) ChannelModerators(ctx context.Context) ([]string, error) {
+	mods, err := b.helix.Moderators(ctx, internal.Config.Twitch.BroadcasterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return channelUserIDs(mods), nil
+}
+
+// ChannelVIPs returns the Twitch user ids of the broadcaster channel VIPs.
+// Requires the channel:read:vips scope.
+func (b *TwitchBroadcasterClient) ChannelVIPs(ctx context.Context) ([]string, error) {
+	vips, err := b.helix.VIPs(ctx, internal.Config.Twitch.BroadcasterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return channelUserIDs(vips), nil
+}
+
+// UserBan returns whether a user is banned or timed out in the broadcaster channel,
+// and the timeout expiry, which is zero for permanent bans.
+// Requires the moderation:read scope.
+func (b *TwitchBroadcasterClient) UserBan(ctx context.Context, twitchUserID string) (bool, time.Time, error) {
+	bans, err := b.helix.BannedUsers(ctx, internal.Config.Twitch.BroadcasterID, twitchUserID)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+	if len(bans) == 0 {
+		return false, time.Time{}, nil
+	}
+
+	var expiresAt time.Time
+	if bans[0].ExpiresAt != "" {
+		t, err := time.Parse(time.RFC3339, bans[0].ExpiresAt)
+		if err != nil {
+			return false, time.Time{}, fmt.Errorf("invalid ban expiry: %w", err)
+		}
+		expiresAt = t
+	}
+
+	return true, expiresAt, nil
+}
+
+// UserSubscribed returns whether a user is subscribed to the broadcaster channel.
+// Requires the channel:read:subscriptions scope.
+func (b *TwitchBroadcasterClient) UserSubscribed(ctx context.Context, twitchUserID string) (bool, error) {
+	subs, err := b.helix.BroadcasterSubscriptions(ctx, internal.Config.Twitch.BroadcasterID, twitchUserID)
+	if err != nil {
+		return false, err
+	}
+
+	return len(subs) > 0, nil
+}
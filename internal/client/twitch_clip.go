@@ -0,0 +1,44 @@
+package client
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var twitchClipSlugRegex = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// ParseTwitchClipID returns the clip ID (slug) of a Twitch clip URL, e.g.
+//
+//	https://clips.twitch.tv/<slug>
+//	https://www.twitch.tv/<channel>/clip/<slug>
+//	https://clips.twitch.tv/embed?clip=<slug>
+func ParseTwitchClipID(rawURL string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return "", errors.New("invalid clip URL")
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+
+	var slug string
+	switch strings.ToLower(u.Hostname()) {
+	case "clips.twitch.tv":
+		if len(segments) == 1 && segments[0] == "embed" {
+			slug = u.Query().Get("clip")
+		} else if len(segments) == 1 {
+			slug = segments[0]
+		}
+	case "twitch.tv", "www.twitch.tv", "m.twitch.tv":
+		if len(segments) == 3 && segments[1] == "clip" {
+			slug = segments[2]
+		}
+	}
+
+	if !twitchClipSlugRegex.MatchString(slug) {
+		return "", errors.New("not a Twitch clip URL")
+	}
+
+	return slug, nil
+}
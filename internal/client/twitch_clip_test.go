@@ -0,0 +1,43 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTwitchClipID(t *testing.T) {
+	t.Parallel()
+
+	valid := map[string]string{
+		"https://clips.twitch.tv/FunnyClipSlug-AbC_12":                        "FunnyClipSlug-AbC_12",
+		"https://clips.twitch.tv/FunnyClipSlug/":                              "FunnyClipSlug",
+		"https://www.twitch.tv/caliebre/clip/FunnyClipSlug?filter=clips":      "FunnyClipSlug",
+		"https://m.twitch.tv/caliebre/clip/FunnyClipSlug":                     "FunnyClipSlug",
+		"https://twitch.tv/caliebre/clip/FunnyClipSlug":                       "FunnyClipSlug",
+		"https://clips.twitch.tv/embed?clip=FunnyClipSlug&parent=example.com": "FunnyClipSlug",
+		"  https://clips.twitch.tv/FunnyClipSlug  ":                           "FunnyClipSlug",
+	}
+	for rawURL, want := range valid {
+		got, err := ParseTwitchClipID(rawURL)
+		require.NoError(t, err, rawURL)
+		assert.Equal(t, want, got, rawURL)
+	}
+
+	invalid := []string{
+		"",
+		"FunnyClipSlug",
+		"ftp://clips.twitch.tv/FunnyClipSlug",
+		"https://www.twitch.tv/caliebre",
+		"https://www.twitch.tv/videos/123456",
+		"https://clips.twitch.tv/embed",
+		"https://clips.twitch.tv/a/b",
+		"https://clips.twitch.tv.example.com/FunnyClipSlug",
+		"https://www.youtube.com/watch?v=FunnyClipSlug",
+	}
+	for _, rawURL := range invalid {
+		_, err := ParseTwitchClipID(rawURL)
+		assert.Error(t, err, rawURL)
+	}
+}
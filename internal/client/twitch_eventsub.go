@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/client/helix"
+	"github.com/caliecode/la-clipasa/internal/models"
+)
+
+// TwitchEventSubClient manages EventSub webhook subscriptions with an app access token.
+// Webhook subscriptions to channel events still require the broadcaster to have authorized
+// the app with the scopes of each subscription type.
+type TwitchEventSubClient struct {
+	helix *helix.Client
+}
+
+// NewTwitchEventSubClient returns a new TwitchEventSubClient.
+func NewTwitchEventSubClient(tokens *AppTokenSource, opts ...helix.Option) *TwitchEventSubClient {
+	return &TwitchEventSubClient{
+		helix: helix.New(internal.Config.TwitchOIDC.ClientID, tokens, opts...),
+	}
+}
+
+// Subscriptions returns all EventSub subscriptions of the app.
+func (c *TwitchEventSubClient) Subscriptions(ctx context.Context) ([]models.TwitchEventSubSubscription, error) {
+	return c.helix.EventSubSubscriptions(ctx)
+}
+
+// Subscribe creates a webhook subscription delivering notifications to callback, signed with secret.
+func (c *TwitchEventSubClient) Subscribe(ctx context.Context, subType, version string, condition map[string]string, callback, secret string) (models.TwitchEventSubSubscription, error) {
+	return c.helix.CreateEventSubSubscription(ctx, models.TwitchEventSubSubscription{
+		Type:      subType,
+		Version:   version,
+		Condition: condition,
+		Transport: models.TwitchEventSubTransport{
+			Method:   "webhook",
+			Callback: callback,
+			Secret:   secret,
+		},
+	})
+}
+
+// Unsubscribe deletes an EventSub subscription.
+func (c *TwitchEventSubClient) Unsubscribe(ctx context.Context, id string) error {
+	return c.helix.DeleteEventSubSubscription(ctx, id)
+}
@@ -0,0 +1,265 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/twitchtoken"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+	"github.com/caliecode/la-clipasa/internal/models"
+	"github.com/caliecode/la-clipasa/internal/utils/crypto"
+)
+
+const (
+	// BroadcasterTokenRefreshInterval is the interval the broadcaster token is checked for expiry at.
+	BroadcasterTokenRefreshInterval = 10 * time.Minute
+	// twitchTokenExpiryLeeway refreshes access tokens before they expire.
+	twitchTokenExpiryLeeway = 2 * BroadcasterTokenRefreshInterval
+)
+
+// ErrBroadcasterTokenNotFound is returned when the broadcaster has not logged in
+// with the broadcaster login mode yet.
+var ErrBroadcasterTokenNotFound = errors.New("broadcaster twitch token not found")
+
+// BroadcasterTokenSource provides the broadcaster Twitch tokens,
+// stored encrypted in the database and refreshed before expiry.
+type BroadcasterTokenSource struct {
+	entc       *generated.Client
+	httpClient *http.Client
+	tokenURL   string
+
+	mu    sync.Mutex
+	token *models.TwitchTokenInfo
+}
+
+// NewBroadcasterTokenSource returns a new BroadcasterTokenSource.
+func NewBroadcasterTokenSource(entc *generated.Client) *BroadcasterTokenSource {
+	return &BroadcasterTokenSource{
+		entc:       entc,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		tokenURL:   twitchRefreshURL,
+	}
+}
+
+func tokenEncryptionKey() []byte {
+	secret := internal.Config.SigningKey
+	if key := internal.Config.TokenEncryptionKey; key != nil && *key != "" {
+		secret = *key
+	}
+
+	return crypto.DeriveKey(secret, "twitch-tokens")
+}
+
+// Store saves the tokens obtained via the broadcaster login.
+func (s *BroadcasterTokenSource) Store(ctx context.Context, twitchUserID string, tokenInfo *models.TwitchTokenInfo, scopes []string) error {
+	if twitchUserID != internal.Config.Twitch.BroadcasterID {
+		return internal.NewErrorf(internal.ErrorCodeUnauthorized, "only the broadcaster can log in with the broadcaster login mode")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx = privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+
+	accessToken, refreshToken, err := encryptTwitchToken(tokenInfo)
+	if err != nil {
+		return err
+	}
+
+	n, err := s.entc.TwitchToken.Update().
+		Where(twitchtoken.TwitchUserID(twitchUserID), twitchtoken.SessionIDIsNil()).
+		SetAccessToken(accessToken).
+		SetRefreshToken(refreshToken).
+		SetExpiresAt(tokenInfo.Expiry).
+		SetScopes(scopes).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("could not update broadcaster token: %w", err)
+	}
+	if n == 0 {
+		err = s.entc.TwitchToken.Create().
+			SetTwitchUserID(twitchUserID).
+			SetAccessToken(accessToken).
+			SetRefreshToken(refreshToken).
+			SetExpiresAt(tokenInfo.Expiry).
+			SetScopes(scopes).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("could not create broadcaster token: %w", err)
+		}
+	}
+
+	s.token = tokenInfo
+
+	return nil
+}
+
+// Token returns a valid broadcaster token, refreshing it when about to expire.
+func (s *BroadcasterTokenSource) Token(ctx context.Context) (*models.TwitchTokenInfo, error) {
+	return s.getToken(ctx, false)
+}
+
+// Refresh refreshes the broadcaster token regardless of its expiry,
+// e.g. when revoked by Twitch before expiry.
+func (s *BroadcasterTokenSource) Refresh(ctx context.Context) (*models.TwitchTokenInfo, error) {
+	return s.getToken(ctx, true)
+}
+
+func (s *BroadcasterTokenSource) getToken(ctx context.Context, force bool) (*models.TwitchTokenInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !force && tokenValid(s.token) {
+		return s.token, nil
+	}
+
+	ctx = privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+
+	tx, err := s.entc.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// other instances wait for the refresh
+	tt, err := tx.TwitchToken.Query().
+		Where(twitchtoken.TwitchUserID(internal.Config.Twitch.BroadcasterID), twitchtoken.SessionIDIsNil()).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, ErrBroadcasterTokenNotFound
+		}
+		return nil, fmt.Errorf("could not get broadcaster token: %w", err)
+	}
+
+	tokenInfo, err := decryptTwitchToken(tt)
+	if err != nil {
+		return nil, err
+	}
+
+	// refreshed by another instance in the meantime
+	refreshedElsewhere := s.token != nil && s.token.AccessToken != tokenInfo.AccessToken
+	if tokenValid(tokenInfo) && (!force || refreshedElsewhere) {
+		s.token = tokenInfo
+		return tokenInfo, tx.Commit()
+	}
+
+	tokenInfo, err = requestRefreshedToken(ctx, s.httpClient, s.tokenURL, tokenInfo.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, refreshToken, err := encryptTwitchToken(tokenInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.TwitchToken.UpdateOne(tt).
+		SetAccessToken(accessToken).
+		SetRefreshToken(refreshToken).
+		SetExpiresAt(tokenInfo.Expiry).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not update broadcaster token: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.token = tokenInfo
+
+	return tokenInfo, nil
+}
+
+// requestRefreshedToken exchanges a refresh token for new tokens.
+// see https://dev.twitch.tv/docs/authentication/refresh-tokens/
+func requestRefreshedToken(ctx context.Context, httpClient *http.Client, tokenURL, refreshToken string) (*models.TwitchTokenInfo, error) {
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("refresh_token", refreshToken)
+	form.Set("client_id", internal.Config.TwitchOIDC.ClientID)
+	form.Set("client_secret", internal.Config.TwitchOIDC.ClientSecret)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create twitch token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("twitch token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("twitch token request: unexpected status code: %d", resp.StatusCode)
+	}
+
+	var tr models.TwitchTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tr); err != nil {
+		return nil, fmt.Errorf("failed to decode twitch token response: %w", err)
+	}
+
+	// refresh tokens may be rotated
+	if tr.RefreshToken != "" {
+		refreshToken = tr.RefreshToken
+	}
+
+	return &models.TwitchTokenInfo{
+		AccessToken:  tr.AccessToken,
+		RefreshToken: refreshToken,
+		Expiry:       time.Now().Add(time.Duration(tr.ExpiresIn) * time.Second),
+		TokenType:    tr.TokenType,
+	}, nil
+}
+
+func encryptTwitchToken(tokenInfo *models.TwitchTokenInfo) (accessToken, refreshToken []byte, err error) {
+	key := tokenEncryptionKey()
+	accessToken, err = crypto.Encrypt(key, []byte(tokenInfo.AccessToken))
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not encrypt access token: %w", err)
+	}
+	refreshToken, err = crypto.Encrypt(key, []byte(tokenInfo.RefreshToken))
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not encrypt refresh token: %w", err)
+	}
+
+	return accessToken, refreshToken, nil
+}
+
+func decryptTwitchToken(tt *generated.TwitchToken) (*models.TwitchTokenInfo, error) {
+	key := tokenEncryptionKey()
+	accessToken, err := crypto.Decrypt(key, tt.AccessToken)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt access token: %w", err)
+	}
+	refreshToken, err := crypto.Decrypt(key, tt.RefreshToken)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt refresh token: %w", err)
+	}
+
+	return &models.TwitchTokenInfo{
+		AccessToken:  string(accessToken),
+		RefreshToken: string(refreshToken),
+		Expiry:       tt.ExpiresAt,
+		TokenType:    "bearer",
+	}, nil
+}
+
+func tokenValid(t *models.TwitchTokenInfo) bool {
+	return t != nil && time.Now().Add(twitchTokenExpiryLeeway).Before(t.Expiry)
+}
@@ -0,0 +1,229 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/refreshtoken"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/twitchtoken"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+	"github.com/caliecode/la-clipasa/internal/models"
+)
+
+// ErrTwitchTokenNotFound is returned when no Twitch tokens are stored for the session,
+// e.g. sessions without a Twitch login.
+var ErrTwitchTokenNotFound = errors.New("twitch token not found for session")
+
+// userTokenLocks serializes token refreshes per Twitch user in this instance,
+// shared by all sources.
+var userTokenLocks keyedMutex
+
+// UserTokenSource provides the Twitch tokens of user sessions,
+// stored encrypted in the database and refreshed when about to expire.
+// A session is the refresh token family started by a login.
+type UserTokenSource struct {
+	entc       *generated.Client
+	httpClient *http.Client
+	tokenURL   string
+}
+
+// NewUserTokenSource returns a new UserTokenSource.
+func NewUserTokenSource(entc *generated.Client) *UserTokenSource {
+	return &UserTokenSource{
+		entc:       entc,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		tokenURL:   twitchRefreshURL,
+	}
+}
+
+// SessionID returns the session of the refresh token in ctx.
+func (s *UserTokenSource) SessionID(ctx context.Context) (uuid.UUID, error) {
+	hash := internal.GetRefreshTokenHashFromCtx(ctx)
+	if hash == "" {
+		return uuid.Nil, ErrTwitchTokenNotFound
+	}
+
+	ctx = privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+
+	rt, err := s.entc.RefreshToken.Query().Where(refreshtoken.TokenHash(hash)).Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return uuid.Nil, ErrTwitchTokenNotFound
+		}
+		return uuid.Nil, fmt.Errorf("could not get session: %w", err)
+	}
+
+	return rt.FamilyID, nil
+}
+
+// Store saves the tokens of a session, replacing previous tokens.
+func (s *UserTokenSource) Store(ctx context.Context, sessionID uuid.UUID, twitchUserID string, tokenInfo *models.TwitchTokenInfo) error {
+	ctx = privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+
+	accessToken, refreshToken, err := encryptTwitchToken(tokenInfo)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.entc.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// the session may be linked to another Twitch user
+	if _, err := tx.TwitchToken.Delete().Where(twitchtoken.SessionID(sessionID)).Exec(ctx); err != nil {
+		return fmt.Errorf("could not delete session twitch token: %w", err)
+	}
+	err = tx.TwitchToken.Create().
+		SetTwitchUserID(twitchUserID).
+		SetSessionID(sessionID).
+		SetAccessToken(accessToken).
+		SetRefreshToken(refreshToken).
+		SetExpiresAt(tokenInfo.Expiry).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("could not create session twitch token: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Token returns a valid token of a session, refreshing it when about to expire.
+func (s *UserTokenSource) Token(ctx context.Context, sessionID uuid.UUID) (*models.TwitchTokenInfo, error) {
+	return s.getToken(ctx, sessionID, "")
+}
+
+// Refresh refreshes the token of a session after Twitch rejected accessToken,
+// unless a concurrent request refreshed it already.
+func (s *UserTokenSource) Refresh(ctx context.Context, sessionID uuid.UUID, accessToken string) (*models.TwitchTokenInfo, error) {
+	return s.getToken(ctx, sessionID, accessToken)
+}
+
+func (s *UserTokenSource) getToken(ctx context.Context, sessionID uuid.UUID, rejected string) (*models.TwitchTokenInfo, error) {
+	ctx = privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+
+	tt, err := s.entc.TwitchToken.Query().Where(twitchtoken.SessionID(sessionID)).Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, ErrTwitchTokenNotFound
+		}
+		return nil, fmt.Errorf("could not get session twitch token: %w", err)
+	}
+
+	tokenInfo, err := decryptTwitchToken(tt)
+	if err != nil {
+		return nil, err
+	}
+	if tokenValid(tokenInfo) && tokenInfo.AccessToken != rejected {
+		return tokenInfo, nil
+	}
+
+	unlock := userTokenLocks.lock(tt.TwitchUserID)
+	defer unlock()
+
+	tx, err := s.entc.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// other instances wait for the refresh
+	tt, err = tx.TwitchToken.Query().Where(twitchtoken.SessionID(sessionID)).ForUpdate().Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, ErrTwitchTokenNotFound
+		}
+		return nil, fmt.Errorf("could not get session twitch token: %w", err)
+	}
+
+	tokenInfo, err = decryptTwitchToken(tt)
+	if err != nil {
+		return nil, err
+	}
+	// refreshed by a concurrent request in the meantime
+	if tokenValid(tokenInfo) && tokenInfo.AccessToken != rejected {
+		return tokenInfo, tx.Commit()
+	}
+
+	tokenInfo, err = requestRefreshedToken(ctx, s.httpClient, s.tokenURL, tokenInfo.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, refreshToken, err := encryptTwitchToken(tokenInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.TwitchToken.UpdateOne(tt).
+		SetAccessToken(accessToken).
+		SetRefreshToken(refreshToken).
+		SetExpiresAt(tokenInfo.Expiry).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not update session twitch token: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return tokenInfo, nil
+}
+
+// keyedMutex provides a mutex per key, removed once unused.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedMutexEntry
+}
+
+type keyedMutexEntry struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// lock locks the mutex of key and returns its unlock function.
+func (k *keyedMutex) lock(key string) (unlock func()) {
+	k.mu.Lock()
+	if k.locks == nil {
+		k.locks = make(map[string]*keyedMutexEntry)
+	}
+	e, ok := k.locks[key]
+	if !ok {
+		e = &keyedMutexEntry{}
+		k.locks[key] = e
+	}
+	e.refs++
+	k.mu.Unlock()
+
+	e.mu.Lock()
+
+	return func() {
+		e.mu.Unlock()
+
+		k.mu.Lock()
+		defer k.mu.Unlock()
+
+		e.refs--
+		if e.refs == 0 {
+			delete(k.locks, key)
+		}
+	}
+}
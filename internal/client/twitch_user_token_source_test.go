@@ -0,0 +1,44 @@
+package client
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyedMutex(t *testing.T) {
+	t.Parallel()
+
+	var k keyedMutex
+	var running, maxRunning atomic.Int32
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			unlock := k.lock("user")
+			defer unlock()
+
+			n := running.Add(1)
+			if n > maxRunning.Load() {
+				maxRunning.Store(n)
+			}
+			time.Sleep(time.Millisecond)
+			running.Add(-1)
+		}()
+	}
+
+	// other keys are not blocked
+	unlock := k.lock("other")
+	unlock()
+
+	wg.Wait()
+
+	assert.Equal(t, int32(1), maxRunning.Load(), "refreshes of a user are serialized")
+	assert.Empty(t, k.locks, "unused locks are removed")
+}
@@ -22,7 +22,10 @@ var (
 type TwitchOidcConfig struct {
 	ClientID     string `env:"OIDC_TWITCH_CLIENT_ID"`
 	ClientSecret string `env:"OIDC_TWITCH_CLIENT_SECRET"`
-	Issuer       string
+	Issuer       string `env:"OIDC_TWITCH_ISSUER,https://id.twitch.tv/oauth2"`
+	UserInfoURL  string `env:"OIDC_TWITCH_USERINFO_URL,https://id.twitch.tv/oauth2/userinfo"`
+	// MockServerPort starts an embedded mock OIDC provider replacing Twitch's in dev and e2e environments.
+	MockServerPort *string `env:"OIDC_TWITCH_MOCK_SERVER_PORT"`
 	// Streamer scopes for code flow API usage with refresh_token.
 	BroadcasterScopes string
 	// User scopes.
@@ -30,6 +33,19 @@ type TwitchOidcConfig struct {
 	Domain     string
 }
 
+// DiscordOidcConfig configures the optional Discord login provider.
+// The provider is enabled when the client id is set.
+type DiscordOidcConfig struct {
+	ClientID     *string `env:"OIDC_DISCORD_CLIENT_ID"`
+	ClientSecret *string `env:"OIDC_DISCORD_CLIENT_SECRET"`
+}
+
+// GoogleOidcConfig configures the optional Google login provider.
+type GoogleOidcConfig struct {
+	ClientID     *string `env:"OIDC_GOOGLE_CLIENT_ID"`
+	ClientSecret *string `env:"OIDC_GOOGLE_CLIENT_SECRET"`
+}
+
 type PostgresConfig struct {
 	// Port represents the db port to use in the application, depending on setup (dockerized or not).
 	Port     string `env:"DB_PORT"`
@@ -42,9 +58,11 @@ type PostgresConfig struct {
 }
 
 // KeyValueDbConfig contains key-value db settings.
+// Caches are shared by instances via Redis if Host is set, else they are per instance.
 type KeyValueDbConfig struct {
-	DB   int    `env:"KEY_VALUE_DB"`
-	Host string `env:"KEY_VALUE_HOST"`
+	DB       *int    `env:"KEY_VALUE_DB"`
+	Host     *string `env:"KEY_VALUE_HOST"`
+	Password *string `env:"KEY_VALUE_PASSWORD"`
 }
 
 type SuperAdminConfig struct {
@@ -74,9 +92,24 @@ func (e *AppEnv) Decode(value string) error {
 }
 
 type TwitchConfig struct {
-	BroadcasterID     string
-	BroadcasterName   string
+	BroadcasterID   string
+	BroadcasterName string
+	// AuthInfoCookieKey is the cookie Twitch tokens were kept in before being stored server-side.
 	AuthInfoCookieKey string
+	// EventSubSecret signs EventSub webhook messages. The webhook endpoint is disabled if unset.
+	EventSubSecret *string `env:"TWITCH_EVENTSUB_SECRET"`
+	// ImportClips periodically suggests the top new clips of the channel as unmoderated posts.
+	ImportClips *bool `env:"TWITCH_IMPORT_CLIPS"`
+}
+
+// JWTConfig contains access token signing keys.
+// If KeysDir is unset, an Ed25519 key is derived from SigningKey.
+type JWTConfig struct {
+	// KeysDir contains PKCS#8 RSA or Ed25519 PEM keys named <kid>.pem.
+	// Public keys are used to verify tokens signed by retired keys.
+	KeysDir *string `env:"JWT_KEYS_DIR"`
+	// SigningKeyID is the private key in KeysDir that signs new tokens.
+	SigningKeyID *string `env:"JWT_SIGNING_KEY_ID"`
 }
 
 type DiscordConfig struct {
@@ -89,9 +122,13 @@ type AppConfig struct {
 	Postgres   PostgresConfig
 	KV         KeyValueDbConfig
 	TwitchOIDC TwitchOidcConfig
-	SuperAdmin SuperAdminConfig
-	Twitch     TwitchConfig
-	Discord    DiscordConfig
+	// DiscordOIDC and GoogleOIDC are optional login providers.
+	DiscordOIDC DiscordOidcConfig
+	GoogleOIDC  GoogleOidcConfig
+	SuperAdmin  SuperAdminConfig
+	Twitch      TwitchConfig
+	Discord     DiscordConfig
+	JWT         JWTConfig
 
 	FrontendPort          string  `env:"FRONTEND_PORT"`
 	Domain                string  `env:"DOMAIN"`
@@ -101,9 +138,17 @@ type AppConfig struct {
 	ProjectPrefix         string  `env:"PROJECT_PREFIX"`
 	AppEnv                AppEnv  `env:"APP_ENV"`
 	SigningKey            string  `env:"SIGNING_KEY"`
-	BuildVersion          string  `env:"BUILD_VERSION,-"`
-	CookieDomain          string  `env:"COOKIE_DOMAIN"`
-	LoginCookieKey        string  `env:"LOGIN_COOKIE_KEY"`
+	// TokenEncryptionKey encrypts third party tokens stored in the database.
+	// If unset, a key is derived from SigningKey.
+	TokenEncryptionKey *string `env:"TOKEN_ENCRYPTION_KEY"`
+	BuildVersion       string  `env:"BUILD_VERSION,-"`
+	CookieDomain       string  `env:"COOKIE_DOMAIN"`
+	LoginCookieKey     string  `env:"LOGIN_COOKIE_KEY"`
+	// TrustedProxies are comma-separated IPs or CIDRs of reverse proxies whose Fly-Client-IP
+	// and X-Forwarded-For headers are used as client IP. If unset, the connection address is used.
+	TrustedProxies *string `env:"TRUSTED_PROXIES"`
+	// FlyAppName is set on Fly.io machines, reached through the Fly proxy only.
+	FlyAppName *string `env:"FLY_APP_NAME"`
 }
 
 // NewAppConfig initializes app config from current environment variables.
@@ -120,8 +165,7 @@ func NewAppConfig() error {
 		},
 		TwitchOIDC: TwitchOidcConfig{
 			Domain:            "id.twitch.tv",
-			Issuer:            "https://id.twitch.tv/oauth2",
-			BroadcasterScopes: "openid user:read:subscriptions user:read:follows moderation:read",
+			BroadcasterScopes: "openid user:read:subscriptions user:read:follows moderation:read channel:read:vips channel:read:subscriptions channel:moderate",
 			UserScopes:        "openid user:read:subscriptions user:read:follows",
 		},
 	}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
 	"github.com/gin-gonic/gin"
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"go.uber.org/zap"
@@ -65,3 +66,33 @@ func GetUserFromCtx(ctx context.Context) *generated.User {
 func SetUserCtx(ctx context.Context, u *generated.User) context.Context {
 	return context.WithValue(ctx, ctxKeyUser{}, u)
 }
+
+type ctxKeyRefreshTokenHash struct{}
+
+// GetRefreshTokenHashFromCtx returns the hash of the refresh token of the current session, if any.
+func GetRefreshTokenHashFromCtx(ctx context.Context) string {
+	h, _ := ctx.Value(ctxKeyRefreshTokenHash{}).(string)
+
+	return h
+}
+
+func SetRefreshTokenHashCtx(ctx context.Context, hash string) context.Context {
+	return context.WithValue(ctx, ctxKeyRefreshTokenHash{}, hash)
+}
+
+type ctxKeyRoleChangeSource struct{}
+
+// GetRoleChangeSourceFromCtx returns the source recorded for role changes.
+// Changes are recorded as manual by default.
+func GetRoleChangeSourceFromCtx(ctx context.Context) rolechange.Source {
+	if source, ok := ctx.Value(ctxKeyRoleChangeSource{}).(rolechange.Source); ok {
+		return source
+	}
+
+	return rolechange.SourceMANUAL
+}
+
+// SetRoleChangeSourceCtx sets the source recorded for role changes.
+func SetRoleChangeSourceCtx(ctx context.Context, source rolechange.Source) context.Context {
+	return context.WithValue(ctx, ctxKeyRoleChangeSource{}, source)
+}
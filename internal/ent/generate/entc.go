@@ -7,12 +7,13 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
 	laclipasa "github.com/caliecode/la-clipasa"
-	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
 	"github.com/caliecode/la-clipasa/internal/ent/schema/annotations"
 	"github.com/caliecode/la-clipasa/internal/utils/slices"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -129,7 +130,15 @@ func main() {
 					FieldName: "role",
 					Targets:   []DirectiveTarget{CreateInputFieldTarget, UpdateInputFieldTarget},
 					Directives: []entgql.Directive{
-						annotations.HasRoleDirective(user.RoleADMIN),
+						annotations.HasPermissionDirective(rolepermission.PermissionRolesManage),
+					},
+				},
+			},
+			"AwardDefinition": {
+				{
+					Targets: []DirectiveTarget{CreateInputObjectTarget, UpdateInputObjectTarget},
+					Directives: []entgql.Directive{
+						annotations.HasPermissionDirective(rolepermission.PermissionAwardsManage),
 					},
 				},
 			},
@@ -142,6 +151,7 @@ func main() {
 			// 	},
 			// },
 		})),
+		entgql.WithSchemaHook(costConnections),
 		entgql.WithSchemaHook(xExt.GQLSchemaHooks()...),
 	)
 	if err != nil {
@@ -167,6 +177,7 @@ func main() {
 			gen.FeaturePrivacy,
 			gen.FeatureNamedEdges,
 			gen.FeatureEntQL,
+			gen.FeatureUpsert,
 		},
 	},
 		entc.Extensions(
@@ -186,6 +197,25 @@ func main() {
 	}
 }
 
+// costConnections weighs connections by the number of requested items in query cost analysis.
+func costConnections(_ *gen.Graph, s *ast.Schema) error {
+	for _, t := range s.Types {
+		for _, f := range t.Fields {
+			if !strings.HasSuffix(f.Type.Name(), "Connection") || f.Arguments.ForName("first") == nil {
+				continue
+			}
+			if f.Directives.ForName("cost") != nil {
+				continue
+			}
+			f.Directives = append(f.Directives, convertDirectives([]entgql.Directive{
+				annotations.CostDirective(1, "first", "last"),
+			})...)
+		}
+	}
+
+	return nil
+}
+
 type DirectiveTarget int
 
 const (
@@ -3,6 +3,7 @@
 package generated
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -25,10 +26,18 @@ type ApiKey struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// The user id that owns the object
 	OwnerID uuid.UUID `json:"owner_id,omitempty"`
-	// APIKey holds the value of the "api_key" field.
-	APIKey string `json:"api_key,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Prefix holds the value of the "prefix" field.
+	Prefix string `json:"prefix,omitempty"`
+	// KeyHash holds the value of the "key_hash" field.
+	KeyHash string `json:"-"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
 	// ExpiresOn holds the value of the "expires_on" field.
 	ExpiresOn time.Time `json:"expires_on,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ApiKeyQuery when eager-loading is set.
 	Edges        ApiKeyEdges `json:"edges"`
@@ -62,9 +71,11 @@ func (*ApiKey) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case apikey.FieldAPIKey:
+		case apikey.FieldScopes:
+			values[i] = new([]byte)
+		case apikey.FieldName, apikey.FieldPrefix, apikey.FieldKeyHash:
 			values[i] = new(sql.NullString)
-		case apikey.FieldUpdatedAt, apikey.FieldCreatedAt, apikey.FieldExpiresOn:
+		case apikey.FieldUpdatedAt, apikey.FieldCreatedAt, apikey.FieldExpiresOn, apikey.FieldLastUsedAt:
 			values[i] = new(sql.NullTime)
 		case apikey.FieldID, apikey.FieldOwnerID:
 			values[i] = new(uuid.UUID)
@@ -107,11 +118,31 @@ func (ak *ApiKey) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				ak.OwnerID = *value
 			}
-		case apikey.FieldAPIKey:
+		case apikey.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field api_key", values[i])
+				return fmt.Errorf("unexpected type %T for field name", values[i])
 			} else if value.Valid {
-				ak.APIKey = value.String
+				ak.Name = value.String
+			}
+		case apikey.FieldPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prefix", values[i])
+			} else if value.Valid {
+				ak.Prefix = value.String
+			}
+		case apikey.FieldKeyHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_hash", values[i])
+			} else if value.Valid {
+				ak.KeyHash = value.String
+			}
+		case apikey.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ak.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
 			}
 		case apikey.FieldExpiresOn:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -119,6 +150,13 @@ func (ak *ApiKey) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ak.ExpiresOn = value.Time
 			}
+		case apikey.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				ak.LastUsedAt = new(time.Time)
+				*ak.LastUsedAt = value.Time
+			}
 		default:
 			ak.selectValues.Set(columns[i], values[i])
 		}
@@ -169,11 +207,24 @@ func (ak *ApiKey) String() string {
 	builder.WriteString("owner_id=")
 	builder.WriteString(fmt.Sprintf("%v", ak.OwnerID))
 	builder.WriteString(", ")
-	builder.WriteString("api_key=")
-	builder.WriteString(ak.APIKey)
+	builder.WriteString("name=")
+	builder.WriteString(ak.Name)
+	builder.WriteString(", ")
+	builder.WriteString("prefix=")
+	builder.WriteString(ak.Prefix)
+	builder.WriteString(", ")
+	builder.WriteString("key_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", ak.Scopes))
 	builder.WriteString(", ")
 	builder.WriteString("expires_on=")
 	builder.WriteString(ak.ExpiresOn.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ak.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/apikey"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
@@ -51,20 +52,32 @@ func (aku *ApiKeyUpdate) SetNillableOwnerID(u *uuid.UUID) *ApiKeyUpdate {
 	return aku
 }
 
-// SetAPIKey sets the "api_key" field.
-func (aku *ApiKeyUpdate) SetAPIKey(s string) *ApiKeyUpdate {
-	aku.mutation.SetAPIKey(s)
+// SetName sets the "name" field.
+func (aku *ApiKeyUpdate) SetName(s string) *ApiKeyUpdate {
+	aku.mutation.SetName(s)
 	return aku
 }
 
-// SetNillableAPIKey sets the "api_key" field if the given value is not nil.
-func (aku *ApiKeyUpdate) SetNillableAPIKey(s *string) *ApiKeyUpdate {
+// SetNillableName sets the "name" field if the given value is not nil.
+func (aku *ApiKeyUpdate) SetNillableName(s *string) *ApiKeyUpdate {
 	if s != nil {
-		aku.SetAPIKey(*s)
+		aku.SetName(*s)
 	}
 	return aku
 }
 
+// SetScopes sets the "scopes" field.
+func (aku *ApiKeyUpdate) SetScopes(s []string) *ApiKeyUpdate {
+	aku.mutation.SetScopes(s)
+	return aku
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (aku *ApiKeyUpdate) AppendScopes(s []string) *ApiKeyUpdate {
+	aku.mutation.AppendScopes(s)
+	return aku
+}
+
 // SetExpiresOn sets the "expires_on" field.
 func (aku *ApiKeyUpdate) SetExpiresOn(t time.Time) *ApiKeyUpdate {
 	aku.mutation.SetExpiresOn(t)
@@ -79,6 +92,26 @@ func (aku *ApiKeyUpdate) SetNillableExpiresOn(t *time.Time) *ApiKeyUpdate {
 	return aku
 }
 
+// SetLastUsedAt sets the "last_used_at" field.
+func (aku *ApiKeyUpdate) SetLastUsedAt(t time.Time) *ApiKeyUpdate {
+	aku.mutation.SetLastUsedAt(t)
+	return aku
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (aku *ApiKeyUpdate) SetNillableLastUsedAt(t *time.Time) *ApiKeyUpdate {
+	if t != nil {
+		aku.SetLastUsedAt(*t)
+	}
+	return aku
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (aku *ApiKeyUpdate) ClearLastUsedAt() *ApiKeyUpdate {
+	aku.mutation.ClearLastUsedAt()
+	return aku
+}
+
 // SetOwner sets the "owner" edge to the User entity.
 func (aku *ApiKeyUpdate) SetOwner(u *User) *ApiKeyUpdate {
 	return aku.SetOwnerID(u.ID)
@@ -139,6 +172,16 @@ func (aku *ApiKeyUpdate) defaults() error {
 
 // check runs all checks and user-defined validators on the builder.
 func (aku *ApiKeyUpdate) check() error {
+	if v, ok := aku.mutation.Name(); ok {
+		if err := apikey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "ApiKey.name": %w`, err)}
+		}
+	}
+	if v, ok := aku.mutation.Scopes(); ok {
+		if err := apikey.ScopesValidator(v); err != nil {
+			return &ValidationError{Name: "scopes", err: fmt.Errorf(`generated: validator failed for field "ApiKey.scopes": %w`, err)}
+		}
+	}
 	if aku.mutation.OwnerCleared() && len(aku.mutation.OwnerIDs()) > 0 {
 		return errors.New(`generated: clearing a required unique edge "ApiKey.owner"`)
 	}
@@ -166,12 +209,26 @@ func (aku *ApiKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := aku.mutation.UpdatedAt(); ok {
 		_spec.SetField(apikey.FieldUpdatedAt, field.TypeTime, value)
 	}
-	if value, ok := aku.mutation.APIKey(); ok {
-		_spec.SetField(apikey.FieldAPIKey, field.TypeString, value)
+	if value, ok := aku.mutation.Name(); ok {
+		_spec.SetField(apikey.FieldName, field.TypeString, value)
+	}
+	if value, ok := aku.mutation.Scopes(); ok {
+		_spec.SetField(apikey.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := aku.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apikey.FieldScopes, value)
+		})
 	}
 	if value, ok := aku.mutation.ExpiresOn(); ok {
 		_spec.SetField(apikey.FieldExpiresOn, field.TypeTime, value)
 	}
+	if value, ok := aku.mutation.LastUsedAt(); ok {
+		_spec.SetField(apikey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if aku.mutation.LastUsedAtCleared() {
+		_spec.ClearField(apikey.FieldLastUsedAt, field.TypeTime)
+	}
 	if aku.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -243,20 +300,32 @@ func (akuo *ApiKeyUpdateOne) SetNillableOwnerID(u *uuid.UUID) *ApiKeyUpdateOne {
 	return akuo
 }
 
-// SetAPIKey sets the "api_key" field.
-func (akuo *ApiKeyUpdateOne) SetAPIKey(s string) *ApiKeyUpdateOne {
-	akuo.mutation.SetAPIKey(s)
+// SetName sets the "name" field.
+func (akuo *ApiKeyUpdateOne) SetName(s string) *ApiKeyUpdateOne {
+	akuo.mutation.SetName(s)
 	return akuo
 }
 
-// SetNillableAPIKey sets the "api_key" field if the given value is not nil.
-func (akuo *ApiKeyUpdateOne) SetNillableAPIKey(s *string) *ApiKeyUpdateOne {
+// SetNillableName sets the "name" field if the given value is not nil.
+func (akuo *ApiKeyUpdateOne) SetNillableName(s *string) *ApiKeyUpdateOne {
 	if s != nil {
-		akuo.SetAPIKey(*s)
+		akuo.SetName(*s)
 	}
 	return akuo
 }
 
+// SetScopes sets the "scopes" field.
+func (akuo *ApiKeyUpdateOne) SetScopes(s []string) *ApiKeyUpdateOne {
+	akuo.mutation.SetScopes(s)
+	return akuo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (akuo *ApiKeyUpdateOne) AppendScopes(s []string) *ApiKeyUpdateOne {
+	akuo.mutation.AppendScopes(s)
+	return akuo
+}
+
 // SetExpiresOn sets the "expires_on" field.
 func (akuo *ApiKeyUpdateOne) SetExpiresOn(t time.Time) *ApiKeyUpdateOne {
 	akuo.mutation.SetExpiresOn(t)
@@ -271,6 +340,26 @@ func (akuo *ApiKeyUpdateOne) SetNillableExpiresOn(t *time.Time) *ApiKeyUpdateOne
 	return akuo
 }
 
+// SetLastUsedAt sets the "last_used_at" field.
+func (akuo *ApiKeyUpdateOne) SetLastUsedAt(t time.Time) *ApiKeyUpdateOne {
+	akuo.mutation.SetLastUsedAt(t)
+	return akuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (akuo *ApiKeyUpdateOne) SetNillableLastUsedAt(t *time.Time) *ApiKeyUpdateOne {
+	if t != nil {
+		akuo.SetLastUsedAt(*t)
+	}
+	return akuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (akuo *ApiKeyUpdateOne) ClearLastUsedAt() *ApiKeyUpdateOne {
+	akuo.mutation.ClearLastUsedAt()
+	return akuo
+}
+
 // SetOwner sets the "owner" edge to the User entity.
 func (akuo *ApiKeyUpdateOne) SetOwner(u *User) *ApiKeyUpdateOne {
 	return akuo.SetOwnerID(u.ID)
@@ -344,6 +433,16 @@ func (akuo *ApiKeyUpdateOne) defaults() error {
 
 // check runs all checks and user-defined validators on the builder.
 func (akuo *ApiKeyUpdateOne) check() error {
+	if v, ok := akuo.mutation.Name(); ok {
+		if err := apikey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "ApiKey.name": %w`, err)}
+		}
+	}
+	if v, ok := akuo.mutation.Scopes(); ok {
+		if err := apikey.ScopesValidator(v); err != nil {
+			return &ValidationError{Name: "scopes", err: fmt.Errorf(`generated: validator failed for field "ApiKey.scopes": %w`, err)}
+		}
+	}
 	if akuo.mutation.OwnerCleared() && len(akuo.mutation.OwnerIDs()) > 0 {
 		return errors.New(`generated: clearing a required unique edge "ApiKey.owner"`)
 	}
@@ -388,12 +487,26 @@ func (akuo *ApiKeyUpdateOne) sqlSave(ctx context.Context) (_node *ApiKey, err er
 	if value, ok := akuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(apikey.FieldUpdatedAt, field.TypeTime, value)
 	}
-	if value, ok := akuo.mutation.APIKey(); ok {
-		_spec.SetField(apikey.FieldAPIKey, field.TypeString, value)
+	if value, ok := akuo.mutation.Name(); ok {
+		_spec.SetField(apikey.FieldName, field.TypeString, value)
+	}
+	if value, ok := akuo.mutation.Scopes(); ok {
+		_spec.SetField(apikey.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := akuo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apikey.FieldScopes, value)
+		})
 	}
 	if value, ok := akuo.mutation.ExpiresOn(); ok {
 		_spec.SetField(apikey.FieldExpiresOn, field.TypeTime, value)
 	}
+	if value, ok := akuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(apikey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if akuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(apikey.FieldLastUsedAt, field.TypeTime)
+	}
 	if akuo.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	"github.com/google/uuid"
+)
+
+// AwardDefinition is the model entity for the AwardDefinition schema.
+type AwardDefinition struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// emoji or image URL shown alongside the award
+	Icon string `json:"icon,omitempty"`
+	// the rule evaluated to grant the award
+	Rule awarddefinition.Rule `json:"rule,omitempty"`
+	// the post category counted by POSTS_IN_CATEGORY rules
+	RuleCategory *awarddefinition.RuleCategory `json:"rule_category,omitempty"`
+	// the minimum count (or days for MEMBERSHIP_DAYS) required to grant the award
+	RuleThreshold int `json:"rule_threshold,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AwardDefinitionQuery when eager-loading is set.
+	Edges        AwardDefinitionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AwardDefinitionEdges holds the relations/edges for other nodes in the graph.
+type AwardDefinitionEdges struct {
+	// Grants holds the value of the grants edge.
+	Grants []*UserAward `json:"grants,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+
+	namedGrants map[string][]*UserAward
+}
+
+// GrantsOrErr returns the Grants value or an error if the edge
+// was not loaded in eager-loading.
+func (e AwardDefinitionEdges) GrantsOrErr() ([]*UserAward, error) {
+	if e.loadedTypes[0] {
+		return e.Grants, nil
+	}
+	return nil, &NotLoadedError{edge: "grants"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AwardDefinition) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case awarddefinition.FieldRuleThreshold:
+			values[i] = new(sql.NullInt64)
+		case awarddefinition.FieldName, awarddefinition.FieldDescription, awarddefinition.FieldIcon, awarddefinition.FieldRule, awarddefinition.FieldRuleCategory:
+			values[i] = new(sql.NullString)
+		case awarddefinition.FieldUpdatedAt, awarddefinition.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case awarddefinition.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AwardDefinition fields.
+func (ad *AwardDefinition) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case awarddefinition.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ad.ID = *value
+			}
+		case awarddefinition.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ad.UpdatedAt = value.Time
+			}
+		case awarddefinition.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ad.CreatedAt = value.Time
+			}
+		case awarddefinition.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ad.Name = value.String
+			}
+		case awarddefinition.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				ad.Description = value.String
+			}
+		case awarddefinition.FieldIcon:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field icon", values[i])
+			} else if value.Valid {
+				ad.Icon = value.String
+			}
+		case awarddefinition.FieldRule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule", values[i])
+			} else if value.Valid {
+				ad.Rule = awarddefinition.Rule(value.String)
+			}
+		case awarddefinition.FieldRuleCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_category", values[i])
+			} else if value.Valid {
+				ad.RuleCategory = new(awarddefinition.RuleCategory)
+				*ad.RuleCategory = awarddefinition.RuleCategory(value.String)
+			}
+		case awarddefinition.FieldRuleThreshold:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_threshold", values[i])
+			} else if value.Valid {
+				ad.RuleThreshold = int(value.Int64)
+			}
+		default:
+			ad.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AwardDefinition.
+// This includes values selected through modifiers, order, etc.
+func (ad *AwardDefinition) Value(name string) (ent.Value, error) {
+	return ad.selectValues.Get(name)
+}
+
+// QueryGrants queries the "grants" edge of the AwardDefinition entity.
+func (ad *AwardDefinition) QueryGrants() *UserAwardQuery {
+	return NewAwardDefinitionClient(ad.config).QueryGrants(ad)
+}
+
+// Update returns a builder for updating this AwardDefinition.
+// Note that you need to call AwardDefinition.Unwrap() before calling this method if this AwardDefinition
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ad *AwardDefinition) Update() *AwardDefinitionUpdateOne {
+	return NewAwardDefinitionClient(ad.config).UpdateOne(ad)
+}
+
+// Unwrap unwraps the AwardDefinition entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ad *AwardDefinition) Unwrap() *AwardDefinition {
+	_tx, ok := ad.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: AwardDefinition is not a transactional entity")
+	}
+	ad.config.driver = _tx.drv
+	return ad
+}
+
+// String implements the fmt.Stringer.
+func (ad *AwardDefinition) String() string {
+	var builder strings.Builder
+	builder.WriteString("AwardDefinition(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ad.ID))
+	builder.WriteString("updated_at=")
+	builder.WriteString(ad.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ad.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ad.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(ad.Description)
+	builder.WriteString(", ")
+	builder.WriteString("icon=")
+	builder.WriteString(ad.Icon)
+	builder.WriteString(", ")
+	builder.WriteString("rule=")
+	builder.WriteString(fmt.Sprintf("%v", ad.Rule))
+	builder.WriteString(", ")
+	if v := ad.RuleCategory; v != nil {
+		builder.WriteString("rule_category=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("rule_threshold=")
+	builder.WriteString(fmt.Sprintf("%v", ad.RuleThreshold))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedGrants returns the Grants named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (ad *AwardDefinition) NamedGrants(name string) ([]*UserAward, error) {
+	if ad.Edges.namedGrants == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := ad.Edges.namedGrants[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (ad *AwardDefinition) appendNamedGrants(name string, edges ...*UserAward) {
+	if ad.Edges.namedGrants == nil {
+		ad.Edges.namedGrants = make(map[string][]*UserAward)
+	}
+	if len(edges) == 0 {
+		ad.Edges.namedGrants[name] = []*UserAward{}
+	} else {
+		ad.Edges.namedGrants[name] = append(ad.Edges.namedGrants[name], edges...)
+	}
+}
+
+// AwardDefinitions is a parsable slice of AwardDefinition.
+type AwardDefinitions []*AwardDefinition
@@ -0,0 +1,285 @@
+// Code generated by ent, DO NOT EDIT.
+
+package awarddefinition
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the awarddefinition type in the database.
+	Label = "award_definition"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldIcon holds the string denoting the icon field in the database.
+	FieldIcon = "icon"
+	// FieldRule holds the string denoting the rule field in the database.
+	FieldRule = "rule"
+	// FieldRuleCategory holds the string denoting the rule_category field in the database.
+	FieldRuleCategory = "rule_category"
+	// FieldRuleThreshold holds the string denoting the rule_threshold field in the database.
+	FieldRuleThreshold = "rule_threshold"
+	// EdgeGrants holds the string denoting the grants edge name in mutations.
+	EdgeGrants = "grants"
+	// Table holds the table name of the awarddefinition in the database.
+	Table = "award_definitions"
+	// GrantsTable is the table that holds the grants relation/edge.
+	GrantsTable = "user_awards"
+	// GrantsInverseTable is the table name for the UserAward entity.
+	// It exists in this package in order to avoid circular dependency with the "useraward" package.
+	GrantsInverseTable = "user_awards"
+	// GrantsColumn is the table column denoting the grants relation/edge.
+	GrantsColumn = "award_id"
+)
+
+// Columns holds all SQL columns for awarddefinition fields.
+var Columns = []string{
+	FieldID,
+	FieldUpdatedAt,
+	FieldCreatedAt,
+	FieldName,
+	FieldDescription,
+	FieldIcon,
+	FieldRule,
+	FieldRuleCategory,
+	FieldRuleThreshold,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/caliecode/la-clipasa/internal/ent/generated/runtime"
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultRuleThreshold holds the default value on creation for the "rule_threshold" field.
+	DefaultRuleThreshold int
+	// RuleThresholdValidator is a validator for the "rule_threshold" field. It is called by the builders before save.
+	RuleThresholdValidator func(int) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Rule defines the type for the "rule" enum field.
+type Rule string
+
+// Rule values.
+const (
+	RulePOSTS_PUBLISHED   Rule = "POSTS_PUBLISHED"
+	RulePOSTS_IN_CATEGORY Rule = "POSTS_IN_CATEGORY"
+	RuleLIKES_RECEIVED    Rule = "LIKES_RECEIVED"
+	RuleMEMBERSHIP_DAYS   Rule = "MEMBERSHIP_DAYS"
+)
+
+func (r Rule) String() string {
+	return string(r)
+}
+
+// RuleValidator is a validator for the "rule" field enum values. It is called by the builders before save.
+func RuleValidator(r Rule) error {
+	switch r {
+	case RulePOSTS_PUBLISHED, RulePOSTS_IN_CATEGORY, RuleLIKES_RECEIVED, RuleMEMBERSHIP_DAYS:
+		return nil
+	default:
+		return fmt.Errorf("awarddefinition: invalid enum value for rule field: %q", r)
+	}
+}
+
+// AllRules returns all Rule values.
+func AllRules() []Rule {
+	return []Rule{
+		RulePOSTS_PUBLISHED,
+		RulePOSTS_IN_CATEGORY,
+		RuleLIKES_RECEIVED,
+		RuleMEMBERSHIP_DAYS,
+	}
+}
+
+// RuleCategory defines the type for the "rule_category" enum field.
+type RuleCategory string
+
+// RuleCategory values.
+const (
+	RuleCategoryRANA              RuleCategory = "RANA"
+	RuleCategorySIN_SONIDO        RuleCategory = "SIN_SONIDO"
+	RuleCategoryMEME_ARTESANAL    RuleCategory = "MEME_ARTESANAL"
+	RuleCategoryNO_SE_YO          RuleCategory = "NO_SE_YO"
+	RuleCategoryORO               RuleCategory = "ORO"
+	RuleCategoryDIAMANTE          RuleCategory = "DIAMANTE"
+	RuleCategoryMEH               RuleCategory = "MEH"
+	RuleCategoryALERTA_GLONETILLO RuleCategory = "ALERTA_GLONETILLO"
+	RuleCategoryGRR               RuleCategory = "GRR"
+	RuleCategoryENSORDECEDOR      RuleCategory = "ENSORDECEDOR"
+	RuleCategoryRAGUUUL           RuleCategory = "RAGUUUL"
+)
+
+func (rc RuleCategory) String() string {
+	return string(rc)
+}
+
+// RuleCategoryValidator is a validator for the "rule_category" field enum values. It is called by the builders before save.
+func RuleCategoryValidator(rc RuleCategory) error {
+	switch rc {
+	case RuleCategoryRANA, RuleCategorySIN_SONIDO, RuleCategoryMEME_ARTESANAL, RuleCategoryNO_SE_YO, RuleCategoryORO, RuleCategoryDIAMANTE, RuleCategoryMEH, RuleCategoryALERTA_GLONETILLO, RuleCategoryGRR, RuleCategoryENSORDECEDOR, RuleCategoryRAGUUUL:
+		return nil
+	default:
+		return fmt.Errorf("awarddefinition: invalid enum value for rule_category field: %q", rc)
+	}
+}
+
+// AllRuleCategories returns all RuleCategory values.
+func AllRuleCategories() []RuleCategory {
+	return []RuleCategory{
+		RuleCategoryRANA,
+		RuleCategorySIN_SONIDO,
+		RuleCategoryMEME_ARTESANAL,
+		RuleCategoryNO_SE_YO,
+		RuleCategoryORO,
+		RuleCategoryDIAMANTE,
+		RuleCategoryMEH,
+		RuleCategoryALERTA_GLONETILLO,
+		RuleCategoryGRR,
+		RuleCategoryENSORDECEDOR,
+		RuleCategoryRAGUUUL,
+	}
+}
+
+// OrderOption defines the ordering options for the AwardDefinition queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByIcon orders the results by the icon field.
+func ByIcon(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIcon, opts...).ToFunc()
+}
+
+// ByRule orders the results by the rule field.
+func ByRule(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRule, opts...).ToFunc()
+}
+
+// ByRuleCategory orders the results by the rule_category field.
+func ByRuleCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRuleCategory, opts...).ToFunc()
+}
+
+// ByRuleThreshold orders the results by the rule_threshold field.
+func ByRuleThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRuleThreshold, opts...).ToFunc()
+}
+
+// ByGrantsCount orders the results by grants count.
+func ByGrantsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGrantsStep(), opts...)
+	}
+}
+
+// ByGrants orders the results by grants terms.
+func ByGrants(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGrantsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newGrantsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GrantsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, GrantsTable, GrantsColumn),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Rule) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Rule) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Rule(str)
+	if err := RuleValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Rule", str)
+	}
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e RuleCategory) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *RuleCategory) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = RuleCategory(str)
+	if err := RuleCategoryValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid RuleCategory", str)
+	}
+	return nil
+}
@@ -0,0 +1,500 @@
+// Code generated by ent, DO NOT EDIT.
+
+package awarddefinition
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldLTE(FieldID, id))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldEQ(FieldDescription, v))
+}
+
+// Icon applies equality check predicate on the "icon" field. It's identical to IconEQ.
+func Icon(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldEQ(FieldIcon, v))
+}
+
+// RuleThreshold applies equality check predicate on the "rule_threshold" field. It's identical to RuleThresholdEQ.
+func RuleThreshold(v int) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldEQ(FieldRuleThreshold, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// IconEQ applies the EQ predicate on the "icon" field.
+func IconEQ(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldEQ(FieldIcon, v))
+}
+
+// IconNEQ applies the NEQ predicate on the "icon" field.
+func IconNEQ(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldNEQ(FieldIcon, v))
+}
+
+// IconIn applies the In predicate on the "icon" field.
+func IconIn(vs ...string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldIn(FieldIcon, vs...))
+}
+
+// IconNotIn applies the NotIn predicate on the "icon" field.
+func IconNotIn(vs ...string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldNotIn(FieldIcon, vs...))
+}
+
+// IconGT applies the GT predicate on the "icon" field.
+func IconGT(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldGT(FieldIcon, v))
+}
+
+// IconGTE applies the GTE predicate on the "icon" field.
+func IconGTE(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldGTE(FieldIcon, v))
+}
+
+// IconLT applies the LT predicate on the "icon" field.
+func IconLT(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldLT(FieldIcon, v))
+}
+
+// IconLTE applies the LTE predicate on the "icon" field.
+func IconLTE(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldLTE(FieldIcon, v))
+}
+
+// IconContains applies the Contains predicate on the "icon" field.
+func IconContains(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldContains(FieldIcon, v))
+}
+
+// IconHasPrefix applies the HasPrefix predicate on the "icon" field.
+func IconHasPrefix(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldHasPrefix(FieldIcon, v))
+}
+
+// IconHasSuffix applies the HasSuffix predicate on the "icon" field.
+func IconHasSuffix(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldHasSuffix(FieldIcon, v))
+}
+
+// IconEqualFold applies the EqualFold predicate on the "icon" field.
+func IconEqualFold(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldEqualFold(FieldIcon, v))
+}
+
+// IconContainsFold applies the ContainsFold predicate on the "icon" field.
+func IconContainsFold(v string) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldContainsFold(FieldIcon, v))
+}
+
+// RuleEQ applies the EQ predicate on the "rule" field.
+func RuleEQ(v Rule) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldEQ(FieldRule, v))
+}
+
+// RuleNEQ applies the NEQ predicate on the "rule" field.
+func RuleNEQ(v Rule) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldNEQ(FieldRule, v))
+}
+
+// RuleIn applies the In predicate on the "rule" field.
+func RuleIn(vs ...Rule) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldIn(FieldRule, vs...))
+}
+
+// RuleNotIn applies the NotIn predicate on the "rule" field.
+func RuleNotIn(vs ...Rule) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldNotIn(FieldRule, vs...))
+}
+
+// RuleCategoryEQ applies the EQ predicate on the "rule_category" field.
+func RuleCategoryEQ(v RuleCategory) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldEQ(FieldRuleCategory, v))
+}
+
+// RuleCategoryNEQ applies the NEQ predicate on the "rule_category" field.
+func RuleCategoryNEQ(v RuleCategory) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldNEQ(FieldRuleCategory, v))
+}
+
+// RuleCategoryIn applies the In predicate on the "rule_category" field.
+func RuleCategoryIn(vs ...RuleCategory) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldIn(FieldRuleCategory, vs...))
+}
+
+// RuleCategoryNotIn applies the NotIn predicate on the "rule_category" field.
+func RuleCategoryNotIn(vs ...RuleCategory) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldNotIn(FieldRuleCategory, vs...))
+}
+
+// RuleCategoryIsNil applies the IsNil predicate on the "rule_category" field.
+func RuleCategoryIsNil() predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldIsNull(FieldRuleCategory))
+}
+
+// RuleCategoryNotNil applies the NotNil predicate on the "rule_category" field.
+func RuleCategoryNotNil() predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldNotNull(FieldRuleCategory))
+}
+
+// RuleThresholdEQ applies the EQ predicate on the "rule_threshold" field.
+func RuleThresholdEQ(v int) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldEQ(FieldRuleThreshold, v))
+}
+
+// RuleThresholdNEQ applies the NEQ predicate on the "rule_threshold" field.
+func RuleThresholdNEQ(v int) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldNEQ(FieldRuleThreshold, v))
+}
+
+// RuleThresholdIn applies the In predicate on the "rule_threshold" field.
+func RuleThresholdIn(vs ...int) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldIn(FieldRuleThreshold, vs...))
+}
+
+// RuleThresholdNotIn applies the NotIn predicate on the "rule_threshold" field.
+func RuleThresholdNotIn(vs ...int) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldNotIn(FieldRuleThreshold, vs...))
+}
+
+// RuleThresholdGT applies the GT predicate on the "rule_threshold" field.
+func RuleThresholdGT(v int) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldGT(FieldRuleThreshold, v))
+}
+
+// RuleThresholdGTE applies the GTE predicate on the "rule_threshold" field.
+func RuleThresholdGTE(v int) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldGTE(FieldRuleThreshold, v))
+}
+
+// RuleThresholdLT applies the LT predicate on the "rule_threshold" field.
+func RuleThresholdLT(v int) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldLT(FieldRuleThreshold, v))
+}
+
+// RuleThresholdLTE applies the LTE predicate on the "rule_threshold" field.
+func RuleThresholdLTE(v int) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.FieldLTE(FieldRuleThreshold, v))
+}
+
+// HasGrants applies the HasEdge predicate on the "grants" edge.
+func HasGrants() predicate.AwardDefinition {
+	return predicate.AwardDefinition(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, GrantsTable, GrantsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGrantsWith applies the HasEdge predicate on the "grants" edge with a given conditions (other predicates).
+func HasGrantsWith(preds ...predicate.UserAward) predicate.AwardDefinition {
+	return predicate.AwardDefinition(func(s *sql.Selector) {
+		step := newGrantsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AwardDefinition) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AwardDefinition) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AwardDefinition) predicate.AwardDefinition {
+	return predicate.AwardDefinition(sql.NotPredicates(p))
+}
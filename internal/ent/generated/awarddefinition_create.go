@@ -0,0 +1,1016 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
+	"github.com/google/uuid"
+)
+
+// AwardDefinitionCreate is the builder for creating a AwardDefinition entity.
+type AwardDefinitionCreate struct {
+	config
+	mutation *AwardDefinitionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (adc *AwardDefinitionCreate) SetUpdatedAt(t time.Time) *AwardDefinitionCreate {
+	adc.mutation.SetUpdatedAt(t)
+	return adc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (adc *AwardDefinitionCreate) SetNillableUpdatedAt(t *time.Time) *AwardDefinitionCreate {
+	if t != nil {
+		adc.SetUpdatedAt(*t)
+	}
+	return adc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (adc *AwardDefinitionCreate) SetCreatedAt(t time.Time) *AwardDefinitionCreate {
+	adc.mutation.SetCreatedAt(t)
+	return adc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (adc *AwardDefinitionCreate) SetNillableCreatedAt(t *time.Time) *AwardDefinitionCreate {
+	if t != nil {
+		adc.SetCreatedAt(*t)
+	}
+	return adc
+}
+
+// SetName sets the "name" field.
+func (adc *AwardDefinitionCreate) SetName(s string) *AwardDefinitionCreate {
+	adc.mutation.SetName(s)
+	return adc
+}
+
+// SetDescription sets the "description" field.
+func (adc *AwardDefinitionCreate) SetDescription(s string) *AwardDefinitionCreate {
+	adc.mutation.SetDescription(s)
+	return adc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (adc *AwardDefinitionCreate) SetNillableDescription(s *string) *AwardDefinitionCreate {
+	if s != nil {
+		adc.SetDescription(*s)
+	}
+	return adc
+}
+
+// SetIcon sets the "icon" field.
+func (adc *AwardDefinitionCreate) SetIcon(s string) *AwardDefinitionCreate {
+	adc.mutation.SetIcon(s)
+	return adc
+}
+
+// SetRule sets the "rule" field.
+func (adc *AwardDefinitionCreate) SetRule(a awarddefinition.Rule) *AwardDefinitionCreate {
+	adc.mutation.SetRule(a)
+	return adc
+}
+
+// SetRuleCategory sets the "rule_category" field.
+func (adc *AwardDefinitionCreate) SetRuleCategory(ac awarddefinition.RuleCategory) *AwardDefinitionCreate {
+	adc.mutation.SetRuleCategory(ac)
+	return adc
+}
+
+// SetNillableRuleCategory sets the "rule_category" field if the given value is not nil.
+func (adc *AwardDefinitionCreate) SetNillableRuleCategory(ac *awarddefinition.RuleCategory) *AwardDefinitionCreate {
+	if ac != nil {
+		adc.SetRuleCategory(*ac)
+	}
+	return adc
+}
+
+// SetRuleThreshold sets the "rule_threshold" field.
+func (adc *AwardDefinitionCreate) SetRuleThreshold(i int) *AwardDefinitionCreate {
+	adc.mutation.SetRuleThreshold(i)
+	return adc
+}
+
+// SetNillableRuleThreshold sets the "rule_threshold" field if the given value is not nil.
+func (adc *AwardDefinitionCreate) SetNillableRuleThreshold(i *int) *AwardDefinitionCreate {
+	if i != nil {
+		adc.SetRuleThreshold(*i)
+	}
+	return adc
+}
+
+// SetID sets the "id" field.
+func (adc *AwardDefinitionCreate) SetID(u uuid.UUID) *AwardDefinitionCreate {
+	adc.mutation.SetID(u)
+	return adc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (adc *AwardDefinitionCreate) SetNillableID(u *uuid.UUID) *AwardDefinitionCreate {
+	if u != nil {
+		adc.SetID(*u)
+	}
+	return adc
+}
+
+// AddGrantIDs adds the "grants" edge to the UserAward entity by IDs.
+func (adc *AwardDefinitionCreate) AddGrantIDs(ids ...uuid.UUID) *AwardDefinitionCreate {
+	adc.mutation.AddGrantIDs(ids...)
+	return adc
+}
+
+// AddGrants adds the "grants" edges to the UserAward entity.
+func (adc *AwardDefinitionCreate) AddGrants(u ...*UserAward) *AwardDefinitionCreate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return adc.AddGrantIDs(ids...)
+}
+
+// Mutation returns the AwardDefinitionMutation object of the builder.
+func (adc *AwardDefinitionCreate) Mutation() *AwardDefinitionMutation {
+	return adc.mutation
+}
+
+// Save creates the AwardDefinition in the database.
+func (adc *AwardDefinitionCreate) Save(ctx context.Context) (*AwardDefinition, error) {
+	if err := adc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, adc.sqlSave, adc.mutation, adc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (adc *AwardDefinitionCreate) SaveX(ctx context.Context) *AwardDefinition {
+	v, err := adc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (adc *AwardDefinitionCreate) Exec(ctx context.Context) error {
+	_, err := adc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (adc *AwardDefinitionCreate) ExecX(ctx context.Context) {
+	if err := adc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (adc *AwardDefinitionCreate) defaults() error {
+	if _, ok := adc.mutation.UpdatedAt(); !ok {
+		if awarddefinition.DefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized awarddefinition.DefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := awarddefinition.DefaultUpdatedAt()
+		adc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := adc.mutation.CreatedAt(); !ok {
+		if awarddefinition.DefaultCreatedAt == nil {
+			return fmt.Errorf("generated: uninitialized awarddefinition.DefaultCreatedAt (forgotten import generated/runtime?)")
+		}
+		v := awarddefinition.DefaultCreatedAt()
+		adc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := adc.mutation.RuleThreshold(); !ok {
+		v := awarddefinition.DefaultRuleThreshold
+		adc.mutation.SetRuleThreshold(v)
+	}
+	if _, ok := adc.mutation.ID(); !ok {
+		if awarddefinition.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized awarddefinition.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := awarddefinition.DefaultID()
+		adc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (adc *AwardDefinitionCreate) check() error {
+	if _, ok := adc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "AwardDefinition.updated_at"`)}
+	}
+	if _, ok := adc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "AwardDefinition.created_at"`)}
+	}
+	if _, ok := adc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`generated: missing required field "AwardDefinition.name"`)}
+	}
+	if v, ok := adc.mutation.Name(); ok {
+		if err := awarddefinition.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "AwardDefinition.name": %w`, err)}
+		}
+	}
+	if _, ok := adc.mutation.Icon(); !ok {
+		return &ValidationError{Name: "icon", err: errors.New(`generated: missing required field "AwardDefinition.icon"`)}
+	}
+	if _, ok := adc.mutation.Rule(); !ok {
+		return &ValidationError{Name: "rule", err: errors.New(`generated: missing required field "AwardDefinition.rule"`)}
+	}
+	if v, ok := adc.mutation.Rule(); ok {
+		if err := awarddefinition.RuleValidator(v); err != nil {
+			return &ValidationError{Name: "rule", err: fmt.Errorf(`generated: validator failed for field "AwardDefinition.rule": %w`, err)}
+		}
+	}
+	if v, ok := adc.mutation.RuleCategory(); ok {
+		if err := awarddefinition.RuleCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "rule_category", err: fmt.Errorf(`generated: validator failed for field "AwardDefinition.rule_category": %w`, err)}
+		}
+	}
+	if _, ok := adc.mutation.RuleThreshold(); !ok {
+		return &ValidationError{Name: "rule_threshold", err: errors.New(`generated: missing required field "AwardDefinition.rule_threshold"`)}
+	}
+	if v, ok := adc.mutation.RuleThreshold(); ok {
+		if err := awarddefinition.RuleThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "rule_threshold", err: fmt.Errorf(`generated: validator failed for field "AwardDefinition.rule_threshold": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (adc *AwardDefinitionCreate) sqlSave(ctx context.Context) (*AwardDefinition, error) {
+	if err := adc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := adc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, adc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	adc.mutation.id = &_node.ID
+	adc.mutation.done = true
+	return _node, nil
+}
+
+func (adc *AwardDefinitionCreate) createSpec() (*AwardDefinition, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AwardDefinition{config: adc.config}
+		_spec = sqlgraph.NewCreateSpec(awarddefinition.Table, sqlgraph.NewFieldSpec(awarddefinition.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = adc.conflict
+	if id, ok := adc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := adc.mutation.UpdatedAt(); ok {
+		_spec.SetField(awarddefinition.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := adc.mutation.CreatedAt(); ok {
+		_spec.SetField(awarddefinition.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := adc.mutation.Name(); ok {
+		_spec.SetField(awarddefinition.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := adc.mutation.Description(); ok {
+		_spec.SetField(awarddefinition.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := adc.mutation.Icon(); ok {
+		_spec.SetField(awarddefinition.FieldIcon, field.TypeString, value)
+		_node.Icon = value
+	}
+	if value, ok := adc.mutation.Rule(); ok {
+		_spec.SetField(awarddefinition.FieldRule, field.TypeEnum, value)
+		_node.Rule = value
+	}
+	if value, ok := adc.mutation.RuleCategory(); ok {
+		_spec.SetField(awarddefinition.FieldRuleCategory, field.TypeEnum, value)
+		_node.RuleCategory = &value
+	}
+	if value, ok := adc.mutation.RuleThreshold(); ok {
+		_spec.SetField(awarddefinition.FieldRuleThreshold, field.TypeInt, value)
+		_node.RuleThreshold = value
+	}
+	if nodes := adc.mutation.GrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   awarddefinition.GrantsTable,
+			Columns: []string{awarddefinition.GrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useraward.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AwardDefinition.Create().
+//		SetUpdatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AwardDefinitionUpsert) {
+//			SetUpdatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (adc *AwardDefinitionCreate) OnConflict(opts ...sql.ConflictOption) *AwardDefinitionUpsertOne {
+	adc.conflict = opts
+	return &AwardDefinitionUpsertOne{
+		create: adc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AwardDefinition.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (adc *AwardDefinitionCreate) OnConflictColumns(columns ...string) *AwardDefinitionUpsertOne {
+	adc.conflict = append(adc.conflict, sql.ConflictColumns(columns...))
+	return &AwardDefinitionUpsertOne{
+		create: adc,
+	}
+}
+
+type (
+	// AwardDefinitionUpsertOne is the builder for "upsert"-ing
+	//  one AwardDefinition node.
+	AwardDefinitionUpsertOne struct {
+		create *AwardDefinitionCreate
+	}
+
+	// AwardDefinitionUpsert is the "OnConflict" setter.
+	AwardDefinitionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AwardDefinitionUpsert) SetUpdatedAt(v time.Time) *AwardDefinitionUpsert {
+	u.Set(awarddefinition.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AwardDefinitionUpsert) UpdateUpdatedAt() *AwardDefinitionUpsert {
+	u.SetExcluded(awarddefinition.FieldUpdatedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *AwardDefinitionUpsert) SetName(v string) *AwardDefinitionUpsert {
+	u.Set(awarddefinition.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AwardDefinitionUpsert) UpdateName() *AwardDefinitionUpsert {
+	u.SetExcluded(awarddefinition.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *AwardDefinitionUpsert) SetDescription(v string) *AwardDefinitionUpsert {
+	u.Set(awarddefinition.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *AwardDefinitionUpsert) UpdateDescription() *AwardDefinitionUpsert {
+	u.SetExcluded(awarddefinition.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *AwardDefinitionUpsert) ClearDescription() *AwardDefinitionUpsert {
+	u.SetNull(awarddefinition.FieldDescription)
+	return u
+}
+
+// SetIcon sets the "icon" field.
+func (u *AwardDefinitionUpsert) SetIcon(v string) *AwardDefinitionUpsert {
+	u.Set(awarddefinition.FieldIcon, v)
+	return u
+}
+
+// UpdateIcon sets the "icon" field to the value that was provided on create.
+func (u *AwardDefinitionUpsert) UpdateIcon() *AwardDefinitionUpsert {
+	u.SetExcluded(awarddefinition.FieldIcon)
+	return u
+}
+
+// SetRule sets the "rule" field.
+func (u *AwardDefinitionUpsert) SetRule(v awarddefinition.Rule) *AwardDefinitionUpsert {
+	u.Set(awarddefinition.FieldRule, v)
+	return u
+}
+
+// UpdateRule sets the "rule" field to the value that was provided on create.
+func (u *AwardDefinitionUpsert) UpdateRule() *AwardDefinitionUpsert {
+	u.SetExcluded(awarddefinition.FieldRule)
+	return u
+}
+
+// SetRuleCategory sets the "rule_category" field.
+func (u *AwardDefinitionUpsert) SetRuleCategory(v awarddefinition.RuleCategory) *AwardDefinitionUpsert {
+	u.Set(awarddefinition.FieldRuleCategory, v)
+	return u
+}
+
+// UpdateRuleCategory sets the "rule_category" field to the value that was provided on create.
+func (u *AwardDefinitionUpsert) UpdateRuleCategory() *AwardDefinitionUpsert {
+	u.SetExcluded(awarddefinition.FieldRuleCategory)
+	return u
+}
+
+// ClearRuleCategory clears the value of the "rule_category" field.
+func (u *AwardDefinitionUpsert) ClearRuleCategory() *AwardDefinitionUpsert {
+	u.SetNull(awarddefinition.FieldRuleCategory)
+	return u
+}
+
+// SetRuleThreshold sets the "rule_threshold" field.
+func (u *AwardDefinitionUpsert) SetRuleThreshold(v int) *AwardDefinitionUpsert {
+	u.Set(awarddefinition.FieldRuleThreshold, v)
+	return u
+}
+
+// UpdateRuleThreshold sets the "rule_threshold" field to the value that was provided on create.
+func (u *AwardDefinitionUpsert) UpdateRuleThreshold() *AwardDefinitionUpsert {
+	u.SetExcluded(awarddefinition.FieldRuleThreshold)
+	return u
+}
+
+// AddRuleThreshold adds v to the "rule_threshold" field.
+func (u *AwardDefinitionUpsert) AddRuleThreshold(v int) *AwardDefinitionUpsert {
+	u.Add(awarddefinition.FieldRuleThreshold, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AwardDefinition.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(awarddefinition.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AwardDefinitionUpsertOne) UpdateNewValues() *AwardDefinitionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(awarddefinition.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(awarddefinition.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AwardDefinition.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AwardDefinitionUpsertOne) Ignore() *AwardDefinitionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AwardDefinitionUpsertOne) DoNothing() *AwardDefinitionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AwardDefinitionCreate.OnConflict
+// documentation for more info.
+func (u *AwardDefinitionUpsertOne) Update(set func(*AwardDefinitionUpsert)) *AwardDefinitionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AwardDefinitionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AwardDefinitionUpsertOne) SetUpdatedAt(v time.Time) *AwardDefinitionUpsertOne {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AwardDefinitionUpsertOne) UpdateUpdatedAt() *AwardDefinitionUpsertOne {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *AwardDefinitionUpsertOne) SetName(v string) *AwardDefinitionUpsertOne {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AwardDefinitionUpsertOne) UpdateName() *AwardDefinitionUpsertOne {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *AwardDefinitionUpsertOne) SetDescription(v string) *AwardDefinitionUpsertOne {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *AwardDefinitionUpsertOne) UpdateDescription() *AwardDefinitionUpsertOne {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *AwardDefinitionUpsertOne) ClearDescription() *AwardDefinitionUpsertOne {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetIcon sets the "icon" field.
+func (u *AwardDefinitionUpsertOne) SetIcon(v string) *AwardDefinitionUpsertOne {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.SetIcon(v)
+	})
+}
+
+// UpdateIcon sets the "icon" field to the value that was provided on create.
+func (u *AwardDefinitionUpsertOne) UpdateIcon() *AwardDefinitionUpsertOne {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.UpdateIcon()
+	})
+}
+
+// SetRule sets the "rule" field.
+func (u *AwardDefinitionUpsertOne) SetRule(v awarddefinition.Rule) *AwardDefinitionUpsertOne {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.SetRule(v)
+	})
+}
+
+// UpdateRule sets the "rule" field to the value that was provided on create.
+func (u *AwardDefinitionUpsertOne) UpdateRule() *AwardDefinitionUpsertOne {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.UpdateRule()
+	})
+}
+
+// SetRuleCategory sets the "rule_category" field.
+func (u *AwardDefinitionUpsertOne) SetRuleCategory(v awarddefinition.RuleCategory) *AwardDefinitionUpsertOne {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.SetRuleCategory(v)
+	})
+}
+
+// UpdateRuleCategory sets the "rule_category" field to the value that was provided on create.
+func (u *AwardDefinitionUpsertOne) UpdateRuleCategory() *AwardDefinitionUpsertOne {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.UpdateRuleCategory()
+	})
+}
+
+// ClearRuleCategory clears the value of the "rule_category" field.
+func (u *AwardDefinitionUpsertOne) ClearRuleCategory() *AwardDefinitionUpsertOne {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.ClearRuleCategory()
+	})
+}
+
+// SetRuleThreshold sets the "rule_threshold" field.
+func (u *AwardDefinitionUpsertOne) SetRuleThreshold(v int) *AwardDefinitionUpsertOne {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.SetRuleThreshold(v)
+	})
+}
+
+// AddRuleThreshold adds v to the "rule_threshold" field.
+func (u *AwardDefinitionUpsertOne) AddRuleThreshold(v int) *AwardDefinitionUpsertOne {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.AddRuleThreshold(v)
+	})
+}
+
+// UpdateRuleThreshold sets the "rule_threshold" field to the value that was provided on create.
+func (u *AwardDefinitionUpsertOne) UpdateRuleThreshold() *AwardDefinitionUpsertOne {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.UpdateRuleThreshold()
+	})
+}
+
+// Exec executes the query.
+func (u *AwardDefinitionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for AwardDefinitionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AwardDefinitionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AwardDefinitionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: AwardDefinitionUpsertOne.ID is not supported by MySQL driver. Use AwardDefinitionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AwardDefinitionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AwardDefinitionCreateBulk is the builder for creating many AwardDefinition entities in bulk.
+type AwardDefinitionCreateBulk struct {
+	config
+	err      error
+	builders []*AwardDefinitionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AwardDefinition entities in the database.
+func (adcb *AwardDefinitionCreateBulk) Save(ctx context.Context) ([]*AwardDefinition, error) {
+	if adcb.err != nil {
+		return nil, adcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(adcb.builders))
+	nodes := make([]*AwardDefinition, len(adcb.builders))
+	mutators := make([]Mutator, len(adcb.builders))
+	for i := range adcb.builders {
+		func(i int, root context.Context) {
+			builder := adcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AwardDefinitionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, adcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = adcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, adcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, adcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (adcb *AwardDefinitionCreateBulk) SaveX(ctx context.Context) []*AwardDefinition {
+	v, err := adcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (adcb *AwardDefinitionCreateBulk) Exec(ctx context.Context) error {
+	_, err := adcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (adcb *AwardDefinitionCreateBulk) ExecX(ctx context.Context) {
+	if err := adcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AwardDefinition.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AwardDefinitionUpsert) {
+//			SetUpdatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (adcb *AwardDefinitionCreateBulk) OnConflict(opts ...sql.ConflictOption) *AwardDefinitionUpsertBulk {
+	adcb.conflict = opts
+	return &AwardDefinitionUpsertBulk{
+		create: adcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AwardDefinition.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (adcb *AwardDefinitionCreateBulk) OnConflictColumns(columns ...string) *AwardDefinitionUpsertBulk {
+	adcb.conflict = append(adcb.conflict, sql.ConflictColumns(columns...))
+	return &AwardDefinitionUpsertBulk{
+		create: adcb,
+	}
+}
+
+// AwardDefinitionUpsertBulk is the builder for "upsert"-ing
+// a bulk of AwardDefinition nodes.
+type AwardDefinitionUpsertBulk struct {
+	create *AwardDefinitionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AwardDefinition.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(awarddefinition.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AwardDefinitionUpsertBulk) UpdateNewValues() *AwardDefinitionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(awarddefinition.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(awarddefinition.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AwardDefinition.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AwardDefinitionUpsertBulk) Ignore() *AwardDefinitionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AwardDefinitionUpsertBulk) DoNothing() *AwardDefinitionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AwardDefinitionCreateBulk.OnConflict
+// documentation for more info.
+func (u *AwardDefinitionUpsertBulk) Update(set func(*AwardDefinitionUpsert)) *AwardDefinitionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AwardDefinitionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AwardDefinitionUpsertBulk) SetUpdatedAt(v time.Time) *AwardDefinitionUpsertBulk {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AwardDefinitionUpsertBulk) UpdateUpdatedAt() *AwardDefinitionUpsertBulk {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *AwardDefinitionUpsertBulk) SetName(v string) *AwardDefinitionUpsertBulk {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AwardDefinitionUpsertBulk) UpdateName() *AwardDefinitionUpsertBulk {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *AwardDefinitionUpsertBulk) SetDescription(v string) *AwardDefinitionUpsertBulk {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *AwardDefinitionUpsertBulk) UpdateDescription() *AwardDefinitionUpsertBulk {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *AwardDefinitionUpsertBulk) ClearDescription() *AwardDefinitionUpsertBulk {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetIcon sets the "icon" field.
+func (u *AwardDefinitionUpsertBulk) SetIcon(v string) *AwardDefinitionUpsertBulk {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.SetIcon(v)
+	})
+}
+
+// UpdateIcon sets the "icon" field to the value that was provided on create.
+func (u *AwardDefinitionUpsertBulk) UpdateIcon() *AwardDefinitionUpsertBulk {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.UpdateIcon()
+	})
+}
+
+// SetRule sets the "rule" field.
+func (u *AwardDefinitionUpsertBulk) SetRule(v awarddefinition.Rule) *AwardDefinitionUpsertBulk {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.SetRule(v)
+	})
+}
+
+// UpdateRule sets the "rule" field to the value that was provided on create.
+func (u *AwardDefinitionUpsertBulk) UpdateRule() *AwardDefinitionUpsertBulk {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.UpdateRule()
+	})
+}
+
+// SetRuleCategory sets the "rule_category" field.
+func (u *AwardDefinitionUpsertBulk) SetRuleCategory(v awarddefinition.RuleCategory) *AwardDefinitionUpsertBulk {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.SetRuleCategory(v)
+	})
+}
+
+// UpdateRuleCategory sets the "rule_category" field to the value that was provided on create.
+func (u *AwardDefinitionUpsertBulk) UpdateRuleCategory() *AwardDefinitionUpsertBulk {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.UpdateRuleCategory()
+	})
+}
+
+// ClearRuleCategory clears the value of the "rule_category" field.
+func (u *AwardDefinitionUpsertBulk) ClearRuleCategory() *AwardDefinitionUpsertBulk {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.ClearRuleCategory()
+	})
+}
+
+// SetRuleThreshold sets the "rule_threshold" field.
+func (u *AwardDefinitionUpsertBulk) SetRuleThreshold(v int) *AwardDefinitionUpsertBulk {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.SetRuleThreshold(v)
+	})
+}
+
+// AddRuleThreshold adds v to the "rule_threshold" field.
+func (u *AwardDefinitionUpsertBulk) AddRuleThreshold(v int) *AwardDefinitionUpsertBulk {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.AddRuleThreshold(v)
+	})
+}
+
+// UpdateRuleThreshold sets the "rule_threshold" field to the value that was provided on create.
+func (u *AwardDefinitionUpsertBulk) UpdateRuleThreshold() *AwardDefinitionUpsertBulk {
+	return u.Update(func(s *AwardDefinitionUpsert) {
+		s.UpdateRuleThreshold()
+	})
+}
+
+// Exec executes the query.
+func (u *AwardDefinitionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the AwardDefinitionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for AwardDefinitionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AwardDefinitionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
+)
+
+// AwardDefinitionDelete is the builder for deleting a AwardDefinition entity.
+type AwardDefinitionDelete struct {
+	config
+	hooks    []Hook
+	mutation *AwardDefinitionMutation
+}
+
+// Where appends a list predicates to the AwardDefinitionDelete builder.
+func (add *AwardDefinitionDelete) Where(ps ...predicate.AwardDefinition) *AwardDefinitionDelete {
+	add.mutation.Where(ps...)
+	return add
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (add *AwardDefinitionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, add.sqlExec, add.mutation, add.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (add *AwardDefinitionDelete) ExecX(ctx context.Context) int {
+	n, err := add.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (add *AwardDefinitionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(awarddefinition.Table, sqlgraph.NewFieldSpec(awarddefinition.FieldID, field.TypeUUID))
+	if ps := add.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, add.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	add.mutation.done = true
+	return affected, err
+}
+
+// AwardDefinitionDeleteOne is the builder for deleting a single AwardDefinition entity.
+type AwardDefinitionDeleteOne struct {
+	add *AwardDefinitionDelete
+}
+
+// Where appends a list predicates to the AwardDefinitionDelete builder.
+func (addo *AwardDefinitionDeleteOne) Where(ps ...predicate.AwardDefinition) *AwardDefinitionDeleteOne {
+	addo.add.mutation.Where(ps...)
+	return addo
+}
+
+// Exec executes the deletion query.
+func (addo *AwardDefinitionDeleteOne) Exec(ctx context.Context) error {
+	n, err := addo.add.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{awarddefinition.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (addo *AwardDefinitionDeleteOne) ExecX(ctx context.Context) {
+	if err := addo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,692 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
+	"github.com/google/uuid"
+)
+
+// AwardDefinitionQuery is the builder for querying AwardDefinition entities.
+type AwardDefinitionQuery struct {
+	config
+	ctx             *QueryContext
+	order           []awarddefinition.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.AwardDefinition
+	withGrants      *UserAwardQuery
+	loadTotal       []func(context.Context, []*AwardDefinition) error
+	modifiers       []func(*sql.Selector)
+	withNamedGrants map[string]*UserAwardQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AwardDefinitionQuery builder.
+func (adq *AwardDefinitionQuery) Where(ps ...predicate.AwardDefinition) *AwardDefinitionQuery {
+	adq.predicates = append(adq.predicates, ps...)
+	return adq
+}
+
+// Limit the number of records to be returned by this query.
+func (adq *AwardDefinitionQuery) Limit(limit int) *AwardDefinitionQuery {
+	adq.ctx.Limit = &limit
+	return adq
+}
+
+// Offset to start from.
+func (adq *AwardDefinitionQuery) Offset(offset int) *AwardDefinitionQuery {
+	adq.ctx.Offset = &offset
+	return adq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (adq *AwardDefinitionQuery) Unique(unique bool) *AwardDefinitionQuery {
+	adq.ctx.Unique = &unique
+	return adq
+}
+
+// Order specifies how the records should be ordered.
+func (adq *AwardDefinitionQuery) Order(o ...awarddefinition.OrderOption) *AwardDefinitionQuery {
+	adq.order = append(adq.order, o...)
+	return adq
+}
+
+// QueryGrants chains the current query on the "grants" edge.
+func (adq *AwardDefinitionQuery) QueryGrants() *UserAwardQuery {
+	query := (&UserAwardClient{config: adq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := adq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := adq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(awarddefinition.Table, awarddefinition.FieldID, selector),
+			sqlgraph.To(useraward.Table, useraward.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, awarddefinition.GrantsTable, awarddefinition.GrantsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(adq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first AwardDefinition entity from the query.
+// Returns a *NotFoundError when no AwardDefinition was found.
+func (adq *AwardDefinitionQuery) First(ctx context.Context) (*AwardDefinition, error) {
+	nodes, err := adq.Limit(1).All(setContextOp(ctx, adq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{awarddefinition.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (adq *AwardDefinitionQuery) FirstX(ctx context.Context) *AwardDefinition {
+	node, err := adq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AwardDefinition ID from the query.
+// Returns a *NotFoundError when no AwardDefinition ID was found.
+func (adq *AwardDefinitionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = adq.Limit(1).IDs(setContextOp(ctx, adq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{awarddefinition.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (adq *AwardDefinitionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := adq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AwardDefinition entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AwardDefinition entity is found.
+// Returns a *NotFoundError when no AwardDefinition entities are found.
+func (adq *AwardDefinitionQuery) Only(ctx context.Context) (*AwardDefinition, error) {
+	nodes, err := adq.Limit(2).All(setContextOp(ctx, adq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{awarddefinition.Label}
+	default:
+		return nil, &NotSingularError{awarddefinition.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (adq *AwardDefinitionQuery) OnlyX(ctx context.Context) *AwardDefinition {
+	node, err := adq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AwardDefinition ID in the query.
+// Returns a *NotSingularError when more than one AwardDefinition ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (adq *AwardDefinitionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = adq.Limit(2).IDs(setContextOp(ctx, adq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{awarddefinition.Label}
+	default:
+		err = &NotSingularError{awarddefinition.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (adq *AwardDefinitionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := adq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AwardDefinitions.
+func (adq *AwardDefinitionQuery) All(ctx context.Context) ([]*AwardDefinition, error) {
+	ctx = setContextOp(ctx, adq.ctx, ent.OpQueryAll)
+	if err := adq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AwardDefinition, *AwardDefinitionQuery]()
+	return withInterceptors[[]*AwardDefinition](ctx, adq, qr, adq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (adq *AwardDefinitionQuery) AllX(ctx context.Context) []*AwardDefinition {
+	nodes, err := adq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AwardDefinition IDs.
+func (adq *AwardDefinitionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if adq.ctx.Unique == nil && adq.path != nil {
+		adq.Unique(true)
+	}
+	ctx = setContextOp(ctx, adq.ctx, ent.OpQueryIDs)
+	if err = adq.Select(awarddefinition.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (adq *AwardDefinitionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := adq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (adq *AwardDefinitionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, adq.ctx, ent.OpQueryCount)
+	if err := adq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, adq, querierCount[*AwardDefinitionQuery](), adq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (adq *AwardDefinitionQuery) CountX(ctx context.Context) int {
+	count, err := adq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (adq *AwardDefinitionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, adq.ctx, ent.OpQueryExist)
+	switch _, err := adq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("generated: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (adq *AwardDefinitionQuery) ExistX(ctx context.Context) bool {
+	exist, err := adq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AwardDefinitionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (adq *AwardDefinitionQuery) Clone() *AwardDefinitionQuery {
+	if adq == nil {
+		return nil
+	}
+	return &AwardDefinitionQuery{
+		config:     adq.config,
+		ctx:        adq.ctx.Clone(),
+		order:      append([]awarddefinition.OrderOption{}, adq.order...),
+		inters:     append([]Interceptor{}, adq.inters...),
+		predicates: append([]predicate.AwardDefinition{}, adq.predicates...),
+		withGrants: adq.withGrants.Clone(),
+		// clone intermediate query.
+		sql:       adq.sql.Clone(),
+		path:      adq.path,
+		modifiers: append([]func(*sql.Selector){}, adq.modifiers...),
+	}
+}
+
+// WithGrants tells the query-builder to eager-load the nodes that are connected to
+// the "grants" edge. The optional arguments are used to configure the query builder of the edge.
+func (adq *AwardDefinitionQuery) WithGrants(opts ...func(*UserAwardQuery)) *AwardDefinitionQuery {
+	query := (&UserAwardClient{config: adq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	adq.withGrants = query
+	return adq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UpdatedAt time.Time `json:"updated_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AwardDefinition.Query().
+//		GroupBy(awarddefinition.FieldUpdatedAt).
+//		Aggregate(generated.Count()).
+//		Scan(ctx, &v)
+func (adq *AwardDefinitionQuery) GroupBy(field string, fields ...string) *AwardDefinitionGroupBy {
+	adq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AwardDefinitionGroupBy{build: adq}
+	grbuild.flds = &adq.ctx.Fields
+	grbuild.label = awarddefinition.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UpdatedAt time.Time `json:"updated_at,omitempty"`
+//	}
+//
+//	client.AwardDefinition.Query().
+//		Select(awarddefinition.FieldUpdatedAt).
+//		Scan(ctx, &v)
+func (adq *AwardDefinitionQuery) Select(fields ...string) *AwardDefinitionSelect {
+	adq.ctx.Fields = append(adq.ctx.Fields, fields...)
+	sbuild := &AwardDefinitionSelect{AwardDefinitionQuery: adq}
+	sbuild.label = awarddefinition.Label
+	sbuild.flds, sbuild.scan = &adq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AwardDefinitionSelect configured with the given aggregations.
+func (adq *AwardDefinitionQuery) Aggregate(fns ...AggregateFunc) *AwardDefinitionSelect {
+	return adq.Select().Aggregate(fns...)
+}
+
+func (adq *AwardDefinitionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range adq.inters {
+		if inter == nil {
+			return fmt.Errorf("generated: uninitialized interceptor (forgotten import generated/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, adq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range adq.ctx.Fields {
+		if !awarddefinition.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+		}
+	}
+	if adq.path != nil {
+		prev, err := adq.path(ctx)
+		if err != nil {
+			return err
+		}
+		adq.sql = prev
+	}
+	if awarddefinition.Policy == nil {
+		return errors.New("generated: uninitialized awarddefinition.Policy (forgotten import generated/runtime?)")
+	}
+	if err := awarddefinition.Policy.EvalQuery(ctx, adq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (adq *AwardDefinitionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AwardDefinition, error) {
+	var (
+		nodes       = []*AwardDefinition{}
+		_spec       = adq.querySpec()
+		loadedTypes = [1]bool{
+			adq.withGrants != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AwardDefinition).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AwardDefinition{config: adq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(adq.modifiers) > 0 {
+		_spec.Modifiers = adq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, adq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := adq.withGrants; query != nil {
+		if err := adq.loadGrants(ctx, query, nodes,
+			func(n *AwardDefinition) { n.Edges.Grants = []*UserAward{} },
+			func(n *AwardDefinition, e *UserAward) { n.Edges.Grants = append(n.Edges.Grants, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range adq.withNamedGrants {
+		if err := adq.loadGrants(ctx, query, nodes,
+			func(n *AwardDefinition) { n.appendNamedGrants(name) },
+			func(n *AwardDefinition, e *UserAward) { n.appendNamedGrants(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range adq.loadTotal {
+		if err := adq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (adq *AwardDefinitionQuery) loadGrants(ctx context.Context, query *UserAwardQuery, nodes []*AwardDefinition, init func(*AwardDefinition), assign func(*AwardDefinition, *UserAward)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*AwardDefinition)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(useraward.FieldAwardID)
+	}
+	query.Where(predicate.UserAward(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(awarddefinition.GrantsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AwardID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "award_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (adq *AwardDefinitionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := adq.querySpec()
+	if len(adq.modifiers) > 0 {
+		_spec.Modifiers = adq.modifiers
+	}
+	_spec.Node.Columns = adq.ctx.Fields
+	if len(adq.ctx.Fields) > 0 {
+		_spec.Unique = adq.ctx.Unique != nil && *adq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, adq.driver, _spec)
+}
+
+func (adq *AwardDefinitionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(awarddefinition.Table, awarddefinition.Columns, sqlgraph.NewFieldSpec(awarddefinition.FieldID, field.TypeUUID))
+	_spec.From = adq.sql
+	if unique := adq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if adq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := adq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, awarddefinition.FieldID)
+		for i := range fields {
+			if fields[i] != awarddefinition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := adq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := adq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := adq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := adq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (adq *AwardDefinitionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(adq.driver.Dialect())
+	t1 := builder.Table(awarddefinition.Table)
+	columns := adq.ctx.Fields
+	if len(columns) == 0 {
+		columns = awarddefinition.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if adq.sql != nil {
+		selector = adq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if adq.ctx.Unique != nil && *adq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range adq.modifiers {
+		m(selector)
+	}
+	for _, p := range adq.predicates {
+		p(selector)
+	}
+	for _, p := range adq.order {
+		p(selector)
+	}
+	if offset := adq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := adq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (adq *AwardDefinitionQuery) ForUpdate(opts ...sql.LockOption) *AwardDefinitionQuery {
+	if adq.driver.Dialect() == dialect.Postgres {
+		adq.Unique(false)
+	}
+	adq.modifiers = append(adq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return adq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (adq *AwardDefinitionQuery) ForShare(opts ...sql.LockOption) *AwardDefinitionQuery {
+	if adq.driver.Dialect() == dialect.Postgres {
+		adq.Unique(false)
+	}
+	adq.modifiers = append(adq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return adq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (adq *AwardDefinitionQuery) Modify(modifiers ...func(s *sql.Selector)) *AwardDefinitionSelect {
+	adq.modifiers = append(adq.modifiers, modifiers...)
+	return adq.Select()
+}
+
+// WithNamedGrants tells the query-builder to eager-load the nodes that are connected to the "grants"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (adq *AwardDefinitionQuery) WithNamedGrants(name string, opts ...func(*UserAwardQuery)) *AwardDefinitionQuery {
+	query := (&UserAwardClient{config: adq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if adq.withNamedGrants == nil {
+		adq.withNamedGrants = make(map[string]*UserAwardQuery)
+	}
+	adq.withNamedGrants[name] = query
+	return adq
+}
+
+// AwardDefinitionGroupBy is the group-by builder for AwardDefinition entities.
+type AwardDefinitionGroupBy struct {
+	selector
+	build *AwardDefinitionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (adgb *AwardDefinitionGroupBy) Aggregate(fns ...AggregateFunc) *AwardDefinitionGroupBy {
+	adgb.fns = append(adgb.fns, fns...)
+	return adgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (adgb *AwardDefinitionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, adgb.build.ctx, ent.OpQueryGroupBy)
+	if err := adgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AwardDefinitionQuery, *AwardDefinitionGroupBy](ctx, adgb.build, adgb, adgb.build.inters, v)
+}
+
+func (adgb *AwardDefinitionGroupBy) sqlScan(ctx context.Context, root *AwardDefinitionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(adgb.fns))
+	for _, fn := range adgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*adgb.flds)+len(adgb.fns))
+		for _, f := range *adgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*adgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := adgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AwardDefinitionSelect is the builder for selecting fields of AwardDefinition entities.
+type AwardDefinitionSelect struct {
+	*AwardDefinitionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ads *AwardDefinitionSelect) Aggregate(fns ...AggregateFunc) *AwardDefinitionSelect {
+	ads.fns = append(ads.fns, fns...)
+	return ads
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ads *AwardDefinitionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ads.ctx, ent.OpQuerySelect)
+	if err := ads.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AwardDefinitionQuery, *AwardDefinitionSelect](ctx, ads.AwardDefinitionQuery, ads, ads.inters, v)
+}
+
+func (ads *AwardDefinitionSelect) sqlScan(ctx context.Context, root *AwardDefinitionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ads.fns))
+	for _, fn := range ads.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ads.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ads.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ads *AwardDefinitionSelect) Modify(modifiers ...func(s *sql.Selector)) *AwardDefinitionSelect {
+	ads.modifiers = append(ads.modifiers, modifiers...)
+	return ads
+}
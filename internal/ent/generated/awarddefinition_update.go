@@ -0,0 +1,720 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
+	"github.com/google/uuid"
+)
+
+// AwardDefinitionUpdate is the builder for updating AwardDefinition entities.
+type AwardDefinitionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AwardDefinitionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AwardDefinitionUpdate builder.
+func (adu *AwardDefinitionUpdate) Where(ps ...predicate.AwardDefinition) *AwardDefinitionUpdate {
+	adu.mutation.Where(ps...)
+	return adu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (adu *AwardDefinitionUpdate) SetUpdatedAt(t time.Time) *AwardDefinitionUpdate {
+	adu.mutation.SetUpdatedAt(t)
+	return adu
+}
+
+// SetName sets the "name" field.
+func (adu *AwardDefinitionUpdate) SetName(s string) *AwardDefinitionUpdate {
+	adu.mutation.SetName(s)
+	return adu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (adu *AwardDefinitionUpdate) SetNillableName(s *string) *AwardDefinitionUpdate {
+	if s != nil {
+		adu.SetName(*s)
+	}
+	return adu
+}
+
+// SetDescription sets the "description" field.
+func (adu *AwardDefinitionUpdate) SetDescription(s string) *AwardDefinitionUpdate {
+	adu.mutation.SetDescription(s)
+	return adu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (adu *AwardDefinitionUpdate) SetNillableDescription(s *string) *AwardDefinitionUpdate {
+	if s != nil {
+		adu.SetDescription(*s)
+	}
+	return adu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (adu *AwardDefinitionUpdate) ClearDescription() *AwardDefinitionUpdate {
+	adu.mutation.ClearDescription()
+	return adu
+}
+
+// SetIcon sets the "icon" field.
+func (adu *AwardDefinitionUpdate) SetIcon(s string) *AwardDefinitionUpdate {
+	adu.mutation.SetIcon(s)
+	return adu
+}
+
+// SetNillableIcon sets the "icon" field if the given value is not nil.
+func (adu *AwardDefinitionUpdate) SetNillableIcon(s *string) *AwardDefinitionUpdate {
+	if s != nil {
+		adu.SetIcon(*s)
+	}
+	return adu
+}
+
+// SetRule sets the "rule" field.
+func (adu *AwardDefinitionUpdate) SetRule(a awarddefinition.Rule) *AwardDefinitionUpdate {
+	adu.mutation.SetRule(a)
+	return adu
+}
+
+// SetNillableRule sets the "rule" field if the given value is not nil.
+func (adu *AwardDefinitionUpdate) SetNillableRule(a *awarddefinition.Rule) *AwardDefinitionUpdate {
+	if a != nil {
+		adu.SetRule(*a)
+	}
+	return adu
+}
+
+// SetRuleCategory sets the "rule_category" field.
+func (adu *AwardDefinitionUpdate) SetRuleCategory(ac awarddefinition.RuleCategory) *AwardDefinitionUpdate {
+	adu.mutation.SetRuleCategory(ac)
+	return adu
+}
+
+// SetNillableRuleCategory sets the "rule_category" field if the given value is not nil.
+func (adu *AwardDefinitionUpdate) SetNillableRuleCategory(ac *awarddefinition.RuleCategory) *AwardDefinitionUpdate {
+	if ac != nil {
+		adu.SetRuleCategory(*ac)
+	}
+	return adu
+}
+
+// ClearRuleCategory clears the value of the "rule_category" field.
+func (adu *AwardDefinitionUpdate) ClearRuleCategory() *AwardDefinitionUpdate {
+	adu.mutation.ClearRuleCategory()
+	return adu
+}
+
+// SetRuleThreshold sets the "rule_threshold" field.
+func (adu *AwardDefinitionUpdate) SetRuleThreshold(i int) *AwardDefinitionUpdate {
+	adu.mutation.ResetRuleThreshold()
+	adu.mutation.SetRuleThreshold(i)
+	return adu
+}
+
+// SetNillableRuleThreshold sets the "rule_threshold" field if the given value is not nil.
+func (adu *AwardDefinitionUpdate) SetNillableRuleThreshold(i *int) *AwardDefinitionUpdate {
+	if i != nil {
+		adu.SetRuleThreshold(*i)
+	}
+	return adu
+}
+
+// AddRuleThreshold adds i to the "rule_threshold" field.
+func (adu *AwardDefinitionUpdate) AddRuleThreshold(i int) *AwardDefinitionUpdate {
+	adu.mutation.AddRuleThreshold(i)
+	return adu
+}
+
+// AddGrantIDs adds the "grants" edge to the UserAward entity by IDs.
+func (adu *AwardDefinitionUpdate) AddGrantIDs(ids ...uuid.UUID) *AwardDefinitionUpdate {
+	adu.mutation.AddGrantIDs(ids...)
+	return adu
+}
+
+// AddGrants adds the "grants" edges to the UserAward entity.
+func (adu *AwardDefinitionUpdate) AddGrants(u ...*UserAward) *AwardDefinitionUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return adu.AddGrantIDs(ids...)
+}
+
+// Mutation returns the AwardDefinitionMutation object of the builder.
+func (adu *AwardDefinitionUpdate) Mutation() *AwardDefinitionMutation {
+	return adu.mutation
+}
+
+// ClearGrants clears all "grants" edges to the UserAward entity.
+func (adu *AwardDefinitionUpdate) ClearGrants() *AwardDefinitionUpdate {
+	adu.mutation.ClearGrants()
+	return adu
+}
+
+// RemoveGrantIDs removes the "grants" edge to UserAward entities by IDs.
+func (adu *AwardDefinitionUpdate) RemoveGrantIDs(ids ...uuid.UUID) *AwardDefinitionUpdate {
+	adu.mutation.RemoveGrantIDs(ids...)
+	return adu
+}
+
+// RemoveGrants removes "grants" edges to UserAward entities.
+func (adu *AwardDefinitionUpdate) RemoveGrants(u ...*UserAward) *AwardDefinitionUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return adu.RemoveGrantIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (adu *AwardDefinitionUpdate) Save(ctx context.Context) (int, error) {
+	if err := adu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, adu.sqlSave, adu.mutation, adu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (adu *AwardDefinitionUpdate) SaveX(ctx context.Context) int {
+	affected, err := adu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (adu *AwardDefinitionUpdate) Exec(ctx context.Context) error {
+	_, err := adu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (adu *AwardDefinitionUpdate) ExecX(ctx context.Context) {
+	if err := adu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (adu *AwardDefinitionUpdate) defaults() error {
+	if _, ok := adu.mutation.UpdatedAt(); !ok {
+		if awarddefinition.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized awarddefinition.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := awarddefinition.UpdateDefaultUpdatedAt()
+		adu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (adu *AwardDefinitionUpdate) check() error {
+	if v, ok := adu.mutation.Name(); ok {
+		if err := awarddefinition.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "AwardDefinition.name": %w`, err)}
+		}
+	}
+	if v, ok := adu.mutation.Rule(); ok {
+		if err := awarddefinition.RuleValidator(v); err != nil {
+			return &ValidationError{Name: "rule", err: fmt.Errorf(`generated: validator failed for field "AwardDefinition.rule": %w`, err)}
+		}
+	}
+	if v, ok := adu.mutation.RuleCategory(); ok {
+		if err := awarddefinition.RuleCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "rule_category", err: fmt.Errorf(`generated: validator failed for field "AwardDefinition.rule_category": %w`, err)}
+		}
+	}
+	if v, ok := adu.mutation.RuleThreshold(); ok {
+		if err := awarddefinition.RuleThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "rule_threshold", err: fmt.Errorf(`generated: validator failed for field "AwardDefinition.rule_threshold": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (adu *AwardDefinitionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AwardDefinitionUpdate {
+	adu.modifiers = append(adu.modifiers, modifiers...)
+	return adu
+}
+
+func (adu *AwardDefinitionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := adu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(awarddefinition.Table, awarddefinition.Columns, sqlgraph.NewFieldSpec(awarddefinition.FieldID, field.TypeUUID))
+	if ps := adu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := adu.mutation.UpdatedAt(); ok {
+		_spec.SetField(awarddefinition.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := adu.mutation.Name(); ok {
+		_spec.SetField(awarddefinition.FieldName, field.TypeString, value)
+	}
+	if value, ok := adu.mutation.Description(); ok {
+		_spec.SetField(awarddefinition.FieldDescription, field.TypeString, value)
+	}
+	if adu.mutation.DescriptionCleared() {
+		_spec.ClearField(awarddefinition.FieldDescription, field.TypeString)
+	}
+	if value, ok := adu.mutation.Icon(); ok {
+		_spec.SetField(awarddefinition.FieldIcon, field.TypeString, value)
+	}
+	if value, ok := adu.mutation.Rule(); ok {
+		_spec.SetField(awarddefinition.FieldRule, field.TypeEnum, value)
+	}
+	if value, ok := adu.mutation.RuleCategory(); ok {
+		_spec.SetField(awarddefinition.FieldRuleCategory, field.TypeEnum, value)
+	}
+	if adu.mutation.RuleCategoryCleared() {
+		_spec.ClearField(awarddefinition.FieldRuleCategory, field.TypeEnum)
+	}
+	if value, ok := adu.mutation.RuleThreshold(); ok {
+		_spec.SetField(awarddefinition.FieldRuleThreshold, field.TypeInt, value)
+	}
+	if value, ok := adu.mutation.AddedRuleThreshold(); ok {
+		_spec.AddField(awarddefinition.FieldRuleThreshold, field.TypeInt, value)
+	}
+	if adu.mutation.GrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   awarddefinition.GrantsTable,
+			Columns: []string{awarddefinition.GrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useraward.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := adu.mutation.RemovedGrantsIDs(); len(nodes) > 0 && !adu.mutation.GrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   awarddefinition.GrantsTable,
+			Columns: []string{awarddefinition.GrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useraward.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := adu.mutation.GrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   awarddefinition.GrantsTable,
+			Columns: []string{awarddefinition.GrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useraward.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(adu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, adu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{awarddefinition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	adu.mutation.done = true
+	return n, nil
+}
+
+// AwardDefinitionUpdateOne is the builder for updating a single AwardDefinition entity.
+type AwardDefinitionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AwardDefinitionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aduo *AwardDefinitionUpdateOne) SetUpdatedAt(t time.Time) *AwardDefinitionUpdateOne {
+	aduo.mutation.SetUpdatedAt(t)
+	return aduo
+}
+
+// SetName sets the "name" field.
+func (aduo *AwardDefinitionUpdateOne) SetName(s string) *AwardDefinitionUpdateOne {
+	aduo.mutation.SetName(s)
+	return aduo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (aduo *AwardDefinitionUpdateOne) SetNillableName(s *string) *AwardDefinitionUpdateOne {
+	if s != nil {
+		aduo.SetName(*s)
+	}
+	return aduo
+}
+
+// SetDescription sets the "description" field.
+func (aduo *AwardDefinitionUpdateOne) SetDescription(s string) *AwardDefinitionUpdateOne {
+	aduo.mutation.SetDescription(s)
+	return aduo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (aduo *AwardDefinitionUpdateOne) SetNillableDescription(s *string) *AwardDefinitionUpdateOne {
+	if s != nil {
+		aduo.SetDescription(*s)
+	}
+	return aduo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (aduo *AwardDefinitionUpdateOne) ClearDescription() *AwardDefinitionUpdateOne {
+	aduo.mutation.ClearDescription()
+	return aduo
+}
+
+// SetIcon sets the "icon" field.
+func (aduo *AwardDefinitionUpdateOne) SetIcon(s string) *AwardDefinitionUpdateOne {
+	aduo.mutation.SetIcon(s)
+	return aduo
+}
+
+// SetNillableIcon sets the "icon" field if the given value is not nil.
+func (aduo *AwardDefinitionUpdateOne) SetNillableIcon(s *string) *AwardDefinitionUpdateOne {
+	if s != nil {
+		aduo.SetIcon(*s)
+	}
+	return aduo
+}
+
+// SetRule sets the "rule" field.
+func (aduo *AwardDefinitionUpdateOne) SetRule(a awarddefinition.Rule) *AwardDefinitionUpdateOne {
+	aduo.mutation.SetRule(a)
+	return aduo
+}
+
+// SetNillableRule sets the "rule" field if the given value is not nil.
+func (aduo *AwardDefinitionUpdateOne) SetNillableRule(a *awarddefinition.Rule) *AwardDefinitionUpdateOne {
+	if a != nil {
+		aduo.SetRule(*a)
+	}
+	return aduo
+}
+
+// SetRuleCategory sets the "rule_category" field.
+func (aduo *AwardDefinitionUpdateOne) SetRuleCategory(ac awarddefinition.RuleCategory) *AwardDefinitionUpdateOne {
+	aduo.mutation.SetRuleCategory(ac)
+	return aduo
+}
+
+// SetNillableRuleCategory sets the "rule_category" field if the given value is not nil.
+func (aduo *AwardDefinitionUpdateOne) SetNillableRuleCategory(ac *awarddefinition.RuleCategory) *AwardDefinitionUpdateOne {
+	if ac != nil {
+		aduo.SetRuleCategory(*ac)
+	}
+	return aduo
+}
+
+// ClearRuleCategory clears the value of the "rule_category" field.
+func (aduo *AwardDefinitionUpdateOne) ClearRuleCategory() *AwardDefinitionUpdateOne {
+	aduo.mutation.ClearRuleCategory()
+	return aduo
+}
+
+// SetRuleThreshold sets the "rule_threshold" field.
+func (aduo *AwardDefinitionUpdateOne) SetRuleThreshold(i int) *AwardDefinitionUpdateOne {
+	aduo.mutation.ResetRuleThreshold()
+	aduo.mutation.SetRuleThreshold(i)
+	return aduo
+}
+
+// SetNillableRuleThreshold sets the "rule_threshold" field if the given value is not nil.
+func (aduo *AwardDefinitionUpdateOne) SetNillableRuleThreshold(i *int) *AwardDefinitionUpdateOne {
+	if i != nil {
+		aduo.SetRuleThreshold(*i)
+	}
+	return aduo
+}
+
+// AddRuleThreshold adds i to the "rule_threshold" field.
+func (aduo *AwardDefinitionUpdateOne) AddRuleThreshold(i int) *AwardDefinitionUpdateOne {
+	aduo.mutation.AddRuleThreshold(i)
+	return aduo
+}
+
+// AddGrantIDs adds the "grants" edge to the UserAward entity by IDs.
+func (aduo *AwardDefinitionUpdateOne) AddGrantIDs(ids ...uuid.UUID) *AwardDefinitionUpdateOne {
+	aduo.mutation.AddGrantIDs(ids...)
+	return aduo
+}
+
+// AddGrants adds the "grants" edges to the UserAward entity.
+func (aduo *AwardDefinitionUpdateOne) AddGrants(u ...*UserAward) *AwardDefinitionUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return aduo.AddGrantIDs(ids...)
+}
+
+// Mutation returns the AwardDefinitionMutation object of the builder.
+func (aduo *AwardDefinitionUpdateOne) Mutation() *AwardDefinitionMutation {
+	return aduo.mutation
+}
+
+// ClearGrants clears all "grants" edges to the UserAward entity.
+func (aduo *AwardDefinitionUpdateOne) ClearGrants() *AwardDefinitionUpdateOne {
+	aduo.mutation.ClearGrants()
+	return aduo
+}
+
+// RemoveGrantIDs removes the "grants" edge to UserAward entities by IDs.
+func (aduo *AwardDefinitionUpdateOne) RemoveGrantIDs(ids ...uuid.UUID) *AwardDefinitionUpdateOne {
+	aduo.mutation.RemoveGrantIDs(ids...)
+	return aduo
+}
+
+// RemoveGrants removes "grants" edges to UserAward entities.
+func (aduo *AwardDefinitionUpdateOne) RemoveGrants(u ...*UserAward) *AwardDefinitionUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return aduo.RemoveGrantIDs(ids...)
+}
+
+// Where appends a list predicates to the AwardDefinitionUpdate builder.
+func (aduo *AwardDefinitionUpdateOne) Where(ps ...predicate.AwardDefinition) *AwardDefinitionUpdateOne {
+	aduo.mutation.Where(ps...)
+	return aduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aduo *AwardDefinitionUpdateOne) Select(field string, fields ...string) *AwardDefinitionUpdateOne {
+	aduo.fields = append([]string{field}, fields...)
+	return aduo
+}
+
+// Save executes the query and returns the updated AwardDefinition entity.
+func (aduo *AwardDefinitionUpdateOne) Save(ctx context.Context) (*AwardDefinition, error) {
+	if err := aduo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, aduo.sqlSave, aduo.mutation, aduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aduo *AwardDefinitionUpdateOne) SaveX(ctx context.Context) *AwardDefinition {
+	node, err := aduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aduo *AwardDefinitionUpdateOne) Exec(ctx context.Context) error {
+	_, err := aduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aduo *AwardDefinitionUpdateOne) ExecX(ctx context.Context) {
+	if err := aduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aduo *AwardDefinitionUpdateOne) defaults() error {
+	if _, ok := aduo.mutation.UpdatedAt(); !ok {
+		if awarddefinition.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized awarddefinition.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := awarddefinition.UpdateDefaultUpdatedAt()
+		aduo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aduo *AwardDefinitionUpdateOne) check() error {
+	if v, ok := aduo.mutation.Name(); ok {
+		if err := awarddefinition.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "AwardDefinition.name": %w`, err)}
+		}
+	}
+	if v, ok := aduo.mutation.Rule(); ok {
+		if err := awarddefinition.RuleValidator(v); err != nil {
+			return &ValidationError{Name: "rule", err: fmt.Errorf(`generated: validator failed for field "AwardDefinition.rule": %w`, err)}
+		}
+	}
+	if v, ok := aduo.mutation.RuleCategory(); ok {
+		if err := awarddefinition.RuleCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "rule_category", err: fmt.Errorf(`generated: validator failed for field "AwardDefinition.rule_category": %w`, err)}
+		}
+	}
+	if v, ok := aduo.mutation.RuleThreshold(); ok {
+		if err := awarddefinition.RuleThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "rule_threshold", err: fmt.Errorf(`generated: validator failed for field "AwardDefinition.rule_threshold": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (aduo *AwardDefinitionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AwardDefinitionUpdateOne {
+	aduo.modifiers = append(aduo.modifiers, modifiers...)
+	return aduo
+}
+
+func (aduo *AwardDefinitionUpdateOne) sqlSave(ctx context.Context) (_node *AwardDefinition, err error) {
+	if err := aduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(awarddefinition.Table, awarddefinition.Columns, sqlgraph.NewFieldSpec(awarddefinition.FieldID, field.TypeUUID))
+	id, ok := aduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "AwardDefinition.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, awarddefinition.FieldID)
+		for _, f := range fields {
+			if !awarddefinition.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != awarddefinition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aduo.mutation.UpdatedAt(); ok {
+		_spec.SetField(awarddefinition.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := aduo.mutation.Name(); ok {
+		_spec.SetField(awarddefinition.FieldName, field.TypeString, value)
+	}
+	if value, ok := aduo.mutation.Description(); ok {
+		_spec.SetField(awarddefinition.FieldDescription, field.TypeString, value)
+	}
+	if aduo.mutation.DescriptionCleared() {
+		_spec.ClearField(awarddefinition.FieldDescription, field.TypeString)
+	}
+	if value, ok := aduo.mutation.Icon(); ok {
+		_spec.SetField(awarddefinition.FieldIcon, field.TypeString, value)
+	}
+	if value, ok := aduo.mutation.Rule(); ok {
+		_spec.SetField(awarddefinition.FieldRule, field.TypeEnum, value)
+	}
+	if value, ok := aduo.mutation.RuleCategory(); ok {
+		_spec.SetField(awarddefinition.FieldRuleCategory, field.TypeEnum, value)
+	}
+	if aduo.mutation.RuleCategoryCleared() {
+		_spec.ClearField(awarddefinition.FieldRuleCategory, field.TypeEnum)
+	}
+	if value, ok := aduo.mutation.RuleThreshold(); ok {
+		_spec.SetField(awarddefinition.FieldRuleThreshold, field.TypeInt, value)
+	}
+	if value, ok := aduo.mutation.AddedRuleThreshold(); ok {
+		_spec.AddField(awarddefinition.FieldRuleThreshold, field.TypeInt, value)
+	}
+	if aduo.mutation.GrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   awarddefinition.GrantsTable,
+			Columns: []string{awarddefinition.GrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useraward.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aduo.mutation.RemovedGrantsIDs(); len(nodes) > 0 && !aduo.mutation.GrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   awarddefinition.GrantsTable,
+			Columns: []string{awarddefinition.GrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useraward.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aduo.mutation.GrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   awarddefinition.GrantsTable,
+			Columns: []string{awarddefinition.GrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useraward.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(aduo.modifiers...)
+	_node = &AwardDefinition{config: aduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{awarddefinition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aduo.mutation.done = true
+	return _node, nil
+}
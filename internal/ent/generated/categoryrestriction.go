@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/categoryrestriction"
+	"github.com/google/uuid"
+)
+
+// CategoryRestriction is the model entity for the CategoryRestriction schema.
+type CategoryRestriction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Category holds the value of the "category" field.
+	Category categoryrestriction.Category `json:"category,omitempty"`
+	// SubscriberOnlyPost holds the value of the "subscriber_only_post" field.
+	SubscriberOnlyPost bool `json:"subscriber_only_post,omitempty"`
+	// SubscriberOnlyView holds the value of the "subscriber_only_view" field.
+	SubscriberOnlyView bool `json:"subscriber_only_view,omitempty"`
+	selectValues       sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CategoryRestriction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case categoryrestriction.FieldSubscriberOnlyPost, categoryrestriction.FieldSubscriberOnlyView:
+			values[i] = new(sql.NullBool)
+		case categoryrestriction.FieldCategory:
+			values[i] = new(sql.NullString)
+		case categoryrestriction.FieldUpdatedAt, categoryrestriction.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case categoryrestriction.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CategoryRestriction fields.
+func (cr *CategoryRestriction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case categoryrestriction.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cr.ID = *value
+			}
+		case categoryrestriction.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				cr.UpdatedAt = value.Time
+			}
+		case categoryrestriction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cr.CreatedAt = value.Time
+			}
+		case categoryrestriction.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				cr.Category = categoryrestriction.Category(value.String)
+			}
+		case categoryrestriction.FieldSubscriberOnlyPost:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field subscriber_only_post", values[i])
+			} else if value.Valid {
+				cr.SubscriberOnlyPost = value.Bool
+			}
+		case categoryrestriction.FieldSubscriberOnlyView:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field subscriber_only_view", values[i])
+			} else if value.Valid {
+				cr.SubscriberOnlyView = value.Bool
+			}
+		default:
+			cr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CategoryRestriction.
+// This includes values selected through modifiers, order, etc.
+func (cr *CategoryRestriction) Value(name string) (ent.Value, error) {
+	return cr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CategoryRestriction.
+// Note that you need to call CategoryRestriction.Unwrap() before calling this method if this CategoryRestriction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cr *CategoryRestriction) Update() *CategoryRestrictionUpdateOne {
+	return NewCategoryRestrictionClient(cr.config).UpdateOne(cr)
+}
+
+// Unwrap unwraps the CategoryRestriction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cr *CategoryRestriction) Unwrap() *CategoryRestriction {
+	_tx, ok := cr.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: CategoryRestriction is not a transactional entity")
+	}
+	cr.config.driver = _tx.drv
+	return cr
+}
+
+// String implements the fmt.Stringer.
+func (cr *CategoryRestriction) String() string {
+	var builder strings.Builder
+	builder.WriteString("CategoryRestriction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cr.ID))
+	builder.WriteString("updated_at=")
+	builder.WriteString(cr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(fmt.Sprintf("%v", cr.Category))
+	builder.WriteString(", ")
+	builder.WriteString("subscriber_only_post=")
+	builder.WriteString(fmt.Sprintf("%v", cr.SubscriberOnlyPost))
+	builder.WriteString(", ")
+	builder.WriteString("subscriber_only_view=")
+	builder.WriteString(fmt.Sprintf("%v", cr.SubscriberOnlyView))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CategoryRestrictions is a parsable slice of CategoryRestriction.
+type CategoryRestrictions []*CategoryRestriction
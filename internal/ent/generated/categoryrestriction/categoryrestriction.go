@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package categoryrestriction
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the categoryrestriction type in the database.
+	Label = "category_restriction"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldSubscriberOnlyPost holds the string denoting the subscriber_only_post field in the database.
+	FieldSubscriberOnlyPost = "subscriber_only_post"
+	// FieldSubscriberOnlyView holds the string denoting the subscriber_only_view field in the database.
+	FieldSubscriberOnlyView = "subscriber_only_view"
+	// Table holds the table name of the categoryrestriction in the database.
+	Table = "category_restrictions"
+)
+
+// Columns holds all SQL columns for categoryrestriction fields.
+var Columns = []string{
+	FieldID,
+	FieldUpdatedAt,
+	FieldCreatedAt,
+	FieldCategory,
+	FieldSubscriberOnlyPost,
+	FieldSubscriberOnlyView,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/caliecode/la-clipasa/internal/ent/generated/runtime"
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultSubscriberOnlyPost holds the default value on creation for the "subscriber_only_post" field.
+	DefaultSubscriberOnlyPost bool
+	// DefaultSubscriberOnlyView holds the default value on creation for the "subscriber_only_view" field.
+	DefaultSubscriberOnlyView bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Category defines the type for the "category" enum field.
+type Category string
+
+// Category values.
+const (
+	CategoryRANA              Category = "RANA"
+	CategorySIN_SONIDO        Category = "SIN_SONIDO"
+	CategoryMEME_ARTESANAL    Category = "MEME_ARTESANAL"
+	CategoryNO_SE_YO          Category = "NO_SE_YO"
+	CategoryORO               Category = "ORO"
+	CategoryDIAMANTE          Category = "DIAMANTE"
+	CategoryMEH               Category = "MEH"
+	CategoryALERTA_GLONETILLO Category = "ALERTA_GLONETILLO"
+	CategoryGRR               Category = "GRR"
+	CategoryENSORDECEDOR      Category = "ENSORDECEDOR"
+	CategoryRAGUUUL           Category = "RAGUUUL"
+)
+
+func (c Category) String() string {
+	return string(c)
+}
+
+// CategoryValidator is a validator for the "category" field enum values. It is called by the builders before save.
+func CategoryValidator(c Category) error {
+	switch c {
+	case CategoryRANA, CategorySIN_SONIDO, CategoryMEME_ARTESANAL, CategoryNO_SE_YO, CategoryORO, CategoryDIAMANTE, CategoryMEH, CategoryALERTA_GLONETILLO, CategoryGRR, CategoryENSORDECEDOR, CategoryRAGUUUL:
+		return nil
+	default:
+		return fmt.Errorf("categoryrestriction: invalid enum value for category field: %q", c)
+	}
+}
+
+// AllCategories returns all Category values.
+func AllCategories() []Category {
+	return []Category{
+		CategoryRANA,
+		CategorySIN_SONIDO,
+		CategoryMEME_ARTESANAL,
+		CategoryNO_SE_YO,
+		CategoryORO,
+		CategoryDIAMANTE,
+		CategoryMEH,
+		CategoryALERTA_GLONETILLO,
+		CategoryGRR,
+		CategoryENSORDECEDOR,
+		CategoryRAGUUUL,
+	}
+}
+
+// OrderOption defines the ordering options for the CategoryRestriction queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByCategory orders the results by the category field.
+func ByCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategory, opts...).ToFunc()
+}
+
+// BySubscriberOnlyPost orders the results by the subscriber_only_post field.
+func BySubscriberOnlyPost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubscriberOnlyPost, opts...).ToFunc()
+}
+
+// BySubscriberOnlyView orders the results by the subscriber_only_view field.
+func BySubscriberOnlyView(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubscriberOnlyView, opts...).ToFunc()
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Category) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Category) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Category(str)
+	if err := CategoryValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Category", str)
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package categoryrestriction
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldLTE(FieldID, id))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// SubscriberOnlyPost applies equality check predicate on the "subscriber_only_post" field. It's identical to SubscriberOnlyPostEQ.
+func SubscriberOnlyPost(v bool) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldEQ(FieldSubscriberOnlyPost, v))
+}
+
+// SubscriberOnlyView applies equality check predicate on the "subscriber_only_view" field. It's identical to SubscriberOnlyViewEQ.
+func SubscriberOnlyView(v bool) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldEQ(FieldSubscriberOnlyView, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v Category) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v Category) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...Category) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...Category) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// SubscriberOnlyPostEQ applies the EQ predicate on the "subscriber_only_post" field.
+func SubscriberOnlyPostEQ(v bool) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldEQ(FieldSubscriberOnlyPost, v))
+}
+
+// SubscriberOnlyPostNEQ applies the NEQ predicate on the "subscriber_only_post" field.
+func SubscriberOnlyPostNEQ(v bool) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldNEQ(FieldSubscriberOnlyPost, v))
+}
+
+// SubscriberOnlyViewEQ applies the EQ predicate on the "subscriber_only_view" field.
+func SubscriberOnlyViewEQ(v bool) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldEQ(FieldSubscriberOnlyView, v))
+}
+
+// SubscriberOnlyViewNEQ applies the NEQ predicate on the "subscriber_only_view" field.
+func SubscriberOnlyViewNEQ(v bool) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.FieldNEQ(FieldSubscriberOnlyView, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CategoryRestriction) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CategoryRestriction) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CategoryRestriction) predicate.CategoryRestriction {
+	return predicate.CategoryRestriction(sql.NotPredicates(p))
+}
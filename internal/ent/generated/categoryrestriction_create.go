@@ -0,0 +1,718 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/categoryrestriction"
+	"github.com/google/uuid"
+)
+
+// CategoryRestrictionCreate is the builder for creating a CategoryRestriction entity.
+type CategoryRestrictionCreate struct {
+	config
+	mutation *CategoryRestrictionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (crc *CategoryRestrictionCreate) SetUpdatedAt(t time.Time) *CategoryRestrictionCreate {
+	crc.mutation.SetUpdatedAt(t)
+	return crc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (crc *CategoryRestrictionCreate) SetNillableUpdatedAt(t *time.Time) *CategoryRestrictionCreate {
+	if t != nil {
+		crc.SetUpdatedAt(*t)
+	}
+	return crc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (crc *CategoryRestrictionCreate) SetCreatedAt(t time.Time) *CategoryRestrictionCreate {
+	crc.mutation.SetCreatedAt(t)
+	return crc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (crc *CategoryRestrictionCreate) SetNillableCreatedAt(t *time.Time) *CategoryRestrictionCreate {
+	if t != nil {
+		crc.SetCreatedAt(*t)
+	}
+	return crc
+}
+
+// SetCategory sets the "category" field.
+func (crc *CategoryRestrictionCreate) SetCategory(c categoryrestriction.Category) *CategoryRestrictionCreate {
+	crc.mutation.SetCategory(c)
+	return crc
+}
+
+// SetSubscriberOnlyPost sets the "subscriber_only_post" field.
+func (crc *CategoryRestrictionCreate) SetSubscriberOnlyPost(b bool) *CategoryRestrictionCreate {
+	crc.mutation.SetSubscriberOnlyPost(b)
+	return crc
+}
+
+// SetNillableSubscriberOnlyPost sets the "subscriber_only_post" field if the given value is not nil.
+func (crc *CategoryRestrictionCreate) SetNillableSubscriberOnlyPost(b *bool) *CategoryRestrictionCreate {
+	if b != nil {
+		crc.SetSubscriberOnlyPost(*b)
+	}
+	return crc
+}
+
+// SetSubscriberOnlyView sets the "subscriber_only_view" field.
+func (crc *CategoryRestrictionCreate) SetSubscriberOnlyView(b bool) *CategoryRestrictionCreate {
+	crc.mutation.SetSubscriberOnlyView(b)
+	return crc
+}
+
+// SetNillableSubscriberOnlyView sets the "subscriber_only_view" field if the given value is not nil.
+func (crc *CategoryRestrictionCreate) SetNillableSubscriberOnlyView(b *bool) *CategoryRestrictionCreate {
+	if b != nil {
+		crc.SetSubscriberOnlyView(*b)
+	}
+	return crc
+}
+
+// SetID sets the "id" field.
+func (crc *CategoryRestrictionCreate) SetID(u uuid.UUID) *CategoryRestrictionCreate {
+	crc.mutation.SetID(u)
+	return crc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (crc *CategoryRestrictionCreate) SetNillableID(u *uuid.UUID) *CategoryRestrictionCreate {
+	if u != nil {
+		crc.SetID(*u)
+	}
+	return crc
+}
+
+// Mutation returns the CategoryRestrictionMutation object of the builder.
+func (crc *CategoryRestrictionCreate) Mutation() *CategoryRestrictionMutation {
+	return crc.mutation
+}
+
+// Save creates the CategoryRestriction in the database.
+func (crc *CategoryRestrictionCreate) Save(ctx context.Context) (*CategoryRestriction, error) {
+	if err := crc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, crc.sqlSave, crc.mutation, crc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (crc *CategoryRestrictionCreate) SaveX(ctx context.Context) *CategoryRestriction {
+	v, err := crc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (crc *CategoryRestrictionCreate) Exec(ctx context.Context) error {
+	_, err := crc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crc *CategoryRestrictionCreate) ExecX(ctx context.Context) {
+	if err := crc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (crc *CategoryRestrictionCreate) defaults() error {
+	if _, ok := crc.mutation.UpdatedAt(); !ok {
+		if categoryrestriction.DefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized categoryrestriction.DefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := categoryrestriction.DefaultUpdatedAt()
+		crc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := crc.mutation.CreatedAt(); !ok {
+		if categoryrestriction.DefaultCreatedAt == nil {
+			return fmt.Errorf("generated: uninitialized categoryrestriction.DefaultCreatedAt (forgotten import generated/runtime?)")
+		}
+		v := categoryrestriction.DefaultCreatedAt()
+		crc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := crc.mutation.SubscriberOnlyPost(); !ok {
+		v := categoryrestriction.DefaultSubscriberOnlyPost
+		crc.mutation.SetSubscriberOnlyPost(v)
+	}
+	if _, ok := crc.mutation.SubscriberOnlyView(); !ok {
+		v := categoryrestriction.DefaultSubscriberOnlyView
+		crc.mutation.SetSubscriberOnlyView(v)
+	}
+	if _, ok := crc.mutation.ID(); !ok {
+		if categoryrestriction.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized categoryrestriction.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := categoryrestriction.DefaultID()
+		crc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (crc *CategoryRestrictionCreate) check() error {
+	if _, ok := crc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "CategoryRestriction.updated_at"`)}
+	}
+	if _, ok := crc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "CategoryRestriction.created_at"`)}
+	}
+	if _, ok := crc.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`generated: missing required field "CategoryRestriction.category"`)}
+	}
+	if v, ok := crc.mutation.Category(); ok {
+		if err := categoryrestriction.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`generated: validator failed for field "CategoryRestriction.category": %w`, err)}
+		}
+	}
+	if _, ok := crc.mutation.SubscriberOnlyPost(); !ok {
+		return &ValidationError{Name: "subscriber_only_post", err: errors.New(`generated: missing required field "CategoryRestriction.subscriber_only_post"`)}
+	}
+	if _, ok := crc.mutation.SubscriberOnlyView(); !ok {
+		return &ValidationError{Name: "subscriber_only_view", err: errors.New(`generated: missing required field "CategoryRestriction.subscriber_only_view"`)}
+	}
+	return nil
+}
+
+func (crc *CategoryRestrictionCreate) sqlSave(ctx context.Context) (*CategoryRestriction, error) {
+	if err := crc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := crc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, crc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	crc.mutation.id = &_node.ID
+	crc.mutation.done = true
+	return _node, nil
+}
+
+func (crc *CategoryRestrictionCreate) createSpec() (*CategoryRestriction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CategoryRestriction{config: crc.config}
+		_spec = sqlgraph.NewCreateSpec(categoryrestriction.Table, sqlgraph.NewFieldSpec(categoryrestriction.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = crc.conflict
+	if id, ok := crc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := crc.mutation.UpdatedAt(); ok {
+		_spec.SetField(categoryrestriction.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := crc.mutation.CreatedAt(); ok {
+		_spec.SetField(categoryrestriction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := crc.mutation.Category(); ok {
+		_spec.SetField(categoryrestriction.FieldCategory, field.TypeEnum, value)
+		_node.Category = value
+	}
+	if value, ok := crc.mutation.SubscriberOnlyPost(); ok {
+		_spec.SetField(categoryrestriction.FieldSubscriberOnlyPost, field.TypeBool, value)
+		_node.SubscriberOnlyPost = value
+	}
+	if value, ok := crc.mutation.SubscriberOnlyView(); ok {
+		_spec.SetField(categoryrestriction.FieldSubscriberOnlyView, field.TypeBool, value)
+		_node.SubscriberOnlyView = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CategoryRestriction.Create().
+//		SetUpdatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CategoryRestrictionUpsert) {
+//			SetUpdatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (crc *CategoryRestrictionCreate) OnConflict(opts ...sql.ConflictOption) *CategoryRestrictionUpsertOne {
+	crc.conflict = opts
+	return &CategoryRestrictionUpsertOne{
+		create: crc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CategoryRestriction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (crc *CategoryRestrictionCreate) OnConflictColumns(columns ...string) *CategoryRestrictionUpsertOne {
+	crc.conflict = append(crc.conflict, sql.ConflictColumns(columns...))
+	return &CategoryRestrictionUpsertOne{
+		create: crc,
+	}
+}
+
+type (
+	// CategoryRestrictionUpsertOne is the builder for "upsert"-ing
+	//  one CategoryRestriction node.
+	CategoryRestrictionUpsertOne struct {
+		create *CategoryRestrictionCreate
+	}
+
+	// CategoryRestrictionUpsert is the "OnConflict" setter.
+	CategoryRestrictionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CategoryRestrictionUpsert) SetUpdatedAt(v time.Time) *CategoryRestrictionUpsert {
+	u.Set(categoryrestriction.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CategoryRestrictionUpsert) UpdateUpdatedAt() *CategoryRestrictionUpsert {
+	u.SetExcluded(categoryrestriction.FieldUpdatedAt)
+	return u
+}
+
+// SetSubscriberOnlyPost sets the "subscriber_only_post" field.
+func (u *CategoryRestrictionUpsert) SetSubscriberOnlyPost(v bool) *CategoryRestrictionUpsert {
+	u.Set(categoryrestriction.FieldSubscriberOnlyPost, v)
+	return u
+}
+
+// UpdateSubscriberOnlyPost sets the "subscriber_only_post" field to the value that was provided on create.
+func (u *CategoryRestrictionUpsert) UpdateSubscriberOnlyPost() *CategoryRestrictionUpsert {
+	u.SetExcluded(categoryrestriction.FieldSubscriberOnlyPost)
+	return u
+}
+
+// SetSubscriberOnlyView sets the "subscriber_only_view" field.
+func (u *CategoryRestrictionUpsert) SetSubscriberOnlyView(v bool) *CategoryRestrictionUpsert {
+	u.Set(categoryrestriction.FieldSubscriberOnlyView, v)
+	return u
+}
+
+// UpdateSubscriberOnlyView sets the "subscriber_only_view" field to the value that was provided on create.
+func (u *CategoryRestrictionUpsert) UpdateSubscriberOnlyView() *CategoryRestrictionUpsert {
+	u.SetExcluded(categoryrestriction.FieldSubscriberOnlyView)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CategoryRestriction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(categoryrestriction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CategoryRestrictionUpsertOne) UpdateNewValues() *CategoryRestrictionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(categoryrestriction.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(categoryrestriction.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Category(); exists {
+			s.SetIgnore(categoryrestriction.FieldCategory)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CategoryRestriction.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CategoryRestrictionUpsertOne) Ignore() *CategoryRestrictionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CategoryRestrictionUpsertOne) DoNothing() *CategoryRestrictionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CategoryRestrictionCreate.OnConflict
+// documentation for more info.
+func (u *CategoryRestrictionUpsertOne) Update(set func(*CategoryRestrictionUpsert)) *CategoryRestrictionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CategoryRestrictionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CategoryRestrictionUpsertOne) SetUpdatedAt(v time.Time) *CategoryRestrictionUpsertOne {
+	return u.Update(func(s *CategoryRestrictionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CategoryRestrictionUpsertOne) UpdateUpdatedAt() *CategoryRestrictionUpsertOne {
+	return u.Update(func(s *CategoryRestrictionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetSubscriberOnlyPost sets the "subscriber_only_post" field.
+func (u *CategoryRestrictionUpsertOne) SetSubscriberOnlyPost(v bool) *CategoryRestrictionUpsertOne {
+	return u.Update(func(s *CategoryRestrictionUpsert) {
+		s.SetSubscriberOnlyPost(v)
+	})
+}
+
+// UpdateSubscriberOnlyPost sets the "subscriber_only_post" field to the value that was provided on create.
+func (u *CategoryRestrictionUpsertOne) UpdateSubscriberOnlyPost() *CategoryRestrictionUpsertOne {
+	return u.Update(func(s *CategoryRestrictionUpsert) {
+		s.UpdateSubscriberOnlyPost()
+	})
+}
+
+// SetSubscriberOnlyView sets the "subscriber_only_view" field.
+func (u *CategoryRestrictionUpsertOne) SetSubscriberOnlyView(v bool) *CategoryRestrictionUpsertOne {
+	return u.Update(func(s *CategoryRestrictionUpsert) {
+		s.SetSubscriberOnlyView(v)
+	})
+}
+
+// UpdateSubscriberOnlyView sets the "subscriber_only_view" field to the value that was provided on create.
+func (u *CategoryRestrictionUpsertOne) UpdateSubscriberOnlyView() *CategoryRestrictionUpsertOne {
+	return u.Update(func(s *CategoryRestrictionUpsert) {
+		s.UpdateSubscriberOnlyView()
+	})
+}
+
+// Exec executes the query.
+func (u *CategoryRestrictionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for CategoryRestrictionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CategoryRestrictionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CategoryRestrictionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: CategoryRestrictionUpsertOne.ID is not supported by MySQL driver. Use CategoryRestrictionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CategoryRestrictionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CategoryRestrictionCreateBulk is the builder for creating many CategoryRestriction entities in bulk.
+type CategoryRestrictionCreateBulk struct {
+	config
+	err      error
+	builders []*CategoryRestrictionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CategoryRestriction entities in the database.
+func (crcb *CategoryRestrictionCreateBulk) Save(ctx context.Context) ([]*CategoryRestriction, error) {
+	if crcb.err != nil {
+		return nil, crcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(crcb.builders))
+	nodes := make([]*CategoryRestriction, len(crcb.builders))
+	mutators := make([]Mutator, len(crcb.builders))
+	for i := range crcb.builders {
+		func(i int, root context.Context) {
+			builder := crcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CategoryRestrictionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, crcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = crcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, crcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, crcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (crcb *CategoryRestrictionCreateBulk) SaveX(ctx context.Context) []*CategoryRestriction {
+	v, err := crcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (crcb *CategoryRestrictionCreateBulk) Exec(ctx context.Context) error {
+	_, err := crcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crcb *CategoryRestrictionCreateBulk) ExecX(ctx context.Context) {
+	if err := crcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CategoryRestriction.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CategoryRestrictionUpsert) {
+//			SetUpdatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (crcb *CategoryRestrictionCreateBulk) OnConflict(opts ...sql.ConflictOption) *CategoryRestrictionUpsertBulk {
+	crcb.conflict = opts
+	return &CategoryRestrictionUpsertBulk{
+		create: crcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CategoryRestriction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (crcb *CategoryRestrictionCreateBulk) OnConflictColumns(columns ...string) *CategoryRestrictionUpsertBulk {
+	crcb.conflict = append(crcb.conflict, sql.ConflictColumns(columns...))
+	return &CategoryRestrictionUpsertBulk{
+		create: crcb,
+	}
+}
+
+// CategoryRestrictionUpsertBulk is the builder for "upsert"-ing
+// a bulk of CategoryRestriction nodes.
+type CategoryRestrictionUpsertBulk struct {
+	create *CategoryRestrictionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CategoryRestriction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(categoryrestriction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CategoryRestrictionUpsertBulk) UpdateNewValues() *CategoryRestrictionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(categoryrestriction.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(categoryrestriction.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Category(); exists {
+				s.SetIgnore(categoryrestriction.FieldCategory)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CategoryRestriction.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CategoryRestrictionUpsertBulk) Ignore() *CategoryRestrictionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CategoryRestrictionUpsertBulk) DoNothing() *CategoryRestrictionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CategoryRestrictionCreateBulk.OnConflict
+// documentation for more info.
+func (u *CategoryRestrictionUpsertBulk) Update(set func(*CategoryRestrictionUpsert)) *CategoryRestrictionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CategoryRestrictionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CategoryRestrictionUpsertBulk) SetUpdatedAt(v time.Time) *CategoryRestrictionUpsertBulk {
+	return u.Update(func(s *CategoryRestrictionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CategoryRestrictionUpsertBulk) UpdateUpdatedAt() *CategoryRestrictionUpsertBulk {
+	return u.Update(func(s *CategoryRestrictionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetSubscriberOnlyPost sets the "subscriber_only_post" field.
+func (u *CategoryRestrictionUpsertBulk) SetSubscriberOnlyPost(v bool) *CategoryRestrictionUpsertBulk {
+	return u.Update(func(s *CategoryRestrictionUpsert) {
+		s.SetSubscriberOnlyPost(v)
+	})
+}
+
+// UpdateSubscriberOnlyPost sets the "subscriber_only_post" field to the value that was provided on create.
+func (u *CategoryRestrictionUpsertBulk) UpdateSubscriberOnlyPost() *CategoryRestrictionUpsertBulk {
+	return u.Update(func(s *CategoryRestrictionUpsert) {
+		s.UpdateSubscriberOnlyPost()
+	})
+}
+
+// SetSubscriberOnlyView sets the "subscriber_only_view" field.
+func (u *CategoryRestrictionUpsertBulk) SetSubscriberOnlyView(v bool) *CategoryRestrictionUpsertBulk {
+	return u.Update(func(s *CategoryRestrictionUpsert) {
+		s.SetSubscriberOnlyView(v)
+	})
+}
+
+// UpdateSubscriberOnlyView sets the "subscriber_only_view" field to the value that was provided on create.
+func (u *CategoryRestrictionUpsertBulk) UpdateSubscriberOnlyView() *CategoryRestrictionUpsertBulk {
+	return u.Update(func(s *CategoryRestrictionUpsert) {
+		s.UpdateSubscriberOnlyView()
+	})
+}
+
+// Exec executes the query.
+func (u *CategoryRestrictionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the CategoryRestrictionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for CategoryRestrictionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CategoryRestrictionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/categoryrestriction"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
+)
+
+// CategoryRestrictionDelete is the builder for deleting a CategoryRestriction entity.
+type CategoryRestrictionDelete struct {
+	config
+	hooks    []Hook
+	mutation *CategoryRestrictionMutation
+}
+
+// Where appends a list predicates to the CategoryRestrictionDelete builder.
+func (crd *CategoryRestrictionDelete) Where(ps ...predicate.CategoryRestriction) *CategoryRestrictionDelete {
+	crd.mutation.Where(ps...)
+	return crd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (crd *CategoryRestrictionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, crd.sqlExec, crd.mutation, crd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crd *CategoryRestrictionDelete) ExecX(ctx context.Context) int {
+	n, err := crd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (crd *CategoryRestrictionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(categoryrestriction.Table, sqlgraph.NewFieldSpec(categoryrestriction.FieldID, field.TypeUUID))
+	if ps := crd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, crd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	crd.mutation.done = true
+	return affected, err
+}
+
+// CategoryRestrictionDeleteOne is the builder for deleting a single CategoryRestriction entity.
+type CategoryRestrictionDeleteOne struct {
+	crd *CategoryRestrictionDelete
+}
+
+// Where appends a list predicates to the CategoryRestrictionDelete builder.
+func (crdo *CategoryRestrictionDeleteOne) Where(ps ...predicate.CategoryRestriction) *CategoryRestrictionDeleteOne {
+	crdo.crd.mutation.Where(ps...)
+	return crdo
+}
+
+// Exec executes the deletion query.
+func (crdo *CategoryRestrictionDeleteOne) Exec(ctx context.Context) error {
+	n, err := crdo.crd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{categoryrestriction.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crdo *CategoryRestrictionDeleteOne) ExecX(ctx context.Context) {
+	if err := crdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,591 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/categoryrestriction"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// CategoryRestrictionQuery is the builder for querying CategoryRestriction entities.
+type CategoryRestrictionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []categoryrestriction.OrderOption
+	inters     []Interceptor
+	predicates []predicate.CategoryRestriction
+	loadTotal  []func(context.Context, []*CategoryRestriction) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CategoryRestrictionQuery builder.
+func (crq *CategoryRestrictionQuery) Where(ps ...predicate.CategoryRestriction) *CategoryRestrictionQuery {
+	crq.predicates = append(crq.predicates, ps...)
+	return crq
+}
+
+// Limit the number of records to be returned by this query.
+func (crq *CategoryRestrictionQuery) Limit(limit int) *CategoryRestrictionQuery {
+	crq.ctx.Limit = &limit
+	return crq
+}
+
+// Offset to start from.
+func (crq *CategoryRestrictionQuery) Offset(offset int) *CategoryRestrictionQuery {
+	crq.ctx.Offset = &offset
+	return crq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (crq *CategoryRestrictionQuery) Unique(unique bool) *CategoryRestrictionQuery {
+	crq.ctx.Unique = &unique
+	return crq
+}
+
+// Order specifies how the records should be ordered.
+func (crq *CategoryRestrictionQuery) Order(o ...categoryrestriction.OrderOption) *CategoryRestrictionQuery {
+	crq.order = append(crq.order, o...)
+	return crq
+}
+
+// First returns the first CategoryRestriction entity from the query.
+// Returns a *NotFoundError when no CategoryRestriction was found.
+func (crq *CategoryRestrictionQuery) First(ctx context.Context) (*CategoryRestriction, error) {
+	nodes, err := crq.Limit(1).All(setContextOp(ctx, crq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{categoryrestriction.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (crq *CategoryRestrictionQuery) FirstX(ctx context.Context) *CategoryRestriction {
+	node, err := crq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CategoryRestriction ID from the query.
+// Returns a *NotFoundError when no CategoryRestriction ID was found.
+func (crq *CategoryRestrictionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = crq.Limit(1).IDs(setContextOp(ctx, crq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{categoryrestriction.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (crq *CategoryRestrictionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := crq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CategoryRestriction entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CategoryRestriction entity is found.
+// Returns a *NotFoundError when no CategoryRestriction entities are found.
+func (crq *CategoryRestrictionQuery) Only(ctx context.Context) (*CategoryRestriction, error) {
+	nodes, err := crq.Limit(2).All(setContextOp(ctx, crq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{categoryrestriction.Label}
+	default:
+		return nil, &NotSingularError{categoryrestriction.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (crq *CategoryRestrictionQuery) OnlyX(ctx context.Context) *CategoryRestriction {
+	node, err := crq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CategoryRestriction ID in the query.
+// Returns a *NotSingularError when more than one CategoryRestriction ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (crq *CategoryRestrictionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = crq.Limit(2).IDs(setContextOp(ctx, crq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{categoryrestriction.Label}
+	default:
+		err = &NotSingularError{categoryrestriction.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (crq *CategoryRestrictionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := crq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CategoryRestrictions.
+func (crq *CategoryRestrictionQuery) All(ctx context.Context) ([]*CategoryRestriction, error) {
+	ctx = setContextOp(ctx, crq.ctx, ent.OpQueryAll)
+	if err := crq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CategoryRestriction, *CategoryRestrictionQuery]()
+	return withInterceptors[[]*CategoryRestriction](ctx, crq, qr, crq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (crq *CategoryRestrictionQuery) AllX(ctx context.Context) []*CategoryRestriction {
+	nodes, err := crq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CategoryRestriction IDs.
+func (crq *CategoryRestrictionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if crq.ctx.Unique == nil && crq.path != nil {
+		crq.Unique(true)
+	}
+	ctx = setContextOp(ctx, crq.ctx, ent.OpQueryIDs)
+	if err = crq.Select(categoryrestriction.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (crq *CategoryRestrictionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := crq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (crq *CategoryRestrictionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, crq.ctx, ent.OpQueryCount)
+	if err := crq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, crq, querierCount[*CategoryRestrictionQuery](), crq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (crq *CategoryRestrictionQuery) CountX(ctx context.Context) int {
+	count, err := crq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (crq *CategoryRestrictionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, crq.ctx, ent.OpQueryExist)
+	switch _, err := crq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("generated: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (crq *CategoryRestrictionQuery) ExistX(ctx context.Context) bool {
+	exist, err := crq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CategoryRestrictionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (crq *CategoryRestrictionQuery) Clone() *CategoryRestrictionQuery {
+	if crq == nil {
+		return nil
+	}
+	return &CategoryRestrictionQuery{
+		config:     crq.config,
+		ctx:        crq.ctx.Clone(),
+		order:      append([]categoryrestriction.OrderOption{}, crq.order...),
+		inters:     append([]Interceptor{}, crq.inters...),
+		predicates: append([]predicate.CategoryRestriction{}, crq.predicates...),
+		// clone intermediate query.
+		sql:       crq.sql.Clone(),
+		path:      crq.path,
+		modifiers: append([]func(*sql.Selector){}, crq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UpdatedAt time.Time `json:"updated_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CategoryRestriction.Query().
+//		GroupBy(categoryrestriction.FieldUpdatedAt).
+//		Aggregate(generated.Count()).
+//		Scan(ctx, &v)
+func (crq *CategoryRestrictionQuery) GroupBy(field string, fields ...string) *CategoryRestrictionGroupBy {
+	crq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CategoryRestrictionGroupBy{build: crq}
+	grbuild.flds = &crq.ctx.Fields
+	grbuild.label = categoryrestriction.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UpdatedAt time.Time `json:"updated_at,omitempty"`
+//	}
+//
+//	client.CategoryRestriction.Query().
+//		Select(categoryrestriction.FieldUpdatedAt).
+//		Scan(ctx, &v)
+func (crq *CategoryRestrictionQuery) Select(fields ...string) *CategoryRestrictionSelect {
+	crq.ctx.Fields = append(crq.ctx.Fields, fields...)
+	sbuild := &CategoryRestrictionSelect{CategoryRestrictionQuery: crq}
+	sbuild.label = categoryrestriction.Label
+	sbuild.flds, sbuild.scan = &crq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CategoryRestrictionSelect configured with the given aggregations.
+func (crq *CategoryRestrictionQuery) Aggregate(fns ...AggregateFunc) *CategoryRestrictionSelect {
+	return crq.Select().Aggregate(fns...)
+}
+
+func (crq *CategoryRestrictionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range crq.inters {
+		if inter == nil {
+			return fmt.Errorf("generated: uninitialized interceptor (forgotten import generated/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, crq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range crq.ctx.Fields {
+		if !categoryrestriction.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+		}
+	}
+	if crq.path != nil {
+		prev, err := crq.path(ctx)
+		if err != nil {
+			return err
+		}
+		crq.sql = prev
+	}
+	if categoryrestriction.Policy == nil {
+		return errors.New("generated: uninitialized categoryrestriction.Policy (forgotten import generated/runtime?)")
+	}
+	if err := categoryrestriction.Policy.EvalQuery(ctx, crq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (crq *CategoryRestrictionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CategoryRestriction, error) {
+	var (
+		nodes = []*CategoryRestriction{}
+		_spec = crq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CategoryRestriction).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CategoryRestriction{config: crq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(crq.modifiers) > 0 {
+		_spec.Modifiers = crq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, crq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range crq.loadTotal {
+		if err := crq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (crq *CategoryRestrictionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := crq.querySpec()
+	if len(crq.modifiers) > 0 {
+		_spec.Modifiers = crq.modifiers
+	}
+	_spec.Node.Columns = crq.ctx.Fields
+	if len(crq.ctx.Fields) > 0 {
+		_spec.Unique = crq.ctx.Unique != nil && *crq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, crq.driver, _spec)
+}
+
+func (crq *CategoryRestrictionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(categoryrestriction.Table, categoryrestriction.Columns, sqlgraph.NewFieldSpec(categoryrestriction.FieldID, field.TypeUUID))
+	_spec.From = crq.sql
+	if unique := crq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if crq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := crq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, categoryrestriction.FieldID)
+		for i := range fields {
+			if fields[i] != categoryrestriction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := crq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := crq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := crq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := crq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (crq *CategoryRestrictionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(crq.driver.Dialect())
+	t1 := builder.Table(categoryrestriction.Table)
+	columns := crq.ctx.Fields
+	if len(columns) == 0 {
+		columns = categoryrestriction.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if crq.sql != nil {
+		selector = crq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if crq.ctx.Unique != nil && *crq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range crq.modifiers {
+		m(selector)
+	}
+	for _, p := range crq.predicates {
+		p(selector)
+	}
+	for _, p := range crq.order {
+		p(selector)
+	}
+	if offset := crq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := crq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (crq *CategoryRestrictionQuery) ForUpdate(opts ...sql.LockOption) *CategoryRestrictionQuery {
+	if crq.driver.Dialect() == dialect.Postgres {
+		crq.Unique(false)
+	}
+	crq.modifiers = append(crq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return crq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (crq *CategoryRestrictionQuery) ForShare(opts ...sql.LockOption) *CategoryRestrictionQuery {
+	if crq.driver.Dialect() == dialect.Postgres {
+		crq.Unique(false)
+	}
+	crq.modifiers = append(crq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return crq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (crq *CategoryRestrictionQuery) Modify(modifiers ...func(s *sql.Selector)) *CategoryRestrictionSelect {
+	crq.modifiers = append(crq.modifiers, modifiers...)
+	return crq.Select()
+}
+
+// CategoryRestrictionGroupBy is the group-by builder for CategoryRestriction entities.
+type CategoryRestrictionGroupBy struct {
+	selector
+	build *CategoryRestrictionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (crgb *CategoryRestrictionGroupBy) Aggregate(fns ...AggregateFunc) *CategoryRestrictionGroupBy {
+	crgb.fns = append(crgb.fns, fns...)
+	return crgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (crgb *CategoryRestrictionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, crgb.build.ctx, ent.OpQueryGroupBy)
+	if err := crgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CategoryRestrictionQuery, *CategoryRestrictionGroupBy](ctx, crgb.build, crgb, crgb.build.inters, v)
+}
+
+func (crgb *CategoryRestrictionGroupBy) sqlScan(ctx context.Context, root *CategoryRestrictionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(crgb.fns))
+	for _, fn := range crgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*crgb.flds)+len(crgb.fns))
+		for _, f := range *crgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*crgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := crgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CategoryRestrictionSelect is the builder for selecting fields of CategoryRestriction entities.
+type CategoryRestrictionSelect struct {
+	*CategoryRestrictionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (crs *CategoryRestrictionSelect) Aggregate(fns ...AggregateFunc) *CategoryRestrictionSelect {
+	crs.fns = append(crs.fns, fns...)
+	return crs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (crs *CategoryRestrictionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, crs.ctx, ent.OpQuerySelect)
+	if err := crs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CategoryRestrictionQuery, *CategoryRestrictionSelect](ctx, crs.CategoryRestrictionQuery, crs, crs.inters, v)
+}
+
+func (crs *CategoryRestrictionSelect) sqlScan(ctx context.Context, root *CategoryRestrictionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(crs.fns))
+	for _, fn := range crs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*crs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := crs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (crs *CategoryRestrictionSelect) Modify(modifiers ...func(s *sql.Selector)) *CategoryRestrictionSelect {
+	crs.modifiers = append(crs.modifiers, modifiers...)
+	return crs
+}
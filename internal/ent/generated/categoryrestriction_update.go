@@ -0,0 +1,308 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/categoryrestriction"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
+)
+
+// CategoryRestrictionUpdate is the builder for updating CategoryRestriction entities.
+type CategoryRestrictionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CategoryRestrictionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CategoryRestrictionUpdate builder.
+func (cru *CategoryRestrictionUpdate) Where(ps ...predicate.CategoryRestriction) *CategoryRestrictionUpdate {
+	cru.mutation.Where(ps...)
+	return cru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cru *CategoryRestrictionUpdate) SetUpdatedAt(t time.Time) *CategoryRestrictionUpdate {
+	cru.mutation.SetUpdatedAt(t)
+	return cru
+}
+
+// SetSubscriberOnlyPost sets the "subscriber_only_post" field.
+func (cru *CategoryRestrictionUpdate) SetSubscriberOnlyPost(b bool) *CategoryRestrictionUpdate {
+	cru.mutation.SetSubscriberOnlyPost(b)
+	return cru
+}
+
+// SetNillableSubscriberOnlyPost sets the "subscriber_only_post" field if the given value is not nil.
+func (cru *CategoryRestrictionUpdate) SetNillableSubscriberOnlyPost(b *bool) *CategoryRestrictionUpdate {
+	if b != nil {
+		cru.SetSubscriberOnlyPost(*b)
+	}
+	return cru
+}
+
+// SetSubscriberOnlyView sets the "subscriber_only_view" field.
+func (cru *CategoryRestrictionUpdate) SetSubscriberOnlyView(b bool) *CategoryRestrictionUpdate {
+	cru.mutation.SetSubscriberOnlyView(b)
+	return cru
+}
+
+// SetNillableSubscriberOnlyView sets the "subscriber_only_view" field if the given value is not nil.
+func (cru *CategoryRestrictionUpdate) SetNillableSubscriberOnlyView(b *bool) *CategoryRestrictionUpdate {
+	if b != nil {
+		cru.SetSubscriberOnlyView(*b)
+	}
+	return cru
+}
+
+// Mutation returns the CategoryRestrictionMutation object of the builder.
+func (cru *CategoryRestrictionUpdate) Mutation() *CategoryRestrictionMutation {
+	return cru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cru *CategoryRestrictionUpdate) Save(ctx context.Context) (int, error) {
+	if err := cru.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, cru.sqlSave, cru.mutation, cru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cru *CategoryRestrictionUpdate) SaveX(ctx context.Context) int {
+	affected, err := cru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cru *CategoryRestrictionUpdate) Exec(ctx context.Context) error {
+	_, err := cru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cru *CategoryRestrictionUpdate) ExecX(ctx context.Context) {
+	if err := cru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cru *CategoryRestrictionUpdate) defaults() error {
+	if _, ok := cru.mutation.UpdatedAt(); !ok {
+		if categoryrestriction.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized categoryrestriction.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := categoryrestriction.UpdateDefaultUpdatedAt()
+		cru.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cru *CategoryRestrictionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CategoryRestrictionUpdate {
+	cru.modifiers = append(cru.modifiers, modifiers...)
+	return cru
+}
+
+func (cru *CategoryRestrictionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(categoryrestriction.Table, categoryrestriction.Columns, sqlgraph.NewFieldSpec(categoryrestriction.FieldID, field.TypeUUID))
+	if ps := cru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cru.mutation.UpdatedAt(); ok {
+		_spec.SetField(categoryrestriction.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cru.mutation.SubscriberOnlyPost(); ok {
+		_spec.SetField(categoryrestriction.FieldSubscriberOnlyPost, field.TypeBool, value)
+	}
+	if value, ok := cru.mutation.SubscriberOnlyView(); ok {
+		_spec.SetField(categoryrestriction.FieldSubscriberOnlyView, field.TypeBool, value)
+	}
+	_spec.AddModifiers(cru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, cru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categoryrestriction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cru.mutation.done = true
+	return n, nil
+}
+
+// CategoryRestrictionUpdateOne is the builder for updating a single CategoryRestriction entity.
+type CategoryRestrictionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CategoryRestrictionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cruo *CategoryRestrictionUpdateOne) SetUpdatedAt(t time.Time) *CategoryRestrictionUpdateOne {
+	cruo.mutation.SetUpdatedAt(t)
+	return cruo
+}
+
+// SetSubscriberOnlyPost sets the "subscriber_only_post" field.
+func (cruo *CategoryRestrictionUpdateOne) SetSubscriberOnlyPost(b bool) *CategoryRestrictionUpdateOne {
+	cruo.mutation.SetSubscriberOnlyPost(b)
+	return cruo
+}
+
+// SetNillableSubscriberOnlyPost sets the "subscriber_only_post" field if the given value is not nil.
+func (cruo *CategoryRestrictionUpdateOne) SetNillableSubscriberOnlyPost(b *bool) *CategoryRestrictionUpdateOne {
+	if b != nil {
+		cruo.SetSubscriberOnlyPost(*b)
+	}
+	return cruo
+}
+
+// SetSubscriberOnlyView sets the "subscriber_only_view" field.
+func (cruo *CategoryRestrictionUpdateOne) SetSubscriberOnlyView(b bool) *CategoryRestrictionUpdateOne {
+	cruo.mutation.SetSubscriberOnlyView(b)
+	return cruo
+}
+
+// SetNillableSubscriberOnlyView sets the "subscriber_only_view" field if the given value is not nil.
+func (cruo *CategoryRestrictionUpdateOne) SetNillableSubscriberOnlyView(b *bool) *CategoryRestrictionUpdateOne {
+	if b != nil {
+		cruo.SetSubscriberOnlyView(*b)
+	}
+	return cruo
+}
+
+// Mutation returns the CategoryRestrictionMutation object of the builder.
+func (cruo *CategoryRestrictionUpdateOne) Mutation() *CategoryRestrictionMutation {
+	return cruo.mutation
+}
+
+// Where appends a list predicates to the CategoryRestrictionUpdate builder.
+func (cruo *CategoryRestrictionUpdateOne) Where(ps ...predicate.CategoryRestriction) *CategoryRestrictionUpdateOne {
+	cruo.mutation.Where(ps...)
+	return cruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cruo *CategoryRestrictionUpdateOne) Select(field string, fields ...string) *CategoryRestrictionUpdateOne {
+	cruo.fields = append([]string{field}, fields...)
+	return cruo
+}
+
+// Save executes the query and returns the updated CategoryRestriction entity.
+func (cruo *CategoryRestrictionUpdateOne) Save(ctx context.Context) (*CategoryRestriction, error) {
+	if err := cruo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, cruo.sqlSave, cruo.mutation, cruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cruo *CategoryRestrictionUpdateOne) SaveX(ctx context.Context) *CategoryRestriction {
+	node, err := cruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cruo *CategoryRestrictionUpdateOne) Exec(ctx context.Context) error {
+	_, err := cruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cruo *CategoryRestrictionUpdateOne) ExecX(ctx context.Context) {
+	if err := cruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cruo *CategoryRestrictionUpdateOne) defaults() error {
+	if _, ok := cruo.mutation.UpdatedAt(); !ok {
+		if categoryrestriction.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized categoryrestriction.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := categoryrestriction.UpdateDefaultUpdatedAt()
+		cruo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cruo *CategoryRestrictionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CategoryRestrictionUpdateOne {
+	cruo.modifiers = append(cruo.modifiers, modifiers...)
+	return cruo
+}
+
+func (cruo *CategoryRestrictionUpdateOne) sqlSave(ctx context.Context) (_node *CategoryRestriction, err error) {
+	_spec := sqlgraph.NewUpdateSpec(categoryrestriction.Table, categoryrestriction.Columns, sqlgraph.NewFieldSpec(categoryrestriction.FieldID, field.TypeUUID))
+	id, ok := cruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "CategoryRestriction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, categoryrestriction.FieldID)
+		for _, f := range fields {
+			if !categoryrestriction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != categoryrestriction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(categoryrestriction.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cruo.mutation.SubscriberOnlyPost(); ok {
+		_spec.SetField(categoryrestriction.FieldSubscriberOnlyPost, field.TypeBool, value)
+	}
+	if value, ok := cruo.mutation.SubscriberOnlyView(); ok {
+		_spec.SetField(categoryrestriction.FieldSubscriberOnlyView, field.TypeBool, value)
+	}
+	_spec.AddModifiers(cruo.modifiers...)
+	_node = &CategoryRestriction{config: cruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categoryrestriction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cruo.mutation.done = true
+	return _node, nil
+}
@@ -17,11 +17,19 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/apikey"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/categoryrestriction"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/comment"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/post"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/postcategory"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/refreshtoken"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/twitchtoken"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
 )
@@ -33,16 +41,32 @@ type Client struct {
 	Schema *migrate.Schema
 	// ApiKey is the client for interacting with the ApiKey builders.
 	ApiKey *ApiKeyClient
+	// AwardDefinition is the client for interacting with the AwardDefinition builders.
+	AwardDefinition *AwardDefinitionClient
+	// CategoryRestriction is the client for interacting with the CategoryRestriction builders.
+	CategoryRestriction *CategoryRestrictionClient
 	// Comment is the client for interacting with the Comment builders.
 	Comment *CommentClient
+	// Identity is the client for interacting with the Identity builders.
+	Identity *IdentityClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
 	// PostCategory is the client for interacting with the PostCategory builders.
 	PostCategory *PostCategoryClient
 	// RefreshToken is the client for interacting with the RefreshToken builders.
 	RefreshToken *RefreshTokenClient
+	// RoleChange is the client for interacting with the RoleChange builders.
+	RoleChange *RoleChangeClient
+	// RolePermission is the client for interacting with the RolePermission builders.
+	RolePermission *RolePermissionClient
+	// Suspension is the client for interacting with the Suspension builders.
+	Suspension *SuspensionClient
+	// TwitchToken is the client for interacting with the TwitchToken builders.
+	TwitchToken *TwitchTokenClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserAward is the client for interacting with the UserAward builders.
+	UserAward *UserAwardClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -55,11 +79,19 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.ApiKey = NewApiKeyClient(c.config)
+	c.AwardDefinition = NewAwardDefinitionClient(c.config)
+	c.CategoryRestriction = NewCategoryRestrictionClient(c.config)
 	c.Comment = NewCommentClient(c.config)
+	c.Identity = NewIdentityClient(c.config)
 	c.Post = NewPostClient(c.config)
 	c.PostCategory = NewPostCategoryClient(c.config)
 	c.RefreshToken = NewRefreshTokenClient(c.config)
+	c.RoleChange = NewRoleChangeClient(c.config)
+	c.RolePermission = NewRolePermissionClient(c.config)
+	c.Suspension = NewSuspensionClient(c.config)
+	c.TwitchToken = NewTwitchTokenClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.UserAward = NewUserAwardClient(c.config)
 }
 
 type (
@@ -166,14 +198,22 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		ApiKey:       NewApiKeyClient(cfg),
-		Comment:      NewCommentClient(cfg),
-		Post:         NewPostClient(cfg),
-		PostCategory: NewPostCategoryClient(cfg),
-		RefreshToken: NewRefreshTokenClient(cfg),
-		User:         NewUserClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		ApiKey:              NewApiKeyClient(cfg),
+		AwardDefinition:     NewAwardDefinitionClient(cfg),
+		CategoryRestriction: NewCategoryRestrictionClient(cfg),
+		Comment:             NewCommentClient(cfg),
+		Identity:            NewIdentityClient(cfg),
+		Post:                NewPostClient(cfg),
+		PostCategory:        NewPostCategoryClient(cfg),
+		RefreshToken:        NewRefreshTokenClient(cfg),
+		RoleChange:          NewRoleChangeClient(cfg),
+		RolePermission:      NewRolePermissionClient(cfg),
+		Suspension:          NewSuspensionClient(cfg),
+		TwitchToken:         NewTwitchTokenClient(cfg),
+		User:                NewUserClient(cfg),
+		UserAward:           NewUserAwardClient(cfg),
 	}, nil
 }
 
@@ -191,14 +231,22 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		ApiKey:       NewApiKeyClient(cfg),
-		Comment:      NewCommentClient(cfg),
-		Post:         NewPostClient(cfg),
-		PostCategory: NewPostCategoryClient(cfg),
-		RefreshToken: NewRefreshTokenClient(cfg),
-		User:         NewUserClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		ApiKey:              NewApiKeyClient(cfg),
+		AwardDefinition:     NewAwardDefinitionClient(cfg),
+		CategoryRestriction: NewCategoryRestrictionClient(cfg),
+		Comment:             NewCommentClient(cfg),
+		Identity:            NewIdentityClient(cfg),
+		Post:                NewPostClient(cfg),
+		PostCategory:        NewPostCategoryClient(cfg),
+		RefreshToken:        NewRefreshTokenClient(cfg),
+		RoleChange:          NewRoleChangeClient(cfg),
+		RolePermission:      NewRolePermissionClient(cfg),
+		Suspension:          NewSuspensionClient(cfg),
+		TwitchToken:         NewTwitchTokenClient(cfg),
+		User:                NewUserClient(cfg),
+		UserAward:           NewUserAwardClient(cfg),
 	}, nil
 }
 
@@ -228,7 +276,9 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.ApiKey, c.Comment, c.Post, c.PostCategory, c.RefreshToken, c.User,
+		c.ApiKey, c.AwardDefinition, c.CategoryRestriction, c.Comment, c.Identity,
+		c.Post, c.PostCategory, c.RefreshToken, c.RoleChange, c.RolePermission,
+		c.Suspension, c.TwitchToken, c.User, c.UserAward,
 	} {
 		n.Use(hooks...)
 	}
@@ -238,7 +288,9 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.ApiKey, c.Comment, c.Post, c.PostCategory, c.RefreshToken, c.User,
+		c.ApiKey, c.AwardDefinition, c.CategoryRestriction, c.Comment, c.Identity,
+		c.Post, c.PostCategory, c.RefreshToken, c.RoleChange, c.RolePermission,
+		c.Suspension, c.TwitchToken, c.User, c.UserAward,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -249,16 +301,32 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *ApiKeyMutation:
 		return c.ApiKey.mutate(ctx, m)
+	case *AwardDefinitionMutation:
+		return c.AwardDefinition.mutate(ctx, m)
+	case *CategoryRestrictionMutation:
+		return c.CategoryRestriction.mutate(ctx, m)
 	case *CommentMutation:
 		return c.Comment.mutate(ctx, m)
+	case *IdentityMutation:
+		return c.Identity.mutate(ctx, m)
 	case *PostMutation:
 		return c.Post.mutate(ctx, m)
 	case *PostCategoryMutation:
 		return c.PostCategory.mutate(ctx, m)
 	case *RefreshTokenMutation:
 		return c.RefreshToken.mutate(ctx, m)
+	case *RoleChangeMutation:
+		return c.RoleChange.mutate(ctx, m)
+	case *RolePermissionMutation:
+		return c.RolePermission.mutate(ctx, m)
+	case *SuspensionMutation:
+		return c.Suspension.mutate(ctx, m)
+	case *TwitchTokenMutation:
+		return c.TwitchToken.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *UserAwardMutation:
+		return c.UserAward.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("generated: unknown mutation type %T", m)
 	}
@@ -415,6 +483,290 @@ func (c *ApiKeyClient) mutate(ctx context.Context, m *ApiKeyMutation) (Value, er
 	}
 }
 
+// AwardDefinitionClient is a client for the AwardDefinition schema.
+type AwardDefinitionClient struct {
+	config
+}
+
+// NewAwardDefinitionClient returns a client for the AwardDefinition from the given config.
+func NewAwardDefinitionClient(c config) *AwardDefinitionClient {
+	return &AwardDefinitionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `awarddefinition.Hooks(f(g(h())))`.
+func (c *AwardDefinitionClient) Use(hooks ...Hook) {
+	c.hooks.AwardDefinition = append(c.hooks.AwardDefinition, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `awarddefinition.Intercept(f(g(h())))`.
+func (c *AwardDefinitionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AwardDefinition = append(c.inters.AwardDefinition, interceptors...)
+}
+
+// Create returns a builder for creating a AwardDefinition entity.
+func (c *AwardDefinitionClient) Create() *AwardDefinitionCreate {
+	mutation := newAwardDefinitionMutation(c.config, OpCreate)
+	return &AwardDefinitionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AwardDefinition entities.
+func (c *AwardDefinitionClient) CreateBulk(builders ...*AwardDefinitionCreate) *AwardDefinitionCreateBulk {
+	return &AwardDefinitionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AwardDefinitionClient) MapCreateBulk(slice any, setFunc func(*AwardDefinitionCreate, int)) *AwardDefinitionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AwardDefinitionCreateBulk{err: fmt.Errorf("calling to AwardDefinitionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AwardDefinitionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AwardDefinitionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AwardDefinition.
+func (c *AwardDefinitionClient) Update() *AwardDefinitionUpdate {
+	mutation := newAwardDefinitionMutation(c.config, OpUpdate)
+	return &AwardDefinitionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AwardDefinitionClient) UpdateOne(ad *AwardDefinition) *AwardDefinitionUpdateOne {
+	mutation := newAwardDefinitionMutation(c.config, OpUpdateOne, withAwardDefinition(ad))
+	return &AwardDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AwardDefinitionClient) UpdateOneID(id uuid.UUID) *AwardDefinitionUpdateOne {
+	mutation := newAwardDefinitionMutation(c.config, OpUpdateOne, withAwardDefinitionID(id))
+	return &AwardDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AwardDefinition.
+func (c *AwardDefinitionClient) Delete() *AwardDefinitionDelete {
+	mutation := newAwardDefinitionMutation(c.config, OpDelete)
+	return &AwardDefinitionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AwardDefinitionClient) DeleteOne(ad *AwardDefinition) *AwardDefinitionDeleteOne {
+	return c.DeleteOneID(ad.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AwardDefinitionClient) DeleteOneID(id uuid.UUID) *AwardDefinitionDeleteOne {
+	builder := c.Delete().Where(awarddefinition.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AwardDefinitionDeleteOne{builder}
+}
+
+// Query returns a query builder for AwardDefinition.
+func (c *AwardDefinitionClient) Query() *AwardDefinitionQuery {
+	return &AwardDefinitionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAwardDefinition},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AwardDefinition entity by its id.
+func (c *AwardDefinitionClient) Get(ctx context.Context, id uuid.UUID) (*AwardDefinition, error) {
+	return c.Query().Where(awarddefinition.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AwardDefinitionClient) GetX(ctx context.Context, id uuid.UUID) *AwardDefinition {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGrants queries the grants edge of a AwardDefinition.
+func (c *AwardDefinitionClient) QueryGrants(ad *AwardDefinition) *UserAwardQuery {
+	query := (&UserAwardClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ad.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(awarddefinition.Table, awarddefinition.FieldID, id),
+			sqlgraph.To(useraward.Table, useraward.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, awarddefinition.GrantsTable, awarddefinition.GrantsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ad.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AwardDefinitionClient) Hooks() []Hook {
+	hooks := c.hooks.AwardDefinition
+	return append(hooks[:len(hooks):len(hooks)], awarddefinition.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *AwardDefinitionClient) Interceptors() []Interceptor {
+	return c.inters.AwardDefinition
+}
+
+func (c *AwardDefinitionClient) mutate(ctx context.Context, m *AwardDefinitionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AwardDefinitionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AwardDefinitionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AwardDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AwardDefinitionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown AwardDefinition mutation op: %q", m.Op())
+	}
+}
+
+// CategoryRestrictionClient is a client for the CategoryRestriction schema.
+type CategoryRestrictionClient struct {
+	config
+}
+
+// NewCategoryRestrictionClient returns a client for the CategoryRestriction from the given config.
+func NewCategoryRestrictionClient(c config) *CategoryRestrictionClient {
+	return &CategoryRestrictionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `categoryrestriction.Hooks(f(g(h())))`.
+func (c *CategoryRestrictionClient) Use(hooks ...Hook) {
+	c.hooks.CategoryRestriction = append(c.hooks.CategoryRestriction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `categoryrestriction.Intercept(f(g(h())))`.
+func (c *CategoryRestrictionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CategoryRestriction = append(c.inters.CategoryRestriction, interceptors...)
+}
+
+// Create returns a builder for creating a CategoryRestriction entity.
+func (c *CategoryRestrictionClient) Create() *CategoryRestrictionCreate {
+	mutation := newCategoryRestrictionMutation(c.config, OpCreate)
+	return &CategoryRestrictionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CategoryRestriction entities.
+func (c *CategoryRestrictionClient) CreateBulk(builders ...*CategoryRestrictionCreate) *CategoryRestrictionCreateBulk {
+	return &CategoryRestrictionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CategoryRestrictionClient) MapCreateBulk(slice any, setFunc func(*CategoryRestrictionCreate, int)) *CategoryRestrictionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CategoryRestrictionCreateBulk{err: fmt.Errorf("calling to CategoryRestrictionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CategoryRestrictionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CategoryRestrictionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CategoryRestriction.
+func (c *CategoryRestrictionClient) Update() *CategoryRestrictionUpdate {
+	mutation := newCategoryRestrictionMutation(c.config, OpUpdate)
+	return &CategoryRestrictionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CategoryRestrictionClient) UpdateOne(cr *CategoryRestriction) *CategoryRestrictionUpdateOne {
+	mutation := newCategoryRestrictionMutation(c.config, OpUpdateOne, withCategoryRestriction(cr))
+	return &CategoryRestrictionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CategoryRestrictionClient) UpdateOneID(id uuid.UUID) *CategoryRestrictionUpdateOne {
+	mutation := newCategoryRestrictionMutation(c.config, OpUpdateOne, withCategoryRestrictionID(id))
+	return &CategoryRestrictionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CategoryRestriction.
+func (c *CategoryRestrictionClient) Delete() *CategoryRestrictionDelete {
+	mutation := newCategoryRestrictionMutation(c.config, OpDelete)
+	return &CategoryRestrictionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CategoryRestrictionClient) DeleteOne(cr *CategoryRestriction) *CategoryRestrictionDeleteOne {
+	return c.DeleteOneID(cr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CategoryRestrictionClient) DeleteOneID(id uuid.UUID) *CategoryRestrictionDeleteOne {
+	builder := c.Delete().Where(categoryrestriction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CategoryRestrictionDeleteOne{builder}
+}
+
+// Query returns a query builder for CategoryRestriction.
+func (c *CategoryRestrictionClient) Query() *CategoryRestrictionQuery {
+	return &CategoryRestrictionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCategoryRestriction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CategoryRestriction entity by its id.
+func (c *CategoryRestrictionClient) Get(ctx context.Context, id uuid.UUID) (*CategoryRestriction, error) {
+	return c.Query().Where(categoryrestriction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CategoryRestrictionClient) GetX(ctx context.Context, id uuid.UUID) *CategoryRestriction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CategoryRestrictionClient) Hooks() []Hook {
+	hooks := c.hooks.CategoryRestriction
+	return append(hooks[:len(hooks):len(hooks)], categoryrestriction.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *CategoryRestrictionClient) Interceptors() []Interceptor {
+	return c.inters.CategoryRestriction
+}
+
+func (c *CategoryRestrictionClient) mutate(ctx context.Context, m *CategoryRestrictionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CategoryRestrictionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CategoryRestrictionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CategoryRestrictionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CategoryRestrictionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown CategoryRestriction mutation op: %q", m.Op())
+	}
+}
+
 // CommentClient is a client for the Comment schema.
 type CommentClient struct {
 	config
@@ -582,6 +934,157 @@ func (c *CommentClient) mutate(ctx context.Context, m *CommentMutation) (Value,
 	}
 }
 
+// IdentityClient is a client for the Identity schema.
+type IdentityClient struct {
+	config
+}
+
+// NewIdentityClient returns a client for the Identity from the given config.
+func NewIdentityClient(c config) *IdentityClient {
+	return &IdentityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `identity.Hooks(f(g(h())))`.
+func (c *IdentityClient) Use(hooks ...Hook) {
+	c.hooks.Identity = append(c.hooks.Identity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `identity.Intercept(f(g(h())))`.
+func (c *IdentityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Identity = append(c.inters.Identity, interceptors...)
+}
+
+// Create returns a builder for creating a Identity entity.
+func (c *IdentityClient) Create() *IdentityCreate {
+	mutation := newIdentityMutation(c.config, OpCreate)
+	return &IdentityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Identity entities.
+func (c *IdentityClient) CreateBulk(builders ...*IdentityCreate) *IdentityCreateBulk {
+	return &IdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IdentityClient) MapCreateBulk(slice any, setFunc func(*IdentityCreate, int)) *IdentityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IdentityCreateBulk{err: fmt.Errorf("calling to IdentityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IdentityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Identity.
+func (c *IdentityClient) Update() *IdentityUpdate {
+	mutation := newIdentityMutation(c.config, OpUpdate)
+	return &IdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdentityClient) UpdateOne(i *Identity) *IdentityUpdateOne {
+	mutation := newIdentityMutation(c.config, OpUpdateOne, withIdentity(i))
+	return &IdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdentityClient) UpdateOneID(id uuid.UUID) *IdentityUpdateOne {
+	mutation := newIdentityMutation(c.config, OpUpdateOne, withIdentityID(id))
+	return &IdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Identity.
+func (c *IdentityClient) Delete() *IdentityDelete {
+	mutation := newIdentityMutation(c.config, OpDelete)
+	return &IdentityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IdentityClient) DeleteOne(i *Identity) *IdentityDeleteOne {
+	return c.DeleteOneID(i.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IdentityClient) DeleteOneID(id uuid.UUID) *IdentityDeleteOne {
+	builder := c.Delete().Where(identity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IdentityDeleteOne{builder}
+}
+
+// Query returns a query builder for Identity.
+func (c *IdentityClient) Query() *IdentityQuery {
+	return &IdentityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIdentity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Identity entity by its id.
+func (c *IdentityClient) Get(ctx context.Context, id uuid.UUID) (*Identity, error) {
+	return c.Query().Where(identity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IdentityClient) GetX(ctx context.Context, id uuid.UUID) *Identity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a Identity.
+func (c *IdentityClient) QueryOwner(i *Identity) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(identity.Table, identity.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, identity.OwnerTable, identity.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *IdentityClient) Hooks() []Hook {
+	hooks := c.hooks.Identity
+	return append(hooks[:len(hooks):len(hooks)], identity.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *IdentityClient) Interceptors() []Interceptor {
+	inters := c.inters.Identity
+	return append(inters[:len(inters):len(inters)], identity.Interceptors[:]...)
+}
+
+func (c *IdentityClient) mutate(ctx context.Context, m *IdentityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IdentityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IdentityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Identity mutation op: %q", m.Op())
+	}
+}
+
 // PostClient is a client for the Post schema.
 type PostClient struct {
 	config
@@ -923,7 +1426,8 @@ func (c *PostCategoryClient) QueryPost(pc *PostCategory) *PostQuery {
 
 // Hooks returns the client hooks.
 func (c *PostCategoryClient) Hooks() []Hook {
-	return c.hooks.PostCategory
+	hooks := c.hooks.PostCategory
+	return append(hooks[:len(hooks):len(hooks)], postcategory.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -1097,35 +1601,619 @@ func (c *RefreshTokenClient) mutate(ctx context.Context, m *RefreshTokenMutation
 	}
 }
 
-// UserClient is a client for the User schema.
-type UserClient struct {
+// RoleChangeClient is a client for the RoleChange schema.
+type RoleChangeClient struct {
 	config
 }
 
-// NewUserClient returns a client for the User from the given config.
-func NewUserClient(c config) *UserClient {
-	return &UserClient{config: c}
+// NewRoleChangeClient returns a client for the RoleChange from the given config.
+func NewRoleChangeClient(c config) *RoleChangeClient {
+	return &RoleChangeClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
-func (c *UserClient) Use(hooks ...Hook) {
-	c.hooks.User = append(c.hooks.User, hooks...)
+// A call to `Use(f, g, h)` equals to `rolechange.Hooks(f(g(h())))`.
+func (c *RoleChangeClient) Use(hooks ...Hook) {
+	c.hooks.RoleChange = append(c.hooks.RoleChange, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
-func (c *UserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.User = append(c.inters.User, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `rolechange.Intercept(f(g(h())))`.
+func (c *RoleChangeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoleChange = append(c.inters.RoleChange, interceptors...)
 }
 
-// Create returns a builder for creating a User entity.
-func (c *UserClient) Create() *UserCreate {
-	mutation := newUserMutation(c.config, OpCreate)
-	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a RoleChange entity.
+func (c *RoleChangeClient) Create() *RoleChangeCreate {
+	mutation := newRoleChangeMutation(c.config, OpCreate)
+	return &RoleChangeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of User entities.
+// CreateBulk returns a builder for creating a bulk of RoleChange entities.
+func (c *RoleChangeClient) CreateBulk(builders ...*RoleChangeCreate) *RoleChangeCreateBulk {
+	return &RoleChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoleChangeClient) MapCreateBulk(slice any, setFunc func(*RoleChangeCreate, int)) *RoleChangeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoleChangeCreateBulk{err: fmt.Errorf("calling to RoleChangeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoleChangeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoleChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RoleChange.
+func (c *RoleChangeClient) Update() *RoleChangeUpdate {
+	mutation := newRoleChangeMutation(c.config, OpUpdate)
+	return &RoleChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoleChangeClient) UpdateOne(rc *RoleChange) *RoleChangeUpdateOne {
+	mutation := newRoleChangeMutation(c.config, OpUpdateOne, withRoleChange(rc))
+	return &RoleChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoleChangeClient) UpdateOneID(id uuid.UUID) *RoleChangeUpdateOne {
+	mutation := newRoleChangeMutation(c.config, OpUpdateOne, withRoleChangeID(id))
+	return &RoleChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RoleChange.
+func (c *RoleChangeClient) Delete() *RoleChangeDelete {
+	mutation := newRoleChangeMutation(c.config, OpDelete)
+	return &RoleChangeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoleChangeClient) DeleteOne(rc *RoleChange) *RoleChangeDeleteOne {
+	return c.DeleteOneID(rc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoleChangeClient) DeleteOneID(id uuid.UUID) *RoleChangeDeleteOne {
+	builder := c.Delete().Where(rolechange.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleChangeDeleteOne{builder}
+}
+
+// Query returns a query builder for RoleChange.
+func (c *RoleChangeClient) Query() *RoleChangeQuery {
+	return &RoleChangeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoleChange},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RoleChange entity by its id.
+func (c *RoleChangeClient) Get(ctx context.Context, id uuid.UUID) (*RoleChange, error) {
+	return c.Query().Where(rolechange.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleChangeClient) GetX(ctx context.Context, id uuid.UUID) *RoleChange {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a RoleChange.
+func (c *RoleChangeClient) QueryUser(rc *RoleChange) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rolechange.Table, rolechange.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rolechange.UserTable, rolechange.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoleChangeClient) Hooks() []Hook {
+	hooks := c.hooks.RoleChange
+	return append(hooks[:len(hooks):len(hooks)], rolechange.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoleChangeClient) Interceptors() []Interceptor {
+	return c.inters.RoleChange
+}
+
+func (c *RoleChangeClient) mutate(ctx context.Context, m *RoleChangeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoleChangeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoleChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoleChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoleChangeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown RoleChange mutation op: %q", m.Op())
+	}
+}
+
+// RolePermissionClient is a client for the RolePermission schema.
+type RolePermissionClient struct {
+	config
+}
+
+// NewRolePermissionClient returns a client for the RolePermission from the given config.
+func NewRolePermissionClient(c config) *RolePermissionClient {
+	return &RolePermissionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rolepermission.Hooks(f(g(h())))`.
+func (c *RolePermissionClient) Use(hooks ...Hook) {
+	c.hooks.RolePermission = append(c.hooks.RolePermission, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `rolepermission.Intercept(f(g(h())))`.
+func (c *RolePermissionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RolePermission = append(c.inters.RolePermission, interceptors...)
+}
+
+// Create returns a builder for creating a RolePermission entity.
+func (c *RolePermissionClient) Create() *RolePermissionCreate {
+	mutation := newRolePermissionMutation(c.config, OpCreate)
+	return &RolePermissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RolePermission entities.
+func (c *RolePermissionClient) CreateBulk(builders ...*RolePermissionCreate) *RolePermissionCreateBulk {
+	return &RolePermissionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RolePermissionClient) MapCreateBulk(slice any, setFunc func(*RolePermissionCreate, int)) *RolePermissionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RolePermissionCreateBulk{err: fmt.Errorf("calling to RolePermissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RolePermissionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RolePermissionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RolePermission.
+func (c *RolePermissionClient) Update() *RolePermissionUpdate {
+	mutation := newRolePermissionMutation(c.config, OpUpdate)
+	return &RolePermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RolePermissionClient) UpdateOne(rp *RolePermission) *RolePermissionUpdateOne {
+	mutation := newRolePermissionMutation(c.config, OpUpdateOne, withRolePermission(rp))
+	return &RolePermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RolePermissionClient) UpdateOneID(id uuid.UUID) *RolePermissionUpdateOne {
+	mutation := newRolePermissionMutation(c.config, OpUpdateOne, withRolePermissionID(id))
+	return &RolePermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RolePermission.
+func (c *RolePermissionClient) Delete() *RolePermissionDelete {
+	mutation := newRolePermissionMutation(c.config, OpDelete)
+	return &RolePermissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RolePermissionClient) DeleteOne(rp *RolePermission) *RolePermissionDeleteOne {
+	return c.DeleteOneID(rp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RolePermissionClient) DeleteOneID(id uuid.UUID) *RolePermissionDeleteOne {
+	builder := c.Delete().Where(rolepermission.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RolePermissionDeleteOne{builder}
+}
+
+// Query returns a query builder for RolePermission.
+func (c *RolePermissionClient) Query() *RolePermissionQuery {
+	return &RolePermissionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRolePermission},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RolePermission entity by its id.
+func (c *RolePermissionClient) Get(ctx context.Context, id uuid.UUID) (*RolePermission, error) {
+	return c.Query().Where(rolepermission.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RolePermissionClient) GetX(ctx context.Context, id uuid.UUID) *RolePermission {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RolePermissionClient) Hooks() []Hook {
+	hooks := c.hooks.RolePermission
+	return append(hooks[:len(hooks):len(hooks)], rolepermission.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *RolePermissionClient) Interceptors() []Interceptor {
+	return c.inters.RolePermission
+}
+
+func (c *RolePermissionClient) mutate(ctx context.Context, m *RolePermissionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RolePermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RolePermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RolePermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RolePermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown RolePermission mutation op: %q", m.Op())
+	}
+}
+
+// SuspensionClient is a client for the Suspension schema.
+type SuspensionClient struct {
+	config
+}
+
+// NewSuspensionClient returns a client for the Suspension from the given config.
+func NewSuspensionClient(c config) *SuspensionClient {
+	return &SuspensionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `suspension.Hooks(f(g(h())))`.
+func (c *SuspensionClient) Use(hooks ...Hook) {
+	c.hooks.Suspension = append(c.hooks.Suspension, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `suspension.Intercept(f(g(h())))`.
+func (c *SuspensionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Suspension = append(c.inters.Suspension, interceptors...)
+}
+
+// Create returns a builder for creating a Suspension entity.
+func (c *SuspensionClient) Create() *SuspensionCreate {
+	mutation := newSuspensionMutation(c.config, OpCreate)
+	return &SuspensionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Suspension entities.
+func (c *SuspensionClient) CreateBulk(builders ...*SuspensionCreate) *SuspensionCreateBulk {
+	return &SuspensionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SuspensionClient) MapCreateBulk(slice any, setFunc func(*SuspensionCreate, int)) *SuspensionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SuspensionCreateBulk{err: fmt.Errorf("calling to SuspensionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SuspensionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SuspensionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Suspension.
+func (c *SuspensionClient) Update() *SuspensionUpdate {
+	mutation := newSuspensionMutation(c.config, OpUpdate)
+	return &SuspensionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SuspensionClient) UpdateOne(s *Suspension) *SuspensionUpdateOne {
+	mutation := newSuspensionMutation(c.config, OpUpdateOne, withSuspension(s))
+	return &SuspensionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SuspensionClient) UpdateOneID(id uuid.UUID) *SuspensionUpdateOne {
+	mutation := newSuspensionMutation(c.config, OpUpdateOne, withSuspensionID(id))
+	return &SuspensionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Suspension.
+func (c *SuspensionClient) Delete() *SuspensionDelete {
+	mutation := newSuspensionMutation(c.config, OpDelete)
+	return &SuspensionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SuspensionClient) DeleteOne(s *Suspension) *SuspensionDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SuspensionClient) DeleteOneID(id uuid.UUID) *SuspensionDeleteOne {
+	builder := c.Delete().Where(suspension.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SuspensionDeleteOne{builder}
+}
+
+// Query returns a query builder for Suspension.
+func (c *SuspensionClient) Query() *SuspensionQuery {
+	return &SuspensionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSuspension},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Suspension entity by its id.
+func (c *SuspensionClient) Get(ctx context.Context, id uuid.UUID) (*Suspension, error) {
+	return c.Query().Where(suspension.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SuspensionClient) GetX(ctx context.Context, id uuid.UUID) *Suspension {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Suspension.
+func (c *SuspensionClient) QueryUser(s *Suspension) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(suspension.Table, suspension.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, suspension.UserTable, suspension.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIssuer queries the issuer edge of a Suspension.
+func (c *SuspensionClient) QueryIssuer(s *Suspension) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(suspension.Table, suspension.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, suspension.IssuerTable, suspension.IssuerColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SuspensionClient) Hooks() []Hook {
+	hooks := c.hooks.Suspension
+	return append(hooks[:len(hooks):len(hooks)], suspension.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SuspensionClient) Interceptors() []Interceptor {
+	return c.inters.Suspension
+}
+
+func (c *SuspensionClient) mutate(ctx context.Context, m *SuspensionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SuspensionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SuspensionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SuspensionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SuspensionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Suspension mutation op: %q", m.Op())
+	}
+}
+
+// TwitchTokenClient is a client for the TwitchToken schema.
+type TwitchTokenClient struct {
+	config
+}
+
+// NewTwitchTokenClient returns a client for the TwitchToken from the given config.
+func NewTwitchTokenClient(c config) *TwitchTokenClient {
+	return &TwitchTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `twitchtoken.Hooks(f(g(h())))`.
+func (c *TwitchTokenClient) Use(hooks ...Hook) {
+	c.hooks.TwitchToken = append(c.hooks.TwitchToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `twitchtoken.Intercept(f(g(h())))`.
+func (c *TwitchTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TwitchToken = append(c.inters.TwitchToken, interceptors...)
+}
+
+// Create returns a builder for creating a TwitchToken entity.
+func (c *TwitchTokenClient) Create() *TwitchTokenCreate {
+	mutation := newTwitchTokenMutation(c.config, OpCreate)
+	return &TwitchTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TwitchToken entities.
+func (c *TwitchTokenClient) CreateBulk(builders ...*TwitchTokenCreate) *TwitchTokenCreateBulk {
+	return &TwitchTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TwitchTokenClient) MapCreateBulk(slice any, setFunc func(*TwitchTokenCreate, int)) *TwitchTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TwitchTokenCreateBulk{err: fmt.Errorf("calling to TwitchTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TwitchTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TwitchTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TwitchToken.
+func (c *TwitchTokenClient) Update() *TwitchTokenUpdate {
+	mutation := newTwitchTokenMutation(c.config, OpUpdate)
+	return &TwitchTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TwitchTokenClient) UpdateOne(tt *TwitchToken) *TwitchTokenUpdateOne {
+	mutation := newTwitchTokenMutation(c.config, OpUpdateOne, withTwitchToken(tt))
+	return &TwitchTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TwitchTokenClient) UpdateOneID(id uuid.UUID) *TwitchTokenUpdateOne {
+	mutation := newTwitchTokenMutation(c.config, OpUpdateOne, withTwitchTokenID(id))
+	return &TwitchTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TwitchToken.
+func (c *TwitchTokenClient) Delete() *TwitchTokenDelete {
+	mutation := newTwitchTokenMutation(c.config, OpDelete)
+	return &TwitchTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TwitchTokenClient) DeleteOne(tt *TwitchToken) *TwitchTokenDeleteOne {
+	return c.DeleteOneID(tt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TwitchTokenClient) DeleteOneID(id uuid.UUID) *TwitchTokenDeleteOne {
+	builder := c.Delete().Where(twitchtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TwitchTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for TwitchToken.
+func (c *TwitchTokenClient) Query() *TwitchTokenQuery {
+	return &TwitchTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTwitchToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TwitchToken entity by its id.
+func (c *TwitchTokenClient) Get(ctx context.Context, id uuid.UUID) (*TwitchToken, error) {
+	return c.Query().Where(twitchtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TwitchTokenClient) GetX(ctx context.Context, id uuid.UUID) *TwitchToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TwitchTokenClient) Hooks() []Hook {
+	hooks := c.hooks.TwitchToken
+	return append(hooks[:len(hooks):len(hooks)], twitchtoken.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TwitchTokenClient) Interceptors() []Interceptor {
+	return c.inters.TwitchToken
+}
+
+func (c *TwitchTokenClient) mutate(ctx context.Context, m *TwitchTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TwitchTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TwitchTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TwitchTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TwitchTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown TwitchToken mutation op: %q", m.Op())
+	}
+}
+
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
 func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
 	return &UserCreateBulk{config: c.config, builders: builders}
 }
@@ -1285,6 +2373,102 @@ func (c *UserClient) QueryAPIKeys(u *User) *ApiKeyQuery {
 	return query
 }
 
+// QueryAwards queries the awards edge of a User.
+func (c *UserClient) QueryAwards(u *User) *UserAwardQuery {
+	query := (&UserAwardClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(useraward.Table, useraward.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AwardsTable, user.AwardsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBlockedUsers queries the blocked_users edge of a User.
+func (c *UserClient) QueryBlockedUsers(u *User) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, user.BlockedUsersTable, user.BlockedUsersPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBlockedBy queries the blocked_by edge of a User.
+func (c *UserClient) QueryBlockedBy(u *User) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, user.BlockedByTable, user.BlockedByPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMutedUsers queries the muted_users edge of a User.
+func (c *UserClient) QueryMutedUsers(u *User) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, user.MutedUsersTable, user.MutedUsersPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMutedBy queries the muted_by edge of a User.
+func (c *UserClient) QueryMutedBy(u *User) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, user.MutedByTable, user.MutedByPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIdentities queries the identities edge of a User.
+func (c *UserClient) QueryIdentities(u *User) *IdentityQuery {
+	query := (&IdentityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(identity.Table, identity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.IdentitiesTable, user.IdentitiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryRefreshTokens queries the refresh_tokens edge of a User.
 func (c *UserClient) QueryRefreshTokens(u *User) *RefreshTokenQuery {
 	query := (&RefreshTokenClient{config: c.config}).Query()
@@ -1301,6 +2485,38 @@ func (c *UserClient) QueryRefreshTokens(u *User) *RefreshTokenQuery {
 	return query
 }
 
+// QueryRoleChanges queries the role_changes edge of a User.
+func (c *UserClient) QueryRoleChanges(u *User) *RoleChangeQuery {
+	query := (&RoleChangeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(rolechange.Table, rolechange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RoleChangesTable, user.RoleChangesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySuspensions queries the suspensions edge of a User.
+func (c *UserClient) QuerySuspensions(u *User) *SuspensionQuery {
+	query := (&SuspensionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(suspension.Table, suspension.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SuspensionsTable, user.SuspensionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	hooks := c.hooks.User
@@ -1328,12 +2544,183 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 	}
 }
 
+// UserAwardClient is a client for the UserAward schema.
+type UserAwardClient struct {
+	config
+}
+
+// NewUserAwardClient returns a client for the UserAward from the given config.
+func NewUserAwardClient(c config) *UserAwardClient {
+	return &UserAwardClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `useraward.Hooks(f(g(h())))`.
+func (c *UserAwardClient) Use(hooks ...Hook) {
+	c.hooks.UserAward = append(c.hooks.UserAward, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `useraward.Intercept(f(g(h())))`.
+func (c *UserAwardClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserAward = append(c.inters.UserAward, interceptors...)
+}
+
+// Create returns a builder for creating a UserAward entity.
+func (c *UserAwardClient) Create() *UserAwardCreate {
+	mutation := newUserAwardMutation(c.config, OpCreate)
+	return &UserAwardCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserAward entities.
+func (c *UserAwardClient) CreateBulk(builders ...*UserAwardCreate) *UserAwardCreateBulk {
+	return &UserAwardCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserAwardClient) MapCreateBulk(slice any, setFunc func(*UserAwardCreate, int)) *UserAwardCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserAwardCreateBulk{err: fmt.Errorf("calling to UserAwardClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserAwardCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserAwardCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserAward.
+func (c *UserAwardClient) Update() *UserAwardUpdate {
+	mutation := newUserAwardMutation(c.config, OpUpdate)
+	return &UserAwardUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserAwardClient) UpdateOne(ua *UserAward) *UserAwardUpdateOne {
+	mutation := newUserAwardMutation(c.config, OpUpdateOne, withUserAward(ua))
+	return &UserAwardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserAwardClient) UpdateOneID(id uuid.UUID) *UserAwardUpdateOne {
+	mutation := newUserAwardMutation(c.config, OpUpdateOne, withUserAwardID(id))
+	return &UserAwardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserAward.
+func (c *UserAwardClient) Delete() *UserAwardDelete {
+	mutation := newUserAwardMutation(c.config, OpDelete)
+	return &UserAwardDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserAwardClient) DeleteOne(ua *UserAward) *UserAwardDeleteOne {
+	return c.DeleteOneID(ua.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserAwardClient) DeleteOneID(id uuid.UUID) *UserAwardDeleteOne {
+	builder := c.Delete().Where(useraward.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserAwardDeleteOne{builder}
+}
+
+// Query returns a query builder for UserAward.
+func (c *UserAwardClient) Query() *UserAwardQuery {
+	return &UserAwardQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserAward},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserAward entity by its id.
+func (c *UserAwardClient) Get(ctx context.Context, id uuid.UUID) (*UserAward, error) {
+	return c.Query().Where(useraward.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserAwardClient) GetX(ctx context.Context, id uuid.UUID) *UserAward {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a UserAward.
+func (c *UserAwardClient) QueryOwner(ua *UserAward) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ua.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(useraward.Table, useraward.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, useraward.OwnerTable, useraward.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(ua.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAward queries the award edge of a UserAward.
+func (c *UserAwardClient) QueryAward(ua *UserAward) *AwardDefinitionQuery {
+	query := (&AwardDefinitionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ua.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(useraward.Table, useraward.FieldID, id),
+			sqlgraph.To(awarddefinition.Table, awarddefinition.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, useraward.AwardTable, useraward.AwardColumn),
+		)
+		fromV = sqlgraph.Neighbors(ua.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserAwardClient) Hooks() []Hook {
+	hooks := c.hooks.UserAward
+	return append(hooks[:len(hooks):len(hooks)], useraward.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserAwardClient) Interceptors() []Interceptor {
+	inters := c.inters.UserAward
+	return append(inters[:len(inters):len(inters)], useraward.Interceptors[:]...)
+}
+
+func (c *UserAwardClient) mutate(ctx context.Context, m *UserAwardMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserAwardCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserAwardUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserAwardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserAwardDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown UserAward mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		ApiKey, Comment, Post, PostCategory, RefreshToken, User []ent.Hook
+		ApiKey, AwardDefinition, CategoryRestriction, Comment, Identity, Post,
+		PostCategory, RefreshToken, RoleChange, RolePermission, Suspension,
+		TwitchToken, User, UserAward []ent.Hook
 	}
 	inters struct {
-		ApiKey, Comment, Post, PostCategory, RefreshToken, User []ent.Interceptor
+		ApiKey, AwardDefinition, CategoryRestriction, Comment, Identity, Post,
+		PostCategory, RefreshToken, RoleChange, RolePermission, Suspension,
+		TwitchToken, User, UserAward []ent.Interceptor
 	}
 )
@@ -88,8 +88,9 @@ func ValidColumn(column string) bool {
 //
 //	import _ "github.com/caliecode/la-clipasa/internal/ent/generated/runtime"
 var (
-	Hooks        [2]ent.Hook
-	Interceptors [2]ent.Interceptor
+	Hooks        [4]ent.Hook
+	Interceptors [4]ent.Interceptor
+	Policy       ent.Policy
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
@@ -4,6 +4,7 @@ package generated
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 
@@ -405,6 +406,12 @@ func (cq *CommentQuery) prepareQuery(ctx context.Context) error {
 		}
 		cq.sql = prev
 	}
+	if comment.Policy == nil {
+		return errors.New("generated: uninitialized comment.Policy (forgotten import generated/runtime?)")
+	}
+	if err := comment.Policy.EvalQuery(ctx, cq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6,9 +6,12 @@ import (
 	"context"
 
 	"github.com/caliecode/la-clipasa/internal/ent/generated/apikey"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/post"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/refreshtoken"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
 	uuid "github.com/google/uuid"
 )
 
@@ -17,11 +20,26 @@ func ApiKeyEdgeCleanup(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+func AwardDefinitionEdgeCleanup(ctx context.Context, id uuid.UUID) error {
+
+	return nil
+}
+
+func CategoryRestrictionEdgeCleanup(ctx context.Context, id uuid.UUID) error {
+
+	return nil
+}
+
 func CommentEdgeCleanup(ctx context.Context, id uuid.UUID) error {
 
 	return nil
 }
 
+func IdentityEdgeCleanup(ctx context.Context, id uuid.UUID) error {
+
+	return nil
+}
+
 func PostEdgeCleanup(ctx context.Context, id uuid.UUID) error {
 
 	return nil
@@ -37,6 +55,26 @@ func RefreshTokenEdgeCleanup(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+func RoleChangeEdgeCleanup(ctx context.Context, id uuid.UUID) error {
+
+	return nil
+}
+
+func RolePermissionEdgeCleanup(ctx context.Context, id uuid.UUID) error {
+
+	return nil
+}
+
+func SuspensionEdgeCleanup(ctx context.Context, id uuid.UUID) error {
+
+	return nil
+}
+
+func TwitchTokenEdgeCleanup(ctx context.Context, id uuid.UUID) error {
+
+	return nil
+}
+
 func UserEdgeCleanup(ctx context.Context, id uuid.UUID) error {
 
 	if exists, err := FromContext(ctx).Post.Query().Where((post.HasOwnerWith(user.ID(id)))).Exist(ctx); err == nil && exists {
@@ -53,6 +91,20 @@ func UserEdgeCleanup(ctx context.Context, id uuid.UUID) error {
 		}
 	}
 
+	if exists, err := FromContext(ctx).UserAward.Query().Where((useraward.HasOwnerWith(user.ID(id)))).Exist(ctx); err == nil && exists {
+		if _, err := FromContext(ctx).UserAward.Delete().Where(useraward.HasOwnerWith(user.ID(id))).Exec(ctx); err != nil {
+
+			return err
+		}
+	}
+
+	if exists, err := FromContext(ctx).Identity.Query().Where((identity.HasOwnerWith(user.ID(id)))).Exist(ctx); err == nil && exists {
+		if _, err := FromContext(ctx).Identity.Delete().Where(identity.HasOwnerWith(user.ID(id))).Exec(ctx); err != nil {
+
+			return err
+		}
+	}
+
 	if exists, err := FromContext(ctx).RefreshToken.Query().Where((refreshtoken.HasOwnerWith(user.ID(id)))).Exist(ctx); err == nil && exists {
 		if _, err := FromContext(ctx).RefreshToken.Delete().Where(refreshtoken.HasOwnerWith(user.ID(id))).Exec(ctx); err != nil {
 
@@ -60,5 +112,17 @@ func UserEdgeCleanup(ctx context.Context, id uuid.UUID) error {
 		}
 	}
 
+	if exists, err := FromContext(ctx).RoleChange.Query().Where((rolechange.HasUserWith(user.ID(id)))).Exist(ctx); err == nil && exists {
+		if _, err := FromContext(ctx).RoleChange.Delete().Where(rolechange.HasUserWith(user.ID(id))).Exec(ctx); err != nil {
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func UserAwardEdgeCleanup(ctx context.Context, id uuid.UUID) error {
+
 	return nil
 }
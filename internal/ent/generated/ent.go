@@ -13,11 +13,19 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/apikey"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/categoryrestriction"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/comment"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/post"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/postcategory"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/refreshtoken"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/twitchtoken"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -78,12 +86,20 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			apikey.Table:       apikey.ValidColumn,
-			comment.Table:      comment.ValidColumn,
-			post.Table:         post.ValidColumn,
-			postcategory.Table: postcategory.ValidColumn,
-			refreshtoken.Table: refreshtoken.ValidColumn,
-			user.Table:         user.ValidColumn,
+			apikey.Table:              apikey.ValidColumn,
+			awarddefinition.Table:     awarddefinition.ValidColumn,
+			categoryrestriction.Table: categoryrestriction.ValidColumn,
+			comment.Table:             comment.ValidColumn,
+			identity.Table:            identity.ValidColumn,
+			post.Table:                post.ValidColumn,
+			postcategory.Table:        postcategory.ValidColumn,
+			refreshtoken.Table:        refreshtoken.ValidColumn,
+			rolechange.Table:          rolechange.ValidColumn,
+			rolepermission.Table:      rolepermission.ValidColumn,
+			suspension.Table:          suspension.ValidColumn,
+			twitchtoken.Table:         twitchtoken.ValidColumn,
+			user.Table:                user.ValidColumn,
+			useraward.Table:           useraward.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -4,12 +4,20 @@ package generated
 
 import (
 	"github.com/caliecode/la-clipasa/internal/ent/generated/apikey"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/categoryrestriction"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/comment"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/post"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/postcategory"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/refreshtoken"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/twitchtoken"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -19,7 +27,7 @@ import (
 
 // schemaGraph holds a representation of ent/schema at runtime.
 var schemaGraph = func() *sqlgraph.Schema {
-	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 6)}
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 14)}
 	graph.Nodes[0] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   apikey.Table,
@@ -31,14 +39,57 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "ApiKey",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			apikey.FieldUpdatedAt: {Type: field.TypeTime, Column: apikey.FieldUpdatedAt},
-			apikey.FieldCreatedAt: {Type: field.TypeTime, Column: apikey.FieldCreatedAt},
-			apikey.FieldOwnerID:   {Type: field.TypeUUID, Column: apikey.FieldOwnerID},
-			apikey.FieldAPIKey:    {Type: field.TypeString, Column: apikey.FieldAPIKey},
-			apikey.FieldExpiresOn: {Type: field.TypeTime, Column: apikey.FieldExpiresOn},
+			apikey.FieldUpdatedAt:  {Type: field.TypeTime, Column: apikey.FieldUpdatedAt},
+			apikey.FieldCreatedAt:  {Type: field.TypeTime, Column: apikey.FieldCreatedAt},
+			apikey.FieldOwnerID:    {Type: field.TypeUUID, Column: apikey.FieldOwnerID},
+			apikey.FieldName:       {Type: field.TypeString, Column: apikey.FieldName},
+			apikey.FieldPrefix:     {Type: field.TypeString, Column: apikey.FieldPrefix},
+			apikey.FieldKeyHash:    {Type: field.TypeString, Column: apikey.FieldKeyHash},
+			apikey.FieldScopes:     {Type: field.TypeJSON, Column: apikey.FieldScopes},
+			apikey.FieldExpiresOn:  {Type: field.TypeTime, Column: apikey.FieldExpiresOn},
+			apikey.FieldLastUsedAt: {Type: field.TypeTime, Column: apikey.FieldLastUsedAt},
 		},
 	}
 	graph.Nodes[1] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   awarddefinition.Table,
+			Columns: awarddefinition.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: awarddefinition.FieldID,
+			},
+		},
+		Type: "AwardDefinition",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			awarddefinition.FieldUpdatedAt:     {Type: field.TypeTime, Column: awarddefinition.FieldUpdatedAt},
+			awarddefinition.FieldCreatedAt:     {Type: field.TypeTime, Column: awarddefinition.FieldCreatedAt},
+			awarddefinition.FieldName:          {Type: field.TypeString, Column: awarddefinition.FieldName},
+			awarddefinition.FieldDescription:   {Type: field.TypeString, Column: awarddefinition.FieldDescription},
+			awarddefinition.FieldIcon:          {Type: field.TypeString, Column: awarddefinition.FieldIcon},
+			awarddefinition.FieldRule:          {Type: field.TypeEnum, Column: awarddefinition.FieldRule},
+			awarddefinition.FieldRuleCategory:  {Type: field.TypeEnum, Column: awarddefinition.FieldRuleCategory},
+			awarddefinition.FieldRuleThreshold: {Type: field.TypeInt, Column: awarddefinition.FieldRuleThreshold},
+		},
+	}
+	graph.Nodes[2] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   categoryrestriction.Table,
+			Columns: categoryrestriction.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: categoryrestriction.FieldID,
+			},
+		},
+		Type: "CategoryRestriction",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			categoryrestriction.FieldUpdatedAt:          {Type: field.TypeTime, Column: categoryrestriction.FieldUpdatedAt},
+			categoryrestriction.FieldCreatedAt:          {Type: field.TypeTime, Column: categoryrestriction.FieldCreatedAt},
+			categoryrestriction.FieldCategory:           {Type: field.TypeEnum, Column: categoryrestriction.FieldCategory},
+			categoryrestriction.FieldSubscriberOnlyPost: {Type: field.TypeBool, Column: categoryrestriction.FieldSubscriberOnlyPost},
+			categoryrestriction.FieldSubscriberOnlyView: {Type: field.TypeBool, Column: categoryrestriction.FieldSubscriberOnlyView},
+		},
+	}
+	graph.Nodes[3] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   comment.Table,
 			Columns: comment.Columns,
@@ -57,7 +108,26 @@ var schemaGraph = func() *sqlgraph.Schema {
 			comment.FieldContent:   {Type: field.TypeString, Column: comment.FieldContent},
 		},
 	}
-	graph.Nodes[2] = &sqlgraph.Node{
+	graph.Nodes[4] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   identity.Table,
+			Columns: identity.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: identity.FieldID,
+			},
+		},
+		Type: "Identity",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			identity.FieldUpdatedAt: {Type: field.TypeTime, Column: identity.FieldUpdatedAt},
+			identity.FieldCreatedAt: {Type: field.TypeTime, Column: identity.FieldCreatedAt},
+			identity.FieldOwnerID:   {Type: field.TypeUUID, Column: identity.FieldOwnerID},
+			identity.FieldProvider:  {Type: field.TypeEnum, Column: identity.FieldProvider},
+			identity.FieldSubject:   {Type: field.TypeString, Column: identity.FieldSubject},
+			identity.FieldEmail:     {Type: field.TypeString, Column: identity.FieldEmail},
+		},
+	}
+	graph.Nodes[5] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   post.Table,
 			Columns: post.Columns,
@@ -84,7 +154,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			post.FieldMetadata:          {Type: field.TypeJSON, Column: post.FieldMetadata},
 		},
 	}
-	graph.Nodes[3] = &sqlgraph.Node{
+	graph.Nodes[6] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   postcategory.Table,
 			Columns: postcategory.Columns,
@@ -100,7 +170,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			postcategory.FieldCategory:  {Type: field.TypeEnum, Column: postcategory.FieldCategory},
 		},
 	}
-	graph.Nodes[4] = &sqlgraph.Node{
+	graph.Nodes[7] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   refreshtoken.Table,
 			Columns: refreshtoken.Columns,
@@ -119,9 +189,91 @@ var schemaGraph = func() *sqlgraph.Schema {
 			refreshtoken.FieldRevoked:   {Type: field.TypeBool, Column: refreshtoken.FieldRevoked},
 			refreshtoken.FieldIPAddress: {Type: field.TypeString, Column: refreshtoken.FieldIPAddress},
 			refreshtoken.FieldUserAgent: {Type: field.TypeString, Column: refreshtoken.FieldUserAgent},
+			refreshtoken.FieldFamilyID:  {Type: field.TypeUUID, Column: refreshtoken.FieldFamilyID},
+			refreshtoken.FieldParentID:  {Type: field.TypeUUID, Column: refreshtoken.FieldParentID},
 		},
 	}
-	graph.Nodes[5] = &sqlgraph.Node{
+	graph.Nodes[8] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   rolechange.Table,
+			Columns: rolechange.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: rolechange.FieldID,
+			},
+		},
+		Type: "RoleChange",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			rolechange.FieldUpdatedAt:   {Type: field.TypeTime, Column: rolechange.FieldUpdatedAt},
+			rolechange.FieldCreatedAt:   {Type: field.TypeTime, Column: rolechange.FieldCreatedAt},
+			rolechange.FieldUserID:      {Type: field.TypeUUID, Column: rolechange.FieldUserID},
+			rolechange.FieldAttribute:   {Type: field.TypeEnum, Column: rolechange.FieldAttribute},
+			rolechange.FieldOldValue:    {Type: field.TypeString, Column: rolechange.FieldOldValue},
+			rolechange.FieldNewValue:    {Type: field.TypeString, Column: rolechange.FieldNewValue},
+			rolechange.FieldSource:      {Type: field.TypeEnum, Column: rolechange.FieldSource},
+			rolechange.FieldChangedByID: {Type: field.TypeUUID, Column: rolechange.FieldChangedByID},
+		},
+	}
+	graph.Nodes[9] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   rolepermission.Table,
+			Columns: rolepermission.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: rolepermission.FieldID,
+			},
+		},
+		Type: "RolePermission",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			rolepermission.FieldUpdatedAt:  {Type: field.TypeTime, Column: rolepermission.FieldUpdatedAt},
+			rolepermission.FieldCreatedAt:  {Type: field.TypeTime, Column: rolepermission.FieldCreatedAt},
+			rolepermission.FieldRole:       {Type: field.TypeEnum, Column: rolepermission.FieldRole},
+			rolepermission.FieldPermission: {Type: field.TypeEnum, Column: rolepermission.FieldPermission},
+		},
+	}
+	graph.Nodes[10] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   suspension.Table,
+			Columns: suspension.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: suspension.FieldID,
+			},
+		},
+		Type: "Suspension",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			suspension.FieldUpdatedAt: {Type: field.TypeTime, Column: suspension.FieldUpdatedAt},
+			suspension.FieldCreatedAt: {Type: field.TypeTime, Column: suspension.FieldCreatedAt},
+			suspension.FieldUserID:    {Type: field.TypeUUID, Column: suspension.FieldUserID},
+			suspension.FieldIssuerID:  {Type: field.TypeUUID, Column: suspension.FieldIssuerID},
+			suspension.FieldReason:    {Type: field.TypeString, Column: suspension.FieldReason},
+			suspension.FieldScope:     {Type: field.TypeEnum, Column: suspension.FieldScope},
+			suspension.FieldStartsAt:  {Type: field.TypeTime, Column: suspension.FieldStartsAt},
+			suspension.FieldEndsAt:    {Type: field.TypeTime, Column: suspension.FieldEndsAt},
+		},
+	}
+	graph.Nodes[11] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   twitchtoken.Table,
+			Columns: twitchtoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: twitchtoken.FieldID,
+			},
+		},
+		Type: "TwitchToken",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			twitchtoken.FieldUpdatedAt:    {Type: field.TypeTime, Column: twitchtoken.FieldUpdatedAt},
+			twitchtoken.FieldCreatedAt:    {Type: field.TypeTime, Column: twitchtoken.FieldCreatedAt},
+			twitchtoken.FieldTwitchUserID: {Type: field.TypeString, Column: twitchtoken.FieldTwitchUserID},
+			twitchtoken.FieldSessionID:    {Type: field.TypeUUID, Column: twitchtoken.FieldSessionID},
+			twitchtoken.FieldAccessToken:  {Type: field.TypeBytes, Column: twitchtoken.FieldAccessToken},
+			twitchtoken.FieldRefreshToken: {Type: field.TypeBytes, Column: twitchtoken.FieldRefreshToken},
+			twitchtoken.FieldExpiresAt:    {Type: field.TypeTime, Column: twitchtoken.FieldExpiresAt},
+			twitchtoken.FieldScopes:       {Type: field.TypeJSON, Column: twitchtoken.FieldScopes},
+		},
+	}
+	graph.Nodes[12] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   user.Table,
 			Columns: user.Columns,
@@ -142,9 +294,28 @@ var schemaGraph = func() *sqlgraph.Schema {
 			user.FieldExternalID:         {Type: field.TypeString, Column: user.FieldExternalID},
 			user.FieldAuthProvider:       {Type: field.TypeEnum, Column: user.FieldAuthProvider},
 			user.FieldRole:               {Type: field.TypeEnum, Column: user.FieldRole},
+			user.FieldRoleSource:         {Type: field.TypeEnum, Column: user.FieldRoleSource},
+			user.FieldTwitchVip:          {Type: field.TypeBool, Column: user.FieldTwitchVip},
 			user.FieldLastSeenAt:         {Type: field.TypeTime, Column: user.FieldLastSeenAt},
 			user.FieldLastPostSeenCursor: {Type: field.TypeString, Column: user.FieldLastPostSeenCursor},
-			user.FieldAwards:             {Type: field.TypeJSON, Column: user.FieldAwards},
+		},
+	}
+	graph.Nodes[13] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   useraward.Table,
+			Columns: useraward.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: useraward.FieldID,
+			},
+		},
+		Type: "UserAward",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			useraward.FieldUpdatedAt: {Type: field.TypeTime, Column: useraward.FieldUpdatedAt},
+			useraward.FieldCreatedAt: {Type: field.TypeTime, Column: useraward.FieldCreatedAt},
+			useraward.FieldOwnerID:   {Type: field.TypeUUID, Column: useraward.FieldOwnerID},
+			useraward.FieldAwardID:   {Type: field.TypeUUID, Column: useraward.FieldAwardID},
+			useraward.FieldGrantedAt: {Type: field.TypeTime, Column: useraward.FieldGrantedAt},
 		},
 	}
 	graph.MustAddE(
@@ -159,6 +330,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"ApiKey",
 		"User",
 	)
+	graph.MustAddE(
+		"grants",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   awarddefinition.GrantsTable,
+			Columns: []string{awarddefinition.GrantsColumn},
+			Bidi:    false,
+		},
+		"AwardDefinition",
+		"UserAward",
+	)
 	graph.MustAddE(
 		"owner",
 		&sqlgraph.EdgeSpec{
@@ -183,6 +366,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"Comment",
 		"Post",
 	)
+	graph.MustAddE(
+		"owner",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   identity.OwnerTable,
+			Columns: []string{identity.OwnerColumn},
+			Bidi:    false,
+		},
+		"Identity",
+		"User",
+	)
 	graph.MustAddE(
 		"owner",
 		&sqlgraph.EdgeSpec{
@@ -267,6 +462,42 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"RefreshToken",
 		"User",
 	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rolechange.UserTable,
+			Columns: []string{rolechange.UserColumn},
+			Bidi:    false,
+		},
+		"RoleChange",
+		"User",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   suspension.UserTable,
+			Columns: []string{suspension.UserColumn},
+			Bidi:    false,
+		},
+		"Suspension",
+		"User",
+	)
+	graph.MustAddE(
+		"issuer",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   suspension.IssuerTable,
+			Columns: []string{suspension.IssuerColumn},
+			Bidi:    false,
+		},
+		"Suspension",
+		"User",
+	)
 	graph.MustAddE(
 		"saved_posts",
 		&sqlgraph.EdgeSpec{
@@ -327,6 +558,78 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"User",
 		"ApiKey",
 	)
+	graph.MustAddE(
+		"awards",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AwardsTable,
+			Columns: []string{user.AwardsColumn},
+			Bidi:    false,
+		},
+		"User",
+		"UserAward",
+	)
+	graph.MustAddE(
+		"blocked_users",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.BlockedUsersTable,
+			Columns: user.BlockedUsersPrimaryKey,
+			Bidi:    true,
+		},
+		"User",
+		"User",
+	)
+	graph.MustAddE(
+		"blocked_by",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.BlockedByTable,
+			Columns: user.BlockedByPrimaryKey,
+			Bidi:    false,
+		},
+		"User",
+		"User",
+	)
+	graph.MustAddE(
+		"muted_users",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.MutedUsersTable,
+			Columns: user.MutedUsersPrimaryKey,
+			Bidi:    true,
+		},
+		"User",
+		"User",
+	)
+	graph.MustAddE(
+		"muted_by",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MutedByTable,
+			Columns: user.MutedByPrimaryKey,
+			Bidi:    false,
+		},
+		"User",
+		"User",
+	)
+	graph.MustAddE(
+		"identities",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+		},
+		"User",
+		"Identity",
+	)
 	graph.MustAddE(
 		"refresh_tokens",
 		&sqlgraph.EdgeSpec{
@@ -339,6 +642,54 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"User",
 		"RefreshToken",
 	)
+	graph.MustAddE(
+		"role_changes",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoleChangesTable,
+			Columns: []string{user.RoleChangesColumn},
+			Bidi:    false,
+		},
+		"User",
+		"RoleChange",
+	)
+	graph.MustAddE(
+		"suspensions",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SuspensionsTable,
+			Columns: []string{user.SuspensionsColumn},
+			Bidi:    false,
+		},
+		"User",
+		"Suspension",
+	)
+	graph.MustAddE(
+		"owner",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   useraward.OwnerTable,
+			Columns: []string{useraward.OwnerColumn},
+			Bidi:    false,
+		},
+		"UserAward",
+		"User",
+	)
+	graph.MustAddE(
+		"award",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   useraward.AwardTable,
+			Columns: []string{useraward.AwardColumn},
+			Bidi:    false,
+		},
+		"UserAward",
+		"AwardDefinition",
+	)
 	return graph
 }()
 
@@ -403,9 +754,24 @@ func (f *ApiKeyFilter) WhereOwnerID(p entql.ValueP) {
 	f.Where(p.Field(apikey.FieldOwnerID))
 }
 
-// WhereAPIKey applies the entql string predicate on the api_key field.
-func (f *ApiKeyFilter) WhereAPIKey(p entql.StringP) {
-	f.Where(p.Field(apikey.FieldAPIKey))
+// WhereName applies the entql string predicate on the name field.
+func (f *ApiKeyFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(apikey.FieldName))
+}
+
+// WherePrefix applies the entql string predicate on the prefix field.
+func (f *ApiKeyFilter) WherePrefix(p entql.StringP) {
+	f.Where(p.Field(apikey.FieldPrefix))
+}
+
+// WhereKeyHash applies the entql string predicate on the key_hash field.
+func (f *ApiKeyFilter) WhereKeyHash(p entql.StringP) {
+	f.Where(p.Field(apikey.FieldKeyHash))
+}
+
+// WhereScopes applies the entql json.RawMessage predicate on the scopes field.
+func (f *ApiKeyFilter) WhereScopes(p entql.BytesP) {
+	f.Where(p.Field(apikey.FieldScopes))
 }
 
 // WhereExpiresOn applies the entql time.Time predicate on the expires_on field.
@@ -413,6 +779,11 @@ func (f *ApiKeyFilter) WhereExpiresOn(p entql.TimeP) {
 	f.Where(p.Field(apikey.FieldExpiresOn))
 }
 
+// WhereLastUsedAt applies the entql time.Time predicate on the last_used_at field.
+func (f *ApiKeyFilter) WhereLastUsedAt(p entql.TimeP) {
+	f.Where(p.Field(apikey.FieldLastUsedAt))
+}
+
 // WhereHasOwner applies a predicate to check if query has an edge owner.
 func (f *ApiKeyFilter) WhereHasOwner() {
 	f.Where(entql.HasEdge("owner"))
@@ -428,33 +799,33 @@ func (f *ApiKeyFilter) WhereHasOwnerWith(preds ...predicate.User) {
 }
 
 // addPredicate implements the predicateAdder interface.
-func (cq *CommentQuery) addPredicate(pred func(s *sql.Selector)) {
-	cq.predicates = append(cq.predicates, pred)
+func (adq *AwardDefinitionQuery) addPredicate(pred func(s *sql.Selector)) {
+	adq.predicates = append(adq.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the CommentQuery builder.
-func (cq *CommentQuery) Filter() *CommentFilter {
-	return &CommentFilter{config: cq.config, predicateAdder: cq}
+// Filter returns a Filter implementation to apply filters on the AwardDefinitionQuery builder.
+func (adq *AwardDefinitionQuery) Filter() *AwardDefinitionFilter {
+	return &AwardDefinitionFilter{config: adq.config, predicateAdder: adq}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *CommentMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *AwardDefinitionMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the CommentMutation builder.
-func (m *CommentMutation) Filter() *CommentFilter {
-	return &CommentFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the AwardDefinitionMutation builder.
+func (m *AwardDefinitionMutation) Filter() *AwardDefinitionFilter {
+	return &AwardDefinitionFilter{config: m.config, predicateAdder: m}
 }
 
-// CommentFilter provides a generic filtering capability at runtime for CommentQuery.
-type CommentFilter struct {
+// AwardDefinitionFilter provides a generic filtering capability at runtime for AwardDefinitionQuery.
+type AwardDefinitionFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *CommentFilter) Where(p entql.P) {
+func (f *AwardDefinitionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
 			s.AddError(err)
@@ -463,62 +834,58 @@ func (f *CommentFilter) Where(p entql.P) {
 }
 
 // WhereID applies the entql [16]byte predicate on the id field.
-func (f *CommentFilter) WhereID(p entql.ValueP) {
-	f.Where(p.Field(comment.FieldID))
+func (f *AwardDefinitionFilter) WhereID(p entql.ValueP) {
+	f.Where(p.Field(awarddefinition.FieldID))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *CommentFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(comment.FieldUpdatedAt))
+func (f *AwardDefinitionFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(awarddefinition.FieldUpdatedAt))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *CommentFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(comment.FieldCreatedAt))
+func (f *AwardDefinitionFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(awarddefinition.FieldCreatedAt))
 }
 
-// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *CommentFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(comment.FieldDeletedAt))
+// WhereName applies the entql string predicate on the name field.
+func (f *AwardDefinitionFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(awarddefinition.FieldName))
 }
 
-// WhereDeletedBy applies the entql string predicate on the deleted_by field.
-func (f *CommentFilter) WhereDeletedBy(p entql.StringP) {
-	f.Where(p.Field(comment.FieldDeletedBy))
+// WhereDescription applies the entql string predicate on the description field.
+func (f *AwardDefinitionFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(awarddefinition.FieldDescription))
 }
 
-// WhereOwnerID applies the entql [16]byte predicate on the owner_id field.
-func (f *CommentFilter) WhereOwnerID(p entql.ValueP) {
-	f.Where(p.Field(comment.FieldOwnerID))
+// WhereIcon applies the entql string predicate on the icon field.
+func (f *AwardDefinitionFilter) WhereIcon(p entql.StringP) {
+	f.Where(p.Field(awarddefinition.FieldIcon))
 }
 
-// WhereContent applies the entql string predicate on the content field.
-func (f *CommentFilter) WhereContent(p entql.StringP) {
-	f.Where(p.Field(comment.FieldContent))
+// WhereRule applies the entql string predicate on the rule field.
+func (f *AwardDefinitionFilter) WhereRule(p entql.StringP) {
+	f.Where(p.Field(awarddefinition.FieldRule))
 }
 
-// WhereHasOwner applies a predicate to check if query has an edge owner.
-func (f *CommentFilter) WhereHasOwner() {
-	f.Where(entql.HasEdge("owner"))
+// WhereRuleCategory applies the entql string predicate on the rule_category field.
+func (f *AwardDefinitionFilter) WhereRuleCategory(p entql.StringP) {
+	f.Where(p.Field(awarddefinition.FieldRuleCategory))
 }
 
-// WhereHasOwnerWith applies a predicate to check if query has an edge owner with a given conditions (other predicates).
-func (f *CommentFilter) WhereHasOwnerWith(preds ...predicate.User) {
-	f.Where(entql.HasEdgeWith("owner", sqlgraph.WrapFunc(func(s *sql.Selector) {
-		for _, p := range preds {
-			p(s)
-		}
-	})))
+// WhereRuleThreshold applies the entql int predicate on the rule_threshold field.
+func (f *AwardDefinitionFilter) WhereRuleThreshold(p entql.IntP) {
+	f.Where(p.Field(awarddefinition.FieldRuleThreshold))
 }
 
-// WhereHasPost applies a predicate to check if query has an edge post.
-func (f *CommentFilter) WhereHasPost() {
-	f.Where(entql.HasEdge("post"))
+// WhereHasGrants applies a predicate to check if query has an edge grants.
+func (f *AwardDefinitionFilter) WhereHasGrants() {
+	f.Where(entql.HasEdge("grants"))
 }
 
-// WhereHasPostWith applies a predicate to check if query has an edge post with a given conditions (other predicates).
-func (f *CommentFilter) WhereHasPostWith(preds ...predicate.Post) {
-	f.Where(entql.HasEdgeWith("post", sqlgraph.WrapFunc(func(s *sql.Selector) {
+// WhereHasGrantsWith applies a predicate to check if query has an edge grants with a given conditions (other predicates).
+func (f *AwardDefinitionFilter) WhereHasGrantsWith(preds ...predicate.UserAward) {
+	f.Where(entql.HasEdgeWith("grants", sqlgraph.WrapFunc(func(s *sql.Selector) {
 		for _, p := range preds {
 			p(s)
 		}
@@ -526,22 +893,269 @@ func (f *CommentFilter) WhereHasPostWith(preds ...predicate.Post) {
 }
 
 // addPredicate implements the predicateAdder interface.
-func (pq *PostQuery) addPredicate(pred func(s *sql.Selector)) {
-	pq.predicates = append(pq.predicates, pred)
+func (crq *CategoryRestrictionQuery) addPredicate(pred func(s *sql.Selector)) {
+	crq.predicates = append(crq.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the PostQuery builder.
-func (pq *PostQuery) Filter() *PostFilter {
-	return &PostFilter{config: pq.config, predicateAdder: pq}
+// Filter returns a Filter implementation to apply filters on the CategoryRestrictionQuery builder.
+func (crq *CategoryRestrictionQuery) Filter() *CategoryRestrictionFilter {
+	return &CategoryRestrictionFilter{config: crq.config, predicateAdder: crq}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *PostMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *CategoryRestrictionMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the PostMutation builder.
-func (m *PostMutation) Filter() *PostFilter {
+// Filter returns an entql.Where implementation to apply filters on the CategoryRestrictionMutation builder.
+func (m *CategoryRestrictionMutation) Filter() *CategoryRestrictionFilter {
+	return &CategoryRestrictionFilter{config: m.config, predicateAdder: m}
+}
+
+// CategoryRestrictionFilter provides a generic filtering capability at runtime for CategoryRestrictionQuery.
+type CategoryRestrictionFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *CategoryRestrictionFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql [16]byte predicate on the id field.
+func (f *CategoryRestrictionFilter) WhereID(p entql.ValueP) {
+	f.Where(p.Field(categoryrestriction.FieldID))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *CategoryRestrictionFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(categoryrestriction.FieldUpdatedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *CategoryRestrictionFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(categoryrestriction.FieldCreatedAt))
+}
+
+// WhereCategory applies the entql string predicate on the category field.
+func (f *CategoryRestrictionFilter) WhereCategory(p entql.StringP) {
+	f.Where(p.Field(categoryrestriction.FieldCategory))
+}
+
+// WhereSubscriberOnlyPost applies the entql bool predicate on the subscriber_only_post field.
+func (f *CategoryRestrictionFilter) WhereSubscriberOnlyPost(p entql.BoolP) {
+	f.Where(p.Field(categoryrestriction.FieldSubscriberOnlyPost))
+}
+
+// WhereSubscriberOnlyView applies the entql bool predicate on the subscriber_only_view field.
+func (f *CategoryRestrictionFilter) WhereSubscriberOnlyView(p entql.BoolP) {
+	f.Where(p.Field(categoryrestriction.FieldSubscriberOnlyView))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (cq *CommentQuery) addPredicate(pred func(s *sql.Selector)) {
+	cq.predicates = append(cq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the CommentQuery builder.
+func (cq *CommentQuery) Filter() *CommentFilter {
+	return &CommentFilter{config: cq.config, predicateAdder: cq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *CommentMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the CommentMutation builder.
+func (m *CommentMutation) Filter() *CommentFilter {
+	return &CommentFilter{config: m.config, predicateAdder: m}
+}
+
+// CommentFilter provides a generic filtering capability at runtime for CommentQuery.
+type CommentFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *CommentFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql [16]byte predicate on the id field.
+func (f *CommentFilter) WhereID(p entql.ValueP) {
+	f.Where(p.Field(comment.FieldID))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *CommentFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(comment.FieldUpdatedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *CommentFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(comment.FieldCreatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *CommentFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(comment.FieldDeletedAt))
+}
+
+// WhereDeletedBy applies the entql string predicate on the deleted_by field.
+func (f *CommentFilter) WhereDeletedBy(p entql.StringP) {
+	f.Where(p.Field(comment.FieldDeletedBy))
+}
+
+// WhereOwnerID applies the entql [16]byte predicate on the owner_id field.
+func (f *CommentFilter) WhereOwnerID(p entql.ValueP) {
+	f.Where(p.Field(comment.FieldOwnerID))
+}
+
+// WhereContent applies the entql string predicate on the content field.
+func (f *CommentFilter) WhereContent(p entql.StringP) {
+	f.Where(p.Field(comment.FieldContent))
+}
+
+// WhereHasOwner applies a predicate to check if query has an edge owner.
+func (f *CommentFilter) WhereHasOwner() {
+	f.Where(entql.HasEdge("owner"))
+}
+
+// WhereHasOwnerWith applies a predicate to check if query has an edge owner with a given conditions (other predicates).
+func (f *CommentFilter) WhereHasOwnerWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("owner", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasPost applies a predicate to check if query has an edge post.
+func (f *CommentFilter) WhereHasPost() {
+	f.Where(entql.HasEdge("post"))
+}
+
+// WhereHasPostWith applies a predicate to check if query has an edge post with a given conditions (other predicates).
+func (f *CommentFilter) WhereHasPostWith(preds ...predicate.Post) {
+	f.Where(entql.HasEdgeWith("post", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (iq *IdentityQuery) addPredicate(pred func(s *sql.Selector)) {
+	iq.predicates = append(iq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the IdentityQuery builder.
+func (iq *IdentityQuery) Filter() *IdentityFilter {
+	return &IdentityFilter{config: iq.config, predicateAdder: iq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *IdentityMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the IdentityMutation builder.
+func (m *IdentityMutation) Filter() *IdentityFilter {
+	return &IdentityFilter{config: m.config, predicateAdder: m}
+}
+
+// IdentityFilter provides a generic filtering capability at runtime for IdentityQuery.
+type IdentityFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *IdentityFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql [16]byte predicate on the id field.
+func (f *IdentityFilter) WhereID(p entql.ValueP) {
+	f.Where(p.Field(identity.FieldID))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *IdentityFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(identity.FieldUpdatedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *IdentityFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(identity.FieldCreatedAt))
+}
+
+// WhereOwnerID applies the entql [16]byte predicate on the owner_id field.
+func (f *IdentityFilter) WhereOwnerID(p entql.ValueP) {
+	f.Where(p.Field(identity.FieldOwnerID))
+}
+
+// WhereProvider applies the entql string predicate on the provider field.
+func (f *IdentityFilter) WhereProvider(p entql.StringP) {
+	f.Where(p.Field(identity.FieldProvider))
+}
+
+// WhereSubject applies the entql string predicate on the subject field.
+func (f *IdentityFilter) WhereSubject(p entql.StringP) {
+	f.Where(p.Field(identity.FieldSubject))
+}
+
+// WhereEmail applies the entql string predicate on the email field.
+func (f *IdentityFilter) WhereEmail(p entql.StringP) {
+	f.Where(p.Field(identity.FieldEmail))
+}
+
+// WhereHasOwner applies a predicate to check if query has an edge owner.
+func (f *IdentityFilter) WhereHasOwner() {
+	f.Where(entql.HasEdge("owner"))
+}
+
+// WhereHasOwnerWith applies a predicate to check if query has an edge owner with a given conditions (other predicates).
+func (f *IdentityFilter) WhereHasOwnerWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("owner", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (pq *PostQuery) addPredicate(pred func(s *sql.Selector)) {
+	pq.predicates = append(pq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the PostQuery builder.
+func (pq *PostQuery) Filter() *PostFilter {
+	return &PostFilter{config: pq.config, predicateAdder: pq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *PostMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the PostMutation builder.
+func (m *PostMutation) Filter() *PostFilter {
 	return &PostFilter{config: m.config, predicateAdder: m}
 }
 
@@ -554,7 +1168,7 @@ type PostFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PostFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -734,7 +1348,7 @@ type PostCategoryFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PostCategoryFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -803,7 +1417,7 @@ type RefreshTokenFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *RefreshTokenFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -854,6 +1468,16 @@ func (f *RefreshTokenFilter) WhereUserAgent(p entql.StringP) {
 	f.Where(p.Field(refreshtoken.FieldUserAgent))
 }
 
+// WhereFamilyID applies the entql [16]byte predicate on the family_id field.
+func (f *RefreshTokenFilter) WhereFamilyID(p entql.ValueP) {
+	f.Where(p.Field(refreshtoken.FieldFamilyID))
+}
+
+// WhereParentID applies the entql [16]byte predicate on the parent_id field.
+func (f *RefreshTokenFilter) WhereParentID(p entql.ValueP) {
+	f.Where(p.Field(refreshtoken.FieldParentID))
+}
+
 // WhereHasOwner applies a predicate to check if query has an edge owner.
 func (f *RefreshTokenFilter) WhereHasOwner() {
 	f.Where(entql.HasEdge("owner"))
@@ -869,98 +1493,450 @@ func (f *RefreshTokenFilter) WhereHasOwnerWith(preds ...predicate.User) {
 }
 
 // addPredicate implements the predicateAdder interface.
-func (uq *UserQuery) addPredicate(pred func(s *sql.Selector)) {
-	uq.predicates = append(uq.predicates, pred)
+func (rcq *RoleChangeQuery) addPredicate(pred func(s *sql.Selector)) {
+	rcq.predicates = append(rcq.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the UserQuery builder.
-func (uq *UserQuery) Filter() *UserFilter {
-	return &UserFilter{config: uq.config, predicateAdder: uq}
+// Filter returns a Filter implementation to apply filters on the RoleChangeQuery builder.
+func (rcq *RoleChangeQuery) Filter() *RoleChangeFilter {
+	return &RoleChangeFilter{config: rcq.config, predicateAdder: rcq}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *UserMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *RoleChangeMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the UserMutation builder.
-func (m *UserMutation) Filter() *UserFilter {
-	return &UserFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the RoleChangeMutation builder.
+func (m *RoleChangeMutation) Filter() *RoleChangeFilter {
+	return &RoleChangeFilter{config: m.config, predicateAdder: m}
 }
 
-// UserFilter provides a generic filtering capability at runtime for UserQuery.
-type UserFilter struct {
+// RoleChangeFilter provides a generic filtering capability at runtime for RoleChangeQuery.
+type RoleChangeFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *UserFilter) Where(p entql.P) {
+func (f *RoleChangeFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql [16]byte predicate on the id field.
-func (f *UserFilter) WhereID(p entql.ValueP) {
-	f.Where(p.Field(user.FieldID))
+func (f *RoleChangeFilter) WhereID(p entql.ValueP) {
+	f.Where(p.Field(rolechange.FieldID))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *UserFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(user.FieldUpdatedAt))
+func (f *RoleChangeFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(rolechange.FieldUpdatedAt))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *UserFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(user.FieldCreatedAt))
+func (f *RoleChangeFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(rolechange.FieldCreatedAt))
 }
 
-// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *UserFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(user.FieldDeletedAt))
+// WhereUserID applies the entql [16]byte predicate on the user_id field.
+func (f *RoleChangeFilter) WhereUserID(p entql.ValueP) {
+	f.Where(p.Field(rolechange.FieldUserID))
 }
 
-// WhereDeletedBy applies the entql string predicate on the deleted_by field.
-func (f *UserFilter) WhereDeletedBy(p entql.StringP) {
-	f.Where(p.Field(user.FieldDeletedBy))
+// WhereAttribute applies the entql string predicate on the attribute field.
+func (f *RoleChangeFilter) WhereAttribute(p entql.StringP) {
+	f.Where(p.Field(rolechange.FieldAttribute))
 }
 
-// WhereDisplayName applies the entql string predicate on the display_name field.
-func (f *UserFilter) WhereDisplayName(p entql.StringP) {
-	f.Where(p.Field(user.FieldDisplayName))
+// WhereOldValue applies the entql string predicate on the old_value field.
+func (f *RoleChangeFilter) WhereOldValue(p entql.StringP) {
+	f.Where(p.Field(rolechange.FieldOldValue))
 }
 
-// WhereAlias applies the entql string predicate on the alias field.
-func (f *UserFilter) WhereAlias(p entql.StringP) {
-	f.Where(p.Field(user.FieldAlias))
+// WhereNewValue applies the entql string predicate on the new_value field.
+func (f *RoleChangeFilter) WhereNewValue(p entql.StringP) {
+	f.Where(p.Field(rolechange.FieldNewValue))
 }
 
-// WhereProfileImage applies the entql string predicate on the profile_image field.
-func (f *UserFilter) WhereProfileImage(p entql.StringP) {
-	f.Where(p.Field(user.FieldProfileImage))
+// WhereSource applies the entql string predicate on the source field.
+func (f *RoleChangeFilter) WhereSource(p entql.StringP) {
+	f.Where(p.Field(rolechange.FieldSource))
 }
 
-// WhereExternalID applies the entql string predicate on the external_id field.
-func (f *UserFilter) WhereExternalID(p entql.StringP) {
-	f.Where(p.Field(user.FieldExternalID))
+// WhereChangedByID applies the entql [16]byte predicate on the changed_by_id field.
+func (f *RoleChangeFilter) WhereChangedByID(p entql.ValueP) {
+	f.Where(p.Field(rolechange.FieldChangedByID))
 }
 
-// WhereAuthProvider applies the entql string predicate on the auth_provider field.
-func (f *UserFilter) WhereAuthProvider(p entql.StringP) {
-	f.Where(p.Field(user.FieldAuthProvider))
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *RoleChangeFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
 }
 
-// WhereRole applies the entql string predicate on the role field.
-func (f *UserFilter) WhereRole(p entql.StringP) {
-	f.Where(p.Field(user.FieldRole))
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *RoleChangeFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
 }
 
-// WhereLastSeenAt applies the entql time.Time predicate on the last_seen_at field.
-func (f *UserFilter) WhereLastSeenAt(p entql.TimeP) {
-	f.Where(p.Field(user.FieldLastSeenAt))
+// addPredicate implements the predicateAdder interface.
+func (rpq *RolePermissionQuery) addPredicate(pred func(s *sql.Selector)) {
+	rpq.predicates = append(rpq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the RolePermissionQuery builder.
+func (rpq *RolePermissionQuery) Filter() *RolePermissionFilter {
+	return &RolePermissionFilter{config: rpq.config, predicateAdder: rpq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *RolePermissionMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the RolePermissionMutation builder.
+func (m *RolePermissionMutation) Filter() *RolePermissionFilter {
+	return &RolePermissionFilter{config: m.config, predicateAdder: m}
+}
+
+// RolePermissionFilter provides a generic filtering capability at runtime for RolePermissionQuery.
+type RolePermissionFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *RolePermissionFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql [16]byte predicate on the id field.
+func (f *RolePermissionFilter) WhereID(p entql.ValueP) {
+	f.Where(p.Field(rolepermission.FieldID))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *RolePermissionFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(rolepermission.FieldUpdatedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *RolePermissionFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(rolepermission.FieldCreatedAt))
+}
+
+// WhereRole applies the entql string predicate on the role field.
+func (f *RolePermissionFilter) WhereRole(p entql.StringP) {
+	f.Where(p.Field(rolepermission.FieldRole))
+}
+
+// WherePermission applies the entql string predicate on the permission field.
+func (f *RolePermissionFilter) WherePermission(p entql.StringP) {
+	f.Where(p.Field(rolepermission.FieldPermission))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (sq *SuspensionQuery) addPredicate(pred func(s *sql.Selector)) {
+	sq.predicates = append(sq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the SuspensionQuery builder.
+func (sq *SuspensionQuery) Filter() *SuspensionFilter {
+	return &SuspensionFilter{config: sq.config, predicateAdder: sq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *SuspensionMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the SuspensionMutation builder.
+func (m *SuspensionMutation) Filter() *SuspensionFilter {
+	return &SuspensionFilter{config: m.config, predicateAdder: m}
+}
+
+// SuspensionFilter provides a generic filtering capability at runtime for SuspensionQuery.
+type SuspensionFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *SuspensionFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql [16]byte predicate on the id field.
+func (f *SuspensionFilter) WhereID(p entql.ValueP) {
+	f.Where(p.Field(suspension.FieldID))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *SuspensionFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(suspension.FieldUpdatedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *SuspensionFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(suspension.FieldCreatedAt))
+}
+
+// WhereUserID applies the entql [16]byte predicate on the user_id field.
+func (f *SuspensionFilter) WhereUserID(p entql.ValueP) {
+	f.Where(p.Field(suspension.FieldUserID))
+}
+
+// WhereIssuerID applies the entql [16]byte predicate on the issuer_id field.
+func (f *SuspensionFilter) WhereIssuerID(p entql.ValueP) {
+	f.Where(p.Field(suspension.FieldIssuerID))
+}
+
+// WhereReason applies the entql string predicate on the reason field.
+func (f *SuspensionFilter) WhereReason(p entql.StringP) {
+	f.Where(p.Field(suspension.FieldReason))
+}
+
+// WhereScope applies the entql string predicate on the scope field.
+func (f *SuspensionFilter) WhereScope(p entql.StringP) {
+	f.Where(p.Field(suspension.FieldScope))
+}
+
+// WhereStartsAt applies the entql time.Time predicate on the starts_at field.
+func (f *SuspensionFilter) WhereStartsAt(p entql.TimeP) {
+	f.Where(p.Field(suspension.FieldStartsAt))
+}
+
+// WhereEndsAt applies the entql time.Time predicate on the ends_at field.
+func (f *SuspensionFilter) WhereEndsAt(p entql.TimeP) {
+	f.Where(p.Field(suspension.FieldEndsAt))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *SuspensionFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *SuspensionFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasIssuer applies a predicate to check if query has an edge issuer.
+func (f *SuspensionFilter) WhereHasIssuer() {
+	f.Where(entql.HasEdge("issuer"))
+}
+
+// WhereHasIssuerWith applies a predicate to check if query has an edge issuer with a given conditions (other predicates).
+func (f *SuspensionFilter) WhereHasIssuerWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("issuer", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (ttq *TwitchTokenQuery) addPredicate(pred func(s *sql.Selector)) {
+	ttq.predicates = append(ttq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TwitchTokenQuery builder.
+func (ttq *TwitchTokenQuery) Filter() *TwitchTokenFilter {
+	return &TwitchTokenFilter{config: ttq.config, predicateAdder: ttq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TwitchTokenMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TwitchTokenMutation builder.
+func (m *TwitchTokenMutation) Filter() *TwitchTokenFilter {
+	return &TwitchTokenFilter{config: m.config, predicateAdder: m}
+}
+
+// TwitchTokenFilter provides a generic filtering capability at runtime for TwitchTokenQuery.
+type TwitchTokenFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TwitchTokenFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql [16]byte predicate on the id field.
+func (f *TwitchTokenFilter) WhereID(p entql.ValueP) {
+	f.Where(p.Field(twitchtoken.FieldID))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *TwitchTokenFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(twitchtoken.FieldUpdatedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *TwitchTokenFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(twitchtoken.FieldCreatedAt))
+}
+
+// WhereTwitchUserID applies the entql string predicate on the twitch_user_id field.
+func (f *TwitchTokenFilter) WhereTwitchUserID(p entql.StringP) {
+	f.Where(p.Field(twitchtoken.FieldTwitchUserID))
+}
+
+// WhereSessionID applies the entql [16]byte predicate on the session_id field.
+func (f *TwitchTokenFilter) WhereSessionID(p entql.ValueP) {
+	f.Where(p.Field(twitchtoken.FieldSessionID))
+}
+
+// WhereAccessToken applies the entql []byte predicate on the access_token field.
+func (f *TwitchTokenFilter) WhereAccessToken(p entql.BytesP) {
+	f.Where(p.Field(twitchtoken.FieldAccessToken))
+}
+
+// WhereRefreshToken applies the entql []byte predicate on the refresh_token field.
+func (f *TwitchTokenFilter) WhereRefreshToken(p entql.BytesP) {
+	f.Where(p.Field(twitchtoken.FieldRefreshToken))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *TwitchTokenFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(twitchtoken.FieldExpiresAt))
+}
+
+// WhereScopes applies the entql json.RawMessage predicate on the scopes field.
+func (f *TwitchTokenFilter) WhereScopes(p entql.BytesP) {
+	f.Where(p.Field(twitchtoken.FieldScopes))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (uq *UserQuery) addPredicate(pred func(s *sql.Selector)) {
+	uq.predicates = append(uq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the UserQuery builder.
+func (uq *UserQuery) Filter() *UserFilter {
+	return &UserFilter{config: uq.config, predicateAdder: uq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *UserMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the UserMutation builder.
+func (m *UserMutation) Filter() *UserFilter {
+	return &UserFilter{config: m.config, predicateAdder: m}
+}
+
+// UserFilter provides a generic filtering capability at runtime for UserQuery.
+type UserFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *UserFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql [16]byte predicate on the id field.
+func (f *UserFilter) WhereID(p entql.ValueP) {
+	f.Where(p.Field(user.FieldID))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *UserFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(user.FieldUpdatedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *UserFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(user.FieldCreatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *UserFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(user.FieldDeletedAt))
+}
+
+// WhereDeletedBy applies the entql string predicate on the deleted_by field.
+func (f *UserFilter) WhereDeletedBy(p entql.StringP) {
+	f.Where(p.Field(user.FieldDeletedBy))
+}
+
+// WhereDisplayName applies the entql string predicate on the display_name field.
+func (f *UserFilter) WhereDisplayName(p entql.StringP) {
+	f.Where(p.Field(user.FieldDisplayName))
+}
+
+// WhereAlias applies the entql string predicate on the alias field.
+func (f *UserFilter) WhereAlias(p entql.StringP) {
+	f.Where(p.Field(user.FieldAlias))
+}
+
+// WhereProfileImage applies the entql string predicate on the profile_image field.
+func (f *UserFilter) WhereProfileImage(p entql.StringP) {
+	f.Where(p.Field(user.FieldProfileImage))
+}
+
+// WhereExternalID applies the entql string predicate on the external_id field.
+func (f *UserFilter) WhereExternalID(p entql.StringP) {
+	f.Where(p.Field(user.FieldExternalID))
+}
+
+// WhereAuthProvider applies the entql string predicate on the auth_provider field.
+func (f *UserFilter) WhereAuthProvider(p entql.StringP) {
+	f.Where(p.Field(user.FieldAuthProvider))
+}
+
+// WhereRole applies the entql string predicate on the role field.
+func (f *UserFilter) WhereRole(p entql.StringP) {
+	f.Where(p.Field(user.FieldRole))
+}
+
+// WhereRoleSource applies the entql string predicate on the role_source field.
+func (f *UserFilter) WhereRoleSource(p entql.StringP) {
+	f.Where(p.Field(user.FieldRoleSource))
+}
+
+// WhereTwitchVip applies the entql bool predicate on the twitch_vip field.
+func (f *UserFilter) WhereTwitchVip(p entql.BoolP) {
+	f.Where(p.Field(user.FieldTwitchVip))
+}
+
+// WhereLastSeenAt applies the entql time.Time predicate on the last_seen_at field.
+func (f *UserFilter) WhereLastSeenAt(p entql.TimeP) {
+	f.Where(p.Field(user.FieldLastSeenAt))
 }
 
 // WhereLastPostSeenCursor applies the entql string predicate on the last_post_seen_cursor field.
@@ -968,11 +1944,6 @@ func (f *UserFilter) WhereLastPostSeenCursor(p entql.StringP) {
 	f.Where(p.Field(user.FieldLastPostSeenCursor))
 }
 
-// WhereAwards applies the entql json.RawMessage predicate on the awards field.
-func (f *UserFilter) WhereAwards(p entql.BytesP) {
-	f.Where(p.Field(user.FieldAwards))
-}
-
 // WhereHasSavedPosts applies a predicate to check if query has an edge saved_posts.
 func (f *UserFilter) WhereHasSavedPosts() {
 	f.Where(entql.HasEdge("saved_posts"))
@@ -1043,6 +2014,90 @@ func (f *UserFilter) WhereHasAPIKeysWith(preds ...predicate.ApiKey) {
 	})))
 }
 
+// WhereHasAwards applies a predicate to check if query has an edge awards.
+func (f *UserFilter) WhereHasAwards() {
+	f.Where(entql.HasEdge("awards"))
+}
+
+// WhereHasAwardsWith applies a predicate to check if query has an edge awards with a given conditions (other predicates).
+func (f *UserFilter) WhereHasAwardsWith(preds ...predicate.UserAward) {
+	f.Where(entql.HasEdgeWith("awards", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasBlockedUsers applies a predicate to check if query has an edge blocked_users.
+func (f *UserFilter) WhereHasBlockedUsers() {
+	f.Where(entql.HasEdge("blocked_users"))
+}
+
+// WhereHasBlockedUsersWith applies a predicate to check if query has an edge blocked_users with a given conditions (other predicates).
+func (f *UserFilter) WhereHasBlockedUsersWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("blocked_users", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasBlockedBy applies a predicate to check if query has an edge blocked_by.
+func (f *UserFilter) WhereHasBlockedBy() {
+	f.Where(entql.HasEdge("blocked_by"))
+}
+
+// WhereHasBlockedByWith applies a predicate to check if query has an edge blocked_by with a given conditions (other predicates).
+func (f *UserFilter) WhereHasBlockedByWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("blocked_by", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasMutedUsers applies a predicate to check if query has an edge muted_users.
+func (f *UserFilter) WhereHasMutedUsers() {
+	f.Where(entql.HasEdge("muted_users"))
+}
+
+// WhereHasMutedUsersWith applies a predicate to check if query has an edge muted_users with a given conditions (other predicates).
+func (f *UserFilter) WhereHasMutedUsersWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("muted_users", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasMutedBy applies a predicate to check if query has an edge muted_by.
+func (f *UserFilter) WhereHasMutedBy() {
+	f.Where(entql.HasEdge("muted_by"))
+}
+
+// WhereHasMutedByWith applies a predicate to check if query has an edge muted_by with a given conditions (other predicates).
+func (f *UserFilter) WhereHasMutedByWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("muted_by", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasIdentities applies a predicate to check if query has an edge identities.
+func (f *UserFilter) WhereHasIdentities() {
+	f.Where(entql.HasEdge("identities"))
+}
+
+// WhereHasIdentitiesWith applies a predicate to check if query has an edge identities with a given conditions (other predicates).
+func (f *UserFilter) WhereHasIdentitiesWith(preds ...predicate.Identity) {
+	f.Where(entql.HasEdgeWith("identities", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // WhereHasRefreshTokens applies a predicate to check if query has an edge refresh_tokens.
 func (f *UserFilter) WhereHasRefreshTokens() {
 	f.Where(entql.HasEdge("refresh_tokens"))
@@ -1056,3 +2111,124 @@ func (f *UserFilter) WhereHasRefreshTokensWith(preds ...predicate.RefreshToken)
 		}
 	})))
 }
+
+// WhereHasRoleChanges applies a predicate to check if query has an edge role_changes.
+func (f *UserFilter) WhereHasRoleChanges() {
+	f.Where(entql.HasEdge("role_changes"))
+}
+
+// WhereHasRoleChangesWith applies a predicate to check if query has an edge role_changes with a given conditions (other predicates).
+func (f *UserFilter) WhereHasRoleChangesWith(preds ...predicate.RoleChange) {
+	f.Where(entql.HasEdgeWith("role_changes", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasSuspensions applies a predicate to check if query has an edge suspensions.
+func (f *UserFilter) WhereHasSuspensions() {
+	f.Where(entql.HasEdge("suspensions"))
+}
+
+// WhereHasSuspensionsWith applies a predicate to check if query has an edge suspensions with a given conditions (other predicates).
+func (f *UserFilter) WhereHasSuspensionsWith(preds ...predicate.Suspension) {
+	f.Where(entql.HasEdgeWith("suspensions", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (uaq *UserAwardQuery) addPredicate(pred func(s *sql.Selector)) {
+	uaq.predicates = append(uaq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the UserAwardQuery builder.
+func (uaq *UserAwardQuery) Filter() *UserAwardFilter {
+	return &UserAwardFilter{config: uaq.config, predicateAdder: uaq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *UserAwardMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the UserAwardMutation builder.
+func (m *UserAwardMutation) Filter() *UserAwardFilter {
+	return &UserAwardFilter{config: m.config, predicateAdder: m}
+}
+
+// UserAwardFilter provides a generic filtering capability at runtime for UserAwardQuery.
+type UserAwardFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *UserAwardFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql [16]byte predicate on the id field.
+func (f *UserAwardFilter) WhereID(p entql.ValueP) {
+	f.Where(p.Field(useraward.FieldID))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *UserAwardFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(useraward.FieldUpdatedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *UserAwardFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(useraward.FieldCreatedAt))
+}
+
+// WhereOwnerID applies the entql [16]byte predicate on the owner_id field.
+func (f *UserAwardFilter) WhereOwnerID(p entql.ValueP) {
+	f.Where(p.Field(useraward.FieldOwnerID))
+}
+
+// WhereAwardID applies the entql [16]byte predicate on the award_id field.
+func (f *UserAwardFilter) WhereAwardID(p entql.ValueP) {
+	f.Where(p.Field(useraward.FieldAwardID))
+}
+
+// WhereGrantedAt applies the entql time.Time predicate on the granted_at field.
+func (f *UserAwardFilter) WhereGrantedAt(p entql.TimeP) {
+	f.Where(p.Field(useraward.FieldGrantedAt))
+}
+
+// WhereHasOwner applies a predicate to check if query has an edge owner.
+func (f *UserAwardFilter) WhereHasOwner() {
+	f.Where(entql.HasEdge("owner"))
+}
+
+// WhereHasOwnerWith applies a predicate to check if query has an edge owner with a given conditions (other predicates).
+func (f *UserAwardFilter) WhereHasOwnerWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("owner", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasAward applies a predicate to check if query has an edge award.
+func (f *UserAwardFilter) WhereHasAward() {
+	f.Where(entql.HasEdge("award"))
+}
+
+// WhereHasAwardWith applies a predicate to check if query has an edge award with a given conditions (other predicates).
+func (f *UserAwardFilter) WhereHasAwardWith(preds ...predicate.AwardDefinition) {
+	f.Where(entql.HasEdgeWith("award", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
@@ -11,11 +11,18 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/apikey"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/categoryrestriction"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/comment"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/post"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/postcategory"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/refreshtoken"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
 	"github.com/google/uuid"
 )
 
@@ -65,16 +72,31 @@ func (ak *ApiKeyQuery) collectField(ctx context.Context, oneNode bool, opCtx *gr
 				selectedFields = append(selectedFields, apikey.FieldCreatedAt)
 				fieldSeen[apikey.FieldCreatedAt] = struct{}{}
 			}
-		case "apiKey":
-			if _, ok := fieldSeen[apikey.FieldAPIKey]; !ok {
-				selectedFields = append(selectedFields, apikey.FieldAPIKey)
-				fieldSeen[apikey.FieldAPIKey] = struct{}{}
+		case "name":
+			if _, ok := fieldSeen[apikey.FieldName]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldName)
+				fieldSeen[apikey.FieldName] = struct{}{}
+			}
+		case "prefix":
+			if _, ok := fieldSeen[apikey.FieldPrefix]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldPrefix)
+				fieldSeen[apikey.FieldPrefix] = struct{}{}
+			}
+		case "scopes":
+			if _, ok := fieldSeen[apikey.FieldScopes]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldScopes)
+				fieldSeen[apikey.FieldScopes] = struct{}{}
 			}
 		case "expiresOn":
 			if _, ok := fieldSeen[apikey.FieldExpiresOn]; !ok {
 				selectedFields = append(selectedFields, apikey.FieldExpiresOn)
 				fieldSeen[apikey.FieldExpiresOn] = struct{}{}
 			}
+		case "lastUsedAt":
+			if _, ok := fieldSeen[apikey.FieldLastUsedAt]; !ok {
+				selectedFields = append(selectedFields, apikey.FieldLastUsedAt)
+				fieldSeen[apikey.FieldLastUsedAt] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -138,6 +160,252 @@ func newApiKeyPaginateArgs(rv map[string]any) *apikeyPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (ad *AwardDefinitionQuery) CollectFields(ctx context.Context, satisfies ...string) (*AwardDefinitionQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return ad, nil
+	}
+	if err := ad.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return ad, nil
+}
+
+func (ad *AwardDefinitionQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(awarddefinition.Columns))
+		selectedFields = []string{awarddefinition.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "grants":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserAwardClient{config: ad.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, userawardImplementors)...); err != nil {
+				return err
+			}
+			ad.WithNamedGrants(alias, func(wq *UserAwardQuery) {
+				*wq = *query
+			})
+		case "updatedAt":
+			if _, ok := fieldSeen[awarddefinition.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, awarddefinition.FieldUpdatedAt)
+				fieldSeen[awarddefinition.FieldUpdatedAt] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[awarddefinition.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, awarddefinition.FieldCreatedAt)
+				fieldSeen[awarddefinition.FieldCreatedAt] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[awarddefinition.FieldName]; !ok {
+				selectedFields = append(selectedFields, awarddefinition.FieldName)
+				fieldSeen[awarddefinition.FieldName] = struct{}{}
+			}
+		case "description":
+			if _, ok := fieldSeen[awarddefinition.FieldDescription]; !ok {
+				selectedFields = append(selectedFields, awarddefinition.FieldDescription)
+				fieldSeen[awarddefinition.FieldDescription] = struct{}{}
+			}
+		case "icon":
+			if _, ok := fieldSeen[awarddefinition.FieldIcon]; !ok {
+				selectedFields = append(selectedFields, awarddefinition.FieldIcon)
+				fieldSeen[awarddefinition.FieldIcon] = struct{}{}
+			}
+		case "rule":
+			if _, ok := fieldSeen[awarddefinition.FieldRule]; !ok {
+				selectedFields = append(selectedFields, awarddefinition.FieldRule)
+				fieldSeen[awarddefinition.FieldRule] = struct{}{}
+			}
+		case "ruleCategory":
+			if _, ok := fieldSeen[awarddefinition.FieldRuleCategory]; !ok {
+				selectedFields = append(selectedFields, awarddefinition.FieldRuleCategory)
+				fieldSeen[awarddefinition.FieldRuleCategory] = struct{}{}
+			}
+		case "ruleThreshold":
+			if _, ok := fieldSeen[awarddefinition.FieldRuleThreshold]; !ok {
+				selectedFields = append(selectedFields, awarddefinition.FieldRuleThreshold)
+				fieldSeen[awarddefinition.FieldRuleThreshold] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		ad.Select(selectedFields...)
+	}
+	return nil
+}
+
+type awarddefinitionPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []AwardDefinitionPaginateOption
+}
+
+func newAwardDefinitionPaginateArgs(rv map[string]any) *awarddefinitionPaginateArgs {
+	args := &awarddefinitionPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &AwardDefinitionOrder{Field: &AwardDefinitionOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithAwardDefinitionOrder(order))
+			}
+		case *AwardDefinitionOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithAwardDefinitionOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*AwardDefinitionWhereInput); ok {
+		args.opts = append(args.opts, WithAwardDefinitionFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (cr *CategoryRestrictionQuery) CollectFields(ctx context.Context, satisfies ...string) (*CategoryRestrictionQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return cr, nil
+	}
+	if err := cr.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return cr, nil
+}
+
+func (cr *CategoryRestrictionQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(categoryrestriction.Columns))
+		selectedFields = []string{categoryrestriction.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "updatedAt":
+			if _, ok := fieldSeen[categoryrestriction.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, categoryrestriction.FieldUpdatedAt)
+				fieldSeen[categoryrestriction.FieldUpdatedAt] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[categoryrestriction.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, categoryrestriction.FieldCreatedAt)
+				fieldSeen[categoryrestriction.FieldCreatedAt] = struct{}{}
+			}
+		case "category":
+			if _, ok := fieldSeen[categoryrestriction.FieldCategory]; !ok {
+				selectedFields = append(selectedFields, categoryrestriction.FieldCategory)
+				fieldSeen[categoryrestriction.FieldCategory] = struct{}{}
+			}
+		case "subscriberOnlyPost":
+			if _, ok := fieldSeen[categoryrestriction.FieldSubscriberOnlyPost]; !ok {
+				selectedFields = append(selectedFields, categoryrestriction.FieldSubscriberOnlyPost)
+				fieldSeen[categoryrestriction.FieldSubscriberOnlyPost] = struct{}{}
+			}
+		case "subscriberOnlyView":
+			if _, ok := fieldSeen[categoryrestriction.FieldSubscriberOnlyView]; !ok {
+				selectedFields = append(selectedFields, categoryrestriction.FieldSubscriberOnlyView)
+				fieldSeen[categoryrestriction.FieldSubscriberOnlyView] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		cr.Select(selectedFields...)
+	}
+	return nil
+}
+
+type categoryrestrictionPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []CategoryRestrictionPaginateOption
+}
+
+func newCategoryRestrictionPaginateArgs(rv map[string]any) *categoryrestrictionPaginateArgs {
+	args := &categoryrestrictionPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &CategoryRestrictionOrder{Field: &CategoryRestrictionOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithCategoryRestrictionOrder(order))
+			}
+		case *CategoryRestrictionOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithCategoryRestrictionOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*CategoryRestrictionWhereInput); ok {
+		args.opts = append(args.opts, WithCategoryRestrictionFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (c *CommentQuery) CollectFields(ctx context.Context, satisfies ...string) (*CommentQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -273,6 +541,130 @@ func newCommentPaginateArgs(rv map[string]any) *commentPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (i *IdentityQuery) CollectFields(ctx context.Context, satisfies ...string) (*IdentityQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return i, nil
+	}
+	if err := i.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+func (i *IdentityQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(identity.Columns))
+		selectedFields = []string{identity.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "owner":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: i.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, userImplementors)...); err != nil {
+				return err
+			}
+			i.withOwner = query
+			if _, ok := fieldSeen[identity.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, identity.FieldOwnerID)
+				fieldSeen[identity.FieldOwnerID] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[identity.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, identity.FieldUpdatedAt)
+				fieldSeen[identity.FieldUpdatedAt] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[identity.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, identity.FieldCreatedAt)
+				fieldSeen[identity.FieldCreatedAt] = struct{}{}
+			}
+		case "provider":
+			if _, ok := fieldSeen[identity.FieldProvider]; !ok {
+				selectedFields = append(selectedFields, identity.FieldProvider)
+				fieldSeen[identity.FieldProvider] = struct{}{}
+			}
+		case "subject":
+			if _, ok := fieldSeen[identity.FieldSubject]; !ok {
+				selectedFields = append(selectedFields, identity.FieldSubject)
+				fieldSeen[identity.FieldSubject] = struct{}{}
+			}
+		case "email":
+			if _, ok := fieldSeen[identity.FieldEmail]; !ok {
+				selectedFields = append(selectedFields, identity.FieldEmail)
+				fieldSeen[identity.FieldEmail] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		i.Select(selectedFields...)
+	}
+	return nil
+}
+
+type identityPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []IdentityPaginateOption
+}
+
+func newIdentityPaginateArgs(rv map[string]any) *identityPaginateArgs {
+	args := &identityPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &IdentityOrder{Field: &IdentityOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithIdentityOrder(order))
+			}
+		case *IdentityOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithIdentityOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*IdentityWhereInput); ok {
+		args.opts = append(args.opts, WithIdentityFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (po *PostQuery) CollectFields(ctx context.Context, satisfies ...string) (*PostQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -885,42 +1277,439 @@ func newRefreshTokenPaginateArgs(rv map[string]any) *refreshtokenPaginateArgs {
 }
 
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
-func (u *UserQuery) CollectFields(ctx context.Context, satisfies ...string) (*UserQuery, error) {
+func (rc *RoleChangeQuery) CollectFields(ctx context.Context, satisfies ...string) (*RoleChangeQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
 	if fc == nil {
-		return u, nil
+		return rc, nil
 	}
-	if err := u.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+	if err := rc.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
 		return nil, err
 	}
-	return u, nil
+	return rc, nil
 }
 
-func (u *UserQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+func (rc *RoleChangeQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
 	path = append([]string(nil), path...)
 	var (
 		unknownSeen    bool
-		fieldSeen      = make(map[string]struct{}, len(user.Columns))
-		selectedFields = []string{user.FieldID}
+		fieldSeen      = make(map[string]struct{}, len(rolechange.Columns))
+		selectedFields = []string{rolechange.FieldID}
 	)
 	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
 		switch field.Name {
 
-		case "savedPosts":
+		case "user":
 			var (
 				alias = field.Alias
 				path  = append(path, alias)
-				query = (&PostClient{config: u.config}).Query()
+				query = (&UserClient{config: rc.config}).Query()
 			)
-			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, postImplementors)...); err != nil {
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, userImplementors)...); err != nil {
 				return err
 			}
-			u.WithNamedSavedPosts(alias, func(wq *PostQuery) {
-				*wq = *query
-			})
-
-		case "likedPosts":
-			var (
+			rc.withUser = query
+			if _, ok := fieldSeen[rolechange.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, rolechange.FieldUserID)
+				fieldSeen[rolechange.FieldUserID] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[rolechange.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, rolechange.FieldUpdatedAt)
+				fieldSeen[rolechange.FieldUpdatedAt] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[rolechange.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, rolechange.FieldCreatedAt)
+				fieldSeen[rolechange.FieldCreatedAt] = struct{}{}
+			}
+		case "userID":
+			if _, ok := fieldSeen[rolechange.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, rolechange.FieldUserID)
+				fieldSeen[rolechange.FieldUserID] = struct{}{}
+			}
+		case "attribute":
+			if _, ok := fieldSeen[rolechange.FieldAttribute]; !ok {
+				selectedFields = append(selectedFields, rolechange.FieldAttribute)
+				fieldSeen[rolechange.FieldAttribute] = struct{}{}
+			}
+		case "oldValue":
+			if _, ok := fieldSeen[rolechange.FieldOldValue]; !ok {
+				selectedFields = append(selectedFields, rolechange.FieldOldValue)
+				fieldSeen[rolechange.FieldOldValue] = struct{}{}
+			}
+		case "newValue":
+			if _, ok := fieldSeen[rolechange.FieldNewValue]; !ok {
+				selectedFields = append(selectedFields, rolechange.FieldNewValue)
+				fieldSeen[rolechange.FieldNewValue] = struct{}{}
+			}
+		case "source":
+			if _, ok := fieldSeen[rolechange.FieldSource]; !ok {
+				selectedFields = append(selectedFields, rolechange.FieldSource)
+				fieldSeen[rolechange.FieldSource] = struct{}{}
+			}
+		case "changedByID":
+			if _, ok := fieldSeen[rolechange.FieldChangedByID]; !ok {
+				selectedFields = append(selectedFields, rolechange.FieldChangedByID)
+				fieldSeen[rolechange.FieldChangedByID] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		rc.Select(selectedFields...)
+	}
+	return nil
+}
+
+type rolechangePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []RoleChangePaginateOption
+}
+
+func newRoleChangePaginateArgs(rv map[string]any) *rolechangePaginateArgs {
+	args := &rolechangePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &RoleChangeOrder{Field: &RoleChangeOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithRoleChangeOrder(order))
+			}
+		case *RoleChangeOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithRoleChangeOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*RoleChangeWhereInput); ok {
+		args.opts = append(args.opts, WithRoleChangeFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (rp *RolePermissionQuery) CollectFields(ctx context.Context, satisfies ...string) (*RolePermissionQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return rp, nil
+	}
+	if err := rp.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return rp, nil
+}
+
+func (rp *RolePermissionQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(rolepermission.Columns))
+		selectedFields = []string{rolepermission.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "updatedAt":
+			if _, ok := fieldSeen[rolepermission.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, rolepermission.FieldUpdatedAt)
+				fieldSeen[rolepermission.FieldUpdatedAt] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[rolepermission.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, rolepermission.FieldCreatedAt)
+				fieldSeen[rolepermission.FieldCreatedAt] = struct{}{}
+			}
+		case "role":
+			if _, ok := fieldSeen[rolepermission.FieldRole]; !ok {
+				selectedFields = append(selectedFields, rolepermission.FieldRole)
+				fieldSeen[rolepermission.FieldRole] = struct{}{}
+			}
+		case "permission":
+			if _, ok := fieldSeen[rolepermission.FieldPermission]; !ok {
+				selectedFields = append(selectedFields, rolepermission.FieldPermission)
+				fieldSeen[rolepermission.FieldPermission] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		rp.Select(selectedFields...)
+	}
+	return nil
+}
+
+type rolepermissionPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []RolePermissionPaginateOption
+}
+
+func newRolePermissionPaginateArgs(rv map[string]any) *rolepermissionPaginateArgs {
+	args := &rolepermissionPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &RolePermissionOrder{Field: &RolePermissionOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithRolePermissionOrder(order))
+			}
+		case *RolePermissionOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithRolePermissionOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*RolePermissionWhereInput); ok {
+		args.opts = append(args.opts, WithRolePermissionFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (s *SuspensionQuery) CollectFields(ctx context.Context, satisfies ...string) (*SuspensionQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return s, nil
+	}
+	if err := s.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *SuspensionQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(suspension.Columns))
+		selectedFields = []string{suspension.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "user":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: s.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, userImplementors)...); err != nil {
+				return err
+			}
+			s.withUser = query
+			if _, ok := fieldSeen[suspension.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, suspension.FieldUserID)
+				fieldSeen[suspension.FieldUserID] = struct{}{}
+			}
+
+		case "issuer":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: s.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, userImplementors)...); err != nil {
+				return err
+			}
+			s.withIssuer = query
+			if _, ok := fieldSeen[suspension.FieldIssuerID]; !ok {
+				selectedFields = append(selectedFields, suspension.FieldIssuerID)
+				fieldSeen[suspension.FieldIssuerID] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[suspension.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, suspension.FieldUpdatedAt)
+				fieldSeen[suspension.FieldUpdatedAt] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[suspension.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, suspension.FieldCreatedAt)
+				fieldSeen[suspension.FieldCreatedAt] = struct{}{}
+			}
+		case "userID":
+			if _, ok := fieldSeen[suspension.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, suspension.FieldUserID)
+				fieldSeen[suspension.FieldUserID] = struct{}{}
+			}
+		case "issuerID":
+			if _, ok := fieldSeen[suspension.FieldIssuerID]; !ok {
+				selectedFields = append(selectedFields, suspension.FieldIssuerID)
+				fieldSeen[suspension.FieldIssuerID] = struct{}{}
+			}
+		case "reason":
+			if _, ok := fieldSeen[suspension.FieldReason]; !ok {
+				selectedFields = append(selectedFields, suspension.FieldReason)
+				fieldSeen[suspension.FieldReason] = struct{}{}
+			}
+		case "scope":
+			if _, ok := fieldSeen[suspension.FieldScope]; !ok {
+				selectedFields = append(selectedFields, suspension.FieldScope)
+				fieldSeen[suspension.FieldScope] = struct{}{}
+			}
+		case "startsAt":
+			if _, ok := fieldSeen[suspension.FieldStartsAt]; !ok {
+				selectedFields = append(selectedFields, suspension.FieldStartsAt)
+				fieldSeen[suspension.FieldStartsAt] = struct{}{}
+			}
+		case "endsAt":
+			if _, ok := fieldSeen[suspension.FieldEndsAt]; !ok {
+				selectedFields = append(selectedFields, suspension.FieldEndsAt)
+				fieldSeen[suspension.FieldEndsAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		s.Select(selectedFields...)
+	}
+	return nil
+}
+
+type suspensionPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []SuspensionPaginateOption
+}
+
+func newSuspensionPaginateArgs(rv map[string]any) *suspensionPaginateArgs {
+	args := &suspensionPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &SuspensionOrder{Field: &SuspensionOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithSuspensionOrder(order))
+			}
+		case *SuspensionOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithSuspensionOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*SuspensionWhereInput); ok {
+		args.opts = append(args.opts, WithSuspensionFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (u *UserQuery) CollectFields(ctx context.Context, satisfies ...string) (*UserQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return u, nil
+	}
+	if err := u.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (u *UserQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(user.Columns))
+		selectedFields = []string{user.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "savedPosts":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&PostClient{config: u.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, postImplementors)...); err != nil {
+				return err
+			}
+			u.WithNamedSavedPosts(alias, func(wq *PostQuery) {
+				*wq = *query
+			})
+
+		case "likedPosts":
+			var (
 				alias = field.Alias
 				path  = append(path, alias)
 				query = (&PostClient{config: u.config}).Query()
@@ -970,6 +1759,32 @@ func (u *UserQuery) collectField(ctx context.Context, oneNode bool, opCtx *graph
 			u.WithNamedAPIKeys(alias, func(wq *ApiKeyQuery) {
 				*wq = *query
 			})
+
+		case "awards":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserAwardClient{config: u.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, userawardImplementors)...); err != nil {
+				return err
+			}
+			u.WithNamedAwards(alias, func(wq *UserAwardQuery) {
+				*wq = *query
+			})
+
+		case "suspensions":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&SuspensionClient{config: u.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, suspensionImplementors)...); err != nil {
+				return err
+			}
+			u.WithNamedSuspensions(alias, func(wq *SuspensionQuery) {
+				*wq = *query
+			})
 		case "updatedAt":
 			if _, ok := fieldSeen[user.FieldUpdatedAt]; !ok {
 				selectedFields = append(selectedFields, user.FieldUpdatedAt)
@@ -1015,6 +1830,16 @@ func (u *UserQuery) collectField(ctx context.Context, oneNode bool, opCtx *graph
 				selectedFields = append(selectedFields, user.FieldRole)
 				fieldSeen[user.FieldRole] = struct{}{}
 			}
+		case "roleSource":
+			if _, ok := fieldSeen[user.FieldRoleSource]; !ok {
+				selectedFields = append(selectedFields, user.FieldRoleSource)
+				fieldSeen[user.FieldRoleSource] = struct{}{}
+			}
+		case "twitchVip":
+			if _, ok := fieldSeen[user.FieldTwitchVip]; !ok {
+				selectedFields = append(selectedFields, user.FieldTwitchVip)
+				fieldSeen[user.FieldTwitchVip] = struct{}{}
+			}
 		case "lastSeenAt":
 			if _, ok := fieldSeen[user.FieldLastSeenAt]; !ok {
 				selectedFields = append(selectedFields, user.FieldLastSeenAt)
@@ -1025,11 +1850,6 @@ func (u *UserQuery) collectField(ctx context.Context, oneNode bool, opCtx *graph
 				selectedFields = append(selectedFields, user.FieldLastPostSeenCursor)
 				fieldSeen[user.FieldLastPostSeenCursor] = struct{}{}
 			}
-		case "awards":
-			if _, ok := fieldSeen[user.FieldAwards]; !ok {
-				selectedFields = append(selectedFields, user.FieldAwards)
-				fieldSeen[user.FieldAwards] = struct{}{}
-			}
 		case "id":
 		case "__typename":
 		default:
@@ -1093,6 +1913,135 @@ func newUserPaginateArgs(rv map[string]any) *userPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (ua *UserAwardQuery) CollectFields(ctx context.Context, satisfies ...string) (*UserAwardQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return ua, nil
+	}
+	if err := ua.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return ua, nil
+}
+
+func (ua *UserAwardQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(useraward.Columns))
+		selectedFields = []string{useraward.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "owner":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: ua.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, userImplementors)...); err != nil {
+				return err
+			}
+			ua.withOwner = query
+			if _, ok := fieldSeen[useraward.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, useraward.FieldOwnerID)
+				fieldSeen[useraward.FieldOwnerID] = struct{}{}
+			}
+
+		case "award":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&AwardDefinitionClient{config: ua.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, awarddefinitionImplementors)...); err != nil {
+				return err
+			}
+			ua.withAward = query
+			if _, ok := fieldSeen[useraward.FieldAwardID]; !ok {
+				selectedFields = append(selectedFields, useraward.FieldAwardID)
+				fieldSeen[useraward.FieldAwardID] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[useraward.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, useraward.FieldUpdatedAt)
+				fieldSeen[useraward.FieldUpdatedAt] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[useraward.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, useraward.FieldCreatedAt)
+				fieldSeen[useraward.FieldCreatedAt] = struct{}{}
+			}
+		case "grantedAt":
+			if _, ok := fieldSeen[useraward.FieldGrantedAt]; !ok {
+				selectedFields = append(selectedFields, useraward.FieldGrantedAt)
+				fieldSeen[useraward.FieldGrantedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		ua.Select(selectedFields...)
+	}
+	return nil
+}
+
+type userawardPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []UserAwardPaginateOption
+}
+
+func newUserAwardPaginateArgs(rv map[string]any) *userawardPaginateArgs {
+	args := &userawardPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &UserAwardOrder{Field: &UserAwardOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithUserAwardOrder(order))
+			}
+		case *UserAwardOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithUserAwardOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*UserAwardWhereInput); ok {
+		args.opts = append(args.opts, WithUserAwardFilter(v.Filter))
+	}
+	return args
+}
+
 const (
 	afterField     = "after"
 	firstField     = "first"
@@ -16,6 +16,18 @@ func (ak *ApiKey) Owner(ctx context.Context) (*User, error) {
 	return result, err
 }
 
+func (ad *AwardDefinition) Grants(ctx context.Context) (result []*UserAward, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = ad.NamedGrants(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = ad.Edges.GrantsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = ad.QueryGrants().All(ctx)
+	}
+	return result, err
+}
+
 func (c *Comment) Owner(ctx context.Context) (*User, error) {
 	result, err := c.Edges.OwnerOrErr()
 	if IsNotLoaded(err) {
@@ -32,6 +44,14 @@ func (c *Comment) Post(ctx context.Context) (*Post, error) {
 	return result, MaskNotFound(err)
 }
 
+func (i *Identity) Owner(ctx context.Context) (*User, error) {
+	result, err := i.Edges.OwnerOrErr()
+	if IsNotLoaded(err) {
+		result, err = i.QueryOwner().Only(ctx)
+	}
+	return result, err
+}
+
 func (po *Post) Owner(ctx context.Context) (*User, error) {
 	result, err := po.Edges.OwnerOrErr()
 	if IsNotLoaded(err) {
@@ -122,6 +142,30 @@ func (rt *RefreshToken) Owner(ctx context.Context) (*User, error) {
 	return result, err
 }
 
+func (rc *RoleChange) User(ctx context.Context) (*User, error) {
+	result, err := rc.Edges.UserOrErr()
+	if IsNotLoaded(err) {
+		result, err = rc.QueryUser().Only(ctx)
+	}
+	return result, err
+}
+
+func (s *Suspension) User(ctx context.Context) (*User, error) {
+	result, err := s.Edges.UserOrErr()
+	if IsNotLoaded(err) {
+		result, err = s.QueryUser().Only(ctx)
+	}
+	return result, err
+}
+
+func (s *Suspension) Issuer(ctx context.Context) (*User, error) {
+	result, err := s.Edges.IssuerOrErr()
+	if IsNotLoaded(err) {
+		result, err = s.QueryIssuer().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
 func (u *User) SavedPosts(ctx context.Context) (result []*Post, err error) {
 	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
 		result, err = u.NamedSavedPosts(graphql.GetFieldContext(ctx).Field.Alias)
@@ -181,3 +225,43 @@ func (u *User) APIKeys(ctx context.Context) (result []*ApiKey, err error) {
 	}
 	return result, err
 }
+
+func (u *User) Awards(ctx context.Context) (result []*UserAward, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = u.NamedAwards(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = u.Edges.AwardsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = u.QueryAwards().All(ctx)
+	}
+	return result, err
+}
+
+func (u *User) Suspensions(ctx context.Context) (result []*Suspension, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = u.NamedSuspensions(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = u.Edges.SuspensionsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = u.QuerySuspensions().All(ctx)
+	}
+	return result, err
+}
+
+func (ua *UserAward) Owner(ctx context.Context) (*User, error) {
+	result, err := ua.Edges.OwnerOrErr()
+	if IsNotLoaded(err) {
+		result, err = ua.QueryOwner().Only(ctx)
+	}
+	return result, err
+}
+
+func (ua *UserAward) Award(ctx context.Context) (*AwardDefinition, error) {
+	result, err := ua.Edges.AwardOrErr()
+	if IsNotLoaded(err) {
+		result, err = ua.QueryAward().Only(ctx)
+	}
+	return result, err
+}
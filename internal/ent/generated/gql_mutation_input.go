@@ -5,18 +5,27 @@ package generated
 import (
 	"time"
 
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/categoryrestriction"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/postcategory"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
 	"github.com/google/uuid"
 )
 
 // CreateApiKeyInput represents a mutation input for creating apikeys.
 type CreateApiKeyInput struct {
+	Name      string
+	Scopes    []string
 	ExpiresOn time.Time
 }
 
 // Mutate applies the CreateApiKeyInput on the ApiKeyMutation builder.
 func (i *CreateApiKeyInput) Mutate(m *ApiKeyMutation) {
+	m.SetName(i.Name)
+	if v := i.Scopes; v != nil {
+		m.SetScopes(v)
+	}
 	m.SetExpiresOn(i.ExpiresOn)
 }
 
@@ -28,11 +37,23 @@ func (c *ApiKeyCreate) SetInput(i CreateApiKeyInput) *ApiKeyCreate {
 
 // UpdateApiKeyInput represents a mutation input for updating apikeys.
 type UpdateApiKeyInput struct {
-	ExpiresOn *time.Time
+	Name         *string
+	Scopes       []string
+	AppendScopes []string
+	ExpiresOn    *time.Time
 }
 
 // Mutate applies the UpdateApiKeyInput on the ApiKeyMutation builder.
 func (i *UpdateApiKeyInput) Mutate(m *ApiKeyMutation) {
+	if v := i.Name; v != nil {
+		m.SetName(*v)
+	}
+	if v := i.Scopes; v != nil {
+		m.SetScopes(v)
+	}
+	if i.AppendScopes != nil {
+		m.AppendScopes(i.Scopes)
+	}
 	if v := i.ExpiresOn; v != nil {
 		m.SetExpiresOn(*v)
 	}
@@ -50,6 +71,142 @@ func (c *ApiKeyUpdateOne) SetInput(i UpdateApiKeyInput) *ApiKeyUpdateOne {
 	return c
 }
 
+// CreateAwardDefinitionInput represents a mutation input for creating awarddefinitions.
+type CreateAwardDefinitionInput struct {
+	Name          string
+	Description   *string
+	Icon          string
+	Rule          awarddefinition.Rule
+	RuleCategory  *awarddefinition.RuleCategory
+	RuleThreshold *int
+}
+
+// Mutate applies the CreateAwardDefinitionInput on the AwardDefinitionMutation builder.
+func (i *CreateAwardDefinitionInput) Mutate(m *AwardDefinitionMutation) {
+	m.SetName(i.Name)
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+	m.SetIcon(i.Icon)
+	m.SetRule(i.Rule)
+	if v := i.RuleCategory; v != nil {
+		m.SetRuleCategory(*v)
+	}
+	if v := i.RuleThreshold; v != nil {
+		m.SetRuleThreshold(*v)
+	}
+}
+
+// SetInput applies the change-set in the CreateAwardDefinitionInput on the AwardDefinitionCreate builder.
+func (c *AwardDefinitionCreate) SetInput(i CreateAwardDefinitionInput) *AwardDefinitionCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// UpdateAwardDefinitionInput represents a mutation input for updating awarddefinitions.
+type UpdateAwardDefinitionInput struct {
+	Name              *string
+	ClearDescription  bool
+	Description       *string
+	Icon              *string
+	Rule              *awarddefinition.Rule
+	ClearRuleCategory bool
+	RuleCategory      *awarddefinition.RuleCategory
+	RuleThreshold     *int
+}
+
+// Mutate applies the UpdateAwardDefinitionInput on the AwardDefinitionMutation builder.
+func (i *UpdateAwardDefinitionInput) Mutate(m *AwardDefinitionMutation) {
+	if v := i.Name; v != nil {
+		m.SetName(*v)
+	}
+	if i.ClearDescription {
+		m.ClearDescription()
+	}
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+	if v := i.Icon; v != nil {
+		m.SetIcon(*v)
+	}
+	if v := i.Rule; v != nil {
+		m.SetRule(*v)
+	}
+	if i.ClearRuleCategory {
+		m.ClearRuleCategory()
+	}
+	if v := i.RuleCategory; v != nil {
+		m.SetRuleCategory(*v)
+	}
+	if v := i.RuleThreshold; v != nil {
+		m.SetRuleThreshold(*v)
+	}
+}
+
+// SetInput applies the change-set in the UpdateAwardDefinitionInput on the AwardDefinitionUpdate builder.
+func (c *AwardDefinitionUpdate) SetInput(i UpdateAwardDefinitionInput) *AwardDefinitionUpdate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// SetInput applies the change-set in the UpdateAwardDefinitionInput on the AwardDefinitionUpdateOne builder.
+func (c *AwardDefinitionUpdateOne) SetInput(i UpdateAwardDefinitionInput) *AwardDefinitionUpdateOne {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// CreateCategoryRestrictionInput represents a mutation input for creating categoryrestrictions.
+type CreateCategoryRestrictionInput struct {
+	Category           categoryrestriction.Category
+	SubscriberOnlyPost *bool
+	SubscriberOnlyView *bool
+}
+
+// Mutate applies the CreateCategoryRestrictionInput on the CategoryRestrictionMutation builder.
+func (i *CreateCategoryRestrictionInput) Mutate(m *CategoryRestrictionMutation) {
+	m.SetCategory(i.Category)
+	if v := i.SubscriberOnlyPost; v != nil {
+		m.SetSubscriberOnlyPost(*v)
+	}
+	if v := i.SubscriberOnlyView; v != nil {
+		m.SetSubscriberOnlyView(*v)
+	}
+}
+
+// SetInput applies the change-set in the CreateCategoryRestrictionInput on the CategoryRestrictionCreate builder.
+func (c *CategoryRestrictionCreate) SetInput(i CreateCategoryRestrictionInput) *CategoryRestrictionCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// UpdateCategoryRestrictionInput represents a mutation input for updating categoryrestrictions.
+type UpdateCategoryRestrictionInput struct {
+	SubscriberOnlyPost *bool
+	SubscriberOnlyView *bool
+}
+
+// Mutate applies the UpdateCategoryRestrictionInput on the CategoryRestrictionMutation builder.
+func (i *UpdateCategoryRestrictionInput) Mutate(m *CategoryRestrictionMutation) {
+	if v := i.SubscriberOnlyPost; v != nil {
+		m.SetSubscriberOnlyPost(*v)
+	}
+	if v := i.SubscriberOnlyView; v != nil {
+		m.SetSubscriberOnlyView(*v)
+	}
+}
+
+// SetInput applies the change-set in the UpdateCategoryRestrictionInput on the CategoryRestrictionUpdate builder.
+func (c *CategoryRestrictionUpdate) SetInput(i UpdateCategoryRestrictionInput) *CategoryRestrictionUpdate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// SetInput applies the change-set in the UpdateCategoryRestrictionInput on the CategoryRestrictionUpdateOne builder.
+func (c *CategoryRestrictionUpdateOne) SetInput(i UpdateCategoryRestrictionInput) *CategoryRestrictionUpdateOne {
+	i.Mutate(c.Mutation())
+	return c
+}
+
 // CreateCommentInput represents a mutation input for creating comments.
 type CreateCommentInput struct {
 	Content string
@@ -74,10 +231,7 @@ func (c *CommentCreate) SetInput(i CreateCommentInput) *CommentCreate {
 
 // UpdateCommentInput represents a mutation input for updating comments.
 type UpdateCommentInput struct {
-	Content   *string
-	OwnerID   *uuid.UUID
-	ClearPost bool
-	PostID    *uuid.UUID
+	Content *string
 }
 
 // Mutate applies the UpdateCommentInput on the CommentMutation builder.
@@ -85,15 +239,6 @@ func (i *UpdateCommentInput) Mutate(m *CommentMutation) {
 	if v := i.Content; v != nil {
 		m.SetContent(*v)
 	}
-	if v := i.OwnerID; v != nil {
-		m.SetOwnerID(*v)
-	}
-	if i.ClearPost {
-		m.ClearPost()
-	}
-	if v := i.PostID; v != nil {
-		m.SetPostID(*v)
-	}
 }
 
 // SetInput applies the change-set in the UpdateCommentInput on the CommentUpdate builder.
@@ -390,6 +535,76 @@ func (c *RefreshTokenUpdateOne) SetInput(i UpdateRefreshTokenInput) *RefreshToke
 	return c
 }
 
+// CreateSuspensionInput represents a mutation input for creating suspensions.
+type CreateSuspensionInput struct {
+	Reason   string
+	Scope    *suspension.Scope
+	StartsAt *time.Time
+	EndsAt   *time.Time
+	UserID   uuid.UUID
+}
+
+// Mutate applies the CreateSuspensionInput on the SuspensionMutation builder.
+func (i *CreateSuspensionInput) Mutate(m *SuspensionMutation) {
+	m.SetReason(i.Reason)
+	if v := i.Scope; v != nil {
+		m.SetScope(*v)
+	}
+	if v := i.StartsAt; v != nil {
+		m.SetStartsAt(*v)
+	}
+	if v := i.EndsAt; v != nil {
+		m.SetEndsAt(*v)
+	}
+	m.SetUserID(i.UserID)
+}
+
+// SetInput applies the change-set in the CreateSuspensionInput on the SuspensionCreate builder.
+func (c *SuspensionCreate) SetInput(i CreateSuspensionInput) *SuspensionCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// UpdateSuspensionInput represents a mutation input for updating suspensions.
+type UpdateSuspensionInput struct {
+	Reason      *string
+	Scope       *suspension.Scope
+	StartsAt    *time.Time
+	ClearEndsAt bool
+	EndsAt      *time.Time
+}
+
+// Mutate applies the UpdateSuspensionInput on the SuspensionMutation builder.
+func (i *UpdateSuspensionInput) Mutate(m *SuspensionMutation) {
+	if v := i.Reason; v != nil {
+		m.SetReason(*v)
+	}
+	if v := i.Scope; v != nil {
+		m.SetScope(*v)
+	}
+	if v := i.StartsAt; v != nil {
+		m.SetStartsAt(*v)
+	}
+	if i.ClearEndsAt {
+		m.ClearEndsAt()
+	}
+	if v := i.EndsAt; v != nil {
+		m.SetEndsAt(*v)
+	}
+}
+
+// SetInput applies the change-set in the UpdateSuspensionInput on the SuspensionUpdate builder.
+func (c *SuspensionUpdate) SetInput(i UpdateSuspensionInput) *SuspensionUpdate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// SetInput applies the change-set in the UpdateSuspensionInput on the SuspensionUpdateOne builder.
+func (c *SuspensionUpdateOne) SetInput(i UpdateSuspensionInput) *SuspensionUpdateOne {
+	i.Mutate(c.Mutation())
+	return c
+}
+
 // CreateUserInput represents a mutation input for creating users.
 type CreateUserInput struct {
 	DisplayName        string
@@ -399,7 +614,6 @@ type CreateUserInput struct {
 	Role               *user.Role
 	LastSeenAt         *time.Time
 	LastPostSeenCursor *string
-	Awards             []string
 	SavedPostIDs       []uuid.UUID
 	LikedPostIDs       []uuid.UUID
 	PublishedPostIDs   []uuid.UUID
@@ -428,9 +642,6 @@ func (i *CreateUserInput) Mutate(m *UserMutation) {
 	if v := i.LastPostSeenCursor; v != nil {
 		m.SetLastPostSeenCursor(*v)
 	}
-	if v := i.Awards; v != nil {
-		m.SetAwards(v)
-	}
 	if v := i.SavedPostIDs; len(v) > 0 {
 		m.AddSavedPostIDs(v...)
 	}
@@ -467,9 +678,6 @@ type UpdateUserInput struct {
 	LastSeenAt              *time.Time
 	ClearLastPostSeenCursor bool
 	LastPostSeenCursor      *string
-	ClearAwards             bool
-	Awards                  []string
-	AppendAwards            []string
 	ClearSavedPosts         bool
 	AddSavedPostIDs         []uuid.UUID
 	RemoveSavedPostIDs      []uuid.UUID
@@ -522,15 +730,6 @@ func (i *UpdateUserInput) Mutate(m *UserMutation) {
 	if v := i.LastPostSeenCursor; v != nil {
 		m.SetLastPostSeenCursor(*v)
 	}
-	if i.ClearAwards {
-		m.ClearAwards()
-	}
-	if v := i.Awards; v != nil {
-		m.SetAwards(v)
-	}
-	if i.AppendAwards != nil {
-		m.AppendAwards(i.Awards)
-	}
 	if i.ClearSavedPosts {
 		m.ClearSavedPosts()
 	}
@@ -9,11 +9,18 @@ import (
 	"entgo.io/contrib/entgql"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/apikey"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/categoryrestriction"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/comment"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/post"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/postcategory"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/refreshtoken"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
 	"github.com/google/uuid"
 	"github.com/hashicorp/go-multierror"
 )
@@ -29,11 +36,26 @@ var apikeyImplementors = []string{"ApiKey", "Node"}
 // IsNode implements the Node interface check for GQLGen.
 func (*ApiKey) IsNode() {}
 
+var awarddefinitionImplementors = []string{"AwardDefinition", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*AwardDefinition) IsNode() {}
+
+var categoryrestrictionImplementors = []string{"CategoryRestriction", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*CategoryRestriction) IsNode() {}
+
 var commentImplementors = []string{"Comment", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
 func (*Comment) IsNode() {}
 
+var identityImplementors = []string{"Identity", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Identity) IsNode() {}
+
 var postImplementors = []string{"Post", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
@@ -49,11 +71,31 @@ var refreshtokenImplementors = []string{"RefreshToken", "Node"}
 // IsNode implements the Node interface check for GQLGen.
 func (*RefreshToken) IsNode() {}
 
+var rolechangeImplementors = []string{"RoleChange", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*RoleChange) IsNode() {}
+
+var rolepermissionImplementors = []string{"RolePermission", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*RolePermission) IsNode() {}
+
+var suspensionImplementors = []string{"Suspension", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Suspension) IsNode() {}
+
 var userImplementors = []string{"User", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
 func (*User) IsNode() {}
 
+var userawardImplementors = []string{"UserAward", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*UserAward) IsNode() {}
+
 var errNodeInvalidID = &NotFoundError{"node"}
 
 // NodeOption allows configuring the Noder execution using functional options.
@@ -121,6 +163,24 @@ func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder,
 			}
 		}
 		return query.Only(ctx)
+	case awarddefinition.Table:
+		query := c.AwardDefinition.Query().
+			Where(awarddefinition.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, awarddefinitionImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case categoryrestriction.Table:
+		query := c.CategoryRestriction.Query().
+			Where(categoryrestriction.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, categoryrestrictionImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case comment.Table:
 		query := c.Comment.Query().
 			Where(comment.ID(id))
@@ -130,6 +190,15 @@ func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder,
 			}
 		}
 		return query.Only(ctx)
+	case identity.Table:
+		query := c.Identity.Query().
+			Where(identity.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, identityImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case post.Table:
 		query := c.Post.Query().
 			Where(post.ID(id))
@@ -157,6 +226,33 @@ func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder,
 			}
 		}
 		return query.Only(ctx)
+	case rolechange.Table:
+		query := c.RoleChange.Query().
+			Where(rolechange.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, rolechangeImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case rolepermission.Table:
+		query := c.RolePermission.Query().
+			Where(rolepermission.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, rolepermissionImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case suspension.Table:
+		query := c.Suspension.Query().
+			Where(suspension.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, suspensionImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case user.Table:
 		query := c.User.Query().
 			Where(user.ID(id))
@@ -166,6 +262,15 @@ func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder,
 			}
 		}
 		return query.Only(ctx)
+	case useraward.Table:
+		query := c.UserAward.Query().
+			Where(useraward.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, userawardImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	default:
 		return nil, fmt.Errorf("cannot resolve noder from table %q: %w", table, errNodeInvalidID)
 	}
@@ -255,6 +360,38 @@ func (c *Client) noders(ctx context.Context, table string, ids []uuid.UUID) ([]N
 				*noder = node
 			}
 		}
+	case awarddefinition.Table:
+		query := c.AwardDefinition.Query().
+			Where(awarddefinition.IDIn(ids...))
+		query, err := query.CollectFields(ctx, awarddefinitionImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case categoryrestriction.Table:
+		query := c.CategoryRestriction.Query().
+			Where(categoryrestriction.IDIn(ids...))
+		query, err := query.CollectFields(ctx, categoryrestrictionImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case comment.Table:
 		query := c.Comment.Query().
 			Where(comment.IDIn(ids...))
@@ -271,6 +408,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []uuid.UUID) ([]N
 				*noder = node
 			}
 		}
+	case identity.Table:
+		query := c.Identity.Query().
+			Where(identity.IDIn(ids...))
+		query, err := query.CollectFields(ctx, identityImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case post.Table:
 		query := c.Post.Query().
 			Where(post.IDIn(ids...))
@@ -319,6 +472,54 @@ func (c *Client) noders(ctx context.Context, table string, ids []uuid.UUID) ([]N
 				*noder = node
 			}
 		}
+	case rolechange.Table:
+		query := c.RoleChange.Query().
+			Where(rolechange.IDIn(ids...))
+		query, err := query.CollectFields(ctx, rolechangeImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case rolepermission.Table:
+		query := c.RolePermission.Query().
+			Where(rolepermission.IDIn(ids...))
+		query, err := query.CollectFields(ctx, rolepermissionImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case suspension.Table:
+		query := c.Suspension.Query().
+			Where(suspension.IDIn(ids...))
+		query, err := query.CollectFields(ctx, suspensionImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case user.Table:
 		query := c.User.Query().
 			Where(user.IDIn(ids...))
@@ -335,6 +536,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []uuid.UUID) ([]N
 				*noder = node
 			}
 		}
+	case useraward.Table:
+		query := c.UserAward.Query().
+			Where(useraward.IDIn(ids...))
+		query, err := query.CollectFields(ctx, userawardImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	default:
 		return nil, fmt.Errorf("cannot resolve noders from table %q: %w", table, errNodeInvalidID)
 	}
@@ -7,10 +7,13 @@ import (
 	"encoding/json"
 
 	"github.com/caliecode/la-clipasa/internal/ent/generated/apikey"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/comment"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/post"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/postcategory"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
 	"github.com/google/uuid"
 )
 
@@ -41,7 +44,7 @@ func (ak *ApiKey) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     ak.ID,
 		Type:   "ApiKey",
-		Fields: make([]*Field, 4),
+		Fields: make([]*Field, 7),
 		Edges:  make([]*Edge, 1),
 	}
 	var buf []byte
@@ -61,22 +64,46 @@ func (ak *ApiKey) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "created_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(ak.APIKey); err != nil {
+	if buf, err = json.Marshal(ak.Name); err != nil {
 		return nil, err
 	}
 	node.Fields[2] = &Field{
 		Type:  "string",
-		Name:  "api_key",
+		Name:  "name",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(ak.ExpiresOn); err != nil {
+	if buf, err = json.Marshal(ak.Prefix); err != nil {
 		return nil, err
 	}
 	node.Fields[3] = &Field{
+		Type:  "string",
+		Name:  "prefix",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ak.Scopes); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "[]string",
+		Name:  "scopes",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ak.ExpiresOn); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
 		Type:  "time.Time",
 		Name:  "expires_on",
 		Value: string(buf),
 	}
+	if buf, err = json.Marshal(ak.LastUsedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "time.Time",
+		Name:  "last_used_at",
+		Value: string(buf),
+	}
 	node.Edges[0] = &Edge{
 		Type: "User",
 		Name: "owner",
@@ -90,6 +117,144 @@ func (ak *ApiKey) Node(ctx context.Context) (node *Node, err error) {
 	return node, nil
 }
 
+// Node implements Noder interface
+func (ad *AwardDefinition) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     ad.ID,
+		Type:   "AwardDefinition",
+		Fields: make([]*Field, 8),
+		Edges:  make([]*Edge, 1),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(ad.UpdatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "updated_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ad.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ad.Name); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "string",
+		Name:  "name",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ad.Description); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "string",
+		Name:  "description",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ad.Icon); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "string",
+		Name:  "icon",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ad.Rule); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "awarddefinition.Rule",
+		Name:  "rule",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ad.RuleCategory); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "awarddefinition.RuleCategory",
+		Name:  "rule_category",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ad.RuleThreshold); err != nil {
+		return nil, err
+	}
+	node.Fields[7] = &Field{
+		Type:  "int",
+		Name:  "rule_threshold",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
+		Type: "UserAward",
+		Name: "grants",
+	}
+	err = ad.QueryGrants().
+		Select(useraward.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// Node implements Noder interface
+func (cr *CategoryRestriction) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     cr.ID,
+		Type:   "CategoryRestriction",
+		Fields: make([]*Field, 5),
+		Edges:  make([]*Edge, 0),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(cr.UpdatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "updated_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(cr.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(cr.Category); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "categoryrestriction.Category",
+		Name:  "category",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(cr.SubscriberOnlyPost); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "bool",
+		Name:  "subscriber_only_post",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(cr.SubscriberOnlyView); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "bool",
+		Name:  "subscriber_only_view",
+		Value: string(buf),
+	}
+	return node, nil
+}
+
 // Node implements Noder interface
 func (c *Comment) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
@@ -162,6 +327,68 @@ func (c *Comment) Node(ctx context.Context) (node *Node, err error) {
 	return node, nil
 }
 
+// Node implements Noder interface
+func (i *Identity) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     i.ID,
+		Type:   "Identity",
+		Fields: make([]*Field, 5),
+		Edges:  make([]*Edge, 1),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(i.UpdatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "updated_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(i.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(i.Provider); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "identity.Provider",
+		Name:  "provider",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(i.Subject); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "string",
+		Name:  "subject",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(i.Email); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "string",
+		Name:  "email",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
+		Type: "User",
+		Name: "owner",
+	}
+	err = i.QueryOwner().
+		Select(user.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
 // Node implements Noder interface
 func (po *Post) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
@@ -444,13 +671,239 @@ func (rt *RefreshToken) Node(ctx context.Context) (node *Node, err error) {
 	return node, nil
 }
 
+// Node implements Noder interface
+func (rc *RoleChange) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     rc.ID,
+		Type:   "RoleChange",
+		Fields: make([]*Field, 8),
+		Edges:  make([]*Edge, 1),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(rc.UpdatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "updated_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(rc.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(rc.UserID); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "uuid.UUID",
+		Name:  "user_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(rc.Attribute); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "rolechange.Attribute",
+		Name:  "attribute",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(rc.OldValue); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "string",
+		Name:  "old_value",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(rc.NewValue); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "string",
+		Name:  "new_value",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(rc.Source); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "rolechange.Source",
+		Name:  "source",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(rc.ChangedByID); err != nil {
+		return nil, err
+	}
+	node.Fields[7] = &Field{
+		Type:  "uuid.UUID",
+		Name:  "changed_by_id",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
+		Type: "User",
+		Name: "user",
+	}
+	err = rc.QueryUser().
+		Select(user.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// Node implements Noder interface
+func (rp *RolePermission) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     rp.ID,
+		Type:   "RolePermission",
+		Fields: make([]*Field, 4),
+		Edges:  make([]*Edge, 0),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(rp.UpdatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "updated_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(rp.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(rp.Role); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "rolepermission.Role",
+		Name:  "role",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(rp.Permission); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "rolepermission.Permission",
+		Name:  "permission",
+		Value: string(buf),
+	}
+	return node, nil
+}
+
+// Node implements Noder interface
+func (s *Suspension) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     s.ID,
+		Type:   "Suspension",
+		Fields: make([]*Field, 8),
+		Edges:  make([]*Edge, 2),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(s.UpdatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "updated_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(s.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(s.UserID); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "uuid.UUID",
+		Name:  "user_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(s.IssuerID); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "uuid.UUID",
+		Name:  "issuer_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(s.Reason); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "string",
+		Name:  "reason",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(s.Scope); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "suspension.Scope",
+		Name:  "scope",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(s.StartsAt); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "time.Time",
+		Name:  "starts_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(s.EndsAt); err != nil {
+		return nil, err
+	}
+	node.Fields[7] = &Field{
+		Type:  "time.Time",
+		Name:  "ends_at",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
+		Type: "User",
+		Name: "user",
+	}
+	err = s.QueryUser().
+		Select(user.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[1] = &Edge{
+		Type: "User",
+		Name: "issuer",
+	}
+	err = s.QueryIssuer().
+		Select(user.FieldID).
+		Scan(ctx, &node.Edges[1].IDs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
 // Node implements Noder interface
 func (u *User) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     u.ID,
 		Type:   "User",
-		Fields: make([]*Field, 12),
-		Edges:  make([]*Edge, 5),
+		Fields: make([]*Field, 13),
+		Edges:  make([]*Edge, 7),
 	}
 	var buf []byte
 	if buf, err = json.Marshal(u.UpdatedAt); err != nil {
@@ -525,28 +978,36 @@ func (u *User) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "role",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(u.LastSeenAt); err != nil {
+	if buf, err = json.Marshal(u.RoleSource); err != nil {
 		return nil, err
 	}
 	node.Fields[9] = &Field{
-		Type:  "time.Time",
-		Name:  "last_seen_at",
+		Type:  "user.RoleSource",
+		Name:  "role_source",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(u.LastPostSeenCursor); err != nil {
+	if buf, err = json.Marshal(u.TwitchVip); err != nil {
 		return nil, err
 	}
 	node.Fields[10] = &Field{
-		Type:  "string",
-		Name:  "last_post_seen_cursor",
+		Type:  "bool",
+		Name:  "twitch_vip",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(u.Awards); err != nil {
+	if buf, err = json.Marshal(u.LastSeenAt); err != nil {
 		return nil, err
 	}
 	node.Fields[11] = &Field{
-		Type:  "[]string",
-		Name:  "awards",
+		Type:  "time.Time",
+		Name:  "last_seen_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(u.LastPostSeenCursor); err != nil {
+		return nil, err
+	}
+	node.Fields[12] = &Field{
+		Type:  "string",
+		Name:  "last_post_seen_cursor",
 		Value: string(buf),
 	}
 	node.Edges[0] = &Edge{
@@ -599,6 +1060,82 @@ func (u *User) Node(ctx context.Context) (node *Node, err error) {
 	if err != nil {
 		return nil, err
 	}
+	node.Edges[5] = &Edge{
+		Type: "UserAward",
+		Name: "awards",
+	}
+	err = u.QueryAwards().
+		Select(useraward.FieldID).
+		Scan(ctx, &node.Edges[5].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[6] = &Edge{
+		Type: "Suspension",
+		Name: "suspensions",
+	}
+	err = u.QuerySuspensions().
+		Select(suspension.FieldID).
+		Scan(ctx, &node.Edges[6].IDs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// Node implements Noder interface
+func (ua *UserAward) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     ua.ID,
+		Type:   "UserAward",
+		Fields: make([]*Field, 3),
+		Edges:  make([]*Edge, 2),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(ua.UpdatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "updated_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ua.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ua.GrantedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "time.Time",
+		Name:  "granted_at",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
+		Type: "User",
+		Name: "owner",
+	}
+	err = ua.QueryOwner().
+		Select(user.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[1] = &Edge{
+		Type: "AwardDefinition",
+		Name: "award",
+	}
+	err = ua.QueryAward().
+		Select(awarddefinition.FieldID).
+		Scan(ctx, &node.Edges[1].IDs)
+	if err != nil {
+		return nil, err
+	}
 	return node, nil
 }
 
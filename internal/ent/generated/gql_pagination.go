@@ -15,11 +15,18 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/errcode"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/apikey"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/categoryrestriction"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/comment"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/post"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/postcategory"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/refreshtoken"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
 	"github.com/google/uuid"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -436,20 +443,20 @@ func (ak *ApiKey) ToEdge(order *ApiKeyOrder) *ApiKeyEdge {
 	}
 }
 
-// CommentEdge is the edge representation of Comment.
-type CommentEdge struct {
-	Node   *Comment `json:"node"`
-	Cursor Cursor   `json:"cursor"`
+// AwardDefinitionEdge is the edge representation of AwardDefinition.
+type AwardDefinitionEdge struct {
+	Node   *AwardDefinition `json:"node"`
+	Cursor Cursor           `json:"cursor"`
 }
 
-// CommentConnection is the connection containing edges to Comment.
-type CommentConnection struct {
-	Edges      []*CommentEdge `json:"edges"`
-	PageInfo   PageInfo       `json:"pageInfo"`
-	TotalCount int            `json:"totalCount"`
+// AwardDefinitionConnection is the connection containing edges to AwardDefinition.
+type AwardDefinitionConnection struct {
+	Edges      []*AwardDefinitionEdge `json:"edges"`
+	PageInfo   PageInfo               `json:"pageInfo"`
+	TotalCount int                    `json:"totalCount"`
 }
 
-func (c *CommentConnection) build(nodes []*Comment, pager *commentPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *AwardDefinitionConnection) build(nodes []*AwardDefinition, pager *awarddefinitionPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -459,21 +466,21 @@ func (c *CommentConnection) build(nodes []*Comment, pager *commentPager, after *
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *Comment
+	var nodeAt func(int) *AwardDefinition
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Comment {
+		nodeAt = func(i int) *AwardDefinition {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Comment {
+		nodeAt = func(i int) *AwardDefinition {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*CommentEdge, len(nodes))
+	c.Edges = make([]*AwardDefinitionEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &CommentEdge{
+		c.Edges[i] = &AwardDefinitionEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -487,87 +494,87 @@ func (c *CommentConnection) build(nodes []*Comment, pager *commentPager, after *
 	}
 }
 
-// CommentPaginateOption enables pagination customization.
-type CommentPaginateOption func(*commentPager) error
+// AwardDefinitionPaginateOption enables pagination customization.
+type AwardDefinitionPaginateOption func(*awarddefinitionPager) error
 
-// WithCommentOrder configures pagination ordering.
-func WithCommentOrder(order *CommentOrder) CommentPaginateOption {
+// WithAwardDefinitionOrder configures pagination ordering.
+func WithAwardDefinitionOrder(order *AwardDefinitionOrder) AwardDefinitionPaginateOption {
 	if order == nil {
-		order = DefaultCommentOrder
+		order = DefaultAwardDefinitionOrder
 	}
 	o := *order
-	return func(pager *commentPager) error {
+	return func(pager *awarddefinitionPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultCommentOrder.Field
+			o.Field = DefaultAwardDefinitionOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithCommentFilter configures pagination filter.
-func WithCommentFilter(filter func(*CommentQuery) (*CommentQuery, error)) CommentPaginateOption {
-	return func(pager *commentPager) error {
+// WithAwardDefinitionFilter configures pagination filter.
+func WithAwardDefinitionFilter(filter func(*AwardDefinitionQuery) (*AwardDefinitionQuery, error)) AwardDefinitionPaginateOption {
+	return func(pager *awarddefinitionPager) error {
 		if filter == nil {
-			return errors.New("CommentQuery filter cannot be nil")
+			return errors.New("AwardDefinitionQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type commentPager struct {
+type awarddefinitionPager struct {
 	reverse bool
-	order   *CommentOrder
-	filter  func(*CommentQuery) (*CommentQuery, error)
+	order   *AwardDefinitionOrder
+	filter  func(*AwardDefinitionQuery) (*AwardDefinitionQuery, error)
 }
 
-func newCommentPager(opts []CommentPaginateOption, reverse bool) (*commentPager, error) {
-	pager := &commentPager{reverse: reverse}
+func newAwardDefinitionPager(opts []AwardDefinitionPaginateOption, reverse bool) (*awarddefinitionPager, error) {
+	pager := &awarddefinitionPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultCommentOrder
+		pager.order = DefaultAwardDefinitionOrder
 	}
 	return pager, nil
 }
 
-func (p *commentPager) applyFilter(query *CommentQuery) (*CommentQuery, error) {
+func (p *awarddefinitionPager) applyFilter(query *AwardDefinitionQuery) (*AwardDefinitionQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *commentPager) toCursor(c *Comment) Cursor {
-	return p.order.Field.toCursor(c)
+func (p *awarddefinitionPager) toCursor(ad *AwardDefinition) Cursor {
+	return p.order.Field.toCursor(ad)
 }
 
-func (p *commentPager) applyCursors(query *CommentQuery, after, before *Cursor) (*CommentQuery, error) {
+func (p *awarddefinitionPager) applyCursors(query *AwardDefinitionQuery, after, before *Cursor) (*AwardDefinitionQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultCommentOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultAwardDefinitionOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *commentPager) applyOrder(query *CommentQuery) *CommentQuery {
+func (p *awarddefinitionPager) applyOrder(query *AwardDefinitionQuery) *AwardDefinitionQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultCommentOrder.Field {
-		query = query.Order(DefaultCommentOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultAwardDefinitionOrder.Field {
+		query = query.Order(DefaultAwardDefinitionOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -575,7 +582,7 @@ func (p *commentPager) applyOrder(query *CommentQuery) *CommentQuery {
 	return query
 }
 
-func (p *commentPager) orderExpr(query *CommentQuery) sql.Querier {
+func (p *awarddefinitionPager) orderExpr(query *AwardDefinitionQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -585,33 +592,33 @@ func (p *commentPager) orderExpr(query *CommentQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultCommentOrder.Field {
-			b.Comma().Ident(DefaultCommentOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultAwardDefinitionOrder.Field {
+			b.Comma().Ident(DefaultAwardDefinitionOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Comment.
-func (c *CommentQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to AwardDefinition.
+func (ad *AwardDefinitionQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...CommentPaginateOption,
-) (*CommentConnection, error) {
+	before *Cursor, last *int, opts ...AwardDefinitionPaginateOption,
+) (*AwardDefinitionConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newCommentPager(opts, last != nil)
+	pager, err := newAwardDefinitionPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
-	if c, err = pager.applyFilter(c); err != nil {
+	if ad, err = pager.applyFilter(ad); err != nil {
 		return nil, err
 	}
-	conn := &CommentConnection{Edges: []*CommentEdge{}}
+	conn := &AwardDefinitionConnection{Edges: []*AwardDefinitionEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			c := c.Clone()
+			c := ad.Clone()
 			c.ctx.Fields = nil
 			if conn.TotalCount, err = c.Count(ctx); err != nil {
 				return nil, err
@@ -623,20 +630,20 @@ func (c *CommentQuery) Paginate(
 	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
 		return conn, nil
 	}
-	if c, err = pager.applyCursors(c, after, before); err != nil {
+	if ad, err = pager.applyCursors(ad, after, before); err != nil {
 		return nil, err
 	}
 	limit := paginateLimit(first, last)
 	if limit != 0 {
-		c.Limit(limit)
+		ad.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := c.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := ad.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
-	c = pager.applyOrder(c)
-	nodes, err := c.All(ctx)
+	ad = pager.applyOrder(ad)
+	nodes, err := ad.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -645,143 +652,143 @@ func (c *CommentQuery) Paginate(
 }
 
 var (
-	// CommentOrderFieldID orders Comment by id.
-	CommentOrderFieldID = &CommentOrderField{
-		Value: func(c *Comment) (ent.Value, error) {
-			return c.ID, nil
+	// AwardDefinitionOrderFieldID orders AwardDefinition by id.
+	AwardDefinitionOrderFieldID = &AwardDefinitionOrderField{
+		Value: func(ad *AwardDefinition) (ent.Value, error) {
+			return ad.ID, nil
 		},
-		column: comment.FieldID,
-		toTerm: comment.ByID,
-		toCursor: func(c *Comment) Cursor {
+		column: awarddefinition.FieldID,
+		toTerm: awarddefinition.ByID,
+		toCursor: func(ad *AwardDefinition) Cursor {
 			return Cursor{
-				ID:    c.ID,
-				Value: c.ID,
+				ID:    ad.ID,
+				Value: ad.ID,
 			}
 		},
 	}
-	// CommentOrderFieldUpdatedAt orders Comment by updated_at.
-	CommentOrderFieldUpdatedAt = &CommentOrderField{
-		Value: func(c *Comment) (ent.Value, error) {
-			return c.UpdatedAt, nil
+	// AwardDefinitionOrderFieldUpdatedAt orders AwardDefinition by updated_at.
+	AwardDefinitionOrderFieldUpdatedAt = &AwardDefinitionOrderField{
+		Value: func(ad *AwardDefinition) (ent.Value, error) {
+			return ad.UpdatedAt, nil
 		},
-		column: comment.FieldUpdatedAt,
-		toTerm: comment.ByUpdatedAt,
-		toCursor: func(c *Comment) Cursor {
+		column: awarddefinition.FieldUpdatedAt,
+		toTerm: awarddefinition.ByUpdatedAt,
+		toCursor: func(ad *AwardDefinition) Cursor {
 			return Cursor{
-				ID:    c.ID,
-				Value: c.UpdatedAt,
+				ID:    ad.ID,
+				Value: ad.UpdatedAt,
 			}
 		},
 	}
-	// CommentOrderFieldCreatedAt orders Comment by created_at.
-	CommentOrderFieldCreatedAt = &CommentOrderField{
-		Value: func(c *Comment) (ent.Value, error) {
-			return c.CreatedAt, nil
+	// AwardDefinitionOrderFieldCreatedAt orders AwardDefinition by created_at.
+	AwardDefinitionOrderFieldCreatedAt = &AwardDefinitionOrderField{
+		Value: func(ad *AwardDefinition) (ent.Value, error) {
+			return ad.CreatedAt, nil
 		},
-		column: comment.FieldCreatedAt,
-		toTerm: comment.ByCreatedAt,
-		toCursor: func(c *Comment) Cursor {
+		column: awarddefinition.FieldCreatedAt,
+		toTerm: awarddefinition.ByCreatedAt,
+		toCursor: func(ad *AwardDefinition) Cursor {
 			return Cursor{
-				ID:    c.ID,
-				Value: c.CreatedAt,
+				ID:    ad.ID,
+				Value: ad.CreatedAt,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f CommentOrderField) String() string {
+func (f AwardDefinitionOrderField) String() string {
 	var str string
 	switch f.column {
-	case CommentOrderFieldID.column:
+	case AwardDefinitionOrderFieldID.column:
 		str = "ID"
-	case CommentOrderFieldUpdatedAt.column:
+	case AwardDefinitionOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
-	case CommentOrderFieldCreatedAt.column:
+	case AwardDefinitionOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f CommentOrderField) MarshalGQL(w io.Writer) {
+func (f AwardDefinitionOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *CommentOrderField) UnmarshalGQL(v interface{}) error {
+func (f *AwardDefinitionOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("CommentOrderField %T must be a string", v)
+		return fmt.Errorf("AwardDefinitionOrderField %T must be a string", v)
 	}
 	switch str {
 	case "ID":
-		*f = *CommentOrderFieldID
+		*f = *AwardDefinitionOrderFieldID
 	case "UPDATED_AT":
-		*f = *CommentOrderFieldUpdatedAt
+		*f = *AwardDefinitionOrderFieldUpdatedAt
 	case "CREATED_AT":
-		*f = *CommentOrderFieldCreatedAt
+		*f = *AwardDefinitionOrderFieldCreatedAt
 	default:
-		return fmt.Errorf("%s is not a valid CommentOrderField", str)
+		return fmt.Errorf("%s is not a valid AwardDefinitionOrderField", str)
 	}
 	return nil
 }
 
-// CommentOrderField defines the ordering field of Comment.
-type CommentOrderField struct {
-	// Value extracts the ordering value from the given Comment.
-	Value    func(*Comment) (ent.Value, error)
+// AwardDefinitionOrderField defines the ordering field of AwardDefinition.
+type AwardDefinitionOrderField struct {
+	// Value extracts the ordering value from the given AwardDefinition.
+	Value    func(*AwardDefinition) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) comment.OrderOption
-	toCursor func(*Comment) Cursor
+	toTerm   func(...sql.OrderTermOption) awarddefinition.OrderOption
+	toCursor func(*AwardDefinition) Cursor
 }
 
-// CommentOrder defines the ordering of Comment.
-type CommentOrder struct {
-	Direction OrderDirection     `json:"direction"`
-	Field     *CommentOrderField `json:"field"`
+// AwardDefinitionOrder defines the ordering of AwardDefinition.
+type AwardDefinitionOrder struct {
+	Direction OrderDirection             `json:"direction"`
+	Field     *AwardDefinitionOrderField `json:"field"`
 }
 
-// DefaultCommentOrder is the default ordering of Comment.
-var DefaultCommentOrder = &CommentOrder{
+// DefaultAwardDefinitionOrder is the default ordering of AwardDefinition.
+var DefaultAwardDefinitionOrder = &AwardDefinitionOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &CommentOrderField{
-		Value: func(c *Comment) (ent.Value, error) {
-			return c.ID, nil
+	Field: &AwardDefinitionOrderField{
+		Value: func(ad *AwardDefinition) (ent.Value, error) {
+			return ad.ID, nil
 		},
-		column: comment.FieldID,
-		toTerm: comment.ByID,
-		toCursor: func(c *Comment) Cursor {
-			return Cursor{ID: c.ID}
+		column: awarddefinition.FieldID,
+		toTerm: awarddefinition.ByID,
+		toCursor: func(ad *AwardDefinition) Cursor {
+			return Cursor{ID: ad.ID}
 		},
 	},
 }
 
-// ToEdge converts Comment into CommentEdge.
-func (c *Comment) ToEdge(order *CommentOrder) *CommentEdge {
+// ToEdge converts AwardDefinition into AwardDefinitionEdge.
+func (ad *AwardDefinition) ToEdge(order *AwardDefinitionOrder) *AwardDefinitionEdge {
 	if order == nil {
-		order = DefaultCommentOrder
+		order = DefaultAwardDefinitionOrder
 	}
-	return &CommentEdge{
-		Node:   c,
-		Cursor: order.Field.toCursor(c),
+	return &AwardDefinitionEdge{
+		Node:   ad,
+		Cursor: order.Field.toCursor(ad),
 	}
 }
 
-// PostEdge is the edge representation of Post.
-type PostEdge struct {
-	Node   *Post  `json:"node"`
-	Cursor Cursor `json:"cursor"`
+// CategoryRestrictionEdge is the edge representation of CategoryRestriction.
+type CategoryRestrictionEdge struct {
+	Node   *CategoryRestriction `json:"node"`
+	Cursor Cursor               `json:"cursor"`
 }
 
-// PostConnection is the connection containing edges to Post.
-type PostConnection struct {
-	Edges      []*PostEdge `json:"edges"`
-	PageInfo   PageInfo    `json:"pageInfo"`
-	TotalCount int         `json:"totalCount"`
+// CategoryRestrictionConnection is the connection containing edges to CategoryRestriction.
+type CategoryRestrictionConnection struct {
+	Edges      []*CategoryRestrictionEdge `json:"edges"`
+	PageInfo   PageInfo                   `json:"pageInfo"`
+	TotalCount int                        `json:"totalCount"`
 }
 
-func (c *PostConnection) build(nodes []*Post, pager *postPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *CategoryRestrictionConnection) build(nodes []*CategoryRestriction, pager *categoryrestrictionPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -791,21 +798,21 @@ func (c *PostConnection) build(nodes []*Post, pager *postPager, after *Cursor, f
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *Post
+	var nodeAt func(int) *CategoryRestriction
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Post {
+		nodeAt = func(i int) *CategoryRestriction {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Post {
+		nodeAt = func(i int) *CategoryRestriction {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*PostEdge, len(nodes))
+	c.Edges = make([]*CategoryRestrictionEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &PostEdge{
+		c.Edges[i] = &CategoryRestrictionEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -819,140 +826,131 @@ func (c *PostConnection) build(nodes []*Post, pager *postPager, after *Cursor, f
 	}
 }
 
-// PostPaginateOption enables pagination customization.
-type PostPaginateOption func(*postPager) error
+// CategoryRestrictionPaginateOption enables pagination customization.
+type CategoryRestrictionPaginateOption func(*categoryrestrictionPager) error
 
-// WithPostOrder configures pagination ordering.
-func WithPostOrder(order *PostOrder) PostPaginateOption {
+// WithCategoryRestrictionOrder configures pagination ordering.
+func WithCategoryRestrictionOrder(order *CategoryRestrictionOrder) CategoryRestrictionPaginateOption {
 	if order == nil {
-		order = DefaultPostOrder
+		order = DefaultCategoryRestrictionOrder
 	}
 	o := *order
-	return func(pager *postPager) error {
+	return func(pager *categoryrestrictionPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultPostOrder.Field
+			o.Field = DefaultCategoryRestrictionOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithPostFilter configures pagination filter.
-func WithPostFilter(filter func(*PostQuery) (*PostQuery, error)) PostPaginateOption {
-	return func(pager *postPager) error {
+// WithCategoryRestrictionFilter configures pagination filter.
+func WithCategoryRestrictionFilter(filter func(*CategoryRestrictionQuery) (*CategoryRestrictionQuery, error)) CategoryRestrictionPaginateOption {
+	return func(pager *categoryrestrictionPager) error {
 		if filter == nil {
-			return errors.New("PostQuery filter cannot be nil")
+			return errors.New("CategoryRestrictionQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type postPager struct {
+type categoryrestrictionPager struct {
 	reverse bool
-	order   *PostOrder
-	filter  func(*PostQuery) (*PostQuery, error)
+	order   *CategoryRestrictionOrder
+	filter  func(*CategoryRestrictionQuery) (*CategoryRestrictionQuery, error)
 }
 
-func newPostPager(opts []PostPaginateOption, reverse bool) (*postPager, error) {
-	pager := &postPager{reverse: reverse}
+func newCategoryRestrictionPager(opts []CategoryRestrictionPaginateOption, reverse bool) (*categoryrestrictionPager, error) {
+	pager := &categoryrestrictionPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultPostOrder
+		pager.order = DefaultCategoryRestrictionOrder
 	}
 	return pager, nil
 }
 
-func (p *postPager) applyFilter(query *PostQuery) (*PostQuery, error) {
+func (p *categoryrestrictionPager) applyFilter(query *CategoryRestrictionQuery) (*CategoryRestrictionQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *postPager) toCursor(po *Post) Cursor {
-	return p.order.Field.toCursor(po)
+func (p *categoryrestrictionPager) toCursor(cr *CategoryRestriction) Cursor {
+	return p.order.Field.toCursor(cr)
 }
 
-func (p *postPager) applyCursors(query *PostQuery, after, before *Cursor) (*PostQuery, error) {
+func (p *categoryrestrictionPager) applyCursors(query *CategoryRestrictionQuery, after, before *Cursor) (*CategoryRestrictionQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultPostOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultCategoryRestrictionOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *postPager) applyOrder(query *PostQuery) *PostQuery {
+func (p *categoryrestrictionPager) applyOrder(query *CategoryRestrictionQuery) *CategoryRestrictionQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultPostOrder.Field {
-		query = query.Order(DefaultPostOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultCategoryRestrictionOrder.Field {
+		query = query.Order(DefaultCategoryRestrictionOrder.Field.toTerm(direction.OrderTermOption()))
 	}
-	switch p.order.Field.column {
-	case PostOrderFieldCommentsCount.column, PostOrderFieldLikedByCount.column:
-	default:
-		if len(query.ctx.Fields) > 0 {
-			query.ctx.AppendFieldOnce(p.order.Field.column)
-		}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
 	}
 	return query
 }
 
-func (p *postPager) orderExpr(query *PostQuery) sql.Querier {
+func (p *categoryrestrictionPager) orderExpr(query *CategoryRestrictionQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	switch p.order.Field.column {
-	case PostOrderFieldCommentsCount.column, PostOrderFieldLikedByCount.column:
-		query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	default:
-		if len(query.ctx.Fields) > 0 {
-			query.ctx.AppendFieldOnce(p.order.Field.column)
-		}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultPostOrder.Field {
-			b.Comma().Ident(DefaultPostOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultCategoryRestrictionOrder.Field {
+			b.Comma().Ident(DefaultCategoryRestrictionOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Post.
-func (po *PostQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to CategoryRestriction.
+func (cr *CategoryRestrictionQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...PostPaginateOption,
-) (*PostConnection, error) {
+	before *Cursor, last *int, opts ...CategoryRestrictionPaginateOption,
+) (*CategoryRestrictionConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newPostPager(opts, last != nil)
+	pager, err := newCategoryRestrictionPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
-	if po, err = pager.applyFilter(po); err != nil {
+	if cr, err = pager.applyFilter(cr); err != nil {
 		return nil, err
 	}
-	conn := &PostConnection{Edges: []*PostEdge{}}
+	conn := &CategoryRestrictionConnection{Edges: []*CategoryRestrictionEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			c := po.Clone()
+			c := cr.Clone()
 			c.ctx.Fields = nil
 			if conn.TotalCount, err = c.Count(ctx); err != nil {
 				return nil, err
@@ -964,20 +962,20 @@ func (po *PostQuery) Paginate(
 	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
 		return conn, nil
 	}
-	if po, err = pager.applyCursors(po, after, before); err != nil {
+	if cr, err = pager.applyCursors(cr, after, before); err != nil {
 		return nil, err
 	}
 	limit := paginateLimit(first, last)
 	if limit != 0 {
-		po.Limit(limit)
+		cr.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := po.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := cr.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
-	po = pager.applyOrder(po)
-	nodes, err := po.All(ctx)
+	cr = pager.applyOrder(cr)
+	nodes, err := cr.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -986,207 +984,2190 @@ func (po *PostQuery) Paginate(
 }
 
 var (
-	// PostOrderFieldID orders Post by id.
-	PostOrderFieldID = &PostOrderField{
-		Value: func(po *Post) (ent.Value, error) {
-			return po.ID, nil
+	// CategoryRestrictionOrderFieldID orders CategoryRestriction by id.
+	CategoryRestrictionOrderFieldID = &CategoryRestrictionOrderField{
+		Value: func(cr *CategoryRestriction) (ent.Value, error) {
+			return cr.ID, nil
 		},
-		column: post.FieldID,
-		toTerm: post.ByID,
-		toCursor: func(po *Post) Cursor {
+		column: categoryrestriction.FieldID,
+		toTerm: categoryrestriction.ByID,
+		toCursor: func(cr *CategoryRestriction) Cursor {
 			return Cursor{
-				ID:    po.ID,
-				Value: po.ID,
+				ID:    cr.ID,
+				Value: cr.ID,
 			}
 		},
 	}
-	// PostOrderFieldUpdatedAt orders Post by updated_at.
-	PostOrderFieldUpdatedAt = &PostOrderField{
-		Value: func(po *Post) (ent.Value, error) {
-			return po.UpdatedAt, nil
+	// CategoryRestrictionOrderFieldUpdatedAt orders CategoryRestriction by updated_at.
+	CategoryRestrictionOrderFieldUpdatedAt = &CategoryRestrictionOrderField{
+		Value: func(cr *CategoryRestriction) (ent.Value, error) {
+			return cr.UpdatedAt, nil
 		},
-		column: post.FieldUpdatedAt,
-		toTerm: post.ByUpdatedAt,
-		toCursor: func(po *Post) Cursor {
+		column: categoryrestriction.FieldUpdatedAt,
+		toTerm: categoryrestriction.ByUpdatedAt,
+		toCursor: func(cr *CategoryRestriction) Cursor {
 			return Cursor{
-				ID:    po.ID,
-				Value: po.UpdatedAt,
+				ID:    cr.ID,
+				Value: cr.UpdatedAt,
 			}
 		},
 	}
-	// PostOrderFieldCreatedAt orders Post by created_at.
-	PostOrderFieldCreatedAt = &PostOrderField{
-		Value: func(po *Post) (ent.Value, error) {
-			return po.CreatedAt, nil
+	// CategoryRestrictionOrderFieldCreatedAt orders CategoryRestriction by created_at.
+	CategoryRestrictionOrderFieldCreatedAt = &CategoryRestrictionOrderField{
+		Value: func(cr *CategoryRestriction) (ent.Value, error) {
+			return cr.CreatedAt, nil
 		},
-		column: post.FieldCreatedAt,
-		toTerm: post.ByCreatedAt,
-		toCursor: func(po *Post) Cursor {
+		column: categoryrestriction.FieldCreatedAt,
+		toTerm: categoryrestriction.ByCreatedAt,
+		toCursor: func(cr *CategoryRestriction) Cursor {
 			return Cursor{
-				ID:    po.ID,
-				Value: po.CreatedAt,
+				ID:    cr.ID,
+				Value: cr.CreatedAt,
 			}
 		},
 	}
-	// PostOrderFieldModeratedAt orders Post by moderated_at.
-	PostOrderFieldModeratedAt = &PostOrderField{
-		Value: func(po *Post) (ent.Value, error) {
-			return po.ModeratedAt, nil
-		},
-		column: post.FieldModeratedAt,
-		toTerm: post.ByModeratedAt,
-		toCursor: func(po *Post) Cursor {
-			return Cursor{
-				ID:    po.ID,
-				Value: po.ModeratedAt,
-			}
-		},
+)
+
+// String implement fmt.Stringer interface.
+func (f CategoryRestrictionOrderField) String() string {
+	var str string
+	switch f.column {
+	case CategoryRestrictionOrderFieldID.column:
+		str = "ID"
+	case CategoryRestrictionOrderFieldUpdatedAt.column:
+		str = "UPDATED_AT"
+	case CategoryRestrictionOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
 	}
-	// PostOrderFieldCommentsCount orders by COMMENTS_COUNT.
-	PostOrderFieldCommentsCount = &PostOrderField{
-		Value: func(po *Post) (ent.Value, error) {
-			return po.Value("comments_count")
-		},
-		column: "comments_count",
-		toTerm: func(opts ...sql.OrderTermOption) post.OrderOption {
-			return post.ByCommentsCount(
-				append(opts, sql.OrderSelectAs("comments_count"))...,
-			)
-		},
-		toCursor: func(po *Post) Cursor {
-			cv, _ := po.Value("comments_count")
-			return Cursor{
-				ID:    po.ID,
-				Value: cv,
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f CategoryRestrictionOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *CategoryRestrictionOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("CategoryRestrictionOrderField %T must be a string", v)
+	}
+	switch str {
+	case "ID":
+		*f = *CategoryRestrictionOrderFieldID
+	case "UPDATED_AT":
+		*f = *CategoryRestrictionOrderFieldUpdatedAt
+	case "CREATED_AT":
+		*f = *CategoryRestrictionOrderFieldCreatedAt
+	default:
+		return fmt.Errorf("%s is not a valid CategoryRestrictionOrderField", str)
+	}
+	return nil
+}
+
+// CategoryRestrictionOrderField defines the ordering field of CategoryRestriction.
+type CategoryRestrictionOrderField struct {
+	// Value extracts the ordering value from the given CategoryRestriction.
+	Value    func(*CategoryRestriction) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) categoryrestriction.OrderOption
+	toCursor func(*CategoryRestriction) Cursor
+}
+
+// CategoryRestrictionOrder defines the ordering of CategoryRestriction.
+type CategoryRestrictionOrder struct {
+	Direction OrderDirection                 `json:"direction"`
+	Field     *CategoryRestrictionOrderField `json:"field"`
+}
+
+// DefaultCategoryRestrictionOrder is the default ordering of CategoryRestriction.
+var DefaultCategoryRestrictionOrder = &CategoryRestrictionOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &CategoryRestrictionOrderField{
+		Value: func(cr *CategoryRestriction) (ent.Value, error) {
+			return cr.ID, nil
+		},
+		column: categoryrestriction.FieldID,
+		toTerm: categoryrestriction.ByID,
+		toCursor: func(cr *CategoryRestriction) Cursor {
+			return Cursor{ID: cr.ID}
+		},
+	},
+}
+
+// ToEdge converts CategoryRestriction into CategoryRestrictionEdge.
+func (cr *CategoryRestriction) ToEdge(order *CategoryRestrictionOrder) *CategoryRestrictionEdge {
+	if order == nil {
+		order = DefaultCategoryRestrictionOrder
+	}
+	return &CategoryRestrictionEdge{
+		Node:   cr,
+		Cursor: order.Field.toCursor(cr),
+	}
+}
+
+// CommentEdge is the edge representation of Comment.
+type CommentEdge struct {
+	Node   *Comment `json:"node"`
+	Cursor Cursor   `json:"cursor"`
+}
+
+// CommentConnection is the connection containing edges to Comment.
+type CommentConnection struct {
+	Edges      []*CommentEdge `json:"edges"`
+	PageInfo   PageInfo       `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
+}
+
+func (c *CommentConnection) build(nodes []*Comment, pager *commentPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Comment
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Comment {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Comment {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*CommentEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &CommentEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// CommentPaginateOption enables pagination customization.
+type CommentPaginateOption func(*commentPager) error
+
+// WithCommentOrder configures pagination ordering.
+func WithCommentOrder(order *CommentOrder) CommentPaginateOption {
+	if order == nil {
+		order = DefaultCommentOrder
+	}
+	o := *order
+	return func(pager *commentPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultCommentOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithCommentFilter configures pagination filter.
+func WithCommentFilter(filter func(*CommentQuery) (*CommentQuery, error)) CommentPaginateOption {
+	return func(pager *commentPager) error {
+		if filter == nil {
+			return errors.New("CommentQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type commentPager struct {
+	reverse bool
+	order   *CommentOrder
+	filter  func(*CommentQuery) (*CommentQuery, error)
+}
+
+func newCommentPager(opts []CommentPaginateOption, reverse bool) (*commentPager, error) {
+	pager := &commentPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultCommentOrder
+	}
+	return pager, nil
+}
+
+func (p *commentPager) applyFilter(query *CommentQuery) (*CommentQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *commentPager) toCursor(c *Comment) Cursor {
+	return p.order.Field.toCursor(c)
+}
+
+func (p *commentPager) applyCursors(query *CommentQuery, after, before *Cursor) (*CommentQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultCommentOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *commentPager) applyOrder(query *CommentQuery) *CommentQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultCommentOrder.Field {
+		query = query.Order(DefaultCommentOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *commentPager) orderExpr(query *CommentQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultCommentOrder.Field {
+			b.Comma().Ident(DefaultCommentOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Comment.
+func (c *CommentQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...CommentPaginateOption,
+) (*CommentConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newCommentPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if c, err = pager.applyFilter(c); err != nil {
+		return nil, err
+	}
+	conn := &CommentConnection{Edges: []*CommentEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := c.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if c, err = pager.applyCursors(c, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		c.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := c.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	c = pager.applyOrder(c)
+	nodes, err := c.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// CommentOrderFieldID orders Comment by id.
+	CommentOrderFieldID = &CommentOrderField{
+		Value: func(c *Comment) (ent.Value, error) {
+			return c.ID, nil
+		},
+		column: comment.FieldID,
+		toTerm: comment.ByID,
+		toCursor: func(c *Comment) Cursor {
+			return Cursor{
+				ID:    c.ID,
+				Value: c.ID,
+			}
+		},
+	}
+	// CommentOrderFieldUpdatedAt orders Comment by updated_at.
+	CommentOrderFieldUpdatedAt = &CommentOrderField{
+		Value: func(c *Comment) (ent.Value, error) {
+			return c.UpdatedAt, nil
+		},
+		column: comment.FieldUpdatedAt,
+		toTerm: comment.ByUpdatedAt,
+		toCursor: func(c *Comment) Cursor {
+			return Cursor{
+				ID:    c.ID,
+				Value: c.UpdatedAt,
+			}
+		},
+	}
+	// CommentOrderFieldCreatedAt orders Comment by created_at.
+	CommentOrderFieldCreatedAt = &CommentOrderField{
+		Value: func(c *Comment) (ent.Value, error) {
+			return c.CreatedAt, nil
+		},
+		column: comment.FieldCreatedAt,
+		toTerm: comment.ByCreatedAt,
+		toCursor: func(c *Comment) Cursor {
+			return Cursor{
+				ID:    c.ID,
+				Value: c.CreatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f CommentOrderField) String() string {
+	var str string
+	switch f.column {
+	case CommentOrderFieldID.column:
+		str = "ID"
+	case CommentOrderFieldUpdatedAt.column:
+		str = "UPDATED_AT"
+	case CommentOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f CommentOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *CommentOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("CommentOrderField %T must be a string", v)
+	}
+	switch str {
+	case "ID":
+		*f = *CommentOrderFieldID
+	case "UPDATED_AT":
+		*f = *CommentOrderFieldUpdatedAt
+	case "CREATED_AT":
+		*f = *CommentOrderFieldCreatedAt
+	default:
+		return fmt.Errorf("%s is not a valid CommentOrderField", str)
+	}
+	return nil
+}
+
+// CommentOrderField defines the ordering field of Comment.
+type CommentOrderField struct {
+	// Value extracts the ordering value from the given Comment.
+	Value    func(*Comment) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) comment.OrderOption
+	toCursor func(*Comment) Cursor
+}
+
+// CommentOrder defines the ordering of Comment.
+type CommentOrder struct {
+	Direction OrderDirection     `json:"direction"`
+	Field     *CommentOrderField `json:"field"`
+}
+
+// DefaultCommentOrder is the default ordering of Comment.
+var DefaultCommentOrder = &CommentOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &CommentOrderField{
+		Value: func(c *Comment) (ent.Value, error) {
+			return c.ID, nil
+		},
+		column: comment.FieldID,
+		toTerm: comment.ByID,
+		toCursor: func(c *Comment) Cursor {
+			return Cursor{ID: c.ID}
+		},
+	},
+}
+
+// ToEdge converts Comment into CommentEdge.
+func (c *Comment) ToEdge(order *CommentOrder) *CommentEdge {
+	if order == nil {
+		order = DefaultCommentOrder
+	}
+	return &CommentEdge{
+		Node:   c,
+		Cursor: order.Field.toCursor(c),
+	}
+}
+
+// IdentityEdge is the edge representation of Identity.
+type IdentityEdge struct {
+	Node   *Identity `json:"node"`
+	Cursor Cursor    `json:"cursor"`
+}
+
+// IdentityConnection is the connection containing edges to Identity.
+type IdentityConnection struct {
+	Edges      []*IdentityEdge `json:"edges"`
+	PageInfo   PageInfo        `json:"pageInfo"`
+	TotalCount int             `json:"totalCount"`
+}
+
+func (c *IdentityConnection) build(nodes []*Identity, pager *identityPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Identity
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Identity {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Identity {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*IdentityEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &IdentityEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// IdentityPaginateOption enables pagination customization.
+type IdentityPaginateOption func(*identityPager) error
+
+// WithIdentityOrder configures pagination ordering.
+func WithIdentityOrder(order *IdentityOrder) IdentityPaginateOption {
+	if order == nil {
+		order = DefaultIdentityOrder
+	}
+	o := *order
+	return func(pager *identityPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultIdentityOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithIdentityFilter configures pagination filter.
+func WithIdentityFilter(filter func(*IdentityQuery) (*IdentityQuery, error)) IdentityPaginateOption {
+	return func(pager *identityPager) error {
+		if filter == nil {
+			return errors.New("IdentityQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type identityPager struct {
+	reverse bool
+	order   *IdentityOrder
+	filter  func(*IdentityQuery) (*IdentityQuery, error)
+}
+
+func newIdentityPager(opts []IdentityPaginateOption, reverse bool) (*identityPager, error) {
+	pager := &identityPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultIdentityOrder
+	}
+	return pager, nil
+}
+
+func (p *identityPager) applyFilter(query *IdentityQuery) (*IdentityQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *identityPager) toCursor(i *Identity) Cursor {
+	return p.order.Field.toCursor(i)
+}
+
+func (p *identityPager) applyCursors(query *IdentityQuery, after, before *Cursor) (*IdentityQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultIdentityOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *identityPager) applyOrder(query *IdentityQuery) *IdentityQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultIdentityOrder.Field {
+		query = query.Order(DefaultIdentityOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *identityPager) orderExpr(query *IdentityQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultIdentityOrder.Field {
+			b.Comma().Ident(DefaultIdentityOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Identity.
+func (i *IdentityQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...IdentityPaginateOption,
+) (*IdentityConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newIdentityPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if i, err = pager.applyFilter(i); err != nil {
+		return nil, err
+	}
+	conn := &IdentityConnection{Edges: []*IdentityEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := i.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if i, err = pager.applyCursors(i, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		i.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := i.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	i = pager.applyOrder(i)
+	nodes, err := i.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// IdentityOrderFieldID orders Identity by id.
+	IdentityOrderFieldID = &IdentityOrderField{
+		Value: func(i *Identity) (ent.Value, error) {
+			return i.ID, nil
+		},
+		column: identity.FieldID,
+		toTerm: identity.ByID,
+		toCursor: func(i *Identity) Cursor {
+			return Cursor{
+				ID:    i.ID,
+				Value: i.ID,
+			}
+		},
+	}
+	// IdentityOrderFieldUpdatedAt orders Identity by updated_at.
+	IdentityOrderFieldUpdatedAt = &IdentityOrderField{
+		Value: func(i *Identity) (ent.Value, error) {
+			return i.UpdatedAt, nil
+		},
+		column: identity.FieldUpdatedAt,
+		toTerm: identity.ByUpdatedAt,
+		toCursor: func(i *Identity) Cursor {
+			return Cursor{
+				ID:    i.ID,
+				Value: i.UpdatedAt,
+			}
+		},
+	}
+	// IdentityOrderFieldCreatedAt orders Identity by created_at.
+	IdentityOrderFieldCreatedAt = &IdentityOrderField{
+		Value: func(i *Identity) (ent.Value, error) {
+			return i.CreatedAt, nil
+		},
+		column: identity.FieldCreatedAt,
+		toTerm: identity.ByCreatedAt,
+		toCursor: func(i *Identity) Cursor {
+			return Cursor{
+				ID:    i.ID,
+				Value: i.CreatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f IdentityOrderField) String() string {
+	var str string
+	switch f.column {
+	case IdentityOrderFieldID.column:
+		str = "ID"
+	case IdentityOrderFieldUpdatedAt.column:
+		str = "UPDATED_AT"
+	case IdentityOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f IdentityOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *IdentityOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("IdentityOrderField %T must be a string", v)
+	}
+	switch str {
+	case "ID":
+		*f = *IdentityOrderFieldID
+	case "UPDATED_AT":
+		*f = *IdentityOrderFieldUpdatedAt
+	case "CREATED_AT":
+		*f = *IdentityOrderFieldCreatedAt
+	default:
+		return fmt.Errorf("%s is not a valid IdentityOrderField", str)
+	}
+	return nil
+}
+
+// IdentityOrderField defines the ordering field of Identity.
+type IdentityOrderField struct {
+	// Value extracts the ordering value from the given Identity.
+	Value    func(*Identity) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) identity.OrderOption
+	toCursor func(*Identity) Cursor
+}
+
+// IdentityOrder defines the ordering of Identity.
+type IdentityOrder struct {
+	Direction OrderDirection      `json:"direction"`
+	Field     *IdentityOrderField `json:"field"`
+}
+
+// DefaultIdentityOrder is the default ordering of Identity.
+var DefaultIdentityOrder = &IdentityOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &IdentityOrderField{
+		Value: func(i *Identity) (ent.Value, error) {
+			return i.ID, nil
+		},
+		column: identity.FieldID,
+		toTerm: identity.ByID,
+		toCursor: func(i *Identity) Cursor {
+			return Cursor{ID: i.ID}
+		},
+	},
+}
+
+// ToEdge converts Identity into IdentityEdge.
+func (i *Identity) ToEdge(order *IdentityOrder) *IdentityEdge {
+	if order == nil {
+		order = DefaultIdentityOrder
+	}
+	return &IdentityEdge{
+		Node:   i,
+		Cursor: order.Field.toCursor(i),
+	}
+}
+
+// PostEdge is the edge representation of Post.
+type PostEdge struct {
+	Node   *Post  `json:"node"`
+	Cursor Cursor `json:"cursor"`
+}
+
+// PostConnection is the connection containing edges to Post.
+type PostConnection struct {
+	Edges      []*PostEdge `json:"edges"`
+	PageInfo   PageInfo    `json:"pageInfo"`
+	TotalCount int         `json:"totalCount"`
+}
+
+func (c *PostConnection) build(nodes []*Post, pager *postPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Post
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Post {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Post {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*PostEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &PostEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// PostPaginateOption enables pagination customization.
+type PostPaginateOption func(*postPager) error
+
+// WithPostOrder configures pagination ordering.
+func WithPostOrder(order *PostOrder) PostPaginateOption {
+	if order == nil {
+		order = DefaultPostOrder
+	}
+	o := *order
+	return func(pager *postPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultPostOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithPostFilter configures pagination filter.
+func WithPostFilter(filter func(*PostQuery) (*PostQuery, error)) PostPaginateOption {
+	return func(pager *postPager) error {
+		if filter == nil {
+			return errors.New("PostQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type postPager struct {
+	reverse bool
+	order   *PostOrder
+	filter  func(*PostQuery) (*PostQuery, error)
+}
+
+func newPostPager(opts []PostPaginateOption, reverse bool) (*postPager, error) {
+	pager := &postPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultPostOrder
+	}
+	return pager, nil
+}
+
+func (p *postPager) applyFilter(query *PostQuery) (*PostQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *postPager) toCursor(po *Post) Cursor {
+	return p.order.Field.toCursor(po)
+}
+
+func (p *postPager) applyCursors(query *PostQuery, after, before *Cursor) (*PostQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultPostOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *postPager) applyOrder(query *PostQuery) *PostQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultPostOrder.Field {
+		query = query.Order(DefaultPostOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	switch p.order.Field.column {
+	case PostOrderFieldCommentsCount.column, PostOrderFieldLikedByCount.column:
+	default:
+		if len(query.ctx.Fields) > 0 {
+			query.ctx.AppendFieldOnce(p.order.Field.column)
+		}
+	}
+	return query
+}
+
+func (p *postPager) orderExpr(query *PostQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	switch p.order.Field.column {
+	case PostOrderFieldCommentsCount.column, PostOrderFieldLikedByCount.column:
+		query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	default:
+		if len(query.ctx.Fields) > 0 {
+			query.ctx.AppendFieldOnce(p.order.Field.column)
+		}
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultPostOrder.Field {
+			b.Comma().Ident(DefaultPostOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Post.
+func (po *PostQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...PostPaginateOption,
+) (*PostConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newPostPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if po, err = pager.applyFilter(po); err != nil {
+		return nil, err
+	}
+	conn := &PostConnection{Edges: []*PostEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := po.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if po, err = pager.applyCursors(po, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		po.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := po.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	po = pager.applyOrder(po)
+	nodes, err := po.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// PostOrderFieldID orders Post by id.
+	PostOrderFieldID = &PostOrderField{
+		Value: func(po *Post) (ent.Value, error) {
+			return po.ID, nil
+		},
+		column: post.FieldID,
+		toTerm: post.ByID,
+		toCursor: func(po *Post) Cursor {
+			return Cursor{
+				ID:    po.ID,
+				Value: po.ID,
+			}
+		},
+	}
+	// PostOrderFieldUpdatedAt orders Post by updated_at.
+	PostOrderFieldUpdatedAt = &PostOrderField{
+		Value: func(po *Post) (ent.Value, error) {
+			return po.UpdatedAt, nil
+		},
+		column: post.FieldUpdatedAt,
+		toTerm: post.ByUpdatedAt,
+		toCursor: func(po *Post) Cursor {
+			return Cursor{
+				ID:    po.ID,
+				Value: po.UpdatedAt,
+			}
+		},
+	}
+	// PostOrderFieldCreatedAt orders Post by created_at.
+	PostOrderFieldCreatedAt = &PostOrderField{
+		Value: func(po *Post) (ent.Value, error) {
+			return po.CreatedAt, nil
+		},
+		column: post.FieldCreatedAt,
+		toTerm: post.ByCreatedAt,
+		toCursor: func(po *Post) Cursor {
+			return Cursor{
+				ID:    po.ID,
+				Value: po.CreatedAt,
+			}
+		},
+	}
+	// PostOrderFieldModeratedAt orders Post by moderated_at.
+	PostOrderFieldModeratedAt = &PostOrderField{
+		Value: func(po *Post) (ent.Value, error) {
+			return po.ModeratedAt, nil
+		},
+		column: post.FieldModeratedAt,
+		toTerm: post.ByModeratedAt,
+		toCursor: func(po *Post) Cursor {
+			return Cursor{
+				ID:    po.ID,
+				Value: po.ModeratedAt,
+			}
+		},
+	}
+	// PostOrderFieldCommentsCount orders by COMMENTS_COUNT.
+	PostOrderFieldCommentsCount = &PostOrderField{
+		Value: func(po *Post) (ent.Value, error) {
+			return po.Value("comments_count")
+		},
+		column: "comments_count",
+		toTerm: func(opts ...sql.OrderTermOption) post.OrderOption {
+			return post.ByCommentsCount(
+				append(opts, sql.OrderSelectAs("comments_count"))...,
+			)
+		},
+		toCursor: func(po *Post) Cursor {
+			cv, _ := po.Value("comments_count")
+			return Cursor{
+				ID:    po.ID,
+				Value: cv,
+			}
+		},
+	}
+	// PostOrderFieldLikedByCount orders by LIKED_BY_COUNT.
+	PostOrderFieldLikedByCount = &PostOrderField{
+		Value: func(po *Post) (ent.Value, error) {
+			return po.Value("liked_by_count")
+		},
+		column: "liked_by_count",
+		toTerm: func(opts ...sql.OrderTermOption) post.OrderOption {
+			return post.ByLikedByCount(
+				append(opts, sql.OrderSelectAs("liked_by_count"))...,
+			)
+		},
+		toCursor: func(po *Post) Cursor {
+			cv, _ := po.Value("liked_by_count")
+			return Cursor{
+				ID:    po.ID,
+				Value: cv,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f PostOrderField) String() string {
+	var str string
+	switch f.column {
+	case PostOrderFieldID.column:
+		str = "ID"
+	case PostOrderFieldUpdatedAt.column:
+		str = "UPDATED_AT"
+	case PostOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	case PostOrderFieldModeratedAt.column:
+		str = "MODERATED_AT"
+	case PostOrderFieldCommentsCount.column:
+		str = "COMMENTS_COUNT"
+	case PostOrderFieldLikedByCount.column:
+		str = "LIKED_BY_COUNT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f PostOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *PostOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("PostOrderField %T must be a string", v)
+	}
+	switch str {
+	case "ID":
+		*f = *PostOrderFieldID
+	case "UPDATED_AT":
+		*f = *PostOrderFieldUpdatedAt
+	case "CREATED_AT":
+		*f = *PostOrderFieldCreatedAt
+	case "MODERATED_AT":
+		*f = *PostOrderFieldModeratedAt
+	case "COMMENTS_COUNT":
+		*f = *PostOrderFieldCommentsCount
+	case "LIKED_BY_COUNT":
+		*f = *PostOrderFieldLikedByCount
+	default:
+		return fmt.Errorf("%s is not a valid PostOrderField", str)
+	}
+	return nil
+}
+
+// PostOrderField defines the ordering field of Post.
+type PostOrderField struct {
+	// Value extracts the ordering value from the given Post.
+	Value    func(*Post) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) post.OrderOption
+	toCursor func(*Post) Cursor
+}
+
+// PostOrder defines the ordering of Post.
+type PostOrder struct {
+	Direction OrderDirection  `json:"direction"`
+	Field     *PostOrderField `json:"field"`
+}
+
+// DefaultPostOrder is the default ordering of Post.
+var DefaultPostOrder = &PostOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &PostOrderField{
+		Value: func(po *Post) (ent.Value, error) {
+			return po.ID, nil
+		},
+		column: post.FieldID,
+		toTerm: post.ByID,
+		toCursor: func(po *Post) Cursor {
+			return Cursor{ID: po.ID}
+		},
+	},
+}
+
+// ToEdge converts Post into PostEdge.
+func (po *Post) ToEdge(order *PostOrder) *PostEdge {
+	if order == nil {
+		order = DefaultPostOrder
+	}
+	return &PostEdge{
+		Node:   po,
+		Cursor: order.Field.toCursor(po),
+	}
+}
+
+// PostCategoryEdge is the edge representation of PostCategory.
+type PostCategoryEdge struct {
+	Node   *PostCategory `json:"node"`
+	Cursor Cursor        `json:"cursor"`
+}
+
+// PostCategoryConnection is the connection containing edges to PostCategory.
+type PostCategoryConnection struct {
+	Edges      []*PostCategoryEdge `json:"edges"`
+	PageInfo   PageInfo            `json:"pageInfo"`
+	TotalCount int                 `json:"totalCount"`
+}
+
+func (c *PostCategoryConnection) build(nodes []*PostCategory, pager *postcategoryPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *PostCategory
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *PostCategory {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *PostCategory {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*PostCategoryEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &PostCategoryEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// PostCategoryPaginateOption enables pagination customization.
+type PostCategoryPaginateOption func(*postcategoryPager) error
+
+// WithPostCategoryOrder configures pagination ordering.
+func WithPostCategoryOrder(order *PostCategoryOrder) PostCategoryPaginateOption {
+	if order == nil {
+		order = DefaultPostCategoryOrder
+	}
+	o := *order
+	return func(pager *postcategoryPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultPostCategoryOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithPostCategoryFilter configures pagination filter.
+func WithPostCategoryFilter(filter func(*PostCategoryQuery) (*PostCategoryQuery, error)) PostCategoryPaginateOption {
+	return func(pager *postcategoryPager) error {
+		if filter == nil {
+			return errors.New("PostCategoryQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type postcategoryPager struct {
+	reverse bool
+	order   *PostCategoryOrder
+	filter  func(*PostCategoryQuery) (*PostCategoryQuery, error)
+}
+
+func newPostCategoryPager(opts []PostCategoryPaginateOption, reverse bool) (*postcategoryPager, error) {
+	pager := &postcategoryPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultPostCategoryOrder
+	}
+	return pager, nil
+}
+
+func (p *postcategoryPager) applyFilter(query *PostCategoryQuery) (*PostCategoryQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *postcategoryPager) toCursor(pc *PostCategory) Cursor {
+	return p.order.Field.toCursor(pc)
+}
+
+func (p *postcategoryPager) applyCursors(query *PostCategoryQuery, after, before *Cursor) (*PostCategoryQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultPostCategoryOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *postcategoryPager) applyOrder(query *PostCategoryQuery) *PostCategoryQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultPostCategoryOrder.Field {
+		query = query.Order(DefaultPostCategoryOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *postcategoryPager) orderExpr(query *PostCategoryQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultPostCategoryOrder.Field {
+			b.Comma().Ident(DefaultPostCategoryOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to PostCategory.
+func (pc *PostCategoryQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...PostCategoryPaginateOption,
+) (*PostCategoryConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newPostCategoryPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if pc, err = pager.applyFilter(pc); err != nil {
+		return nil, err
+	}
+	conn := &PostCategoryConnection{Edges: []*PostCategoryEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := pc.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if pc, err = pager.applyCursors(pc, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		pc.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := pc.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	pc = pager.applyOrder(pc)
+	nodes, err := pc.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// PostCategoryOrderFieldID orders PostCategory by id.
+	PostCategoryOrderFieldID = &PostCategoryOrderField{
+		Value: func(pc *PostCategory) (ent.Value, error) {
+			return pc.ID, nil
+		},
+		column: postcategory.FieldID,
+		toTerm: postcategory.ByID,
+		toCursor: func(pc *PostCategory) Cursor {
+			return Cursor{
+				ID:    pc.ID,
+				Value: pc.ID,
+			}
+		},
+	}
+	// PostCategoryOrderFieldUpdatedAt orders PostCategory by updated_at.
+	PostCategoryOrderFieldUpdatedAt = &PostCategoryOrderField{
+		Value: func(pc *PostCategory) (ent.Value, error) {
+			return pc.UpdatedAt, nil
+		},
+		column: postcategory.FieldUpdatedAt,
+		toTerm: postcategory.ByUpdatedAt,
+		toCursor: func(pc *PostCategory) Cursor {
+			return Cursor{
+				ID:    pc.ID,
+				Value: pc.UpdatedAt,
+			}
+		},
+	}
+	// PostCategoryOrderFieldCreatedAt orders PostCategory by created_at.
+	PostCategoryOrderFieldCreatedAt = &PostCategoryOrderField{
+		Value: func(pc *PostCategory) (ent.Value, error) {
+			return pc.CreatedAt, nil
+		},
+		column: postcategory.FieldCreatedAt,
+		toTerm: postcategory.ByCreatedAt,
+		toCursor: func(pc *PostCategory) Cursor {
+			return Cursor{
+				ID:    pc.ID,
+				Value: pc.CreatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f PostCategoryOrderField) String() string {
+	var str string
+	switch f.column {
+	case PostCategoryOrderFieldID.column:
+		str = "ID"
+	case PostCategoryOrderFieldUpdatedAt.column:
+		str = "UPDATED_AT"
+	case PostCategoryOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f PostCategoryOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *PostCategoryOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("PostCategoryOrderField %T must be a string", v)
+	}
+	switch str {
+	case "ID":
+		*f = *PostCategoryOrderFieldID
+	case "UPDATED_AT":
+		*f = *PostCategoryOrderFieldUpdatedAt
+	case "CREATED_AT":
+		*f = *PostCategoryOrderFieldCreatedAt
+	default:
+		return fmt.Errorf("%s is not a valid PostCategoryOrderField", str)
+	}
+	return nil
+}
+
+// PostCategoryOrderField defines the ordering field of PostCategory.
+type PostCategoryOrderField struct {
+	// Value extracts the ordering value from the given PostCategory.
+	Value    func(*PostCategory) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) postcategory.OrderOption
+	toCursor func(*PostCategory) Cursor
+}
+
+// PostCategoryOrder defines the ordering of PostCategory.
+type PostCategoryOrder struct {
+	Direction OrderDirection          `json:"direction"`
+	Field     *PostCategoryOrderField `json:"field"`
+}
+
+// DefaultPostCategoryOrder is the default ordering of PostCategory.
+var DefaultPostCategoryOrder = &PostCategoryOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &PostCategoryOrderField{
+		Value: func(pc *PostCategory) (ent.Value, error) {
+			return pc.ID, nil
+		},
+		column: postcategory.FieldID,
+		toTerm: postcategory.ByID,
+		toCursor: func(pc *PostCategory) Cursor {
+			return Cursor{ID: pc.ID}
+		},
+	},
+}
+
+// ToEdge converts PostCategory into PostCategoryEdge.
+func (pc *PostCategory) ToEdge(order *PostCategoryOrder) *PostCategoryEdge {
+	if order == nil {
+		order = DefaultPostCategoryOrder
+	}
+	return &PostCategoryEdge{
+		Node:   pc,
+		Cursor: order.Field.toCursor(pc),
+	}
+}
+
+// RefreshTokenEdge is the edge representation of RefreshToken.
+type RefreshTokenEdge struct {
+	Node   *RefreshToken `json:"node"`
+	Cursor Cursor        `json:"cursor"`
+}
+
+// RefreshTokenConnection is the connection containing edges to RefreshToken.
+type RefreshTokenConnection struct {
+	Edges      []*RefreshTokenEdge `json:"edges"`
+	PageInfo   PageInfo            `json:"pageInfo"`
+	TotalCount int                 `json:"totalCount"`
+}
+
+func (c *RefreshTokenConnection) build(nodes []*RefreshToken, pager *refreshtokenPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *RefreshToken
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *RefreshToken {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *RefreshToken {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*RefreshTokenEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &RefreshTokenEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// RefreshTokenPaginateOption enables pagination customization.
+type RefreshTokenPaginateOption func(*refreshtokenPager) error
+
+// WithRefreshTokenOrder configures pagination ordering.
+func WithRefreshTokenOrder(order *RefreshTokenOrder) RefreshTokenPaginateOption {
+	if order == nil {
+		order = DefaultRefreshTokenOrder
+	}
+	o := *order
+	return func(pager *refreshtokenPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultRefreshTokenOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithRefreshTokenFilter configures pagination filter.
+func WithRefreshTokenFilter(filter func(*RefreshTokenQuery) (*RefreshTokenQuery, error)) RefreshTokenPaginateOption {
+	return func(pager *refreshtokenPager) error {
+		if filter == nil {
+			return errors.New("RefreshTokenQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type refreshtokenPager struct {
+	reverse bool
+	order   *RefreshTokenOrder
+	filter  func(*RefreshTokenQuery) (*RefreshTokenQuery, error)
+}
+
+func newRefreshTokenPager(opts []RefreshTokenPaginateOption, reverse bool) (*refreshtokenPager, error) {
+	pager := &refreshtokenPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultRefreshTokenOrder
+	}
+	return pager, nil
+}
+
+func (p *refreshtokenPager) applyFilter(query *RefreshTokenQuery) (*RefreshTokenQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *refreshtokenPager) toCursor(rt *RefreshToken) Cursor {
+	return p.order.Field.toCursor(rt)
+}
+
+func (p *refreshtokenPager) applyCursors(query *RefreshTokenQuery, after, before *Cursor) (*RefreshTokenQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultRefreshTokenOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *refreshtokenPager) applyOrder(query *RefreshTokenQuery) *RefreshTokenQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultRefreshTokenOrder.Field {
+		query = query.Order(DefaultRefreshTokenOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *refreshtokenPager) orderExpr(query *RefreshTokenQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultRefreshTokenOrder.Field {
+			b.Comma().Ident(DefaultRefreshTokenOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to RefreshToken.
+func (rt *RefreshTokenQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...RefreshTokenPaginateOption,
+) (*RefreshTokenConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newRefreshTokenPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if rt, err = pager.applyFilter(rt); err != nil {
+		return nil, err
+	}
+	conn := &RefreshTokenConnection{Edges: []*RefreshTokenEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := rt.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if rt, err = pager.applyCursors(rt, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		rt.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := rt.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	rt = pager.applyOrder(rt)
+	nodes, err := rt.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// RefreshTokenOrderFieldUpdatedAt orders RefreshToken by updated_at.
+	RefreshTokenOrderFieldUpdatedAt = &RefreshTokenOrderField{
+		Value: func(rt *RefreshToken) (ent.Value, error) {
+			return rt.UpdatedAt, nil
+		},
+		column: refreshtoken.FieldUpdatedAt,
+		toTerm: refreshtoken.ByUpdatedAt,
+		toCursor: func(rt *RefreshToken) Cursor {
+			return Cursor{
+				ID:    rt.ID,
+				Value: rt.UpdatedAt,
+			}
+		},
+	}
+	// RefreshTokenOrderFieldCreatedAt orders RefreshToken by created_at.
+	RefreshTokenOrderFieldCreatedAt = &RefreshTokenOrderField{
+		Value: func(rt *RefreshToken) (ent.Value, error) {
+			return rt.CreatedAt, nil
+		},
+		column: refreshtoken.FieldCreatedAt,
+		toTerm: refreshtoken.ByCreatedAt,
+		toCursor: func(rt *RefreshToken) Cursor {
+			return Cursor{
+				ID:    rt.ID,
+				Value: rt.CreatedAt,
 			}
 		},
 	}
-	// PostOrderFieldLikedByCount orders by LIKED_BY_COUNT.
-	PostOrderFieldLikedByCount = &PostOrderField{
-		Value: func(po *Post) (ent.Value, error) {
-			return po.Value("liked_by_count")
+)
+
+// String implement fmt.Stringer interface.
+func (f RefreshTokenOrderField) String() string {
+	var str string
+	switch f.column {
+	case RefreshTokenOrderFieldUpdatedAt.column:
+		str = "UPDATED_AT"
+	case RefreshTokenOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f RefreshTokenOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *RefreshTokenOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("RefreshTokenOrderField %T must be a string", v)
+	}
+	switch str {
+	case "UPDATED_AT":
+		*f = *RefreshTokenOrderFieldUpdatedAt
+	case "CREATED_AT":
+		*f = *RefreshTokenOrderFieldCreatedAt
+	default:
+		return fmt.Errorf("%s is not a valid RefreshTokenOrderField", str)
+	}
+	return nil
+}
+
+// RefreshTokenOrderField defines the ordering field of RefreshToken.
+type RefreshTokenOrderField struct {
+	// Value extracts the ordering value from the given RefreshToken.
+	Value    func(*RefreshToken) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) refreshtoken.OrderOption
+	toCursor func(*RefreshToken) Cursor
+}
+
+// RefreshTokenOrder defines the ordering of RefreshToken.
+type RefreshTokenOrder struct {
+	Direction OrderDirection          `json:"direction"`
+	Field     *RefreshTokenOrderField `json:"field"`
+}
+
+// DefaultRefreshTokenOrder is the default ordering of RefreshToken.
+var DefaultRefreshTokenOrder = &RefreshTokenOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &RefreshTokenOrderField{
+		Value: func(rt *RefreshToken) (ent.Value, error) {
+			return rt.ID, nil
 		},
-		column: "liked_by_count",
-		toTerm: func(opts ...sql.OrderTermOption) post.OrderOption {
-			return post.ByLikedByCount(
-				append(opts, sql.OrderSelectAs("liked_by_count"))...,
-			)
+		column: refreshtoken.FieldID,
+		toTerm: refreshtoken.ByID,
+		toCursor: func(rt *RefreshToken) Cursor {
+			return Cursor{ID: rt.ID}
 		},
-		toCursor: func(po *Post) Cursor {
-			cv, _ := po.Value("liked_by_count")
+	},
+}
+
+// ToEdge converts RefreshToken into RefreshTokenEdge.
+func (rt *RefreshToken) ToEdge(order *RefreshTokenOrder) *RefreshTokenEdge {
+	if order == nil {
+		order = DefaultRefreshTokenOrder
+	}
+	return &RefreshTokenEdge{
+		Node:   rt,
+		Cursor: order.Field.toCursor(rt),
+	}
+}
+
+// RoleChangeEdge is the edge representation of RoleChange.
+type RoleChangeEdge struct {
+	Node   *RoleChange `json:"node"`
+	Cursor Cursor      `json:"cursor"`
+}
+
+// RoleChangeConnection is the connection containing edges to RoleChange.
+type RoleChangeConnection struct {
+	Edges      []*RoleChangeEdge `json:"edges"`
+	PageInfo   PageInfo          `json:"pageInfo"`
+	TotalCount int               `json:"totalCount"`
+}
+
+func (c *RoleChangeConnection) build(nodes []*RoleChange, pager *rolechangePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *RoleChange
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *RoleChange {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *RoleChange {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*RoleChangeEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &RoleChangeEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// RoleChangePaginateOption enables pagination customization.
+type RoleChangePaginateOption func(*rolechangePager) error
+
+// WithRoleChangeOrder configures pagination ordering.
+func WithRoleChangeOrder(order *RoleChangeOrder) RoleChangePaginateOption {
+	if order == nil {
+		order = DefaultRoleChangeOrder
+	}
+	o := *order
+	return func(pager *rolechangePager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultRoleChangeOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithRoleChangeFilter configures pagination filter.
+func WithRoleChangeFilter(filter func(*RoleChangeQuery) (*RoleChangeQuery, error)) RoleChangePaginateOption {
+	return func(pager *rolechangePager) error {
+		if filter == nil {
+			return errors.New("RoleChangeQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type rolechangePager struct {
+	reverse bool
+	order   *RoleChangeOrder
+	filter  func(*RoleChangeQuery) (*RoleChangeQuery, error)
+}
+
+func newRoleChangePager(opts []RoleChangePaginateOption, reverse bool) (*rolechangePager, error) {
+	pager := &rolechangePager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultRoleChangeOrder
+	}
+	return pager, nil
+}
+
+func (p *rolechangePager) applyFilter(query *RoleChangeQuery) (*RoleChangeQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *rolechangePager) toCursor(rc *RoleChange) Cursor {
+	return p.order.Field.toCursor(rc)
+}
+
+func (p *rolechangePager) applyCursors(query *RoleChangeQuery, after, before *Cursor) (*RoleChangeQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultRoleChangeOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *rolechangePager) applyOrder(query *RoleChangeQuery) *RoleChangeQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultRoleChangeOrder.Field {
+		query = query.Order(DefaultRoleChangeOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *rolechangePager) orderExpr(query *RoleChangeQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultRoleChangeOrder.Field {
+			b.Comma().Ident(DefaultRoleChangeOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to RoleChange.
+func (rc *RoleChangeQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...RoleChangePaginateOption,
+) (*RoleChangeConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newRoleChangePager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if rc, err = pager.applyFilter(rc); err != nil {
+		return nil, err
+	}
+	conn := &RoleChangeConnection{Edges: []*RoleChangeEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := rc.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if rc, err = pager.applyCursors(rc, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		rc.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := rc.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	rc = pager.applyOrder(rc)
+	nodes, err := rc.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// RoleChangeOrderFieldID orders RoleChange by id.
+	RoleChangeOrderFieldID = &RoleChangeOrderField{
+		Value: func(rc *RoleChange) (ent.Value, error) {
+			return rc.ID, nil
+		},
+		column: rolechange.FieldID,
+		toTerm: rolechange.ByID,
+		toCursor: func(rc *RoleChange) Cursor {
+			return Cursor{
+				ID:    rc.ID,
+				Value: rc.ID,
+			}
+		},
+	}
+	// RoleChangeOrderFieldUpdatedAt orders RoleChange by updated_at.
+	RoleChangeOrderFieldUpdatedAt = &RoleChangeOrderField{
+		Value: func(rc *RoleChange) (ent.Value, error) {
+			return rc.UpdatedAt, nil
+		},
+		column: rolechange.FieldUpdatedAt,
+		toTerm: rolechange.ByUpdatedAt,
+		toCursor: func(rc *RoleChange) Cursor {
+			return Cursor{
+				ID:    rc.ID,
+				Value: rc.UpdatedAt,
+			}
+		},
+	}
+	// RoleChangeOrderFieldCreatedAt orders RoleChange by created_at.
+	RoleChangeOrderFieldCreatedAt = &RoleChangeOrderField{
+		Value: func(rc *RoleChange) (ent.Value, error) {
+			return rc.CreatedAt, nil
+		},
+		column: rolechange.FieldCreatedAt,
+		toTerm: rolechange.ByCreatedAt,
+		toCursor: func(rc *RoleChange) Cursor {
 			return Cursor{
-				ID:    po.ID,
-				Value: cv,
+				ID:    rc.ID,
+				Value: rc.CreatedAt,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f PostOrderField) String() string {
+func (f RoleChangeOrderField) String() string {
 	var str string
 	switch f.column {
-	case PostOrderFieldID.column:
+	case RoleChangeOrderFieldID.column:
 		str = "ID"
-	case PostOrderFieldUpdatedAt.column:
+	case RoleChangeOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
-	case PostOrderFieldCreatedAt.column:
+	case RoleChangeOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case PostOrderFieldModeratedAt.column:
-		str = "MODERATED_AT"
-	case PostOrderFieldCommentsCount.column:
-		str = "COMMENTS_COUNT"
-	case PostOrderFieldLikedByCount.column:
-		str = "LIKED_BY_COUNT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f PostOrderField) MarshalGQL(w io.Writer) {
+func (f RoleChangeOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *PostOrderField) UnmarshalGQL(v interface{}) error {
+func (f *RoleChangeOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("PostOrderField %T must be a string", v)
+		return fmt.Errorf("RoleChangeOrderField %T must be a string", v)
 	}
 	switch str {
 	case "ID":
-		*f = *PostOrderFieldID
+		*f = *RoleChangeOrderFieldID
 	case "UPDATED_AT":
-		*f = *PostOrderFieldUpdatedAt
+		*f = *RoleChangeOrderFieldUpdatedAt
 	case "CREATED_AT":
-		*f = *PostOrderFieldCreatedAt
-	case "MODERATED_AT":
-		*f = *PostOrderFieldModeratedAt
-	case "COMMENTS_COUNT":
-		*f = *PostOrderFieldCommentsCount
-	case "LIKED_BY_COUNT":
-		*f = *PostOrderFieldLikedByCount
+		*f = *RoleChangeOrderFieldCreatedAt
 	default:
-		return fmt.Errorf("%s is not a valid PostOrderField", str)
+		return fmt.Errorf("%s is not a valid RoleChangeOrderField", str)
 	}
 	return nil
 }
 
-// PostOrderField defines the ordering field of Post.
-type PostOrderField struct {
-	// Value extracts the ordering value from the given Post.
-	Value    func(*Post) (ent.Value, error)
+// RoleChangeOrderField defines the ordering field of RoleChange.
+type RoleChangeOrderField struct {
+	// Value extracts the ordering value from the given RoleChange.
+	Value    func(*RoleChange) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) post.OrderOption
-	toCursor func(*Post) Cursor
+	toTerm   func(...sql.OrderTermOption) rolechange.OrderOption
+	toCursor func(*RoleChange) Cursor
 }
 
-// PostOrder defines the ordering of Post.
-type PostOrder struct {
-	Direction OrderDirection  `json:"direction"`
-	Field     *PostOrderField `json:"field"`
+// RoleChangeOrder defines the ordering of RoleChange.
+type RoleChangeOrder struct {
+	Direction OrderDirection        `json:"direction"`
+	Field     *RoleChangeOrderField `json:"field"`
 }
 
-// DefaultPostOrder is the default ordering of Post.
-var DefaultPostOrder = &PostOrder{
+// DefaultRoleChangeOrder is the default ordering of RoleChange.
+var DefaultRoleChangeOrder = &RoleChangeOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &PostOrderField{
-		Value: func(po *Post) (ent.Value, error) {
-			return po.ID, nil
+	Field: &RoleChangeOrderField{
+		Value: func(rc *RoleChange) (ent.Value, error) {
+			return rc.ID, nil
 		},
-		column: post.FieldID,
-		toTerm: post.ByID,
-		toCursor: func(po *Post) Cursor {
-			return Cursor{ID: po.ID}
+		column: rolechange.FieldID,
+		toTerm: rolechange.ByID,
+		toCursor: func(rc *RoleChange) Cursor {
+			return Cursor{ID: rc.ID}
 		},
 	},
 }
 
-// ToEdge converts Post into PostEdge.
-func (po *Post) ToEdge(order *PostOrder) *PostEdge {
+// ToEdge converts RoleChange into RoleChangeEdge.
+func (rc *RoleChange) ToEdge(order *RoleChangeOrder) *RoleChangeEdge {
 	if order == nil {
-		order = DefaultPostOrder
+		order = DefaultRoleChangeOrder
 	}
-	return &PostEdge{
-		Node:   po,
-		Cursor: order.Field.toCursor(po),
+	return &RoleChangeEdge{
+		Node:   rc,
+		Cursor: order.Field.toCursor(rc),
 	}
 }
 
-// PostCategoryEdge is the edge representation of PostCategory.
-type PostCategoryEdge struct {
-	Node   *PostCategory `json:"node"`
-	Cursor Cursor        `json:"cursor"`
+// RolePermissionEdge is the edge representation of RolePermission.
+type RolePermissionEdge struct {
+	Node   *RolePermission `json:"node"`
+	Cursor Cursor          `json:"cursor"`
 }
 
-// PostCategoryConnection is the connection containing edges to PostCategory.
-type PostCategoryConnection struct {
-	Edges      []*PostCategoryEdge `json:"edges"`
-	PageInfo   PageInfo            `json:"pageInfo"`
-	TotalCount int                 `json:"totalCount"`
+// RolePermissionConnection is the connection containing edges to RolePermission.
+type RolePermissionConnection struct {
+	Edges      []*RolePermissionEdge `json:"edges"`
+	PageInfo   PageInfo              `json:"pageInfo"`
+	TotalCount int                   `json:"totalCount"`
 }
 
-func (c *PostCategoryConnection) build(nodes []*PostCategory, pager *postcategoryPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *RolePermissionConnection) build(nodes []*RolePermission, pager *rolepermissionPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -1196,21 +3177,21 @@ func (c *PostCategoryConnection) build(nodes []*PostCategory, pager *postcategor
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *PostCategory
+	var nodeAt func(int) *RolePermission
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *PostCategory {
+		nodeAt = func(i int) *RolePermission {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *PostCategory {
+		nodeAt = func(i int) *RolePermission {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*PostCategoryEdge, len(nodes))
+	c.Edges = make([]*RolePermissionEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &PostCategoryEdge{
+		c.Edges[i] = &RolePermissionEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -1224,87 +3205,87 @@ func (c *PostCategoryConnection) build(nodes []*PostCategory, pager *postcategor
 	}
 }
 
-// PostCategoryPaginateOption enables pagination customization.
-type PostCategoryPaginateOption func(*postcategoryPager) error
+// RolePermissionPaginateOption enables pagination customization.
+type RolePermissionPaginateOption func(*rolepermissionPager) error
 
-// WithPostCategoryOrder configures pagination ordering.
-func WithPostCategoryOrder(order *PostCategoryOrder) PostCategoryPaginateOption {
+// WithRolePermissionOrder configures pagination ordering.
+func WithRolePermissionOrder(order *RolePermissionOrder) RolePermissionPaginateOption {
 	if order == nil {
-		order = DefaultPostCategoryOrder
+		order = DefaultRolePermissionOrder
 	}
 	o := *order
-	return func(pager *postcategoryPager) error {
+	return func(pager *rolepermissionPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultPostCategoryOrder.Field
+			o.Field = DefaultRolePermissionOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithPostCategoryFilter configures pagination filter.
-func WithPostCategoryFilter(filter func(*PostCategoryQuery) (*PostCategoryQuery, error)) PostCategoryPaginateOption {
-	return func(pager *postcategoryPager) error {
+// WithRolePermissionFilter configures pagination filter.
+func WithRolePermissionFilter(filter func(*RolePermissionQuery) (*RolePermissionQuery, error)) RolePermissionPaginateOption {
+	return func(pager *rolepermissionPager) error {
 		if filter == nil {
-			return errors.New("PostCategoryQuery filter cannot be nil")
+			return errors.New("RolePermissionQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type postcategoryPager struct {
+type rolepermissionPager struct {
 	reverse bool
-	order   *PostCategoryOrder
-	filter  func(*PostCategoryQuery) (*PostCategoryQuery, error)
+	order   *RolePermissionOrder
+	filter  func(*RolePermissionQuery) (*RolePermissionQuery, error)
 }
 
-func newPostCategoryPager(opts []PostCategoryPaginateOption, reverse bool) (*postcategoryPager, error) {
-	pager := &postcategoryPager{reverse: reverse}
+func newRolePermissionPager(opts []RolePermissionPaginateOption, reverse bool) (*rolepermissionPager, error) {
+	pager := &rolepermissionPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultPostCategoryOrder
+		pager.order = DefaultRolePermissionOrder
 	}
 	return pager, nil
 }
 
-func (p *postcategoryPager) applyFilter(query *PostCategoryQuery) (*PostCategoryQuery, error) {
+func (p *rolepermissionPager) applyFilter(query *RolePermissionQuery) (*RolePermissionQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *postcategoryPager) toCursor(pc *PostCategory) Cursor {
-	return p.order.Field.toCursor(pc)
+func (p *rolepermissionPager) toCursor(rp *RolePermission) Cursor {
+	return p.order.Field.toCursor(rp)
 }
 
-func (p *postcategoryPager) applyCursors(query *PostCategoryQuery, after, before *Cursor) (*PostCategoryQuery, error) {
+func (p *rolepermissionPager) applyCursors(query *RolePermissionQuery, after, before *Cursor) (*RolePermissionQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultPostCategoryOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultRolePermissionOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *postcategoryPager) applyOrder(query *PostCategoryQuery) *PostCategoryQuery {
+func (p *rolepermissionPager) applyOrder(query *RolePermissionQuery) *RolePermissionQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultPostCategoryOrder.Field {
-		query = query.Order(DefaultPostCategoryOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultRolePermissionOrder.Field {
+		query = query.Order(DefaultRolePermissionOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -1312,7 +3293,7 @@ func (p *postcategoryPager) applyOrder(query *PostCategoryQuery) *PostCategoryQu
 	return query
 }
 
-func (p *postcategoryPager) orderExpr(query *PostCategoryQuery) sql.Querier {
+func (p *rolepermissionPager) orderExpr(query *RolePermissionQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -1322,33 +3303,33 @@ func (p *postcategoryPager) orderExpr(query *PostCategoryQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultPostCategoryOrder.Field {
-			b.Comma().Ident(DefaultPostCategoryOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultRolePermissionOrder.Field {
+			b.Comma().Ident(DefaultRolePermissionOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to PostCategory.
-func (pc *PostCategoryQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to RolePermission.
+func (rp *RolePermissionQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...PostCategoryPaginateOption,
-) (*PostCategoryConnection, error) {
+	before *Cursor, last *int, opts ...RolePermissionPaginateOption,
+) (*RolePermissionConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newPostCategoryPager(opts, last != nil)
+	pager, err := newRolePermissionPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
-	if pc, err = pager.applyFilter(pc); err != nil {
+	if rp, err = pager.applyFilter(rp); err != nil {
 		return nil, err
 	}
-	conn := &PostCategoryConnection{Edges: []*PostCategoryEdge{}}
+	conn := &RolePermissionConnection{Edges: []*RolePermissionEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			c := pc.Clone()
+			c := rp.Clone()
 			c.ctx.Fields = nil
 			if conn.TotalCount, err = c.Count(ctx); err != nil {
 				return nil, err
@@ -1360,20 +3341,20 @@ func (pc *PostCategoryQuery) Paginate(
 	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
 		return conn, nil
 	}
-	if pc, err = pager.applyCursors(pc, after, before); err != nil {
+	if rp, err = pager.applyCursors(rp, after, before); err != nil {
 		return nil, err
 	}
 	limit := paginateLimit(first, last)
 	if limit != 0 {
-		pc.Limit(limit)
+		rp.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := pc.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := rp.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
-	pc = pager.applyOrder(pc)
-	nodes, err := pc.All(ctx)
+	rp = pager.applyOrder(rp)
+	nodes, err := rp.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1382,143 +3363,143 @@ func (pc *PostCategoryQuery) Paginate(
 }
 
 var (
-	// PostCategoryOrderFieldID orders PostCategory by id.
-	PostCategoryOrderFieldID = &PostCategoryOrderField{
-		Value: func(pc *PostCategory) (ent.Value, error) {
-			return pc.ID, nil
+	// RolePermissionOrderFieldID orders RolePermission by id.
+	RolePermissionOrderFieldID = &RolePermissionOrderField{
+		Value: func(rp *RolePermission) (ent.Value, error) {
+			return rp.ID, nil
 		},
-		column: postcategory.FieldID,
-		toTerm: postcategory.ByID,
-		toCursor: func(pc *PostCategory) Cursor {
+		column: rolepermission.FieldID,
+		toTerm: rolepermission.ByID,
+		toCursor: func(rp *RolePermission) Cursor {
 			return Cursor{
-				ID:    pc.ID,
-				Value: pc.ID,
+				ID:    rp.ID,
+				Value: rp.ID,
 			}
 		},
 	}
-	// PostCategoryOrderFieldUpdatedAt orders PostCategory by updated_at.
-	PostCategoryOrderFieldUpdatedAt = &PostCategoryOrderField{
-		Value: func(pc *PostCategory) (ent.Value, error) {
-			return pc.UpdatedAt, nil
+	// RolePermissionOrderFieldUpdatedAt orders RolePermission by updated_at.
+	RolePermissionOrderFieldUpdatedAt = &RolePermissionOrderField{
+		Value: func(rp *RolePermission) (ent.Value, error) {
+			return rp.UpdatedAt, nil
 		},
-		column: postcategory.FieldUpdatedAt,
-		toTerm: postcategory.ByUpdatedAt,
-		toCursor: func(pc *PostCategory) Cursor {
+		column: rolepermission.FieldUpdatedAt,
+		toTerm: rolepermission.ByUpdatedAt,
+		toCursor: func(rp *RolePermission) Cursor {
 			return Cursor{
-				ID:    pc.ID,
-				Value: pc.UpdatedAt,
+				ID:    rp.ID,
+				Value: rp.UpdatedAt,
 			}
 		},
 	}
-	// PostCategoryOrderFieldCreatedAt orders PostCategory by created_at.
-	PostCategoryOrderFieldCreatedAt = &PostCategoryOrderField{
-		Value: func(pc *PostCategory) (ent.Value, error) {
-			return pc.CreatedAt, nil
+	// RolePermissionOrderFieldCreatedAt orders RolePermission by created_at.
+	RolePermissionOrderFieldCreatedAt = &RolePermissionOrderField{
+		Value: func(rp *RolePermission) (ent.Value, error) {
+			return rp.CreatedAt, nil
 		},
-		column: postcategory.FieldCreatedAt,
-		toTerm: postcategory.ByCreatedAt,
-		toCursor: func(pc *PostCategory) Cursor {
+		column: rolepermission.FieldCreatedAt,
+		toTerm: rolepermission.ByCreatedAt,
+		toCursor: func(rp *RolePermission) Cursor {
 			return Cursor{
-				ID:    pc.ID,
-				Value: pc.CreatedAt,
+				ID:    rp.ID,
+				Value: rp.CreatedAt,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f PostCategoryOrderField) String() string {
+func (f RolePermissionOrderField) String() string {
 	var str string
 	switch f.column {
-	case PostCategoryOrderFieldID.column:
+	case RolePermissionOrderFieldID.column:
 		str = "ID"
-	case PostCategoryOrderFieldUpdatedAt.column:
+	case RolePermissionOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
-	case PostCategoryOrderFieldCreatedAt.column:
+	case RolePermissionOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f PostCategoryOrderField) MarshalGQL(w io.Writer) {
+func (f RolePermissionOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *PostCategoryOrderField) UnmarshalGQL(v interface{}) error {
+func (f *RolePermissionOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("PostCategoryOrderField %T must be a string", v)
+		return fmt.Errorf("RolePermissionOrderField %T must be a string", v)
 	}
 	switch str {
 	case "ID":
-		*f = *PostCategoryOrderFieldID
+		*f = *RolePermissionOrderFieldID
 	case "UPDATED_AT":
-		*f = *PostCategoryOrderFieldUpdatedAt
+		*f = *RolePermissionOrderFieldUpdatedAt
 	case "CREATED_AT":
-		*f = *PostCategoryOrderFieldCreatedAt
+		*f = *RolePermissionOrderFieldCreatedAt
 	default:
-		return fmt.Errorf("%s is not a valid PostCategoryOrderField", str)
+		return fmt.Errorf("%s is not a valid RolePermissionOrderField", str)
 	}
 	return nil
 }
 
-// PostCategoryOrderField defines the ordering field of PostCategory.
-type PostCategoryOrderField struct {
-	// Value extracts the ordering value from the given PostCategory.
-	Value    func(*PostCategory) (ent.Value, error)
+// RolePermissionOrderField defines the ordering field of RolePermission.
+type RolePermissionOrderField struct {
+	// Value extracts the ordering value from the given RolePermission.
+	Value    func(*RolePermission) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) postcategory.OrderOption
-	toCursor func(*PostCategory) Cursor
+	toTerm   func(...sql.OrderTermOption) rolepermission.OrderOption
+	toCursor func(*RolePermission) Cursor
 }
 
-// PostCategoryOrder defines the ordering of PostCategory.
-type PostCategoryOrder struct {
-	Direction OrderDirection          `json:"direction"`
-	Field     *PostCategoryOrderField `json:"field"`
+// RolePermissionOrder defines the ordering of RolePermission.
+type RolePermissionOrder struct {
+	Direction OrderDirection            `json:"direction"`
+	Field     *RolePermissionOrderField `json:"field"`
 }
 
-// DefaultPostCategoryOrder is the default ordering of PostCategory.
-var DefaultPostCategoryOrder = &PostCategoryOrder{
+// DefaultRolePermissionOrder is the default ordering of RolePermission.
+var DefaultRolePermissionOrder = &RolePermissionOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &PostCategoryOrderField{
-		Value: func(pc *PostCategory) (ent.Value, error) {
-			return pc.ID, nil
+	Field: &RolePermissionOrderField{
+		Value: func(rp *RolePermission) (ent.Value, error) {
+			return rp.ID, nil
 		},
-		column: postcategory.FieldID,
-		toTerm: postcategory.ByID,
-		toCursor: func(pc *PostCategory) Cursor {
-			return Cursor{ID: pc.ID}
+		column: rolepermission.FieldID,
+		toTerm: rolepermission.ByID,
+		toCursor: func(rp *RolePermission) Cursor {
+			return Cursor{ID: rp.ID}
 		},
 	},
 }
 
-// ToEdge converts PostCategory into PostCategoryEdge.
-func (pc *PostCategory) ToEdge(order *PostCategoryOrder) *PostCategoryEdge {
+// ToEdge converts RolePermission into RolePermissionEdge.
+func (rp *RolePermission) ToEdge(order *RolePermissionOrder) *RolePermissionEdge {
 	if order == nil {
-		order = DefaultPostCategoryOrder
+		order = DefaultRolePermissionOrder
 	}
-	return &PostCategoryEdge{
-		Node:   pc,
-		Cursor: order.Field.toCursor(pc),
+	return &RolePermissionEdge{
+		Node:   rp,
+		Cursor: order.Field.toCursor(rp),
 	}
 }
 
-// RefreshTokenEdge is the edge representation of RefreshToken.
-type RefreshTokenEdge struct {
-	Node   *RefreshToken `json:"node"`
-	Cursor Cursor        `json:"cursor"`
+// SuspensionEdge is the edge representation of Suspension.
+type SuspensionEdge struct {
+	Node   *Suspension `json:"node"`
+	Cursor Cursor      `json:"cursor"`
 }
 
-// RefreshTokenConnection is the connection containing edges to RefreshToken.
-type RefreshTokenConnection struct {
-	Edges      []*RefreshTokenEdge `json:"edges"`
-	PageInfo   PageInfo            `json:"pageInfo"`
-	TotalCount int                 `json:"totalCount"`
+// SuspensionConnection is the connection containing edges to Suspension.
+type SuspensionConnection struct {
+	Edges      []*SuspensionEdge `json:"edges"`
+	PageInfo   PageInfo          `json:"pageInfo"`
+	TotalCount int               `json:"totalCount"`
 }
 
-func (c *RefreshTokenConnection) build(nodes []*RefreshToken, pager *refreshtokenPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *SuspensionConnection) build(nodes []*Suspension, pager *suspensionPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -1528,21 +3509,21 @@ func (c *RefreshTokenConnection) build(nodes []*RefreshToken, pager *refreshtoke
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *RefreshToken
+	var nodeAt func(int) *Suspension
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *RefreshToken {
+		nodeAt = func(i int) *Suspension {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *RefreshToken {
+		nodeAt = func(i int) *Suspension {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*RefreshTokenEdge, len(nodes))
+	c.Edges = make([]*SuspensionEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &RefreshTokenEdge{
+		c.Edges[i] = &SuspensionEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -1556,87 +3537,87 @@ func (c *RefreshTokenConnection) build(nodes []*RefreshToken, pager *refreshtoke
 	}
 }
 
-// RefreshTokenPaginateOption enables pagination customization.
-type RefreshTokenPaginateOption func(*refreshtokenPager) error
+// SuspensionPaginateOption enables pagination customization.
+type SuspensionPaginateOption func(*suspensionPager) error
 
-// WithRefreshTokenOrder configures pagination ordering.
-func WithRefreshTokenOrder(order *RefreshTokenOrder) RefreshTokenPaginateOption {
+// WithSuspensionOrder configures pagination ordering.
+func WithSuspensionOrder(order *SuspensionOrder) SuspensionPaginateOption {
 	if order == nil {
-		order = DefaultRefreshTokenOrder
+		order = DefaultSuspensionOrder
 	}
 	o := *order
-	return func(pager *refreshtokenPager) error {
+	return func(pager *suspensionPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultRefreshTokenOrder.Field
+			o.Field = DefaultSuspensionOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithRefreshTokenFilter configures pagination filter.
-func WithRefreshTokenFilter(filter func(*RefreshTokenQuery) (*RefreshTokenQuery, error)) RefreshTokenPaginateOption {
-	return func(pager *refreshtokenPager) error {
+// WithSuspensionFilter configures pagination filter.
+func WithSuspensionFilter(filter func(*SuspensionQuery) (*SuspensionQuery, error)) SuspensionPaginateOption {
+	return func(pager *suspensionPager) error {
 		if filter == nil {
-			return errors.New("RefreshTokenQuery filter cannot be nil")
+			return errors.New("SuspensionQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type refreshtokenPager struct {
+type suspensionPager struct {
 	reverse bool
-	order   *RefreshTokenOrder
-	filter  func(*RefreshTokenQuery) (*RefreshTokenQuery, error)
+	order   *SuspensionOrder
+	filter  func(*SuspensionQuery) (*SuspensionQuery, error)
 }
 
-func newRefreshTokenPager(opts []RefreshTokenPaginateOption, reverse bool) (*refreshtokenPager, error) {
-	pager := &refreshtokenPager{reverse: reverse}
+func newSuspensionPager(opts []SuspensionPaginateOption, reverse bool) (*suspensionPager, error) {
+	pager := &suspensionPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultRefreshTokenOrder
+		pager.order = DefaultSuspensionOrder
 	}
 	return pager, nil
 }
 
-func (p *refreshtokenPager) applyFilter(query *RefreshTokenQuery) (*RefreshTokenQuery, error) {
+func (p *suspensionPager) applyFilter(query *SuspensionQuery) (*SuspensionQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *refreshtokenPager) toCursor(rt *RefreshToken) Cursor {
-	return p.order.Field.toCursor(rt)
+func (p *suspensionPager) toCursor(s *Suspension) Cursor {
+	return p.order.Field.toCursor(s)
 }
 
-func (p *refreshtokenPager) applyCursors(query *RefreshTokenQuery, after, before *Cursor) (*RefreshTokenQuery, error) {
+func (p *suspensionPager) applyCursors(query *SuspensionQuery, after, before *Cursor) (*SuspensionQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultRefreshTokenOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultSuspensionOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *refreshtokenPager) applyOrder(query *RefreshTokenQuery) *RefreshTokenQuery {
+func (p *suspensionPager) applyOrder(query *SuspensionQuery) *SuspensionQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultRefreshTokenOrder.Field {
-		query = query.Order(DefaultRefreshTokenOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultSuspensionOrder.Field {
+		query = query.Order(DefaultSuspensionOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -1644,7 +3625,7 @@ func (p *refreshtokenPager) applyOrder(query *RefreshTokenQuery) *RefreshTokenQu
 	return query
 }
 
-func (p *refreshtokenPager) orderExpr(query *RefreshTokenQuery) sql.Querier {
+func (p *suspensionPager) orderExpr(query *SuspensionQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -1654,33 +3635,33 @@ func (p *refreshtokenPager) orderExpr(query *RefreshTokenQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultRefreshTokenOrder.Field {
-			b.Comma().Ident(DefaultRefreshTokenOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultSuspensionOrder.Field {
+			b.Comma().Ident(DefaultSuspensionOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to RefreshToken.
-func (rt *RefreshTokenQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Suspension.
+func (s *SuspensionQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...RefreshTokenPaginateOption,
-) (*RefreshTokenConnection, error) {
+	before *Cursor, last *int, opts ...SuspensionPaginateOption,
+) (*SuspensionConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newRefreshTokenPager(opts, last != nil)
+	pager, err := newSuspensionPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
-	if rt, err = pager.applyFilter(rt); err != nil {
+	if s, err = pager.applyFilter(s); err != nil {
 		return nil, err
 	}
-	conn := &RefreshTokenConnection{Edges: []*RefreshTokenEdge{}}
+	conn := &SuspensionConnection{Edges: []*SuspensionEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			c := rt.Clone()
+			c := s.Clone()
 			c.ctx.Fields = nil
 			if conn.TotalCount, err = c.Count(ctx); err != nil {
 				return nil, err
@@ -1692,20 +3673,20 @@ func (rt *RefreshTokenQuery) Paginate(
 	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
 		return conn, nil
 	}
-	if rt, err = pager.applyCursors(rt, after, before); err != nil {
+	if s, err = pager.applyCursors(s, after, before); err != nil {
 		return nil, err
 	}
 	limit := paginateLimit(first, last)
 	if limit != 0 {
-		rt.Limit(limit)
+		s.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := rt.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := s.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
-	rt = pager.applyOrder(rt)
-	nodes, err := rt.All(ctx)
+	s = pager.applyOrder(s)
+	nodes, err := s.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1714,108 +3695,162 @@ func (rt *RefreshTokenQuery) Paginate(
 }
 
 var (
-	// RefreshTokenOrderFieldUpdatedAt orders RefreshToken by updated_at.
-	RefreshTokenOrderFieldUpdatedAt = &RefreshTokenOrderField{
-		Value: func(rt *RefreshToken) (ent.Value, error) {
-			return rt.UpdatedAt, nil
+	// SuspensionOrderFieldID orders Suspension by id.
+	SuspensionOrderFieldID = &SuspensionOrderField{
+		Value: func(s *Suspension) (ent.Value, error) {
+			return s.ID, nil
 		},
-		column: refreshtoken.FieldUpdatedAt,
-		toTerm: refreshtoken.ByUpdatedAt,
-		toCursor: func(rt *RefreshToken) Cursor {
+		column: suspension.FieldID,
+		toTerm: suspension.ByID,
+		toCursor: func(s *Suspension) Cursor {
 			return Cursor{
-				ID:    rt.ID,
-				Value: rt.UpdatedAt,
+				ID:    s.ID,
+				Value: s.ID,
 			}
 		},
 	}
-	// RefreshTokenOrderFieldCreatedAt orders RefreshToken by created_at.
-	RefreshTokenOrderFieldCreatedAt = &RefreshTokenOrderField{
-		Value: func(rt *RefreshToken) (ent.Value, error) {
-			return rt.CreatedAt, nil
+	// SuspensionOrderFieldUpdatedAt orders Suspension by updated_at.
+	SuspensionOrderFieldUpdatedAt = &SuspensionOrderField{
+		Value: func(s *Suspension) (ent.Value, error) {
+			return s.UpdatedAt, nil
 		},
-		column: refreshtoken.FieldCreatedAt,
-		toTerm: refreshtoken.ByCreatedAt,
-		toCursor: func(rt *RefreshToken) Cursor {
+		column: suspension.FieldUpdatedAt,
+		toTerm: suspension.ByUpdatedAt,
+		toCursor: func(s *Suspension) Cursor {
+			return Cursor{
+				ID:    s.ID,
+				Value: s.UpdatedAt,
+			}
+		},
+	}
+	// SuspensionOrderFieldCreatedAt orders Suspension by created_at.
+	SuspensionOrderFieldCreatedAt = &SuspensionOrderField{
+		Value: func(s *Suspension) (ent.Value, error) {
+			return s.CreatedAt, nil
+		},
+		column: suspension.FieldCreatedAt,
+		toTerm: suspension.ByCreatedAt,
+		toCursor: func(s *Suspension) Cursor {
+			return Cursor{
+				ID:    s.ID,
+				Value: s.CreatedAt,
+			}
+		},
+	}
+	// SuspensionOrderFieldStartsAt orders Suspension by starts_at.
+	SuspensionOrderFieldStartsAt = &SuspensionOrderField{
+		Value: func(s *Suspension) (ent.Value, error) {
+			return s.StartsAt, nil
+		},
+		column: suspension.FieldStartsAt,
+		toTerm: suspension.ByStartsAt,
+		toCursor: func(s *Suspension) Cursor {
+			return Cursor{
+				ID:    s.ID,
+				Value: s.StartsAt,
+			}
+		},
+	}
+	// SuspensionOrderFieldEndsAt orders Suspension by ends_at.
+	SuspensionOrderFieldEndsAt = &SuspensionOrderField{
+		Value: func(s *Suspension) (ent.Value, error) {
+			return s.EndsAt, nil
+		},
+		column: suspension.FieldEndsAt,
+		toTerm: suspension.ByEndsAt,
+		toCursor: func(s *Suspension) Cursor {
 			return Cursor{
-				ID:    rt.ID,
-				Value: rt.CreatedAt,
+				ID:    s.ID,
+				Value: s.EndsAt,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f RefreshTokenOrderField) String() string {
+func (f SuspensionOrderField) String() string {
 	var str string
 	switch f.column {
-	case RefreshTokenOrderFieldUpdatedAt.column:
+	case SuspensionOrderFieldID.column:
+		str = "ID"
+	case SuspensionOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
-	case RefreshTokenOrderFieldCreatedAt.column:
+	case SuspensionOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
+	case SuspensionOrderFieldStartsAt.column:
+		str = "STARTS_AT"
+	case SuspensionOrderFieldEndsAt.column:
+		str = "ENDS_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f RefreshTokenOrderField) MarshalGQL(w io.Writer) {
+func (f SuspensionOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *RefreshTokenOrderField) UnmarshalGQL(v interface{}) error {
+func (f *SuspensionOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("RefreshTokenOrderField %T must be a string", v)
+		return fmt.Errorf("SuspensionOrderField %T must be a string", v)
 	}
 	switch str {
+	case "ID":
+		*f = *SuspensionOrderFieldID
 	case "UPDATED_AT":
-		*f = *RefreshTokenOrderFieldUpdatedAt
+		*f = *SuspensionOrderFieldUpdatedAt
 	case "CREATED_AT":
-		*f = *RefreshTokenOrderFieldCreatedAt
+		*f = *SuspensionOrderFieldCreatedAt
+	case "STARTS_AT":
+		*f = *SuspensionOrderFieldStartsAt
+	case "ENDS_AT":
+		*f = *SuspensionOrderFieldEndsAt
 	default:
-		return fmt.Errorf("%s is not a valid RefreshTokenOrderField", str)
+		return fmt.Errorf("%s is not a valid SuspensionOrderField", str)
 	}
 	return nil
 }
 
-// RefreshTokenOrderField defines the ordering field of RefreshToken.
-type RefreshTokenOrderField struct {
-	// Value extracts the ordering value from the given RefreshToken.
-	Value    func(*RefreshToken) (ent.Value, error)
+// SuspensionOrderField defines the ordering field of Suspension.
+type SuspensionOrderField struct {
+	// Value extracts the ordering value from the given Suspension.
+	Value    func(*Suspension) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) refreshtoken.OrderOption
-	toCursor func(*RefreshToken) Cursor
+	toTerm   func(...sql.OrderTermOption) suspension.OrderOption
+	toCursor func(*Suspension) Cursor
 }
 
-// RefreshTokenOrder defines the ordering of RefreshToken.
-type RefreshTokenOrder struct {
-	Direction OrderDirection          `json:"direction"`
-	Field     *RefreshTokenOrderField `json:"field"`
+// SuspensionOrder defines the ordering of Suspension.
+type SuspensionOrder struct {
+	Direction OrderDirection        `json:"direction"`
+	Field     *SuspensionOrderField `json:"field"`
 }
 
-// DefaultRefreshTokenOrder is the default ordering of RefreshToken.
-var DefaultRefreshTokenOrder = &RefreshTokenOrder{
+// DefaultSuspensionOrder is the default ordering of Suspension.
+var DefaultSuspensionOrder = &SuspensionOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &RefreshTokenOrderField{
-		Value: func(rt *RefreshToken) (ent.Value, error) {
-			return rt.ID, nil
+	Field: &SuspensionOrderField{
+		Value: func(s *Suspension) (ent.Value, error) {
+			return s.ID, nil
 		},
-		column: refreshtoken.FieldID,
-		toTerm: refreshtoken.ByID,
-		toCursor: func(rt *RefreshToken) Cursor {
-			return Cursor{ID: rt.ID}
+		column: suspension.FieldID,
+		toTerm: suspension.ByID,
+		toCursor: func(s *Suspension) Cursor {
+			return Cursor{ID: s.ID}
 		},
 	},
 }
 
-// ToEdge converts RefreshToken into RefreshTokenEdge.
-func (rt *RefreshToken) ToEdge(order *RefreshTokenOrder) *RefreshTokenEdge {
+// ToEdge converts Suspension into SuspensionEdge.
+func (s *Suspension) ToEdge(order *SuspensionOrder) *SuspensionEdge {
 	if order == nil {
-		order = DefaultRefreshTokenOrder
+		order = DefaultSuspensionOrder
 	}
-	return &RefreshTokenEdge{
-		Node:   rt,
-		Cursor: order.Field.toCursor(rt),
+	return &SuspensionEdge{
+		Node:   s,
+		Cursor: order.Field.toCursor(s),
 	}
 }
 
@@ -2150,3 +4185,353 @@ func (u *User) ToEdge(order *UserOrder) *UserEdge {
 		Cursor: order.Field.toCursor(u),
 	}
 }
+
+// UserAwardEdge is the edge representation of UserAward.
+type UserAwardEdge struct {
+	Node   *UserAward `json:"node"`
+	Cursor Cursor     `json:"cursor"`
+}
+
+// UserAwardConnection is the connection containing edges to UserAward.
+type UserAwardConnection struct {
+	Edges      []*UserAwardEdge `json:"edges"`
+	PageInfo   PageInfo         `json:"pageInfo"`
+	TotalCount int              `json:"totalCount"`
+}
+
+func (c *UserAwardConnection) build(nodes []*UserAward, pager *userawardPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *UserAward
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *UserAward {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *UserAward {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*UserAwardEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &UserAwardEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// UserAwardPaginateOption enables pagination customization.
+type UserAwardPaginateOption func(*userawardPager) error
+
+// WithUserAwardOrder configures pagination ordering.
+func WithUserAwardOrder(order *UserAwardOrder) UserAwardPaginateOption {
+	if order == nil {
+		order = DefaultUserAwardOrder
+	}
+	o := *order
+	return func(pager *userawardPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultUserAwardOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithUserAwardFilter configures pagination filter.
+func WithUserAwardFilter(filter func(*UserAwardQuery) (*UserAwardQuery, error)) UserAwardPaginateOption {
+	return func(pager *userawardPager) error {
+		if filter == nil {
+			return errors.New("UserAwardQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type userawardPager struct {
+	reverse bool
+	order   *UserAwardOrder
+	filter  func(*UserAwardQuery) (*UserAwardQuery, error)
+}
+
+func newUserAwardPager(opts []UserAwardPaginateOption, reverse bool) (*userawardPager, error) {
+	pager := &userawardPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultUserAwardOrder
+	}
+	return pager, nil
+}
+
+func (p *userawardPager) applyFilter(query *UserAwardQuery) (*UserAwardQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *userawardPager) toCursor(ua *UserAward) Cursor {
+	return p.order.Field.toCursor(ua)
+}
+
+func (p *userawardPager) applyCursors(query *UserAwardQuery, after, before *Cursor) (*UserAwardQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultUserAwardOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *userawardPager) applyOrder(query *UserAwardQuery) *UserAwardQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultUserAwardOrder.Field {
+		query = query.Order(DefaultUserAwardOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *userawardPager) orderExpr(query *UserAwardQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultUserAwardOrder.Field {
+			b.Comma().Ident(DefaultUserAwardOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to UserAward.
+func (ua *UserAwardQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...UserAwardPaginateOption,
+) (*UserAwardConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newUserAwardPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if ua, err = pager.applyFilter(ua); err != nil {
+		return nil, err
+	}
+	conn := &UserAwardConnection{Edges: []*UserAwardEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := ua.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if ua, err = pager.applyCursors(ua, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		ua.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := ua.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	ua = pager.applyOrder(ua)
+	nodes, err := ua.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// UserAwardOrderFieldID orders UserAward by id.
+	UserAwardOrderFieldID = &UserAwardOrderField{
+		Value: func(ua *UserAward) (ent.Value, error) {
+			return ua.ID, nil
+		},
+		column: useraward.FieldID,
+		toTerm: useraward.ByID,
+		toCursor: func(ua *UserAward) Cursor {
+			return Cursor{
+				ID:    ua.ID,
+				Value: ua.ID,
+			}
+		},
+	}
+	// UserAwardOrderFieldUpdatedAt orders UserAward by updated_at.
+	UserAwardOrderFieldUpdatedAt = &UserAwardOrderField{
+		Value: func(ua *UserAward) (ent.Value, error) {
+			return ua.UpdatedAt, nil
+		},
+		column: useraward.FieldUpdatedAt,
+		toTerm: useraward.ByUpdatedAt,
+		toCursor: func(ua *UserAward) Cursor {
+			return Cursor{
+				ID:    ua.ID,
+				Value: ua.UpdatedAt,
+			}
+		},
+	}
+	// UserAwardOrderFieldCreatedAt orders UserAward by created_at.
+	UserAwardOrderFieldCreatedAt = &UserAwardOrderField{
+		Value: func(ua *UserAward) (ent.Value, error) {
+			return ua.CreatedAt, nil
+		},
+		column: useraward.FieldCreatedAt,
+		toTerm: useraward.ByCreatedAt,
+		toCursor: func(ua *UserAward) Cursor {
+			return Cursor{
+				ID:    ua.ID,
+				Value: ua.CreatedAt,
+			}
+		},
+	}
+	// UserAwardOrderFieldGrantedAt orders UserAward by granted_at.
+	UserAwardOrderFieldGrantedAt = &UserAwardOrderField{
+		Value: func(ua *UserAward) (ent.Value, error) {
+			return ua.GrantedAt, nil
+		},
+		column: useraward.FieldGrantedAt,
+		toTerm: useraward.ByGrantedAt,
+		toCursor: func(ua *UserAward) Cursor {
+			return Cursor{
+				ID:    ua.ID,
+				Value: ua.GrantedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f UserAwardOrderField) String() string {
+	var str string
+	switch f.column {
+	case UserAwardOrderFieldID.column:
+		str = "ID"
+	case UserAwardOrderFieldUpdatedAt.column:
+		str = "UPDATED_AT"
+	case UserAwardOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	case UserAwardOrderFieldGrantedAt.column:
+		str = "GRANTED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f UserAwardOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *UserAwardOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("UserAwardOrderField %T must be a string", v)
+	}
+	switch str {
+	case "ID":
+		*f = *UserAwardOrderFieldID
+	case "UPDATED_AT":
+		*f = *UserAwardOrderFieldUpdatedAt
+	case "CREATED_AT":
+		*f = *UserAwardOrderFieldCreatedAt
+	case "GRANTED_AT":
+		*f = *UserAwardOrderFieldGrantedAt
+	default:
+		return fmt.Errorf("%s is not a valid UserAwardOrderField", str)
+	}
+	return nil
+}
+
+// UserAwardOrderField defines the ordering field of UserAward.
+type UserAwardOrderField struct {
+	// Value extracts the ordering value from the given UserAward.
+	Value    func(*UserAward) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) useraward.OrderOption
+	toCursor func(*UserAward) Cursor
+}
+
+// UserAwardOrder defines the ordering of UserAward.
+type UserAwardOrder struct {
+	Direction OrderDirection       `json:"direction"`
+	Field     *UserAwardOrderField `json:"field"`
+}
+
+// DefaultUserAwardOrder is the default ordering of UserAward.
+var DefaultUserAwardOrder = &UserAwardOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &UserAwardOrderField{
+		Value: func(ua *UserAward) (ent.Value, error) {
+			return ua.ID, nil
+		},
+		column: useraward.FieldID,
+		toTerm: useraward.ByID,
+		toCursor: func(ua *UserAward) Cursor {
+			return Cursor{ID: ua.ID}
+		},
+	},
+}
+
+// ToEdge converts UserAward into UserAwardEdge.
+func (ua *UserAward) ToEdge(order *UserAwardOrder) *UserAwardEdge {
+	if order == nil {
+		order = DefaultUserAwardOrder
+	}
+	return &UserAwardEdge{
+		Node:   ua,
+		Cursor: order.Field.toCursor(ua),
+	}
+}
@@ -8,12 +8,19 @@ import (
 	"time"
 
 	"github.com/caliecode/la-clipasa/internal/ent/generated/apikey"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/categoryrestriction"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/comment"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/post"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/postcategory"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/refreshtoken"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
 	"github.com/google/uuid"
 )
 
@@ -54,20 +61,35 @@ type ApiKeyWhereInput struct {
 	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
 	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
 
-	// "api_key" field predicates.
-	APIKey             *string  `json:"apiKey,omitempty"`
-	APIKeyNEQ          *string  `json:"apiKeyNEQ,omitempty"`
-	APIKeyIn           []string `json:"apiKeyIn,omitempty"`
-	APIKeyNotIn        []string `json:"apiKeyNotIn,omitempty"`
-	APIKeyGT           *string  `json:"apiKeyGT,omitempty"`
-	APIKeyGTE          *string  `json:"apiKeyGTE,omitempty"`
-	APIKeyLT           *string  `json:"apiKeyLT,omitempty"`
-	APIKeyLTE          *string  `json:"apiKeyLTE,omitempty"`
-	APIKeyContains     *string  `json:"apiKeyContains,omitempty"`
-	APIKeyHasPrefix    *string  `json:"apiKeyHasPrefix,omitempty"`
-	APIKeyHasSuffix    *string  `json:"apiKeyHasSuffix,omitempty"`
-	APIKeyEqualFold    *string  `json:"apiKeyEqualFold,omitempty"`
-	APIKeyContainsFold *string  `json:"apiKeyContainsFold,omitempty"`
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+
+	// "prefix" field predicates.
+	Prefix             *string  `json:"prefix,omitempty"`
+	PrefixNEQ          *string  `json:"prefixNEQ,omitempty"`
+	PrefixIn           []string `json:"prefixIn,omitempty"`
+	PrefixNotIn        []string `json:"prefixNotIn,omitempty"`
+	PrefixGT           *string  `json:"prefixGT,omitempty"`
+	PrefixGTE          *string  `json:"prefixGTE,omitempty"`
+	PrefixLT           *string  `json:"prefixLT,omitempty"`
+	PrefixLTE          *string  `json:"prefixLTE,omitempty"`
+	PrefixContains     *string  `json:"prefixContains,omitempty"`
+	PrefixHasPrefix    *string  `json:"prefixHasPrefix,omitempty"`
+	PrefixHasSuffix    *string  `json:"prefixHasSuffix,omitempty"`
+	PrefixEqualFold    *string  `json:"prefixEqualFold,omitempty"`
+	PrefixContainsFold *string  `json:"prefixContainsFold,omitempty"`
 
 	// "expires_on" field predicates.
 	ExpiresOn      *time.Time  `json:"expiresOn,omitempty"`
@@ -79,6 +101,18 @@ type ApiKeyWhereInput struct {
 	ExpiresOnLT    *time.Time  `json:"expiresOnLT,omitempty"`
 	ExpiresOnLTE   *time.Time  `json:"expiresOnLTE,omitempty"`
 
+	// "last_used_at" field predicates.
+	LastUsedAt       *time.Time  `json:"lastUsedAt,omitempty"`
+	LastUsedAtNEQ    *time.Time  `json:"lastUsedAtNEQ,omitempty"`
+	LastUsedAtIn     []time.Time `json:"lastUsedAtIn,omitempty"`
+	LastUsedAtNotIn  []time.Time `json:"lastUsedAtNotIn,omitempty"`
+	LastUsedAtGT     *time.Time  `json:"lastUsedAtGT,omitempty"`
+	LastUsedAtGTE    *time.Time  `json:"lastUsedAtGTE,omitempty"`
+	LastUsedAtLT     *time.Time  `json:"lastUsedAtLT,omitempty"`
+	LastUsedAtLTE    *time.Time  `json:"lastUsedAtLTE,omitempty"`
+	LastUsedAtIsNil  bool        `json:"lastUsedAtIsNil,omitempty"`
+	LastUsedAtNotNil bool        `json:"lastUsedAtNotNil,omitempty"`
+
 	// "owner" edge predicates.
 	HasOwner     *bool             `json:"hasOwner,omitempty"`
 	HasOwnerWith []*UserWhereInput `json:"hasOwnerWith,omitempty"`
@@ -227,44 +261,83 @@ func (i *ApiKeyWhereInput) P() (predicate.ApiKey, error) {
 	if i.CreatedAtLTE != nil {
 		predicates = append(predicates, apikey.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if i.APIKey != nil {
-		predicates = append(predicates, apikey.APIKeyEQ(*i.APIKey))
+	if i.Name != nil {
+		predicates = append(predicates, apikey.NameEQ(*i.Name))
+	}
+	if i.NameNEQ != nil {
+		predicates = append(predicates, apikey.NameNEQ(*i.NameNEQ))
+	}
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, apikey.NameIn(i.NameIn...))
+	}
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, apikey.NameNotIn(i.NameNotIn...))
+	}
+	if i.NameGT != nil {
+		predicates = append(predicates, apikey.NameGT(*i.NameGT))
+	}
+	if i.NameGTE != nil {
+		predicates = append(predicates, apikey.NameGTE(*i.NameGTE))
+	}
+	if i.NameLT != nil {
+		predicates = append(predicates, apikey.NameLT(*i.NameLT))
 	}
-	if i.APIKeyNEQ != nil {
-		predicates = append(predicates, apikey.APIKeyNEQ(*i.APIKeyNEQ))
+	if i.NameLTE != nil {
+		predicates = append(predicates, apikey.NameLTE(*i.NameLTE))
 	}
-	if len(i.APIKeyIn) > 0 {
-		predicates = append(predicates, apikey.APIKeyIn(i.APIKeyIn...))
+	if i.NameContains != nil {
+		predicates = append(predicates, apikey.NameContains(*i.NameContains))
 	}
-	if len(i.APIKeyNotIn) > 0 {
-		predicates = append(predicates, apikey.APIKeyNotIn(i.APIKeyNotIn...))
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, apikey.NameHasPrefix(*i.NameHasPrefix))
 	}
-	if i.APIKeyGT != nil {
-		predicates = append(predicates, apikey.APIKeyGT(*i.APIKeyGT))
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, apikey.NameHasSuffix(*i.NameHasSuffix))
 	}
-	if i.APIKeyGTE != nil {
-		predicates = append(predicates, apikey.APIKeyGTE(*i.APIKeyGTE))
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, apikey.NameEqualFold(*i.NameEqualFold))
 	}
-	if i.APIKeyLT != nil {
-		predicates = append(predicates, apikey.APIKeyLT(*i.APIKeyLT))
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, apikey.NameContainsFold(*i.NameContainsFold))
 	}
-	if i.APIKeyLTE != nil {
-		predicates = append(predicates, apikey.APIKeyLTE(*i.APIKeyLTE))
+	if i.Prefix != nil {
+		predicates = append(predicates, apikey.PrefixEQ(*i.Prefix))
 	}
-	if i.APIKeyContains != nil {
-		predicates = append(predicates, apikey.APIKeyContains(*i.APIKeyContains))
+	if i.PrefixNEQ != nil {
+		predicates = append(predicates, apikey.PrefixNEQ(*i.PrefixNEQ))
 	}
-	if i.APIKeyHasPrefix != nil {
-		predicates = append(predicates, apikey.APIKeyHasPrefix(*i.APIKeyHasPrefix))
+	if len(i.PrefixIn) > 0 {
+		predicates = append(predicates, apikey.PrefixIn(i.PrefixIn...))
 	}
-	if i.APIKeyHasSuffix != nil {
-		predicates = append(predicates, apikey.APIKeyHasSuffix(*i.APIKeyHasSuffix))
+	if len(i.PrefixNotIn) > 0 {
+		predicates = append(predicates, apikey.PrefixNotIn(i.PrefixNotIn...))
 	}
-	if i.APIKeyEqualFold != nil {
-		predicates = append(predicates, apikey.APIKeyEqualFold(*i.APIKeyEqualFold))
+	if i.PrefixGT != nil {
+		predicates = append(predicates, apikey.PrefixGT(*i.PrefixGT))
 	}
-	if i.APIKeyContainsFold != nil {
-		predicates = append(predicates, apikey.APIKeyContainsFold(*i.APIKeyContainsFold))
+	if i.PrefixGTE != nil {
+		predicates = append(predicates, apikey.PrefixGTE(*i.PrefixGTE))
+	}
+	if i.PrefixLT != nil {
+		predicates = append(predicates, apikey.PrefixLT(*i.PrefixLT))
+	}
+	if i.PrefixLTE != nil {
+		predicates = append(predicates, apikey.PrefixLTE(*i.PrefixLTE))
+	}
+	if i.PrefixContains != nil {
+		predicates = append(predicates, apikey.PrefixContains(*i.PrefixContains))
+	}
+	if i.PrefixHasPrefix != nil {
+		predicates = append(predicates, apikey.PrefixHasPrefix(*i.PrefixHasPrefix))
+	}
+	if i.PrefixHasSuffix != nil {
+		predicates = append(predicates, apikey.PrefixHasSuffix(*i.PrefixHasSuffix))
+	}
+	if i.PrefixEqualFold != nil {
+		predicates = append(predicates, apikey.PrefixEqualFold(*i.PrefixEqualFold))
+	}
+	if i.PrefixContainsFold != nil {
+		predicates = append(predicates, apikey.PrefixContainsFold(*i.PrefixContainsFold))
 	}
 	if i.ExpiresOn != nil {
 		predicates = append(predicates, apikey.ExpiresOnEQ(*i.ExpiresOn))
@@ -290,6 +363,36 @@ func (i *ApiKeyWhereInput) P() (predicate.ApiKey, error) {
 	if i.ExpiresOnLTE != nil {
 		predicates = append(predicates, apikey.ExpiresOnLTE(*i.ExpiresOnLTE))
 	}
+	if i.LastUsedAt != nil {
+		predicates = append(predicates, apikey.LastUsedAtEQ(*i.LastUsedAt))
+	}
+	if i.LastUsedAtNEQ != nil {
+		predicates = append(predicates, apikey.LastUsedAtNEQ(*i.LastUsedAtNEQ))
+	}
+	if len(i.LastUsedAtIn) > 0 {
+		predicates = append(predicates, apikey.LastUsedAtIn(i.LastUsedAtIn...))
+	}
+	if len(i.LastUsedAtNotIn) > 0 {
+		predicates = append(predicates, apikey.LastUsedAtNotIn(i.LastUsedAtNotIn...))
+	}
+	if i.LastUsedAtGT != nil {
+		predicates = append(predicates, apikey.LastUsedAtGT(*i.LastUsedAtGT))
+	}
+	if i.LastUsedAtGTE != nil {
+		predicates = append(predicates, apikey.LastUsedAtGTE(*i.LastUsedAtGTE))
+	}
+	if i.LastUsedAtLT != nil {
+		predicates = append(predicates, apikey.LastUsedAtLT(*i.LastUsedAtLT))
+	}
+	if i.LastUsedAtLTE != nil {
+		predicates = append(predicates, apikey.LastUsedAtLTE(*i.LastUsedAtLTE))
+	}
+	if i.LastUsedAtIsNil {
+		predicates = append(predicates, apikey.LastUsedAtIsNil())
+	}
+	if i.LastUsedAtNotNil {
+		predicates = append(predicates, apikey.LastUsedAtNotNil())
+	}
 
 	if i.HasOwner != nil {
 		p := apikey.HasOwner()
@@ -320,12 +423,12 @@ func (i *ApiKeyWhereInput) P() (predicate.ApiKey, error) {
 	}
 }
 
-// CommentWhereInput represents a where input for filtering Comment queries.
-type CommentWhereInput struct {
-	Predicates []predicate.Comment  `json:"-"`
-	Not        *CommentWhereInput   `json:"not,omitempty"`
-	Or         []*CommentWhereInput `json:"or,omitempty"`
-	And        []*CommentWhereInput `json:"and,omitempty"`
+// AwardDefinitionWhereInput represents a where input for filtering AwardDefinition queries.
+type AwardDefinitionWhereInput struct {
+	Predicates []predicate.AwardDefinition  `json:"-"`
+	Not        *AwardDefinitionWhereInput   `json:"not,omitempty"`
+	Or         []*AwardDefinitionWhereInput `json:"or,omitempty"`
+	And        []*AwardDefinitionWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *uuid.UUID  `json:"id,omitempty"`
@@ -357,75 +460,95 @@ type CommentWhereInput struct {
 	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
 	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
 
-	// "deleted_at" field predicates.
-	DeletedAt       *time.Time  `json:"deletedAt,omitempty"`
-	DeletedAtNEQ    *time.Time  `json:"deletedAtNEQ,omitempty"`
-	DeletedAtIn     []time.Time `json:"deletedAtIn,omitempty"`
-	DeletedAtNotIn  []time.Time `json:"deletedAtNotIn,omitempty"`
-	DeletedAtGT     *time.Time  `json:"deletedAtGT,omitempty"`
-	DeletedAtGTE    *time.Time  `json:"deletedAtGTE,omitempty"`
-	DeletedAtLT     *time.Time  `json:"deletedAtLT,omitempty"`
-	DeletedAtLTE    *time.Time  `json:"deletedAtLTE,omitempty"`
-	DeletedAtIsNil  bool        `json:"deletedAtIsNil,omitempty"`
-	DeletedAtNotNil bool        `json:"deletedAtNotNil,omitempty"`
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
 
-	// "deleted_by" field predicates.
-	DeletedBy             *string  `json:"deletedBy,omitempty"`
-	DeletedByNEQ          *string  `json:"deletedByNEQ,omitempty"`
-	DeletedByIn           []string `json:"deletedByIn,omitempty"`
-	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
-	DeletedByGT           *string  `json:"deletedByGT,omitempty"`
-	DeletedByGTE          *string  `json:"deletedByGTE,omitempty"`
-	DeletedByLT           *string  `json:"deletedByLT,omitempty"`
-	DeletedByLTE          *string  `json:"deletedByLTE,omitempty"`
-	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
-	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
-	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
-	DeletedByIsNil        bool     `json:"deletedByIsNil,omitempty"`
-	DeletedByNotNil       bool     `json:"deletedByNotNil,omitempty"`
-	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
-	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
+	// "description" field predicates.
+	Description             *string  `json:"description,omitempty"`
+	DescriptionNEQ          *string  `json:"descriptionNEQ,omitempty"`
+	DescriptionIn           []string `json:"descriptionIn,omitempty"`
+	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
+	DescriptionGT           *string  `json:"descriptionGT,omitempty"`
+	DescriptionGTE          *string  `json:"descriptionGTE,omitempty"`
+	DescriptionLT           *string  `json:"descriptionLT,omitempty"`
+	DescriptionLTE          *string  `json:"descriptionLTE,omitempty"`
+	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
+	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
+	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
+	DescriptionIsNil        bool     `json:"descriptionIsNil,omitempty"`
+	DescriptionNotNil       bool     `json:"descriptionNotNil,omitempty"`
+	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
+	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
 
-	// "content" field predicates.
-	Content             *string  `json:"content,omitempty"`
-	ContentNEQ          *string  `json:"contentNEQ,omitempty"`
-	ContentIn           []string `json:"contentIn,omitempty"`
-	ContentNotIn        []string `json:"contentNotIn,omitempty"`
-	ContentGT           *string  `json:"contentGT,omitempty"`
-	ContentGTE          *string  `json:"contentGTE,omitempty"`
-	ContentLT           *string  `json:"contentLT,omitempty"`
-	ContentLTE          *string  `json:"contentLTE,omitempty"`
-	ContentContains     *string  `json:"contentContains,omitempty"`
-	ContentHasPrefix    *string  `json:"contentHasPrefix,omitempty"`
-	ContentHasSuffix    *string  `json:"contentHasSuffix,omitempty"`
-	ContentEqualFold    *string  `json:"contentEqualFold,omitempty"`
-	ContentContainsFold *string  `json:"contentContainsFold,omitempty"`
+	// "icon" field predicates.
+	Icon             *string  `json:"icon,omitempty"`
+	IconNEQ          *string  `json:"iconNEQ,omitempty"`
+	IconIn           []string `json:"iconIn,omitempty"`
+	IconNotIn        []string `json:"iconNotIn,omitempty"`
+	IconGT           *string  `json:"iconGT,omitempty"`
+	IconGTE          *string  `json:"iconGTE,omitempty"`
+	IconLT           *string  `json:"iconLT,omitempty"`
+	IconLTE          *string  `json:"iconLTE,omitempty"`
+	IconContains     *string  `json:"iconContains,omitempty"`
+	IconHasPrefix    *string  `json:"iconHasPrefix,omitempty"`
+	IconHasSuffix    *string  `json:"iconHasSuffix,omitempty"`
+	IconEqualFold    *string  `json:"iconEqualFold,omitempty"`
+	IconContainsFold *string  `json:"iconContainsFold,omitempty"`
 
-	// "owner" edge predicates.
-	HasOwner     *bool             `json:"hasOwner,omitempty"`
-	HasOwnerWith []*UserWhereInput `json:"hasOwnerWith,omitempty"`
+	// "rule" field predicates.
+	Rule      *awarddefinition.Rule  `json:"rule,omitempty"`
+	RuleNEQ   *awarddefinition.Rule  `json:"ruleNEQ,omitempty"`
+	RuleIn    []awarddefinition.Rule `json:"ruleIn,omitempty"`
+	RuleNotIn []awarddefinition.Rule `json:"ruleNotIn,omitempty"`
 
-	// "post" edge predicates.
-	HasPost     *bool             `json:"hasPost,omitempty"`
-	HasPostWith []*PostWhereInput `json:"hasPostWith,omitempty"`
-	// Deleted record filter options.
-	IncludeDeleted     *bool `json:"includeDeleted,omitempty"`
-	IncludeDeletedOnly *bool `json:"includeDeletedOnly,omitempty"`
+	// "rule_category" field predicates.
+	RuleCategory       *awarddefinition.RuleCategory  `json:"ruleCategory,omitempty"`
+	RuleCategoryNEQ    *awarddefinition.RuleCategory  `json:"ruleCategoryNEQ,omitempty"`
+	RuleCategoryIn     []awarddefinition.RuleCategory `json:"ruleCategoryIn,omitempty"`
+	RuleCategoryNotIn  []awarddefinition.RuleCategory `json:"ruleCategoryNotIn,omitempty"`
+	RuleCategoryIsNil  bool                           `json:"ruleCategoryIsNil,omitempty"`
+	RuleCategoryNotNil bool                           `json:"ruleCategoryNotNil,omitempty"`
+
+	// "rule_threshold" field predicates.
+	RuleThreshold      *int  `json:"ruleThreshold,omitempty"`
+	RuleThresholdNEQ   *int  `json:"ruleThresholdNEQ,omitempty"`
+	RuleThresholdIn    []int `json:"ruleThresholdIn,omitempty"`
+	RuleThresholdNotIn []int `json:"ruleThresholdNotIn,omitempty"`
+	RuleThresholdGT    *int  `json:"ruleThresholdGT,omitempty"`
+	RuleThresholdGTE   *int  `json:"ruleThresholdGTE,omitempty"`
+	RuleThresholdLT    *int  `json:"ruleThresholdLT,omitempty"`
+	RuleThresholdLTE   *int  `json:"ruleThresholdLTE,omitempty"`
+
+	// "grants" edge predicates.
+	HasGrants     *bool                  `json:"hasGrants,omitempty"`
+	HasGrantsWith []*UserAwardWhereInput `json:"hasGrantsWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *CommentWhereInput) AddPredicates(predicates ...predicate.Comment) {
+func (i *AwardDefinitionWhereInput) AddPredicates(predicates ...predicate.AwardDefinition) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the CommentWhereInput filter on the CommentQuery builder.
-func (i *CommentWhereInput) Filter(q *CommentQuery) (*CommentQuery, error) {
+// Filter applies the AwardDefinitionWhereInput filter on the AwardDefinitionQuery builder.
+func (i *AwardDefinitionWhereInput) Filter(q *AwardDefinitionQuery) (*AwardDefinitionQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyCommentWhereInput {
+		if err == ErrEmptyAwardDefinitionWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -433,19 +556,19 @@ func (i *CommentWhereInput) Filter(q *CommentQuery) (*CommentQuery, error) {
 	return q.Where(p), nil
 }
 
-// ErrEmptyCommentWhereInput is returned in case the CommentWhereInput is empty.
-var ErrEmptyCommentWhereInput = errors.New("generated: empty predicate CommentWhereInput")
+// ErrEmptyAwardDefinitionWhereInput is returned in case the AwardDefinitionWhereInput is empty.
+var ErrEmptyAwardDefinitionWhereInput = errors.New("generated: empty predicate AwardDefinitionWhereInput")
 
-// P returns a predicate for filtering comments.
+// P returns a predicate for filtering awarddefinitions.
 // An error is returned if the input is empty or invalid.
-func (i *CommentWhereInput) P() (predicate.Comment, error) {
-	var predicates []predicate.Comment
+func (i *AwardDefinitionWhereInput) P() (predicate.AwardDefinition, error) {
+	var predicates []predicate.AwardDefinition
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, comment.Not(p))
+		predicates = append(predicates, awarddefinition.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -455,7 +578,7 @@ func (i *CommentWhereInput) P() (predicate.Comment, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Comment, 0, n)
+		or := make([]predicate.AwardDefinition, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -463,7 +586,7 @@ func (i *CommentWhereInput) P() (predicate.Comment, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, comment.Or(or...))
+		predicates = append(predicates, awarddefinition.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -473,7 +596,7 @@ func (i *CommentWhereInput) P() (predicate.Comment, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Comment, 0, n)
+		and := make([]predicate.AwardDefinition, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -481,253 +604,294 @@ func (i *CommentWhereInput) P() (predicate.Comment, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, comment.And(and...))
+		predicates = append(predicates, awarddefinition.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, comment.IDEQ(*i.ID))
+		predicates = append(predicates, awarddefinition.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, comment.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, awarddefinition.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, comment.IDIn(i.IDIn...))
+		predicates = append(predicates, awarddefinition.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, comment.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, awarddefinition.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, comment.IDGT(*i.IDGT))
+		predicates = append(predicates, awarddefinition.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, comment.IDGTE(*i.IDGTE))
+		predicates = append(predicates, awarddefinition.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, comment.IDLT(*i.IDLT))
+		predicates = append(predicates, awarddefinition.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, comment.IDLTE(*i.IDLTE))
+		predicates = append(predicates, awarddefinition.IDLTE(*i.IDLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, comment.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, awarddefinition.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, comment.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, awarddefinition.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, comment.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, awarddefinition.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, comment.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, awarddefinition.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, comment.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, awarddefinition.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, comment.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, awarddefinition.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, comment.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, awarddefinition.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, comment.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, awarddefinition.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, comment.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, awarddefinition.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, comment.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, awarddefinition.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, comment.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, awarddefinition.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, comment.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, awarddefinition.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, comment.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, awarddefinition.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, comment.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, awarddefinition.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, comment.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, awarddefinition.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, comment.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, awarddefinition.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if i.DeletedAt != nil {
-		predicates = append(predicates, comment.DeletedAtEQ(*i.DeletedAt))
+	if i.Name != nil {
+		predicates = append(predicates, awarddefinition.NameEQ(*i.Name))
 	}
-	if i.DeletedAtNEQ != nil {
-		predicates = append(predicates, comment.DeletedAtNEQ(*i.DeletedAtNEQ))
+	if i.NameNEQ != nil {
+		predicates = append(predicates, awarddefinition.NameNEQ(*i.NameNEQ))
 	}
-	if len(i.DeletedAtIn) > 0 {
-		predicates = append(predicates, comment.DeletedAtIn(i.DeletedAtIn...))
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, awarddefinition.NameIn(i.NameIn...))
 	}
-	if len(i.DeletedAtNotIn) > 0 {
-		predicates = append(predicates, comment.DeletedAtNotIn(i.DeletedAtNotIn...))
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, awarddefinition.NameNotIn(i.NameNotIn...))
 	}
-	if i.DeletedAtGT != nil {
-		predicates = append(predicates, comment.DeletedAtGT(*i.DeletedAtGT))
+	if i.NameGT != nil {
+		predicates = append(predicates, awarddefinition.NameGT(*i.NameGT))
 	}
-	if i.DeletedAtGTE != nil {
-		predicates = append(predicates, comment.DeletedAtGTE(*i.DeletedAtGTE))
+	if i.NameGTE != nil {
+		predicates = append(predicates, awarddefinition.NameGTE(*i.NameGTE))
 	}
-	if i.DeletedAtLT != nil {
-		predicates = append(predicates, comment.DeletedAtLT(*i.DeletedAtLT))
+	if i.NameLT != nil {
+		predicates = append(predicates, awarddefinition.NameLT(*i.NameLT))
 	}
-	if i.DeletedAtLTE != nil {
-		predicates = append(predicates, comment.DeletedAtLTE(*i.DeletedAtLTE))
+	if i.NameLTE != nil {
+		predicates = append(predicates, awarddefinition.NameLTE(*i.NameLTE))
 	}
-	if i.DeletedAtIsNil {
-		predicates = append(predicates, comment.DeletedAtIsNil())
+	if i.NameContains != nil {
+		predicates = append(predicates, awarddefinition.NameContains(*i.NameContains))
 	}
-	if i.DeletedAtNotNil {
-		predicates = append(predicates, comment.DeletedAtNotNil())
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, awarddefinition.NameHasPrefix(*i.NameHasPrefix))
 	}
-	if i.DeletedBy != nil {
-		predicates = append(predicates, comment.DeletedByEQ(*i.DeletedBy))
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, awarddefinition.NameHasSuffix(*i.NameHasSuffix))
 	}
-	if i.DeletedByNEQ != nil {
-		predicates = append(predicates, comment.DeletedByNEQ(*i.DeletedByNEQ))
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, awarddefinition.NameEqualFold(*i.NameEqualFold))
 	}
-	if len(i.DeletedByIn) > 0 {
-		predicates = append(predicates, comment.DeletedByIn(i.DeletedByIn...))
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, awarddefinition.NameContainsFold(*i.NameContainsFold))
 	}
-	if len(i.DeletedByNotIn) > 0 {
-		predicates = append(predicates, comment.DeletedByNotIn(i.DeletedByNotIn...))
+	if i.Description != nil {
+		predicates = append(predicates, awarddefinition.DescriptionEQ(*i.Description))
 	}
-	if i.DeletedByGT != nil {
-		predicates = append(predicates, comment.DeletedByGT(*i.DeletedByGT))
+	if i.DescriptionNEQ != nil {
+		predicates = append(predicates, awarddefinition.DescriptionNEQ(*i.DescriptionNEQ))
 	}
-	if i.DeletedByGTE != nil {
-		predicates = append(predicates, comment.DeletedByGTE(*i.DeletedByGTE))
+	if len(i.DescriptionIn) > 0 {
+		predicates = append(predicates, awarddefinition.DescriptionIn(i.DescriptionIn...))
 	}
-	if i.DeletedByLT != nil {
-		predicates = append(predicates, comment.DeletedByLT(*i.DeletedByLT))
+	if len(i.DescriptionNotIn) > 0 {
+		predicates = append(predicates, awarddefinition.DescriptionNotIn(i.DescriptionNotIn...))
 	}
-	if i.DeletedByLTE != nil {
-		predicates = append(predicates, comment.DeletedByLTE(*i.DeletedByLTE))
+	if i.DescriptionGT != nil {
+		predicates = append(predicates, awarddefinition.DescriptionGT(*i.DescriptionGT))
 	}
-	if i.DeletedByContains != nil {
-		predicates = append(predicates, comment.DeletedByContains(*i.DeletedByContains))
+	if i.DescriptionGTE != nil {
+		predicates = append(predicates, awarddefinition.DescriptionGTE(*i.DescriptionGTE))
 	}
-	if i.DeletedByHasPrefix != nil {
-		predicates = append(predicates, comment.DeletedByHasPrefix(*i.DeletedByHasPrefix))
+	if i.DescriptionLT != nil {
+		predicates = append(predicates, awarddefinition.DescriptionLT(*i.DescriptionLT))
 	}
-	if i.DeletedByHasSuffix != nil {
-		predicates = append(predicates, comment.DeletedByHasSuffix(*i.DeletedByHasSuffix))
+	if i.DescriptionLTE != nil {
+		predicates = append(predicates, awarddefinition.DescriptionLTE(*i.DescriptionLTE))
 	}
-	if i.DeletedByIsNil {
-		predicates = append(predicates, comment.DeletedByIsNil())
+	if i.DescriptionContains != nil {
+		predicates = append(predicates, awarddefinition.DescriptionContains(*i.DescriptionContains))
 	}
-	if i.DeletedByNotNil {
-		predicates = append(predicates, comment.DeletedByNotNil())
+	if i.DescriptionHasPrefix != nil {
+		predicates = append(predicates, awarddefinition.DescriptionHasPrefix(*i.DescriptionHasPrefix))
 	}
-	if i.DeletedByEqualFold != nil {
-		predicates = append(predicates, comment.DeletedByEqualFold(*i.DeletedByEqualFold))
+	if i.DescriptionHasSuffix != nil {
+		predicates = append(predicates, awarddefinition.DescriptionHasSuffix(*i.DescriptionHasSuffix))
 	}
-	if i.DeletedByContainsFold != nil {
-		predicates = append(predicates, comment.DeletedByContainsFold(*i.DeletedByContainsFold))
+	if i.DescriptionIsNil {
+		predicates = append(predicates, awarddefinition.DescriptionIsNil())
 	}
-	if i.Content != nil {
-		predicates = append(predicates, comment.ContentEQ(*i.Content))
+	if i.DescriptionNotNil {
+		predicates = append(predicates, awarddefinition.DescriptionNotNil())
 	}
-	if i.ContentNEQ != nil {
-		predicates = append(predicates, comment.ContentNEQ(*i.ContentNEQ))
+	if i.DescriptionEqualFold != nil {
+		predicates = append(predicates, awarddefinition.DescriptionEqualFold(*i.DescriptionEqualFold))
 	}
-	if len(i.ContentIn) > 0 {
-		predicates = append(predicates, comment.ContentIn(i.ContentIn...))
+	if i.DescriptionContainsFold != nil {
+		predicates = append(predicates, awarddefinition.DescriptionContainsFold(*i.DescriptionContainsFold))
 	}
-	if len(i.ContentNotIn) > 0 {
-		predicates = append(predicates, comment.ContentNotIn(i.ContentNotIn...))
+	if i.Icon != nil {
+		predicates = append(predicates, awarddefinition.IconEQ(*i.Icon))
 	}
-	if i.ContentGT != nil {
-		predicates = append(predicates, comment.ContentGT(*i.ContentGT))
+	if i.IconNEQ != nil {
+		predicates = append(predicates, awarddefinition.IconNEQ(*i.IconNEQ))
 	}
-	if i.ContentGTE != nil {
-		predicates = append(predicates, comment.ContentGTE(*i.ContentGTE))
+	if len(i.IconIn) > 0 {
+		predicates = append(predicates, awarddefinition.IconIn(i.IconIn...))
 	}
-	if i.ContentLT != nil {
-		predicates = append(predicates, comment.ContentLT(*i.ContentLT))
+	if len(i.IconNotIn) > 0 {
+		predicates = append(predicates, awarddefinition.IconNotIn(i.IconNotIn...))
 	}
-	if i.ContentLTE != nil {
-		predicates = append(predicates, comment.ContentLTE(*i.ContentLTE))
+	if i.IconGT != nil {
+		predicates = append(predicates, awarddefinition.IconGT(*i.IconGT))
 	}
-	if i.ContentContains != nil {
-		predicates = append(predicates, comment.ContentContains(*i.ContentContains))
+	if i.IconGTE != nil {
+		predicates = append(predicates, awarddefinition.IconGTE(*i.IconGTE))
 	}
-	if i.ContentHasPrefix != nil {
-		predicates = append(predicates, comment.ContentHasPrefix(*i.ContentHasPrefix))
+	if i.IconLT != nil {
+		predicates = append(predicates, awarddefinition.IconLT(*i.IconLT))
 	}
-	if i.ContentHasSuffix != nil {
-		predicates = append(predicates, comment.ContentHasSuffix(*i.ContentHasSuffix))
+	if i.IconLTE != nil {
+		predicates = append(predicates, awarddefinition.IconLTE(*i.IconLTE))
 	}
-	if i.ContentEqualFold != nil {
-		predicates = append(predicates, comment.ContentEqualFold(*i.ContentEqualFold))
+	if i.IconContains != nil {
+		predicates = append(predicates, awarddefinition.IconContains(*i.IconContains))
 	}
-	if i.ContentContainsFold != nil {
-		predicates = append(predicates, comment.ContentContainsFold(*i.ContentContainsFold))
+	if i.IconHasPrefix != nil {
+		predicates = append(predicates, awarddefinition.IconHasPrefix(*i.IconHasPrefix))
 	}
-
-	if i.HasOwner != nil {
-		p := comment.HasOwner()
-		if !*i.HasOwner {
-			p = comment.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.IconHasSuffix != nil {
+		predicates = append(predicates, awarddefinition.IconHasSuffix(*i.IconHasSuffix))
 	}
-	if len(i.HasOwnerWith) > 0 {
-		with := make([]predicate.User, 0, len(i.HasOwnerWith))
-		for _, w := range i.HasOwnerWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasOwnerWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, comment.HasOwnerWith(with...))
+	if i.IconEqualFold != nil {
+		predicates = append(predicates, awarddefinition.IconEqualFold(*i.IconEqualFold))
 	}
-	if i.HasPost != nil {
-		p := comment.HasPost()
-		if !*i.HasPost {
-			p = comment.Not(p)
+	if i.IconContainsFold != nil {
+		predicates = append(predicates, awarddefinition.IconContainsFold(*i.IconContainsFold))
+	}
+	if i.Rule != nil {
+		predicates = append(predicates, awarddefinition.RuleEQ(*i.Rule))
+	}
+	if i.RuleNEQ != nil {
+		predicates = append(predicates, awarddefinition.RuleNEQ(*i.RuleNEQ))
+	}
+	if len(i.RuleIn) > 0 {
+		predicates = append(predicates, awarddefinition.RuleIn(i.RuleIn...))
+	}
+	if len(i.RuleNotIn) > 0 {
+		predicates = append(predicates, awarddefinition.RuleNotIn(i.RuleNotIn...))
+	}
+	if i.RuleCategory != nil {
+		predicates = append(predicates, awarddefinition.RuleCategoryEQ(*i.RuleCategory))
+	}
+	if i.RuleCategoryNEQ != nil {
+		predicates = append(predicates, awarddefinition.RuleCategoryNEQ(*i.RuleCategoryNEQ))
+	}
+	if len(i.RuleCategoryIn) > 0 {
+		predicates = append(predicates, awarddefinition.RuleCategoryIn(i.RuleCategoryIn...))
+	}
+	if len(i.RuleCategoryNotIn) > 0 {
+		predicates = append(predicates, awarddefinition.RuleCategoryNotIn(i.RuleCategoryNotIn...))
+	}
+	if i.RuleCategoryIsNil {
+		predicates = append(predicates, awarddefinition.RuleCategoryIsNil())
+	}
+	if i.RuleCategoryNotNil {
+		predicates = append(predicates, awarddefinition.RuleCategoryNotNil())
+	}
+	if i.RuleThreshold != nil {
+		predicates = append(predicates, awarddefinition.RuleThresholdEQ(*i.RuleThreshold))
+	}
+	if i.RuleThresholdNEQ != nil {
+		predicates = append(predicates, awarddefinition.RuleThresholdNEQ(*i.RuleThresholdNEQ))
+	}
+	if len(i.RuleThresholdIn) > 0 {
+		predicates = append(predicates, awarddefinition.RuleThresholdIn(i.RuleThresholdIn...))
+	}
+	if len(i.RuleThresholdNotIn) > 0 {
+		predicates = append(predicates, awarddefinition.RuleThresholdNotIn(i.RuleThresholdNotIn...))
+	}
+	if i.RuleThresholdGT != nil {
+		predicates = append(predicates, awarddefinition.RuleThresholdGT(*i.RuleThresholdGT))
+	}
+	if i.RuleThresholdGTE != nil {
+		predicates = append(predicates, awarddefinition.RuleThresholdGTE(*i.RuleThresholdGTE))
+	}
+	if i.RuleThresholdLT != nil {
+		predicates = append(predicates, awarddefinition.RuleThresholdLT(*i.RuleThresholdLT))
+	}
+	if i.RuleThresholdLTE != nil {
+		predicates = append(predicates, awarddefinition.RuleThresholdLTE(*i.RuleThresholdLTE))
+	}
+
+	if i.HasGrants != nil {
+		p := awarddefinition.HasGrants()
+		if !*i.HasGrants {
+			p = awarddefinition.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasPostWith) > 0 {
-		with := make([]predicate.Post, 0, len(i.HasPostWith))
-		for _, w := range i.HasPostWith {
+	if len(i.HasGrantsWith) > 0 {
+		with := make([]predicate.UserAward, 0, len(i.HasGrantsWith))
+		for _, w := range i.HasGrantsWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasPostWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasGrantsWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, comment.HasPostWith(with...))
-	}
-
-	if i.IncludeDeletedOnly != nil && *i.IncludeDeletedOnly {
-		predicates = append(predicates, comment.DeletedAtNotNil())
+		predicates = append(predicates, awarddefinition.HasGrantsWith(with...))
 	}
 
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyCommentWhereInput
+		return nil, ErrEmptyAwardDefinitionWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return comment.And(predicates...), nil
+		return awarddefinition.And(predicates...), nil
 	}
 }
 
-// PostWhereInput represents a where input for filtering Post queries.
-type PostWhereInput struct {
-	Predicates []predicate.Post  `json:"-"`
-	Not        *PostWhereInput   `json:"not,omitempty"`
-	Or         []*PostWhereInput `json:"or,omitempty"`
-	And        []*PostWhereInput `json:"and,omitempty"`
+// CategoryRestrictionWhereInput represents a where input for filtering CategoryRestriction queries.
+type CategoryRestrictionWhereInput struct {
+	Predicates []predicate.CategoryRestriction  `json:"-"`
+	Not        *CategoryRestrictionWhereInput   `json:"not,omitempty"`
+	Or         []*CategoryRestrictionWhereInput `json:"or,omitempty"`
+	And        []*CategoryRestrictionWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *uuid.UUID  `json:"id,omitempty"`
@@ -759,173 +923,34 @@ type PostWhereInput struct {
 	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
 	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
 
-	// "deleted_at" field predicates.
-	DeletedAt       *time.Time  `json:"deletedAt,omitempty"`
-	DeletedAtNEQ    *time.Time  `json:"deletedAtNEQ,omitempty"`
-	DeletedAtIn     []time.Time `json:"deletedAtIn,omitempty"`
-	DeletedAtNotIn  []time.Time `json:"deletedAtNotIn,omitempty"`
-	DeletedAtGT     *time.Time  `json:"deletedAtGT,omitempty"`
-	DeletedAtGTE    *time.Time  `json:"deletedAtGTE,omitempty"`
-	DeletedAtLT     *time.Time  `json:"deletedAtLT,omitempty"`
-	DeletedAtLTE    *time.Time  `json:"deletedAtLTE,omitempty"`
-	DeletedAtIsNil  bool        `json:"deletedAtIsNil,omitempty"`
-	DeletedAtNotNil bool        `json:"deletedAtNotNil,omitempty"`
-
-	// "deleted_by" field predicates.
-	DeletedBy             *string  `json:"deletedBy,omitempty"`
-	DeletedByNEQ          *string  `json:"deletedByNEQ,omitempty"`
-	DeletedByIn           []string `json:"deletedByIn,omitempty"`
-	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
-	DeletedByGT           *string  `json:"deletedByGT,omitempty"`
-	DeletedByGTE          *string  `json:"deletedByGTE,omitempty"`
-	DeletedByLT           *string  `json:"deletedByLT,omitempty"`
-	DeletedByLTE          *string  `json:"deletedByLTE,omitempty"`
-	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
-	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
-	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
-	DeletedByIsNil        bool     `json:"deletedByIsNil,omitempty"`
-	DeletedByNotNil       bool     `json:"deletedByNotNil,omitempty"`
-	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
-	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
-
-	// "pinned" field predicates.
-	Pinned    *bool `json:"pinned,omitempty"`
-	PinnedNEQ *bool `json:"pinnedNEQ,omitempty"`
-
-	// "title" field predicates.
-	Title             *string  `json:"title,omitempty"`
-	TitleNEQ          *string  `json:"titleNEQ,omitempty"`
-	TitleIn           []string `json:"titleIn,omitempty"`
-	TitleNotIn        []string `json:"titleNotIn,omitempty"`
-	TitleGT           *string  `json:"titleGT,omitempty"`
-	TitleGTE          *string  `json:"titleGTE,omitempty"`
-	TitleLT           *string  `json:"titleLT,omitempty"`
-	TitleLTE          *string  `json:"titleLTE,omitempty"`
-	TitleContains     *string  `json:"titleContains,omitempty"`
-	TitleHasPrefix    *string  `json:"titleHasPrefix,omitempty"`
-	TitleHasSuffix    *string  `json:"titleHasSuffix,omitempty"`
-	TitleEqualFold    *string  `json:"titleEqualFold,omitempty"`
-	TitleContainsFold *string  `json:"titleContainsFold,omitempty"`
-
-	// "content" field predicates.
-	Content             *string  `json:"content,omitempty"`
-	ContentNEQ          *string  `json:"contentNEQ,omitempty"`
-	ContentIn           []string `json:"contentIn,omitempty"`
-	ContentNotIn        []string `json:"contentNotIn,omitempty"`
-	ContentGT           *string  `json:"contentGT,omitempty"`
-	ContentGTE          *string  `json:"contentGTE,omitempty"`
-	ContentLT           *string  `json:"contentLT,omitempty"`
-	ContentLTE          *string  `json:"contentLTE,omitempty"`
-	ContentContains     *string  `json:"contentContains,omitempty"`
-	ContentHasPrefix    *string  `json:"contentHasPrefix,omitempty"`
-	ContentHasSuffix    *string  `json:"contentHasSuffix,omitempty"`
-	ContentIsNil        bool     `json:"contentIsNil,omitempty"`
-	ContentNotNil       bool     `json:"contentNotNil,omitempty"`
-	ContentEqualFold    *string  `json:"contentEqualFold,omitempty"`
-	ContentContainsFold *string  `json:"contentContainsFold,omitempty"`
-
-	// "link" field predicates.
-	Link             *string  `json:"link,omitempty"`
-	LinkNEQ          *string  `json:"linkNEQ,omitempty"`
-	LinkIn           []string `json:"linkIn,omitempty"`
-	LinkNotIn        []string `json:"linkNotIn,omitempty"`
-	LinkGT           *string  `json:"linkGT,omitempty"`
-	LinkGTE          *string  `json:"linkGTE,omitempty"`
-	LinkLT           *string  `json:"linkLT,omitempty"`
-	LinkLTE          *string  `json:"linkLTE,omitempty"`
-	LinkContains     *string  `json:"linkContains,omitempty"`
-	LinkHasPrefix    *string  `json:"linkHasPrefix,omitempty"`
-	LinkHasSuffix    *string  `json:"linkHasSuffix,omitempty"`
-	LinkEqualFold    *string  `json:"linkEqualFold,omitempty"`
-	LinkContainsFold *string  `json:"linkContainsFold,omitempty"`
-
-	// "moderation_comment" field predicates.
-	ModerationComment             *string  `json:"moderationComment,omitempty"`
-	ModerationCommentNEQ          *string  `json:"moderationCommentNEQ,omitempty"`
-	ModerationCommentIn           []string `json:"moderationCommentIn,omitempty"`
-	ModerationCommentNotIn        []string `json:"moderationCommentNotIn,omitempty"`
-	ModerationCommentGT           *string  `json:"moderationCommentGT,omitempty"`
-	ModerationCommentGTE          *string  `json:"moderationCommentGTE,omitempty"`
-	ModerationCommentLT           *string  `json:"moderationCommentLT,omitempty"`
-	ModerationCommentLTE          *string  `json:"moderationCommentLTE,omitempty"`
-	ModerationCommentContains     *string  `json:"moderationCommentContains,omitempty"`
-	ModerationCommentHasPrefix    *string  `json:"moderationCommentHasPrefix,omitempty"`
-	ModerationCommentHasSuffix    *string  `json:"moderationCommentHasSuffix,omitempty"`
-	ModerationCommentIsNil        bool     `json:"moderationCommentIsNil,omitempty"`
-	ModerationCommentNotNil       bool     `json:"moderationCommentNotNil,omitempty"`
-	ModerationCommentEqualFold    *string  `json:"moderationCommentEqualFold,omitempty"`
-	ModerationCommentContainsFold *string  `json:"moderationCommentContainsFold,omitempty"`
-
-	// "is_moderated" field predicates.
-	IsModerated    *bool `json:"isModerated,omitempty"`
-	IsModeratedNEQ *bool `json:"isModeratedNEQ,omitempty"`
-
-	// "moderated_at" field predicates.
-	ModeratedAt       *time.Time  `json:"moderatedAt,omitempty"`
-	ModeratedAtNEQ    *time.Time  `json:"moderatedAtNEQ,omitempty"`
-	ModeratedAtIn     []time.Time `json:"moderatedAtIn,omitempty"`
-	ModeratedAtNotIn  []time.Time `json:"moderatedAtNotIn,omitempty"`
-	ModeratedAtGT     *time.Time  `json:"moderatedAtGT,omitempty"`
-	ModeratedAtGTE    *time.Time  `json:"moderatedAtGTE,omitempty"`
-	ModeratedAtLT     *time.Time  `json:"moderatedAtLT,omitempty"`
-	ModeratedAtLTE    *time.Time  `json:"moderatedAtLTE,omitempty"`
-	ModeratedAtIsNil  bool        `json:"moderatedAtIsNil,omitempty"`
-	ModeratedAtNotNil bool        `json:"moderatedAtNotNil,omitempty"`
-
-	// "entity_vector" field predicates.
-	EntityVector             *string  `json:"entityVector,omitempty"`
-	EntityVectorNEQ          *string  `json:"entityVectorNEQ,omitempty"`
-	EntityVectorIn           []string `json:"entityVectorIn,omitempty"`
-	EntityVectorNotIn        []string `json:"entityVectorNotIn,omitempty"`
-	EntityVectorGT           *string  `json:"entityVectorGT,omitempty"`
-	EntityVectorGTE          *string  `json:"entityVectorGTE,omitempty"`
-	EntityVectorLT           *string  `json:"entityVectorLT,omitempty"`
-	EntityVectorLTE          *string  `json:"entityVectorLTE,omitempty"`
-	EntityVectorContains     *string  `json:"entityVectorContains,omitempty"`
-	EntityVectorHasPrefix    *string  `json:"entityVectorHasPrefix,omitempty"`
-	EntityVectorHasSuffix    *string  `json:"entityVectorHasSuffix,omitempty"`
-	EntityVectorIsNil        bool     `json:"entityVectorIsNil,omitempty"`
-	EntityVectorNotNil       bool     `json:"entityVectorNotNil,omitempty"`
-	EntityVectorEqualFold    *string  `json:"entityVectorEqualFold,omitempty"`
-	EntityVectorContainsFold *string  `json:"entityVectorContainsFold,omitempty"`
-
-	// "owner" edge predicates.
-	HasOwner     *bool             `json:"hasOwner,omitempty"`
-	HasOwnerWith []*UserWhereInput `json:"hasOwnerWith,omitempty"`
-
-	// "comments" edge predicates.
-	HasComments     *bool                `json:"hasComments,omitempty"`
-	HasCommentsWith []*CommentWhereInput `json:"hasCommentsWith,omitempty"`
-
-	// "saved_by" edge predicates.
-	HasSavedBy     *bool             `json:"hasSavedBy,omitempty"`
-	HasSavedByWith []*UserWhereInput `json:"hasSavedByWith,omitempty"`
+	// "category" field predicates.
+	Category      *categoryrestriction.Category  `json:"category,omitempty"`
+	CategoryNEQ   *categoryrestriction.Category  `json:"categoryNEQ,omitempty"`
+	CategoryIn    []categoryrestriction.Category `json:"categoryIn,omitempty"`
+	CategoryNotIn []categoryrestriction.Category `json:"categoryNotIn,omitempty"`
 
-	// "liked_by" edge predicates.
-	HasLikedBy     *bool             `json:"hasLikedBy,omitempty"`
-	HasLikedByWith []*UserWhereInput `json:"hasLikedByWith,omitempty"`
+	// "subscriber_only_post" field predicates.
+	SubscriberOnlyPost    *bool `json:"subscriberOnlyPost,omitempty"`
+	SubscriberOnlyPostNEQ *bool `json:"subscriberOnlyPostNEQ,omitempty"`
 
-	// "categories" edge predicates.
-	HasCategories     *bool                     `json:"hasCategories,omitempty"`
-	HasCategoriesWith []*PostCategoryWhereInput `json:"hasCategoriesWith,omitempty"`
-	// Deleted record filter options.
-	IncludeDeleted     *bool `json:"includeDeleted,omitempty"`
-	IncludeDeletedOnly *bool `json:"includeDeletedOnly,omitempty"`
+	// "subscriber_only_view" field predicates.
+	SubscriberOnlyView    *bool `json:"subscriberOnlyView,omitempty"`
+	SubscriberOnlyViewNEQ *bool `json:"subscriberOnlyViewNEQ,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *PostWhereInput) AddPredicates(predicates ...predicate.Post) {
+func (i *CategoryRestrictionWhereInput) AddPredicates(predicates ...predicate.CategoryRestriction) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the PostWhereInput filter on the PostQuery builder.
-func (i *PostWhereInput) Filter(q *PostQuery) (*PostQuery, error) {
+// Filter applies the CategoryRestrictionWhereInput filter on the CategoryRestrictionQuery builder.
+func (i *CategoryRestrictionWhereInput) Filter(q *CategoryRestrictionQuery) (*CategoryRestrictionQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyPostWhereInput {
+		if err == ErrEmptyCategoryRestrictionWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -933,19 +958,19 @@ func (i *PostWhereInput) Filter(q *PostQuery) (*PostQuery, error) {
 	return q.Where(p), nil
 }
 
-// ErrEmptyPostWhereInput is returned in case the PostWhereInput is empty.
-var ErrEmptyPostWhereInput = errors.New("generated: empty predicate PostWhereInput")
+// ErrEmptyCategoryRestrictionWhereInput is returned in case the CategoryRestrictionWhereInput is empty.
+var ErrEmptyCategoryRestrictionWhereInput = errors.New("generated: empty predicate CategoryRestrictionWhereInput")
 
-// P returns a predicate for filtering posts.
+// P returns a predicate for filtering categoryrestrictions.
 // An error is returned if the input is empty or invalid.
-func (i *PostWhereInput) P() (predicate.Post, error) {
-	var predicates []predicate.Post
+func (i *CategoryRestrictionWhereInput) P() (predicate.CategoryRestriction, error) {
+	var predicates []predicate.CategoryRestriction
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, post.Not(p))
+		predicates = append(predicates, categoryrestriction.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -955,7 +980,7 @@ func (i *PostWhereInput) P() (predicate.Post, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Post, 0, n)
+		or := make([]predicate.CategoryRestriction, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -963,7 +988,7 @@ func (i *PostWhereInput) P() (predicate.Post, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, post.Or(or...))
+		predicates = append(predicates, categoryrestriction.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -973,7 +998,7 @@ func (i *PostWhereInput) P() (predicate.Post, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Post, 0, n)
+		and := make([]predicate.CategoryRestriction, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -981,523 +1006,2678 @@ func (i *PostWhereInput) P() (predicate.Post, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, post.And(and...))
+		predicates = append(predicates, categoryrestriction.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, post.IDEQ(*i.ID))
+		predicates = append(predicates, categoryrestriction.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, post.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, categoryrestriction.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, post.IDIn(i.IDIn...))
+		predicates = append(predicates, categoryrestriction.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, post.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, categoryrestriction.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, post.IDGT(*i.IDGT))
+		predicates = append(predicates, categoryrestriction.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, post.IDGTE(*i.IDGTE))
+		predicates = append(predicates, categoryrestriction.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, post.IDLT(*i.IDLT))
+		predicates = append(predicates, categoryrestriction.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, post.IDLTE(*i.IDLTE))
+		predicates = append(predicates, categoryrestriction.IDLTE(*i.IDLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, post.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, categoryrestriction.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, post.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, categoryrestriction.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, post.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, categoryrestriction.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, post.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, categoryrestriction.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, post.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, categoryrestriction.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, post.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, categoryrestriction.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, post.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, categoryrestriction.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, post.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, categoryrestriction.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, post.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, categoryrestriction.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, post.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, categoryrestriction.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, post.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, categoryrestriction.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, post.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, categoryrestriction.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, post.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, categoryrestriction.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, post.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, categoryrestriction.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, post.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, categoryrestriction.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, post.CreatedAtLTE(*i.CreatedAtLTE))
-	}
-	if i.DeletedAt != nil {
-		predicates = append(predicates, post.DeletedAtEQ(*i.DeletedAt))
-	}
-	if i.DeletedAtNEQ != nil {
-		predicates = append(predicates, post.DeletedAtNEQ(*i.DeletedAtNEQ))
-	}
-	if len(i.DeletedAtIn) > 0 {
-		predicates = append(predicates, post.DeletedAtIn(i.DeletedAtIn...))
-	}
-	if len(i.DeletedAtNotIn) > 0 {
-		predicates = append(predicates, post.DeletedAtNotIn(i.DeletedAtNotIn...))
-	}
-	if i.DeletedAtGT != nil {
-		predicates = append(predicates, post.DeletedAtGT(*i.DeletedAtGT))
-	}
-	if i.DeletedAtGTE != nil {
-		predicates = append(predicates, post.DeletedAtGTE(*i.DeletedAtGTE))
-	}
-	if i.DeletedAtLT != nil {
-		predicates = append(predicates, post.DeletedAtLT(*i.DeletedAtLT))
-	}
-	if i.DeletedAtLTE != nil {
-		predicates = append(predicates, post.DeletedAtLTE(*i.DeletedAtLTE))
-	}
-	if i.DeletedAtIsNil {
-		predicates = append(predicates, post.DeletedAtIsNil())
-	}
-	if i.DeletedAtNotNil {
-		predicates = append(predicates, post.DeletedAtNotNil())
-	}
-	if i.DeletedBy != nil {
-		predicates = append(predicates, post.DeletedByEQ(*i.DeletedBy))
-	}
-	if i.DeletedByNEQ != nil {
-		predicates = append(predicates, post.DeletedByNEQ(*i.DeletedByNEQ))
+		predicates = append(predicates, categoryrestriction.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if len(i.DeletedByIn) > 0 {
-		predicates = append(predicates, post.DeletedByIn(i.DeletedByIn...))
-	}
-	if len(i.DeletedByNotIn) > 0 {
-		predicates = append(predicates, post.DeletedByNotIn(i.DeletedByNotIn...))
+	if i.Category != nil {
+		predicates = append(predicates, categoryrestriction.CategoryEQ(*i.Category))
 	}
-	if i.DeletedByGT != nil {
-		predicates = append(predicates, post.DeletedByGT(*i.DeletedByGT))
+	if i.CategoryNEQ != nil {
+		predicates = append(predicates, categoryrestriction.CategoryNEQ(*i.CategoryNEQ))
 	}
-	if i.DeletedByGTE != nil {
-		predicates = append(predicates, post.DeletedByGTE(*i.DeletedByGTE))
+	if len(i.CategoryIn) > 0 {
+		predicates = append(predicates, categoryrestriction.CategoryIn(i.CategoryIn...))
 	}
-	if i.DeletedByLT != nil {
-		predicates = append(predicates, post.DeletedByLT(*i.DeletedByLT))
+	if len(i.CategoryNotIn) > 0 {
+		predicates = append(predicates, categoryrestriction.CategoryNotIn(i.CategoryNotIn...))
 	}
-	if i.DeletedByLTE != nil {
-		predicates = append(predicates, post.DeletedByLTE(*i.DeletedByLTE))
+	if i.SubscriberOnlyPost != nil {
+		predicates = append(predicates, categoryrestriction.SubscriberOnlyPostEQ(*i.SubscriberOnlyPost))
 	}
-	if i.DeletedByContains != nil {
-		predicates = append(predicates, post.DeletedByContains(*i.DeletedByContains))
+	if i.SubscriberOnlyPostNEQ != nil {
+		predicates = append(predicates, categoryrestriction.SubscriberOnlyPostNEQ(*i.SubscriberOnlyPostNEQ))
 	}
-	if i.DeletedByHasPrefix != nil {
-		predicates = append(predicates, post.DeletedByHasPrefix(*i.DeletedByHasPrefix))
+	if i.SubscriberOnlyView != nil {
+		predicates = append(predicates, categoryrestriction.SubscriberOnlyViewEQ(*i.SubscriberOnlyView))
 	}
-	if i.DeletedByHasSuffix != nil {
-		predicates = append(predicates, post.DeletedByHasSuffix(*i.DeletedByHasSuffix))
+	if i.SubscriberOnlyViewNEQ != nil {
+		predicates = append(predicates, categoryrestriction.SubscriberOnlyViewNEQ(*i.SubscriberOnlyViewNEQ))
 	}
-	if i.DeletedByIsNil {
-		predicates = append(predicates, post.DeletedByIsNil())
+
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyCategoryRestrictionWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return categoryrestriction.And(predicates...), nil
 	}
-	if i.DeletedByNotNil {
+}
+
+// CommentWhereInput represents a where input for filtering Comment queries.
+type CommentWhereInput struct {
+	Predicates []predicate.Comment  `json:"-"`
+	Not        *CommentWhereInput   `json:"not,omitempty"`
+	Or         []*CommentWhereInput `json:"or,omitempty"`
+	And        []*CommentWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "deleted_at" field predicates.
+	DeletedAt       *time.Time  `json:"deletedAt,omitempty"`
+	DeletedAtNEQ    *time.Time  `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn     []time.Time `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn  []time.Time `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGT     *time.Time  `json:"deletedAtGT,omitempty"`
+	DeletedAtGTE    *time.Time  `json:"deletedAtGTE,omitempty"`
+	DeletedAtLT     *time.Time  `json:"deletedAtLT,omitempty"`
+	DeletedAtLTE    *time.Time  `json:"deletedAtLTE,omitempty"`
+	DeletedAtIsNil  bool        `json:"deletedAtIsNil,omitempty"`
+	DeletedAtNotNil bool        `json:"deletedAtNotNil,omitempty"`
+
+	// "deleted_by" field predicates.
+	DeletedBy             *string  `json:"deletedBy,omitempty"`
+	DeletedByNEQ          *string  `json:"deletedByNEQ,omitempty"`
+	DeletedByIn           []string `json:"deletedByIn,omitempty"`
+	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
+	DeletedByGT           *string  `json:"deletedByGT,omitempty"`
+	DeletedByGTE          *string  `json:"deletedByGTE,omitempty"`
+	DeletedByLT           *string  `json:"deletedByLT,omitempty"`
+	DeletedByLTE          *string  `json:"deletedByLTE,omitempty"`
+	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
+	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
+	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
+	DeletedByIsNil        bool     `json:"deletedByIsNil,omitempty"`
+	DeletedByNotNil       bool     `json:"deletedByNotNil,omitempty"`
+	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
+	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
+
+	// "content" field predicates.
+	Content             *string  `json:"content,omitempty"`
+	ContentNEQ          *string  `json:"contentNEQ,omitempty"`
+	ContentIn           []string `json:"contentIn,omitempty"`
+	ContentNotIn        []string `json:"contentNotIn,omitempty"`
+	ContentGT           *string  `json:"contentGT,omitempty"`
+	ContentGTE          *string  `json:"contentGTE,omitempty"`
+	ContentLT           *string  `json:"contentLT,omitempty"`
+	ContentLTE          *string  `json:"contentLTE,omitempty"`
+	ContentContains     *string  `json:"contentContains,omitempty"`
+	ContentHasPrefix    *string  `json:"contentHasPrefix,omitempty"`
+	ContentHasSuffix    *string  `json:"contentHasSuffix,omitempty"`
+	ContentEqualFold    *string  `json:"contentEqualFold,omitempty"`
+	ContentContainsFold *string  `json:"contentContainsFold,omitempty"`
+
+	// "owner" edge predicates.
+	HasOwner     *bool             `json:"hasOwner,omitempty"`
+	HasOwnerWith []*UserWhereInput `json:"hasOwnerWith,omitempty"`
+
+	// "post" edge predicates.
+	HasPost     *bool             `json:"hasPost,omitempty"`
+	HasPostWith []*PostWhereInput `json:"hasPostWith,omitempty"`
+	// Deleted record filter options.
+	IncludeDeleted     *bool `json:"includeDeleted,omitempty"`
+	IncludeDeletedOnly *bool `json:"includeDeletedOnly,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *CommentWhereInput) AddPredicates(predicates ...predicate.Comment) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the CommentWhereInput filter on the CommentQuery builder.
+func (i *CommentWhereInput) Filter(q *CommentQuery) (*CommentQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyCommentWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyCommentWhereInput is returned in case the CommentWhereInput is empty.
+var ErrEmptyCommentWhereInput = errors.New("generated: empty predicate CommentWhereInput")
+
+// P returns a predicate for filtering comments.
+// An error is returned if the input is empty or invalid.
+func (i *CommentWhereInput) P() (predicate.Comment, error) {
+	var predicates []predicate.Comment
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, comment.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Comment, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, comment.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Comment, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, comment.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, comment.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, comment.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, comment.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, comment.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, comment.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, comment.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, comment.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, comment.IDLTE(*i.IDLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, comment.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, comment.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, comment.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, comment.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, comment.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, comment.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, comment.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, comment.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, comment.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, comment.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, comment.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, comment.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, comment.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, comment.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, comment.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, comment.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.DeletedAt != nil {
+		predicates = append(predicates, comment.DeletedAtEQ(*i.DeletedAt))
+	}
+	if i.DeletedAtNEQ != nil {
+		predicates = append(predicates, comment.DeletedAtNEQ(*i.DeletedAtNEQ))
+	}
+	if len(i.DeletedAtIn) > 0 {
+		predicates = append(predicates, comment.DeletedAtIn(i.DeletedAtIn...))
+	}
+	if len(i.DeletedAtNotIn) > 0 {
+		predicates = append(predicates, comment.DeletedAtNotIn(i.DeletedAtNotIn...))
+	}
+	if i.DeletedAtGT != nil {
+		predicates = append(predicates, comment.DeletedAtGT(*i.DeletedAtGT))
+	}
+	if i.DeletedAtGTE != nil {
+		predicates = append(predicates, comment.DeletedAtGTE(*i.DeletedAtGTE))
+	}
+	if i.DeletedAtLT != nil {
+		predicates = append(predicates, comment.DeletedAtLT(*i.DeletedAtLT))
+	}
+	if i.DeletedAtLTE != nil {
+		predicates = append(predicates, comment.DeletedAtLTE(*i.DeletedAtLTE))
+	}
+	if i.DeletedAtIsNil {
+		predicates = append(predicates, comment.DeletedAtIsNil())
+	}
+	if i.DeletedAtNotNil {
+		predicates = append(predicates, comment.DeletedAtNotNil())
+	}
+	if i.DeletedBy != nil {
+		predicates = append(predicates, comment.DeletedByEQ(*i.DeletedBy))
+	}
+	if i.DeletedByNEQ != nil {
+		predicates = append(predicates, comment.DeletedByNEQ(*i.DeletedByNEQ))
+	}
+	if len(i.DeletedByIn) > 0 {
+		predicates = append(predicates, comment.DeletedByIn(i.DeletedByIn...))
+	}
+	if len(i.DeletedByNotIn) > 0 {
+		predicates = append(predicates, comment.DeletedByNotIn(i.DeletedByNotIn...))
+	}
+	if i.DeletedByGT != nil {
+		predicates = append(predicates, comment.DeletedByGT(*i.DeletedByGT))
+	}
+	if i.DeletedByGTE != nil {
+		predicates = append(predicates, comment.DeletedByGTE(*i.DeletedByGTE))
+	}
+	if i.DeletedByLT != nil {
+		predicates = append(predicates, comment.DeletedByLT(*i.DeletedByLT))
+	}
+	if i.DeletedByLTE != nil {
+		predicates = append(predicates, comment.DeletedByLTE(*i.DeletedByLTE))
+	}
+	if i.DeletedByContains != nil {
+		predicates = append(predicates, comment.DeletedByContains(*i.DeletedByContains))
+	}
+	if i.DeletedByHasPrefix != nil {
+		predicates = append(predicates, comment.DeletedByHasPrefix(*i.DeletedByHasPrefix))
+	}
+	if i.DeletedByHasSuffix != nil {
+		predicates = append(predicates, comment.DeletedByHasSuffix(*i.DeletedByHasSuffix))
+	}
+	if i.DeletedByIsNil {
+		predicates = append(predicates, comment.DeletedByIsNil())
+	}
+	if i.DeletedByNotNil {
+		predicates = append(predicates, comment.DeletedByNotNil())
+	}
+	if i.DeletedByEqualFold != nil {
+		predicates = append(predicates, comment.DeletedByEqualFold(*i.DeletedByEqualFold))
+	}
+	if i.DeletedByContainsFold != nil {
+		predicates = append(predicates, comment.DeletedByContainsFold(*i.DeletedByContainsFold))
+	}
+	if i.Content != nil {
+		predicates = append(predicates, comment.ContentEQ(*i.Content))
+	}
+	if i.ContentNEQ != nil {
+		predicates = append(predicates, comment.ContentNEQ(*i.ContentNEQ))
+	}
+	if len(i.ContentIn) > 0 {
+		predicates = append(predicates, comment.ContentIn(i.ContentIn...))
+	}
+	if len(i.ContentNotIn) > 0 {
+		predicates = append(predicates, comment.ContentNotIn(i.ContentNotIn...))
+	}
+	if i.ContentGT != nil {
+		predicates = append(predicates, comment.ContentGT(*i.ContentGT))
+	}
+	if i.ContentGTE != nil {
+		predicates = append(predicates, comment.ContentGTE(*i.ContentGTE))
+	}
+	if i.ContentLT != nil {
+		predicates = append(predicates, comment.ContentLT(*i.ContentLT))
+	}
+	if i.ContentLTE != nil {
+		predicates = append(predicates, comment.ContentLTE(*i.ContentLTE))
+	}
+	if i.ContentContains != nil {
+		predicates = append(predicates, comment.ContentContains(*i.ContentContains))
+	}
+	if i.ContentHasPrefix != nil {
+		predicates = append(predicates, comment.ContentHasPrefix(*i.ContentHasPrefix))
+	}
+	if i.ContentHasSuffix != nil {
+		predicates = append(predicates, comment.ContentHasSuffix(*i.ContentHasSuffix))
+	}
+	if i.ContentEqualFold != nil {
+		predicates = append(predicates, comment.ContentEqualFold(*i.ContentEqualFold))
+	}
+	if i.ContentContainsFold != nil {
+		predicates = append(predicates, comment.ContentContainsFold(*i.ContentContainsFold))
+	}
+
+	if i.HasOwner != nil {
+		p := comment.HasOwner()
+		if !*i.HasOwner {
+			p = comment.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasOwnerWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasOwnerWith))
+		for _, w := range i.HasOwnerWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOwnerWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, comment.HasOwnerWith(with...))
+	}
+	if i.HasPost != nil {
+		p := comment.HasPost()
+		if !*i.HasPost {
+			p = comment.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasPostWith) > 0 {
+		with := make([]predicate.Post, 0, len(i.HasPostWith))
+		for _, w := range i.HasPostWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasPostWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, comment.HasPostWith(with...))
+	}
+
+	if i.IncludeDeletedOnly != nil && *i.IncludeDeletedOnly {
+		predicates = append(predicates, comment.DeletedAtNotNil())
+	}
+
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyCommentWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return comment.And(predicates...), nil
+	}
+}
+
+// IdentityWhereInput represents a where input for filtering Identity queries.
+type IdentityWhereInput struct {
+	Predicates []predicate.Identity  `json:"-"`
+	Not        *IdentityWhereInput   `json:"not,omitempty"`
+	Or         []*IdentityWhereInput `json:"or,omitempty"`
+	And        []*IdentityWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "provider" field predicates.
+	Provider      *identity.Provider  `json:"provider,omitempty"`
+	ProviderNEQ   *identity.Provider  `json:"providerNEQ,omitempty"`
+	ProviderIn    []identity.Provider `json:"providerIn,omitempty"`
+	ProviderNotIn []identity.Provider `json:"providerNotIn,omitempty"`
+
+	// "subject" field predicates.
+	Subject             *string  `json:"subject,omitempty"`
+	SubjectNEQ          *string  `json:"subjectNEQ,omitempty"`
+	SubjectIn           []string `json:"subjectIn,omitempty"`
+	SubjectNotIn        []string `json:"subjectNotIn,omitempty"`
+	SubjectGT           *string  `json:"subjectGT,omitempty"`
+	SubjectGTE          *string  `json:"subjectGTE,omitempty"`
+	SubjectLT           *string  `json:"subjectLT,omitempty"`
+	SubjectLTE          *string  `json:"subjectLTE,omitempty"`
+	SubjectContains     *string  `json:"subjectContains,omitempty"`
+	SubjectHasPrefix    *string  `json:"subjectHasPrefix,omitempty"`
+	SubjectHasSuffix    *string  `json:"subjectHasSuffix,omitempty"`
+	SubjectEqualFold    *string  `json:"subjectEqualFold,omitempty"`
+	SubjectContainsFold *string  `json:"subjectContainsFold,omitempty"`
+
+	// "email" field predicates.
+	Email             *string  `json:"email,omitempty"`
+	EmailNEQ          *string  `json:"emailNEQ,omitempty"`
+	EmailIn           []string `json:"emailIn,omitempty"`
+	EmailNotIn        []string `json:"emailNotIn,omitempty"`
+	EmailGT           *string  `json:"emailGT,omitempty"`
+	EmailGTE          *string  `json:"emailGTE,omitempty"`
+	EmailLT           *string  `json:"emailLT,omitempty"`
+	EmailLTE          *string  `json:"emailLTE,omitempty"`
+	EmailContains     *string  `json:"emailContains,omitempty"`
+	EmailHasPrefix    *string  `json:"emailHasPrefix,omitempty"`
+	EmailHasSuffix    *string  `json:"emailHasSuffix,omitempty"`
+	EmailIsNil        bool     `json:"emailIsNil,omitempty"`
+	EmailNotNil       bool     `json:"emailNotNil,omitempty"`
+	EmailEqualFold    *string  `json:"emailEqualFold,omitempty"`
+	EmailContainsFold *string  `json:"emailContainsFold,omitempty"`
+
+	// "owner" edge predicates.
+	HasOwner     *bool             `json:"hasOwner,omitempty"`
+	HasOwnerWith []*UserWhereInput `json:"hasOwnerWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *IdentityWhereInput) AddPredicates(predicates ...predicate.Identity) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the IdentityWhereInput filter on the IdentityQuery builder.
+func (i *IdentityWhereInput) Filter(q *IdentityQuery) (*IdentityQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyIdentityWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyIdentityWhereInput is returned in case the IdentityWhereInput is empty.
+var ErrEmptyIdentityWhereInput = errors.New("generated: empty predicate IdentityWhereInput")
+
+// P returns a predicate for filtering identities.
+// An error is returned if the input is empty or invalid.
+func (i *IdentityWhereInput) P() (predicate.Identity, error) {
+	var predicates []predicate.Identity
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, identity.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Identity, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, identity.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Identity, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, identity.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, identity.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, identity.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, identity.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, identity.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, identity.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, identity.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, identity.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, identity.IDLTE(*i.IDLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, identity.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, identity.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, identity.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, identity.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, identity.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, identity.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, identity.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, identity.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, identity.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, identity.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, identity.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, identity.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, identity.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, identity.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, identity.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, identity.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.Provider != nil {
+		predicates = append(predicates, identity.ProviderEQ(*i.Provider))
+	}
+	if i.ProviderNEQ != nil {
+		predicates = append(predicates, identity.ProviderNEQ(*i.ProviderNEQ))
+	}
+	if len(i.ProviderIn) > 0 {
+		predicates = append(predicates, identity.ProviderIn(i.ProviderIn...))
+	}
+	if len(i.ProviderNotIn) > 0 {
+		predicates = append(predicates, identity.ProviderNotIn(i.ProviderNotIn...))
+	}
+	if i.Subject != nil {
+		predicates = append(predicates, identity.SubjectEQ(*i.Subject))
+	}
+	if i.SubjectNEQ != nil {
+		predicates = append(predicates, identity.SubjectNEQ(*i.SubjectNEQ))
+	}
+	if len(i.SubjectIn) > 0 {
+		predicates = append(predicates, identity.SubjectIn(i.SubjectIn...))
+	}
+	if len(i.SubjectNotIn) > 0 {
+		predicates = append(predicates, identity.SubjectNotIn(i.SubjectNotIn...))
+	}
+	if i.SubjectGT != nil {
+		predicates = append(predicates, identity.SubjectGT(*i.SubjectGT))
+	}
+	if i.SubjectGTE != nil {
+		predicates = append(predicates, identity.SubjectGTE(*i.SubjectGTE))
+	}
+	if i.SubjectLT != nil {
+		predicates = append(predicates, identity.SubjectLT(*i.SubjectLT))
+	}
+	if i.SubjectLTE != nil {
+		predicates = append(predicates, identity.SubjectLTE(*i.SubjectLTE))
+	}
+	if i.SubjectContains != nil {
+		predicates = append(predicates, identity.SubjectContains(*i.SubjectContains))
+	}
+	if i.SubjectHasPrefix != nil {
+		predicates = append(predicates, identity.SubjectHasPrefix(*i.SubjectHasPrefix))
+	}
+	if i.SubjectHasSuffix != nil {
+		predicates = append(predicates, identity.SubjectHasSuffix(*i.SubjectHasSuffix))
+	}
+	if i.SubjectEqualFold != nil {
+		predicates = append(predicates, identity.SubjectEqualFold(*i.SubjectEqualFold))
+	}
+	if i.SubjectContainsFold != nil {
+		predicates = append(predicates, identity.SubjectContainsFold(*i.SubjectContainsFold))
+	}
+	if i.Email != nil {
+		predicates = append(predicates, identity.EmailEQ(*i.Email))
+	}
+	if i.EmailNEQ != nil {
+		predicates = append(predicates, identity.EmailNEQ(*i.EmailNEQ))
+	}
+	if len(i.EmailIn) > 0 {
+		predicates = append(predicates, identity.EmailIn(i.EmailIn...))
+	}
+	if len(i.EmailNotIn) > 0 {
+		predicates = append(predicates, identity.EmailNotIn(i.EmailNotIn...))
+	}
+	if i.EmailGT != nil {
+		predicates = append(predicates, identity.EmailGT(*i.EmailGT))
+	}
+	if i.EmailGTE != nil {
+		predicates = append(predicates, identity.EmailGTE(*i.EmailGTE))
+	}
+	if i.EmailLT != nil {
+		predicates = append(predicates, identity.EmailLT(*i.EmailLT))
+	}
+	if i.EmailLTE != nil {
+		predicates = append(predicates, identity.EmailLTE(*i.EmailLTE))
+	}
+	if i.EmailContains != nil {
+		predicates = append(predicates, identity.EmailContains(*i.EmailContains))
+	}
+	if i.EmailHasPrefix != nil {
+		predicates = append(predicates, identity.EmailHasPrefix(*i.EmailHasPrefix))
+	}
+	if i.EmailHasSuffix != nil {
+		predicates = append(predicates, identity.EmailHasSuffix(*i.EmailHasSuffix))
+	}
+	if i.EmailIsNil {
+		predicates = append(predicates, identity.EmailIsNil())
+	}
+	if i.EmailNotNil {
+		predicates = append(predicates, identity.EmailNotNil())
+	}
+	if i.EmailEqualFold != nil {
+		predicates = append(predicates, identity.EmailEqualFold(*i.EmailEqualFold))
+	}
+	if i.EmailContainsFold != nil {
+		predicates = append(predicates, identity.EmailContainsFold(*i.EmailContainsFold))
+	}
+
+	if i.HasOwner != nil {
+		p := identity.HasOwner()
+		if !*i.HasOwner {
+			p = identity.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasOwnerWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasOwnerWith))
+		for _, w := range i.HasOwnerWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOwnerWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, identity.HasOwnerWith(with...))
+	}
+
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyIdentityWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return identity.And(predicates...), nil
+	}
+}
+
+// PostWhereInput represents a where input for filtering Post queries.
+type PostWhereInput struct {
+	Predicates []predicate.Post  `json:"-"`
+	Not        *PostWhereInput   `json:"not,omitempty"`
+	Or         []*PostWhereInput `json:"or,omitempty"`
+	And        []*PostWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "deleted_at" field predicates.
+	DeletedAt       *time.Time  `json:"deletedAt,omitempty"`
+	DeletedAtNEQ    *time.Time  `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn     []time.Time `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn  []time.Time `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGT     *time.Time  `json:"deletedAtGT,omitempty"`
+	DeletedAtGTE    *time.Time  `json:"deletedAtGTE,omitempty"`
+	DeletedAtLT     *time.Time  `json:"deletedAtLT,omitempty"`
+	DeletedAtLTE    *time.Time  `json:"deletedAtLTE,omitempty"`
+	DeletedAtIsNil  bool        `json:"deletedAtIsNil,omitempty"`
+	DeletedAtNotNil bool        `json:"deletedAtNotNil,omitempty"`
+
+	// "deleted_by" field predicates.
+	DeletedBy             *string  `json:"deletedBy,omitempty"`
+	DeletedByNEQ          *string  `json:"deletedByNEQ,omitempty"`
+	DeletedByIn           []string `json:"deletedByIn,omitempty"`
+	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
+	DeletedByGT           *string  `json:"deletedByGT,omitempty"`
+	DeletedByGTE          *string  `json:"deletedByGTE,omitempty"`
+	DeletedByLT           *string  `json:"deletedByLT,omitempty"`
+	DeletedByLTE          *string  `json:"deletedByLTE,omitempty"`
+	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
+	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
+	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
+	DeletedByIsNil        bool     `json:"deletedByIsNil,omitempty"`
+	DeletedByNotNil       bool     `json:"deletedByNotNil,omitempty"`
+	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
+	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
+
+	// "pinned" field predicates.
+	Pinned    *bool `json:"pinned,omitempty"`
+	PinnedNEQ *bool `json:"pinnedNEQ,omitempty"`
+
+	// "title" field predicates.
+	Title             *string  `json:"title,omitempty"`
+	TitleNEQ          *string  `json:"titleNEQ,omitempty"`
+	TitleIn           []string `json:"titleIn,omitempty"`
+	TitleNotIn        []string `json:"titleNotIn,omitempty"`
+	TitleGT           *string  `json:"titleGT,omitempty"`
+	TitleGTE          *string  `json:"titleGTE,omitempty"`
+	TitleLT           *string  `json:"titleLT,omitempty"`
+	TitleLTE          *string  `json:"titleLTE,omitempty"`
+	TitleContains     *string  `json:"titleContains,omitempty"`
+	TitleHasPrefix    *string  `json:"titleHasPrefix,omitempty"`
+	TitleHasSuffix    *string  `json:"titleHasSuffix,omitempty"`
+	TitleEqualFold    *string  `json:"titleEqualFold,omitempty"`
+	TitleContainsFold *string  `json:"titleContainsFold,omitempty"`
+
+	// "content" field predicates.
+	Content             *string  `json:"content,omitempty"`
+	ContentNEQ          *string  `json:"contentNEQ,omitempty"`
+	ContentIn           []string `json:"contentIn,omitempty"`
+	ContentNotIn        []string `json:"contentNotIn,omitempty"`
+	ContentGT           *string  `json:"contentGT,omitempty"`
+	ContentGTE          *string  `json:"contentGTE,omitempty"`
+	ContentLT           *string  `json:"contentLT,omitempty"`
+	ContentLTE          *string  `json:"contentLTE,omitempty"`
+	ContentContains     *string  `json:"contentContains,omitempty"`
+	ContentHasPrefix    *string  `json:"contentHasPrefix,omitempty"`
+	ContentHasSuffix    *string  `json:"contentHasSuffix,omitempty"`
+	ContentIsNil        bool     `json:"contentIsNil,omitempty"`
+	ContentNotNil       bool     `json:"contentNotNil,omitempty"`
+	ContentEqualFold    *string  `json:"contentEqualFold,omitempty"`
+	ContentContainsFold *string  `json:"contentContainsFold,omitempty"`
+
+	// "link" field predicates.
+	Link             *string  `json:"link,omitempty"`
+	LinkNEQ          *string  `json:"linkNEQ,omitempty"`
+	LinkIn           []string `json:"linkIn,omitempty"`
+	LinkNotIn        []string `json:"linkNotIn,omitempty"`
+	LinkGT           *string  `json:"linkGT,omitempty"`
+	LinkGTE          *string  `json:"linkGTE,omitempty"`
+	LinkLT           *string  `json:"linkLT,omitempty"`
+	LinkLTE          *string  `json:"linkLTE,omitempty"`
+	LinkContains     *string  `json:"linkContains,omitempty"`
+	LinkHasPrefix    *string  `json:"linkHasPrefix,omitempty"`
+	LinkHasSuffix    *string  `json:"linkHasSuffix,omitempty"`
+	LinkEqualFold    *string  `json:"linkEqualFold,omitempty"`
+	LinkContainsFold *string  `json:"linkContainsFold,omitempty"`
+
+	// "moderation_comment" field predicates.
+	ModerationComment             *string  `json:"moderationComment,omitempty"`
+	ModerationCommentNEQ          *string  `json:"moderationCommentNEQ,omitempty"`
+	ModerationCommentIn           []string `json:"moderationCommentIn,omitempty"`
+	ModerationCommentNotIn        []string `json:"moderationCommentNotIn,omitempty"`
+	ModerationCommentGT           *string  `json:"moderationCommentGT,omitempty"`
+	ModerationCommentGTE          *string  `json:"moderationCommentGTE,omitempty"`
+	ModerationCommentLT           *string  `json:"moderationCommentLT,omitempty"`
+	ModerationCommentLTE          *string  `json:"moderationCommentLTE,omitempty"`
+	ModerationCommentContains     *string  `json:"moderationCommentContains,omitempty"`
+	ModerationCommentHasPrefix    *string  `json:"moderationCommentHasPrefix,omitempty"`
+	ModerationCommentHasSuffix    *string  `json:"moderationCommentHasSuffix,omitempty"`
+	ModerationCommentIsNil        bool     `json:"moderationCommentIsNil,omitempty"`
+	ModerationCommentNotNil       bool     `json:"moderationCommentNotNil,omitempty"`
+	ModerationCommentEqualFold    *string  `json:"moderationCommentEqualFold,omitempty"`
+	ModerationCommentContainsFold *string  `json:"moderationCommentContainsFold,omitempty"`
+
+	// "is_moderated" field predicates.
+	IsModerated    *bool `json:"isModerated,omitempty"`
+	IsModeratedNEQ *bool `json:"isModeratedNEQ,omitempty"`
+
+	// "moderated_at" field predicates.
+	ModeratedAt       *time.Time  `json:"moderatedAt,omitempty"`
+	ModeratedAtNEQ    *time.Time  `json:"moderatedAtNEQ,omitempty"`
+	ModeratedAtIn     []time.Time `json:"moderatedAtIn,omitempty"`
+	ModeratedAtNotIn  []time.Time `json:"moderatedAtNotIn,omitempty"`
+	ModeratedAtGT     *time.Time  `json:"moderatedAtGT,omitempty"`
+	ModeratedAtGTE    *time.Time  `json:"moderatedAtGTE,omitempty"`
+	ModeratedAtLT     *time.Time  `json:"moderatedAtLT,omitempty"`
+	ModeratedAtLTE    *time.Time  `json:"moderatedAtLTE,omitempty"`
+	ModeratedAtIsNil  bool        `json:"moderatedAtIsNil,omitempty"`
+	ModeratedAtNotNil bool        `json:"moderatedAtNotNil,omitempty"`
+
+	// "entity_vector" field predicates.
+	EntityVector             *string  `json:"entityVector,omitempty"`
+	EntityVectorNEQ          *string  `json:"entityVectorNEQ,omitempty"`
+	EntityVectorIn           []string `json:"entityVectorIn,omitempty"`
+	EntityVectorNotIn        []string `json:"entityVectorNotIn,omitempty"`
+	EntityVectorGT           *string  `json:"entityVectorGT,omitempty"`
+	EntityVectorGTE          *string  `json:"entityVectorGTE,omitempty"`
+	EntityVectorLT           *string  `json:"entityVectorLT,omitempty"`
+	EntityVectorLTE          *string  `json:"entityVectorLTE,omitempty"`
+	EntityVectorContains     *string  `json:"entityVectorContains,omitempty"`
+	EntityVectorHasPrefix    *string  `json:"entityVectorHasPrefix,omitempty"`
+	EntityVectorHasSuffix    *string  `json:"entityVectorHasSuffix,omitempty"`
+	EntityVectorIsNil        bool     `json:"entityVectorIsNil,omitempty"`
+	EntityVectorNotNil       bool     `json:"entityVectorNotNil,omitempty"`
+	EntityVectorEqualFold    *string  `json:"entityVectorEqualFold,omitempty"`
+	EntityVectorContainsFold *string  `json:"entityVectorContainsFold,omitempty"`
+
+	// "owner" edge predicates.
+	HasOwner     *bool             `json:"hasOwner,omitempty"`
+	HasOwnerWith []*UserWhereInput `json:"hasOwnerWith,omitempty"`
+
+	// "comments" edge predicates.
+	HasComments     *bool                `json:"hasComments,omitempty"`
+	HasCommentsWith []*CommentWhereInput `json:"hasCommentsWith,omitempty"`
+
+	// "saved_by" edge predicates.
+	HasSavedBy     *bool             `json:"hasSavedBy,omitempty"`
+	HasSavedByWith []*UserWhereInput `json:"hasSavedByWith,omitempty"`
+
+	// "liked_by" edge predicates.
+	HasLikedBy     *bool             `json:"hasLikedBy,omitempty"`
+	HasLikedByWith []*UserWhereInput `json:"hasLikedByWith,omitempty"`
+
+	// "categories" edge predicates.
+	HasCategories     *bool                     `json:"hasCategories,omitempty"`
+	HasCategoriesWith []*PostCategoryWhereInput `json:"hasCategoriesWith,omitempty"`
+	// Deleted record filter options.
+	IncludeDeleted     *bool `json:"includeDeleted,omitempty"`
+	IncludeDeletedOnly *bool `json:"includeDeletedOnly,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *PostWhereInput) AddPredicates(predicates ...predicate.Post) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the PostWhereInput filter on the PostQuery builder.
+func (i *PostWhereInput) Filter(q *PostQuery) (*PostQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyPostWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyPostWhereInput is returned in case the PostWhereInput is empty.
+var ErrEmptyPostWhereInput = errors.New("generated: empty predicate PostWhereInput")
+
+// P returns a predicate for filtering posts.
+// An error is returned if the input is empty or invalid.
+func (i *PostWhereInput) P() (predicate.Post, error) {
+	var predicates []predicate.Post
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, post.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Post, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, post.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Post, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, post.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, post.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, post.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, post.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, post.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, post.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, post.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, post.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, post.IDLTE(*i.IDLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, post.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, post.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, post.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, post.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, post.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, post.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, post.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, post.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, post.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, post.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, post.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, post.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, post.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, post.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, post.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, post.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.DeletedAt != nil {
+		predicates = append(predicates, post.DeletedAtEQ(*i.DeletedAt))
+	}
+	if i.DeletedAtNEQ != nil {
+		predicates = append(predicates, post.DeletedAtNEQ(*i.DeletedAtNEQ))
+	}
+	if len(i.DeletedAtIn) > 0 {
+		predicates = append(predicates, post.DeletedAtIn(i.DeletedAtIn...))
+	}
+	if len(i.DeletedAtNotIn) > 0 {
+		predicates = append(predicates, post.DeletedAtNotIn(i.DeletedAtNotIn...))
+	}
+	if i.DeletedAtGT != nil {
+		predicates = append(predicates, post.DeletedAtGT(*i.DeletedAtGT))
+	}
+	if i.DeletedAtGTE != nil {
+		predicates = append(predicates, post.DeletedAtGTE(*i.DeletedAtGTE))
+	}
+	if i.DeletedAtLT != nil {
+		predicates = append(predicates, post.DeletedAtLT(*i.DeletedAtLT))
+	}
+	if i.DeletedAtLTE != nil {
+		predicates = append(predicates, post.DeletedAtLTE(*i.DeletedAtLTE))
+	}
+	if i.DeletedAtIsNil {
+		predicates = append(predicates, post.DeletedAtIsNil())
+	}
+	if i.DeletedAtNotNil {
+		predicates = append(predicates, post.DeletedAtNotNil())
+	}
+	if i.DeletedBy != nil {
+		predicates = append(predicates, post.DeletedByEQ(*i.DeletedBy))
+	}
+	if i.DeletedByNEQ != nil {
+		predicates = append(predicates, post.DeletedByNEQ(*i.DeletedByNEQ))
+	}
+	if len(i.DeletedByIn) > 0 {
+		predicates = append(predicates, post.DeletedByIn(i.DeletedByIn...))
+	}
+	if len(i.DeletedByNotIn) > 0 {
+		predicates = append(predicates, post.DeletedByNotIn(i.DeletedByNotIn...))
+	}
+	if i.DeletedByGT != nil {
+		predicates = append(predicates, post.DeletedByGT(*i.DeletedByGT))
+	}
+	if i.DeletedByGTE != nil {
+		predicates = append(predicates, post.DeletedByGTE(*i.DeletedByGTE))
+	}
+	if i.DeletedByLT != nil {
+		predicates = append(predicates, post.DeletedByLT(*i.DeletedByLT))
+	}
+	if i.DeletedByLTE != nil {
+		predicates = append(predicates, post.DeletedByLTE(*i.DeletedByLTE))
+	}
+	if i.DeletedByContains != nil {
+		predicates = append(predicates, post.DeletedByContains(*i.DeletedByContains))
+	}
+	if i.DeletedByHasPrefix != nil {
+		predicates = append(predicates, post.DeletedByHasPrefix(*i.DeletedByHasPrefix))
+	}
+	if i.DeletedByHasSuffix != nil {
+		predicates = append(predicates, post.DeletedByHasSuffix(*i.DeletedByHasSuffix))
+	}
+	if i.DeletedByIsNil {
+		predicates = append(predicates, post.DeletedByIsNil())
+	}
+	if i.DeletedByNotNil {
 		predicates = append(predicates, post.DeletedByNotNil())
 	}
-	if i.DeletedByEqualFold != nil {
-		predicates = append(predicates, post.DeletedByEqualFold(*i.DeletedByEqualFold))
+	if i.DeletedByEqualFold != nil {
+		predicates = append(predicates, post.DeletedByEqualFold(*i.DeletedByEqualFold))
+	}
+	if i.DeletedByContainsFold != nil {
+		predicates = append(predicates, post.DeletedByContainsFold(*i.DeletedByContainsFold))
+	}
+	if i.Pinned != nil {
+		predicates = append(predicates, post.PinnedEQ(*i.Pinned))
+	}
+	if i.PinnedNEQ != nil {
+		predicates = append(predicates, post.PinnedNEQ(*i.PinnedNEQ))
+	}
+	if i.Title != nil {
+		predicates = append(predicates, post.TitleEQ(*i.Title))
+	}
+	if i.TitleNEQ != nil {
+		predicates = append(predicates, post.TitleNEQ(*i.TitleNEQ))
+	}
+	if len(i.TitleIn) > 0 {
+		predicates = append(predicates, post.TitleIn(i.TitleIn...))
+	}
+	if len(i.TitleNotIn) > 0 {
+		predicates = append(predicates, post.TitleNotIn(i.TitleNotIn...))
+	}
+	if i.TitleGT != nil {
+		predicates = append(predicates, post.TitleGT(*i.TitleGT))
+	}
+	if i.TitleGTE != nil {
+		predicates = append(predicates, post.TitleGTE(*i.TitleGTE))
+	}
+	if i.TitleLT != nil {
+		predicates = append(predicates, post.TitleLT(*i.TitleLT))
+	}
+	if i.TitleLTE != nil {
+		predicates = append(predicates, post.TitleLTE(*i.TitleLTE))
+	}
+	if i.TitleContains != nil {
+		predicates = append(predicates, post.TitleContains(*i.TitleContains))
+	}
+	if i.TitleHasPrefix != nil {
+		predicates = append(predicates, post.TitleHasPrefix(*i.TitleHasPrefix))
+	}
+	if i.TitleHasSuffix != nil {
+		predicates = append(predicates, post.TitleHasSuffix(*i.TitleHasSuffix))
+	}
+	if i.TitleEqualFold != nil {
+		predicates = append(predicates, post.TitleEqualFold(*i.TitleEqualFold))
+	}
+	if i.TitleContainsFold != nil {
+		predicates = append(predicates, post.TitleContainsFold(*i.TitleContainsFold))
+	}
+	if i.Content != nil {
+		predicates = append(predicates, post.ContentEQ(*i.Content))
+	}
+	if i.ContentNEQ != nil {
+		predicates = append(predicates, post.ContentNEQ(*i.ContentNEQ))
+	}
+	if len(i.ContentIn) > 0 {
+		predicates = append(predicates, post.ContentIn(i.ContentIn...))
+	}
+	if len(i.ContentNotIn) > 0 {
+		predicates = append(predicates, post.ContentNotIn(i.ContentNotIn...))
+	}
+	if i.ContentGT != nil {
+		predicates = append(predicates, post.ContentGT(*i.ContentGT))
+	}
+	if i.ContentGTE != nil {
+		predicates = append(predicates, post.ContentGTE(*i.ContentGTE))
+	}
+	if i.ContentLT != nil {
+		predicates = append(predicates, post.ContentLT(*i.ContentLT))
+	}
+	if i.ContentLTE != nil {
+		predicates = append(predicates, post.ContentLTE(*i.ContentLTE))
+	}
+	if i.ContentContains != nil {
+		predicates = append(predicates, post.ContentContains(*i.ContentContains))
+	}
+	if i.ContentHasPrefix != nil {
+		predicates = append(predicates, post.ContentHasPrefix(*i.ContentHasPrefix))
+	}
+	if i.ContentHasSuffix != nil {
+		predicates = append(predicates, post.ContentHasSuffix(*i.ContentHasSuffix))
+	}
+	if i.ContentIsNil {
+		predicates = append(predicates, post.ContentIsNil())
+	}
+	if i.ContentNotNil {
+		predicates = append(predicates, post.ContentNotNil())
+	}
+	if i.ContentEqualFold != nil {
+		predicates = append(predicates, post.ContentEqualFold(*i.ContentEqualFold))
+	}
+	if i.ContentContainsFold != nil {
+		predicates = append(predicates, post.ContentContainsFold(*i.ContentContainsFold))
+	}
+	if i.Link != nil {
+		predicates = append(predicates, post.LinkEQ(*i.Link))
+	}
+	if i.LinkNEQ != nil {
+		predicates = append(predicates, post.LinkNEQ(*i.LinkNEQ))
+	}
+	if len(i.LinkIn) > 0 {
+		predicates = append(predicates, post.LinkIn(i.LinkIn...))
+	}
+	if len(i.LinkNotIn) > 0 {
+		predicates = append(predicates, post.LinkNotIn(i.LinkNotIn...))
+	}
+	if i.LinkGT != nil {
+		predicates = append(predicates, post.LinkGT(*i.LinkGT))
+	}
+	if i.LinkGTE != nil {
+		predicates = append(predicates, post.LinkGTE(*i.LinkGTE))
+	}
+	if i.LinkLT != nil {
+		predicates = append(predicates, post.LinkLT(*i.LinkLT))
+	}
+	if i.LinkLTE != nil {
+		predicates = append(predicates, post.LinkLTE(*i.LinkLTE))
+	}
+	if i.LinkContains != nil {
+		predicates = append(predicates, post.LinkContains(*i.LinkContains))
+	}
+	if i.LinkHasPrefix != nil {
+		predicates = append(predicates, post.LinkHasPrefix(*i.LinkHasPrefix))
+	}
+	if i.LinkHasSuffix != nil {
+		predicates = append(predicates, post.LinkHasSuffix(*i.LinkHasSuffix))
+	}
+	if i.LinkEqualFold != nil {
+		predicates = append(predicates, post.LinkEqualFold(*i.LinkEqualFold))
+	}
+	if i.LinkContainsFold != nil {
+		predicates = append(predicates, post.LinkContainsFold(*i.LinkContainsFold))
+	}
+	if i.ModerationComment != nil {
+		predicates = append(predicates, post.ModerationCommentEQ(*i.ModerationComment))
+	}
+	if i.ModerationCommentNEQ != nil {
+		predicates = append(predicates, post.ModerationCommentNEQ(*i.ModerationCommentNEQ))
+	}
+	if len(i.ModerationCommentIn) > 0 {
+		predicates = append(predicates, post.ModerationCommentIn(i.ModerationCommentIn...))
+	}
+	if len(i.ModerationCommentNotIn) > 0 {
+		predicates = append(predicates, post.ModerationCommentNotIn(i.ModerationCommentNotIn...))
+	}
+	if i.ModerationCommentGT != nil {
+		predicates = append(predicates, post.ModerationCommentGT(*i.ModerationCommentGT))
+	}
+	if i.ModerationCommentGTE != nil {
+		predicates = append(predicates, post.ModerationCommentGTE(*i.ModerationCommentGTE))
+	}
+	if i.ModerationCommentLT != nil {
+		predicates = append(predicates, post.ModerationCommentLT(*i.ModerationCommentLT))
+	}
+	if i.ModerationCommentLTE != nil {
+		predicates = append(predicates, post.ModerationCommentLTE(*i.ModerationCommentLTE))
+	}
+	if i.ModerationCommentContains != nil {
+		predicates = append(predicates, post.ModerationCommentContains(*i.ModerationCommentContains))
+	}
+	if i.ModerationCommentHasPrefix != nil {
+		predicates = append(predicates, post.ModerationCommentHasPrefix(*i.ModerationCommentHasPrefix))
+	}
+	if i.ModerationCommentHasSuffix != nil {
+		predicates = append(predicates, post.ModerationCommentHasSuffix(*i.ModerationCommentHasSuffix))
+	}
+	if i.ModerationCommentIsNil {
+		predicates = append(predicates, post.ModerationCommentIsNil())
+	}
+	if i.ModerationCommentNotNil {
+		predicates = append(predicates, post.ModerationCommentNotNil())
+	}
+	if i.ModerationCommentEqualFold != nil {
+		predicates = append(predicates, post.ModerationCommentEqualFold(*i.ModerationCommentEqualFold))
+	}
+	if i.ModerationCommentContainsFold != nil {
+		predicates = append(predicates, post.ModerationCommentContainsFold(*i.ModerationCommentContainsFold))
+	}
+	if i.IsModerated != nil {
+		predicates = append(predicates, post.IsModeratedEQ(*i.IsModerated))
+	}
+	if i.IsModeratedNEQ != nil {
+		predicates = append(predicates, post.IsModeratedNEQ(*i.IsModeratedNEQ))
+	}
+	if i.ModeratedAt != nil {
+		predicates = append(predicates, post.ModeratedAtEQ(*i.ModeratedAt))
+	}
+	if i.ModeratedAtNEQ != nil {
+		predicates = append(predicates, post.ModeratedAtNEQ(*i.ModeratedAtNEQ))
+	}
+	if len(i.ModeratedAtIn) > 0 {
+		predicates = append(predicates, post.ModeratedAtIn(i.ModeratedAtIn...))
+	}
+	if len(i.ModeratedAtNotIn) > 0 {
+		predicates = append(predicates, post.ModeratedAtNotIn(i.ModeratedAtNotIn...))
+	}
+	if i.ModeratedAtGT != nil {
+		predicates = append(predicates, post.ModeratedAtGT(*i.ModeratedAtGT))
+	}
+	if i.ModeratedAtGTE != nil {
+		predicates = append(predicates, post.ModeratedAtGTE(*i.ModeratedAtGTE))
+	}
+	if i.ModeratedAtLT != nil {
+		predicates = append(predicates, post.ModeratedAtLT(*i.ModeratedAtLT))
+	}
+	if i.ModeratedAtLTE != nil {
+		predicates = append(predicates, post.ModeratedAtLTE(*i.ModeratedAtLTE))
+	}
+	if i.ModeratedAtIsNil {
+		predicates = append(predicates, post.ModeratedAtIsNil())
+	}
+	if i.ModeratedAtNotNil {
+		predicates = append(predicates, post.ModeratedAtNotNil())
+	}
+	if i.EntityVector != nil {
+		predicates = append(predicates, post.EntityVectorEQ(*i.EntityVector))
+	}
+	if i.EntityVectorNEQ != nil {
+		predicates = append(predicates, post.EntityVectorNEQ(*i.EntityVectorNEQ))
+	}
+	if len(i.EntityVectorIn) > 0 {
+		predicates = append(predicates, post.EntityVectorIn(i.EntityVectorIn...))
+	}
+	if len(i.EntityVectorNotIn) > 0 {
+		predicates = append(predicates, post.EntityVectorNotIn(i.EntityVectorNotIn...))
+	}
+	if i.EntityVectorGT != nil {
+		predicates = append(predicates, post.EntityVectorGT(*i.EntityVectorGT))
+	}
+	if i.EntityVectorGTE != nil {
+		predicates = append(predicates, post.EntityVectorGTE(*i.EntityVectorGTE))
+	}
+	if i.EntityVectorLT != nil {
+		predicates = append(predicates, post.EntityVectorLT(*i.EntityVectorLT))
+	}
+	if i.EntityVectorLTE != nil {
+		predicates = append(predicates, post.EntityVectorLTE(*i.EntityVectorLTE))
+	}
+	if i.EntityVectorContains != nil {
+		predicates = append(predicates, post.EntityVectorContains(*i.EntityVectorContains))
+	}
+	if i.EntityVectorHasPrefix != nil {
+		predicates = append(predicates, post.EntityVectorHasPrefix(*i.EntityVectorHasPrefix))
+	}
+	if i.EntityVectorHasSuffix != nil {
+		predicates = append(predicates, post.EntityVectorHasSuffix(*i.EntityVectorHasSuffix))
+	}
+	if i.EntityVectorIsNil {
+		predicates = append(predicates, post.EntityVectorIsNil())
+	}
+	if i.EntityVectorNotNil {
+		predicates = append(predicates, post.EntityVectorNotNil())
+	}
+	if i.EntityVectorEqualFold != nil {
+		predicates = append(predicates, post.EntityVectorEqualFold(*i.EntityVectorEqualFold))
+	}
+	if i.EntityVectorContainsFold != nil {
+		predicates = append(predicates, post.EntityVectorContainsFold(*i.EntityVectorContainsFold))
+	}
+
+	if i.HasOwner != nil {
+		p := post.HasOwner()
+		if !*i.HasOwner {
+			p = post.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasOwnerWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasOwnerWith))
+		for _, w := range i.HasOwnerWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOwnerWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, post.HasOwnerWith(with...))
+	}
+	if i.HasComments != nil {
+		p := post.HasComments()
+		if !*i.HasComments {
+			p = post.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasCommentsWith) > 0 {
+		with := make([]predicate.Comment, 0, len(i.HasCommentsWith))
+		for _, w := range i.HasCommentsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasCommentsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, post.HasCommentsWith(with...))
+	}
+	if i.HasSavedBy != nil {
+		p := post.HasSavedBy()
+		if !*i.HasSavedBy {
+			p = post.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSavedByWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasSavedByWith))
+		for _, w := range i.HasSavedByWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSavedByWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, post.HasSavedByWith(with...))
+	}
+	if i.HasLikedBy != nil {
+		p := post.HasLikedBy()
+		if !*i.HasLikedBy {
+			p = post.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasLikedByWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasLikedByWith))
+		for _, w := range i.HasLikedByWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasLikedByWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, post.HasLikedByWith(with...))
+	}
+	if i.HasCategories != nil {
+		p := post.HasCategories()
+		if !*i.HasCategories {
+			p = post.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasCategoriesWith) > 0 {
+		with := make([]predicate.PostCategory, 0, len(i.HasCategoriesWith))
+		for _, w := range i.HasCategoriesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasCategoriesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, post.HasCategoriesWith(with...))
+	}
+
+	if i.IncludeDeletedOnly != nil && *i.IncludeDeletedOnly {
+		predicates = append(predicates, post.DeletedAtNotNil())
+	}
+
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyPostWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return post.And(predicates...), nil
+	}
+}
+
+// PostCategoryWhereInput represents a where input for filtering PostCategory queries.
+type PostCategoryWhereInput struct {
+	Predicates []predicate.PostCategory  `json:"-"`
+	Not        *PostCategoryWhereInput   `json:"not,omitempty"`
+	Or         []*PostCategoryWhereInput `json:"or,omitempty"`
+	And        []*PostCategoryWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "category" field predicates.
+	Category      *postcategory.Category  `json:"category,omitempty"`
+	CategoryNEQ   *postcategory.Category  `json:"categoryNEQ,omitempty"`
+	CategoryIn    []postcategory.Category `json:"categoryIn,omitempty"`
+	CategoryNotIn []postcategory.Category `json:"categoryNotIn,omitempty"`
+
+	// "post" edge predicates.
+	HasPost     *bool             `json:"hasPost,omitempty"`
+	HasPostWith []*PostWhereInput `json:"hasPostWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *PostCategoryWhereInput) AddPredicates(predicates ...predicate.PostCategory) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the PostCategoryWhereInput filter on the PostCategoryQuery builder.
+func (i *PostCategoryWhereInput) Filter(q *PostCategoryQuery) (*PostCategoryQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyPostCategoryWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyPostCategoryWhereInput is returned in case the PostCategoryWhereInput is empty.
+var ErrEmptyPostCategoryWhereInput = errors.New("generated: empty predicate PostCategoryWhereInput")
+
+// P returns a predicate for filtering postcategories.
+// An error is returned if the input is empty or invalid.
+func (i *PostCategoryWhereInput) P() (predicate.PostCategory, error) {
+	var predicates []predicate.PostCategory
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, postcategory.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.PostCategory, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, postcategory.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.PostCategory, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, postcategory.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, postcategory.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, postcategory.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, postcategory.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, postcategory.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, postcategory.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, postcategory.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, postcategory.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, postcategory.IDLTE(*i.IDLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, postcategory.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, postcategory.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, postcategory.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, postcategory.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, postcategory.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, postcategory.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, postcategory.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, postcategory.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, postcategory.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, postcategory.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, postcategory.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, postcategory.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, postcategory.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, postcategory.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, postcategory.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, postcategory.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.Category != nil {
+		predicates = append(predicates, postcategory.CategoryEQ(*i.Category))
+	}
+	if i.CategoryNEQ != nil {
+		predicates = append(predicates, postcategory.CategoryNEQ(*i.CategoryNEQ))
+	}
+	if len(i.CategoryIn) > 0 {
+		predicates = append(predicates, postcategory.CategoryIn(i.CategoryIn...))
+	}
+	if len(i.CategoryNotIn) > 0 {
+		predicates = append(predicates, postcategory.CategoryNotIn(i.CategoryNotIn...))
+	}
+
+	if i.HasPost != nil {
+		p := postcategory.HasPost()
+		if !*i.HasPost {
+			p = postcategory.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasPostWith) > 0 {
+		with := make([]predicate.Post, 0, len(i.HasPostWith))
+		for _, w := range i.HasPostWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasPostWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, postcategory.HasPostWith(with...))
+	}
+
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyPostCategoryWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return postcategory.And(predicates...), nil
+	}
+}
+
+// RefreshTokenWhereInput represents a where input for filtering RefreshToken queries.
+type RefreshTokenWhereInput struct {
+	Predicates []predicate.RefreshToken  `json:"-"`
+	Not        *RefreshTokenWhereInput   `json:"not,omitempty"`
+	Or         []*RefreshTokenWhereInput `json:"or,omitempty"`
+	And        []*RefreshTokenWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "expires_at" field predicates.
+	ExpiresAt      *time.Time  `json:"expiresAt,omitempty"`
+	ExpiresAtNEQ   *time.Time  `json:"expiresAtNEQ,omitempty"`
+	ExpiresAtIn    []time.Time `json:"expiresAtIn,omitempty"`
+	ExpiresAtNotIn []time.Time `json:"expiresAtNotIn,omitempty"`
+	ExpiresAtGT    *time.Time  `json:"expiresAtGT,omitempty"`
+	ExpiresAtGTE   *time.Time  `json:"expiresAtGTE,omitempty"`
+	ExpiresAtLT    *time.Time  `json:"expiresAtLT,omitempty"`
+	ExpiresAtLTE   *time.Time  `json:"expiresAtLTE,omitempty"`
+
+	// "revoked" field predicates.
+	Revoked    *bool `json:"revoked,omitempty"`
+	RevokedNEQ *bool `json:"revokedNEQ,omitempty"`
+
+	// "ip_address" field predicates.
+	IPAddress             *string  `json:"ipAddress,omitempty"`
+	IPAddressNEQ          *string  `json:"ipAddressNEQ,omitempty"`
+	IPAddressIn           []string `json:"ipAddressIn,omitempty"`
+	IPAddressNotIn        []string `json:"ipAddressNotIn,omitempty"`
+	IPAddressGT           *string  `json:"ipAddressGT,omitempty"`
+	IPAddressGTE          *string  `json:"ipAddressGTE,omitempty"`
+	IPAddressLT           *string  `json:"ipAddressLT,omitempty"`
+	IPAddressLTE          *string  `json:"ipAddressLTE,omitempty"`
+	IPAddressContains     *string  `json:"ipAddressContains,omitempty"`
+	IPAddressHasPrefix    *string  `json:"ipAddressHasPrefix,omitempty"`
+	IPAddressHasSuffix    *string  `json:"ipAddressHasSuffix,omitempty"`
+	IPAddressIsNil        bool     `json:"ipAddressIsNil,omitempty"`
+	IPAddressNotNil       bool     `json:"ipAddressNotNil,omitempty"`
+	IPAddressEqualFold    *string  `json:"ipAddressEqualFold,omitempty"`
+	IPAddressContainsFold *string  `json:"ipAddressContainsFold,omitempty"`
+
+	// "user_agent" field predicates.
+	UserAgent             *string  `json:"userAgent,omitempty"`
+	UserAgentNEQ          *string  `json:"userAgentNEQ,omitempty"`
+	UserAgentIn           []string `json:"userAgentIn,omitempty"`
+	UserAgentNotIn        []string `json:"userAgentNotIn,omitempty"`
+	UserAgentGT           *string  `json:"userAgentGT,omitempty"`
+	UserAgentGTE          *string  `json:"userAgentGTE,omitempty"`
+	UserAgentLT           *string  `json:"userAgentLT,omitempty"`
+	UserAgentLTE          *string  `json:"userAgentLTE,omitempty"`
+	UserAgentContains     *string  `json:"userAgentContains,omitempty"`
+	UserAgentHasPrefix    *string  `json:"userAgentHasPrefix,omitempty"`
+	UserAgentHasSuffix    *string  `json:"userAgentHasSuffix,omitempty"`
+	UserAgentIsNil        bool     `json:"userAgentIsNil,omitempty"`
+	UserAgentNotNil       bool     `json:"userAgentNotNil,omitempty"`
+	UserAgentEqualFold    *string  `json:"userAgentEqualFold,omitempty"`
+	UserAgentContainsFold *string  `json:"userAgentContainsFold,omitempty"`
+
+	// "owner" edge predicates.
+	HasOwner     *bool             `json:"hasOwner,omitempty"`
+	HasOwnerWith []*UserWhereInput `json:"hasOwnerWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *RefreshTokenWhereInput) AddPredicates(predicates ...predicate.RefreshToken) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the RefreshTokenWhereInput filter on the RefreshTokenQuery builder.
+func (i *RefreshTokenWhereInput) Filter(q *RefreshTokenQuery) (*RefreshTokenQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyRefreshTokenWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyRefreshTokenWhereInput is returned in case the RefreshTokenWhereInput is empty.
+var ErrEmptyRefreshTokenWhereInput = errors.New("generated: empty predicate RefreshTokenWhereInput")
+
+// P returns a predicate for filtering refreshtokens.
+// An error is returned if the input is empty or invalid.
+func (i *RefreshTokenWhereInput) P() (predicate.RefreshToken, error) {
+	var predicates []predicate.RefreshToken
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, refreshtoken.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.RefreshToken, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, refreshtoken.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.RefreshToken, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, refreshtoken.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, refreshtoken.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, refreshtoken.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, refreshtoken.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, refreshtoken.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, refreshtoken.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, refreshtoken.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, refreshtoken.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, refreshtoken.IDLTE(*i.IDLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, refreshtoken.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, refreshtoken.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, refreshtoken.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, refreshtoken.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, refreshtoken.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, refreshtoken.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, refreshtoken.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, refreshtoken.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, refreshtoken.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, refreshtoken.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, refreshtoken.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, refreshtoken.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
-	if i.DeletedByContainsFold != nil {
-		predicates = append(predicates, post.DeletedByContainsFold(*i.DeletedByContainsFold))
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, refreshtoken.CreatedAtGT(*i.CreatedAtGT))
 	}
-	if i.Pinned != nil {
-		predicates = append(predicates, post.PinnedEQ(*i.Pinned))
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, refreshtoken.CreatedAtGTE(*i.CreatedAtGTE))
 	}
-	if i.PinnedNEQ != nil {
-		predicates = append(predicates, post.PinnedNEQ(*i.PinnedNEQ))
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, refreshtoken.CreatedAtLT(*i.CreatedAtLT))
 	}
-	if i.Title != nil {
-		predicates = append(predicates, post.TitleEQ(*i.Title))
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, refreshtoken.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if i.TitleNEQ != nil {
-		predicates = append(predicates, post.TitleNEQ(*i.TitleNEQ))
+	if i.ExpiresAt != nil {
+		predicates = append(predicates, refreshtoken.ExpiresAtEQ(*i.ExpiresAt))
 	}
-	if len(i.TitleIn) > 0 {
-		predicates = append(predicates, post.TitleIn(i.TitleIn...))
+	if i.ExpiresAtNEQ != nil {
+		predicates = append(predicates, refreshtoken.ExpiresAtNEQ(*i.ExpiresAtNEQ))
 	}
-	if len(i.TitleNotIn) > 0 {
-		predicates = append(predicates, post.TitleNotIn(i.TitleNotIn...))
+	if len(i.ExpiresAtIn) > 0 {
+		predicates = append(predicates, refreshtoken.ExpiresAtIn(i.ExpiresAtIn...))
 	}
-	if i.TitleGT != nil {
-		predicates = append(predicates, post.TitleGT(*i.TitleGT))
+	if len(i.ExpiresAtNotIn) > 0 {
+		predicates = append(predicates, refreshtoken.ExpiresAtNotIn(i.ExpiresAtNotIn...))
 	}
-	if i.TitleGTE != nil {
-		predicates = append(predicates, post.TitleGTE(*i.TitleGTE))
+	if i.ExpiresAtGT != nil {
+		predicates = append(predicates, refreshtoken.ExpiresAtGT(*i.ExpiresAtGT))
 	}
-	if i.TitleLT != nil {
-		predicates = append(predicates, post.TitleLT(*i.TitleLT))
+	if i.ExpiresAtGTE != nil {
+		predicates = append(predicates, refreshtoken.ExpiresAtGTE(*i.ExpiresAtGTE))
 	}
-	if i.TitleLTE != nil {
-		predicates = append(predicates, post.TitleLTE(*i.TitleLTE))
+	if i.ExpiresAtLT != nil {
+		predicates = append(predicates, refreshtoken.ExpiresAtLT(*i.ExpiresAtLT))
 	}
-	if i.TitleContains != nil {
-		predicates = append(predicates, post.TitleContains(*i.TitleContains))
+	if i.ExpiresAtLTE != nil {
+		predicates = append(predicates, refreshtoken.ExpiresAtLTE(*i.ExpiresAtLTE))
 	}
-	if i.TitleHasPrefix != nil {
-		predicates = append(predicates, post.TitleHasPrefix(*i.TitleHasPrefix))
+	if i.Revoked != nil {
+		predicates = append(predicates, refreshtoken.RevokedEQ(*i.Revoked))
 	}
-	if i.TitleHasSuffix != nil {
-		predicates = append(predicates, post.TitleHasSuffix(*i.TitleHasSuffix))
+	if i.RevokedNEQ != nil {
+		predicates = append(predicates, refreshtoken.RevokedNEQ(*i.RevokedNEQ))
 	}
-	if i.TitleEqualFold != nil {
-		predicates = append(predicates, post.TitleEqualFold(*i.TitleEqualFold))
+	if i.IPAddress != nil {
+		predicates = append(predicates, refreshtoken.IPAddressEQ(*i.IPAddress))
 	}
-	if i.TitleContainsFold != nil {
-		predicates = append(predicates, post.TitleContainsFold(*i.TitleContainsFold))
+	if i.IPAddressNEQ != nil {
+		predicates = append(predicates, refreshtoken.IPAddressNEQ(*i.IPAddressNEQ))
 	}
-	if i.Content != nil {
-		predicates = append(predicates, post.ContentEQ(*i.Content))
+	if len(i.IPAddressIn) > 0 {
+		predicates = append(predicates, refreshtoken.IPAddressIn(i.IPAddressIn...))
 	}
-	if i.ContentNEQ != nil {
-		predicates = append(predicates, post.ContentNEQ(*i.ContentNEQ))
+	if len(i.IPAddressNotIn) > 0 {
+		predicates = append(predicates, refreshtoken.IPAddressNotIn(i.IPAddressNotIn...))
 	}
-	if len(i.ContentIn) > 0 {
-		predicates = append(predicates, post.ContentIn(i.ContentIn...))
+	if i.IPAddressGT != nil {
+		predicates = append(predicates, refreshtoken.IPAddressGT(*i.IPAddressGT))
 	}
-	if len(i.ContentNotIn) > 0 {
-		predicates = append(predicates, post.ContentNotIn(i.ContentNotIn...))
+	if i.IPAddressGTE != nil {
+		predicates = append(predicates, refreshtoken.IPAddressGTE(*i.IPAddressGTE))
 	}
-	if i.ContentGT != nil {
-		predicates = append(predicates, post.ContentGT(*i.ContentGT))
+	if i.IPAddressLT != nil {
+		predicates = append(predicates, refreshtoken.IPAddressLT(*i.IPAddressLT))
+	}
+	if i.IPAddressLTE != nil {
+		predicates = append(predicates, refreshtoken.IPAddressLTE(*i.IPAddressLTE))
+	}
+	if i.IPAddressContains != nil {
+		predicates = append(predicates, refreshtoken.IPAddressContains(*i.IPAddressContains))
+	}
+	if i.IPAddressHasPrefix != nil {
+		predicates = append(predicates, refreshtoken.IPAddressHasPrefix(*i.IPAddressHasPrefix))
+	}
+	if i.IPAddressHasSuffix != nil {
+		predicates = append(predicates, refreshtoken.IPAddressHasSuffix(*i.IPAddressHasSuffix))
+	}
+	if i.IPAddressIsNil {
+		predicates = append(predicates, refreshtoken.IPAddressIsNil())
+	}
+	if i.IPAddressNotNil {
+		predicates = append(predicates, refreshtoken.IPAddressNotNil())
+	}
+	if i.IPAddressEqualFold != nil {
+		predicates = append(predicates, refreshtoken.IPAddressEqualFold(*i.IPAddressEqualFold))
+	}
+	if i.IPAddressContainsFold != nil {
+		predicates = append(predicates, refreshtoken.IPAddressContainsFold(*i.IPAddressContainsFold))
+	}
+	if i.UserAgent != nil {
+		predicates = append(predicates, refreshtoken.UserAgentEQ(*i.UserAgent))
+	}
+	if i.UserAgentNEQ != nil {
+		predicates = append(predicates, refreshtoken.UserAgentNEQ(*i.UserAgentNEQ))
+	}
+	if len(i.UserAgentIn) > 0 {
+		predicates = append(predicates, refreshtoken.UserAgentIn(i.UserAgentIn...))
+	}
+	if len(i.UserAgentNotIn) > 0 {
+		predicates = append(predicates, refreshtoken.UserAgentNotIn(i.UserAgentNotIn...))
+	}
+	if i.UserAgentGT != nil {
+		predicates = append(predicates, refreshtoken.UserAgentGT(*i.UserAgentGT))
+	}
+	if i.UserAgentGTE != nil {
+		predicates = append(predicates, refreshtoken.UserAgentGTE(*i.UserAgentGTE))
+	}
+	if i.UserAgentLT != nil {
+		predicates = append(predicates, refreshtoken.UserAgentLT(*i.UserAgentLT))
+	}
+	if i.UserAgentLTE != nil {
+		predicates = append(predicates, refreshtoken.UserAgentLTE(*i.UserAgentLTE))
+	}
+	if i.UserAgentContains != nil {
+		predicates = append(predicates, refreshtoken.UserAgentContains(*i.UserAgentContains))
+	}
+	if i.UserAgentHasPrefix != nil {
+		predicates = append(predicates, refreshtoken.UserAgentHasPrefix(*i.UserAgentHasPrefix))
+	}
+	if i.UserAgentHasSuffix != nil {
+		predicates = append(predicates, refreshtoken.UserAgentHasSuffix(*i.UserAgentHasSuffix))
+	}
+	if i.UserAgentIsNil {
+		predicates = append(predicates, refreshtoken.UserAgentIsNil())
+	}
+	if i.UserAgentNotNil {
+		predicates = append(predicates, refreshtoken.UserAgentNotNil())
+	}
+	if i.UserAgentEqualFold != nil {
+		predicates = append(predicates, refreshtoken.UserAgentEqualFold(*i.UserAgentEqualFold))
+	}
+	if i.UserAgentContainsFold != nil {
+		predicates = append(predicates, refreshtoken.UserAgentContainsFold(*i.UserAgentContainsFold))
+	}
+
+	if i.HasOwner != nil {
+		p := refreshtoken.HasOwner()
+		if !*i.HasOwner {
+			p = refreshtoken.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasOwnerWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasOwnerWith))
+		for _, w := range i.HasOwnerWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOwnerWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, refreshtoken.HasOwnerWith(with...))
+	}
+
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyRefreshTokenWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return refreshtoken.And(predicates...), nil
+	}
+}
+
+// RoleChangeWhereInput represents a where input for filtering RoleChange queries.
+type RoleChangeWhereInput struct {
+	Predicates []predicate.RoleChange  `json:"-"`
+	Not        *RoleChangeWhereInput   `json:"not,omitempty"`
+	Or         []*RoleChangeWhereInput `json:"or,omitempty"`
+	And        []*RoleChangeWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "user_id" field predicates.
+	UserID      *uuid.UUID  `json:"userID,omitempty"`
+	UserIDNEQ   *uuid.UUID  `json:"userIDNEQ,omitempty"`
+	UserIDIn    []uuid.UUID `json:"userIDIn,omitempty"`
+	UserIDNotIn []uuid.UUID `json:"userIDNotIn,omitempty"`
+
+	// "attribute" field predicates.
+	Attribute      *rolechange.Attribute  `json:"attribute,omitempty"`
+	AttributeNEQ   *rolechange.Attribute  `json:"attributeNEQ,omitempty"`
+	AttributeIn    []rolechange.Attribute `json:"attributeIn,omitempty"`
+	AttributeNotIn []rolechange.Attribute `json:"attributeNotIn,omitempty"`
+
+	// "old_value" field predicates.
+	OldValue             *string  `json:"oldValue,omitempty"`
+	OldValueNEQ          *string  `json:"oldValueNEQ,omitempty"`
+	OldValueIn           []string `json:"oldValueIn,omitempty"`
+	OldValueNotIn        []string `json:"oldValueNotIn,omitempty"`
+	OldValueGT           *string  `json:"oldValueGT,omitempty"`
+	OldValueGTE          *string  `json:"oldValueGTE,omitempty"`
+	OldValueLT           *string  `json:"oldValueLT,omitempty"`
+	OldValueLTE          *string  `json:"oldValueLTE,omitempty"`
+	OldValueContains     *string  `json:"oldValueContains,omitempty"`
+	OldValueHasPrefix    *string  `json:"oldValueHasPrefix,omitempty"`
+	OldValueHasSuffix    *string  `json:"oldValueHasSuffix,omitempty"`
+	OldValueEqualFold    *string  `json:"oldValueEqualFold,omitempty"`
+	OldValueContainsFold *string  `json:"oldValueContainsFold,omitempty"`
+
+	// "new_value" field predicates.
+	NewValue             *string  `json:"newValue,omitempty"`
+	NewValueNEQ          *string  `json:"newValueNEQ,omitempty"`
+	NewValueIn           []string `json:"newValueIn,omitempty"`
+	NewValueNotIn        []string `json:"newValueNotIn,omitempty"`
+	NewValueGT           *string  `json:"newValueGT,omitempty"`
+	NewValueGTE          *string  `json:"newValueGTE,omitempty"`
+	NewValueLT           *string  `json:"newValueLT,omitempty"`
+	NewValueLTE          *string  `json:"newValueLTE,omitempty"`
+	NewValueContains     *string  `json:"newValueContains,omitempty"`
+	NewValueHasPrefix    *string  `json:"newValueHasPrefix,omitempty"`
+	NewValueHasSuffix    *string  `json:"newValueHasSuffix,omitempty"`
+	NewValueEqualFold    *string  `json:"newValueEqualFold,omitempty"`
+	NewValueContainsFold *string  `json:"newValueContainsFold,omitempty"`
+
+	// "source" field predicates.
+	Source      *rolechange.Source  `json:"source,omitempty"`
+	SourceNEQ   *rolechange.Source  `json:"sourceNEQ,omitempty"`
+	SourceIn    []rolechange.Source `json:"sourceIn,omitempty"`
+	SourceNotIn []rolechange.Source `json:"sourceNotIn,omitempty"`
+
+	// "changed_by_id" field predicates.
+	ChangedByID       *uuid.UUID  `json:"changedByID,omitempty"`
+	ChangedByIDNEQ    *uuid.UUID  `json:"changedByIDNEQ,omitempty"`
+	ChangedByIDIn     []uuid.UUID `json:"changedByIDIn,omitempty"`
+	ChangedByIDNotIn  []uuid.UUID `json:"changedByIDNotIn,omitempty"`
+	ChangedByIDGT     *uuid.UUID  `json:"changedByIDGT,omitempty"`
+	ChangedByIDGTE    *uuid.UUID  `json:"changedByIDGTE,omitempty"`
+	ChangedByIDLT     *uuid.UUID  `json:"changedByIDLT,omitempty"`
+	ChangedByIDLTE    *uuid.UUID  `json:"changedByIDLTE,omitempty"`
+	ChangedByIDIsNil  bool        `json:"changedByIDIsNil,omitempty"`
+	ChangedByIDNotNil bool        `json:"changedByIDNotNil,omitempty"`
+
+	// "user" edge predicates.
+	HasUser     *bool             `json:"hasUser,omitempty"`
+	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *RoleChangeWhereInput) AddPredicates(predicates ...predicate.RoleChange) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the RoleChangeWhereInput filter on the RoleChangeQuery builder.
+func (i *RoleChangeWhereInput) Filter(q *RoleChangeQuery) (*RoleChangeQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyRoleChangeWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyRoleChangeWhereInput is returned in case the RoleChangeWhereInput is empty.
+var ErrEmptyRoleChangeWhereInput = errors.New("generated: empty predicate RoleChangeWhereInput")
+
+// P returns a predicate for filtering rolechanges.
+// An error is returned if the input is empty or invalid.
+func (i *RoleChangeWhereInput) P() (predicate.RoleChange, error) {
+	var predicates []predicate.RoleChange
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, rolechange.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.RoleChange, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, rolechange.Or(or...))
 	}
-	if i.ContentGTE != nil {
-		predicates = append(predicates, post.ContentGTE(*i.ContentGTE))
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.RoleChange, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, rolechange.And(and...))
 	}
-	if i.ContentLT != nil {
-		predicates = append(predicates, post.ContentLT(*i.ContentLT))
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, rolechange.IDEQ(*i.ID))
 	}
-	if i.ContentLTE != nil {
-		predicates = append(predicates, post.ContentLTE(*i.ContentLTE))
+	if i.IDNEQ != nil {
+		predicates = append(predicates, rolechange.IDNEQ(*i.IDNEQ))
 	}
-	if i.ContentContains != nil {
-		predicates = append(predicates, post.ContentContains(*i.ContentContains))
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, rolechange.IDIn(i.IDIn...))
 	}
-	if i.ContentHasPrefix != nil {
-		predicates = append(predicates, post.ContentHasPrefix(*i.ContentHasPrefix))
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, rolechange.IDNotIn(i.IDNotIn...))
 	}
-	if i.ContentHasSuffix != nil {
-		predicates = append(predicates, post.ContentHasSuffix(*i.ContentHasSuffix))
+	if i.IDGT != nil {
+		predicates = append(predicates, rolechange.IDGT(*i.IDGT))
 	}
-	if i.ContentIsNil {
-		predicates = append(predicates, post.ContentIsNil())
+	if i.IDGTE != nil {
+		predicates = append(predicates, rolechange.IDGTE(*i.IDGTE))
 	}
-	if i.ContentNotNil {
-		predicates = append(predicates, post.ContentNotNil())
+	if i.IDLT != nil {
+		predicates = append(predicates, rolechange.IDLT(*i.IDLT))
 	}
-	if i.ContentEqualFold != nil {
-		predicates = append(predicates, post.ContentEqualFold(*i.ContentEqualFold))
+	if i.IDLTE != nil {
+		predicates = append(predicates, rolechange.IDLTE(*i.IDLTE))
 	}
-	if i.ContentContainsFold != nil {
-		predicates = append(predicates, post.ContentContainsFold(*i.ContentContainsFold))
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, rolechange.UpdatedAtEQ(*i.UpdatedAt))
 	}
-	if i.Link != nil {
-		predicates = append(predicates, post.LinkEQ(*i.Link))
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, rolechange.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
-	if i.LinkNEQ != nil {
-		predicates = append(predicates, post.LinkNEQ(*i.LinkNEQ))
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, rolechange.UpdatedAtIn(i.UpdatedAtIn...))
 	}
-	if len(i.LinkIn) > 0 {
-		predicates = append(predicates, post.LinkIn(i.LinkIn...))
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, rolechange.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
-	if len(i.LinkNotIn) > 0 {
-		predicates = append(predicates, post.LinkNotIn(i.LinkNotIn...))
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, rolechange.UpdatedAtGT(*i.UpdatedAtGT))
 	}
-	if i.LinkGT != nil {
-		predicates = append(predicates, post.LinkGT(*i.LinkGT))
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, rolechange.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
-	if i.LinkGTE != nil {
-		predicates = append(predicates, post.LinkGTE(*i.LinkGTE))
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, rolechange.UpdatedAtLT(*i.UpdatedAtLT))
 	}
-	if i.LinkLT != nil {
-		predicates = append(predicates, post.LinkLT(*i.LinkLT))
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, rolechange.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if i.LinkLTE != nil {
-		predicates = append(predicates, post.LinkLTE(*i.LinkLTE))
+	if i.CreatedAt != nil {
+		predicates = append(predicates, rolechange.CreatedAtEQ(*i.CreatedAt))
 	}
-	if i.LinkContains != nil {
-		predicates = append(predicates, post.LinkContains(*i.LinkContains))
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, rolechange.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
-	if i.LinkHasPrefix != nil {
-		predicates = append(predicates, post.LinkHasPrefix(*i.LinkHasPrefix))
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, rolechange.CreatedAtIn(i.CreatedAtIn...))
 	}
-	if i.LinkHasSuffix != nil {
-		predicates = append(predicates, post.LinkHasSuffix(*i.LinkHasSuffix))
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, rolechange.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
-	if i.LinkEqualFold != nil {
-		predicates = append(predicates, post.LinkEqualFold(*i.LinkEqualFold))
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, rolechange.CreatedAtGT(*i.CreatedAtGT))
 	}
-	if i.LinkContainsFold != nil {
-		predicates = append(predicates, post.LinkContainsFold(*i.LinkContainsFold))
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, rolechange.CreatedAtGTE(*i.CreatedAtGTE))
 	}
-	if i.ModerationComment != nil {
-		predicates = append(predicates, post.ModerationCommentEQ(*i.ModerationComment))
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, rolechange.CreatedAtLT(*i.CreatedAtLT))
 	}
-	if i.ModerationCommentNEQ != nil {
-		predicates = append(predicates, post.ModerationCommentNEQ(*i.ModerationCommentNEQ))
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, rolechange.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if len(i.ModerationCommentIn) > 0 {
-		predicates = append(predicates, post.ModerationCommentIn(i.ModerationCommentIn...))
+	if i.UserID != nil {
+		predicates = append(predicates, rolechange.UserIDEQ(*i.UserID))
 	}
-	if len(i.ModerationCommentNotIn) > 0 {
-		predicates = append(predicates, post.ModerationCommentNotIn(i.ModerationCommentNotIn...))
+	if i.UserIDNEQ != nil {
+		predicates = append(predicates, rolechange.UserIDNEQ(*i.UserIDNEQ))
 	}
-	if i.ModerationCommentGT != nil {
-		predicates = append(predicates, post.ModerationCommentGT(*i.ModerationCommentGT))
+	if len(i.UserIDIn) > 0 {
+		predicates = append(predicates, rolechange.UserIDIn(i.UserIDIn...))
 	}
-	if i.ModerationCommentGTE != nil {
-		predicates = append(predicates, post.ModerationCommentGTE(*i.ModerationCommentGTE))
+	if len(i.UserIDNotIn) > 0 {
+		predicates = append(predicates, rolechange.UserIDNotIn(i.UserIDNotIn...))
 	}
-	if i.ModerationCommentLT != nil {
-		predicates = append(predicates, post.ModerationCommentLT(*i.ModerationCommentLT))
+	if i.Attribute != nil {
+		predicates = append(predicates, rolechange.AttributeEQ(*i.Attribute))
 	}
-	if i.ModerationCommentLTE != nil {
-		predicates = append(predicates, post.ModerationCommentLTE(*i.ModerationCommentLTE))
+	if i.AttributeNEQ != nil {
+		predicates = append(predicates, rolechange.AttributeNEQ(*i.AttributeNEQ))
 	}
-	if i.ModerationCommentContains != nil {
-		predicates = append(predicates, post.ModerationCommentContains(*i.ModerationCommentContains))
+	if len(i.AttributeIn) > 0 {
+		predicates = append(predicates, rolechange.AttributeIn(i.AttributeIn...))
 	}
-	if i.ModerationCommentHasPrefix != nil {
-		predicates = append(predicates, post.ModerationCommentHasPrefix(*i.ModerationCommentHasPrefix))
+	if len(i.AttributeNotIn) > 0 {
+		predicates = append(predicates, rolechange.AttributeNotIn(i.AttributeNotIn...))
 	}
-	if i.ModerationCommentHasSuffix != nil {
-		predicates = append(predicates, post.ModerationCommentHasSuffix(*i.ModerationCommentHasSuffix))
+	if i.OldValue != nil {
+		predicates = append(predicates, rolechange.OldValueEQ(*i.OldValue))
 	}
-	if i.ModerationCommentIsNil {
-		predicates = append(predicates, post.ModerationCommentIsNil())
+	if i.OldValueNEQ != nil {
+		predicates = append(predicates, rolechange.OldValueNEQ(*i.OldValueNEQ))
 	}
-	if i.ModerationCommentNotNil {
-		predicates = append(predicates, post.ModerationCommentNotNil())
+	if len(i.OldValueIn) > 0 {
+		predicates = append(predicates, rolechange.OldValueIn(i.OldValueIn...))
 	}
-	if i.ModerationCommentEqualFold != nil {
-		predicates = append(predicates, post.ModerationCommentEqualFold(*i.ModerationCommentEqualFold))
+	if len(i.OldValueNotIn) > 0 {
+		predicates = append(predicates, rolechange.OldValueNotIn(i.OldValueNotIn...))
 	}
-	if i.ModerationCommentContainsFold != nil {
-		predicates = append(predicates, post.ModerationCommentContainsFold(*i.ModerationCommentContainsFold))
+	if i.OldValueGT != nil {
+		predicates = append(predicates, rolechange.OldValueGT(*i.OldValueGT))
 	}
-	if i.IsModerated != nil {
-		predicates = append(predicates, post.IsModeratedEQ(*i.IsModerated))
+	if i.OldValueGTE != nil {
+		predicates = append(predicates, rolechange.OldValueGTE(*i.OldValueGTE))
 	}
-	if i.IsModeratedNEQ != nil {
-		predicates = append(predicates, post.IsModeratedNEQ(*i.IsModeratedNEQ))
+	if i.OldValueLT != nil {
+		predicates = append(predicates, rolechange.OldValueLT(*i.OldValueLT))
 	}
-	if i.ModeratedAt != nil {
-		predicates = append(predicates, post.ModeratedAtEQ(*i.ModeratedAt))
+	if i.OldValueLTE != nil {
+		predicates = append(predicates, rolechange.OldValueLTE(*i.OldValueLTE))
 	}
-	if i.ModeratedAtNEQ != nil {
-		predicates = append(predicates, post.ModeratedAtNEQ(*i.ModeratedAtNEQ))
+	if i.OldValueContains != nil {
+		predicates = append(predicates, rolechange.OldValueContains(*i.OldValueContains))
 	}
-	if len(i.ModeratedAtIn) > 0 {
-		predicates = append(predicates, post.ModeratedAtIn(i.ModeratedAtIn...))
+	if i.OldValueHasPrefix != nil {
+		predicates = append(predicates, rolechange.OldValueHasPrefix(*i.OldValueHasPrefix))
 	}
-	if len(i.ModeratedAtNotIn) > 0 {
-		predicates = append(predicates, post.ModeratedAtNotIn(i.ModeratedAtNotIn...))
+	if i.OldValueHasSuffix != nil {
+		predicates = append(predicates, rolechange.OldValueHasSuffix(*i.OldValueHasSuffix))
 	}
-	if i.ModeratedAtGT != nil {
-		predicates = append(predicates, post.ModeratedAtGT(*i.ModeratedAtGT))
+	if i.OldValueEqualFold != nil {
+		predicates = append(predicates, rolechange.OldValueEqualFold(*i.OldValueEqualFold))
 	}
-	if i.ModeratedAtGTE != nil {
-		predicates = append(predicates, post.ModeratedAtGTE(*i.ModeratedAtGTE))
+	if i.OldValueContainsFold != nil {
+		predicates = append(predicates, rolechange.OldValueContainsFold(*i.OldValueContainsFold))
 	}
-	if i.ModeratedAtLT != nil {
-		predicates = append(predicates, post.ModeratedAtLT(*i.ModeratedAtLT))
+	if i.NewValue != nil {
+		predicates = append(predicates, rolechange.NewValueEQ(*i.NewValue))
 	}
-	if i.ModeratedAtLTE != nil {
-		predicates = append(predicates, post.ModeratedAtLTE(*i.ModeratedAtLTE))
+	if i.NewValueNEQ != nil {
+		predicates = append(predicates, rolechange.NewValueNEQ(*i.NewValueNEQ))
 	}
-	if i.ModeratedAtIsNil {
-		predicates = append(predicates, post.ModeratedAtIsNil())
+	if len(i.NewValueIn) > 0 {
+		predicates = append(predicates, rolechange.NewValueIn(i.NewValueIn...))
 	}
-	if i.ModeratedAtNotNil {
-		predicates = append(predicates, post.ModeratedAtNotNil())
+	if len(i.NewValueNotIn) > 0 {
+		predicates = append(predicates, rolechange.NewValueNotIn(i.NewValueNotIn...))
 	}
-	if i.EntityVector != nil {
-		predicates = append(predicates, post.EntityVectorEQ(*i.EntityVector))
+	if i.NewValueGT != nil {
+		predicates = append(predicates, rolechange.NewValueGT(*i.NewValueGT))
 	}
-	if i.EntityVectorNEQ != nil {
-		predicates = append(predicates, post.EntityVectorNEQ(*i.EntityVectorNEQ))
+	if i.NewValueGTE != nil {
+		predicates = append(predicates, rolechange.NewValueGTE(*i.NewValueGTE))
 	}
-	if len(i.EntityVectorIn) > 0 {
-		predicates = append(predicates, post.EntityVectorIn(i.EntityVectorIn...))
+	if i.NewValueLT != nil {
+		predicates = append(predicates, rolechange.NewValueLT(*i.NewValueLT))
 	}
-	if len(i.EntityVectorNotIn) > 0 {
-		predicates = append(predicates, post.EntityVectorNotIn(i.EntityVectorNotIn...))
+	if i.NewValueLTE != nil {
+		predicates = append(predicates, rolechange.NewValueLTE(*i.NewValueLTE))
 	}
-	if i.EntityVectorGT != nil {
-		predicates = append(predicates, post.EntityVectorGT(*i.EntityVectorGT))
+	if i.NewValueContains != nil {
+		predicates = append(predicates, rolechange.NewValueContains(*i.NewValueContains))
 	}
-	if i.EntityVectorGTE != nil {
-		predicates = append(predicates, post.EntityVectorGTE(*i.EntityVectorGTE))
+	if i.NewValueHasPrefix != nil {
+		predicates = append(predicates, rolechange.NewValueHasPrefix(*i.NewValueHasPrefix))
 	}
-	if i.EntityVectorLT != nil {
-		predicates = append(predicates, post.EntityVectorLT(*i.EntityVectorLT))
+	if i.NewValueHasSuffix != nil {
+		predicates = append(predicates, rolechange.NewValueHasSuffix(*i.NewValueHasSuffix))
 	}
-	if i.EntityVectorLTE != nil {
-		predicates = append(predicates, post.EntityVectorLTE(*i.EntityVectorLTE))
+	if i.NewValueEqualFold != nil {
+		predicates = append(predicates, rolechange.NewValueEqualFold(*i.NewValueEqualFold))
 	}
-	if i.EntityVectorContains != nil {
-		predicates = append(predicates, post.EntityVectorContains(*i.EntityVectorContains))
+	if i.NewValueContainsFold != nil {
+		predicates = append(predicates, rolechange.NewValueContainsFold(*i.NewValueContainsFold))
 	}
-	if i.EntityVectorHasPrefix != nil {
-		predicates = append(predicates, post.EntityVectorHasPrefix(*i.EntityVectorHasPrefix))
+	if i.Source != nil {
+		predicates = append(predicates, rolechange.SourceEQ(*i.Source))
 	}
-	if i.EntityVectorHasSuffix != nil {
-		predicates = append(predicates, post.EntityVectorHasSuffix(*i.EntityVectorHasSuffix))
+	if i.SourceNEQ != nil {
+		predicates = append(predicates, rolechange.SourceNEQ(*i.SourceNEQ))
 	}
-	if i.EntityVectorIsNil {
-		predicates = append(predicates, post.EntityVectorIsNil())
+	if len(i.SourceIn) > 0 {
+		predicates = append(predicates, rolechange.SourceIn(i.SourceIn...))
 	}
-	if i.EntityVectorNotNil {
-		predicates = append(predicates, post.EntityVectorNotNil())
+	if len(i.SourceNotIn) > 0 {
+		predicates = append(predicates, rolechange.SourceNotIn(i.SourceNotIn...))
 	}
-	if i.EntityVectorEqualFold != nil {
-		predicates = append(predicates, post.EntityVectorEqualFold(*i.EntityVectorEqualFold))
+	if i.ChangedByID != nil {
+		predicates = append(predicates, rolechange.ChangedByIDEQ(*i.ChangedByID))
 	}
-	if i.EntityVectorContainsFold != nil {
-		predicates = append(predicates, post.EntityVectorContainsFold(*i.EntityVectorContainsFold))
+	if i.ChangedByIDNEQ != nil {
+		predicates = append(predicates, rolechange.ChangedByIDNEQ(*i.ChangedByIDNEQ))
 	}
-
-	if i.HasOwner != nil {
-		p := post.HasOwner()
-		if !*i.HasOwner {
-			p = post.Not(p)
-		}
-		predicates = append(predicates, p)
+	if len(i.ChangedByIDIn) > 0 {
+		predicates = append(predicates, rolechange.ChangedByIDIn(i.ChangedByIDIn...))
 	}
-	if len(i.HasOwnerWith) > 0 {
-		with := make([]predicate.User, 0, len(i.HasOwnerWith))
-		for _, w := range i.HasOwnerWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasOwnerWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, post.HasOwnerWith(with...))
+	if len(i.ChangedByIDNotIn) > 0 {
+		predicates = append(predicates, rolechange.ChangedByIDNotIn(i.ChangedByIDNotIn...))
 	}
-	if i.HasComments != nil {
-		p := post.HasComments()
-		if !*i.HasComments {
-			p = post.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.ChangedByIDGT != nil {
+		predicates = append(predicates, rolechange.ChangedByIDGT(*i.ChangedByIDGT))
 	}
-	if len(i.HasCommentsWith) > 0 {
-		with := make([]predicate.Comment, 0, len(i.HasCommentsWith))
-		for _, w := range i.HasCommentsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasCommentsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, post.HasCommentsWith(with...))
+	if i.ChangedByIDGTE != nil {
+		predicates = append(predicates, rolechange.ChangedByIDGTE(*i.ChangedByIDGTE))
 	}
-	if i.HasSavedBy != nil {
-		p := post.HasSavedBy()
-		if !*i.HasSavedBy {
-			p = post.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.ChangedByIDLT != nil {
+		predicates = append(predicates, rolechange.ChangedByIDLT(*i.ChangedByIDLT))
 	}
-	if len(i.HasSavedByWith) > 0 {
-		with := make([]predicate.User, 0, len(i.HasSavedByWith))
-		for _, w := range i.HasSavedByWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasSavedByWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, post.HasSavedByWith(with...))
+	if i.ChangedByIDLTE != nil {
+		predicates = append(predicates, rolechange.ChangedByIDLTE(*i.ChangedByIDLTE))
 	}
-	if i.HasLikedBy != nil {
-		p := post.HasLikedBy()
-		if !*i.HasLikedBy {
-			p = post.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.ChangedByIDIsNil {
+		predicates = append(predicates, rolechange.ChangedByIDIsNil())
 	}
-	if len(i.HasLikedByWith) > 0 {
-		with := make([]predicate.User, 0, len(i.HasLikedByWith))
-		for _, w := range i.HasLikedByWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasLikedByWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, post.HasLikedByWith(with...))
+	if i.ChangedByIDNotNil {
+		predicates = append(predicates, rolechange.ChangedByIDNotNil())
 	}
-	if i.HasCategories != nil {
-		p := post.HasCategories()
-		if !*i.HasCategories {
-			p = post.Not(p)
+
+	if i.HasUser != nil {
+		p := rolechange.HasUser()
+		if !*i.HasUser {
+			p = rolechange.Not(p)
 		}
-		predicates = append(predicates, p)
-	}
-	if len(i.HasCategoriesWith) > 0 {
-		with := make([]predicate.PostCategory, 0, len(i.HasCategoriesWith))
-		for _, w := range i.HasCategoriesWith {
+		predicates = append(predicates, p)
+	}
+	if len(i.HasUserWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasUserWith))
+		for _, w := range i.HasUserWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasCategoriesWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasUserWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, post.HasCategoriesWith(with...))
-	}
-
-	if i.IncludeDeletedOnly != nil && *i.IncludeDeletedOnly {
-		predicates = append(predicates, post.DeletedAtNotNil())
+		predicates = append(predicates, rolechange.HasUserWith(with...))
 	}
 
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyPostWhereInput
+		return nil, ErrEmptyRoleChangeWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return post.And(predicates...), nil
+		return rolechange.And(predicates...), nil
 	}
 }
 
-// PostCategoryWhereInput represents a where input for filtering PostCategory queries.
-type PostCategoryWhereInput struct {
-	Predicates []predicate.PostCategory  `json:"-"`
-	Not        *PostCategoryWhereInput   `json:"not,omitempty"`
-	Or         []*PostCategoryWhereInput `json:"or,omitempty"`
-	And        []*PostCategoryWhereInput `json:"and,omitempty"`
+// RolePermissionWhereInput represents a where input for filtering RolePermission queries.
+type RolePermissionWhereInput struct {
+	Predicates []predicate.RolePermission  `json:"-"`
+	Not        *RolePermissionWhereInput   `json:"not,omitempty"`
+	Or         []*RolePermissionWhereInput `json:"or,omitempty"`
+	And        []*RolePermissionWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *uuid.UUID  `json:"id,omitempty"`
@@ -1529,30 +3709,32 @@ type PostCategoryWhereInput struct {
 	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
 	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
 
-	// "category" field predicates.
-	Category      *postcategory.Category  `json:"category,omitempty"`
-	CategoryNEQ   *postcategory.Category  `json:"categoryNEQ,omitempty"`
-	CategoryIn    []postcategory.Category `json:"categoryIn,omitempty"`
-	CategoryNotIn []postcategory.Category `json:"categoryNotIn,omitempty"`
+	// "role" field predicates.
+	Role      *rolepermission.Role  `json:"role,omitempty"`
+	RoleNEQ   *rolepermission.Role  `json:"roleNEQ,omitempty"`
+	RoleIn    []rolepermission.Role `json:"roleIn,omitempty"`
+	RoleNotIn []rolepermission.Role `json:"roleNotIn,omitempty"`
 
-	// "post" edge predicates.
-	HasPost     *bool             `json:"hasPost,omitempty"`
-	HasPostWith []*PostWhereInput `json:"hasPostWith,omitempty"`
+	// "permission" field predicates.
+	Permission      *rolepermission.Permission  `json:"permission,omitempty"`
+	PermissionNEQ   *rolepermission.Permission  `json:"permissionNEQ,omitempty"`
+	PermissionIn    []rolepermission.Permission `json:"permissionIn,omitempty"`
+	PermissionNotIn []rolepermission.Permission `json:"permissionNotIn,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *PostCategoryWhereInput) AddPredicates(predicates ...predicate.PostCategory) {
+func (i *RolePermissionWhereInput) AddPredicates(predicates ...predicate.RolePermission) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the PostCategoryWhereInput filter on the PostCategoryQuery builder.
-func (i *PostCategoryWhereInput) Filter(q *PostCategoryQuery) (*PostCategoryQuery, error) {
+// Filter applies the RolePermissionWhereInput filter on the RolePermissionQuery builder.
+func (i *RolePermissionWhereInput) Filter(q *RolePermissionQuery) (*RolePermissionQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyPostCategoryWhereInput {
+		if err == ErrEmptyRolePermissionWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -1560,19 +3742,19 @@ func (i *PostCategoryWhereInput) Filter(q *PostCategoryQuery) (*PostCategoryQuer
 	return q.Where(p), nil
 }
 
-// ErrEmptyPostCategoryWhereInput is returned in case the PostCategoryWhereInput is empty.
-var ErrEmptyPostCategoryWhereInput = errors.New("generated: empty predicate PostCategoryWhereInput")
+// ErrEmptyRolePermissionWhereInput is returned in case the RolePermissionWhereInput is empty.
+var ErrEmptyRolePermissionWhereInput = errors.New("generated: empty predicate RolePermissionWhereInput")
 
-// P returns a predicate for filtering postcategories.
+// P returns a predicate for filtering rolepermissions.
 // An error is returned if the input is empty or invalid.
-func (i *PostCategoryWhereInput) P() (predicate.PostCategory, error) {
-	var predicates []predicate.PostCategory
+func (i *RolePermissionWhereInput) P() (predicate.RolePermission, error) {
+	var predicates []predicate.RolePermission
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, postcategory.Not(p))
+		predicates = append(predicates, rolepermission.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -1582,7 +3764,7 @@ func (i *PostCategoryWhereInput) P() (predicate.PostCategory, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.PostCategory, 0, n)
+		or := make([]predicate.RolePermission, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -1590,7 +3772,7 @@ func (i *PostCategoryWhereInput) P() (predicate.PostCategory, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, postcategory.Or(or...))
+		predicates = append(predicates, rolepermission.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -1600,7 +3782,7 @@ func (i *PostCategoryWhereInput) P() (predicate.PostCategory, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.PostCategory, 0, n)
+		and := make([]predicate.RolePermission, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -1608,129 +3790,122 @@ func (i *PostCategoryWhereInput) P() (predicate.PostCategory, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, postcategory.And(and...))
+		predicates = append(predicates, rolepermission.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, postcategory.IDEQ(*i.ID))
+		predicates = append(predicates, rolepermission.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, postcategory.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, rolepermission.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, postcategory.IDIn(i.IDIn...))
+		predicates = append(predicates, rolepermission.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, postcategory.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, rolepermission.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, postcategory.IDGT(*i.IDGT))
+		predicates = append(predicates, rolepermission.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, postcategory.IDGTE(*i.IDGTE))
+		predicates = append(predicates, rolepermission.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, postcategory.IDLT(*i.IDLT))
+		predicates = append(predicates, rolepermission.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, postcategory.IDLTE(*i.IDLTE))
+		predicates = append(predicates, rolepermission.IDLTE(*i.IDLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, postcategory.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, rolepermission.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, postcategory.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, rolepermission.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, postcategory.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, rolepermission.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, postcategory.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, rolepermission.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, postcategory.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, rolepermission.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, postcategory.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, rolepermission.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, postcategory.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, rolepermission.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, postcategory.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, rolepermission.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, postcategory.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, rolepermission.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, postcategory.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, rolepermission.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, postcategory.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, rolepermission.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, postcategory.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, rolepermission.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, postcategory.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, rolepermission.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, postcategory.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, rolepermission.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, postcategory.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, rolepermission.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, postcategory.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, rolepermission.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if i.Category != nil {
-		predicates = append(predicates, postcategory.CategoryEQ(*i.Category))
+	if i.Role != nil {
+		predicates = append(predicates, rolepermission.RoleEQ(*i.Role))
 	}
-	if i.CategoryNEQ != nil {
-		predicates = append(predicates, postcategory.CategoryNEQ(*i.CategoryNEQ))
+	if i.RoleNEQ != nil {
+		predicates = append(predicates, rolepermission.RoleNEQ(*i.RoleNEQ))
 	}
-	if len(i.CategoryIn) > 0 {
-		predicates = append(predicates, postcategory.CategoryIn(i.CategoryIn...))
+	if len(i.RoleIn) > 0 {
+		predicates = append(predicates, rolepermission.RoleIn(i.RoleIn...))
 	}
-	if len(i.CategoryNotIn) > 0 {
-		predicates = append(predicates, postcategory.CategoryNotIn(i.CategoryNotIn...))
+	if len(i.RoleNotIn) > 0 {
+		predicates = append(predicates, rolepermission.RoleNotIn(i.RoleNotIn...))
 	}
-
-	if i.HasPost != nil {
-		p := postcategory.HasPost()
-		if !*i.HasPost {
-			p = postcategory.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.Permission != nil {
+		predicates = append(predicates, rolepermission.PermissionEQ(*i.Permission))
 	}
-	if len(i.HasPostWith) > 0 {
-		with := make([]predicate.Post, 0, len(i.HasPostWith))
-		for _, w := range i.HasPostWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasPostWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, postcategory.HasPostWith(with...))
+	if i.PermissionNEQ != nil {
+		predicates = append(predicates, rolepermission.PermissionNEQ(*i.PermissionNEQ))
+	}
+	if len(i.PermissionIn) > 0 {
+		predicates = append(predicates, rolepermission.PermissionIn(i.PermissionIn...))
+	}
+	if len(i.PermissionNotIn) > 0 {
+		predicates = append(predicates, rolepermission.PermissionNotIn(i.PermissionNotIn...))
 	}
 
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyPostCategoryWhereInput
+		return nil, ErrEmptyRolePermissionWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return postcategory.And(predicates...), nil
+		return rolepermission.And(predicates...), nil
 	}
 }
 
-// RefreshTokenWhereInput represents a where input for filtering RefreshToken queries.
-type RefreshTokenWhereInput struct {
-	Predicates []predicate.RefreshToken  `json:"-"`
-	Not        *RefreshTokenWhereInput   `json:"not,omitempty"`
-	Or         []*RefreshTokenWhereInput `json:"or,omitempty"`
-	And        []*RefreshTokenWhereInput `json:"and,omitempty"`
+// SuspensionWhereInput represents a where input for filtering Suspension queries.
+type SuspensionWhereInput struct {
+	Predicates []predicate.Suspension  `json:"-"`
+	Not        *SuspensionWhereInput   `json:"not,omitempty"`
+	Or         []*SuspensionWhereInput `json:"or,omitempty"`
+	And        []*SuspensionWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *uuid.UUID  `json:"id,omitempty"`
@@ -1762,72 +3937,85 @@ type RefreshTokenWhereInput struct {
 	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
 	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
 
-	// "expires_at" field predicates.
-	ExpiresAt      *time.Time  `json:"expiresAt,omitempty"`
-	ExpiresAtNEQ   *time.Time  `json:"expiresAtNEQ,omitempty"`
-	ExpiresAtIn    []time.Time `json:"expiresAtIn,omitempty"`
-	ExpiresAtNotIn []time.Time `json:"expiresAtNotIn,omitempty"`
-	ExpiresAtGT    *time.Time  `json:"expiresAtGT,omitempty"`
-	ExpiresAtGTE   *time.Time  `json:"expiresAtGTE,omitempty"`
-	ExpiresAtLT    *time.Time  `json:"expiresAtLT,omitempty"`
-	ExpiresAtLTE   *time.Time  `json:"expiresAtLTE,omitempty"`
+	// "user_id" field predicates.
+	UserID      *uuid.UUID  `json:"userID,omitempty"`
+	UserIDNEQ   *uuid.UUID  `json:"userIDNEQ,omitempty"`
+	UserIDIn    []uuid.UUID `json:"userIDIn,omitempty"`
+	UserIDNotIn []uuid.UUID `json:"userIDNotIn,omitempty"`
 
-	// "revoked" field predicates.
-	Revoked    *bool `json:"revoked,omitempty"`
-	RevokedNEQ *bool `json:"revokedNEQ,omitempty"`
+	// "issuer_id" field predicates.
+	IssuerID       *uuid.UUID  `json:"issuerID,omitempty"`
+	IssuerIDNEQ    *uuid.UUID  `json:"issuerIDNEQ,omitempty"`
+	IssuerIDIn     []uuid.UUID `json:"issuerIDIn,omitempty"`
+	IssuerIDNotIn  []uuid.UUID `json:"issuerIDNotIn,omitempty"`
+	IssuerIDIsNil  bool        `json:"issuerIDIsNil,omitempty"`
+	IssuerIDNotNil bool        `json:"issuerIDNotNil,omitempty"`
 
-	// "ip_address" field predicates.
-	IPAddress             *string  `json:"ipAddress,omitempty"`
-	IPAddressNEQ          *string  `json:"ipAddressNEQ,omitempty"`
-	IPAddressIn           []string `json:"ipAddressIn,omitempty"`
-	IPAddressNotIn        []string `json:"ipAddressNotIn,omitempty"`
-	IPAddressGT           *string  `json:"ipAddressGT,omitempty"`
-	IPAddressGTE          *string  `json:"ipAddressGTE,omitempty"`
-	IPAddressLT           *string  `json:"ipAddressLT,omitempty"`
-	IPAddressLTE          *string  `json:"ipAddressLTE,omitempty"`
-	IPAddressContains     *string  `json:"ipAddressContains,omitempty"`
-	IPAddressHasPrefix    *string  `json:"ipAddressHasPrefix,omitempty"`
-	IPAddressHasSuffix    *string  `json:"ipAddressHasSuffix,omitempty"`
-	IPAddressIsNil        bool     `json:"ipAddressIsNil,omitempty"`
-	IPAddressNotNil       bool     `json:"ipAddressNotNil,omitempty"`
-	IPAddressEqualFold    *string  `json:"ipAddressEqualFold,omitempty"`
-	IPAddressContainsFold *string  `json:"ipAddressContainsFold,omitempty"`
+	// "reason" field predicates.
+	Reason             *string  `json:"reason,omitempty"`
+	ReasonNEQ          *string  `json:"reasonNEQ,omitempty"`
+	ReasonIn           []string `json:"reasonIn,omitempty"`
+	ReasonNotIn        []string `json:"reasonNotIn,omitempty"`
+	ReasonGT           *string  `json:"reasonGT,omitempty"`
+	ReasonGTE          *string  `json:"reasonGTE,omitempty"`
+	ReasonLT           *string  `json:"reasonLT,omitempty"`
+	ReasonLTE          *string  `json:"reasonLTE,omitempty"`
+	ReasonContains     *string  `json:"reasonContains,omitempty"`
+	ReasonHasPrefix    *string  `json:"reasonHasPrefix,omitempty"`
+	ReasonHasSuffix    *string  `json:"reasonHasSuffix,omitempty"`
+	ReasonEqualFold    *string  `json:"reasonEqualFold,omitempty"`
+	ReasonContainsFold *string  `json:"reasonContainsFold,omitempty"`
 
-	// "user_agent" field predicates.
-	UserAgent             *string  `json:"userAgent,omitempty"`
-	UserAgentNEQ          *string  `json:"userAgentNEQ,omitempty"`
-	UserAgentIn           []string `json:"userAgentIn,omitempty"`
-	UserAgentNotIn        []string `json:"userAgentNotIn,omitempty"`
-	UserAgentGT           *string  `json:"userAgentGT,omitempty"`
-	UserAgentGTE          *string  `json:"userAgentGTE,omitempty"`
-	UserAgentLT           *string  `json:"userAgentLT,omitempty"`
-	UserAgentLTE          *string  `json:"userAgentLTE,omitempty"`
-	UserAgentContains     *string  `json:"userAgentContains,omitempty"`
-	UserAgentHasPrefix    *string  `json:"userAgentHasPrefix,omitempty"`
-	UserAgentHasSuffix    *string  `json:"userAgentHasSuffix,omitempty"`
-	UserAgentIsNil        bool     `json:"userAgentIsNil,omitempty"`
-	UserAgentNotNil       bool     `json:"userAgentNotNil,omitempty"`
-	UserAgentEqualFold    *string  `json:"userAgentEqualFold,omitempty"`
-	UserAgentContainsFold *string  `json:"userAgentContainsFold,omitempty"`
+	// "scope" field predicates.
+	Scope      *suspension.Scope  `json:"scope,omitempty"`
+	ScopeNEQ   *suspension.Scope  `json:"scopeNEQ,omitempty"`
+	ScopeIn    []suspension.Scope `json:"scopeIn,omitempty"`
+	ScopeNotIn []suspension.Scope `json:"scopeNotIn,omitempty"`
 
-	// "owner" edge predicates.
-	HasOwner     *bool             `json:"hasOwner,omitempty"`
-	HasOwnerWith []*UserWhereInput `json:"hasOwnerWith,omitempty"`
+	// "starts_at" field predicates.
+	StartsAt      *time.Time  `json:"startsAt,omitempty"`
+	StartsAtNEQ   *time.Time  `json:"startsAtNEQ,omitempty"`
+	StartsAtIn    []time.Time `json:"startsAtIn,omitempty"`
+	StartsAtNotIn []time.Time `json:"startsAtNotIn,omitempty"`
+	StartsAtGT    *time.Time  `json:"startsAtGT,omitempty"`
+	StartsAtGTE   *time.Time  `json:"startsAtGTE,omitempty"`
+	StartsAtLT    *time.Time  `json:"startsAtLT,omitempty"`
+	StartsAtLTE   *time.Time  `json:"startsAtLTE,omitempty"`
+
+	// "ends_at" field predicates.
+	EndsAt       *time.Time  `json:"endsAt,omitempty"`
+	EndsAtNEQ    *time.Time  `json:"endsAtNEQ,omitempty"`
+	EndsAtIn     []time.Time `json:"endsAtIn,omitempty"`
+	EndsAtNotIn  []time.Time `json:"endsAtNotIn,omitempty"`
+	EndsAtGT     *time.Time  `json:"endsAtGT,omitempty"`
+	EndsAtGTE    *time.Time  `json:"endsAtGTE,omitempty"`
+	EndsAtLT     *time.Time  `json:"endsAtLT,omitempty"`
+	EndsAtLTE    *time.Time  `json:"endsAtLTE,omitempty"`
+	EndsAtIsNil  bool        `json:"endsAtIsNil,omitempty"`
+	EndsAtNotNil bool        `json:"endsAtNotNil,omitempty"`
+
+	// "user" edge predicates.
+	HasUser     *bool             `json:"hasUser,omitempty"`
+	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
+
+	// "issuer" edge predicates.
+	HasIssuer     *bool             `json:"hasIssuer,omitempty"`
+	HasIssuerWith []*UserWhereInput `json:"hasIssuerWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *RefreshTokenWhereInput) AddPredicates(predicates ...predicate.RefreshToken) {
+func (i *SuspensionWhereInput) AddPredicates(predicates ...predicate.Suspension) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the RefreshTokenWhereInput filter on the RefreshTokenQuery builder.
-func (i *RefreshTokenWhereInput) Filter(q *RefreshTokenQuery) (*RefreshTokenQuery, error) {
+// Filter applies the SuspensionWhereInput filter on the SuspensionQuery builder.
+func (i *SuspensionWhereInput) Filter(q *SuspensionQuery) (*SuspensionQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyRefreshTokenWhereInput {
+		if err == ErrEmptySuspensionWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -1835,19 +4023,19 @@ func (i *RefreshTokenWhereInput) Filter(q *RefreshTokenQuery) (*RefreshTokenQuer
 	return q.Where(p), nil
 }
 
-// ErrEmptyRefreshTokenWhereInput is returned in case the RefreshTokenWhereInput is empty.
-var ErrEmptyRefreshTokenWhereInput = errors.New("generated: empty predicate RefreshTokenWhereInput")
+// ErrEmptySuspensionWhereInput is returned in case the SuspensionWhereInput is empty.
+var ErrEmptySuspensionWhereInput = errors.New("generated: empty predicate SuspensionWhereInput")
 
-// P returns a predicate for filtering refreshtokens.
+// P returns a predicate for filtering suspensions.
 // An error is returned if the input is empty or invalid.
-func (i *RefreshTokenWhereInput) P() (predicate.RefreshToken, error) {
-	var predicates []predicate.RefreshToken
+func (i *SuspensionWhereInput) P() (predicate.Suspension, error) {
+	var predicates []predicate.Suspension
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, refreshtoken.Not(p))
+		predicates = append(predicates, suspension.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -1857,7 +4045,7 @@ func (i *RefreshTokenWhereInput) P() (predicate.RefreshToken, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.RefreshToken, 0, n)
+		or := make([]predicate.Suspension, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -1865,7 +4053,7 @@ func (i *RefreshTokenWhereInput) P() (predicate.RefreshToken, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, refreshtoken.Or(or...))
+		predicates = append(predicates, suspension.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -1875,7 +4063,7 @@ func (i *RefreshTokenWhereInput) P() (predicate.RefreshToken, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.RefreshToken, 0, n)
+		and := make([]predicate.Suspension, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -1883,228 +4071,261 @@ func (i *RefreshTokenWhereInput) P() (predicate.RefreshToken, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, refreshtoken.And(and...))
+		predicates = append(predicates, suspension.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, refreshtoken.IDEQ(*i.ID))
+		predicates = append(predicates, suspension.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, refreshtoken.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, suspension.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, refreshtoken.IDIn(i.IDIn...))
+		predicates = append(predicates, suspension.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, refreshtoken.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, suspension.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, refreshtoken.IDGT(*i.IDGT))
+		predicates = append(predicates, suspension.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, refreshtoken.IDGTE(*i.IDGTE))
+		predicates = append(predicates, suspension.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, refreshtoken.IDLT(*i.IDLT))
+		predicates = append(predicates, suspension.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, refreshtoken.IDLTE(*i.IDLTE))
+		predicates = append(predicates, suspension.IDLTE(*i.IDLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, refreshtoken.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, suspension.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, refreshtoken.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, suspension.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, refreshtoken.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, suspension.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, refreshtoken.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, suspension.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, refreshtoken.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, suspension.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, refreshtoken.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, suspension.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, refreshtoken.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, suspension.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, refreshtoken.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, suspension.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, refreshtoken.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, suspension.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, refreshtoken.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, suspension.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, refreshtoken.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, suspension.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, refreshtoken.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, suspension.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, refreshtoken.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, suspension.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, refreshtoken.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, suspension.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, refreshtoken.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, suspension.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, refreshtoken.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, suspension.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if i.ExpiresAt != nil {
-		predicates = append(predicates, refreshtoken.ExpiresAtEQ(*i.ExpiresAt))
+	if i.UserID != nil {
+		predicates = append(predicates, suspension.UserIDEQ(*i.UserID))
 	}
-	if i.ExpiresAtNEQ != nil {
-		predicates = append(predicates, refreshtoken.ExpiresAtNEQ(*i.ExpiresAtNEQ))
+	if i.UserIDNEQ != nil {
+		predicates = append(predicates, suspension.UserIDNEQ(*i.UserIDNEQ))
 	}
-	if len(i.ExpiresAtIn) > 0 {
-		predicates = append(predicates, refreshtoken.ExpiresAtIn(i.ExpiresAtIn...))
+	if len(i.UserIDIn) > 0 {
+		predicates = append(predicates, suspension.UserIDIn(i.UserIDIn...))
 	}
-	if len(i.ExpiresAtNotIn) > 0 {
-		predicates = append(predicates, refreshtoken.ExpiresAtNotIn(i.ExpiresAtNotIn...))
+	if len(i.UserIDNotIn) > 0 {
+		predicates = append(predicates, suspension.UserIDNotIn(i.UserIDNotIn...))
 	}
-	if i.ExpiresAtGT != nil {
-		predicates = append(predicates, refreshtoken.ExpiresAtGT(*i.ExpiresAtGT))
+	if i.IssuerID != nil {
+		predicates = append(predicates, suspension.IssuerIDEQ(*i.IssuerID))
 	}
-	if i.ExpiresAtGTE != nil {
-		predicates = append(predicates, refreshtoken.ExpiresAtGTE(*i.ExpiresAtGTE))
+	if i.IssuerIDNEQ != nil {
+		predicates = append(predicates, suspension.IssuerIDNEQ(*i.IssuerIDNEQ))
 	}
-	if i.ExpiresAtLT != nil {
-		predicates = append(predicates, refreshtoken.ExpiresAtLT(*i.ExpiresAtLT))
+	if len(i.IssuerIDIn) > 0 {
+		predicates = append(predicates, suspension.IssuerIDIn(i.IssuerIDIn...))
 	}
-	if i.ExpiresAtLTE != nil {
-		predicates = append(predicates, refreshtoken.ExpiresAtLTE(*i.ExpiresAtLTE))
+	if len(i.IssuerIDNotIn) > 0 {
+		predicates = append(predicates, suspension.IssuerIDNotIn(i.IssuerIDNotIn...))
 	}
-	if i.Revoked != nil {
-		predicates = append(predicates, refreshtoken.RevokedEQ(*i.Revoked))
+	if i.IssuerIDIsNil {
+		predicates = append(predicates, suspension.IssuerIDIsNil())
 	}
-	if i.RevokedNEQ != nil {
-		predicates = append(predicates, refreshtoken.RevokedNEQ(*i.RevokedNEQ))
+	if i.IssuerIDNotNil {
+		predicates = append(predicates, suspension.IssuerIDNotNil())
 	}
-	if i.IPAddress != nil {
-		predicates = append(predicates, refreshtoken.IPAddressEQ(*i.IPAddress))
+	if i.Reason != nil {
+		predicates = append(predicates, suspension.ReasonEQ(*i.Reason))
 	}
-	if i.IPAddressNEQ != nil {
-		predicates = append(predicates, refreshtoken.IPAddressNEQ(*i.IPAddressNEQ))
+	if i.ReasonNEQ != nil {
+		predicates = append(predicates, suspension.ReasonNEQ(*i.ReasonNEQ))
 	}
-	if len(i.IPAddressIn) > 0 {
-		predicates = append(predicates, refreshtoken.IPAddressIn(i.IPAddressIn...))
+	if len(i.ReasonIn) > 0 {
+		predicates = append(predicates, suspension.ReasonIn(i.ReasonIn...))
 	}
-	if len(i.IPAddressNotIn) > 0 {
-		predicates = append(predicates, refreshtoken.IPAddressNotIn(i.IPAddressNotIn...))
+	if len(i.ReasonNotIn) > 0 {
+		predicates = append(predicates, suspension.ReasonNotIn(i.ReasonNotIn...))
 	}
-	if i.IPAddressGT != nil {
-		predicates = append(predicates, refreshtoken.IPAddressGT(*i.IPAddressGT))
+	if i.ReasonGT != nil {
+		predicates = append(predicates, suspension.ReasonGT(*i.ReasonGT))
 	}
-	if i.IPAddressGTE != nil {
-		predicates = append(predicates, refreshtoken.IPAddressGTE(*i.IPAddressGTE))
+	if i.ReasonGTE != nil {
+		predicates = append(predicates, suspension.ReasonGTE(*i.ReasonGTE))
 	}
-	if i.IPAddressLT != nil {
-		predicates = append(predicates, refreshtoken.IPAddressLT(*i.IPAddressLT))
+	if i.ReasonLT != nil {
+		predicates = append(predicates, suspension.ReasonLT(*i.ReasonLT))
 	}
-	if i.IPAddressLTE != nil {
-		predicates = append(predicates, refreshtoken.IPAddressLTE(*i.IPAddressLTE))
+	if i.ReasonLTE != nil {
+		predicates = append(predicates, suspension.ReasonLTE(*i.ReasonLTE))
 	}
-	if i.IPAddressContains != nil {
-		predicates = append(predicates, refreshtoken.IPAddressContains(*i.IPAddressContains))
+	if i.ReasonContains != nil {
+		predicates = append(predicates, suspension.ReasonContains(*i.ReasonContains))
 	}
-	if i.IPAddressHasPrefix != nil {
-		predicates = append(predicates, refreshtoken.IPAddressHasPrefix(*i.IPAddressHasPrefix))
+	if i.ReasonHasPrefix != nil {
+		predicates = append(predicates, suspension.ReasonHasPrefix(*i.ReasonHasPrefix))
 	}
-	if i.IPAddressHasSuffix != nil {
-		predicates = append(predicates, refreshtoken.IPAddressHasSuffix(*i.IPAddressHasSuffix))
+	if i.ReasonHasSuffix != nil {
+		predicates = append(predicates, suspension.ReasonHasSuffix(*i.ReasonHasSuffix))
 	}
-	if i.IPAddressIsNil {
-		predicates = append(predicates, refreshtoken.IPAddressIsNil())
+	if i.ReasonEqualFold != nil {
+		predicates = append(predicates, suspension.ReasonEqualFold(*i.ReasonEqualFold))
 	}
-	if i.IPAddressNotNil {
-		predicates = append(predicates, refreshtoken.IPAddressNotNil())
+	if i.ReasonContainsFold != nil {
+		predicates = append(predicates, suspension.ReasonContainsFold(*i.ReasonContainsFold))
 	}
-	if i.IPAddressEqualFold != nil {
-		predicates = append(predicates, refreshtoken.IPAddressEqualFold(*i.IPAddressEqualFold))
+	if i.Scope != nil {
+		predicates = append(predicates, suspension.ScopeEQ(*i.Scope))
 	}
-	if i.IPAddressContainsFold != nil {
-		predicates = append(predicates, refreshtoken.IPAddressContainsFold(*i.IPAddressContainsFold))
+	if i.ScopeNEQ != nil {
+		predicates = append(predicates, suspension.ScopeNEQ(*i.ScopeNEQ))
 	}
-	if i.UserAgent != nil {
-		predicates = append(predicates, refreshtoken.UserAgentEQ(*i.UserAgent))
+	if len(i.ScopeIn) > 0 {
+		predicates = append(predicates, suspension.ScopeIn(i.ScopeIn...))
 	}
-	if i.UserAgentNEQ != nil {
-		predicates = append(predicates, refreshtoken.UserAgentNEQ(*i.UserAgentNEQ))
+	if len(i.ScopeNotIn) > 0 {
+		predicates = append(predicates, suspension.ScopeNotIn(i.ScopeNotIn...))
 	}
-	if len(i.UserAgentIn) > 0 {
-		predicates = append(predicates, refreshtoken.UserAgentIn(i.UserAgentIn...))
+	if i.StartsAt != nil {
+		predicates = append(predicates, suspension.StartsAtEQ(*i.StartsAt))
 	}
-	if len(i.UserAgentNotIn) > 0 {
-		predicates = append(predicates, refreshtoken.UserAgentNotIn(i.UserAgentNotIn...))
+	if i.StartsAtNEQ != nil {
+		predicates = append(predicates, suspension.StartsAtNEQ(*i.StartsAtNEQ))
 	}
-	if i.UserAgentGT != nil {
-		predicates = append(predicates, refreshtoken.UserAgentGT(*i.UserAgentGT))
+	if len(i.StartsAtIn) > 0 {
+		predicates = append(predicates, suspension.StartsAtIn(i.StartsAtIn...))
 	}
-	if i.UserAgentGTE != nil {
-		predicates = append(predicates, refreshtoken.UserAgentGTE(*i.UserAgentGTE))
+	if len(i.StartsAtNotIn) > 0 {
+		predicates = append(predicates, suspension.StartsAtNotIn(i.StartsAtNotIn...))
+	}
+	if i.StartsAtGT != nil {
+		predicates = append(predicates, suspension.StartsAtGT(*i.StartsAtGT))
+	}
+	if i.StartsAtGTE != nil {
+		predicates = append(predicates, suspension.StartsAtGTE(*i.StartsAtGTE))
+	}
+	if i.StartsAtLT != nil {
+		predicates = append(predicates, suspension.StartsAtLT(*i.StartsAtLT))
+	}
+	if i.StartsAtLTE != nil {
+		predicates = append(predicates, suspension.StartsAtLTE(*i.StartsAtLTE))
 	}
-	if i.UserAgentLT != nil {
-		predicates = append(predicates, refreshtoken.UserAgentLT(*i.UserAgentLT))
+	if i.EndsAt != nil {
+		predicates = append(predicates, suspension.EndsAtEQ(*i.EndsAt))
 	}
-	if i.UserAgentLTE != nil {
-		predicates = append(predicates, refreshtoken.UserAgentLTE(*i.UserAgentLTE))
+	if i.EndsAtNEQ != nil {
+		predicates = append(predicates, suspension.EndsAtNEQ(*i.EndsAtNEQ))
 	}
-	if i.UserAgentContains != nil {
-		predicates = append(predicates, refreshtoken.UserAgentContains(*i.UserAgentContains))
+	if len(i.EndsAtIn) > 0 {
+		predicates = append(predicates, suspension.EndsAtIn(i.EndsAtIn...))
 	}
-	if i.UserAgentHasPrefix != nil {
-		predicates = append(predicates, refreshtoken.UserAgentHasPrefix(*i.UserAgentHasPrefix))
+	if len(i.EndsAtNotIn) > 0 {
+		predicates = append(predicates, suspension.EndsAtNotIn(i.EndsAtNotIn...))
 	}
-	if i.UserAgentHasSuffix != nil {
-		predicates = append(predicates, refreshtoken.UserAgentHasSuffix(*i.UserAgentHasSuffix))
+	if i.EndsAtGT != nil {
+		predicates = append(predicates, suspension.EndsAtGT(*i.EndsAtGT))
 	}
-	if i.UserAgentIsNil {
-		predicates = append(predicates, refreshtoken.UserAgentIsNil())
+	if i.EndsAtGTE != nil {
+		predicates = append(predicates, suspension.EndsAtGTE(*i.EndsAtGTE))
 	}
-	if i.UserAgentNotNil {
-		predicates = append(predicates, refreshtoken.UserAgentNotNil())
+	if i.EndsAtLT != nil {
+		predicates = append(predicates, suspension.EndsAtLT(*i.EndsAtLT))
 	}
-	if i.UserAgentEqualFold != nil {
-		predicates = append(predicates, refreshtoken.UserAgentEqualFold(*i.UserAgentEqualFold))
+	if i.EndsAtLTE != nil {
+		predicates = append(predicates, suspension.EndsAtLTE(*i.EndsAtLTE))
 	}
-	if i.UserAgentContainsFold != nil {
-		predicates = append(predicates, refreshtoken.UserAgentContainsFold(*i.UserAgentContainsFold))
+	if i.EndsAtIsNil {
+		predicates = append(predicates, suspension.EndsAtIsNil())
+	}
+	if i.EndsAtNotNil {
+		predicates = append(predicates, suspension.EndsAtNotNil())
 	}
 
-	if i.HasOwner != nil {
-		p := refreshtoken.HasOwner()
-		if !*i.HasOwner {
-			p = refreshtoken.Not(p)
+	if i.HasUser != nil {
+		p := suspension.HasUser()
+		if !*i.HasUser {
+			p = suspension.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasOwnerWith) > 0 {
-		with := make([]predicate.User, 0, len(i.HasOwnerWith))
-		for _, w := range i.HasOwnerWith {
+	if len(i.HasUserWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasUserWith))
+		for _, w := range i.HasUserWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasOwnerWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasUserWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, refreshtoken.HasOwnerWith(with...))
+		predicates = append(predicates, suspension.HasUserWith(with...))
+	}
+	if i.HasIssuer != nil {
+		p := suspension.HasIssuer()
+		if !*i.HasIssuer {
+			p = suspension.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasIssuerWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasIssuerWith))
+		for _, w := range i.HasIssuerWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasIssuerWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, suspension.HasIssuerWith(with...))
 	}
 
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyRefreshTokenWhereInput
+		return nil, ErrEmptySuspensionWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return refreshtoken.And(predicates...), nil
+		return suspension.And(predicates...), nil
 	}
 }
 
@@ -2235,6 +4456,18 @@ type UserWhereInput struct {
 	RoleIn    []user.Role `json:"roleIn,omitempty"`
 	RoleNotIn []user.Role `json:"roleNotIn,omitempty"`
 
+	// "role_source" field predicates.
+	RoleSource       *user.RoleSource  `json:"roleSource,omitempty"`
+	RoleSourceNEQ    *user.RoleSource  `json:"roleSourceNEQ,omitempty"`
+	RoleSourceIn     []user.RoleSource `json:"roleSourceIn,omitempty"`
+	RoleSourceNotIn  []user.RoleSource `json:"roleSourceNotIn,omitempty"`
+	RoleSourceIsNil  bool              `json:"roleSourceIsNil,omitempty"`
+	RoleSourceNotNil bool              `json:"roleSourceNotNil,omitempty"`
+
+	// "twitch_vip" field predicates.
+	TwitchVip    *bool `json:"twitchVip,omitempty"`
+	TwitchVipNEQ *bool `json:"twitchVipNEQ,omitempty"`
+
 	// "last_seen_at" field predicates.
 	LastSeenAt       *time.Time  `json:"lastSeenAt,omitempty"`
 	LastSeenAtNEQ    *time.Time  `json:"lastSeenAtNEQ,omitempty"`
@@ -2283,6 +4516,14 @@ type UserWhereInput struct {
 	// "api_keys" edge predicates.
 	HasAPIKeys     *bool               `json:"hasAPIKeys,omitempty"`
 	HasAPIKeysWith []*ApiKeyWhereInput `json:"hasAPIKeysWith,omitempty"`
+
+	// "awards" edge predicates.
+	HasAwards     *bool                  `json:"hasAwards,omitempty"`
+	HasAwardsWith []*UserAwardWhereInput `json:"hasAwardsWith,omitempty"`
+
+	// "suspensions" edge predicates.
+	HasSuspensions     *bool                   `json:"hasSuspensions,omitempty"`
+	HasSuspensionsWith []*SuspensionWhereInput `json:"hasSuspensionsWith,omitempty"`
 	// Deleted record filter options.
 	IncludeDeleted     *bool `json:"includeDeleted,omitempty"`
 	IncludeDeletedOnly *bool `json:"includeDeletedOnly,omitempty"`
@@ -2659,6 +4900,30 @@ func (i *UserWhereInput) P() (predicate.User, error) {
 	if len(i.RoleNotIn) > 0 {
 		predicates = append(predicates, user.RoleNotIn(i.RoleNotIn...))
 	}
+	if i.RoleSource != nil {
+		predicates = append(predicates, user.RoleSourceEQ(*i.RoleSource))
+	}
+	if i.RoleSourceNEQ != nil {
+		predicates = append(predicates, user.RoleSourceNEQ(*i.RoleSourceNEQ))
+	}
+	if len(i.RoleSourceIn) > 0 {
+		predicates = append(predicates, user.RoleSourceIn(i.RoleSourceIn...))
+	}
+	if len(i.RoleSourceNotIn) > 0 {
+		predicates = append(predicates, user.RoleSourceNotIn(i.RoleSourceNotIn...))
+	}
+	if i.RoleSourceIsNil {
+		predicates = append(predicates, user.RoleSourceIsNil())
+	}
+	if i.RoleSourceNotNil {
+		predicates = append(predicates, user.RoleSourceNotNil())
+	}
+	if i.TwitchVip != nil {
+		predicates = append(predicates, user.TwitchVipEQ(*i.TwitchVip))
+	}
+	if i.TwitchVipNEQ != nil {
+		predicates = append(predicates, user.TwitchVipNEQ(*i.TwitchVipNEQ))
+	}
 	if i.LastSeenAt != nil {
 		predicates = append(predicates, user.LastSeenAtEQ(*i.LastSeenAt))
 	}
@@ -2825,6 +5090,42 @@ func (i *UserWhereInput) P() (predicate.User, error) {
 		}
 		predicates = append(predicates, user.HasAPIKeysWith(with...))
 	}
+	if i.HasAwards != nil {
+		p := user.HasAwards()
+		if !*i.HasAwards {
+			p = user.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasAwardsWith) > 0 {
+		with := make([]predicate.UserAward, 0, len(i.HasAwardsWith))
+		for _, w := range i.HasAwardsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAwardsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, user.HasAwardsWith(with...))
+	}
+	if i.HasSuspensions != nil {
+		p := user.HasSuspensions()
+		if !*i.HasSuspensions {
+			p = user.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSuspensionsWith) > 0 {
+		with := make([]predicate.Suspension, 0, len(i.HasSuspensionsWith))
+		for _, w := range i.HasSuspensionsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSuspensionsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, user.HasSuspensionsWith(with...))
+	}
 
 	if i.IncludeDeletedOnly != nil && *i.IncludeDeletedOnly {
 		predicates = append(predicates, user.DeletedAtNotNil())
@@ -2839,3 +5140,274 @@ func (i *UserWhereInput) P() (predicate.User, error) {
 		return user.And(predicates...), nil
 	}
 }
+
+// UserAwardWhereInput represents a where input for filtering UserAward queries.
+type UserAwardWhereInput struct {
+	Predicates []predicate.UserAward  `json:"-"`
+	Not        *UserAwardWhereInput   `json:"not,omitempty"`
+	Or         []*UserAwardWhereInput `json:"or,omitempty"`
+	And        []*UserAwardWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "granted_at" field predicates.
+	GrantedAt      *time.Time  `json:"grantedAt,omitempty"`
+	GrantedAtNEQ   *time.Time  `json:"grantedAtNEQ,omitempty"`
+	GrantedAtIn    []time.Time `json:"grantedAtIn,omitempty"`
+	GrantedAtNotIn []time.Time `json:"grantedAtNotIn,omitempty"`
+	GrantedAtGT    *time.Time  `json:"grantedAtGT,omitempty"`
+	GrantedAtGTE   *time.Time  `json:"grantedAtGTE,omitempty"`
+	GrantedAtLT    *time.Time  `json:"grantedAtLT,omitempty"`
+	GrantedAtLTE   *time.Time  `json:"grantedAtLTE,omitempty"`
+
+	// "owner" edge predicates.
+	HasOwner     *bool             `json:"hasOwner,omitempty"`
+	HasOwnerWith []*UserWhereInput `json:"hasOwnerWith,omitempty"`
+
+	// "award" edge predicates.
+	HasAward     *bool                        `json:"hasAward,omitempty"`
+	HasAwardWith []*AwardDefinitionWhereInput `json:"hasAwardWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *UserAwardWhereInput) AddPredicates(predicates ...predicate.UserAward) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the UserAwardWhereInput filter on the UserAwardQuery builder.
+func (i *UserAwardWhereInput) Filter(q *UserAwardQuery) (*UserAwardQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyUserAwardWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyUserAwardWhereInput is returned in case the UserAwardWhereInput is empty.
+var ErrEmptyUserAwardWhereInput = errors.New("generated: empty predicate UserAwardWhereInput")
+
+// P returns a predicate for filtering userawards.
+// An error is returned if the input is empty or invalid.
+func (i *UserAwardWhereInput) P() (predicate.UserAward, error) {
+	var predicates []predicate.UserAward
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, useraward.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.UserAward, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, useraward.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.UserAward, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, useraward.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, useraward.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, useraward.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, useraward.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, useraward.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, useraward.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, useraward.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, useraward.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, useraward.IDLTE(*i.IDLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, useraward.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, useraward.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, useraward.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, useraward.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, useraward.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, useraward.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, useraward.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, useraward.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, useraward.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, useraward.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, useraward.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, useraward.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, useraward.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, useraward.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, useraward.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, useraward.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.GrantedAt != nil {
+		predicates = append(predicates, useraward.GrantedAtEQ(*i.GrantedAt))
+	}
+	if i.GrantedAtNEQ != nil {
+		predicates = append(predicates, useraward.GrantedAtNEQ(*i.GrantedAtNEQ))
+	}
+	if len(i.GrantedAtIn) > 0 {
+		predicates = append(predicates, useraward.GrantedAtIn(i.GrantedAtIn...))
+	}
+	if len(i.GrantedAtNotIn) > 0 {
+		predicates = append(predicates, useraward.GrantedAtNotIn(i.GrantedAtNotIn...))
+	}
+	if i.GrantedAtGT != nil {
+		predicates = append(predicates, useraward.GrantedAtGT(*i.GrantedAtGT))
+	}
+	if i.GrantedAtGTE != nil {
+		predicates = append(predicates, useraward.GrantedAtGTE(*i.GrantedAtGTE))
+	}
+	if i.GrantedAtLT != nil {
+		predicates = append(predicates, useraward.GrantedAtLT(*i.GrantedAtLT))
+	}
+	if i.GrantedAtLTE != nil {
+		predicates = append(predicates, useraward.GrantedAtLTE(*i.GrantedAtLTE))
+	}
+
+	if i.HasOwner != nil {
+		p := useraward.HasOwner()
+		if !*i.HasOwner {
+			p = useraward.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasOwnerWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasOwnerWith))
+		for _, w := range i.HasOwnerWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOwnerWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, useraward.HasOwnerWith(with...))
+	}
+	if i.HasAward != nil {
+		p := useraward.HasAward()
+		if !*i.HasAward {
+			p = useraward.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasAwardWith) > 0 {
+		with := make([]predicate.AwardDefinition, 0, len(i.HasAwardWith))
+		for _, w := range i.HasAwardWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAwardWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, useraward.HasAwardWith(with...))
+	}
+
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyUserAwardWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return useraward.And(predicates...), nil
+	}
+}
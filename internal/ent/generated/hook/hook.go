@@ -21,6 +21,30 @@ func (f ApiKeyFunc) Mutate(ctx context.Context, m generated.Mutation) (generated
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.ApiKeyMutation", m)
 }
 
+// The AwardDefinitionFunc type is an adapter to allow the use of ordinary
+// function as AwardDefinition mutator.
+type AwardDefinitionFunc func(context.Context, *generated.AwardDefinitionMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AwardDefinitionFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.AwardDefinitionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.AwardDefinitionMutation", m)
+}
+
+// The CategoryRestrictionFunc type is an adapter to allow the use of ordinary
+// function as CategoryRestriction mutator.
+type CategoryRestrictionFunc func(context.Context, *generated.CategoryRestrictionMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CategoryRestrictionFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.CategoryRestrictionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.CategoryRestrictionMutation", m)
+}
+
 // The CommentFunc type is an adapter to allow the use of ordinary
 // function as Comment mutator.
 type CommentFunc func(context.Context, *generated.CommentMutation) (generated.Value, error)
@@ -33,6 +57,18 @@ func (f CommentFunc) Mutate(ctx context.Context, m generated.Mutation) (generate
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.CommentMutation", m)
 }
 
+// The IdentityFunc type is an adapter to allow the use of ordinary
+// function as Identity mutator.
+type IdentityFunc func(context.Context, *generated.IdentityMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IdentityFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.IdentityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.IdentityMutation", m)
+}
+
 // The PostFunc type is an adapter to allow the use of ordinary
 // function as Post mutator.
 type PostFunc func(context.Context, *generated.PostMutation) (generated.Value, error)
@@ -69,6 +105,54 @@ func (f RefreshTokenFunc) Mutate(ctx context.Context, m generated.Mutation) (gen
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.RefreshTokenMutation", m)
 }
 
+// The RoleChangeFunc type is an adapter to allow the use of ordinary
+// function as RoleChange mutator.
+type RoleChangeFunc func(context.Context, *generated.RoleChangeMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RoleChangeFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.RoleChangeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.RoleChangeMutation", m)
+}
+
+// The RolePermissionFunc type is an adapter to allow the use of ordinary
+// function as RolePermission mutator.
+type RolePermissionFunc func(context.Context, *generated.RolePermissionMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RolePermissionFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.RolePermissionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.RolePermissionMutation", m)
+}
+
+// The SuspensionFunc type is an adapter to allow the use of ordinary
+// function as Suspension mutator.
+type SuspensionFunc func(context.Context, *generated.SuspensionMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SuspensionFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.SuspensionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.SuspensionMutation", m)
+}
+
+// The TwitchTokenFunc type is an adapter to allow the use of ordinary
+// function as TwitchToken mutator.
+type TwitchTokenFunc func(context.Context, *generated.TwitchTokenMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TwitchTokenFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.TwitchTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.TwitchTokenMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *generated.UserMutation) (generated.Value, error)
@@ -81,6 +165,18 @@ func (f UserFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.UserMutation", m)
 }
 
+// The UserAwardFunc type is an adapter to allow the use of ordinary
+// function as UserAward mutator.
+type UserAwardFunc func(context.Context, *generated.UserAwardMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserAwardFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.UserAwardMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.UserAwardMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, generated.Mutation) bool
 
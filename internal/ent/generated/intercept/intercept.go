@@ -9,12 +9,20 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/caliecode/la-clipasa/internal/ent/generated"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/apikey"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/categoryrestriction"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/comment"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/post"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/postcategory"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/refreshtoken"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/twitchtoken"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
 )
 
 // The Query interface represents an operation that queries a graph.
@@ -100,6 +108,60 @@ func (f TraverseApiKey) Traverse(ctx context.Context, q generated.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *generated.ApiKeyQuery", q)
 }
 
+// The AwardDefinitionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AwardDefinitionFunc func(context.Context, *generated.AwardDefinitionQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f AwardDefinitionFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.AwardDefinitionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.AwardDefinitionQuery", q)
+}
+
+// The TraverseAwardDefinition type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAwardDefinition func(context.Context, *generated.AwardDefinitionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAwardDefinition) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAwardDefinition) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.AwardDefinitionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.AwardDefinitionQuery", q)
+}
+
+// The CategoryRestrictionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CategoryRestrictionFunc func(context.Context, *generated.CategoryRestrictionQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f CategoryRestrictionFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.CategoryRestrictionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.CategoryRestrictionQuery", q)
+}
+
+// The TraverseCategoryRestriction type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCategoryRestriction func(context.Context, *generated.CategoryRestrictionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCategoryRestriction) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCategoryRestriction) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.CategoryRestrictionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.CategoryRestrictionQuery", q)
+}
+
 // The CommentFunc type is an adapter to allow the use of ordinary function as a Querier.
 type CommentFunc func(context.Context, *generated.CommentQuery) (generated.Value, error)
 
@@ -127,6 +189,33 @@ func (f TraverseComment) Traverse(ctx context.Context, q generated.Query) error
 	return fmt.Errorf("unexpected query type %T. expect *generated.CommentQuery", q)
 }
 
+// The IdentityFunc type is an adapter to allow the use of ordinary function as a Querier.
+type IdentityFunc func(context.Context, *generated.IdentityQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f IdentityFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.IdentityQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.IdentityQuery", q)
+}
+
+// The TraverseIdentity type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseIdentity func(context.Context, *generated.IdentityQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseIdentity) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseIdentity) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.IdentityQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.IdentityQuery", q)
+}
+
 // The PostFunc type is an adapter to allow the use of ordinary function as a Querier.
 type PostFunc func(context.Context, *generated.PostQuery) (generated.Value, error)
 
@@ -208,6 +297,114 @@ func (f TraverseRefreshToken) Traverse(ctx context.Context, q generated.Query) e
 	return fmt.Errorf("unexpected query type %T. expect *generated.RefreshTokenQuery", q)
 }
 
+// The RoleChangeFunc type is an adapter to allow the use of ordinary function as a Querier.
+type RoleChangeFunc func(context.Context, *generated.RoleChangeQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f RoleChangeFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.RoleChangeQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.RoleChangeQuery", q)
+}
+
+// The TraverseRoleChange type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseRoleChange func(context.Context, *generated.RoleChangeQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseRoleChange) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseRoleChange) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.RoleChangeQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.RoleChangeQuery", q)
+}
+
+// The RolePermissionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type RolePermissionFunc func(context.Context, *generated.RolePermissionQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f RolePermissionFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.RolePermissionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.RolePermissionQuery", q)
+}
+
+// The TraverseRolePermission type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseRolePermission func(context.Context, *generated.RolePermissionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseRolePermission) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseRolePermission) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.RolePermissionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.RolePermissionQuery", q)
+}
+
+// The SuspensionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SuspensionFunc func(context.Context, *generated.SuspensionQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f SuspensionFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.SuspensionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.SuspensionQuery", q)
+}
+
+// The TraverseSuspension type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSuspension func(context.Context, *generated.SuspensionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSuspension) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSuspension) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.SuspensionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.SuspensionQuery", q)
+}
+
+// The TwitchTokenFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TwitchTokenFunc func(context.Context, *generated.TwitchTokenQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f TwitchTokenFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.TwitchTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.TwitchTokenQuery", q)
+}
+
+// The TraverseTwitchToken type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTwitchToken func(context.Context, *generated.TwitchTokenQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTwitchToken) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTwitchToken) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.TwitchTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.TwitchTokenQuery", q)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary function as a Querier.
 type UserFunc func(context.Context, *generated.UserQuery) (generated.Value, error)
 
@@ -235,21 +432,64 @@ func (f TraverseUser) Traverse(ctx context.Context, q generated.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *generated.UserQuery", q)
 }
 
+// The UserAwardFunc type is an adapter to allow the use of ordinary function as a Querier.
+type UserAwardFunc func(context.Context, *generated.UserAwardQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f UserAwardFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.UserAwardQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.UserAwardQuery", q)
+}
+
+// The TraverseUserAward type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseUserAward func(context.Context, *generated.UserAwardQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseUserAward) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseUserAward) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.UserAwardQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.UserAwardQuery", q)
+}
+
 // NewQuery returns the generic Query interface for the given typed query.
 func NewQuery(q generated.Query) (Query, error) {
 	switch q := q.(type) {
 	case *generated.ApiKeyQuery:
 		return &query[*generated.ApiKeyQuery, predicate.ApiKey, apikey.OrderOption]{typ: generated.TypeApiKey, tq: q}, nil
+	case *generated.AwardDefinitionQuery:
+		return &query[*generated.AwardDefinitionQuery, predicate.AwardDefinition, awarddefinition.OrderOption]{typ: generated.TypeAwardDefinition, tq: q}, nil
+	case *generated.CategoryRestrictionQuery:
+		return &query[*generated.CategoryRestrictionQuery, predicate.CategoryRestriction, categoryrestriction.OrderOption]{typ: generated.TypeCategoryRestriction, tq: q}, nil
 	case *generated.CommentQuery:
 		return &query[*generated.CommentQuery, predicate.Comment, comment.OrderOption]{typ: generated.TypeComment, tq: q}, nil
+	case *generated.IdentityQuery:
+		return &query[*generated.IdentityQuery, predicate.Identity, identity.OrderOption]{typ: generated.TypeIdentity, tq: q}, nil
 	case *generated.PostQuery:
 		return &query[*generated.PostQuery, predicate.Post, post.OrderOption]{typ: generated.TypePost, tq: q}, nil
 	case *generated.PostCategoryQuery:
 		return &query[*generated.PostCategoryQuery, predicate.PostCategory, postcategory.OrderOption]{typ: generated.TypePostCategory, tq: q}, nil
 	case *generated.RefreshTokenQuery:
 		return &query[*generated.RefreshTokenQuery, predicate.RefreshToken, refreshtoken.OrderOption]{typ: generated.TypeRefreshToken, tq: q}, nil
+	case *generated.RoleChangeQuery:
+		return &query[*generated.RoleChangeQuery, predicate.RoleChange, rolechange.OrderOption]{typ: generated.TypeRoleChange, tq: q}, nil
+	case *generated.RolePermissionQuery:
+		return &query[*generated.RolePermissionQuery, predicate.RolePermission, rolepermission.OrderOption]{typ: generated.TypeRolePermission, tq: q}, nil
+	case *generated.SuspensionQuery:
+		return &query[*generated.SuspensionQuery, predicate.Suspension, suspension.OrderOption]{typ: generated.TypeSuspension, tq: q}, nil
+	case *generated.TwitchTokenQuery:
+		return &query[*generated.TwitchTokenQuery, predicate.TwitchToken, twitchtoken.OrderOption]{typ: generated.TypeTwitchToken, tq: q}, nil
 	case *generated.UserQuery:
 		return &query[*generated.UserQuery, predicate.User, user.OrderOption]{typ: generated.TypeUser, tq: q}, nil
+	case *generated.UserAwardQuery:
+		return &query[*generated.UserAwardQuery, predicate.UserAward, useraward.OrderOption]{typ: generated.TypeUserAward, tq: q}, nil
 	default:
 		return nil, fmt.Errorf("unknown query type %T", q)
 	}
@@ -14,8 +14,12 @@ var (
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_at", Type: field.TypeTime},
-		{Name: "api_key", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "prefix", Type: field.TypeString},
+		{Name: "key_hash", Type: field.TypeString, Unique: true},
+		{Name: "scopes", Type: field.TypeJSON},
 		{Name: "expires_on", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
 		{Name: "owner_id", Type: field.TypeUUID},
 	}
 	// APIKeysTable holds the schema information for the "api_keys" table.
@@ -26,12 +30,52 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "api_keys_users_api_keys",
-				Columns:    []*schema.Column{APIKeysColumns[5]},
+				Columns:    []*schema.Column{APIKeysColumns[9]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// AwardDefinitionsColumns holds the columns for the "award_definitions" table.
+	AwardDefinitionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "icon", Type: field.TypeString},
+		{Name: "rule", Type: field.TypeEnum, Enums: []string{"POSTS_PUBLISHED", "POSTS_IN_CATEGORY", "LIKES_RECEIVED", "MEMBERSHIP_DAYS"}},
+		{Name: "rule_category", Type: field.TypeEnum, Nullable: true, Enums: []string{"RANA", "SIN_SONIDO", "MEME_ARTESANAL", "NO_SE_YO", "ORO", "DIAMANTE", "MEH", "ALERTA_GLONETILLO", "GRR", "ENSORDECEDOR", "RAGUUUL"}},
+		{Name: "rule_threshold", Type: field.TypeInt, Default: 1},
+	}
+	// AwardDefinitionsTable holds the schema information for the "award_definitions" table.
+	AwardDefinitionsTable = &schema.Table{
+		Name:       "award_definitions",
+		Columns:    AwardDefinitionsColumns,
+		PrimaryKey: []*schema.Column{AwardDefinitionsColumns[0]},
+	}
+	// CategoryRestrictionsColumns holds the columns for the "category_restrictions" table.
+	CategoryRestrictionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "category", Type: field.TypeEnum, Enums: []string{"RANA", "SIN_SONIDO", "MEME_ARTESANAL", "NO_SE_YO", "ORO", "DIAMANTE", "MEH", "ALERTA_GLONETILLO", "GRR", "ENSORDECEDOR", "RAGUUUL"}},
+		{Name: "subscriber_only_post", Type: field.TypeBool, Default: false},
+		{Name: "subscriber_only_view", Type: field.TypeBool, Default: false},
+	}
+	// CategoryRestrictionsTable holds the schema information for the "category_restrictions" table.
+	CategoryRestrictionsTable = &schema.Table{
+		Name:       "category_restrictions",
+		Columns:    CategoryRestrictionsColumns,
+		PrimaryKey: []*schema.Column{CategoryRestrictionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "categoryrestriction_category",
+				Unique:  true,
+				Columns: []*schema.Column{CategoryRestrictionsColumns[3]},
+			},
+		},
+	}
 	// CommentsColumns holds the columns for the "comments" table.
 	CommentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -73,6 +117,37 @@ var (
 			},
 		},
 	}
+	// IdentitiesColumns holds the columns for the "identities" table.
+	IdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "provider", Type: field.TypeEnum, Enums: []string{"TWITCH", "DISCORD", "GOOGLE"}},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "owner_id", Type: field.TypeUUID},
+	}
+	// IdentitiesTable holds the schema information for the "identities" table.
+	IdentitiesTable = &schema.Table{
+		Name:       "identities",
+		Columns:    IdentitiesColumns,
+		PrimaryKey: []*schema.Column{IdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "identities_users_identities",
+				Columns:    []*schema.Column{IdentitiesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "identity_provider_subject",
+				Unique:  true,
+				Columns: []*schema.Column{IdentitiesColumns[3], IdentitiesColumns[4]},
+			},
+		},
+	}
 	// PostsColumns holds the columns for the "posts" table.
 	PostsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -170,6 +245,8 @@ var (
 		{Name: "revoked", Type: field.TypeBool, Default: false},
 		{Name: "ip_address", Type: field.TypeString, Nullable: true},
 		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "family_id", Type: field.TypeUUID},
+		{Name: "parent_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "owner_id", Type: field.TypeUUID},
 	}
 	// RefreshTokensTable holds the schema information for the "refresh_tokens" table.
@@ -180,7 +257,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "refresh_tokens_users_refresh_tokens",
-				Columns:    []*schema.Column{RefreshTokensColumns[8]},
+				Columns:    []*schema.Column{RefreshTokensColumns[10]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -196,10 +273,141 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{RefreshTokensColumns[4]},
 			},
+			{
+				Name:    "refreshtoken_family_id",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[8]},
+			},
+			{
+				Name:    "refreshtoken_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[9]},
+			},
 			{
 				Name:    "refreshtoken_revoked_expires_at_owner_id",
 				Unique:  false,
-				Columns: []*schema.Column{RefreshTokensColumns[5], RefreshTokensColumns[4], RefreshTokensColumns[8]},
+				Columns: []*schema.Column{RefreshTokensColumns[5], RefreshTokensColumns[4], RefreshTokensColumns[10]},
+			},
+		},
+	}
+	// RoleChangesColumns holds the columns for the "role_changes" table.
+	RoleChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "attribute", Type: field.TypeEnum, Enums: []string{"ROLE", "TWITCH_VIP"}},
+		{Name: "old_value", Type: field.TypeString},
+		{Name: "new_value", Type: field.TypeString},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"MANUAL", "TWITCH_SYNC", "SYSTEM"}},
+		{Name: "changed_by_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// RoleChangesTable holds the schema information for the "role_changes" table.
+	RoleChangesTable = &schema.Table{
+		Name:       "role_changes",
+		Columns:    RoleChangesColumns,
+		PrimaryKey: []*schema.Column{RoleChangesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "role_changes_users_role_changes",
+				Columns:    []*schema.Column{RoleChangesColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rolechange_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{RoleChangesColumns[8], RoleChangesColumns[2]},
+			},
+		},
+	}
+	// RolePermissionsColumns holds the columns for the "role_permissions" table.
+	RolePermissionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"GUEST", "USER", "ADMIN", "MODERATOR"}},
+		{Name: "permission", Type: field.TypeEnum, Enums: []string{"POSTS_CATEGORIZE", "POSTS_MODERATE", "POSTS_DELETE", "CATEGORIES_MANAGE", "USERS_MANAGE", "USERS_SUSPEND", "AWARDS_MANAGE", "ROLES_MANAGE"}},
+	}
+	// RolePermissionsTable holds the schema information for the "role_permissions" table.
+	RolePermissionsTable = &schema.Table{
+		Name:       "role_permissions",
+		Columns:    RolePermissionsColumns,
+		PrimaryKey: []*schema.Column{RolePermissionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rolepermission_role_permission",
+				Unique:  true,
+				Columns: []*schema.Column{RolePermissionsColumns[3], RolePermissionsColumns[4]},
+			},
+		},
+	}
+	// SuspensionsColumns holds the columns for the "suspensions" table.
+	SuspensionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "scope", Type: field.TypeEnum, Enums: []string{"POST", "COMMENT", "FULL"}, Default: "FULL"},
+		{Name: "starts_at", Type: field.TypeTime},
+		{Name: "ends_at", Type: field.TypeTime, Nullable: true},
+		{Name: "issuer_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// SuspensionsTable holds the schema information for the "suspensions" table.
+	SuspensionsTable = &schema.Table{
+		Name:       "suspensions",
+		Columns:    SuspensionsColumns,
+		PrimaryKey: []*schema.Column{SuspensionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "suspensions_users_issuer",
+				Columns:    []*schema.Column{SuspensionsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "suspensions_users_suspensions",
+				Columns:    []*schema.Column{SuspensionsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "suspension_user_id_ends_at",
+				Unique:  false,
+				Columns: []*schema.Column{SuspensionsColumns[8], SuspensionsColumns[6]},
+			},
+		},
+	}
+	// TwitchTokensColumns holds the columns for the "twitch_tokens" table.
+	TwitchTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "twitch_user_id", Type: field.TypeString},
+		{Name: "session_id", Type: field.TypeUUID, Unique: true, Nullable: true},
+		{Name: "access_token", Type: field.TypeBytes},
+		{Name: "refresh_token", Type: field.TypeBytes},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true},
+	}
+	// TwitchTokensTable holds the schema information for the "twitch_tokens" table.
+	TwitchTokensTable = &schema.Table{
+		Name:       "twitch_tokens",
+		Columns:    TwitchTokensColumns,
+		PrimaryKey: []*schema.Column{TwitchTokensColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "twitchtoken_twitch_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{TwitchTokensColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "(session_id IS NULL)",
+				},
 			},
 		},
 	}
@@ -214,11 +422,12 @@ var (
 		{Name: "alias", Type: field.TypeString, Nullable: true},
 		{Name: "profile_image", Type: field.TypeString, Nullable: true},
 		{Name: "external_id", Type: field.TypeString, Unique: true},
-		{Name: "auth_provider", Type: field.TypeEnum, Enums: []string{"TWITCH"}, Default: "TWITCH"},
+		{Name: "auth_provider", Type: field.TypeEnum, Enums: []string{"TWITCH", "DISCORD", "GOOGLE"}, Default: "TWITCH"},
 		{Name: "role", Type: field.TypeEnum, Enums: []string{"GUEST", "USER", "ADMIN", "MODERATOR"}, Default: "GUEST"},
+		{Name: "role_source", Type: field.TypeEnum, Nullable: true, Enums: []string{"MANUAL", "TWITCH"}},
+		{Name: "twitch_vip", Type: field.TypeBool, Default: false},
 		{Name: "last_seen_at", Type: field.TypeTime, Nullable: true},
 		{Name: "last_post_seen_cursor", Type: field.TypeString, Nullable: true},
-		{Name: "awards", Type: field.TypeJSON, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -226,6 +435,42 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// UserAwardsColumns holds the columns for the "user_awards" table.
+	UserAwardsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "granted_at", Type: field.TypeTime},
+		{Name: "award_id", Type: field.TypeUUID},
+		{Name: "owner_id", Type: field.TypeUUID},
+	}
+	// UserAwardsTable holds the schema information for the "user_awards" table.
+	UserAwardsTable = &schema.Table{
+		Name:       "user_awards",
+		Columns:    UserAwardsColumns,
+		PrimaryKey: []*schema.Column{UserAwardsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_awards_award_definitions_grants",
+				Columns:    []*schema.Column{UserAwardsColumns[4]},
+				RefColumns: []*schema.Column{AwardDefinitionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "user_awards_users_awards",
+				Columns:    []*schema.Column{UserAwardsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "useraward_owner_id_award_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserAwardsColumns[5], UserAwardsColumns[4]},
+			},
+		},
+	}
 	// UserSavedPostsColumns holds the columns for the "user_saved_posts" table.
 	UserSavedPostsColumns = []*schema.Column{
 		{Name: "user_id", Type: field.TypeUUID},
@@ -276,16 +521,76 @@ var (
 			},
 		},
 	}
+	// UserBlockedUsersColumns holds the columns for the "user_blocked_users" table.
+	UserBlockedUsersColumns = []*schema.Column{
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "blocked_user_id", Type: field.TypeUUID},
+	}
+	// UserBlockedUsersTable holds the schema information for the "user_blocked_users" table.
+	UserBlockedUsersTable = &schema.Table{
+		Name:       "user_blocked_users",
+		Columns:    UserBlockedUsersColumns,
+		PrimaryKey: []*schema.Column{UserBlockedUsersColumns[0], UserBlockedUsersColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_blocked_users_user_id",
+				Columns:    []*schema.Column{UserBlockedUsersColumns[0]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "user_blocked_users_blocked_user_id",
+				Columns:    []*schema.Column{UserBlockedUsersColumns[1]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// UserMutedUsersColumns holds the columns for the "user_muted_users" table.
+	UserMutedUsersColumns = []*schema.Column{
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "muted_user_id", Type: field.TypeUUID},
+	}
+	// UserMutedUsersTable holds the schema information for the "user_muted_users" table.
+	UserMutedUsersTable = &schema.Table{
+		Name:       "user_muted_users",
+		Columns:    UserMutedUsersColumns,
+		PrimaryKey: []*schema.Column{UserMutedUsersColumns[0], UserMutedUsersColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_muted_users_user_id",
+				Columns:    []*schema.Column{UserMutedUsersColumns[0]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "user_muted_users_muted_user_id",
+				Columns:    []*schema.Column{UserMutedUsersColumns[1]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		APIKeysTable,
+		AwardDefinitionsTable,
+		CategoryRestrictionsTable,
 		CommentsTable,
+		IdentitiesTable,
 		PostsTable,
 		PostCategoriesTable,
 		RefreshTokensTable,
+		RoleChangesTable,
+		RolePermissionsTable,
+		SuspensionsTable,
+		TwitchTokensTable,
 		UsersTable,
+		UserAwardsTable,
 		UserSavedPostsTable,
 		UserLikedPostsTable,
+		UserBlockedUsersTable,
+		UserMutedUsersTable,
 	}
 )
 
@@ -293,11 +598,21 @@ func init() {
 	APIKeysTable.ForeignKeys[0].RefTable = UsersTable
 	CommentsTable.ForeignKeys[0].RefTable = PostsTable
 	CommentsTable.ForeignKeys[1].RefTable = UsersTable
+	IdentitiesTable.ForeignKeys[0].RefTable = UsersTable
 	PostsTable.ForeignKeys[0].RefTable = UsersTable
 	PostCategoriesTable.ForeignKeys[0].RefTable = PostsTable
 	RefreshTokensTable.ForeignKeys[0].RefTable = UsersTable
+	RoleChangesTable.ForeignKeys[0].RefTable = UsersTable
+	SuspensionsTable.ForeignKeys[0].RefTable = UsersTable
+	SuspensionsTable.ForeignKeys[1].RefTable = UsersTable
+	UserAwardsTable.ForeignKeys[0].RefTable = AwardDefinitionsTable
+	UserAwardsTable.ForeignKeys[1].RefTable = UsersTable
 	UserSavedPostsTable.ForeignKeys[0].RefTable = UsersTable
 	UserSavedPostsTable.ForeignKeys[1].RefTable = PostsTable
 	UserLikedPostsTable.ForeignKeys[0].RefTable = UsersTable
 	UserLikedPostsTable.ForeignKeys[1].RefTable = PostsTable
+	UserBlockedUsersTable.ForeignKeys[0].RefTable = UsersTable
+	UserBlockedUsersTable.ForeignKeys[1].RefTable = UsersTable
+	UserMutedUsersTable.ForeignKeys[0].RefTable = UsersTable
+	UserMutedUsersTable.ForeignKeys[1].RefTable = UsersTable
 }
@@ -12,12 +12,20 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/apikey"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/categoryrestriction"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/comment"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/post"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/postcategory"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/refreshtoken"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/twitchtoken"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
 	"github.com/caliecode/la-clipasa/internal/gql/extramodel"
 	"github.com/google/uuid"
 )
@@ -31,12 +39,20 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeApiKey       = "ApiKey"
-	TypeComment      = "Comment"
-	TypePost         = "Post"
-	TypePostCategory = "PostCategory"
-	TypeRefreshToken = "RefreshToken"
-	TypeUser         = "User"
+	TypeApiKey              = "ApiKey"
+	TypeAwardDefinition     = "AwardDefinition"
+	TypeCategoryRestriction = "CategoryRestriction"
+	TypeComment             = "Comment"
+	TypeIdentity            = "Identity"
+	TypePost                = "Post"
+	TypePostCategory        = "PostCategory"
+	TypeRefreshToken        = "RefreshToken"
+	TypeRoleChange          = "RoleChange"
+	TypeRolePermission      = "RolePermission"
+	TypeSuspension          = "Suspension"
+	TypeTwitchToken         = "TwitchToken"
+	TypeUser                = "User"
+	TypeUserAward           = "UserAward"
 )
 
 // ApiKeyMutation represents an operation that mutates the ApiKey nodes in the graph.
@@ -47,8 +63,13 @@ type ApiKeyMutation struct {
 	id            *uuid.UUID
 	updated_at    *time.Time
 	created_at    *time.Time
-	api_key       *string
+	name          *string
+	prefix        *string
+	key_hash      *string
+	scopes        *[]string
+	appendscopes  []string
 	expires_on    *time.Time
+	last_used_at  *time.Time
 	clearedFields map[string]struct{}
 	owner         *uuid.UUID
 	clearedowner  bool
@@ -269,40 +290,163 @@ func (m *ApiKeyMutation) ResetOwnerID() {
 	m.owner = nil
 }
 
-// SetAPIKey sets the "api_key" field.
-func (m *ApiKeyMutation) SetAPIKey(s string) {
-	m.api_key = &s
+// SetName sets the "name" field.
+func (m *ApiKeyMutation) SetName(s string) {
+	m.name = &s
 }
 
-// APIKey returns the value of the "api_key" field in the mutation.
-func (m *ApiKeyMutation) APIKey() (r string, exists bool) {
-	v := m.api_key
+// Name returns the value of the "name" field in the mutation.
+func (m *ApiKeyMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAPIKey returns the old "api_key" field's value of the ApiKey entity.
+// OldName returns the old "name" field's value of the ApiKey entity.
 // If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ApiKeyMutation) OldAPIKey(ctx context.Context) (v string, err error) {
+func (m *ApiKeyMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPIKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPIKey requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPIKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.APIKey, nil
+	return oldValue.Name, nil
 }
 
-// ResetAPIKey resets all changes to the "api_key" field.
-func (m *ApiKeyMutation) ResetAPIKey() {
-	m.api_key = nil
+// ResetName resets all changes to the "name" field.
+func (m *ApiKeyMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPrefix sets the "prefix" field.
+func (m *ApiKeyMutation) SetPrefix(s string) {
+	m.prefix = &s
+}
+
+// Prefix returns the value of the "prefix" field in the mutation.
+func (m *ApiKeyMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrefix returns the old "prefix" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApiKeyMutation) OldPrefix(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrefix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
+	}
+	return oldValue.Prefix, nil
+}
+
+// ResetPrefix resets all changes to the "prefix" field.
+func (m *ApiKeyMutation) ResetPrefix() {
+	m.prefix = nil
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (m *ApiKeyMutation) SetKeyHash(s string) {
+	m.key_hash = &s
+}
+
+// KeyHash returns the value of the "key_hash" field in the mutation.
+func (m *ApiKeyMutation) KeyHash() (r string, exists bool) {
+	v := m.key_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyHash returns the old "key_hash" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApiKeyMutation) OldKeyHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyHash: %w", err)
+	}
+	return oldValue.KeyHash, nil
+}
+
+// ResetKeyHash resets all changes to the "key_hash" field.
+func (m *ApiKeyMutation) ResetKeyHash() {
+	m.key_hash = nil
+}
+
+// SetScopes sets the "scopes" field.
+func (m *ApiKeyMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *ApiKeyMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopes returns the old "scopes" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApiKeyMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
+}
+
+// AppendScopes adds s to the "scopes" field.
+func (m *ApiKeyMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
+}
+
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *ApiKeyMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *ApiKeyMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
 }
 
 // SetExpiresOn sets the "expires_on" field.
@@ -341,6 +485,55 @@ func (m *ApiKeyMutation) ResetExpiresOn() {
 	m.expires_on = nil
 }
 
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *ApiKeyMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
+}
+
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *ApiKeyMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedAt returns the old "last_used_at" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApiKeyMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *ApiKeyMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[apikey.FieldLastUsedAt] = struct{}{}
+}
+
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *ApiKeyMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldLastUsedAt]
+	return ok
+}
+
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *ApiKeyMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, apikey.FieldLastUsedAt)
+}
+
 // ClearOwner clears the "owner" edge to the User entity.
 func (m *ApiKeyMutation) ClearOwner() {
 	m.clearedowner = true
@@ -402,7 +595,7 @@ func (m *ApiKeyMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ApiKeyMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+	fields := make([]string, 0, 9)
 	if m.updated_at != nil {
 		fields = append(fields, apikey.FieldUpdatedAt)
 	}
@@ -412,12 +605,24 @@ func (m *ApiKeyMutation) Fields() []string {
 	if m.owner != nil {
 		fields = append(fields, apikey.FieldOwnerID)
 	}
-	if m.api_key != nil {
-		fields = append(fields, apikey.FieldAPIKey)
+	if m.name != nil {
+		fields = append(fields, apikey.FieldName)
+	}
+	if m.prefix != nil {
+		fields = append(fields, apikey.FieldPrefix)
+	}
+	if m.key_hash != nil {
+		fields = append(fields, apikey.FieldKeyHash)
+	}
+	if m.scopes != nil {
+		fields = append(fields, apikey.FieldScopes)
 	}
 	if m.expires_on != nil {
 		fields = append(fields, apikey.FieldExpiresOn)
 	}
+	if m.last_used_at != nil {
+		fields = append(fields, apikey.FieldLastUsedAt)
+	}
 	return fields
 }
 
@@ -432,10 +637,18 @@ func (m *ApiKeyMutation) Field(name string) (ent.Value, bool) {
 		return m.CreatedAt()
 	case apikey.FieldOwnerID:
 		return m.OwnerID()
-	case apikey.FieldAPIKey:
-		return m.APIKey()
+	case apikey.FieldName:
+		return m.Name()
+	case apikey.FieldPrefix:
+		return m.Prefix()
+	case apikey.FieldKeyHash:
+		return m.KeyHash()
+	case apikey.FieldScopes:
+		return m.Scopes()
 	case apikey.FieldExpiresOn:
 		return m.ExpiresOn()
+	case apikey.FieldLastUsedAt:
+		return m.LastUsedAt()
 	}
 	return nil, false
 }
@@ -451,10 +664,18 @@ func (m *ApiKeyMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldCreatedAt(ctx)
 	case apikey.FieldOwnerID:
 		return m.OldOwnerID(ctx)
-	case apikey.FieldAPIKey:
-		return m.OldAPIKey(ctx)
+	case apikey.FieldName:
+		return m.OldName(ctx)
+	case apikey.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case apikey.FieldKeyHash:
+		return m.OldKeyHash(ctx)
+	case apikey.FieldScopes:
+		return m.OldScopes(ctx)
 	case apikey.FieldExpiresOn:
 		return m.OldExpiresOn(ctx)
+	case apikey.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown ApiKey field %s", name)
 }
@@ -485,12 +706,33 @@ func (m *ApiKeyMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetOwnerID(v)
 		return nil
-	case apikey.FieldAPIKey:
+	case apikey.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case apikey.FieldPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrefix(v)
+		return nil
+	case apikey.FieldKeyHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAPIKey(v)
+		m.SetKeyHash(v)
+		return nil
+	case apikey.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
 		return nil
 	case apikey.FieldExpiresOn:
 		v, ok := value.(time.Time)
@@ -499,6 +741,13 @@ func (m *ApiKeyMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetExpiresOn(v)
 		return nil
+	case apikey.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
 	}
 	return fmt.Errorf("unknown ApiKey field %s", name)
 }
@@ -528,7 +777,11 @@ func (m *ApiKeyMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *ApiKeyMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(apikey.FieldLastUsedAt) {
+		fields = append(fields, apikey.FieldLastUsedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -541,6 +794,11 @@ func (m *ApiKeyMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *ApiKeyMutation) ClearField(name string) error {
+	switch name {
+	case apikey.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	}
 	return fmt.Errorf("unknown ApiKey nullable field %s", name)
 }
 
@@ -557,12 +815,24 @@ func (m *ApiKeyMutation) ResetField(name string) error {
 	case apikey.FieldOwnerID:
 		m.ResetOwnerID()
 		return nil
-	case apikey.FieldAPIKey:
-		m.ResetAPIKey()
+	case apikey.FieldName:
+		m.ResetName()
+		return nil
+	case apikey.FieldPrefix:
+		m.ResetPrefix()
+		return nil
+	case apikey.FieldKeyHash:
+		m.ResetKeyHash()
+		return nil
+	case apikey.FieldScopes:
+		m.ResetScopes()
 		return nil
 	case apikey.FieldExpiresOn:
 		m.ResetExpiresOn()
 		return nil
+	case apikey.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
 	}
 	return fmt.Errorf("unknown ApiKey field %s", name)
 }
@@ -641,38 +911,41 @@ func (m *ApiKeyMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown ApiKey edge %s", name)
 }
 
-// CommentMutation represents an operation that mutates the Comment nodes in the graph.
-type CommentMutation struct {
+// AwardDefinitionMutation represents an operation that mutates the AwardDefinition nodes in the graph.
+type AwardDefinitionMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	updated_at    *time.Time
-	created_at    *time.Time
-	deleted_at    *time.Time
-	deleted_by    *string
-	content       *string
-	clearedFields map[string]struct{}
-	owner         *uuid.UUID
-	clearedowner  bool
-	post          *uuid.UUID
-	clearedpost   bool
-	done          bool
-	oldValue      func(context.Context) (*Comment, error)
-	predicates    []predicate.Comment
-}
-
-var _ ent.Mutation = (*CommentMutation)(nil)
-
-// commentOption allows management of the mutation configuration using functional options.
-type commentOption func(*CommentMutation)
-
-// newCommentMutation creates new mutation for the Comment entity.
-func newCommentMutation(c config, op Op, opts ...commentOption) *CommentMutation {
-	m := &CommentMutation{
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	updated_at        *time.Time
+	created_at        *time.Time
+	name              *string
+	description       *string
+	icon              *string
+	rule              *awarddefinition.Rule
+	rule_category     *awarddefinition.RuleCategory
+	rule_threshold    *int
+	addrule_threshold *int
+	clearedFields     map[string]struct{}
+	grants            map[uuid.UUID]struct{}
+	removedgrants     map[uuid.UUID]struct{}
+	clearedgrants     bool
+	done              bool
+	oldValue          func(context.Context) (*AwardDefinition, error)
+	predicates        []predicate.AwardDefinition
+}
+
+var _ ent.Mutation = (*AwardDefinitionMutation)(nil)
+
+// awarddefinitionOption allows management of the mutation configuration using functional options.
+type awarddefinitionOption func(*AwardDefinitionMutation)
+
+// newAwardDefinitionMutation creates new mutation for the AwardDefinition entity.
+func newAwardDefinitionMutation(c config, op Op, opts ...awarddefinitionOption) *AwardDefinitionMutation {
+	m := &AwardDefinitionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeComment,
+		typ:           TypeAwardDefinition,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -681,20 +954,20 @@ func newCommentMutation(c config, op Op, opts ...commentOption) *CommentMutation
 	return m
 }
 
-// withCommentID sets the ID field of the mutation.
-func withCommentID(id uuid.UUID) commentOption {
-	return func(m *CommentMutation) {
+// withAwardDefinitionID sets the ID field of the mutation.
+func withAwardDefinitionID(id uuid.UUID) awarddefinitionOption {
+	return func(m *AwardDefinitionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Comment
+			value *AwardDefinition
 		)
-		m.oldValue = func(ctx context.Context) (*Comment, error) {
+		m.oldValue = func(ctx context.Context) (*AwardDefinition, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Comment.Get(ctx, id)
+					value, err = m.Client().AwardDefinition.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -703,10 +976,10 @@ func withCommentID(id uuid.UUID) commentOption {
 	}
 }
 
-// withComment sets the old Comment of the mutation.
-func withComment(node *Comment) commentOption {
-	return func(m *CommentMutation) {
-		m.oldValue = func(context.Context) (*Comment, error) {
+// withAwardDefinition sets the old AwardDefinition of the mutation.
+func withAwardDefinition(node *AwardDefinition) awarddefinitionOption {
+	return func(m *AwardDefinitionMutation) {
+		m.oldValue = func(context.Context) (*AwardDefinition, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -715,7 +988,7 @@ func withComment(node *Comment) commentOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CommentMutation) Client() *Client {
+func (m AwardDefinitionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -723,7 +996,7 @@ func (m CommentMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CommentMutation) Tx() (*Tx, error) {
+func (m AwardDefinitionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
@@ -733,14 +1006,14 @@ func (m CommentMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Comment entities.
-func (m *CommentMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of AwardDefinition entities.
+func (m *AwardDefinitionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CommentMutation) ID() (id uuid.UUID, exists bool) {
+func (m *AwardDefinitionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -751,7 +1024,7 @@ func (m *CommentMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CommentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *AwardDefinitionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -760,19 +1033,19 @@ func (m *CommentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Comment.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AwardDefinition.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *CommentMutation) SetUpdatedAt(t time.Time) {
+func (m *AwardDefinitionMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CommentMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *AwardDefinitionMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -780,10 +1053,10 @@ func (m *CommentMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the AwardDefinition entity.
+// If the AwardDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AwardDefinitionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -798,17 +1071,17 @@ func (m *CommentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CommentMutation) ResetUpdatedAt() {
+func (m *AwardDefinitionMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *CommentMutation) SetCreatedAt(t time.Time) {
+func (m *AwardDefinitionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CommentMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *AwardDefinitionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -816,10 +1089,10 @@ func (m *CommentMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AwardDefinition entity.
+// If the AwardDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AwardDefinitionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -834,255 +1107,335 @@ func (m *CommentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CommentMutation) ResetCreatedAt() {
+func (m *AwardDefinitionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *CommentMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetName sets the "name" field.
+func (m *AwardDefinitionMutation) SetName(s string) {
+	m.name = &s
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *CommentMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// Name returns the value of the "name" field in the mutation.
+func (m *AwardDefinitionMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the AwardDefinition entity.
+// If the AwardDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AwardDefinitionMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *CommentMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[comment.FieldDeletedAt] = struct{}{}
+	return oldValue.Name, nil
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *CommentMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[comment.FieldDeletedAt]
-	return ok
-}
-
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *CommentMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, comment.FieldDeletedAt)
+// ResetName resets all changes to the "name" field.
+func (m *AwardDefinitionMutation) ResetName() {
+	m.name = nil
 }
 
-// SetDeletedBy sets the "deleted_by" field.
-func (m *CommentMutation) SetDeletedBy(s string) {
-	m.deleted_by = &s
+// SetDescription sets the "description" field.
+func (m *AwardDefinitionMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// DeletedBy returns the value of the "deleted_by" field in the mutation.
-func (m *CommentMutation) DeletedBy() (r string, exists bool) {
-	v := m.deleted_by
+// Description returns the value of the "description" field in the mutation.
+func (m *AwardDefinitionMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedBy returns the old "deleted_by" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the AwardDefinition entity.
+// If the AwardDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
+func (m *AwardDefinitionMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.DeletedBy, nil
+	return oldValue.Description, nil
 }
 
-// ClearDeletedBy clears the value of the "deleted_by" field.
-func (m *CommentMutation) ClearDeletedBy() {
-	m.deleted_by = nil
-	m.clearedFields[comment.FieldDeletedBy] = struct{}{}
+// ClearDescription clears the value of the "description" field.
+func (m *AwardDefinitionMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[awarddefinition.FieldDescription] = struct{}{}
 }
 
-// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
-func (m *CommentMutation) DeletedByCleared() bool {
-	_, ok := m.clearedFields[comment.FieldDeletedBy]
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *AwardDefinitionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[awarddefinition.FieldDescription]
 	return ok
 }
 
-// ResetDeletedBy resets all changes to the "deleted_by" field.
-func (m *CommentMutation) ResetDeletedBy() {
-	m.deleted_by = nil
-	delete(m.clearedFields, comment.FieldDeletedBy)
+// ResetDescription resets all changes to the "description" field.
+func (m *AwardDefinitionMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, awarddefinition.FieldDescription)
 }
 
-// SetOwnerID sets the "owner_id" field.
-func (m *CommentMutation) SetOwnerID(u uuid.UUID) {
-	m.owner = &u
+// SetIcon sets the "icon" field.
+func (m *AwardDefinitionMutation) SetIcon(s string) {
+	m.icon = &s
 }
 
-// OwnerID returns the value of the "owner_id" field in the mutation.
-func (m *CommentMutation) OwnerID() (r uuid.UUID, exists bool) {
-	v := m.owner
+// Icon returns the value of the "icon" field in the mutation.
+func (m *AwardDefinitionMutation) Icon() (r string, exists bool) {
+	v := m.icon
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwnerID returns the old "owner_id" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldIcon returns the old "icon" field's value of the AwardDefinition entity.
+// If the AwardDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldOwnerID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *AwardDefinitionMutation) OldIcon(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+		return v, errors.New("OldIcon requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
 	}
-	return oldValue.OwnerID, nil
+	return oldValue.Icon, nil
 }
 
-// ResetOwnerID resets all changes to the "owner_id" field.
-func (m *CommentMutation) ResetOwnerID() {
-	m.owner = nil
+// ResetIcon resets all changes to the "icon" field.
+func (m *AwardDefinitionMutation) ResetIcon() {
+	m.icon = nil
 }
 
-// SetContent sets the "content" field.
-func (m *CommentMutation) SetContent(s string) {
-	m.content = &s
+// SetRule sets the "rule" field.
+func (m *AwardDefinitionMutation) SetRule(a awarddefinition.Rule) {
+	m.rule = &a
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *CommentMutation) Content() (r string, exists bool) {
-	v := m.content
+// Rule returns the value of the "rule" field in the mutation.
+func (m *AwardDefinitionMutation) Rule() (r awarddefinition.Rule, exists bool) {
+	v := m.rule
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldRule returns the old "rule" field's value of the AwardDefinition entity.
+// If the AwardDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *AwardDefinitionMutation) OldRule(ctx context.Context) (v awarddefinition.Rule, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldRule is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldRule requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldRule: %w", err)
 	}
-	return oldValue.Content, nil
+	return oldValue.Rule, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *CommentMutation) ResetContent() {
-	m.content = nil
+// ResetRule resets all changes to the "rule" field.
+func (m *AwardDefinitionMutation) ResetRule() {
+	m.rule = nil
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *CommentMutation) ClearOwner() {
-	m.clearedowner = true
-	m.clearedFields[comment.FieldOwnerID] = struct{}{}
+// SetRuleCategory sets the "rule_category" field.
+func (m *AwardDefinitionMutation) SetRuleCategory(ac awarddefinition.RuleCategory) {
+	m.rule_category = &ac
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *CommentMutation) OwnerCleared() bool {
-	return m.clearedowner
+// RuleCategory returns the value of the "rule_category" field in the mutation.
+func (m *AwardDefinitionMutation) RuleCategory() (r awarddefinition.RuleCategory, exists bool) {
+	v := m.rule_category
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *CommentMutation) OwnerIDs() (ids []uuid.UUID) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
+// OldRuleCategory returns the old "rule_category" field's value of the AwardDefinition entity.
+// If the AwardDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AwardDefinitionMutation) OldRuleCategory(ctx context.Context) (v *awarddefinition.RuleCategory, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRuleCategory is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRuleCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRuleCategory: %w", err)
+	}
+	return oldValue.RuleCategory, nil
 }
 
-// ResetOwner resets all changes to the "owner" edge.
-func (m *CommentMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
+// ClearRuleCategory clears the value of the "rule_category" field.
+func (m *AwardDefinitionMutation) ClearRuleCategory() {
+	m.rule_category = nil
+	m.clearedFields[awarddefinition.FieldRuleCategory] = struct{}{}
 }
 
-// SetPostID sets the "post" edge to the Post entity by id.
-func (m *CommentMutation) SetPostID(id uuid.UUID) {
-	m.post = &id
+// RuleCategoryCleared returns if the "rule_category" field was cleared in this mutation.
+func (m *AwardDefinitionMutation) RuleCategoryCleared() bool {
+	_, ok := m.clearedFields[awarddefinition.FieldRuleCategory]
+	return ok
 }
 
-// ClearPost clears the "post" edge to the Post entity.
-func (m *CommentMutation) ClearPost() {
-	m.clearedpost = true
+// ResetRuleCategory resets all changes to the "rule_category" field.
+func (m *AwardDefinitionMutation) ResetRuleCategory() {
+	m.rule_category = nil
+	delete(m.clearedFields, awarddefinition.FieldRuleCategory)
 }
 
-// PostCleared reports if the "post" edge to the Post entity was cleared.
-func (m *CommentMutation) PostCleared() bool {
-	return m.clearedpost
+// SetRuleThreshold sets the "rule_threshold" field.
+func (m *AwardDefinitionMutation) SetRuleThreshold(i int) {
+	m.rule_threshold = &i
+	m.addrule_threshold = nil
 }
 
-// PostID returns the "post" edge ID in the mutation.
-func (m *CommentMutation) PostID() (id uuid.UUID, exists bool) {
-	if m.post != nil {
-		return *m.post, true
+// RuleThreshold returns the value of the "rule_threshold" field in the mutation.
+func (m *AwardDefinitionMutation) RuleThreshold() (r int, exists bool) {
+	v := m.rule_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRuleThreshold returns the old "rule_threshold" field's value of the AwardDefinition entity.
+// If the AwardDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AwardDefinitionMutation) OldRuleThreshold(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRuleThreshold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRuleThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRuleThreshold: %w", err)
+	}
+	return oldValue.RuleThreshold, nil
+}
+
+// AddRuleThreshold adds i to the "rule_threshold" field.
+func (m *AwardDefinitionMutation) AddRuleThreshold(i int) {
+	if m.addrule_threshold != nil {
+		*m.addrule_threshold += i
+	} else {
+		m.addrule_threshold = &i
+	}
+}
+
+// AddedRuleThreshold returns the value that was added to the "rule_threshold" field in this mutation.
+func (m *AwardDefinitionMutation) AddedRuleThreshold() (r int, exists bool) {
+	v := m.addrule_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRuleThreshold resets all changes to the "rule_threshold" field.
+func (m *AwardDefinitionMutation) ResetRuleThreshold() {
+	m.rule_threshold = nil
+	m.addrule_threshold = nil
+}
+
+// AddGrantIDs adds the "grants" edge to the UserAward entity by ids.
+func (m *AwardDefinitionMutation) AddGrantIDs(ids ...uuid.UUID) {
+	if m.grants == nil {
+		m.grants = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.grants[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGrants clears the "grants" edge to the UserAward entity.
+func (m *AwardDefinitionMutation) ClearGrants() {
+	m.clearedgrants = true
+}
+
+// GrantsCleared reports if the "grants" edge to the UserAward entity was cleared.
+func (m *AwardDefinitionMutation) GrantsCleared() bool {
+	return m.clearedgrants
+}
+
+// RemoveGrantIDs removes the "grants" edge to the UserAward entity by IDs.
+func (m *AwardDefinitionMutation) RemoveGrantIDs(ids ...uuid.UUID) {
+	if m.removedgrants == nil {
+		m.removedgrants = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.grants, ids[i])
+		m.removedgrants[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGrants returns the removed IDs of the "grants" edge to the UserAward entity.
+func (m *AwardDefinitionMutation) RemovedGrantsIDs() (ids []uuid.UUID) {
+	for id := range m.removedgrants {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// PostIDs returns the "post" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PostID instead. It exists only for internal usage by the builders.
-func (m *CommentMutation) PostIDs() (ids []uuid.UUID) {
-	if id := m.post; id != nil {
-		ids = append(ids, *id)
+// GrantsIDs returns the "grants" edge IDs in the mutation.
+func (m *AwardDefinitionMutation) GrantsIDs() (ids []uuid.UUID) {
+	for id := range m.grants {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetPost resets all changes to the "post" edge.
-func (m *CommentMutation) ResetPost() {
-	m.post = nil
-	m.clearedpost = false
+// ResetGrants resets all changes to the "grants" edge.
+func (m *AwardDefinitionMutation) ResetGrants() {
+	m.grants = nil
+	m.clearedgrants = false
+	m.removedgrants = nil
 }
 
-// Where appends a list predicates to the CommentMutation builder.
-func (m *CommentMutation) Where(ps ...predicate.Comment) {
+// Where appends a list predicates to the AwardDefinitionMutation builder.
+func (m *AwardDefinitionMutation) Where(ps ...predicate.AwardDefinition) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CommentMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AwardDefinitionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CommentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Comment, len(ps))
+func (m *AwardDefinitionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AwardDefinition, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1090,42 +1443,48 @@ func (m *CommentMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CommentMutation) Op() Op {
+func (m *AwardDefinitionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CommentMutation) SetOp(op Op) {
+func (m *AwardDefinitionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Comment).
-func (m *CommentMutation) Type() string {
+// Type returns the node type of this mutation (AwardDefinition).
+func (m *AwardDefinitionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CommentMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *AwardDefinitionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.updated_at != nil {
-		fields = append(fields, comment.FieldUpdatedAt)
+		fields = append(fields, awarddefinition.FieldUpdatedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, comment.FieldCreatedAt)
+		fields = append(fields, awarddefinition.FieldCreatedAt)
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, comment.FieldDeletedAt)
+	if m.name != nil {
+		fields = append(fields, awarddefinition.FieldName)
 	}
-	if m.deleted_by != nil {
-		fields = append(fields, comment.FieldDeletedBy)
+	if m.description != nil {
+		fields = append(fields, awarddefinition.FieldDescription)
 	}
-	if m.owner != nil {
-		fields = append(fields, comment.FieldOwnerID)
+	if m.icon != nil {
+		fields = append(fields, awarddefinition.FieldIcon)
 	}
-	if m.content != nil {
-		fields = append(fields, comment.FieldContent)
+	if m.rule != nil {
+		fields = append(fields, awarddefinition.FieldRule)
+	}
+	if m.rule_category != nil {
+		fields = append(fields, awarddefinition.FieldRuleCategory)
+	}
+	if m.rule_threshold != nil {
+		fields = append(fields, awarddefinition.FieldRuleThreshold)
 	}
 	return fields
 }
@@ -1133,20 +1492,24 @@ func (m *CommentMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CommentMutation) Field(name string) (ent.Value, bool) {
+func (m *AwardDefinitionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case comment.FieldUpdatedAt:
+	case awarddefinition.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case comment.FieldCreatedAt:
+	case awarddefinition.FieldCreatedAt:
 		return m.CreatedAt()
-	case comment.FieldDeletedAt:
-		return m.DeletedAt()
-	case comment.FieldDeletedBy:
-		return m.DeletedBy()
-	case comment.FieldOwnerID:
-		return m.OwnerID()
-	case comment.FieldContent:
-		return m.Content()
+	case awarddefinition.FieldName:
+		return m.Name()
+	case awarddefinition.FieldDescription:
+		return m.Description()
+	case awarddefinition.FieldIcon:
+		return m.Icon()
+	case awarddefinition.FieldRule:
+		return m.Rule()
+	case awarddefinition.FieldRuleCategory:
+		return m.RuleCategory()
+	case awarddefinition.FieldRuleThreshold:
+		return m.RuleThreshold()
 	}
 	return nil, false
 }
@@ -1154,299 +1517,308 @@ func (m *CommentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CommentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AwardDefinitionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case comment.FieldUpdatedAt:
+	case awarddefinition.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case comment.FieldCreatedAt:
+	case awarddefinition.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case comment.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case comment.FieldDeletedBy:
-		return m.OldDeletedBy(ctx)
-	case comment.FieldOwnerID:
-		return m.OldOwnerID(ctx)
-	case comment.FieldContent:
-		return m.OldContent(ctx)
-	}
-	return nil, fmt.Errorf("unknown Comment field %s", name)
+	case awarddefinition.FieldName:
+		return m.OldName(ctx)
+	case awarddefinition.FieldDescription:
+		return m.OldDescription(ctx)
+	case awarddefinition.FieldIcon:
+		return m.OldIcon(ctx)
+	case awarddefinition.FieldRule:
+		return m.OldRule(ctx)
+	case awarddefinition.FieldRuleCategory:
+		return m.OldRuleCategory(ctx)
+	case awarddefinition.FieldRuleThreshold:
+		return m.OldRuleThreshold(ctx)
+	}
+	return nil, fmt.Errorf("unknown AwardDefinition field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CommentMutation) SetField(name string, value ent.Value) error {
+func (m *AwardDefinitionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case comment.FieldUpdatedAt:
+	case awarddefinition.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case comment.FieldCreatedAt:
+	case awarddefinition.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case comment.FieldDeletedAt:
-		v, ok := value.(time.Time)
+	case awarddefinition.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetName(v)
 		return nil
-	case comment.FieldDeletedBy:
+	case awarddefinition.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedBy(v)
+		m.SetDescription(v)
 		return nil
-	case comment.FieldOwnerID:
-		v, ok := value.(uuid.UUID)
+	case awarddefinition.FieldIcon:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOwnerID(v)
+		m.SetIcon(v)
 		return nil
-	case comment.FieldContent:
-		v, ok := value.(string)
+	case awarddefinition.FieldRule:
+		v, ok := value.(awarddefinition.Rule)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetRule(v)
+		return nil
+	case awarddefinition.FieldRuleCategory:
+		v, ok := value.(awarddefinition.RuleCategory)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRuleCategory(v)
+		return nil
+	case awarddefinition.FieldRuleThreshold:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRuleThreshold(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Comment field %s", name)
+	return fmt.Errorf("unknown AwardDefinition field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CommentMutation) AddedFields() []string {
-	return nil
+func (m *AwardDefinitionMutation) AddedFields() []string {
+	var fields []string
+	if m.addrule_threshold != nil {
+		fields = append(fields, awarddefinition.FieldRuleThreshold)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CommentMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AwardDefinitionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case awarddefinition.FieldRuleThreshold:
+		return m.AddedRuleThreshold()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CommentMutation) AddField(name string, value ent.Value) error {
+func (m *AwardDefinitionMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case awarddefinition.FieldRuleThreshold:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRuleThreshold(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Comment numeric field %s", name)
+	return fmt.Errorf("unknown AwardDefinition numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CommentMutation) ClearedFields() []string {
+func (m *AwardDefinitionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(comment.FieldDeletedAt) {
-		fields = append(fields, comment.FieldDeletedAt)
+	if m.FieldCleared(awarddefinition.FieldDescription) {
+		fields = append(fields, awarddefinition.FieldDescription)
 	}
-	if m.FieldCleared(comment.FieldDeletedBy) {
-		fields = append(fields, comment.FieldDeletedBy)
+	if m.FieldCleared(awarddefinition.FieldRuleCategory) {
+		fields = append(fields, awarddefinition.FieldRuleCategory)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CommentMutation) FieldCleared(name string) bool {
+func (m *AwardDefinitionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CommentMutation) ClearField(name string) error {
+func (m *AwardDefinitionMutation) ClearField(name string) error {
 	switch name {
-	case comment.FieldDeletedAt:
-		m.ClearDeletedAt()
+	case awarddefinition.FieldDescription:
+		m.ClearDescription()
 		return nil
-	case comment.FieldDeletedBy:
-		m.ClearDeletedBy()
+	case awarddefinition.FieldRuleCategory:
+		m.ClearRuleCategory()
 		return nil
 	}
-	return fmt.Errorf("unknown Comment nullable field %s", name)
+	return fmt.Errorf("unknown AwardDefinition nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CommentMutation) ResetField(name string) error {
+func (m *AwardDefinitionMutation) ResetField(name string) error {
 	switch name {
-	case comment.FieldUpdatedAt:
+	case awarddefinition.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case comment.FieldCreatedAt:
+	case awarddefinition.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case comment.FieldDeletedAt:
-		m.ResetDeletedAt()
+	case awarddefinition.FieldName:
+		m.ResetName()
 		return nil
-	case comment.FieldDeletedBy:
-		m.ResetDeletedBy()
+	case awarddefinition.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case comment.FieldOwnerID:
-		m.ResetOwnerID()
+	case awarddefinition.FieldIcon:
+		m.ResetIcon()
 		return nil
-	case comment.FieldContent:
-		m.ResetContent()
+	case awarddefinition.FieldRule:
+		m.ResetRule()
+		return nil
+	case awarddefinition.FieldRuleCategory:
+		m.ResetRuleCategory()
+		return nil
+	case awarddefinition.FieldRuleThreshold:
+		m.ResetRuleThreshold()
 		return nil
 	}
-	return fmt.Errorf("unknown Comment field %s", name)
+	return fmt.Errorf("unknown AwardDefinition field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CommentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.owner != nil {
-		edges = append(edges, comment.EdgeOwner)
-	}
-	if m.post != nil {
-		edges = append(edges, comment.EdgePost)
+func (m *AwardDefinitionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.grants != nil {
+		edges = append(edges, awarddefinition.EdgeGrants)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CommentMutation) AddedIDs(name string) []ent.Value {
+func (m *AwardDefinitionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case comment.EdgeOwner:
-		if id := m.owner; id != nil {
-			return []ent.Value{*id}
-		}
-	case comment.EdgePost:
-		if id := m.post; id != nil {
-			return []ent.Value{*id}
+	case awarddefinition.EdgeGrants:
+		ids := make([]ent.Value, 0, len(m.grants))
+		for id := range m.grants {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CommentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *AwardDefinitionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedgrants != nil {
+		edges = append(edges, awarddefinition.EdgeGrants)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CommentMutation) RemovedIDs(name string) []ent.Value {
+func (m *AwardDefinitionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case awarddefinition.EdgeGrants:
+		ids := make([]ent.Value, 0, len(m.removedgrants))
+		for id := range m.removedgrants {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CommentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedowner {
-		edges = append(edges, comment.EdgeOwner)
-	}
-	if m.clearedpost {
-		edges = append(edges, comment.EdgePost)
+func (m *AwardDefinitionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedgrants {
+		edges = append(edges, awarddefinition.EdgeGrants)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CommentMutation) EdgeCleared(name string) bool {
+func (m *AwardDefinitionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case comment.EdgeOwner:
-		return m.clearedowner
-	case comment.EdgePost:
-		return m.clearedpost
+	case awarddefinition.EdgeGrants:
+		return m.clearedgrants
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CommentMutation) ClearEdge(name string) error {
+func (m *AwardDefinitionMutation) ClearEdge(name string) error {
 	switch name {
-	case comment.EdgeOwner:
-		m.ClearOwner()
-		return nil
-	case comment.EdgePost:
-		m.ClearPost()
-		return nil
 	}
-	return fmt.Errorf("unknown Comment unique edge %s", name)
+	return fmt.Errorf("unknown AwardDefinition unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CommentMutation) ResetEdge(name string) error {
+func (m *AwardDefinitionMutation) ResetEdge(name string) error {
 	switch name {
-	case comment.EdgeOwner:
-		m.ResetOwner()
-		return nil
-	case comment.EdgePost:
-		m.ResetPost()
+	case awarddefinition.EdgeGrants:
+		m.ResetGrants()
 		return nil
 	}
-	return fmt.Errorf("unknown Comment edge %s", name)
+	return fmt.Errorf("unknown AwardDefinition edge %s", name)
 }
 
-// PostMutation represents an operation that mutates the Post nodes in the graph.
-type PostMutation struct {
+// CategoryRestrictionMutation represents an operation that mutates the CategoryRestriction nodes in the graph.
+type CategoryRestrictionMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	updated_at         *time.Time
-	created_at         *time.Time
-	deleted_at         *time.Time
-	deleted_by         *string
-	pinned             *bool
-	title              *string
-	content            *string
-	link               *string
-	moderation_comment *string
-	is_moderated       *bool
-	moderated_at       *time.Time
-	entity_vector      *string
-	metadata           *extramodel.PostMetadata
-	clearedFields      map[string]struct{}
-	owner              *uuid.UUID
-	clearedowner       bool
-	comments           map[uuid.UUID]struct{}
-	removedcomments    map[uuid.UUID]struct{}
-	clearedcomments    bool
-	saved_by           map[uuid.UUID]struct{}
-	removedsaved_by    map[uuid.UUID]struct{}
-	clearedsaved_by    bool
-	liked_by           map[uuid.UUID]struct{}
-	removedliked_by    map[uuid.UUID]struct{}
-	clearedliked_by    bool
-	categories         map[uuid.UUID]struct{}
-	removedcategories  map[uuid.UUID]struct{}
-	clearedcategories  bool
-	done               bool
-	oldValue           func(context.Context) (*Post, error)
-	predicates         []predicate.Post
-}
-
-var _ ent.Mutation = (*PostMutation)(nil)
-
-// postOption allows management of the mutation configuration using functional options.
-type postOption func(*PostMutation)
-
-// newPostMutation creates new mutation for the Post entity.
-func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
-	m := &PostMutation{
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	updated_at           *time.Time
+	created_at           *time.Time
+	category             *categoryrestriction.Category
+	subscriber_only_post *bool
+	subscriber_only_view *bool
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*CategoryRestriction, error)
+	predicates           []predicate.CategoryRestriction
+}
+
+var _ ent.Mutation = (*CategoryRestrictionMutation)(nil)
+
+// categoryrestrictionOption allows management of the mutation configuration using functional options.
+type categoryrestrictionOption func(*CategoryRestrictionMutation)
+
+// newCategoryRestrictionMutation creates new mutation for the CategoryRestriction entity.
+func newCategoryRestrictionMutation(c config, op Op, opts ...categoryrestrictionOption) *CategoryRestrictionMutation {
+	m := &CategoryRestrictionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePost,
+		typ:           TypeCategoryRestriction,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1455,20 +1827,20 @@ func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
 	return m
 }
 
-// withPostID sets the ID field of the mutation.
-func withPostID(id uuid.UUID) postOption {
-	return func(m *PostMutation) {
+// withCategoryRestrictionID sets the ID field of the mutation.
+func withCategoryRestrictionID(id uuid.UUID) categoryrestrictionOption {
+	return func(m *CategoryRestrictionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Post
+			value *CategoryRestriction
 		)
-		m.oldValue = func(ctx context.Context) (*Post, error) {
+		m.oldValue = func(ctx context.Context) (*CategoryRestriction, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Post.Get(ctx, id)
+					value, err = m.Client().CategoryRestriction.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1477,10 +1849,10 @@ func withPostID(id uuid.UUID) postOption {
 	}
 }
 
-// withPost sets the old Post of the mutation.
-func withPost(node *Post) postOption {
-	return func(m *PostMutation) {
-		m.oldValue = func(context.Context) (*Post, error) {
+// withCategoryRestriction sets the old CategoryRestriction of the mutation.
+func withCategoryRestriction(node *CategoryRestriction) categoryrestrictionOption {
+	return func(m *CategoryRestrictionMutation) {
+		m.oldValue = func(context.Context) (*CategoryRestriction, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1489,7 +1861,7 @@ func withPost(node *Post) postOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PostMutation) Client() *Client {
+func (m CategoryRestrictionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1497,7 +1869,7 @@ func (m PostMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PostMutation) Tx() (*Tx, error) {
+func (m CategoryRestrictionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
@@ -1507,14 +1879,14 @@ func (m PostMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Post entities.
-func (m *PostMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of CategoryRestriction entities.
+func (m *CategoryRestrictionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PostMutation) ID() (id uuid.UUID, exists bool) {
+func (m *CategoryRestrictionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1525,7 +1897,7 @@ func (m *PostMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PostMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *CategoryRestrictionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1534,19 +1906,19 @@ func (m *PostMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Post.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CategoryRestriction.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *PostMutation) SetUpdatedAt(t time.Time) {
+func (m *CategoryRestrictionMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PostMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *CategoryRestrictionMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1554,10 +1926,10 @@ func (m *PostMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the CategoryRestriction entity.
+// If the CategoryRestriction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CategoryRestrictionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1572,17 +1944,17 @@ func (m *PostMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PostMutation) ResetUpdatedAt() {
+func (m *CategoryRestrictionMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *PostMutation) SetCreatedAt(t time.Time) {
+func (m *CategoryRestrictionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PostMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *CategoryRestrictionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1590,10 +1962,10 @@ func (m *PostMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the CategoryRestriction entity.
+// If the CategoryRestriction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CategoryRestrictionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1608,785 +1980,807 @@ func (m *PostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PostMutation) ResetCreatedAt() {
+func (m *CategoryRestrictionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *PostMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetCategory sets the "category" field.
+func (m *CategoryRestrictionMutation) SetCategory(c categoryrestriction.Category) {
+	m.category = &c
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *PostMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// Category returns the value of the "category" field in the mutation.
+func (m *CategoryRestrictionMutation) Category() (r categoryrestriction.Category, exists bool) {
+	v := m.category
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldCategory returns the old "category" field's value of the CategoryRestriction entity.
+// If the CategoryRestriction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CategoryRestrictionMutation) OldCategory(ctx context.Context) (v categoryrestriction.Category, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldCategory requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
 	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *PostMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[post.FieldDeletedAt] = struct{}{}
-}
-
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *PostMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[post.FieldDeletedAt]
-	return ok
+	return oldValue.Category, nil
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *PostMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, post.FieldDeletedAt)
+// ResetCategory resets all changes to the "category" field.
+func (m *CategoryRestrictionMutation) ResetCategory() {
+	m.category = nil
 }
 
-// SetDeletedBy sets the "deleted_by" field.
-func (m *PostMutation) SetDeletedBy(s string) {
-	m.deleted_by = &s
+// SetSubscriberOnlyPost sets the "subscriber_only_post" field.
+func (m *CategoryRestrictionMutation) SetSubscriberOnlyPost(b bool) {
+	m.subscriber_only_post = &b
 }
 
-// DeletedBy returns the value of the "deleted_by" field in the mutation.
-func (m *PostMutation) DeletedBy() (r string, exists bool) {
-	v := m.deleted_by
+// SubscriberOnlyPost returns the value of the "subscriber_only_post" field in the mutation.
+func (m *CategoryRestrictionMutation) SubscriberOnlyPost() (r bool, exists bool) {
+	v := m.subscriber_only_post
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedBy returns the old "deleted_by" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldSubscriberOnlyPost returns the old "subscriber_only_post" field's value of the CategoryRestriction entity.
+// If the CategoryRestriction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
+func (m *CategoryRestrictionMutation) OldSubscriberOnlyPost(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubscriberOnlyPost is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
+		return v, errors.New("OldSubscriberOnlyPost requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubscriberOnlyPost: %w", err)
 	}
-	return oldValue.DeletedBy, nil
-}
-
-// ClearDeletedBy clears the value of the "deleted_by" field.
-func (m *PostMutation) ClearDeletedBy() {
-	m.deleted_by = nil
-	m.clearedFields[post.FieldDeletedBy] = struct{}{}
+	return oldValue.SubscriberOnlyPost, nil
 }
 
-// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
-func (m *PostMutation) DeletedByCleared() bool {
-	_, ok := m.clearedFields[post.FieldDeletedBy]
-	return ok
-}
-
-// ResetDeletedBy resets all changes to the "deleted_by" field.
-func (m *PostMutation) ResetDeletedBy() {
-	m.deleted_by = nil
-	delete(m.clearedFields, post.FieldDeletedBy)
+// ResetSubscriberOnlyPost resets all changes to the "subscriber_only_post" field.
+func (m *CategoryRestrictionMutation) ResetSubscriberOnlyPost() {
+	m.subscriber_only_post = nil
 }
 
-// SetOwnerID sets the "owner_id" field.
-func (m *PostMutation) SetOwnerID(u uuid.UUID) {
-	m.owner = &u
+// SetSubscriberOnlyView sets the "subscriber_only_view" field.
+func (m *CategoryRestrictionMutation) SetSubscriberOnlyView(b bool) {
+	m.subscriber_only_view = &b
 }
 
-// OwnerID returns the value of the "owner_id" field in the mutation.
-func (m *PostMutation) OwnerID() (r uuid.UUID, exists bool) {
-	v := m.owner
+// SubscriberOnlyView returns the value of the "subscriber_only_view" field in the mutation.
+func (m *CategoryRestrictionMutation) SubscriberOnlyView() (r bool, exists bool) {
+	v := m.subscriber_only_view
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwnerID returns the old "owner_id" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldSubscriberOnlyView returns the old "subscriber_only_view" field's value of the CategoryRestriction entity.
+// If the CategoryRestriction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldOwnerID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *CategoryRestrictionMutation) OldSubscriberOnlyView(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubscriberOnlyView is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+		return v, errors.New("OldSubscriberOnlyView requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubscriberOnlyView: %w", err)
 	}
-	return oldValue.OwnerID, nil
+	return oldValue.SubscriberOnlyView, nil
 }
 
-// ResetOwnerID resets all changes to the "owner_id" field.
-func (m *PostMutation) ResetOwnerID() {
-	m.owner = nil
+// ResetSubscriberOnlyView resets all changes to the "subscriber_only_view" field.
+func (m *CategoryRestrictionMutation) ResetSubscriberOnlyView() {
+	m.subscriber_only_view = nil
 }
 
-// SetPinned sets the "pinned" field.
-func (m *PostMutation) SetPinned(b bool) {
-	m.pinned = &b
+// Where appends a list predicates to the CategoryRestrictionMutation builder.
+func (m *CategoryRestrictionMutation) Where(ps ...predicate.CategoryRestriction) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// Pinned returns the value of the "pinned" field in the mutation.
-func (m *PostMutation) Pinned() (r bool, exists bool) {
-	v := m.pinned
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the CategoryRestrictionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CategoryRestrictionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CategoryRestriction, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldPinned returns the old "pinned" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldPinned(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPinned is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPinned requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPinned: %w", err)
-	}
-	return oldValue.Pinned, nil
+// Op returns the operation name.
+func (m *CategoryRestrictionMutation) Op() Op {
+	return m.op
 }
 
-// ResetPinned resets all changes to the "pinned" field.
-func (m *PostMutation) ResetPinned() {
-	m.pinned = nil
+// SetOp allows setting the mutation operation.
+func (m *CategoryRestrictionMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetTitle sets the "title" field.
-func (m *PostMutation) SetTitle(s string) {
-	m.title = &s
+// Type returns the node type of this mutation (CategoryRestriction).
+func (m *CategoryRestrictionMutation) Type() string {
+	return m.typ
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *PostMutation) Title() (r string, exists bool) {
-	v := m.title
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CategoryRestrictionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.updated_at != nil {
+		fields = append(fields, categoryrestriction.FieldUpdatedAt)
 	}
-	return *v, true
-}
-
-// OldTitle returns the old "title" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldTitle(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	if m.created_at != nil {
+		fields = append(fields, categoryrestriction.FieldCreatedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+	if m.category != nil {
+		fields = append(fields, categoryrestriction.FieldCategory)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	if m.subscriber_only_post != nil {
+		fields = append(fields, categoryrestriction.FieldSubscriberOnlyPost)
 	}
-	return oldValue.Title, nil
+	if m.subscriber_only_view != nil {
+		fields = append(fields, categoryrestriction.FieldSubscriberOnlyView)
+	}
+	return fields
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *PostMutation) ResetTitle() {
-	m.title = nil
-}
-
-// SetContent sets the "content" field.
-func (m *PostMutation) SetContent(s string) {
-	m.content = &s
-}
-
-// Content returns the value of the "content" field in the mutation.
-func (m *PostMutation) Content() (r string, exists bool) {
-	v := m.content
-	if v == nil {
-		return
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CategoryRestrictionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case categoryrestriction.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case categoryrestriction.FieldCreatedAt:
+		return m.CreatedAt()
+	case categoryrestriction.FieldCategory:
+		return m.Category()
+	case categoryrestriction.FieldSubscriberOnlyPost:
+		return m.SubscriberOnlyPost()
+	case categoryrestriction.FieldSubscriberOnlyView:
+		return m.SubscriberOnlyView()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldContent returns the old "content" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldContent(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CategoryRestrictionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case categoryrestriction.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case categoryrestriction.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case categoryrestriction.FieldCategory:
+		return m.OldCategory(ctx)
+	case categoryrestriction.FieldSubscriberOnlyPost:
+		return m.OldSubscriberOnlyPost(ctx)
+	case categoryrestriction.FieldSubscriberOnlyView:
+		return m.OldSubscriberOnlyView(ctx)
 	}
-	return oldValue.Content, nil
-}
-
-// ClearContent clears the value of the "content" field.
-func (m *PostMutation) ClearContent() {
-	m.content = nil
-	m.clearedFields[post.FieldContent] = struct{}{}
+	return nil, fmt.Errorf("unknown CategoryRestriction field %s", name)
 }
 
-// ContentCleared returns if the "content" field was cleared in this mutation.
-func (m *PostMutation) ContentCleared() bool {
-	_, ok := m.clearedFields[post.FieldContent]
-	return ok
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CategoryRestrictionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case categoryrestriction.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case categoryrestriction.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case categoryrestriction.FieldCategory:
+		v, ok := value.(categoryrestriction.Category)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case categoryrestriction.FieldSubscriberOnlyPost:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubscriberOnlyPost(v)
+		return nil
+	case categoryrestriction.FieldSubscriberOnlyView:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubscriberOnlyView(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CategoryRestriction field %s", name)
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *PostMutation) ResetContent() {
-	m.content = nil
-	delete(m.clearedFields, post.FieldContent)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CategoryRestrictionMutation) AddedFields() []string {
+	return nil
 }
 
-// SetLink sets the "link" field.
-func (m *PostMutation) SetLink(s string) {
-	m.link = &s
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CategoryRestrictionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// Link returns the value of the "link" field in the mutation.
-func (m *PostMutation) Link() (r string, exists bool) {
-	v := m.link
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CategoryRestrictionMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown CategoryRestriction numeric field %s", name)
 }
 
-// OldLink returns the old "link" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldLink(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLink is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLink requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLink: %w", err)
-	}
-	return oldValue.Link, nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CategoryRestrictionMutation) ClearedFields() []string {
+	return nil
 }
 
-// ResetLink resets all changes to the "link" field.
-func (m *PostMutation) ResetLink() {
-	m.link = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CategoryRestrictionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetModerationComment sets the "moderation_comment" field.
-func (m *PostMutation) SetModerationComment(s string) {
-	m.moderation_comment = &s
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CategoryRestrictionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CategoryRestriction nullable field %s", name)
 }
 
-// ModerationComment returns the value of the "moderation_comment" field in the mutation.
-func (m *PostMutation) ModerationComment() (r string, exists bool) {
-	v := m.moderation_comment
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CategoryRestrictionMutation) ResetField(name string) error {
+	switch name {
+	case categoryrestriction.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case categoryrestriction.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case categoryrestriction.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case categoryrestriction.FieldSubscriberOnlyPost:
+		m.ResetSubscriberOnlyPost()
+		return nil
+	case categoryrestriction.FieldSubscriberOnlyView:
+		m.ResetSubscriberOnlyView()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown CategoryRestriction field %s", name)
 }
 
-// OldModerationComment returns the old "moderation_comment" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldModerationComment(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModerationComment is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModerationComment requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModerationComment: %w", err)
-	}
-	return oldValue.ModerationComment, nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CategoryRestrictionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearModerationComment clears the value of the "moderation_comment" field.
-func (m *PostMutation) ClearModerationComment() {
-	m.moderation_comment = nil
-	m.clearedFields[post.FieldModerationComment] = struct{}{}
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CategoryRestrictionMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ModerationCommentCleared returns if the "moderation_comment" field was cleared in this mutation.
-func (m *PostMutation) ModerationCommentCleared() bool {
-	_, ok := m.clearedFields[post.FieldModerationComment]
-	return ok
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CategoryRestrictionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetModerationComment resets all changes to the "moderation_comment" field.
-func (m *PostMutation) ResetModerationComment() {
-	m.moderation_comment = nil
-	delete(m.clearedFields, post.FieldModerationComment)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CategoryRestrictionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SetIsModerated sets the "is_moderated" field.
-func (m *PostMutation) SetIsModerated(b bool) {
-	m.is_moderated = &b
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CategoryRestrictionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// IsModerated returns the value of the "is_moderated" field in the mutation.
-func (m *PostMutation) IsModerated() (r bool, exists bool) {
-	v := m.is_moderated
-	if v == nil {
-		return
-	}
-	return *v, true
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CategoryRestrictionMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// OldIsModerated returns the old "is_moderated" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldIsModerated(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsModerated is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsModerated requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsModerated: %w", err)
-	}
-	return oldValue.IsModerated, nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CategoryRestrictionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CategoryRestriction unique edge %s", name)
 }
 
-// ResetIsModerated resets all changes to the "is_moderated" field.
-func (m *PostMutation) ResetIsModerated() {
-	m.is_moderated = nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CategoryRestrictionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CategoryRestriction edge %s", name)
 }
 
-// SetModeratedAt sets the "moderated_at" field.
-func (m *PostMutation) SetModeratedAt(t time.Time) {
-	m.moderated_at = &t
+// CommentMutation represents an operation that mutates the Comment nodes in the graph.
+type CommentMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	updated_at    *time.Time
+	created_at    *time.Time
+	deleted_at    *time.Time
+	deleted_by    *string
+	content       *string
+	clearedFields map[string]struct{}
+	owner         *uuid.UUID
+	clearedowner  bool
+	post          *uuid.UUID
+	clearedpost   bool
+	done          bool
+	oldValue      func(context.Context) (*Comment, error)
+	predicates    []predicate.Comment
 }
 
-// ModeratedAt returns the value of the "moderated_at" field in the mutation.
-func (m *PostMutation) ModeratedAt() (r time.Time, exists bool) {
-	v := m.moderated_at
-	if v == nil {
-		return
+var _ ent.Mutation = (*CommentMutation)(nil)
+
+// commentOption allows management of the mutation configuration using functional options.
+type commentOption func(*CommentMutation)
+
+// newCommentMutation creates new mutation for the Comment entity.
+func newCommentMutation(c config, op Op, opts ...commentOption) *CommentMutation {
+	m := &CommentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeComment,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldModeratedAt returns the old "moderated_at" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldModeratedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModeratedAt is only allowed on UpdateOne operations")
+// withCommentID sets the ID field of the mutation.
+func withCommentID(id uuid.UUID) commentOption {
+	return func(m *CommentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Comment
+		)
+		m.oldValue = func(ctx context.Context) (*Comment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Comment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModeratedAt requires an ID field in the mutation")
+}
+
+// withComment sets the old Comment of the mutation.
+func withComment(node *Comment) commentOption {
+	return func(m *CommentMutation) {
+		m.oldValue = func(context.Context) (*Comment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModeratedAt: %w", err)
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CommentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CommentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
-	return oldValue.ModeratedAt, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearModeratedAt clears the value of the "moderated_at" field.
-func (m *PostMutation) ClearModeratedAt() {
-	m.moderated_at = nil
-	m.clearedFields[post.FieldModeratedAt] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Comment entities.
+func (m *CommentMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// ModeratedAtCleared returns if the "moderated_at" field was cleared in this mutation.
-func (m *PostMutation) ModeratedAtCleared() bool {
-	_, ok := m.clearedFields[post.FieldModeratedAt]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CommentMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetModeratedAt resets all changes to the "moderated_at" field.
-func (m *PostMutation) ResetModeratedAt() {
-	m.moderated_at = nil
-	delete(m.clearedFields, post.FieldModeratedAt)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CommentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Comment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetEntityVector sets the "entity_vector" field.
-func (m *PostMutation) SetEntityVector(s string) {
-	m.entity_vector = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CommentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// EntityVector returns the value of the "entity_vector" field in the mutation.
-func (m *PostMutation) EntityVector() (r string, exists bool) {
-	v := m.entity_vector
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CommentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEntityVector returns the old "entity_vector" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldEntityVector(ctx context.Context) (v string, err error) {
+func (m *CommentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEntityVector is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEntityVector requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEntityVector: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.EntityVector, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearEntityVector clears the value of the "entity_vector" field.
-func (m *PostMutation) ClearEntityVector() {
-	m.entity_vector = nil
-	m.clearedFields[post.FieldEntityVector] = struct{}{}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CommentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// EntityVectorCleared returns if the "entity_vector" field was cleared in this mutation.
-func (m *PostMutation) EntityVectorCleared() bool {
-	_, ok := m.clearedFields[post.FieldEntityVector]
-	return ok
+// SetCreatedAt sets the "created_at" field.
+func (m *CommentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ResetEntityVector resets all changes to the "entity_vector" field.
-func (m *PostMutation) ResetEntityVector() {
-	m.entity_vector = nil
-	delete(m.clearedFields, post.FieldEntityVector)
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CommentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *PostMutation) SetMetadata(em extramodel.PostMetadata) {
-	m.metadata = &em
+// OldCreatedAt returns the old "created_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *PostMutation) Metadata() (r extramodel.PostMetadata, exists bool) {
-	v := m.metadata
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CommentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *CommentMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *CommentMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldMetadata(ctx context.Context) (v extramodel.PostMetadata, err error) {
+func (m *CommentMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.DeletedAt, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *PostMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[post.FieldMetadata] = struct{}{}
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *CommentMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[comment.FieldDeletedAt] = struct{}{}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *PostMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[post.FieldMetadata]
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *CommentMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[comment.FieldDeletedAt]
 	return ok
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *PostMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, post.FieldMetadata)
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *CommentMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, comment.FieldDeletedAt)
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *PostMutation) ClearOwner() {
-	m.clearedowner = true
-	m.clearedFields[post.FieldOwnerID] = struct{}{}
+// SetDeletedBy sets the "deleted_by" field.
+func (m *CommentMutation) SetDeletedBy(s string) {
+	m.deleted_by = &s
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *PostMutation) OwnerCleared() bool {
-	return m.clearedowner
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *CommentMutation) DeletedBy() (r string, exists bool) {
+	v := m.deleted_by
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *PostMutation) OwnerIDs() (ids []uuid.UUID) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
+// OldDeletedBy returns the old "deleted_by" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
+	}
+	return oldValue.DeletedBy, nil
 }
 
-// ResetOwner resets all changes to the "owner" edge.
-func (m *PostMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *CommentMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.clearedFields[comment.FieldDeletedBy] = struct{}{}
 }
 
-// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
-func (m *PostMutation) AddCommentIDs(ids ...uuid.UUID) {
-	if m.comments == nil {
-		m.comments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.comments[ids[i]] = struct{}{}
-	}
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *CommentMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[comment.FieldDeletedBy]
+	return ok
 }
 
-// ClearComments clears the "comments" edge to the Comment entity.
-func (m *PostMutation) ClearComments() {
-	m.clearedcomments = true
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *CommentMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	delete(m.clearedFields, comment.FieldDeletedBy)
 }
 
-// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
-func (m *PostMutation) CommentsCleared() bool {
-	return m.clearedcomments
+// SetOwnerID sets the "owner_id" field.
+func (m *CommentMutation) SetOwnerID(u uuid.UUID) {
+	m.owner = &u
 }
 
-// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
-func (m *PostMutation) RemoveCommentIDs(ids ...uuid.UUID) {
-	if m.removedcomments == nil {
-		m.removedcomments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.comments, ids[i])
-		m.removedcomments[ids[i]] = struct{}{}
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *CommentMutation) OwnerID() (r uuid.UUID, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
-func (m *PostMutation) RemovedCommentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedcomments {
-		ids = append(ids, id)
+// OldOwnerID returns the old "owner_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldOwnerID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
 }
 
-// CommentsIDs returns the "comments" edge IDs in the mutation.
-func (m *PostMutation) CommentsIDs() (ids []uuid.UUID) {
-	for id := range m.comments {
-		ids = append(ids, id)
-	}
-	return
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *CommentMutation) ResetOwnerID() {
+	m.owner = nil
 }
 
-// ResetComments resets all changes to the "comments" edge.
-func (m *PostMutation) ResetComments() {
-	m.comments = nil
-	m.clearedcomments = false
-	m.removedcomments = nil
+// SetContent sets the "content" field.
+func (m *CommentMutation) SetContent(s string) {
+	m.content = &s
 }
 
-// AddSavedByIDs adds the "saved_by" edge to the User entity by ids.
-func (m *PostMutation) AddSavedByIDs(ids ...uuid.UUID) {
-	if m.saved_by == nil {
-		m.saved_by = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.saved_by[ids[i]] = struct{}{}
+// Content returns the value of the "content" field in the mutation.
+func (m *CommentMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearSavedBy clears the "saved_by" edge to the User entity.
-func (m *PostMutation) ClearSavedBy() {
-	m.clearedsaved_by = true
+// OldContent returns the old "content" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
 }
 
-// SavedByCleared reports if the "saved_by" edge to the User entity was cleared.
-func (m *PostMutation) SavedByCleared() bool {
-	return m.clearedsaved_by
+// ResetContent resets all changes to the "content" field.
+func (m *CommentMutation) ResetContent() {
+	m.content = nil
 }
 
-// RemoveSavedByIDs removes the "saved_by" edge to the User entity by IDs.
-func (m *PostMutation) RemoveSavedByIDs(ids ...uuid.UUID) {
-	if m.removedsaved_by == nil {
-		m.removedsaved_by = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.saved_by, ids[i])
-		m.removedsaved_by[ids[i]] = struct{}{}
-	}
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *CommentMutation) ClearOwner() {
+	m.clearedowner = true
+	m.clearedFields[comment.FieldOwnerID] = struct{}{}
 }
 
-// RemovedSavedBy returns the removed IDs of the "saved_by" edge to the User entity.
-func (m *PostMutation) RemovedSavedByIDs() (ids []uuid.UUID) {
-	for id := range m.removedsaved_by {
-		ids = append(ids, id)
-	}
-	return
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *CommentMutation) OwnerCleared() bool {
+	return m.clearedowner
 }
 
-// SavedByIDs returns the "saved_by" edge IDs in the mutation.
-func (m *PostMutation) SavedByIDs() (ids []uuid.UUID) {
-	for id := range m.saved_by {
-		ids = append(ids, id)
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetSavedBy resets all changes to the "saved_by" edge.
-func (m *PostMutation) ResetSavedBy() {
-	m.saved_by = nil
-	m.clearedsaved_by = false
-	m.removedsaved_by = nil
-}
-
-// AddLikedByIDs adds the "liked_by" edge to the User entity by ids.
-func (m *PostMutation) AddLikedByIDs(ids ...uuid.UUID) {
-	if m.liked_by == nil {
-		m.liked_by = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.liked_by[ids[i]] = struct{}{}
-	}
+// ResetOwner resets all changes to the "owner" edge.
+func (m *CommentMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
 }
 
-// ClearLikedBy clears the "liked_by" edge to the User entity.
-func (m *PostMutation) ClearLikedBy() {
-	m.clearedliked_by = true
+// SetPostID sets the "post" edge to the Post entity by id.
+func (m *CommentMutation) SetPostID(id uuid.UUID) {
+	m.post = &id
 }
 
-// LikedByCleared reports if the "liked_by" edge to the User entity was cleared.
-func (m *PostMutation) LikedByCleared() bool {
-	return m.clearedliked_by
+// ClearPost clears the "post" edge to the Post entity.
+func (m *CommentMutation) ClearPost() {
+	m.clearedpost = true
 }
 
-// RemoveLikedByIDs removes the "liked_by" edge to the User entity by IDs.
-func (m *PostMutation) RemoveLikedByIDs(ids ...uuid.UUID) {
-	if m.removedliked_by == nil {
-		m.removedliked_by = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.liked_by, ids[i])
-		m.removedliked_by[ids[i]] = struct{}{}
-	}
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *CommentMutation) PostCleared() bool {
+	return m.clearedpost
 }
 
-// RemovedLikedBy returns the removed IDs of the "liked_by" edge to the User entity.
-func (m *PostMutation) RemovedLikedByIDs() (ids []uuid.UUID) {
-	for id := range m.removedliked_by {
-		ids = append(ids, id)
+// PostID returns the "post" edge ID in the mutation.
+func (m *CommentMutation) PostID() (id uuid.UUID, exists bool) {
+	if m.post != nil {
+		return *m.post, true
 	}
 	return
 }
 
-// LikedByIDs returns the "liked_by" edge IDs in the mutation.
-func (m *PostMutation) LikedByIDs() (ids []uuid.UUID) {
-	for id := range m.liked_by {
-		ids = append(ids, id)
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) PostIDs() (ids []uuid.UUID) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetLikedBy resets all changes to the "liked_by" edge.
-func (m *PostMutation) ResetLikedBy() {
-	m.liked_by = nil
-	m.clearedliked_by = false
-	m.removedliked_by = nil
+// ResetPost resets all changes to the "post" edge.
+func (m *CommentMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
 }
 
-// AddCategoryIDs adds the "categories" edge to the PostCategory entity by ids.
-func (m *PostMutation) AddCategoryIDs(ids ...uuid.UUID) {
-	if m.categories == nil {
-		m.categories = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.categories[ids[i]] = struct{}{}
-	}
+// Where appends a list predicates to the CommentMutation builder.
+func (m *CommentMutation) Where(ps ...predicate.Comment) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ClearCategories clears the "categories" edge to the PostCategory entity.
-func (m *PostMutation) ClearCategories() {
-	m.clearedcategories = true
-}
-
-// CategoriesCleared reports if the "categories" edge to the PostCategory entity was cleared.
-func (m *PostMutation) CategoriesCleared() bool {
-	return m.clearedcategories
-}
-
-// RemoveCategoryIDs removes the "categories" edge to the PostCategory entity by IDs.
-func (m *PostMutation) RemoveCategoryIDs(ids ...uuid.UUID) {
-	if m.removedcategories == nil {
-		m.removedcategories = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.categories, ids[i])
-		m.removedcategories[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedCategories returns the removed IDs of the "categories" edge to the PostCategory entity.
-func (m *PostMutation) RemovedCategoriesIDs() (ids []uuid.UUID) {
-	for id := range m.removedcategories {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// CategoriesIDs returns the "categories" edge IDs in the mutation.
-func (m *PostMutation) CategoriesIDs() (ids []uuid.UUID) {
-	for id := range m.categories {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetCategories resets all changes to the "categories" edge.
-func (m *PostMutation) ResetCategories() {
-	m.categories = nil
-	m.clearedcategories = false
-	m.removedcategories = nil
-}
-
-// Where appends a list predicates to the PostMutation builder.
-func (m *PostMutation) Where(ps ...predicate.Post) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the PostMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CommentMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Post, len(ps))
+func (m *CommentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Comment, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2394,66 +2788,42 @@ func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PostMutation) Op() Op {
+func (m *CommentMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PostMutation) SetOp(op Op) {
+func (m *CommentMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Post).
-func (m *PostMutation) Type() string {
+// Type returns the node type of this mutation (Comment).
+func (m *CommentMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PostMutation) Fields() []string {
-	fields := make([]string, 0, 14)
+func (m *CommentMutation) Fields() []string {
+	fields := make([]string, 0, 6)
 	if m.updated_at != nil {
-		fields = append(fields, post.FieldUpdatedAt)
+		fields = append(fields, comment.FieldUpdatedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, post.FieldCreatedAt)
+		fields = append(fields, comment.FieldCreatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, post.FieldDeletedAt)
+		fields = append(fields, comment.FieldDeletedAt)
 	}
 	if m.deleted_by != nil {
-		fields = append(fields, post.FieldDeletedBy)
+		fields = append(fields, comment.FieldDeletedBy)
 	}
 	if m.owner != nil {
-		fields = append(fields, post.FieldOwnerID)
-	}
-	if m.pinned != nil {
-		fields = append(fields, post.FieldPinned)
-	}
-	if m.title != nil {
-		fields = append(fields, post.FieldTitle)
+		fields = append(fields, comment.FieldOwnerID)
 	}
 	if m.content != nil {
-		fields = append(fields, post.FieldContent)
-	}
-	if m.link != nil {
-		fields = append(fields, post.FieldLink)
-	}
-	if m.moderation_comment != nil {
-		fields = append(fields, post.FieldModerationComment)
-	}
-	if m.is_moderated != nil {
-		fields = append(fields, post.FieldIsModerated)
-	}
-	if m.moderated_at != nil {
-		fields = append(fields, post.FieldModeratedAt)
-	}
-	if m.entity_vector != nil {
-		fields = append(fields, post.FieldEntityVector)
-	}
-	if m.metadata != nil {
-		fields = append(fields, post.FieldMetadata)
+		fields = append(fields, comment.FieldContent)
 	}
 	return fields
 }
@@ -2461,36 +2831,20 @@ func (m *PostMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PostMutation) Field(name string) (ent.Value, bool) {
+func (m *CommentMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case post.FieldUpdatedAt:
+	case comment.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case post.FieldCreatedAt:
+	case comment.FieldCreatedAt:
 		return m.CreatedAt()
-	case post.FieldDeletedAt:
+	case comment.FieldDeletedAt:
 		return m.DeletedAt()
-	case post.FieldDeletedBy:
+	case comment.FieldDeletedBy:
 		return m.DeletedBy()
-	case post.FieldOwnerID:
+	case comment.FieldOwnerID:
 		return m.OwnerID()
-	case post.FieldPinned:
-		return m.Pinned()
-	case post.FieldTitle:
-		return m.Title()
-	case post.FieldContent:
+	case comment.FieldContent:
 		return m.Content()
-	case post.FieldLink:
-		return m.Link()
-	case post.FieldModerationComment:
-		return m.ModerationComment()
-	case post.FieldIsModerated:
-		return m.IsModerated()
-	case post.FieldModeratedAt:
-		return m.ModeratedAt()
-	case post.FieldEntityVector:
-		return m.EntityVector()
-	case post.FieldMetadata:
-		return m.Metadata()
 	}
 	return nil, false
 }
@@ -2498,491 +2852,279 @@ func (m *PostMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CommentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case post.FieldUpdatedAt:
+	case comment.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case post.FieldCreatedAt:
+	case comment.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case post.FieldDeletedAt:
+	case comment.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case post.FieldDeletedBy:
+	case comment.FieldDeletedBy:
 		return m.OldDeletedBy(ctx)
-	case post.FieldOwnerID:
+	case comment.FieldOwnerID:
 		return m.OldOwnerID(ctx)
-	case post.FieldPinned:
-		return m.OldPinned(ctx)
-	case post.FieldTitle:
-		return m.OldTitle(ctx)
-	case post.FieldContent:
+	case comment.FieldContent:
 		return m.OldContent(ctx)
-	case post.FieldLink:
-		return m.OldLink(ctx)
-	case post.FieldModerationComment:
-		return m.OldModerationComment(ctx)
-	case post.FieldIsModerated:
-		return m.OldIsModerated(ctx)
-	case post.FieldModeratedAt:
-		return m.OldModeratedAt(ctx)
-	case post.FieldEntityVector:
-		return m.OldEntityVector(ctx)
-	case post.FieldMetadata:
-		return m.OldMetadata(ctx)
 	}
-	return nil, fmt.Errorf("unknown Post field %s", name)
+	return nil, fmt.Errorf("unknown Comment field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostMutation) SetField(name string, value ent.Value) error {
+func (m *CommentMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case post.FieldUpdatedAt:
+	case comment.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case post.FieldCreatedAt:
+	case comment.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case post.FieldDeletedAt:
+	case comment.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case post.FieldDeletedBy:
+	case comment.FieldDeletedBy:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedBy(v)
 		return nil
-	case post.FieldOwnerID:
+	case comment.FieldOwnerID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetOwnerID(v)
 		return nil
-	case post.FieldPinned:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPinned(v)
-		return nil
-	case post.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case post.FieldContent:
+	case comment.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetContent(v)
 		return nil
-	case post.FieldLink:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLink(v)
-		return nil
-	case post.FieldModerationComment:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetModerationComment(v)
-		return nil
-	case post.FieldIsModerated:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsModerated(v)
-		return nil
-	case post.FieldModeratedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetModeratedAt(v)
-		return nil
-	case post.FieldEntityVector:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEntityVector(v)
-		return nil
-	case post.FieldMetadata:
-		v, ok := value.(extramodel.PostMetadata)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMetadata(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Post field %s", name)
+	return fmt.Errorf("unknown Comment field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PostMutation) AddedFields() []string {
+func (m *CommentMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PostMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CommentMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostMutation) AddField(name string, value ent.Value) error {
+func (m *CommentMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Post numeric field %s", name)
+	return fmt.Errorf("unknown Comment numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PostMutation) ClearedFields() []string {
+func (m *CommentMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(post.FieldDeletedAt) {
-		fields = append(fields, post.FieldDeletedAt)
-	}
-	if m.FieldCleared(post.FieldDeletedBy) {
-		fields = append(fields, post.FieldDeletedBy)
+	if m.FieldCleared(comment.FieldDeletedAt) {
+		fields = append(fields, comment.FieldDeletedAt)
 	}
-	if m.FieldCleared(post.FieldContent) {
-		fields = append(fields, post.FieldContent)
-	}
-	if m.FieldCleared(post.FieldModerationComment) {
-		fields = append(fields, post.FieldModerationComment)
-	}
-	if m.FieldCleared(post.FieldModeratedAt) {
-		fields = append(fields, post.FieldModeratedAt)
-	}
-	if m.FieldCleared(post.FieldEntityVector) {
-		fields = append(fields, post.FieldEntityVector)
-	}
-	if m.FieldCleared(post.FieldMetadata) {
-		fields = append(fields, post.FieldMetadata)
+	if m.FieldCleared(comment.FieldDeletedBy) {
+		fields = append(fields, comment.FieldDeletedBy)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PostMutation) FieldCleared(name string) bool {
+func (m *CommentMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PostMutation) ClearField(name string) error {
+func (m *CommentMutation) ClearField(name string) error {
 	switch name {
-	case post.FieldDeletedAt:
+	case comment.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
-	case post.FieldDeletedBy:
+	case comment.FieldDeletedBy:
 		m.ClearDeletedBy()
 		return nil
-	case post.FieldContent:
-		m.ClearContent()
-		return nil
-	case post.FieldModerationComment:
-		m.ClearModerationComment()
-		return nil
-	case post.FieldModeratedAt:
-		m.ClearModeratedAt()
-		return nil
-	case post.FieldEntityVector:
-		m.ClearEntityVector()
-		return nil
-	case post.FieldMetadata:
-		m.ClearMetadata()
-		return nil
 	}
-	return fmt.Errorf("unknown Post nullable field %s", name)
+	return fmt.Errorf("unknown Comment nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PostMutation) ResetField(name string) error {
+func (m *CommentMutation) ResetField(name string) error {
 	switch name {
-	case post.FieldUpdatedAt:
+	case comment.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case post.FieldCreatedAt:
+	case comment.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case post.FieldDeletedAt:
+	case comment.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case post.FieldDeletedBy:
+	case comment.FieldDeletedBy:
 		m.ResetDeletedBy()
 		return nil
-	case post.FieldOwnerID:
+	case comment.FieldOwnerID:
 		m.ResetOwnerID()
 		return nil
-	case post.FieldPinned:
-		m.ResetPinned()
-		return nil
-	case post.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case post.FieldContent:
+	case comment.FieldContent:
 		m.ResetContent()
 		return nil
-	case post.FieldLink:
-		m.ResetLink()
-		return nil
-	case post.FieldModerationComment:
-		m.ResetModerationComment()
-		return nil
-	case post.FieldIsModerated:
-		m.ResetIsModerated()
-		return nil
-	case post.FieldModeratedAt:
-		m.ResetModeratedAt()
-		return nil
-	case post.FieldEntityVector:
-		m.ResetEntityVector()
-		return nil
-	case post.FieldMetadata:
-		m.ResetMetadata()
-		return nil
 	}
-	return fmt.Errorf("unknown Post field %s", name)
+	return fmt.Errorf("unknown Comment field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PostMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
+func (m *CommentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.owner != nil {
-		edges = append(edges, post.EdgeOwner)
-	}
-	if m.comments != nil {
-		edges = append(edges, post.EdgeComments)
-	}
-	if m.saved_by != nil {
-		edges = append(edges, post.EdgeSavedBy)
-	}
-	if m.liked_by != nil {
-		edges = append(edges, post.EdgeLikedBy)
+		edges = append(edges, comment.EdgeOwner)
 	}
-	if m.categories != nil {
-		edges = append(edges, post.EdgeCategories)
+	if m.post != nil {
+		edges = append(edges, comment.EdgePost)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PostMutation) AddedIDs(name string) []ent.Value {
+func (m *CommentMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case post.EdgeOwner:
+	case comment.EdgeOwner:
 		if id := m.owner; id != nil {
 			return []ent.Value{*id}
 		}
-	case post.EdgeComments:
-		ids := make([]ent.Value, 0, len(m.comments))
-		for id := range m.comments {
-			ids = append(ids, id)
-		}
-		return ids
-	case post.EdgeSavedBy:
-		ids := make([]ent.Value, 0, len(m.saved_by))
-		for id := range m.saved_by {
-			ids = append(ids, id)
-		}
-		return ids
-	case post.EdgeLikedBy:
-		ids := make([]ent.Value, 0, len(m.liked_by))
-		for id := range m.liked_by {
-			ids = append(ids, id)
-		}
-		return ids
-	case post.EdgeCategories:
-		ids := make([]ent.Value, 0, len(m.categories))
-		for id := range m.categories {
-			ids = append(ids, id)
+	case comment.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PostMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedcomments != nil {
-		edges = append(edges, post.EdgeComments)
-	}
-	if m.removedsaved_by != nil {
-		edges = append(edges, post.EdgeSavedBy)
-	}
-	if m.removedliked_by != nil {
-		edges = append(edges, post.EdgeLikedBy)
-	}
-	if m.removedcategories != nil {
-		edges = append(edges, post.EdgeCategories)
-	}
+func (m *CommentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PostMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case post.EdgeComments:
-		ids := make([]ent.Value, 0, len(m.removedcomments))
-		for id := range m.removedcomments {
-			ids = append(ids, id)
-		}
-		return ids
-	case post.EdgeSavedBy:
-		ids := make([]ent.Value, 0, len(m.removedsaved_by))
-		for id := range m.removedsaved_by {
-			ids = append(ids, id)
-		}
-		return ids
-	case post.EdgeLikedBy:
-		ids := make([]ent.Value, 0, len(m.removedliked_by))
-		for id := range m.removedliked_by {
-			ids = append(ids, id)
-		}
-		return ids
-	case post.EdgeCategories:
-		ids := make([]ent.Value, 0, len(m.removedcategories))
-		for id := range m.removedcategories {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *CommentMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PostMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
+func (m *CommentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.clearedowner {
-		edges = append(edges, post.EdgeOwner)
-	}
-	if m.clearedcomments {
-		edges = append(edges, post.EdgeComments)
-	}
-	if m.clearedsaved_by {
-		edges = append(edges, post.EdgeSavedBy)
-	}
-	if m.clearedliked_by {
-		edges = append(edges, post.EdgeLikedBy)
+		edges = append(edges, comment.EdgeOwner)
 	}
-	if m.clearedcategories {
-		edges = append(edges, post.EdgeCategories)
+	if m.clearedpost {
+		edges = append(edges, comment.EdgePost)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PostMutation) EdgeCleared(name string) bool {
+func (m *CommentMutation) EdgeCleared(name string) bool {
 	switch name {
-	case post.EdgeOwner:
+	case comment.EdgeOwner:
 		return m.clearedowner
-	case post.EdgeComments:
-		return m.clearedcomments
-	case post.EdgeSavedBy:
-		return m.clearedsaved_by
-	case post.EdgeLikedBy:
-		return m.clearedliked_by
-	case post.EdgeCategories:
-		return m.clearedcategories
+	case comment.EdgePost:
+		return m.clearedpost
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PostMutation) ClearEdge(name string) error {
+func (m *CommentMutation) ClearEdge(name string) error {
 	switch name {
-	case post.EdgeOwner:
+	case comment.EdgeOwner:
 		m.ClearOwner()
 		return nil
+	case comment.EdgePost:
+		m.ClearPost()
+		return nil
 	}
-	return fmt.Errorf("unknown Post unique edge %s", name)
+	return fmt.Errorf("unknown Comment unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PostMutation) ResetEdge(name string) error {
+func (m *CommentMutation) ResetEdge(name string) error {
 	switch name {
-	case post.EdgeOwner:
+	case comment.EdgeOwner:
 		m.ResetOwner()
 		return nil
-	case post.EdgeComments:
-		m.ResetComments()
-		return nil
-	case post.EdgeSavedBy:
-		m.ResetSavedBy()
-		return nil
-	case post.EdgeLikedBy:
-		m.ResetLikedBy()
-		return nil
-	case post.EdgeCategories:
-		m.ResetCategories()
+	case comment.EdgePost:
+		m.ResetPost()
 		return nil
 	}
-	return fmt.Errorf("unknown Post edge %s", name)
+	return fmt.Errorf("unknown Comment edge %s", name)
 }
 
-// PostCategoryMutation represents an operation that mutates the PostCategory nodes in the graph.
-type PostCategoryMutation struct {
+// IdentityMutation represents an operation that mutates the Identity nodes in the graph.
+type IdentityMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *uuid.UUID
 	updated_at    *time.Time
 	created_at    *time.Time
-	category      *postcategory.Category
+	provider      *identity.Provider
+	subject       *string
+	email         *string
 	clearedFields map[string]struct{}
-	post          *uuid.UUID
-	clearedpost   bool
+	owner         *uuid.UUID
+	clearedowner  bool
 	done          bool
-	oldValue      func(context.Context) (*PostCategory, error)
-	predicates    []predicate.PostCategory
+	oldValue      func(context.Context) (*Identity, error)
+	predicates    []predicate.Identity
 }
 
-var _ ent.Mutation = (*PostCategoryMutation)(nil)
+var _ ent.Mutation = (*IdentityMutation)(nil)
 
-// postcategoryOption allows management of the mutation configuration using functional options.
-type postcategoryOption func(*PostCategoryMutation)
+// identityOption allows management of the mutation configuration using functional options.
+type identityOption func(*IdentityMutation)
 
-// newPostCategoryMutation creates new mutation for the PostCategory entity.
-func newPostCategoryMutation(c config, op Op, opts ...postcategoryOption) *PostCategoryMutation {
-	m := &PostCategoryMutation{
+// newIdentityMutation creates new mutation for the Identity entity.
+func newIdentityMutation(c config, op Op, opts ...identityOption) *IdentityMutation {
+	m := &IdentityMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePostCategory,
+		typ:           TypeIdentity,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2991,20 +3133,20 @@ func newPostCategoryMutation(c config, op Op, opts ...postcategoryOption) *PostC
 	return m
 }
 
-// withPostCategoryID sets the ID field of the mutation.
-func withPostCategoryID(id uuid.UUID) postcategoryOption {
-	return func(m *PostCategoryMutation) {
+// withIdentityID sets the ID field of the mutation.
+func withIdentityID(id uuid.UUID) identityOption {
+	return func(m *IdentityMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PostCategory
+			value *Identity
 		)
-		m.oldValue = func(ctx context.Context) (*PostCategory, error) {
+		m.oldValue = func(ctx context.Context) (*Identity, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PostCategory.Get(ctx, id)
+					value, err = m.Client().Identity.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3013,10 +3155,10 @@ func withPostCategoryID(id uuid.UUID) postcategoryOption {
 	}
 }
 
-// withPostCategory sets the old PostCategory of the mutation.
-func withPostCategory(node *PostCategory) postcategoryOption {
-	return func(m *PostCategoryMutation) {
-		m.oldValue = func(context.Context) (*PostCategory, error) {
+// withIdentity sets the old Identity of the mutation.
+func withIdentity(node *Identity) identityOption {
+	return func(m *IdentityMutation) {
+		m.oldValue = func(context.Context) (*Identity, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3025,7 +3167,7 @@ func withPostCategory(node *PostCategory) postcategoryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PostCategoryMutation) Client() *Client {
+func (m IdentityMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3033,7 +3175,7 @@ func (m PostCategoryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PostCategoryMutation) Tx() (*Tx, error) {
+func (m IdentityMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
@@ -3043,14 +3185,14 @@ func (m PostCategoryMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of PostCategory entities.
-func (m *PostCategoryMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Identity entities.
+func (m *IdentityMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PostCategoryMutation) ID() (id uuid.UUID, exists bool) {
+func (m *IdentityMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3061,7 +3203,7 @@ func (m *PostCategoryMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PostCategoryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *IdentityMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3070,19 +3212,19 @@ func (m *PostCategoryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PostCategory.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Identity.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *PostCategoryMutation) SetUpdatedAt(t time.Time) {
+func (m *IdentityMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PostCategoryMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *IdentityMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3090,10 +3232,10 @@ func (m *PostCategoryMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the PostCategory entity.
-// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostCategoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *IdentityMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3108,17 +3250,17 @@ func (m *PostCategoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PostCategoryMutation) ResetUpdatedAt() {
+func (m *IdentityMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *PostCategoryMutation) SetCreatedAt(t time.Time) {
+func (m *IdentityMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PostCategoryMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *IdentityMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3126,10 +3268,10 @@ func (m *PostCategoryMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PostCategory entity.
-// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostCategoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *IdentityMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3144,94 +3286,203 @@ func (m *PostCategoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PostCategoryMutation) ResetCreatedAt() {
+func (m *IdentityMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetCategory sets the "category" field.
-func (m *PostCategoryMutation) SetCategory(po postcategory.Category) {
-	m.category = &po
+// SetOwnerID sets the "owner_id" field.
+func (m *IdentityMutation) SetOwnerID(u uuid.UUID) {
+	m.owner = &u
 }
 
-// Category returns the value of the "category" field in the mutation.
-func (m *PostCategoryMutation) Category() (r postcategory.Category, exists bool) {
-	v := m.category
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *IdentityMutation) OwnerID() (r uuid.UUID, exists bool) {
+	v := m.owner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCategory returns the old "category" field's value of the PostCategory entity.
-// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
+// OldOwnerID returns the old "owner_id" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostCategoryMutation) OldCategory(ctx context.Context) (v postcategory.Category, err error) {
+func (m *IdentityMutation) OldOwnerID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategory requires an ID field in the mutation")
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
 	}
-	return oldValue.Category, nil
+	return oldValue.OwnerID, nil
 }
 
-// ResetCategory resets all changes to the "category" field.
-func (m *PostCategoryMutation) ResetCategory() {
-	m.category = nil
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *IdentityMutation) ResetOwnerID() {
+	m.owner = nil
 }
 
-// SetPostID sets the "post" edge to the Post entity by id.
-func (m *PostCategoryMutation) SetPostID(id uuid.UUID) {
-	m.post = &id
+// SetProvider sets the "provider" field.
+func (m *IdentityMutation) SetProvider(i identity.Provider) {
+	m.provider = &i
 }
 
-// ClearPost clears the "post" edge to the Post entity.
-func (m *PostCategoryMutation) ClearPost() {
-	m.clearedpost = true
+// Provider returns the value of the "provider" field in the mutation.
+func (m *IdentityMutation) Provider() (r identity.Provider, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// PostCleared reports if the "post" edge to the Post entity was cleared.
-func (m *PostCategoryMutation) PostCleared() bool {
-	return m.clearedpost
+// OldProvider returns the old "provider" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldProvider(ctx context.Context) (v identity.Provider, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
 }
 
-// PostID returns the "post" edge ID in the mutation.
-func (m *PostCategoryMutation) PostID() (id uuid.UUID, exists bool) {
-	if m.post != nil {
-		return *m.post, true
+// ResetProvider resets all changes to the "provider" field.
+func (m *IdentityMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetSubject sets the "subject" field.
+func (m *IdentityMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *IdentityMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// PostIDs returns the "post" edge IDs in the mutation.
+// OldSubject returns the old "subject" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
+}
+
+// ResetSubject resets all changes to the "subject" field.
+func (m *IdentityMutation) ResetSubject() {
+	m.subject = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *IdentityMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *IdentityMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldEmail(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ClearEmail clears the value of the "email" field.
+func (m *IdentityMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[identity.FieldEmail] = struct{}{}
+}
+
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *IdentityMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[identity.FieldEmail]
+	return ok
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *IdentityMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, identity.FieldEmail)
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *IdentityMutation) ClearOwner() {
+	m.clearedowner = true
+	m.clearedFields[identity.FieldOwnerID] = struct{}{}
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *IdentityMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PostID instead. It exists only for internal usage by the builders.
-func (m *PostCategoryMutation) PostIDs() (ids []uuid.UUID) {
-	if id := m.post; id != nil {
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *IdentityMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetPost resets all changes to the "post" edge.
-func (m *PostCategoryMutation) ResetPost() {
-	m.post = nil
-	m.clearedpost = false
+// ResetOwner resets all changes to the "owner" edge.
+func (m *IdentityMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
 }
 
-// Where appends a list predicates to the PostCategoryMutation builder.
-func (m *PostCategoryMutation) Where(ps ...predicate.PostCategory) {
+// Where appends a list predicates to the IdentityMutation builder.
+func (m *IdentityMutation) Where(ps ...predicate.Identity) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PostCategoryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the IdentityMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PostCategoryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PostCategory, len(ps))
+func (m *IdentityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Identity, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3239,33 +3490,42 @@ func (m *PostCategoryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PostCategoryMutation) Op() Op {
+func (m *IdentityMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PostCategoryMutation) SetOp(op Op) {
+func (m *IdentityMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PostCategory).
-func (m *PostCategoryMutation) Type() string {
+// Type returns the node type of this mutation (Identity).
+func (m *IdentityMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PostCategoryMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+func (m *IdentityMutation) Fields() []string {
+	fields := make([]string, 0, 6)
 	if m.updated_at != nil {
-		fields = append(fields, postcategory.FieldUpdatedAt)
+		fields = append(fields, identity.FieldUpdatedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, postcategory.FieldCreatedAt)
+		fields = append(fields, identity.FieldCreatedAt)
 	}
-	if m.category != nil {
-		fields = append(fields, postcategory.FieldCategory)
+	if m.owner != nil {
+		fields = append(fields, identity.FieldOwnerID)
+	}
+	if m.provider != nil {
+		fields = append(fields, identity.FieldProvider)
+	}
+	if m.subject != nil {
+		fields = append(fields, identity.FieldSubject)
+	}
+	if m.email != nil {
+		fields = append(fields, identity.FieldEmail)
 	}
 	return fields
 }
@@ -3273,14 +3533,20 @@ func (m *PostCategoryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PostCategoryMutation) Field(name string) (ent.Value, bool) {
+func (m *IdentityMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case postcategory.FieldUpdatedAt:
+	case identity.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case postcategory.FieldCreatedAt:
+	case identity.FieldCreatedAt:
 		return m.CreatedAt()
-	case postcategory.FieldCategory:
-		return m.Category()
+	case identity.FieldOwnerID:
+		return m.OwnerID()
+	case identity.FieldProvider:
+		return m.Provider()
+	case identity.FieldSubject:
+		return m.Subject()
+	case identity.FieldEmail:
+		return m.Email()
 	}
 	return nil, false
 }
@@ -3288,121 +3554,166 @@ func (m *PostCategoryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PostCategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *IdentityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case postcategory.FieldUpdatedAt:
+	case identity.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case postcategory.FieldCreatedAt:
+	case identity.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case postcategory.FieldCategory:
-		return m.OldCategory(ctx)
+	case identity.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case identity.FieldProvider:
+		return m.OldProvider(ctx)
+	case identity.FieldSubject:
+		return m.OldSubject(ctx)
+	case identity.FieldEmail:
+		return m.OldEmail(ctx)
 	}
-	return nil, fmt.Errorf("unknown PostCategory field %s", name)
+	return nil, fmt.Errorf("unknown Identity field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostCategoryMutation) SetField(name string, value ent.Value) error {
+func (m *IdentityMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case postcategory.FieldUpdatedAt:
+	case identity.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case postcategory.FieldCreatedAt:
+	case identity.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case postcategory.FieldCategory:
-		v, ok := value.(postcategory.Category)
+	case identity.FieldOwnerID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCategory(v)
+		m.SetOwnerID(v)
+		return nil
+	case identity.FieldProvider:
+		v, ok := value.(identity.Provider)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case identity.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case identity.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PostCategory field %s", name)
+	return fmt.Errorf("unknown Identity field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PostCategoryMutation) AddedFields() []string {
+func (m *IdentityMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PostCategoryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *IdentityMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostCategoryMutation) AddField(name string, value ent.Value) error {
+func (m *IdentityMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown PostCategory numeric field %s", name)
+	return fmt.Errorf("unknown Identity numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PostCategoryMutation) ClearedFields() []string {
-	return nil
+func (m *IdentityMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(identity.FieldEmail) {
+		fields = append(fields, identity.FieldEmail)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PostCategoryMutation) FieldCleared(name string) bool {
+func (m *IdentityMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PostCategoryMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown PostCategory nullable field %s", name)
+func (m *IdentityMutation) ClearField(name string) error {
+	switch name {
+	case identity.FieldEmail:
+		m.ClearEmail()
+		return nil
+	}
+	return fmt.Errorf("unknown Identity nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PostCategoryMutation) ResetField(name string) error {
+func (m *IdentityMutation) ResetField(name string) error {
 	switch name {
-	case postcategory.FieldUpdatedAt:
+	case identity.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case postcategory.FieldCreatedAt:
+	case identity.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case postcategory.FieldCategory:
-		m.ResetCategory()
+	case identity.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case identity.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case identity.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case identity.FieldEmail:
+		m.ResetEmail()
 		return nil
 	}
-	return fmt.Errorf("unknown PostCategory field %s", name)
+	return fmt.Errorf("unknown Identity field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PostCategoryMutation) AddedEdges() []string {
+func (m *IdentityMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.post != nil {
-		edges = append(edges, postcategory.EdgePost)
+	if m.owner != nil {
+		edges = append(edges, identity.EdgeOwner)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PostCategoryMutation) AddedIDs(name string) []ent.Value {
+func (m *IdentityMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case postcategory.EdgePost:
-		if id := m.post; id != nil {
+	case identity.EdgeOwner:
+		if id := m.owner; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -3410,90 +3721,108 @@ func (m *PostCategoryMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PostCategoryMutation) RemovedEdges() []string {
+func (m *IdentityMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PostCategoryMutation) RemovedIDs(name string) []ent.Value {
+func (m *IdentityMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PostCategoryMutation) ClearedEdges() []string {
+func (m *IdentityMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedpost {
-		edges = append(edges, postcategory.EdgePost)
+	if m.clearedowner {
+		edges = append(edges, identity.EdgeOwner)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PostCategoryMutation) EdgeCleared(name string) bool {
+func (m *IdentityMutation) EdgeCleared(name string) bool {
 	switch name {
-	case postcategory.EdgePost:
-		return m.clearedpost
+	case identity.EdgeOwner:
+		return m.clearedowner
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PostCategoryMutation) ClearEdge(name string) error {
+func (m *IdentityMutation) ClearEdge(name string) error {
 	switch name {
-	case postcategory.EdgePost:
-		m.ClearPost()
+	case identity.EdgeOwner:
+		m.ClearOwner()
 		return nil
 	}
-	return fmt.Errorf("unknown PostCategory unique edge %s", name)
+	return fmt.Errorf("unknown Identity unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PostCategoryMutation) ResetEdge(name string) error {
+func (m *IdentityMutation) ResetEdge(name string) error {
 	switch name {
-	case postcategory.EdgePost:
-		m.ResetPost()
+	case identity.EdgeOwner:
+		m.ResetOwner()
 		return nil
 	}
-	return fmt.Errorf("unknown PostCategory edge %s", name)
+	return fmt.Errorf("unknown Identity edge %s", name)
 }
 
-// RefreshTokenMutation represents an operation that mutates the RefreshToken nodes in the graph.
-type RefreshTokenMutation struct {
+// PostMutation represents an operation that mutates the Post nodes in the graph.
+type PostMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	updated_at    *time.Time
-	created_at    *time.Time
-	token_hash    *string
-	expires_at    *time.Time
-	revoked       *bool
-	ip_address    *string
-	user_agent    *string
-	clearedFields map[string]struct{}
-	owner         *uuid.UUID
-	clearedowner  bool
-	done          bool
-	oldValue      func(context.Context) (*RefreshToken, error)
-	predicates    []predicate.RefreshToken
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	updated_at         *time.Time
+	created_at         *time.Time
+	deleted_at         *time.Time
+	deleted_by         *string
+	pinned             *bool
+	title              *string
+	content            *string
+	link               *string
+	moderation_comment *string
+	is_moderated       *bool
+	moderated_at       *time.Time
+	entity_vector      *string
+	metadata           *extramodel.PostMetadata
+	clearedFields      map[string]struct{}
+	owner              *uuid.UUID
+	clearedowner       bool
+	comments           map[uuid.UUID]struct{}
+	removedcomments    map[uuid.UUID]struct{}
+	clearedcomments    bool
+	saved_by           map[uuid.UUID]struct{}
+	removedsaved_by    map[uuid.UUID]struct{}
+	clearedsaved_by    bool
+	liked_by           map[uuid.UUID]struct{}
+	removedliked_by    map[uuid.UUID]struct{}
+	clearedliked_by    bool
+	categories         map[uuid.UUID]struct{}
+	removedcategories  map[uuid.UUID]struct{}
+	clearedcategories  bool
+	done               bool
+	oldValue           func(context.Context) (*Post, error)
+	predicates         []predicate.Post
 }
 
-var _ ent.Mutation = (*RefreshTokenMutation)(nil)
+var _ ent.Mutation = (*PostMutation)(nil)
 
-// refreshtokenOption allows management of the mutation configuration using functional options.
-type refreshtokenOption func(*RefreshTokenMutation)
+// postOption allows management of the mutation configuration using functional options.
+type postOption func(*PostMutation)
 
-// newRefreshTokenMutation creates new mutation for the RefreshToken entity.
-func newRefreshTokenMutation(c config, op Op, opts ...refreshtokenOption) *RefreshTokenMutation {
-	m := &RefreshTokenMutation{
+// newPostMutation creates new mutation for the Post entity.
+func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
+	m := &PostMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRefreshToken,
+		typ:           TypePost,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3502,20 +3831,20 @@ func newRefreshTokenMutation(c config, op Op, opts ...refreshtokenOption) *Refre
 	return m
 }
 
-// withRefreshTokenID sets the ID field of the mutation.
-func withRefreshTokenID(id uuid.UUID) refreshtokenOption {
-	return func(m *RefreshTokenMutation) {
+// withPostID sets the ID field of the mutation.
+func withPostID(id uuid.UUID) postOption {
+	return func(m *PostMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *RefreshToken
+			value *Post
 		)
-		m.oldValue = func(ctx context.Context) (*RefreshToken, error) {
+		m.oldValue = func(ctx context.Context) (*Post, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().RefreshToken.Get(ctx, id)
+					value, err = m.Client().Post.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3524,10 +3853,10 @@ func withRefreshTokenID(id uuid.UUID) refreshtokenOption {
 	}
 }
 
-// withRefreshToken sets the old RefreshToken of the mutation.
-func withRefreshToken(node *RefreshToken) refreshtokenOption {
-	return func(m *RefreshTokenMutation) {
-		m.oldValue = func(context.Context) (*RefreshToken, error) {
+// withPost sets the old Post of the mutation.
+func withPost(node *Post) postOption {
+	return func(m *PostMutation) {
+		m.oldValue = func(context.Context) (*Post, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3536,7 +3865,7 @@ func withRefreshToken(node *RefreshToken) refreshtokenOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RefreshTokenMutation) Client() *Client {
+func (m PostMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3544,7 +3873,7 @@ func (m RefreshTokenMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RefreshTokenMutation) Tx() (*Tx, error) {
+func (m PostMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
@@ -3554,14 +3883,14 @@ func (m RefreshTokenMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of RefreshToken entities.
-func (m *RefreshTokenMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Post entities.
+func (m *PostMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RefreshTokenMutation) ID() (id uuid.UUID, exists bool) {
+func (m *PostMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3572,7 +3901,7 @@ func (m *RefreshTokenMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RefreshTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *PostMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3581,19 +3910,19 @@ func (m *RefreshTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().RefreshToken.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Post.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *RefreshTokenMutation) SetUpdatedAt(t time.Time) {
+func (m *PostMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *RefreshTokenMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *PostMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3601,10 +3930,10 @@ func (m *RefreshTokenMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PostMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3619,17 +3948,17 @@ func (m *RefreshTokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *RefreshTokenMutation) ResetUpdatedAt() {
+func (m *PostMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *RefreshTokenMutation) SetCreatedAt(t time.Time) {
+func (m *PostMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RefreshTokenMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *PostMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3637,10 +3966,10 @@ func (m *RefreshTokenMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3655,1674 +3984,8399 @@ func (m *RefreshTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RefreshTokenMutation) ResetCreatedAt() {
+func (m *PostMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetOwnerID sets the "owner_id" field.
-func (m *RefreshTokenMutation) SetOwnerID(u uuid.UUID) {
-	m.owner = &u
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PostMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// OwnerID returns the value of the "owner_id" field in the mutation.
-func (m *RefreshTokenMutation) OwnerID() (r uuid.UUID, exists bool) {
-	v := m.owner
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PostMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwnerID returns the old "owner_id" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldOwnerID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *PostMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.OwnerID, nil
+	return oldValue.DeletedAt, nil
 }
 
-// ResetOwnerID resets all changes to the "owner_id" field.
-func (m *RefreshTokenMutation) ResetOwnerID() {
-	m.owner = nil
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PostMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[post.FieldDeletedAt] = struct{}{}
 }
 
-// SetTokenHash sets the "token_hash" field.
-func (m *RefreshTokenMutation) SetTokenHash(s string) {
-	m.token_hash = &s
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PostMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[post.FieldDeletedAt]
+	return ok
 }
 
-// TokenHash returns the value of the "token_hash" field in the mutation.
-func (m *RefreshTokenMutation) TokenHash() (r string, exists bool) {
-	v := m.token_hash
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PostMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, post.FieldDeletedAt)
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (m *PostMutation) SetDeletedBy(s string) {
+	m.deleted_by = &s
+}
+
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *PostMutation) DeletedBy() (r string, exists bool) {
+	v := m.deleted_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTokenHash returns the old "token_hash" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedBy returns the old "deleted_by" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+func (m *PostMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
 	}
-	return oldValue.TokenHash, nil
+	return oldValue.DeletedBy, nil
 }
 
-// ResetTokenHash resets all changes to the "token_hash" field.
-func (m *RefreshTokenMutation) ResetTokenHash() {
-	m.token_hash = nil
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *PostMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.clearedFields[post.FieldDeletedBy] = struct{}{}
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *RefreshTokenMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *PostMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[post.FieldDeletedBy]
+	return ok
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *RefreshTokenMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *PostMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	delete(m.clearedFields, post.FieldDeletedBy)
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *PostMutation) SetOwnerID(u uuid.UUID) {
+	m.owner = &u
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *PostMutation) OwnerID() (r uuid.UUID, exists bool) {
+	v := m.owner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldOwnerID returns the old "owner_id" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+func (m *PostMutation) OldOwnerID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
 	}
-	return oldValue.ExpiresAt, nil
+	return oldValue.OwnerID, nil
 }
 
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *RefreshTokenMutation) ResetExpiresAt() {
-	m.expires_at = nil
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *PostMutation) ResetOwnerID() {
+	m.owner = nil
 }
 
-// SetRevoked sets the "revoked" field.
-func (m *RefreshTokenMutation) SetRevoked(b bool) {
-	m.revoked = &b
+// SetPinned sets the "pinned" field.
+func (m *PostMutation) SetPinned(b bool) {
+	m.pinned = &b
 }
 
-// Revoked returns the value of the "revoked" field in the mutation.
-func (m *RefreshTokenMutation) Revoked() (r bool, exists bool) {
-	v := m.revoked
+// Pinned returns the value of the "pinned" field in the mutation.
+func (m *PostMutation) Pinned() (r bool, exists bool) {
+	v := m.pinned
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRevoked returns the old "revoked" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldPinned returns the old "pinned" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldRevoked(ctx context.Context) (v bool, err error) {
+func (m *PostMutation) OldPinned(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRevoked is only allowed on UpdateOne operations")
+		return v, errors.New("OldPinned is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRevoked requires an ID field in the mutation")
+		return v, errors.New("OldPinned requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRevoked: %w", err)
+		return v, fmt.Errorf("querying old value for OldPinned: %w", err)
 	}
-	return oldValue.Revoked, nil
+	return oldValue.Pinned, nil
 }
 
-// ResetRevoked resets all changes to the "revoked" field.
-func (m *RefreshTokenMutation) ResetRevoked() {
-	m.revoked = nil
+// ResetPinned resets all changes to the "pinned" field.
+func (m *PostMutation) ResetPinned() {
+	m.pinned = nil
 }
 
-// SetIPAddress sets the "ip_address" field.
-func (m *RefreshTokenMutation) SetIPAddress(s string) {
-	m.ip_address = &s
+// SetTitle sets the "title" field.
+func (m *PostMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// IPAddress returns the value of the "ip_address" field in the mutation.
-func (m *RefreshTokenMutation) IPAddress() (r string, exists bool) {
-	v := m.ip_address
+// Title returns the value of the "title" field in the mutation.
+func (m *PostMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIPAddress returns the old "ip_address" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldIPAddress(ctx context.Context) (v string, err error) {
+func (m *PostMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.IPAddress, nil
-}
-
-// ClearIPAddress clears the value of the "ip_address" field.
-func (m *RefreshTokenMutation) ClearIPAddress() {
-	m.ip_address = nil
-	m.clearedFields[refreshtoken.FieldIPAddress] = struct{}{}
-}
-
-// IPAddressCleared returns if the "ip_address" field was cleared in this mutation.
-func (m *RefreshTokenMutation) IPAddressCleared() bool {
-	_, ok := m.clearedFields[refreshtoken.FieldIPAddress]
-	return ok
+	return oldValue.Title, nil
 }
 
-// ResetIPAddress resets all changes to the "ip_address" field.
-func (m *RefreshTokenMutation) ResetIPAddress() {
-	m.ip_address = nil
-	delete(m.clearedFields, refreshtoken.FieldIPAddress)
+// ResetTitle resets all changes to the "title" field.
+func (m *PostMutation) ResetTitle() {
+	m.title = nil
 }
 
-// SetUserAgent sets the "user_agent" field.
-func (m *RefreshTokenMutation) SetUserAgent(s string) {
-	m.user_agent = &s
+// SetContent sets the "content" field.
+func (m *PostMutation) SetContent(s string) {
+	m.content = &s
 }
 
-// UserAgent returns the value of the "user_agent" field in the mutation.
-func (m *RefreshTokenMutation) UserAgent() (r string, exists bool) {
-	v := m.user_agent
+// Content returns the value of the "content" field in the mutation.
+func (m *PostMutation) Content() (r string, exists bool) {
+	v := m.content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserAgent returns the old "user_agent" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldContent returns the old "content" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+func (m *PostMutation) OldContent(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+		return v, errors.New("OldContent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
 	}
-	return oldValue.UserAgent, nil
+	return oldValue.Content, nil
 }
 
-// ClearUserAgent clears the value of the "user_agent" field.
-func (m *RefreshTokenMutation) ClearUserAgent() {
-	m.user_agent = nil
-	m.clearedFields[refreshtoken.FieldUserAgent] = struct{}{}
+// ClearContent clears the value of the "content" field.
+func (m *PostMutation) ClearContent() {
+	m.content = nil
+	m.clearedFields[post.FieldContent] = struct{}{}
 }
 
-// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
-func (m *RefreshTokenMutation) UserAgentCleared() bool {
-	_, ok := m.clearedFields[refreshtoken.FieldUserAgent]
+// ContentCleared returns if the "content" field was cleared in this mutation.
+func (m *PostMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[post.FieldContent]
 	return ok
 }
 
-// ResetUserAgent resets all changes to the "user_agent" field.
-func (m *RefreshTokenMutation) ResetUserAgent() {
-	m.user_agent = nil
-	delete(m.clearedFields, refreshtoken.FieldUserAgent)
+// ResetContent resets all changes to the "content" field.
+func (m *PostMutation) ResetContent() {
+	m.content = nil
+	delete(m.clearedFields, post.FieldContent)
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *RefreshTokenMutation) ClearOwner() {
-	m.clearedowner = true
-	m.clearedFields[refreshtoken.FieldOwnerID] = struct{}{}
+// SetLink sets the "link" field.
+func (m *PostMutation) SetLink(s string) {
+	m.link = &s
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *RefreshTokenMutation) OwnerCleared() bool {
-	return m.clearedowner
+// Link returns the value of the "link" field in the mutation.
+func (m *PostMutation) Link() (r string, exists bool) {
+	v := m.link
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *RefreshTokenMutation) OwnerIDs() (ids []uuid.UUID) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
+// OldLink returns the old "link" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldLink(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLink is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLink requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLink: %w", err)
+	}
+	return oldValue.Link, nil
 }
 
-// ResetOwner resets all changes to the "owner" edge.
-func (m *RefreshTokenMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
+// ResetLink resets all changes to the "link" field.
+func (m *PostMutation) ResetLink() {
+	m.link = nil
 }
 
-// Where appends a list predicates to the RefreshTokenMutation builder.
-func (m *RefreshTokenMutation) Where(ps ...predicate.RefreshToken) {
-	m.predicates = append(m.predicates, ps...)
+// SetModerationComment sets the "moderation_comment" field.
+func (m *PostMutation) SetModerationComment(s string) {
+	m.moderation_comment = &s
 }
 
-// WhereP appends storage-level predicates to the RefreshTokenMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RefreshTokenMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.RefreshToken, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ModerationComment returns the value of the "moderation_comment" field in the mutation.
+func (m *PostMutation) ModerationComment() (r string, exists bool) {
+	v := m.moderation_comment
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *RefreshTokenMutation) Op() Op {
-	return m.op
+// OldModerationComment returns the old "moderation_comment" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldModerationComment(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModerationComment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModerationComment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModerationComment: %w", err)
+	}
+	return oldValue.ModerationComment, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *RefreshTokenMutation) SetOp(op Op) {
-	m.op = op
+// ClearModerationComment clears the value of the "moderation_comment" field.
+func (m *PostMutation) ClearModerationComment() {
+	m.moderation_comment = nil
+	m.clearedFields[post.FieldModerationComment] = struct{}{}
 }
 
-// Type returns the node type of this mutation (RefreshToken).
-func (m *RefreshTokenMutation) Type() string {
-	return m.typ
+// ModerationCommentCleared returns if the "moderation_comment" field was cleared in this mutation.
+func (m *PostMutation) ModerationCommentCleared() bool {
+	_, ok := m.clearedFields[post.FieldModerationComment]
+	return ok
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *RefreshTokenMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.updated_at != nil {
-		fields = append(fields, refreshtoken.FieldUpdatedAt)
-	}
-	if m.created_at != nil {
-		fields = append(fields, refreshtoken.FieldCreatedAt)
-	}
-	if m.owner != nil {
-		fields = append(fields, refreshtoken.FieldOwnerID)
-	}
-	if m.token_hash != nil {
-		fields = append(fields, refreshtoken.FieldTokenHash)
+// ResetModerationComment resets all changes to the "moderation_comment" field.
+func (m *PostMutation) ResetModerationComment() {
+	m.moderation_comment = nil
+	delete(m.clearedFields, post.FieldModerationComment)
+}
+
+// SetIsModerated sets the "is_moderated" field.
+func (m *PostMutation) SetIsModerated(b bool) {
+	m.is_moderated = &b
+}
+
+// IsModerated returns the value of the "is_moderated" field in the mutation.
+func (m *PostMutation) IsModerated() (r bool, exists bool) {
+	v := m.is_moderated
+	if v == nil {
+		return
 	}
-	if m.expires_at != nil {
-		fields = append(fields, refreshtoken.FieldExpiresAt)
+	return *v, true
+}
+
+// OldIsModerated returns the old "is_moderated" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldIsModerated(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsModerated is only allowed on UpdateOne operations")
 	}
-	if m.revoked != nil {
-		fields = append(fields, refreshtoken.FieldRevoked)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsModerated requires an ID field in the mutation")
 	}
-	if m.ip_address != nil {
-		fields = append(fields, refreshtoken.FieldIPAddress)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsModerated: %w", err)
 	}
-	if m.user_agent != nil {
-		fields = append(fields, refreshtoken.FieldUserAgent)
-	}
-	return fields
+	return oldValue.IsModerated, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *RefreshTokenMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case refreshtoken.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case refreshtoken.FieldCreatedAt:
-		return m.CreatedAt()
-	case refreshtoken.FieldOwnerID:
-		return m.OwnerID()
-	case refreshtoken.FieldTokenHash:
-		return m.TokenHash()
-	case refreshtoken.FieldExpiresAt:
-		return m.ExpiresAt()
-	case refreshtoken.FieldRevoked:
-		return m.Revoked()
-	case refreshtoken.FieldIPAddress:
-		return m.IPAddress()
-	case refreshtoken.FieldUserAgent:
-		return m.UserAgent()
-	}
-	return nil, false
+// ResetIsModerated resets all changes to the "is_moderated" field.
+func (m *PostMutation) ResetIsModerated() {
+	m.is_moderated = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *RefreshTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case refreshtoken.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case refreshtoken.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case refreshtoken.FieldOwnerID:
-		return m.OldOwnerID(ctx)
-	case refreshtoken.FieldTokenHash:
-		return m.OldTokenHash(ctx)
-	case refreshtoken.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
-	case refreshtoken.FieldRevoked:
-		return m.OldRevoked(ctx)
-	case refreshtoken.FieldIPAddress:
-		return m.OldIPAddress(ctx)
-	case refreshtoken.FieldUserAgent:
-		return m.OldUserAgent(ctx)
+// SetModeratedAt sets the "moderated_at" field.
+func (m *PostMutation) SetModeratedAt(t time.Time) {
+	m.moderated_at = &t
+}
+
+// ModeratedAt returns the value of the "moderated_at" field in the mutation.
+func (m *PostMutation) ModeratedAt() (r time.Time, exists bool) {
+	v := m.moderated_at
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown RefreshToken field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *RefreshTokenMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case refreshtoken.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case refreshtoken.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case refreshtoken.FieldOwnerID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOwnerID(v)
-		return nil
-	case refreshtoken.FieldTokenHash:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTokenHash(v)
-		return nil
-	case refreshtoken.FieldExpiresAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExpiresAt(v)
-		return nil
-	case refreshtoken.FieldRevoked:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRevoked(v)
-		return nil
-	case refreshtoken.FieldIPAddress:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIPAddress(v)
-		return nil
-	case refreshtoken.FieldUserAgent:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserAgent(v)
-		return nil
+// OldModeratedAt returns the old "moderated_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldModeratedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModeratedAt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown RefreshToken field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModeratedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModeratedAt: %w", err)
+	}
+	return oldValue.ModeratedAt, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *RefreshTokenMutation) AddedFields() []string {
-	return nil
+// ClearModeratedAt clears the value of the "moderated_at" field.
+func (m *PostMutation) ClearModeratedAt() {
+	m.moderated_at = nil
+	m.clearedFields[post.FieldModeratedAt] = struct{}{}
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *RefreshTokenMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// ModeratedAtCleared returns if the "moderated_at" field was cleared in this mutation.
+func (m *PostMutation) ModeratedAtCleared() bool {
+	_, ok := m.clearedFields[post.FieldModeratedAt]
+	return ok
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *RefreshTokenMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// ResetModeratedAt resets all changes to the "moderated_at" field.
+func (m *PostMutation) ResetModeratedAt() {
+	m.moderated_at = nil
+	delete(m.clearedFields, post.FieldModeratedAt)
+}
+
+// SetEntityVector sets the "entity_vector" field.
+func (m *PostMutation) SetEntityVector(s string) {
+	m.entity_vector = &s
+}
+
+// EntityVector returns the value of the "entity_vector" field in the mutation.
+func (m *PostMutation) EntityVector() (r string, exists bool) {
+	v := m.entity_vector
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown RefreshToken numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *RefreshTokenMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(refreshtoken.FieldIPAddress) {
-		fields = append(fields, refreshtoken.FieldIPAddress)
+// OldEntityVector returns the old "entity_vector" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldEntityVector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityVector is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(refreshtoken.FieldUserAgent) {
-		fields = append(fields, refreshtoken.FieldUserAgent)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityVector requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityVector: %w", err)
+	}
+	return oldValue.EntityVector, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *RefreshTokenMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearEntityVector clears the value of the "entity_vector" field.
+func (m *PostMutation) ClearEntityVector() {
+	m.entity_vector = nil
+	m.clearedFields[post.FieldEntityVector] = struct{}{}
+}
+
+// EntityVectorCleared returns if the "entity_vector" field was cleared in this mutation.
+func (m *PostMutation) EntityVectorCleared() bool {
+	_, ok := m.clearedFields[post.FieldEntityVector]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *RefreshTokenMutation) ClearField(name string) error {
-	switch name {
-	case refreshtoken.FieldIPAddress:
-		m.ClearIPAddress()
-		return nil
-	case refreshtoken.FieldUserAgent:
-		m.ClearUserAgent()
-		return nil
+// ResetEntityVector resets all changes to the "entity_vector" field.
+func (m *PostMutation) ResetEntityVector() {
+	m.entity_vector = nil
+	delete(m.clearedFields, post.FieldEntityVector)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *PostMutation) SetMetadata(em extramodel.PostMetadata) {
+	m.metadata = &em
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *PostMutation) Metadata() (r extramodel.PostMetadata, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown RefreshToken nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *RefreshTokenMutation) ResetField(name string) error {
-	switch name {
-	case refreshtoken.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case refreshtoken.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case refreshtoken.FieldOwnerID:
-		m.ResetOwnerID()
-		return nil
-	case refreshtoken.FieldTokenHash:
-		m.ResetTokenHash()
-		return nil
-	case refreshtoken.FieldExpiresAt:
-		m.ResetExpiresAt()
-		return nil
-	case refreshtoken.FieldRevoked:
-		m.ResetRevoked()
-		return nil
-	case refreshtoken.FieldIPAddress:
-		m.ResetIPAddress()
-		return nil
-	case refreshtoken.FieldUserAgent:
-		m.ResetUserAgent()
-		return nil
+// OldMetadata returns the old "metadata" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldMetadata(ctx context.Context) (v extramodel.PostMetadata, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown RefreshToken field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RefreshTokenMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.owner != nil {
-		edges = append(edges, refreshtoken.EdgeOwner)
-	}
-	return edges
+// ClearMetadata clears the value of the "metadata" field.
+func (m *PostMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[post.FieldMetadata] = struct{}{}
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *RefreshTokenMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case refreshtoken.EdgeOwner:
-		if id := m.owner; id != nil {
-			return []ent.Value{*id}
-		}
-	}
-	return nil
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *PostMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[post.FieldMetadata]
+	return ok
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RefreshTokenMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *PostMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, post.FieldMetadata)
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *RefreshTokenMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *PostMutation) ClearOwner() {
+	m.clearedowner = true
+	m.clearedFields[post.FieldOwnerID] = struct{}{}
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RefreshTokenMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedowner {
-		edges = append(edges, refreshtoken.EdgeOwner)
-	}
-	return edges
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *PostMutation) OwnerCleared() bool {
+	return m.clearedowner
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *RefreshTokenMutation) EdgeCleared(name string) bool {
-	switch name {
-	case refreshtoken.EdgeOwner:
-		return m.clearedowner
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *PostMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
 	}
-	return false
+	return
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *RefreshTokenMutation) ClearEdge(name string) error {
-	switch name {
-	case refreshtoken.EdgeOwner:
-		m.ClearOwner()
-		return nil
-	}
-	return fmt.Errorf("unknown RefreshToken unique edge %s", name)
+// ResetOwner resets all changes to the "owner" edge.
+func (m *PostMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *RefreshTokenMutation) ResetEdge(name string) error {
-	switch name {
-	case refreshtoken.EdgeOwner:
-		m.ResetOwner()
-		return nil
+// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
+func (m *PostMutation) AddCommentIDs(ids ...uuid.UUID) {
+	if m.comments == nil {
+		m.comments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.comments[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown RefreshToken edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
-	config
-	op                     Op
-	typ                    string
-	id                     *uuid.UUID
-	updated_at             *time.Time
-	created_at             *time.Time
-	deleted_at             *time.Time
-	deleted_by             *string
-	display_name           *string
-	alias                  *string
-	profile_image          *string
-	external_id            *string
-	auth_provider          *user.AuthProvider
-	role                   *user.Role
-	last_seen_at           *time.Time
-	last_post_seen_cursor  *string
-	awards                 *[]string
-	appendawards           []string
-	clearedFields          map[string]struct{}
-	saved_posts            map[uuid.UUID]struct{}
-	removedsaved_posts     map[uuid.UUID]struct{}
-	clearedsaved_posts     bool
-	liked_posts            map[uuid.UUID]struct{}
-	removedliked_posts     map[uuid.UUID]struct{}
-	clearedliked_posts     bool
-	published_posts        map[uuid.UUID]struct{}
-	removedpublished_posts map[uuid.UUID]struct{}
-	clearedpublished_posts bool
-	comments               map[uuid.UUID]struct{}
-	removedcomments        map[uuid.UUID]struct{}
-	clearedcomments        bool
-	api_keys               map[uuid.UUID]struct{}
-	removedapi_keys        map[uuid.UUID]struct{}
-	clearedapi_keys        bool
-	refresh_tokens         map[uuid.UUID]struct{}
-	removedrefresh_tokens  map[uuid.UUID]struct{}
-	clearedrefresh_tokens  bool
-	done                   bool
-	oldValue               func(context.Context) (*User, error)
-	predicates             []predicate.User
+// ClearComments clears the "comments" edge to the Comment entity.
+func (m *PostMutation) ClearComments() {
+	m.clearedcomments = true
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
-
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
+func (m *PostMutation) CommentsCleared() bool {
+	return m.clearedcomments
+}
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeUser,
-		clearedFields: make(map[string]struct{}),
+// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
+func (m *PostMutation) RemoveCommentIDs(ids ...uuid.UUID) {
+	if m.removedcomments == nil {
+		m.removedcomments = make(map[uuid.UUID]struct{})
 	}
-	for _, opt := range opts {
-		opt(m)
+	for i := range ids {
+		delete(m.comments, ids[i])
+		m.removedcomments[ids[i]] = struct{}{}
 	}
-	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uuid.UUID) userOption {
-	return func(m *UserMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *User
-		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().User.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
+func (m *PostMutation) RemovedCommentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcomments {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// CommentsIDs returns the "comments" edge IDs in the mutation.
+func (m *PostMutation) CommentsIDs() (ids []uuid.UUID) {
+	for id := range m.comments {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetComments resets all changes to the "comments" edge.
+func (m *PostMutation) ResetComments() {
+	m.comments = nil
+	m.clearedcomments = false
+	m.removedcomments = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("generated: mutation is not running in a transaction")
+// AddSavedByIDs adds the "saved_by" edge to the User entity by ids.
+func (m *PostMutation) AddSavedByIDs(ids ...uuid.UUID) {
+	if m.saved_by == nil {
+		m.saved_by = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.saved_by[ids[i]] = struct{}{}
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ClearSavedBy clears the "saved_by" edge to the User entity.
+func (m *PostMutation) ClearSavedBy() {
+	m.clearedsaved_by = true
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// SavedByCleared reports if the "saved_by" edge to the User entity was cleared.
+func (m *PostMutation) SavedByCleared() bool {
+	return m.clearedsaved_by
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// RemoveSavedByIDs removes the "saved_by" edge to the User entity by IDs.
+func (m *PostMutation) RemoveSavedByIDs(ids ...uuid.UUID) {
+	if m.removedsaved_by == nil {
+		m.removedsaved_by = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.saved_by, ids[i])
+		m.removedsaved_by[ids[i]] = struct{}{}
 	}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// RemovedSavedBy returns the removed IDs of the "saved_by" edge to the User entity.
+func (m *PostMutation) RemovedSavedByIDs() (ids []uuid.UUID) {
+	for id := range m.removedsaved_by {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// SavedByIDs returns the "saved_by" edge IDs in the mutation.
+func (m *PostMutation) SavedByIDs() (ids []uuid.UUID) {
+	for id := range m.saved_by {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
+// ResetSavedBy resets all changes to the "saved_by" edge.
+func (m *PostMutation) ResetSavedBy() {
+	m.saved_by = nil
+	m.clearedsaved_by = false
+	m.removedsaved_by = nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// AddLikedByIDs adds the "liked_by" edge to the User entity by ids.
+func (m *PostMutation) AddLikedByIDs(ids ...uuid.UUID) {
+	if m.liked_by == nil {
+		m.liked_by = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.liked_by[ids[i]] = struct{}{}
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ClearLikedBy clears the "liked_by" edge to the User entity.
+func (m *PostMutation) ClearLikedBy() {
+	m.clearedliked_by = true
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// LikedByCleared reports if the "liked_by" edge to the User entity was cleared.
+func (m *PostMutation) LikedByCleared() bool {
+	return m.clearedliked_by
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+// RemoveLikedByIDs removes the "liked_by" edge to the User entity by IDs.
+func (m *PostMutation) RemoveLikedByIDs(ids ...uuid.UUID) {
+	if m.removedliked_by == nil {
+		m.removedliked_by = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	for i := range ids {
+		delete(m.liked_by, ids[i])
+		m.removedliked_by[ids[i]] = struct{}{}
 	}
-	return oldValue.CreatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
-	m.created_at = nil
+// RemovedLikedBy returns the removed IDs of the "liked_by" edge to the User entity.
+func (m *PostMutation) RemovedLikedByIDs() (ids []uuid.UUID) {
+	for id := range m.removedliked_by {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *UserMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// LikedByIDs returns the "liked_by" edge IDs in the mutation.
+func (m *PostMutation) LikedByIDs() (ids []uuid.UUID) {
+	for id := range m.liked_by {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *UserMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetLikedBy resets all changes to the "liked_by" edge.
+func (m *PostMutation) ResetLikedBy() {
+	m.liked_by = nil
+	m.clearedliked_by = false
+	m.removedliked_by = nil
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+// AddCategoryIDs adds the "categories" edge to the PostCategory entity by ids.
+func (m *PostMutation) AddCategoryIDs(ids ...uuid.UUID) {
+	if m.categories == nil {
+		m.categories = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	for i := range ids {
+		m.categories[ids[i]] = struct{}{}
 	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *UserMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[user.FieldDeletedAt] = struct{}{}
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *UserMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[user.FieldDeletedAt]
-	return ok
+// ClearCategories clears the "categories" edge to the PostCategory entity.
+func (m *PostMutation) ClearCategories() {
+	m.clearedcategories = true
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *UserMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, user.FieldDeletedAt)
+// CategoriesCleared reports if the "categories" edge to the PostCategory entity was cleared.
+func (m *PostMutation) CategoriesCleared() bool {
+	return m.clearedcategories
 }
 
-// SetDeletedBy sets the "deleted_by" field.
-func (m *UserMutation) SetDeletedBy(s string) {
-	m.deleted_by = &s
+// RemoveCategoryIDs removes the "categories" edge to the PostCategory entity by IDs.
+func (m *PostMutation) RemoveCategoryIDs(ids ...uuid.UUID) {
+	if m.removedcategories == nil {
+		m.removedcategories = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.categories, ids[i])
+		m.removedcategories[ids[i]] = struct{}{}
+	}
 }
 
-// DeletedBy returns the value of the "deleted_by" field in the mutation.
-func (m *UserMutation) DeletedBy() (r string, exists bool) {
-	v := m.deleted_by
-	if v == nil {
-		return
+// RemovedCategories returns the removed IDs of the "categories" edge to the PostCategory entity.
+func (m *PostMutation) RemovedCategoriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedcategories {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldDeletedBy returns the old "deleted_by" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
+// CategoriesIDs returns the "categories" edge IDs in the mutation.
+func (m *PostMutation) CategoriesIDs() (ids []uuid.UUID) {
+	for id := range m.categories {
+		ids = append(ids, id)
 	}
-	return oldValue.DeletedBy, nil
+	return
 }
 
-// ClearDeletedBy clears the value of the "deleted_by" field.
-func (m *UserMutation) ClearDeletedBy() {
-	m.deleted_by = nil
-	m.clearedFields[user.FieldDeletedBy] = struct{}{}
+// ResetCategories resets all changes to the "categories" edge.
+func (m *PostMutation) ResetCategories() {
+	m.categories = nil
+	m.clearedcategories = false
+	m.removedcategories = nil
 }
 
-// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
-func (m *UserMutation) DeletedByCleared() bool {
-	_, ok := m.clearedFields[user.FieldDeletedBy]
-	return ok
+// Where appends a list predicates to the PostMutation builder.
+func (m *PostMutation) Where(ps ...predicate.Post) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetDeletedBy resets all changes to the "deleted_by" field.
-func (m *UserMutation) ResetDeletedBy() {
-	m.deleted_by = nil
-	delete(m.clearedFields, user.FieldDeletedBy)
-}
-
-// SetDisplayName sets the "display_name" field.
-func (m *UserMutation) SetDisplayName(s string) {
-	m.display_name = &s
-}
-
-// DisplayName returns the value of the "display_name" field in the mutation.
-func (m *UserMutation) DisplayName() (r string, exists bool) {
-	v := m.display_name
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the PostMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Post, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldDisplayName returns the old "display_name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldDisplayName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
-	}
-	return oldValue.DisplayName, nil
+// Op returns the operation name.
+func (m *PostMutation) Op() Op {
+	return m.op
 }
 
-// ResetDisplayName resets all changes to the "display_name" field.
-func (m *UserMutation) ResetDisplayName() {
-	m.display_name = nil
+// SetOp allows setting the mutation operation.
+func (m *PostMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetAlias sets the "alias" field.
-func (m *UserMutation) SetAlias(s string) {
-	m.alias = &s
+// Type returns the node type of this mutation (Post).
+func (m *PostMutation) Type() string {
+	return m.typ
 }
 
-// Alias returns the value of the "alias" field in the mutation.
-func (m *UserMutation) Alias() (r string, exists bool) {
-	v := m.alias
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PostMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.updated_at != nil {
+		fields = append(fields, post.FieldUpdatedAt)
 	}
-	return *v, true
-}
-
-// OldAlias returns the old "alias" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAlias(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAlias is only allowed on UpdateOne operations")
+	if m.created_at != nil {
+		fields = append(fields, post.FieldCreatedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAlias requires an ID field in the mutation")
+	if m.deleted_at != nil {
+		fields = append(fields, post.FieldDeletedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAlias: %w", err)
+	if m.deleted_by != nil {
+		fields = append(fields, post.FieldDeletedBy)
 	}
-	return oldValue.Alias, nil
-}
-
-// ClearAlias clears the value of the "alias" field.
-func (m *UserMutation) ClearAlias() {
-	m.alias = nil
-	m.clearedFields[user.FieldAlias] = struct{}{}
-}
-
-// AliasCleared returns if the "alias" field was cleared in this mutation.
-func (m *UserMutation) AliasCleared() bool {
-	_, ok := m.clearedFields[user.FieldAlias]
-	return ok
-}
-
-// ResetAlias resets all changes to the "alias" field.
-func (m *UserMutation) ResetAlias() {
-	m.alias = nil
-	delete(m.clearedFields, user.FieldAlias)
-}
-
-// SetProfileImage sets the "profile_image" field.
-func (m *UserMutation) SetProfileImage(s string) {
-	m.profile_image = &s
-}
-
-// ProfileImage returns the value of the "profile_image" field in the mutation.
-func (m *UserMutation) ProfileImage() (r string, exists bool) {
-	v := m.profile_image
-	if v == nil {
-		return
+	if m.owner != nil {
+		fields = append(fields, post.FieldOwnerID)
 	}
-	return *v, true
-}
-
-// OldProfileImage returns the old "profile_image" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldProfileImage(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProfileImage is only allowed on UpdateOne operations")
+	if m.pinned != nil {
+		fields = append(fields, post.FieldPinned)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProfileImage requires an ID field in the mutation")
+	if m.title != nil {
+		fields = append(fields, post.FieldTitle)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProfileImage: %w", err)
+	if m.content != nil {
+		fields = append(fields, post.FieldContent)
 	}
-	return oldValue.ProfileImage, nil
-}
-
-// ClearProfileImage clears the value of the "profile_image" field.
-func (m *UserMutation) ClearProfileImage() {
-	m.profile_image = nil
-	m.clearedFields[user.FieldProfileImage] = struct{}{}
-}
-
-// ProfileImageCleared returns if the "profile_image" field was cleared in this mutation.
-func (m *UserMutation) ProfileImageCleared() bool {
-	_, ok := m.clearedFields[user.FieldProfileImage]
-	return ok
-}
-
-// ResetProfileImage resets all changes to the "profile_image" field.
-func (m *UserMutation) ResetProfileImage() {
-	m.profile_image = nil
-	delete(m.clearedFields, user.FieldProfileImage)
-}
-
-// SetExternalID sets the "external_id" field.
-func (m *UserMutation) SetExternalID(s string) {
-	m.external_id = &s
-}
-
-// ExternalID returns the value of the "external_id" field in the mutation.
-func (m *UserMutation) ExternalID() (r string, exists bool) {
-	v := m.external_id
-	if v == nil {
-		return
+	if m.link != nil {
+		fields = append(fields, post.FieldLink)
 	}
-	return *v, true
-}
-
-// OldExternalID returns the old "external_id" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldExternalID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExternalID is only allowed on UpdateOne operations")
+	if m.moderation_comment != nil {
+		fields = append(fields, post.FieldModerationComment)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExternalID requires an ID field in the mutation")
+	if m.is_moderated != nil {
+		fields = append(fields, post.FieldIsModerated)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExternalID: %w", err)
+	if m.moderated_at != nil {
+		fields = append(fields, post.FieldModeratedAt)
 	}
-	return oldValue.ExternalID, nil
-}
-
-// ResetExternalID resets all changes to the "external_id" field.
-func (m *UserMutation) ResetExternalID() {
-	m.external_id = nil
-}
-
-// SetAuthProvider sets the "auth_provider" field.
-func (m *UserMutation) SetAuthProvider(up user.AuthProvider) {
-	m.auth_provider = &up
+	if m.entity_vector != nil {
+		fields = append(fields, post.FieldEntityVector)
+	}
+	if m.metadata != nil {
+		fields = append(fields, post.FieldMetadata)
+	}
+	return fields
 }
 
-// AuthProvider returns the value of the "auth_provider" field in the mutation.
-func (m *UserMutation) AuthProvider() (r user.AuthProvider, exists bool) {
-	v := m.auth_provider
-	if v == nil {
-		return
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PostMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case post.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case post.FieldCreatedAt:
+		return m.CreatedAt()
+	case post.FieldDeletedAt:
+		return m.DeletedAt()
+	case post.FieldDeletedBy:
+		return m.DeletedBy()
+	case post.FieldOwnerID:
+		return m.OwnerID()
+	case post.FieldPinned:
+		return m.Pinned()
+	case post.FieldTitle:
+		return m.Title()
+	case post.FieldContent:
+		return m.Content()
+	case post.FieldLink:
+		return m.Link()
+	case post.FieldModerationComment:
+		return m.ModerationComment()
+	case post.FieldIsModerated:
+		return m.IsModerated()
+	case post.FieldModeratedAt:
+		return m.ModeratedAt()
+	case post.FieldEntityVector:
+		return m.EntityVector()
+	case post.FieldMetadata:
+		return m.Metadata()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldAuthProvider returns the old "auth_provider" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAuthProvider(ctx context.Context) (v user.AuthProvider, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthProvider is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthProvider requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthProvider: %w", err)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case post.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case post.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case post.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case post.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
+	case post.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case post.FieldPinned:
+		return m.OldPinned(ctx)
+	case post.FieldTitle:
+		return m.OldTitle(ctx)
+	case post.FieldContent:
+		return m.OldContent(ctx)
+	case post.FieldLink:
+		return m.OldLink(ctx)
+	case post.FieldModerationComment:
+		return m.OldModerationComment(ctx)
+	case post.FieldIsModerated:
+		return m.OldIsModerated(ctx)
+	case post.FieldModeratedAt:
+		return m.OldModeratedAt(ctx)
+	case post.FieldEntityVector:
+		return m.OldEntityVector(ctx)
+	case post.FieldMetadata:
+		return m.OldMetadata(ctx)
 	}
-	return oldValue.AuthProvider, nil
+	return nil, fmt.Errorf("unknown Post field %s", name)
 }
 
-// ResetAuthProvider resets all changes to the "auth_provider" field.
-func (m *UserMutation) ResetAuthProvider() {
-	m.auth_provider = nil
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case post.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case post.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case post.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case post.FieldDeletedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedBy(v)
+		return nil
+	case post.FieldOwnerID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	case post.FieldPinned:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPinned(v)
+		return nil
+	case post.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case post.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case post.FieldLink:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLink(v)
+		return nil
+	case post.FieldModerationComment:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModerationComment(v)
+		return nil
+	case post.FieldIsModerated:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsModerated(v)
+		return nil
+	case post.FieldModeratedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModeratedAt(v)
+		return nil
+	case post.FieldEntityVector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityVector(v)
+		return nil
+	case post.FieldMetadata:
+		v, ok := value.(extramodel.PostMetadata)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Post field %s", name)
 }
 
-// SetRole sets the "role" field.
-func (m *UserMutation) SetRole(u user.Role) {
-	m.role = &u
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PostMutation) AddedFields() []string {
+	return nil
 }
 
-// Role returns the value of the "role" field in the mutation.
-func (m *UserMutation) Role() (r user.Role, exists bool) {
-	v := m.role
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PostMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown Post numeric field %s", name)
 }
 
-// OldRole returns the old "role" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldRole(ctx context.Context) (v user.Role, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PostMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(post.FieldDeletedAt) {
+		fields = append(fields, post.FieldDeletedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
+	if m.FieldCleared(post.FieldDeletedBy) {
+		fields = append(fields, post.FieldDeletedBy)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	if m.FieldCleared(post.FieldContent) {
+		fields = append(fields, post.FieldContent)
 	}
-	return oldValue.Role, nil
-}
-
-// ResetRole resets all changes to the "role" field.
-func (m *UserMutation) ResetRole() {
-	m.role = nil
-}
-
-// SetLastSeenAt sets the "last_seen_at" field.
-func (m *UserMutation) SetLastSeenAt(t time.Time) {
-	m.last_seen_at = &t
-}
-
-// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
-func (m *UserMutation) LastSeenAt() (r time.Time, exists bool) {
-	v := m.last_seen_at
-	if v == nil {
-		return
+	if m.FieldCleared(post.FieldModerationComment) {
+		fields = append(fields, post.FieldModerationComment)
 	}
-	return *v, true
-}
-
-// OldLastSeenAt returns the old "last_seen_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldLastSeenAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	if m.FieldCleared(post.FieldModeratedAt) {
+		fields = append(fields, post.FieldModeratedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	if m.FieldCleared(post.FieldEntityVector) {
+		fields = append(fields, post.FieldEntityVector)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	if m.FieldCleared(post.FieldMetadata) {
+		fields = append(fields, post.FieldMetadata)
 	}
-	return oldValue.LastSeenAt, nil
-}
-
-// ClearLastSeenAt clears the value of the "last_seen_at" field.
-func (m *UserMutation) ClearLastSeenAt() {
-	m.last_seen_at = nil
-	m.clearedFields[user.FieldLastSeenAt] = struct{}{}
+	return fields
 }
 
-// LastSeenAtCleared returns if the "last_seen_at" field was cleared in this mutation.
-func (m *UserMutation) LastSeenAtCleared() bool {
-	_, ok := m.clearedFields[user.FieldLastSeenAt]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PostMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetLastSeenAt resets all changes to the "last_seen_at" field.
-func (m *UserMutation) ResetLastSeenAt() {
-	m.last_seen_at = nil
-	delete(m.clearedFields, user.FieldLastSeenAt)
-}
-
-// SetLastPostSeenCursor sets the "last_post_seen_cursor" field.
-func (m *UserMutation) SetLastPostSeenCursor(s string) {
-	m.last_post_seen_cursor = &s
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PostMutation) ClearField(name string) error {
+	switch name {
+	case post.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case post.FieldDeletedBy:
+		m.ClearDeletedBy()
+		return nil
+	case post.FieldContent:
+		m.ClearContent()
+		return nil
+	case post.FieldModerationComment:
+		m.ClearModerationComment()
+		return nil
+	case post.FieldModeratedAt:
+		m.ClearModeratedAt()
+		return nil
+	case post.FieldEntityVector:
+		m.ClearEntityVector()
+		return nil
+	case post.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown Post nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PostMutation) ResetField(name string) error {
+	switch name {
+	case post.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case post.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case post.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case post.FieldDeletedBy:
+		m.ResetDeletedBy()
+		return nil
+	case post.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case post.FieldPinned:
+		m.ResetPinned()
+		return nil
+	case post.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case post.FieldContent:
+		m.ResetContent()
+		return nil
+	case post.FieldLink:
+		m.ResetLink()
+		return nil
+	case post.FieldModerationComment:
+		m.ResetModerationComment()
+		return nil
+	case post.FieldIsModerated:
+		m.ResetIsModerated()
+		return nil
+	case post.FieldModeratedAt:
+		m.ResetModeratedAt()
+		return nil
+	case post.FieldEntityVector:
+		m.ResetEntityVector()
+		return nil
+	case post.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown Post field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PostMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.owner != nil {
+		edges = append(edges, post.EdgeOwner)
+	}
+	if m.comments != nil {
+		edges = append(edges, post.EdgeComments)
+	}
+	if m.saved_by != nil {
+		edges = append(edges, post.EdgeSavedBy)
+	}
+	if m.liked_by != nil {
+		edges = append(edges, post.EdgeLikedBy)
+	}
+	if m.categories != nil {
+		edges = append(edges, post.EdgeCategories)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PostMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case post.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case post.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.comments))
+		for id := range m.comments {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeSavedBy:
+		ids := make([]ent.Value, 0, len(m.saved_by))
+		for id := range m.saved_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeLikedBy:
+		ids := make([]ent.Value, 0, len(m.liked_by))
+		for id := range m.liked_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeCategories:
+		ids := make([]ent.Value, 0, len(m.categories))
+		for id := range m.categories {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PostMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedcomments != nil {
+		edges = append(edges, post.EdgeComments)
+	}
+	if m.removedsaved_by != nil {
+		edges = append(edges, post.EdgeSavedBy)
+	}
+	if m.removedliked_by != nil {
+		edges = append(edges, post.EdgeLikedBy)
+	}
+	if m.removedcategories != nil {
+		edges = append(edges, post.EdgeCategories)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PostMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case post.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.removedcomments))
+		for id := range m.removedcomments {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeSavedBy:
+		ids := make([]ent.Value, 0, len(m.removedsaved_by))
+		for id := range m.removedsaved_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeLikedBy:
+		ids := make([]ent.Value, 0, len(m.removedliked_by))
+		for id := range m.removedliked_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeCategories:
+		ids := make([]ent.Value, 0, len(m.removedcategories))
+		for id := range m.removedcategories {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PostMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedowner {
+		edges = append(edges, post.EdgeOwner)
+	}
+	if m.clearedcomments {
+		edges = append(edges, post.EdgeComments)
+	}
+	if m.clearedsaved_by {
+		edges = append(edges, post.EdgeSavedBy)
+	}
+	if m.clearedliked_by {
+		edges = append(edges, post.EdgeLikedBy)
+	}
+	if m.clearedcategories {
+		edges = append(edges, post.EdgeCategories)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PostMutation) EdgeCleared(name string) bool {
+	switch name {
+	case post.EdgeOwner:
+		return m.clearedowner
+	case post.EdgeComments:
+		return m.clearedcomments
+	case post.EdgeSavedBy:
+		return m.clearedsaved_by
+	case post.EdgeLikedBy:
+		return m.clearedliked_by
+	case post.EdgeCategories:
+		return m.clearedcategories
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PostMutation) ClearEdge(name string) error {
+	switch name {
+	case post.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Post unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PostMutation) ResetEdge(name string) error {
+	switch name {
+	case post.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case post.EdgeComments:
+		m.ResetComments()
+		return nil
+	case post.EdgeSavedBy:
+		m.ResetSavedBy()
+		return nil
+	case post.EdgeLikedBy:
+		m.ResetLikedBy()
+		return nil
+	case post.EdgeCategories:
+		m.ResetCategories()
+		return nil
+	}
+	return fmt.Errorf("unknown Post edge %s", name)
+}
+
+// PostCategoryMutation represents an operation that mutates the PostCategory nodes in the graph.
+type PostCategoryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	updated_at    *time.Time
+	created_at    *time.Time
+	category      *postcategory.Category
+	clearedFields map[string]struct{}
+	post          *uuid.UUID
+	clearedpost   bool
+	done          bool
+	oldValue      func(context.Context) (*PostCategory, error)
+	predicates    []predicate.PostCategory
+}
+
+var _ ent.Mutation = (*PostCategoryMutation)(nil)
+
+// postcategoryOption allows management of the mutation configuration using functional options.
+type postcategoryOption func(*PostCategoryMutation)
+
+// newPostCategoryMutation creates new mutation for the PostCategory entity.
+func newPostCategoryMutation(c config, op Op, opts ...postcategoryOption) *PostCategoryMutation {
+	m := &PostCategoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePostCategory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPostCategoryID sets the ID field of the mutation.
+func withPostCategoryID(id uuid.UUID) postcategoryOption {
+	return func(m *PostCategoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PostCategory
+		)
+		m.oldValue = func(ctx context.Context) (*PostCategory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PostCategory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPostCategory sets the old PostCategory of the mutation.
+func withPostCategory(node *PostCategory) postcategoryOption {
+	return func(m *PostCategoryMutation) {
+		m.oldValue = func(context.Context) (*PostCategory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PostCategoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PostCategoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PostCategory entities.
+func (m *PostCategoryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PostCategoryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PostCategoryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PostCategory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PostCategoryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PostCategoryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PostCategory entity.
+// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostCategoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PostCategoryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PostCategoryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PostCategoryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PostCategory entity.
+// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostCategoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PostCategoryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetCategory sets the "category" field.
+func (m *PostCategoryMutation) SetCategory(po postcategory.Category) {
+	m.category = &po
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *PostCategoryMutation) Category() (r postcategory.Category, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the PostCategory entity.
+// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostCategoryMutation) OldCategory(ctx context.Context) (v postcategory.Category, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *PostCategoryMutation) ResetCategory() {
+	m.category = nil
+}
+
+// SetPostID sets the "post" edge to the Post entity by id.
+func (m *PostCategoryMutation) SetPostID(id uuid.UUID) {
+	m.post = &id
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (m *PostCategoryMutation) ClearPost() {
+	m.clearedpost = true
+}
+
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *PostCategoryMutation) PostCleared() bool {
+	return m.clearedpost
+}
+
+// PostID returns the "post" edge ID in the mutation.
+func (m *PostCategoryMutation) PostID() (id uuid.UUID, exists bool) {
+	if m.post != nil {
+		return *m.post, true
+	}
+	return
+}
+
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *PostCategoryMutation) PostIDs() (ids []uuid.UUID) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPost resets all changes to the "post" edge.
+func (m *PostCategoryMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
+}
+
+// Where appends a list predicates to the PostCategoryMutation builder.
+func (m *PostCategoryMutation) Where(ps ...predicate.PostCategory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PostCategoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PostCategoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PostCategory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PostCategoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PostCategoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PostCategory).
+func (m *PostCategoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PostCategoryMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.updated_at != nil {
+		fields = append(fields, postcategory.FieldUpdatedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, postcategory.FieldCreatedAt)
+	}
+	if m.category != nil {
+		fields = append(fields, postcategory.FieldCategory)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PostCategoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case postcategory.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case postcategory.FieldCreatedAt:
+		return m.CreatedAt()
+	case postcategory.FieldCategory:
+		return m.Category()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PostCategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case postcategory.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case postcategory.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case postcategory.FieldCategory:
+		return m.OldCategory(ctx)
+	}
+	return nil, fmt.Errorf("unknown PostCategory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostCategoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case postcategory.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case postcategory.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case postcategory.FieldCategory:
+		v, ok := value.(postcategory.Category)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PostCategory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PostCategoryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PostCategoryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostCategoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PostCategory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PostCategoryMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PostCategoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PostCategoryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PostCategory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PostCategoryMutation) ResetField(name string) error {
+	switch name {
+	case postcategory.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case postcategory.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case postcategory.FieldCategory:
+		m.ResetCategory()
+		return nil
+	}
+	return fmt.Errorf("unknown PostCategory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PostCategoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.post != nil {
+		edges = append(edges, postcategory.EdgePost)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PostCategoryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case postcategory.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PostCategoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PostCategoryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PostCategoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedpost {
+		edges = append(edges, postcategory.EdgePost)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PostCategoryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case postcategory.EdgePost:
+		return m.clearedpost
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PostCategoryMutation) ClearEdge(name string) error {
+	switch name {
+	case postcategory.EdgePost:
+		m.ClearPost()
+		return nil
+	}
+	return fmt.Errorf("unknown PostCategory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PostCategoryMutation) ResetEdge(name string) error {
+	switch name {
+	case postcategory.EdgePost:
+		m.ResetPost()
+		return nil
+	}
+	return fmt.Errorf("unknown PostCategory edge %s", name)
+}
+
+// RefreshTokenMutation represents an operation that mutates the RefreshToken nodes in the graph.
+type RefreshTokenMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	updated_at    *time.Time
+	created_at    *time.Time
+	token_hash    *string
+	expires_at    *time.Time
+	revoked       *bool
+	ip_address    *string
+	user_agent    *string
+	family_id     *uuid.UUID
+	parent_id     *uuid.UUID
+	clearedFields map[string]struct{}
+	owner         *uuid.UUID
+	clearedowner  bool
+	done          bool
+	oldValue      func(context.Context) (*RefreshToken, error)
+	predicates    []predicate.RefreshToken
+}
+
+var _ ent.Mutation = (*RefreshTokenMutation)(nil)
+
+// refreshtokenOption allows management of the mutation configuration using functional options.
+type refreshtokenOption func(*RefreshTokenMutation)
+
+// newRefreshTokenMutation creates new mutation for the RefreshToken entity.
+func newRefreshTokenMutation(c config, op Op, opts ...refreshtokenOption) *RefreshTokenMutation {
+	m := &RefreshTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRefreshToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRefreshTokenID sets the ID field of the mutation.
+func withRefreshTokenID(id uuid.UUID) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RefreshToken
+		)
+		m.oldValue = func(ctx context.Context) (*RefreshToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RefreshToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRefreshToken sets the old RefreshToken of the mutation.
+func withRefreshToken(node *RefreshToken) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
+		m.oldValue = func(context.Context) (*RefreshToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RefreshTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RefreshTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RefreshToken entities.
+func (m *RefreshTokenMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RefreshTokenMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RefreshTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RefreshToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RefreshTokenMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RefreshTokenMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RefreshTokenMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RefreshTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RefreshTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RefreshTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *RefreshTokenMutation) SetOwnerID(u uuid.UUID) {
+	m.owner = &u
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *RefreshTokenMutation) OwnerID() (r uuid.UUID, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldOwnerID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *RefreshTokenMutation) ResetOwnerID() {
+	m.owner = nil
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *RefreshTokenMutation) SetTokenHash(s string) {
+	m.token_hash = &s
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *RefreshTokenMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "token_hash" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *RefreshTokenMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *RefreshTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *RefreshTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *RefreshTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetRevoked sets the "revoked" field.
+func (m *RefreshTokenMutation) SetRevoked(b bool) {
+	m.revoked = &b
+}
+
+// Revoked returns the value of the "revoked" field in the mutation.
+func (m *RefreshTokenMutation) Revoked() (r bool, exists bool) {
+	v := m.revoked
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevoked returns the old "revoked" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldRevoked(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevoked is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevoked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevoked: %w", err)
+	}
+	return oldValue.Revoked, nil
+}
+
+// ResetRevoked resets all changes to the "revoked" field.
+func (m *RefreshTokenMutation) ResetRevoked() {
+	m.revoked = nil
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (m *RefreshTokenMutation) SetIPAddress(s string) {
+	m.ip_address = &s
+}
+
+// IPAddress returns the value of the "ip_address" field in the mutation.
+func (m *RefreshTokenMutation) IPAddress() (r string, exists bool) {
+	v := m.ip_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIPAddress returns the old "ip_address" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldIPAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
+	}
+	return oldValue.IPAddress, nil
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (m *RefreshTokenMutation) ClearIPAddress() {
+	m.ip_address = nil
+	m.clearedFields[refreshtoken.FieldIPAddress] = struct{}{}
+}
+
+// IPAddressCleared returns if the "ip_address" field was cleared in this mutation.
+func (m *RefreshTokenMutation) IPAddressCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldIPAddress]
+	return ok
+}
+
+// ResetIPAddress resets all changes to the "ip_address" field.
+func (m *RefreshTokenMutation) ResetIPAddress() {
+	m.ip_address = nil
+	delete(m.clearedFields, refreshtoken.FieldIPAddress)
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *RefreshTokenMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *RefreshTokenMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "user_agent" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *RefreshTokenMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[refreshtoken.FieldUserAgent] = struct{}{}
+}
+
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *RefreshTokenMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldUserAgent]
+	return ok
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *RefreshTokenMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, refreshtoken.FieldUserAgent)
+}
+
+// SetFamilyID sets the "family_id" field.
+func (m *RefreshTokenMutation) SetFamilyID(u uuid.UUID) {
+	m.family_id = &u
+}
+
+// FamilyID returns the value of the "family_id" field in the mutation.
+func (m *RefreshTokenMutation) FamilyID() (r uuid.UUID, exists bool) {
+	v := m.family_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFamilyID returns the old "family_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldFamilyID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFamilyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFamilyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFamilyID: %w", err)
+	}
+	return oldValue.FamilyID, nil
+}
+
+// ResetFamilyID resets all changes to the "family_id" field.
+func (m *RefreshTokenMutation) ResetFamilyID() {
+	m.family_id = nil
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *RefreshTokenMutation) SetParentID(u uuid.UUID) {
+	m.parent_id = &u
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *RefreshTokenMutation) ParentID() (r uuid.UUID, exists bool) {
+	v := m.parent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldParentID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *RefreshTokenMutation) ClearParentID() {
+	m.parent_id = nil
+	m.clearedFields[refreshtoken.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *RefreshTokenMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *RefreshTokenMutation) ResetParentID() {
+	m.parent_id = nil
+	delete(m.clearedFields, refreshtoken.FieldParentID)
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *RefreshTokenMutation) ClearOwner() {
+	m.clearedowner = true
+	m.clearedFields[refreshtoken.FieldOwnerID] = struct{}{}
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *RefreshTokenMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *RefreshTokenMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *RefreshTokenMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// Where appends a list predicates to the RefreshTokenMutation builder.
+func (m *RefreshTokenMutation) Where(ps ...predicate.RefreshToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RefreshTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RefreshTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RefreshToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RefreshTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RefreshTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RefreshToken).
+func (m *RefreshTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RefreshTokenMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.updated_at != nil {
+		fields = append(fields, refreshtoken.FieldUpdatedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, refreshtoken.FieldCreatedAt)
+	}
+	if m.owner != nil {
+		fields = append(fields, refreshtoken.FieldOwnerID)
+	}
+	if m.token_hash != nil {
+		fields = append(fields, refreshtoken.FieldTokenHash)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, refreshtoken.FieldExpiresAt)
+	}
+	if m.revoked != nil {
+		fields = append(fields, refreshtoken.FieldRevoked)
+	}
+	if m.ip_address != nil {
+		fields = append(fields, refreshtoken.FieldIPAddress)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, refreshtoken.FieldUserAgent)
+	}
+	if m.family_id != nil {
+		fields = append(fields, refreshtoken.FieldFamilyID)
+	}
+	if m.parent_id != nil {
+		fields = append(fields, refreshtoken.FieldParentID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RefreshTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case refreshtoken.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case refreshtoken.FieldCreatedAt:
+		return m.CreatedAt()
+	case refreshtoken.FieldOwnerID:
+		return m.OwnerID()
+	case refreshtoken.FieldTokenHash:
+		return m.TokenHash()
+	case refreshtoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case refreshtoken.FieldRevoked:
+		return m.Revoked()
+	case refreshtoken.FieldIPAddress:
+		return m.IPAddress()
+	case refreshtoken.FieldUserAgent:
+		return m.UserAgent()
+	case refreshtoken.FieldFamilyID:
+		return m.FamilyID()
+	case refreshtoken.FieldParentID:
+		return m.ParentID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RefreshTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case refreshtoken.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case refreshtoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case refreshtoken.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case refreshtoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case refreshtoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case refreshtoken.FieldRevoked:
+		return m.OldRevoked(ctx)
+	case refreshtoken.FieldIPAddress:
+		return m.OldIPAddress(ctx)
+	case refreshtoken.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case refreshtoken.FieldFamilyID:
+		return m.OldFamilyID(ctx)
+	case refreshtoken.FieldParentID:
+		return m.OldParentID(ctx)
+	}
+	return nil, fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefreshTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case refreshtoken.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case refreshtoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case refreshtoken.FieldOwnerID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	case refreshtoken.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case refreshtoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case refreshtoken.FieldRevoked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevoked(v)
+		return nil
+	case refreshtoken.FieldIPAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIPAddress(v)
+		return nil
+	case refreshtoken.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case refreshtoken.FieldFamilyID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFamilyID(v)
+		return nil
+	case refreshtoken.FieldParentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RefreshTokenMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RefreshTokenMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefreshTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RefreshToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RefreshTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(refreshtoken.FieldIPAddress) {
+		fields = append(fields, refreshtoken.FieldIPAddress)
+	}
+	if m.FieldCleared(refreshtoken.FieldUserAgent) {
+		fields = append(fields, refreshtoken.FieldUserAgent)
+	}
+	if m.FieldCleared(refreshtoken.FieldParentID) {
+		fields = append(fields, refreshtoken.FieldParentID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RefreshTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RefreshTokenMutation) ClearField(name string) error {
+	switch name {
+	case refreshtoken.FieldIPAddress:
+		m.ClearIPAddress()
+		return nil
+	case refreshtoken.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
+	case refreshtoken.FieldParentID:
+		m.ClearParentID()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RefreshTokenMutation) ResetField(name string) error {
+	switch name {
+	case refreshtoken.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case refreshtoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case refreshtoken.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case refreshtoken.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case refreshtoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case refreshtoken.FieldRevoked:
+		m.ResetRevoked()
+		return nil
+	case refreshtoken.FieldIPAddress:
+		m.ResetIPAddress()
+		return nil
+	case refreshtoken.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case refreshtoken.FieldFamilyID:
+		m.ResetFamilyID()
+		return nil
+	case refreshtoken.FieldParentID:
+		m.ResetParentID()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RefreshTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.owner != nil {
+		edges = append(edges, refreshtoken.EdgeOwner)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RefreshTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case refreshtoken.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RefreshTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RefreshTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RefreshTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedowner {
+		edges = append(edges, refreshtoken.EdgeOwner)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RefreshTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case refreshtoken.EdgeOwner:
+		return m.clearedowner
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RefreshTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case refreshtoken.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RefreshTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case refreshtoken.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken edge %s", name)
+}
+
+// RoleChangeMutation represents an operation that mutates the RoleChange nodes in the graph.
+type RoleChangeMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	updated_at    *time.Time
+	created_at    *time.Time
+	attribute     *rolechange.Attribute
+	old_value     *string
+	new_value     *string
+	source        *rolechange.Source
+	changed_by_id *uuid.UUID
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*RoleChange, error)
+	predicates    []predicate.RoleChange
+}
+
+var _ ent.Mutation = (*RoleChangeMutation)(nil)
+
+// rolechangeOption allows management of the mutation configuration using functional options.
+type rolechangeOption func(*RoleChangeMutation)
+
+// newRoleChangeMutation creates new mutation for the RoleChange entity.
+func newRoleChangeMutation(c config, op Op, opts ...rolechangeOption) *RoleChangeMutation {
+	m := &RoleChangeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRoleChange,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoleChangeID sets the ID field of the mutation.
+func withRoleChangeID(id uuid.UUID) rolechangeOption {
+	return func(m *RoleChangeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RoleChange
+		)
+		m.oldValue = func(ctx context.Context) (*RoleChange, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RoleChange.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRoleChange sets the old RoleChange of the mutation.
+func withRoleChange(node *RoleChange) rolechangeOption {
+	return func(m *RoleChangeMutation) {
+		m.oldValue = func(context.Context) (*RoleChange, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoleChangeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoleChangeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RoleChange entities.
+func (m *RoleChangeMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoleChangeMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoleChangeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RoleChange.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RoleChangeMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RoleChangeMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RoleChange entity.
+// If the RoleChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleChangeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RoleChangeMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RoleChangeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RoleChangeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RoleChange entity.
+// If the RoleChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleChangeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RoleChangeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RoleChangeMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RoleChangeMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RoleChange entity.
+// If the RoleChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleChangeMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RoleChangeMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetAttribute sets the "attribute" field.
+func (m *RoleChangeMutation) SetAttribute(r rolechange.Attribute) {
+	m.attribute = &r
+}
+
+// Attribute returns the value of the "attribute" field in the mutation.
+func (m *RoleChangeMutation) Attribute() (r rolechange.Attribute, exists bool) {
+	v := m.attribute
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttribute returns the old "attribute" field's value of the RoleChange entity.
+// If the RoleChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleChangeMutation) OldAttribute(ctx context.Context) (v rolechange.Attribute, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttribute is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttribute requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttribute: %w", err)
+	}
+	return oldValue.Attribute, nil
+}
+
+// ResetAttribute resets all changes to the "attribute" field.
+func (m *RoleChangeMutation) ResetAttribute() {
+	m.attribute = nil
+}
+
+// SetOldValue sets the "old_value" field.
+func (m *RoleChangeMutation) SetOldValue(s string) {
+	m.old_value = &s
+}
+
+// OldValue returns the value of the "old_value" field in the mutation.
+func (m *RoleChangeMutation) OldValue() (r string, exists bool) {
+	v := m.old_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOldValue returns the old "old_value" field's value of the RoleChange entity.
+// If the RoleChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleChangeMutation) OldOldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOldValue: %w", err)
+	}
+	return oldValue.OldValue, nil
+}
+
+// ResetOldValue resets all changes to the "old_value" field.
+func (m *RoleChangeMutation) ResetOldValue() {
+	m.old_value = nil
+}
+
+// SetNewValue sets the "new_value" field.
+func (m *RoleChangeMutation) SetNewValue(s string) {
+	m.new_value = &s
+}
+
+// NewValue returns the value of the "new_value" field in the mutation.
+func (m *RoleChangeMutation) NewValue() (r string, exists bool) {
+	v := m.new_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNewValue returns the old "new_value" field's value of the RoleChange entity.
+// If the RoleChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleChangeMutation) OldNewValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNewValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNewValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNewValue: %w", err)
+	}
+	return oldValue.NewValue, nil
+}
+
+// ResetNewValue resets all changes to the "new_value" field.
+func (m *RoleChangeMutation) ResetNewValue() {
+	m.new_value = nil
+}
+
+// SetSource sets the "source" field.
+func (m *RoleChangeMutation) SetSource(r rolechange.Source) {
+	m.source = &r
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *RoleChangeMutation) Source() (r rolechange.Source, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the RoleChange entity.
+// If the RoleChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleChangeMutation) OldSource(ctx context.Context) (v rolechange.Source, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *RoleChangeMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetChangedByID sets the "changed_by_id" field.
+func (m *RoleChangeMutation) SetChangedByID(u uuid.UUID) {
+	m.changed_by_id = &u
+}
+
+// ChangedByID returns the value of the "changed_by_id" field in the mutation.
+func (m *RoleChangeMutation) ChangedByID() (r uuid.UUID, exists bool) {
+	v := m.changed_by_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChangedByID returns the old "changed_by_id" field's value of the RoleChange entity.
+// If the RoleChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleChangeMutation) OldChangedByID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChangedByID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChangedByID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChangedByID: %w", err)
+	}
+	return oldValue.ChangedByID, nil
+}
+
+// ClearChangedByID clears the value of the "changed_by_id" field.
+func (m *RoleChangeMutation) ClearChangedByID() {
+	m.changed_by_id = nil
+	m.clearedFields[rolechange.FieldChangedByID] = struct{}{}
+}
+
+// ChangedByIDCleared returns if the "changed_by_id" field was cleared in this mutation.
+func (m *RoleChangeMutation) ChangedByIDCleared() bool {
+	_, ok := m.clearedFields[rolechange.FieldChangedByID]
+	return ok
+}
+
+// ResetChangedByID resets all changes to the "changed_by_id" field.
+func (m *RoleChangeMutation) ResetChangedByID() {
+	m.changed_by_id = nil
+	delete(m.clearedFields, rolechange.FieldChangedByID)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RoleChangeMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[rolechange.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RoleChangeMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RoleChangeMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *RoleChangeMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the RoleChangeMutation builder.
+func (m *RoleChangeMutation) Where(ps ...predicate.RoleChange) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoleChangeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoleChangeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoleChange, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoleChangeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoleChangeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RoleChange).
+func (m *RoleChangeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoleChangeMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.updated_at != nil {
+		fields = append(fields, rolechange.FieldUpdatedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, rolechange.FieldCreatedAt)
+	}
+	if m.user != nil {
+		fields = append(fields, rolechange.FieldUserID)
+	}
+	if m.attribute != nil {
+		fields = append(fields, rolechange.FieldAttribute)
+	}
+	if m.old_value != nil {
+		fields = append(fields, rolechange.FieldOldValue)
+	}
+	if m.new_value != nil {
+		fields = append(fields, rolechange.FieldNewValue)
+	}
+	if m.source != nil {
+		fields = append(fields, rolechange.FieldSource)
+	}
+	if m.changed_by_id != nil {
+		fields = append(fields, rolechange.FieldChangedByID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoleChangeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rolechange.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case rolechange.FieldCreatedAt:
+		return m.CreatedAt()
+	case rolechange.FieldUserID:
+		return m.UserID()
+	case rolechange.FieldAttribute:
+		return m.Attribute()
+	case rolechange.FieldOldValue:
+		return m.OldValue()
+	case rolechange.FieldNewValue:
+		return m.NewValue()
+	case rolechange.FieldSource:
+		return m.Source()
+	case rolechange.FieldChangedByID:
+		return m.ChangedByID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoleChangeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rolechange.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case rolechange.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case rolechange.FieldUserID:
+		return m.OldUserID(ctx)
+	case rolechange.FieldAttribute:
+		return m.OldAttribute(ctx)
+	case rolechange.FieldOldValue:
+		return m.OldOldValue(ctx)
+	case rolechange.FieldNewValue:
+		return m.OldNewValue(ctx)
+	case rolechange.FieldSource:
+		return m.OldSource(ctx)
+	case rolechange.FieldChangedByID:
+		return m.OldChangedByID(ctx)
+	}
+	return nil, fmt.Errorf("unknown RoleChange field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleChangeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rolechange.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case rolechange.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case rolechange.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case rolechange.FieldAttribute:
+		v, ok := value.(rolechange.Attribute)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttribute(v)
+		return nil
+	case rolechange.FieldOldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOldValue(v)
+		return nil
+	case rolechange.FieldNewValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNewValue(v)
+		return nil
+	case rolechange.FieldSource:
+		v, ok := value.(rolechange.Source)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case rolechange.FieldChangedByID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChangedByID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoleChange field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoleChangeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoleChangeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleChangeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RoleChange numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoleChangeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(rolechange.FieldChangedByID) {
+		fields = append(fields, rolechange.FieldChangedByID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoleChangeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoleChangeMutation) ClearField(name string) error {
+	switch name {
+	case rolechange.FieldChangedByID:
+		m.ClearChangedByID()
+		return nil
+	}
+	return fmt.Errorf("unknown RoleChange nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoleChangeMutation) ResetField(name string) error {
+	switch name {
+	case rolechange.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case rolechange.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case rolechange.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case rolechange.FieldAttribute:
+		m.ResetAttribute()
+		return nil
+	case rolechange.FieldOldValue:
+		m.ResetOldValue()
+		return nil
+	case rolechange.FieldNewValue:
+		m.ResetNewValue()
+		return nil
+	case rolechange.FieldSource:
+		m.ResetSource()
+		return nil
+	case rolechange.FieldChangedByID:
+		m.ResetChangedByID()
+		return nil
+	}
+	return fmt.Errorf("unknown RoleChange field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoleChangeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, rolechange.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoleChangeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case rolechange.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoleChangeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoleChangeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoleChangeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, rolechange.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoleChangeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case rolechange.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoleChangeMutation) ClearEdge(name string) error {
+	switch name {
+	case rolechange.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RoleChange unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoleChangeMutation) ResetEdge(name string) error {
+	switch name {
+	case rolechange.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RoleChange edge %s", name)
+}
+
+// RolePermissionMutation represents an operation that mutates the RolePermission nodes in the graph.
+type RolePermissionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	updated_at    *time.Time
+	created_at    *time.Time
+	role          *rolepermission.Role
+	permission    *rolepermission.Permission
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*RolePermission, error)
+	predicates    []predicate.RolePermission
+}
+
+var _ ent.Mutation = (*RolePermissionMutation)(nil)
+
+// rolepermissionOption allows management of the mutation configuration using functional options.
+type rolepermissionOption func(*RolePermissionMutation)
+
+// newRolePermissionMutation creates new mutation for the RolePermission entity.
+func newRolePermissionMutation(c config, op Op, opts ...rolepermissionOption) *RolePermissionMutation {
+	m := &RolePermissionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRolePermission,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRolePermissionID sets the ID field of the mutation.
+func withRolePermissionID(id uuid.UUID) rolepermissionOption {
+	return func(m *RolePermissionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RolePermission
+		)
+		m.oldValue = func(ctx context.Context) (*RolePermission, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RolePermission.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRolePermission sets the old RolePermission of the mutation.
+func withRolePermission(node *RolePermission) rolepermissionOption {
+	return func(m *RolePermissionMutation) {
+		m.oldValue = func(context.Context) (*RolePermission, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RolePermissionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RolePermissionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RolePermission entities.
+func (m *RolePermissionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RolePermissionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RolePermissionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RolePermission.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RolePermissionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RolePermissionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RolePermission entity.
+// If the RolePermission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolePermissionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RolePermissionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RolePermissionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RolePermissionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RolePermission entity.
+// If the RolePermission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolePermissionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RolePermissionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetRole sets the "role" field.
+func (m *RolePermissionMutation) SetRole(r rolepermission.Role) {
+	m.role = &r
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *RolePermissionMutation) Role() (r rolepermission.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the RolePermission entity.
+// If the RolePermission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolePermissionMutation) OldRole(ctx context.Context) (v rolepermission.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *RolePermissionMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetPermission sets the "permission" field.
+func (m *RolePermissionMutation) SetPermission(r rolepermission.Permission) {
+	m.permission = &r
+}
+
+// Permission returns the value of the "permission" field in the mutation.
+func (m *RolePermissionMutation) Permission() (r rolepermission.Permission, exists bool) {
+	v := m.permission
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPermission returns the old "permission" field's value of the RolePermission entity.
+// If the RolePermission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolePermissionMutation) OldPermission(ctx context.Context) (v rolepermission.Permission, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPermission is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPermission requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPermission: %w", err)
+	}
+	return oldValue.Permission, nil
+}
+
+// ResetPermission resets all changes to the "permission" field.
+func (m *RolePermissionMutation) ResetPermission() {
+	m.permission = nil
+}
+
+// Where appends a list predicates to the RolePermissionMutation builder.
+func (m *RolePermissionMutation) Where(ps ...predicate.RolePermission) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RolePermissionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RolePermissionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RolePermission, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RolePermissionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RolePermissionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RolePermission).
+func (m *RolePermissionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RolePermissionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.updated_at != nil {
+		fields = append(fields, rolepermission.FieldUpdatedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, rolepermission.FieldCreatedAt)
+	}
+	if m.role != nil {
+		fields = append(fields, rolepermission.FieldRole)
+	}
+	if m.permission != nil {
+		fields = append(fields, rolepermission.FieldPermission)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RolePermissionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rolepermission.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case rolepermission.FieldCreatedAt:
+		return m.CreatedAt()
+	case rolepermission.FieldRole:
+		return m.Role()
+	case rolepermission.FieldPermission:
+		return m.Permission()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RolePermissionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rolepermission.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case rolepermission.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case rolepermission.FieldRole:
+		return m.OldRole(ctx)
+	case rolepermission.FieldPermission:
+		return m.OldPermission(ctx)
+	}
+	return nil, fmt.Errorf("unknown RolePermission field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RolePermissionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rolepermission.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case rolepermission.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case rolepermission.FieldRole:
+		v, ok := value.(rolepermission.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case rolepermission.FieldPermission:
+		v, ok := value.(rolepermission.Permission)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermission(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RolePermission field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RolePermissionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RolePermissionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RolePermissionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RolePermission numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RolePermissionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RolePermissionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RolePermissionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RolePermission nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RolePermissionMutation) ResetField(name string) error {
+	switch name {
+	case rolepermission.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case rolepermission.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case rolepermission.FieldRole:
+		m.ResetRole()
+		return nil
+	case rolepermission.FieldPermission:
+		m.ResetPermission()
+		return nil
+	}
+	return fmt.Errorf("unknown RolePermission field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RolePermissionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RolePermissionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RolePermissionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RolePermissionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RolePermissionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RolePermissionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RolePermissionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RolePermission unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RolePermissionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RolePermission edge %s", name)
+}
+
+// SuspensionMutation represents an operation that mutates the Suspension nodes in the graph.
+type SuspensionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	updated_at    *time.Time
+	created_at    *time.Time
+	reason        *string
+	scope         *suspension.Scope
+	starts_at     *time.Time
+	ends_at       *time.Time
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	issuer        *uuid.UUID
+	clearedissuer bool
+	done          bool
+	oldValue      func(context.Context) (*Suspension, error)
+	predicates    []predicate.Suspension
+}
+
+var _ ent.Mutation = (*SuspensionMutation)(nil)
+
+// suspensionOption allows management of the mutation configuration using functional options.
+type suspensionOption func(*SuspensionMutation)
+
+// newSuspensionMutation creates new mutation for the Suspension entity.
+func newSuspensionMutation(c config, op Op, opts ...suspensionOption) *SuspensionMutation {
+	m := &SuspensionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSuspension,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSuspensionID sets the ID field of the mutation.
+func withSuspensionID(id uuid.UUID) suspensionOption {
+	return func(m *SuspensionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Suspension
+		)
+		m.oldValue = func(ctx context.Context) (*Suspension, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Suspension.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSuspension sets the old Suspension of the mutation.
+func withSuspension(node *Suspension) suspensionOption {
+	return func(m *SuspensionMutation) {
+		m.oldValue = func(context.Context) (*Suspension, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SuspensionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SuspensionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Suspension entities.
+func (m *SuspensionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SuspensionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SuspensionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Suspension.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SuspensionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SuspensionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Suspension entity.
+// If the Suspension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SuspensionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SuspensionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SuspensionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SuspensionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Suspension entity.
+// If the Suspension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SuspensionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SuspensionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SuspensionMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SuspensionMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Suspension entity.
+// If the Suspension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SuspensionMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SuspensionMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetIssuerID sets the "issuer_id" field.
+func (m *SuspensionMutation) SetIssuerID(u uuid.UUID) {
+	m.issuer = &u
+}
+
+// IssuerID returns the value of the "issuer_id" field in the mutation.
+func (m *SuspensionMutation) IssuerID() (r uuid.UUID, exists bool) {
+	v := m.issuer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssuerID returns the old "issuer_id" field's value of the Suspension entity.
+// If the Suspension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SuspensionMutation) OldIssuerID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssuerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssuerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssuerID: %w", err)
+	}
+	return oldValue.IssuerID, nil
+}
+
+// ClearIssuerID clears the value of the "issuer_id" field.
+func (m *SuspensionMutation) ClearIssuerID() {
+	m.issuer = nil
+	m.clearedFields[suspension.FieldIssuerID] = struct{}{}
+}
+
+// IssuerIDCleared returns if the "issuer_id" field was cleared in this mutation.
+func (m *SuspensionMutation) IssuerIDCleared() bool {
+	_, ok := m.clearedFields[suspension.FieldIssuerID]
+	return ok
+}
+
+// ResetIssuerID resets all changes to the "issuer_id" field.
+func (m *SuspensionMutation) ResetIssuerID() {
+	m.issuer = nil
+	delete(m.clearedFields, suspension.FieldIssuerID)
+}
+
+// SetReason sets the "reason" field.
+func (m *SuspensionMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *SuspensionMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the Suspension entity.
+// If the Suspension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SuspensionMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *SuspensionMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetScope sets the "scope" field.
+func (m *SuspensionMutation) SetScope(s suspension.Scope) {
+	m.scope = &s
+}
+
+// Scope returns the value of the "scope" field in the mutation.
+func (m *SuspensionMutation) Scope() (r suspension.Scope, exists bool) {
+	v := m.scope
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScope returns the old "scope" field's value of the Suspension entity.
+// If the Suspension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SuspensionMutation) OldScope(ctx context.Context) (v suspension.Scope, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScope requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
+	}
+	return oldValue.Scope, nil
+}
+
+// ResetScope resets all changes to the "scope" field.
+func (m *SuspensionMutation) ResetScope() {
+	m.scope = nil
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (m *SuspensionMutation) SetStartsAt(t time.Time) {
+	m.starts_at = &t
+}
+
+// StartsAt returns the value of the "starts_at" field in the mutation.
+func (m *SuspensionMutation) StartsAt() (r time.Time, exists bool) {
+	v := m.starts_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartsAt returns the old "starts_at" field's value of the Suspension entity.
+// If the Suspension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SuspensionMutation) OldStartsAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartsAt: %w", err)
+	}
+	return oldValue.StartsAt, nil
+}
+
+// ResetStartsAt resets all changes to the "starts_at" field.
+func (m *SuspensionMutation) ResetStartsAt() {
+	m.starts_at = nil
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (m *SuspensionMutation) SetEndsAt(t time.Time) {
+	m.ends_at = &t
+}
+
+// EndsAt returns the value of the "ends_at" field in the mutation.
+func (m *SuspensionMutation) EndsAt() (r time.Time, exists bool) {
+	v := m.ends_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndsAt returns the old "ends_at" field's value of the Suspension entity.
+// If the Suspension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SuspensionMutation) OldEndsAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndsAt: %w", err)
+	}
+	return oldValue.EndsAt, nil
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (m *SuspensionMutation) ClearEndsAt() {
+	m.ends_at = nil
+	m.clearedFields[suspension.FieldEndsAt] = struct{}{}
+}
+
+// EndsAtCleared returns if the "ends_at" field was cleared in this mutation.
+func (m *SuspensionMutation) EndsAtCleared() bool {
+	_, ok := m.clearedFields[suspension.FieldEndsAt]
+	return ok
+}
+
+// ResetEndsAt resets all changes to the "ends_at" field.
+func (m *SuspensionMutation) ResetEndsAt() {
+	m.ends_at = nil
+	delete(m.clearedFields, suspension.FieldEndsAt)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SuspensionMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[suspension.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SuspensionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SuspensionMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *SuspensionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearIssuer clears the "issuer" edge to the User entity.
+func (m *SuspensionMutation) ClearIssuer() {
+	m.clearedissuer = true
+	m.clearedFields[suspension.FieldIssuerID] = struct{}{}
+}
+
+// IssuerCleared reports if the "issuer" edge to the User entity was cleared.
+func (m *SuspensionMutation) IssuerCleared() bool {
+	return m.IssuerIDCleared() || m.clearedissuer
+}
+
+// IssuerIDs returns the "issuer" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// IssuerID instead. It exists only for internal usage by the builders.
+func (m *SuspensionMutation) IssuerIDs() (ids []uuid.UUID) {
+	if id := m.issuer; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetIssuer resets all changes to the "issuer" edge.
+func (m *SuspensionMutation) ResetIssuer() {
+	m.issuer = nil
+	m.clearedissuer = false
+}
+
+// Where appends a list predicates to the SuspensionMutation builder.
+func (m *SuspensionMutation) Where(ps ...predicate.Suspension) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SuspensionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SuspensionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Suspension, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SuspensionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SuspensionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Suspension).
+func (m *SuspensionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SuspensionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.updated_at != nil {
+		fields = append(fields, suspension.FieldUpdatedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, suspension.FieldCreatedAt)
+	}
+	if m.user != nil {
+		fields = append(fields, suspension.FieldUserID)
+	}
+	if m.issuer != nil {
+		fields = append(fields, suspension.FieldIssuerID)
+	}
+	if m.reason != nil {
+		fields = append(fields, suspension.FieldReason)
+	}
+	if m.scope != nil {
+		fields = append(fields, suspension.FieldScope)
+	}
+	if m.starts_at != nil {
+		fields = append(fields, suspension.FieldStartsAt)
+	}
+	if m.ends_at != nil {
+		fields = append(fields, suspension.FieldEndsAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SuspensionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case suspension.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case suspension.FieldCreatedAt:
+		return m.CreatedAt()
+	case suspension.FieldUserID:
+		return m.UserID()
+	case suspension.FieldIssuerID:
+		return m.IssuerID()
+	case suspension.FieldReason:
+		return m.Reason()
+	case suspension.FieldScope:
+		return m.Scope()
+	case suspension.FieldStartsAt:
+		return m.StartsAt()
+	case suspension.FieldEndsAt:
+		return m.EndsAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SuspensionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case suspension.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case suspension.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case suspension.FieldUserID:
+		return m.OldUserID(ctx)
+	case suspension.FieldIssuerID:
+		return m.OldIssuerID(ctx)
+	case suspension.FieldReason:
+		return m.OldReason(ctx)
+	case suspension.FieldScope:
+		return m.OldScope(ctx)
+	case suspension.FieldStartsAt:
+		return m.OldStartsAt(ctx)
+	case suspension.FieldEndsAt:
+		return m.OldEndsAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Suspension field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SuspensionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case suspension.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case suspension.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case suspension.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case suspension.FieldIssuerID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuerID(v)
+		return nil
+	case suspension.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case suspension.FieldScope:
+		v, ok := value.(suspension.Scope)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScope(v)
+		return nil
+	case suspension.FieldStartsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartsAt(v)
+		return nil
+	case suspension.FieldEndsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndsAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Suspension field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SuspensionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SuspensionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SuspensionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Suspension numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SuspensionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(suspension.FieldIssuerID) {
+		fields = append(fields, suspension.FieldIssuerID)
+	}
+	if m.FieldCleared(suspension.FieldEndsAt) {
+		fields = append(fields, suspension.FieldEndsAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SuspensionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SuspensionMutation) ClearField(name string) error {
+	switch name {
+	case suspension.FieldIssuerID:
+		m.ClearIssuerID()
+		return nil
+	case suspension.FieldEndsAt:
+		m.ClearEndsAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Suspension nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SuspensionMutation) ResetField(name string) error {
+	switch name {
+	case suspension.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case suspension.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case suspension.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case suspension.FieldIssuerID:
+		m.ResetIssuerID()
+		return nil
+	case suspension.FieldReason:
+		m.ResetReason()
+		return nil
+	case suspension.FieldScope:
+		m.ResetScope()
+		return nil
+	case suspension.FieldStartsAt:
+		m.ResetStartsAt()
+		return nil
+	case suspension.FieldEndsAt:
+		m.ResetEndsAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Suspension field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SuspensionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, suspension.EdgeUser)
+	}
+	if m.issuer != nil {
+		edges = append(edges, suspension.EdgeIssuer)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SuspensionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case suspension.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case suspension.EdgeIssuer:
+		if id := m.issuer; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SuspensionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SuspensionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SuspensionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, suspension.EdgeUser)
+	}
+	if m.clearedissuer {
+		edges = append(edges, suspension.EdgeIssuer)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SuspensionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case suspension.EdgeUser:
+		return m.cleareduser
+	case suspension.EdgeIssuer:
+		return m.clearedissuer
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SuspensionMutation) ClearEdge(name string) error {
+	switch name {
+	case suspension.EdgeUser:
+		m.ClearUser()
+		return nil
+	case suspension.EdgeIssuer:
+		m.ClearIssuer()
+		return nil
+	}
+	return fmt.Errorf("unknown Suspension unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SuspensionMutation) ResetEdge(name string) error {
+	switch name {
+	case suspension.EdgeUser:
+		m.ResetUser()
+		return nil
+	case suspension.EdgeIssuer:
+		m.ResetIssuer()
+		return nil
+	}
+	return fmt.Errorf("unknown Suspension edge %s", name)
+}
+
+// TwitchTokenMutation represents an operation that mutates the TwitchToken nodes in the graph.
+type TwitchTokenMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	updated_at     *time.Time
+	created_at     *time.Time
+	twitch_user_id *string
+	session_id     *uuid.UUID
+	access_token   *[]byte
+	refresh_token  *[]byte
+	expires_at     *time.Time
+	scopes         *[]string
+	appendscopes   []string
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*TwitchToken, error)
+	predicates     []predicate.TwitchToken
+}
+
+var _ ent.Mutation = (*TwitchTokenMutation)(nil)
+
+// twitchtokenOption allows management of the mutation configuration using functional options.
+type twitchtokenOption func(*TwitchTokenMutation)
+
+// newTwitchTokenMutation creates new mutation for the TwitchToken entity.
+func newTwitchTokenMutation(c config, op Op, opts ...twitchtokenOption) *TwitchTokenMutation {
+	m := &TwitchTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTwitchToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTwitchTokenID sets the ID field of the mutation.
+func withTwitchTokenID(id uuid.UUID) twitchtokenOption {
+	return func(m *TwitchTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TwitchToken
+		)
+		m.oldValue = func(ctx context.Context) (*TwitchToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TwitchToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTwitchToken sets the old TwitchToken of the mutation.
+func withTwitchToken(node *TwitchToken) twitchtokenOption {
+	return func(m *TwitchTokenMutation) {
+		m.oldValue = func(context.Context) (*TwitchToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TwitchTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TwitchTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TwitchToken entities.
+func (m *TwitchTokenMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TwitchTokenMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TwitchTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TwitchToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TwitchTokenMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TwitchTokenMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the TwitchToken entity.
+// If the TwitchToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TwitchTokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TwitchTokenMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TwitchTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TwitchTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TwitchToken entity.
+// If the TwitchToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TwitchTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TwitchTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetTwitchUserID sets the "twitch_user_id" field.
+func (m *TwitchTokenMutation) SetTwitchUserID(s string) {
+	m.twitch_user_id = &s
+}
+
+// TwitchUserID returns the value of the "twitch_user_id" field in the mutation.
+func (m *TwitchTokenMutation) TwitchUserID() (r string, exists bool) {
+	v := m.twitch_user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTwitchUserID returns the old "twitch_user_id" field's value of the TwitchToken entity.
+// If the TwitchToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TwitchTokenMutation) OldTwitchUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTwitchUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTwitchUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTwitchUserID: %w", err)
+	}
+	return oldValue.TwitchUserID, nil
+}
+
+// ResetTwitchUserID resets all changes to the "twitch_user_id" field.
+func (m *TwitchTokenMutation) ResetTwitchUserID() {
+	m.twitch_user_id = nil
+}
+
+// SetSessionID sets the "session_id" field.
+func (m *TwitchTokenMutation) SetSessionID(u uuid.UUID) {
+	m.session_id = &u
+}
+
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *TwitchTokenMutation) SessionID() (r uuid.UUID, exists bool) {
+	v := m.session_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionID returns the old "session_id" field's value of the TwitchToken entity.
+// If the TwitchToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TwitchTokenMutation) OldSessionID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+	}
+	return oldValue.SessionID, nil
+}
+
+// ClearSessionID clears the value of the "session_id" field.
+func (m *TwitchTokenMutation) ClearSessionID() {
+	m.session_id = nil
+	m.clearedFields[twitchtoken.FieldSessionID] = struct{}{}
+}
+
+// SessionIDCleared returns if the "session_id" field was cleared in this mutation.
+func (m *TwitchTokenMutation) SessionIDCleared() bool {
+	_, ok := m.clearedFields[twitchtoken.FieldSessionID]
+	return ok
+}
+
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *TwitchTokenMutation) ResetSessionID() {
+	m.session_id = nil
+	delete(m.clearedFields, twitchtoken.FieldSessionID)
+}
+
+// SetAccessToken sets the "access_token" field.
+func (m *TwitchTokenMutation) SetAccessToken(b []byte) {
+	m.access_token = &b
+}
+
+// AccessToken returns the value of the "access_token" field in the mutation.
+func (m *TwitchTokenMutation) AccessToken() (r []byte, exists bool) {
+	v := m.access_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessToken returns the old "access_token" field's value of the TwitchToken entity.
+// If the TwitchToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TwitchTokenMutation) OldAccessToken(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessToken: %w", err)
+	}
+	return oldValue.AccessToken, nil
+}
+
+// ResetAccessToken resets all changes to the "access_token" field.
+func (m *TwitchTokenMutation) ResetAccessToken() {
+	m.access_token = nil
+}
+
+// SetRefreshToken sets the "refresh_token" field.
+func (m *TwitchTokenMutation) SetRefreshToken(b []byte) {
+	m.refresh_token = &b
+}
+
+// RefreshToken returns the value of the "refresh_token" field in the mutation.
+func (m *TwitchTokenMutation) RefreshToken() (r []byte, exists bool) {
+	v := m.refresh_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefreshToken returns the old "refresh_token" field's value of the TwitchToken entity.
+// If the TwitchToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TwitchTokenMutation) OldRefreshToken(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefreshToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefreshToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefreshToken: %w", err)
+	}
+	return oldValue.RefreshToken, nil
+}
+
+// ResetRefreshToken resets all changes to the "refresh_token" field.
+func (m *TwitchTokenMutation) ResetRefreshToken() {
+	m.refresh_token = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *TwitchTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *TwitchTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the TwitchToken entity.
+// If the TwitchToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TwitchTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *TwitchTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetScopes sets the "scopes" field.
+func (m *TwitchTokenMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *TwitchTokenMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopes returns the old "scopes" field's value of the TwitchToken entity.
+// If the TwitchToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TwitchTokenMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
+}
+
+// AppendScopes adds s to the "scopes" field.
+func (m *TwitchTokenMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
+}
+
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *TwitchTokenMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (m *TwitchTokenMutation) ClearScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	m.clearedFields[twitchtoken.FieldScopes] = struct{}{}
+}
+
+// ScopesCleared returns if the "scopes" field was cleared in this mutation.
+func (m *TwitchTokenMutation) ScopesCleared() bool {
+	_, ok := m.clearedFields[twitchtoken.FieldScopes]
+	return ok
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *TwitchTokenMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	delete(m.clearedFields, twitchtoken.FieldScopes)
+}
+
+// Where appends a list predicates to the TwitchTokenMutation builder.
+func (m *TwitchTokenMutation) Where(ps ...predicate.TwitchToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TwitchTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TwitchTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TwitchToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TwitchTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TwitchTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TwitchToken).
+func (m *TwitchTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TwitchTokenMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.updated_at != nil {
+		fields = append(fields, twitchtoken.FieldUpdatedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, twitchtoken.FieldCreatedAt)
+	}
+	if m.twitch_user_id != nil {
+		fields = append(fields, twitchtoken.FieldTwitchUserID)
+	}
+	if m.session_id != nil {
+		fields = append(fields, twitchtoken.FieldSessionID)
+	}
+	if m.access_token != nil {
+		fields = append(fields, twitchtoken.FieldAccessToken)
+	}
+	if m.refresh_token != nil {
+		fields = append(fields, twitchtoken.FieldRefreshToken)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, twitchtoken.FieldExpiresAt)
+	}
+	if m.scopes != nil {
+		fields = append(fields, twitchtoken.FieldScopes)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TwitchTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case twitchtoken.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case twitchtoken.FieldCreatedAt:
+		return m.CreatedAt()
+	case twitchtoken.FieldTwitchUserID:
+		return m.TwitchUserID()
+	case twitchtoken.FieldSessionID:
+		return m.SessionID()
+	case twitchtoken.FieldAccessToken:
+		return m.AccessToken()
+	case twitchtoken.FieldRefreshToken:
+		return m.RefreshToken()
+	case twitchtoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case twitchtoken.FieldScopes:
+		return m.Scopes()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TwitchTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case twitchtoken.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case twitchtoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case twitchtoken.FieldTwitchUserID:
+		return m.OldTwitchUserID(ctx)
+	case twitchtoken.FieldSessionID:
+		return m.OldSessionID(ctx)
+	case twitchtoken.FieldAccessToken:
+		return m.OldAccessToken(ctx)
+	case twitchtoken.FieldRefreshToken:
+		return m.OldRefreshToken(ctx)
+	case twitchtoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case twitchtoken.FieldScopes:
+		return m.OldScopes(ctx)
+	}
+	return nil, fmt.Errorf("unknown TwitchToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TwitchTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case twitchtoken.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case twitchtoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case twitchtoken.FieldTwitchUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTwitchUserID(v)
+		return nil
+	case twitchtoken.FieldSessionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionID(v)
+		return nil
+	case twitchtoken.FieldAccessToken:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessToken(v)
+		return nil
+	case twitchtoken.FieldRefreshToken:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefreshToken(v)
+		return nil
+	case twitchtoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case twitchtoken.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TwitchToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TwitchTokenMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TwitchTokenMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TwitchTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TwitchToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TwitchTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(twitchtoken.FieldSessionID) {
+		fields = append(fields, twitchtoken.FieldSessionID)
+	}
+	if m.FieldCleared(twitchtoken.FieldScopes) {
+		fields = append(fields, twitchtoken.FieldScopes)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TwitchTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TwitchTokenMutation) ClearField(name string) error {
+	switch name {
+	case twitchtoken.FieldSessionID:
+		m.ClearSessionID()
+		return nil
+	case twitchtoken.FieldScopes:
+		m.ClearScopes()
+		return nil
+	}
+	return fmt.Errorf("unknown TwitchToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TwitchTokenMutation) ResetField(name string) error {
+	switch name {
+	case twitchtoken.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case twitchtoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case twitchtoken.FieldTwitchUserID:
+		m.ResetTwitchUserID()
+		return nil
+	case twitchtoken.FieldSessionID:
+		m.ResetSessionID()
+		return nil
+	case twitchtoken.FieldAccessToken:
+		m.ResetAccessToken()
+		return nil
+	case twitchtoken.FieldRefreshToken:
+		m.ResetRefreshToken()
+		return nil
+	case twitchtoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case twitchtoken.FieldScopes:
+		m.ResetScopes()
+		return nil
+	}
+	return fmt.Errorf("unknown TwitchToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TwitchTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TwitchTokenMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TwitchTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TwitchTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TwitchTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TwitchTokenMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TwitchTokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TwitchToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TwitchTokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TwitchToken edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	updated_at             *time.Time
+	created_at             *time.Time
+	deleted_at             *time.Time
+	deleted_by             *string
+	display_name           *string
+	alias                  *string
+	profile_image          *string
+	external_id            *string
+	auth_provider          *user.AuthProvider
+	role                   *user.Role
+	role_source            *user.RoleSource
+	twitch_vip             *bool
+	last_seen_at           *time.Time
+	last_post_seen_cursor  *string
+	clearedFields          map[string]struct{}
+	saved_posts            map[uuid.UUID]struct{}
+	removedsaved_posts     map[uuid.UUID]struct{}
+	clearedsaved_posts     bool
+	liked_posts            map[uuid.UUID]struct{}
+	removedliked_posts     map[uuid.UUID]struct{}
+	clearedliked_posts     bool
+	published_posts        map[uuid.UUID]struct{}
+	removedpublished_posts map[uuid.UUID]struct{}
+	clearedpublished_posts bool
+	comments               map[uuid.UUID]struct{}
+	removedcomments        map[uuid.UUID]struct{}
+	clearedcomments        bool
+	api_keys               map[uuid.UUID]struct{}
+	removedapi_keys        map[uuid.UUID]struct{}
+	clearedapi_keys        bool
+	awards                 map[uuid.UUID]struct{}
+	removedawards          map[uuid.UUID]struct{}
+	clearedawards          bool
+	blocked_users          map[uuid.UUID]struct{}
+	removedblocked_users   map[uuid.UUID]struct{}
+	clearedblocked_users   bool
+	blocked_by             map[uuid.UUID]struct{}
+	removedblocked_by      map[uuid.UUID]struct{}
+	clearedblocked_by      bool
+	muted_users            map[uuid.UUID]struct{}
+	removedmuted_users     map[uuid.UUID]struct{}
+	clearedmuted_users     bool
+	muted_by               map[uuid.UUID]struct{}
+	removedmuted_by        map[uuid.UUID]struct{}
+	clearedmuted_by        bool
+	identities             map[uuid.UUID]struct{}
+	removedidentities      map[uuid.UUID]struct{}
+	clearedidentities      bool
+	refresh_tokens         map[uuid.UUID]struct{}
+	removedrefresh_tokens  map[uuid.UUID]struct{}
+	clearedrefresh_tokens  bool
+	role_changes           map[uuid.UUID]struct{}
+	removedrole_changes    map[uuid.UUID]struct{}
+	clearedrole_changes    bool
+	suspensions            map[uuid.UUID]struct{}
+	removedsuspensions     map[uuid.UUID]struct{}
+	clearedsuspensions     bool
+	done                   bool
+	oldValue               func(context.Context) (*User, error)
+	predicates             []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id uuid.UUID) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *UserMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *UserMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *UserMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[user.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *UserMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *UserMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, user.FieldDeletedAt)
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (m *UserMutation) SetDeletedBy(s string) {
+	m.deleted_by = &s
+}
+
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *UserMutation) DeletedBy() (r string, exists bool) {
+	v := m.deleted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedBy returns the old "deleted_by" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
+	}
+	return oldValue.DeletedBy, nil
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *UserMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.clearedFields[user.FieldDeletedBy] = struct{}{}
+}
+
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *UserMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[user.FieldDeletedBy]
+	return ok
+}
+
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *UserMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	delete(m.clearedFields, user.FieldDeletedBy)
+}
+
+// SetDisplayName sets the "display_name" field.
+func (m *UserMutation) SetDisplayName(s string) {
+	m.display_name = &s
+}
+
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *UserMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayName returns the old "display_name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	}
+	return oldValue.DisplayName, nil
+}
+
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *UserMutation) ResetDisplayName() {
+	m.display_name = nil
+}
+
+// SetAlias sets the "alias" field.
+func (m *UserMutation) SetAlias(s string) {
+	m.alias = &s
+}
+
+// Alias returns the value of the "alias" field in the mutation.
+func (m *UserMutation) Alias() (r string, exists bool) {
+	v := m.alias
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlias returns the old "alias" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAlias(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlias is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlias requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlias: %w", err)
+	}
+	return oldValue.Alias, nil
+}
+
+// ClearAlias clears the value of the "alias" field.
+func (m *UserMutation) ClearAlias() {
+	m.alias = nil
+	m.clearedFields[user.FieldAlias] = struct{}{}
+}
+
+// AliasCleared returns if the "alias" field was cleared in this mutation.
+func (m *UserMutation) AliasCleared() bool {
+	_, ok := m.clearedFields[user.FieldAlias]
+	return ok
+}
+
+// ResetAlias resets all changes to the "alias" field.
+func (m *UserMutation) ResetAlias() {
+	m.alias = nil
+	delete(m.clearedFields, user.FieldAlias)
+}
+
+// SetProfileImage sets the "profile_image" field.
+func (m *UserMutation) SetProfileImage(s string) {
+	m.profile_image = &s
+}
+
+// ProfileImage returns the value of the "profile_image" field in the mutation.
+func (m *UserMutation) ProfileImage() (r string, exists bool) {
+	v := m.profile_image
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfileImage returns the old "profile_image" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldProfileImage(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfileImage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfileImage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfileImage: %w", err)
+	}
+	return oldValue.ProfileImage, nil
+}
+
+// ClearProfileImage clears the value of the "profile_image" field.
+func (m *UserMutation) ClearProfileImage() {
+	m.profile_image = nil
+	m.clearedFields[user.FieldProfileImage] = struct{}{}
+}
+
+// ProfileImageCleared returns if the "profile_image" field was cleared in this mutation.
+func (m *UserMutation) ProfileImageCleared() bool {
+	_, ok := m.clearedFields[user.FieldProfileImage]
+	return ok
+}
+
+// ResetProfileImage resets all changes to the "profile_image" field.
+func (m *UserMutation) ResetProfileImage() {
+	m.profile_image = nil
+	delete(m.clearedFields, user.FieldProfileImage)
+}
+
+// SetExternalID sets the "external_id" field.
+func (m *UserMutation) SetExternalID(s string) {
+	m.external_id = &s
+}
+
+// ExternalID returns the value of the "external_id" field in the mutation.
+func (m *UserMutation) ExternalID() (r string, exists bool) {
+	v := m.external_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalID returns the old "external_id" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldExternalID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExternalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExternalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalID: %w", err)
+	}
+	return oldValue.ExternalID, nil
+}
+
+// ResetExternalID resets all changes to the "external_id" field.
+func (m *UserMutation) ResetExternalID() {
+	m.external_id = nil
+}
+
+// SetAuthProvider sets the "auth_provider" field.
+func (m *UserMutation) SetAuthProvider(up user.AuthProvider) {
+	m.auth_provider = &up
+}
+
+// AuthProvider returns the value of the "auth_provider" field in the mutation.
+func (m *UserMutation) AuthProvider() (r user.AuthProvider, exists bool) {
+	v := m.auth_provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthProvider returns the old "auth_provider" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAuthProvider(ctx context.Context) (v user.AuthProvider, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthProvider: %w", err)
+	}
+	return oldValue.AuthProvider, nil
+}
+
+// ResetAuthProvider resets all changes to the "auth_provider" field.
+func (m *UserMutation) ResetAuthProvider() {
+	m.auth_provider = nil
+}
+
+// SetRole sets the "role" field.
+func (m *UserMutation) SetRole(u user.Role) {
+	m.role = &u
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *UserMutation) Role() (r user.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRole(ctx context.Context) (v user.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetRoleSource sets the "role_source" field.
+func (m *UserMutation) SetRoleSource(us user.RoleSource) {
+	m.role_source = &us
+}
+
+// RoleSource returns the value of the "role_source" field in the mutation.
+func (m *UserMutation) RoleSource() (r user.RoleSource, exists bool) {
+	v := m.role_source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleSource returns the old "role_source" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRoleSource(ctx context.Context) (v *user.RoleSource, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleSource: %w", err)
+	}
+	return oldValue.RoleSource, nil
+}
+
+// ClearRoleSource clears the value of the "role_source" field.
+func (m *UserMutation) ClearRoleSource() {
+	m.role_source = nil
+	m.clearedFields[user.FieldRoleSource] = struct{}{}
+}
+
+// RoleSourceCleared returns if the "role_source" field was cleared in this mutation.
+func (m *UserMutation) RoleSourceCleared() bool {
+	_, ok := m.clearedFields[user.FieldRoleSource]
+	return ok
+}
+
+// ResetRoleSource resets all changes to the "role_source" field.
+func (m *UserMutation) ResetRoleSource() {
+	m.role_source = nil
+	delete(m.clearedFields, user.FieldRoleSource)
+}
+
+// SetTwitchVip sets the "twitch_vip" field.
+func (m *UserMutation) SetTwitchVip(b bool) {
+	m.twitch_vip = &b
+}
+
+// TwitchVip returns the value of the "twitch_vip" field in the mutation.
+func (m *UserMutation) TwitchVip() (r bool, exists bool) {
+	v := m.twitch_vip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTwitchVip returns the old "twitch_vip" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTwitchVip(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTwitchVip is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTwitchVip requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTwitchVip: %w", err)
+	}
+	return oldValue.TwitchVip, nil
+}
+
+// ResetTwitchVip resets all changes to the "twitch_vip" field.
+func (m *UserMutation) ResetTwitchVip() {
+	m.twitch_vip = nil
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *UserMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
+}
+
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *UserMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "last_seen_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLastSeenAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (m *UserMutation) ClearLastSeenAt() {
+	m.last_seen_at = nil
+	m.clearedFields[user.FieldLastSeenAt] = struct{}{}
+}
+
+// LastSeenAtCleared returns if the "last_seen_at" field was cleared in this mutation.
+func (m *UserMutation) LastSeenAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldLastSeenAt]
+	return ok
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *UserMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+	delete(m.clearedFields, user.FieldLastSeenAt)
+}
+
+// SetLastPostSeenCursor sets the "last_post_seen_cursor" field.
+func (m *UserMutation) SetLastPostSeenCursor(s string) {
+	m.last_post_seen_cursor = &s
+}
+
+// LastPostSeenCursor returns the value of the "last_post_seen_cursor" field in the mutation.
+func (m *UserMutation) LastPostSeenCursor() (r string, exists bool) {
+	v := m.last_post_seen_cursor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastPostSeenCursor returns the old "last_post_seen_cursor" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLastPostSeenCursor(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastPostSeenCursor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastPostSeenCursor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastPostSeenCursor: %w", err)
+	}
+	return oldValue.LastPostSeenCursor, nil
+}
+
+// ClearLastPostSeenCursor clears the value of the "last_post_seen_cursor" field.
+func (m *UserMutation) ClearLastPostSeenCursor() {
+	m.last_post_seen_cursor = nil
+	m.clearedFields[user.FieldLastPostSeenCursor] = struct{}{}
+}
+
+// LastPostSeenCursorCleared returns if the "last_post_seen_cursor" field was cleared in this mutation.
+func (m *UserMutation) LastPostSeenCursorCleared() bool {
+	_, ok := m.clearedFields[user.FieldLastPostSeenCursor]
+	return ok
+}
+
+// ResetLastPostSeenCursor resets all changes to the "last_post_seen_cursor" field.
+func (m *UserMutation) ResetLastPostSeenCursor() {
+	m.last_post_seen_cursor = nil
+	delete(m.clearedFields, user.FieldLastPostSeenCursor)
+}
+
+// AddSavedPostIDs adds the "saved_posts" edge to the Post entity by ids.
+func (m *UserMutation) AddSavedPostIDs(ids ...uuid.UUID) {
+	if m.saved_posts == nil {
+		m.saved_posts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.saved_posts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSavedPosts clears the "saved_posts" edge to the Post entity.
+func (m *UserMutation) ClearSavedPosts() {
+	m.clearedsaved_posts = true
+}
+
+// SavedPostsCleared reports if the "saved_posts" edge to the Post entity was cleared.
+func (m *UserMutation) SavedPostsCleared() bool {
+	return m.clearedsaved_posts
+}
+
+// RemoveSavedPostIDs removes the "saved_posts" edge to the Post entity by IDs.
+func (m *UserMutation) RemoveSavedPostIDs(ids ...uuid.UUID) {
+	if m.removedsaved_posts == nil {
+		m.removedsaved_posts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.saved_posts, ids[i])
+		m.removedsaved_posts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSavedPosts returns the removed IDs of the "saved_posts" edge to the Post entity.
+func (m *UserMutation) RemovedSavedPostsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsaved_posts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SavedPostsIDs returns the "saved_posts" edge IDs in the mutation.
+func (m *UserMutation) SavedPostsIDs() (ids []uuid.UUID) {
+	for id := range m.saved_posts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSavedPosts resets all changes to the "saved_posts" edge.
+func (m *UserMutation) ResetSavedPosts() {
+	m.saved_posts = nil
+	m.clearedsaved_posts = false
+	m.removedsaved_posts = nil
+}
+
+// AddLikedPostIDs adds the "liked_posts" edge to the Post entity by ids.
+func (m *UserMutation) AddLikedPostIDs(ids ...uuid.UUID) {
+	if m.liked_posts == nil {
+		m.liked_posts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.liked_posts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLikedPosts clears the "liked_posts" edge to the Post entity.
+func (m *UserMutation) ClearLikedPosts() {
+	m.clearedliked_posts = true
+}
+
+// LikedPostsCleared reports if the "liked_posts" edge to the Post entity was cleared.
+func (m *UserMutation) LikedPostsCleared() bool {
+	return m.clearedliked_posts
+}
+
+// RemoveLikedPostIDs removes the "liked_posts" edge to the Post entity by IDs.
+func (m *UserMutation) RemoveLikedPostIDs(ids ...uuid.UUID) {
+	if m.removedliked_posts == nil {
+		m.removedliked_posts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.liked_posts, ids[i])
+		m.removedliked_posts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLikedPosts returns the removed IDs of the "liked_posts" edge to the Post entity.
+func (m *UserMutation) RemovedLikedPostsIDs() (ids []uuid.UUID) {
+	for id := range m.removedliked_posts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LikedPostsIDs returns the "liked_posts" edge IDs in the mutation.
+func (m *UserMutation) LikedPostsIDs() (ids []uuid.UUID) {
+	for id := range m.liked_posts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLikedPosts resets all changes to the "liked_posts" edge.
+func (m *UserMutation) ResetLikedPosts() {
+	m.liked_posts = nil
+	m.clearedliked_posts = false
+	m.removedliked_posts = nil
+}
+
+// AddPublishedPostIDs adds the "published_posts" edge to the Post entity by ids.
+func (m *UserMutation) AddPublishedPostIDs(ids ...uuid.UUID) {
+	if m.published_posts == nil {
+		m.published_posts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.published_posts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPublishedPosts clears the "published_posts" edge to the Post entity.
+func (m *UserMutation) ClearPublishedPosts() {
+	m.clearedpublished_posts = true
+}
+
+// PublishedPostsCleared reports if the "published_posts" edge to the Post entity was cleared.
+func (m *UserMutation) PublishedPostsCleared() bool {
+	return m.clearedpublished_posts
+}
+
+// RemovePublishedPostIDs removes the "published_posts" edge to the Post entity by IDs.
+func (m *UserMutation) RemovePublishedPostIDs(ids ...uuid.UUID) {
+	if m.removedpublished_posts == nil {
+		m.removedpublished_posts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.published_posts, ids[i])
+		m.removedpublished_posts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPublishedPosts returns the removed IDs of the "published_posts" edge to the Post entity.
+func (m *UserMutation) RemovedPublishedPostsIDs() (ids []uuid.UUID) {
+	for id := range m.removedpublished_posts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PublishedPostsIDs returns the "published_posts" edge IDs in the mutation.
+func (m *UserMutation) PublishedPostsIDs() (ids []uuid.UUID) {
+	for id := range m.published_posts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPublishedPosts resets all changes to the "published_posts" edge.
+func (m *UserMutation) ResetPublishedPosts() {
+	m.published_posts = nil
+	m.clearedpublished_posts = false
+	m.removedpublished_posts = nil
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
+func (m *UserMutation) AddCommentIDs(ids ...uuid.UUID) {
+	if m.comments == nil {
+		m.comments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.comments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearComments clears the "comments" edge to the Comment entity.
+func (m *UserMutation) ClearComments() {
+	m.clearedcomments = true
+}
+
+// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
+func (m *UserMutation) CommentsCleared() bool {
+	return m.clearedcomments
+}
+
+// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
+func (m *UserMutation) RemoveCommentIDs(ids ...uuid.UUID) {
+	if m.removedcomments == nil {
+		m.removedcomments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.comments, ids[i])
+		m.removedcomments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
+func (m *UserMutation) RemovedCommentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcomments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CommentsIDs returns the "comments" edge IDs in the mutation.
+func (m *UserMutation) CommentsIDs() (ids []uuid.UUID) {
+	for id := range m.comments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetComments resets all changes to the "comments" edge.
+func (m *UserMutation) ResetComments() {
+	m.comments = nil
+	m.clearedcomments = false
+	m.removedcomments = nil
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the ApiKey entity by ids.
+func (m *UserMutation) AddAPIKeyIDs(ids ...uuid.UUID) {
+	if m.api_keys == nil {
+		m.api_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.api_keys[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAPIKeys clears the "api_keys" edge to the ApiKey entity.
+func (m *UserMutation) ClearAPIKeys() {
+	m.clearedapi_keys = true
+}
+
+// APIKeysCleared reports if the "api_keys" edge to the ApiKey entity was cleared.
+func (m *UserMutation) APIKeysCleared() bool {
+	return m.clearedapi_keys
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to the ApiKey entity by IDs.
+func (m *UserMutation) RemoveAPIKeyIDs(ids ...uuid.UUID) {
+	if m.removedapi_keys == nil {
+		m.removedapi_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.api_keys, ids[i])
+		m.removedapi_keys[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAPIKeys returns the removed IDs of the "api_keys" edge to the ApiKey entity.
+func (m *UserMutation) RemovedAPIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.removedapi_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// APIKeysIDs returns the "api_keys" edge IDs in the mutation.
+func (m *UserMutation) APIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.api_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAPIKeys resets all changes to the "api_keys" edge.
+func (m *UserMutation) ResetAPIKeys() {
+	m.api_keys = nil
+	m.clearedapi_keys = false
+	m.removedapi_keys = nil
+}
+
+// AddAwardIDs adds the "awards" edge to the UserAward entity by ids.
+func (m *UserMutation) AddAwardIDs(ids ...uuid.UUID) {
+	if m.awards == nil {
+		m.awards = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.awards[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAwards clears the "awards" edge to the UserAward entity.
+func (m *UserMutation) ClearAwards() {
+	m.clearedawards = true
+}
+
+// AwardsCleared reports if the "awards" edge to the UserAward entity was cleared.
+func (m *UserMutation) AwardsCleared() bool {
+	return m.clearedawards
+}
+
+// RemoveAwardIDs removes the "awards" edge to the UserAward entity by IDs.
+func (m *UserMutation) RemoveAwardIDs(ids ...uuid.UUID) {
+	if m.removedawards == nil {
+		m.removedawards = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.awards, ids[i])
+		m.removedawards[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAwards returns the removed IDs of the "awards" edge to the UserAward entity.
+func (m *UserMutation) RemovedAwardsIDs() (ids []uuid.UUID) {
+	for id := range m.removedawards {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AwardsIDs returns the "awards" edge IDs in the mutation.
+func (m *UserMutation) AwardsIDs() (ids []uuid.UUID) {
+	for id := range m.awards {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAwards resets all changes to the "awards" edge.
+func (m *UserMutation) ResetAwards() {
+	m.awards = nil
+	m.clearedawards = false
+	m.removedawards = nil
+}
+
+// AddBlockedUserIDs adds the "blocked_users" edge to the User entity by ids.
+func (m *UserMutation) AddBlockedUserIDs(ids ...uuid.UUID) {
+	if m.blocked_users == nil {
+		m.blocked_users = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.blocked_users[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBlockedUsers clears the "blocked_users" edge to the User entity.
+func (m *UserMutation) ClearBlockedUsers() {
+	m.clearedblocked_users = true
+}
+
+// BlockedUsersCleared reports if the "blocked_users" edge to the User entity was cleared.
+func (m *UserMutation) BlockedUsersCleared() bool {
+	return m.clearedblocked_users
+}
+
+// RemoveBlockedUserIDs removes the "blocked_users" edge to the User entity by IDs.
+func (m *UserMutation) RemoveBlockedUserIDs(ids ...uuid.UUID) {
+	if m.removedblocked_users == nil {
+		m.removedblocked_users = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.blocked_users, ids[i])
+		m.removedblocked_users[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBlockedUsers returns the removed IDs of the "blocked_users" edge to the User entity.
+func (m *UserMutation) RemovedBlockedUsersIDs() (ids []uuid.UUID) {
+	for id := range m.removedblocked_users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BlockedUsersIDs returns the "blocked_users" edge IDs in the mutation.
+func (m *UserMutation) BlockedUsersIDs() (ids []uuid.UUID) {
+	for id := range m.blocked_users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBlockedUsers resets all changes to the "blocked_users" edge.
+func (m *UserMutation) ResetBlockedUsers() {
+	m.blocked_users = nil
+	m.clearedblocked_users = false
+	m.removedblocked_users = nil
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the User entity by ids.
+func (m *UserMutation) AddBlockedByIDs(ids ...uuid.UUID) {
+	if m.blocked_by == nil {
+		m.blocked_by = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.blocked_by[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBlockedBy clears the "blocked_by" edge to the User entity.
+func (m *UserMutation) ClearBlockedBy() {
+	m.clearedblocked_by = true
+}
+
+// BlockedByCleared reports if the "blocked_by" edge to the User entity was cleared.
+func (m *UserMutation) BlockedByCleared() bool {
+	return m.clearedblocked_by
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to the User entity by IDs.
+func (m *UserMutation) RemoveBlockedByIDs(ids ...uuid.UUID) {
+	if m.removedblocked_by == nil {
+		m.removedblocked_by = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.blocked_by, ids[i])
+		m.removedblocked_by[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBlockedBy returns the removed IDs of the "blocked_by" edge to the User entity.
+func (m *UserMutation) RemovedBlockedByIDs() (ids []uuid.UUID) {
+	for id := range m.removedblocked_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BlockedByIDs returns the "blocked_by" edge IDs in the mutation.
+func (m *UserMutation) BlockedByIDs() (ids []uuid.UUID) {
+	for id := range m.blocked_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBlockedBy resets all changes to the "blocked_by" edge.
+func (m *UserMutation) ResetBlockedBy() {
+	m.blocked_by = nil
+	m.clearedblocked_by = false
+	m.removedblocked_by = nil
+}
+
+// AddMutedUserIDs adds the "muted_users" edge to the User entity by ids.
+func (m *UserMutation) AddMutedUserIDs(ids ...uuid.UUID) {
+	if m.muted_users == nil {
+		m.muted_users = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.muted_users[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMutedUsers clears the "muted_users" edge to the User entity.
+func (m *UserMutation) ClearMutedUsers() {
+	m.clearedmuted_users = true
+}
+
+// MutedUsersCleared reports if the "muted_users" edge to the User entity was cleared.
+func (m *UserMutation) MutedUsersCleared() bool {
+	return m.clearedmuted_users
+}
+
+// RemoveMutedUserIDs removes the "muted_users" edge to the User entity by IDs.
+func (m *UserMutation) RemoveMutedUserIDs(ids ...uuid.UUID) {
+	if m.removedmuted_users == nil {
+		m.removedmuted_users = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.muted_users, ids[i])
+		m.removedmuted_users[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMutedUsers returns the removed IDs of the "muted_users" edge to the User entity.
+func (m *UserMutation) RemovedMutedUsersIDs() (ids []uuid.UUID) {
+	for id := range m.removedmuted_users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MutedUsersIDs returns the "muted_users" edge IDs in the mutation.
+func (m *UserMutation) MutedUsersIDs() (ids []uuid.UUID) {
+	for id := range m.muted_users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMutedUsers resets all changes to the "muted_users" edge.
+func (m *UserMutation) ResetMutedUsers() {
+	m.muted_users = nil
+	m.clearedmuted_users = false
+	m.removedmuted_users = nil
+}
+
+// AddMutedByIDs adds the "muted_by" edge to the User entity by ids.
+func (m *UserMutation) AddMutedByIDs(ids ...uuid.UUID) {
+	if m.muted_by == nil {
+		m.muted_by = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.muted_by[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMutedBy clears the "muted_by" edge to the User entity.
+func (m *UserMutation) ClearMutedBy() {
+	m.clearedmuted_by = true
+}
+
+// MutedByCleared reports if the "muted_by" edge to the User entity was cleared.
+func (m *UserMutation) MutedByCleared() bool {
+	return m.clearedmuted_by
+}
+
+// RemoveMutedByIDs removes the "muted_by" edge to the User entity by IDs.
+func (m *UserMutation) RemoveMutedByIDs(ids ...uuid.UUID) {
+	if m.removedmuted_by == nil {
+		m.removedmuted_by = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.muted_by, ids[i])
+		m.removedmuted_by[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMutedBy returns the removed IDs of the "muted_by" edge to the User entity.
+func (m *UserMutation) RemovedMutedByIDs() (ids []uuid.UUID) {
+	for id := range m.removedmuted_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MutedByIDs returns the "muted_by" edge IDs in the mutation.
+func (m *UserMutation) MutedByIDs() (ids []uuid.UUID) {
+	for id := range m.muted_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMutedBy resets all changes to the "muted_by" edge.
+func (m *UserMutation) ResetMutedBy() {
+	m.muted_by = nil
+	m.clearedmuted_by = false
+	m.removedmuted_by = nil
+}
+
+// AddIdentityIDs adds the "identities" edge to the Identity entity by ids.
+func (m *UserMutation) AddIdentityIDs(ids ...uuid.UUID) {
+	if m.identities == nil {
+		m.identities = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.identities[ids[i]] = struct{}{}
+	}
+}
+
+// ClearIdentities clears the "identities" edge to the Identity entity.
+func (m *UserMutation) ClearIdentities() {
+	m.clearedidentities = true
+}
+
+// IdentitiesCleared reports if the "identities" edge to the Identity entity was cleared.
+func (m *UserMutation) IdentitiesCleared() bool {
+	return m.clearedidentities
+}
+
+// RemoveIdentityIDs removes the "identities" edge to the Identity entity by IDs.
+func (m *UserMutation) RemoveIdentityIDs(ids ...uuid.UUID) {
+	if m.removedidentities == nil {
+		m.removedidentities = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.identities, ids[i])
+		m.removedidentities[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedIdentities returns the removed IDs of the "identities" edge to the Identity entity.
+func (m *UserMutation) RemovedIdentitiesIDs() (ids []uuid.UUID) {
+	for id := range m.removedidentities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// IdentitiesIDs returns the "identities" edge IDs in the mutation.
+func (m *UserMutation) IdentitiesIDs() (ids []uuid.UUID) {
+	for id := range m.identities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetIdentities resets all changes to the "identities" edge.
+func (m *UserMutation) ResetIdentities() {
+	m.identities = nil
+	m.clearedidentities = false
+	m.removedidentities = nil
+}
+
+// AddRefreshTokenIDs adds the "refresh_tokens" edge to the RefreshToken entity by ids.
+func (m *UserMutation) AddRefreshTokenIDs(ids ...uuid.UUID) {
+	if m.refresh_tokens == nil {
+		m.refresh_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.refresh_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRefreshTokens clears the "refresh_tokens" edge to the RefreshToken entity.
+func (m *UserMutation) ClearRefreshTokens() {
+	m.clearedrefresh_tokens = true
+}
+
+// RefreshTokensCleared reports if the "refresh_tokens" edge to the RefreshToken entity was cleared.
+func (m *UserMutation) RefreshTokensCleared() bool {
+	return m.clearedrefresh_tokens
+}
+
+// RemoveRefreshTokenIDs removes the "refresh_tokens" edge to the RefreshToken entity by IDs.
+func (m *UserMutation) RemoveRefreshTokenIDs(ids ...uuid.UUID) {
+	if m.removedrefresh_tokens == nil {
+		m.removedrefresh_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.refresh_tokens, ids[i])
+		m.removedrefresh_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRefreshTokens returns the removed IDs of the "refresh_tokens" edge to the RefreshToken entity.
+func (m *UserMutation) RemovedRefreshTokensIDs() (ids []uuid.UUID) {
+	for id := range m.removedrefresh_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RefreshTokensIDs returns the "refresh_tokens" edge IDs in the mutation.
+func (m *UserMutation) RefreshTokensIDs() (ids []uuid.UUID) {
+	for id := range m.refresh_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRefreshTokens resets all changes to the "refresh_tokens" edge.
+func (m *UserMutation) ResetRefreshTokens() {
+	m.refresh_tokens = nil
+	m.clearedrefresh_tokens = false
+	m.removedrefresh_tokens = nil
+}
+
+// AddRoleChangeIDs adds the "role_changes" edge to the RoleChange entity by ids.
+func (m *UserMutation) AddRoleChangeIDs(ids ...uuid.UUID) {
+	if m.role_changes == nil {
+		m.role_changes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.role_changes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRoleChanges clears the "role_changes" edge to the RoleChange entity.
+func (m *UserMutation) ClearRoleChanges() {
+	m.clearedrole_changes = true
+}
+
+// RoleChangesCleared reports if the "role_changes" edge to the RoleChange entity was cleared.
+func (m *UserMutation) RoleChangesCleared() bool {
+	return m.clearedrole_changes
+}
+
+// RemoveRoleChangeIDs removes the "role_changes" edge to the RoleChange entity by IDs.
+func (m *UserMutation) RemoveRoleChangeIDs(ids ...uuid.UUID) {
+	if m.removedrole_changes == nil {
+		m.removedrole_changes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.role_changes, ids[i])
+		m.removedrole_changes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRoleChanges returns the removed IDs of the "role_changes" edge to the RoleChange entity.
+func (m *UserMutation) RemovedRoleChangesIDs() (ids []uuid.UUID) {
+	for id := range m.removedrole_changes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RoleChangesIDs returns the "role_changes" edge IDs in the mutation.
+func (m *UserMutation) RoleChangesIDs() (ids []uuid.UUID) {
+	for id := range m.role_changes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRoleChanges resets all changes to the "role_changes" edge.
+func (m *UserMutation) ResetRoleChanges() {
+	m.role_changes = nil
+	m.clearedrole_changes = false
+	m.removedrole_changes = nil
+}
+
+// AddSuspensionIDs adds the "suspensions" edge to the Suspension entity by ids.
+func (m *UserMutation) AddSuspensionIDs(ids ...uuid.UUID) {
+	if m.suspensions == nil {
+		m.suspensions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.suspensions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSuspensions clears the "suspensions" edge to the Suspension entity.
+func (m *UserMutation) ClearSuspensions() {
+	m.clearedsuspensions = true
+}
+
+// SuspensionsCleared reports if the "suspensions" edge to the Suspension entity was cleared.
+func (m *UserMutation) SuspensionsCleared() bool {
+	return m.clearedsuspensions
+}
+
+// RemoveSuspensionIDs removes the "suspensions" edge to the Suspension entity by IDs.
+func (m *UserMutation) RemoveSuspensionIDs(ids ...uuid.UUID) {
+	if m.removedsuspensions == nil {
+		m.removedsuspensions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.suspensions, ids[i])
+		m.removedsuspensions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSuspensions returns the removed IDs of the "suspensions" edge to the Suspension entity.
+func (m *UserMutation) RemovedSuspensionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsuspensions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SuspensionsIDs returns the "suspensions" edge IDs in the mutation.
+func (m *UserMutation) SuspensionsIDs() (ids []uuid.UUID) {
+	for id := range m.suspensions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSuspensions resets all changes to the "suspensions" edge.
+func (m *UserMutation) ResetSuspensions() {
+	m.suspensions = nil
+	m.clearedsuspensions = false
+	m.removedsuspensions = nil
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.updated_at != nil {
+		fields = append(fields, user.FieldUpdatedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, user.FieldDeletedAt)
+	}
+	if m.deleted_by != nil {
+		fields = append(fields, user.FieldDeletedBy)
+	}
+	if m.display_name != nil {
+		fields = append(fields, user.FieldDisplayName)
+	}
+	if m.alias != nil {
+		fields = append(fields, user.FieldAlias)
+	}
+	if m.profile_image != nil {
+		fields = append(fields, user.FieldProfileImage)
+	}
+	if m.external_id != nil {
+		fields = append(fields, user.FieldExternalID)
+	}
+	if m.auth_provider != nil {
+		fields = append(fields, user.FieldAuthProvider)
+	}
+	if m.role != nil {
+		fields = append(fields, user.FieldRole)
+	}
+	if m.role_source != nil {
+		fields = append(fields, user.FieldRoleSource)
+	}
+	if m.twitch_vip != nil {
+		fields = append(fields, user.FieldTwitchVip)
+	}
+	if m.last_seen_at != nil {
+		fields = append(fields, user.FieldLastSeenAt)
+	}
+	if m.last_post_seen_cursor != nil {
+		fields = append(fields, user.FieldLastPostSeenCursor)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldDeletedAt:
+		return m.DeletedAt()
+	case user.FieldDeletedBy:
+		return m.DeletedBy()
+	case user.FieldDisplayName:
+		return m.DisplayName()
+	case user.FieldAlias:
+		return m.Alias()
+	case user.FieldProfileImage:
+		return m.ProfileImage()
+	case user.FieldExternalID:
+		return m.ExternalID()
+	case user.FieldAuthProvider:
+		return m.AuthProvider()
+	case user.FieldRole:
+		return m.Role()
+	case user.FieldRoleSource:
+		return m.RoleSource()
+	case user.FieldTwitchVip:
+		return m.TwitchVip()
+	case user.FieldLastSeenAt:
+		return m.LastSeenAt()
+	case user.FieldLastPostSeenCursor:
+		return m.LastPostSeenCursor()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case user.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
+	case user.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case user.FieldAlias:
+		return m.OldAlias(ctx)
+	case user.FieldProfileImage:
+		return m.OldProfileImage(ctx)
+	case user.FieldExternalID:
+		return m.OldExternalID(ctx)
+	case user.FieldAuthProvider:
+		return m.OldAuthProvider(ctx)
+	case user.FieldRole:
+		return m.OldRole(ctx)
+	case user.FieldRoleSource:
+		return m.OldRoleSource(ctx)
+	case user.FieldTwitchVip:
+		return m.OldTwitchVip(ctx)
+	case user.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
+	case user.FieldLastPostSeenCursor:
+		return m.OldLastPostSeenCursor(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case user.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case user.FieldDeletedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedBy(v)
+		return nil
+	case user.FieldDisplayName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayName(v)
+		return nil
+	case user.FieldAlias:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlias(v)
+		return nil
+	case user.FieldProfileImage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfileImage(v)
+		return nil
+	case user.FieldExternalID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalID(v)
+		return nil
+	case user.FieldAuthProvider:
+		v, ok := value.(user.AuthProvider)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthProvider(v)
+		return nil
+	case user.FieldRole:
+		v, ok := value.(user.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case user.FieldRoleSource:
+		v, ok := value.(user.RoleSource)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleSource(v)
+		return nil
+	case user.FieldTwitchVip:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTwitchVip(v)
+		return nil
+	case user.FieldLastSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenAt(v)
+		return nil
+	case user.FieldLastPostSeenCursor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastPostSeenCursor(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
 }
 
-// LastPostSeenCursor returns the value of the "last_post_seen_cursor" field in the mutation.
-func (m *UserMutation) LastPostSeenCursor() (r string, exists bool) {
-	v := m.last_post_seen_cursor
-	if v == nil {
-		return
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldDeletedAt) {
+		fields = append(fields, user.FieldDeletedAt)
+	}
+	if m.FieldCleared(user.FieldDeletedBy) {
+		fields = append(fields, user.FieldDeletedBy)
+	}
+	if m.FieldCleared(user.FieldAlias) {
+		fields = append(fields, user.FieldAlias)
+	}
+	if m.FieldCleared(user.FieldProfileImage) {
+		fields = append(fields, user.FieldProfileImage)
+	}
+	if m.FieldCleared(user.FieldRoleSource) {
+		fields = append(fields, user.FieldRoleSource)
+	}
+	if m.FieldCleared(user.FieldLastSeenAt) {
+		fields = append(fields, user.FieldLastSeenAt)
+	}
+	if m.FieldCleared(user.FieldLastPostSeenCursor) {
+		fields = append(fields, user.FieldLastPostSeenCursor)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case user.FieldDeletedBy:
+		m.ClearDeletedBy()
+		return nil
+	case user.FieldAlias:
+		m.ClearAlias()
+		return nil
+	case user.FieldProfileImage:
+		m.ClearProfileImage()
+		return nil
+	case user.FieldRoleSource:
+		m.ClearRoleSource()
+		return nil
+	case user.FieldLastSeenAt:
+		m.ClearLastSeenAt()
+		return nil
+	case user.FieldLastPostSeenCursor:
+		m.ClearLastPostSeenCursor()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case user.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case user.FieldDeletedBy:
+		m.ResetDeletedBy()
+		return nil
+	case user.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
+	case user.FieldAlias:
+		m.ResetAlias()
+		return nil
+	case user.FieldProfileImage:
+		m.ResetProfileImage()
+		return nil
+	case user.FieldExternalID:
+		m.ResetExternalID()
+		return nil
+	case user.FieldAuthProvider:
+		m.ResetAuthProvider()
+		return nil
+	case user.FieldRole:
+		m.ResetRole()
+		return nil
+	case user.FieldRoleSource:
+		m.ResetRoleSource()
+		return nil
+	case user.FieldTwitchVip:
+		m.ResetTwitchVip()
+		return nil
+	case user.FieldLastSeenAt:
+		m.ResetLastSeenAt()
+		return nil
+	case user.FieldLastPostSeenCursor:
+		m.ResetLastPostSeenCursor()
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 14)
+	if m.saved_posts != nil {
+		edges = append(edges, user.EdgeSavedPosts)
+	}
+	if m.liked_posts != nil {
+		edges = append(edges, user.EdgeLikedPosts)
+	}
+	if m.published_posts != nil {
+		edges = append(edges, user.EdgePublishedPosts)
+	}
+	if m.comments != nil {
+		edges = append(edges, user.EdgeComments)
+	}
+	if m.api_keys != nil {
+		edges = append(edges, user.EdgeAPIKeys)
+	}
+	if m.awards != nil {
+		edges = append(edges, user.EdgeAwards)
+	}
+	if m.blocked_users != nil {
+		edges = append(edges, user.EdgeBlockedUsers)
+	}
+	if m.blocked_by != nil {
+		edges = append(edges, user.EdgeBlockedBy)
+	}
+	if m.muted_users != nil {
+		edges = append(edges, user.EdgeMutedUsers)
+	}
+	if m.muted_by != nil {
+		edges = append(edges, user.EdgeMutedBy)
+	}
+	if m.identities != nil {
+		edges = append(edges, user.EdgeIdentities)
+	}
+	if m.refresh_tokens != nil {
+		edges = append(edges, user.EdgeRefreshTokens)
+	}
+	if m.role_changes != nil {
+		edges = append(edges, user.EdgeRoleChanges)
+	}
+	if m.suspensions != nil {
+		edges = append(edges, user.EdgeSuspensions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeSavedPosts:
+		ids := make([]ent.Value, 0, len(m.saved_posts))
+		for id := range m.saved_posts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeLikedPosts:
+		ids := make([]ent.Value, 0, len(m.liked_posts))
+		for id := range m.liked_posts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePublishedPosts:
+		ids := make([]ent.Value, 0, len(m.published_posts))
+		for id := range m.published_posts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.comments))
+		for id := range m.comments {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAPIKeys:
+		ids := make([]ent.Value, 0, len(m.api_keys))
+		for id := range m.api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAwards:
+		ids := make([]ent.Value, 0, len(m.awards))
+		for id := range m.awards {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBlockedUsers:
+		ids := make([]ent.Value, 0, len(m.blocked_users))
+		for id := range m.blocked_users {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBlockedBy:
+		ids := make([]ent.Value, 0, len(m.blocked_by))
+		for id := range m.blocked_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMutedUsers:
+		ids := make([]ent.Value, 0, len(m.muted_users))
+		for id := range m.muted_users {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMutedBy:
+		ids := make([]ent.Value, 0, len(m.muted_by))
+		for id := range m.muted_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeIdentities:
+		ids := make([]ent.Value, 0, len(m.identities))
+		for id := range m.identities {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRefreshTokens:
+		ids := make([]ent.Value, 0, len(m.refresh_tokens))
+		for id := range m.refresh_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRoleChanges:
+		ids := make([]ent.Value, 0, len(m.role_changes))
+		for id := range m.role_changes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSuspensions:
+		ids := make([]ent.Value, 0, len(m.suspensions))
+		for id := range m.suspensions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 14)
+	if m.removedsaved_posts != nil {
+		edges = append(edges, user.EdgeSavedPosts)
+	}
+	if m.removedliked_posts != nil {
+		edges = append(edges, user.EdgeLikedPosts)
+	}
+	if m.removedpublished_posts != nil {
+		edges = append(edges, user.EdgePublishedPosts)
+	}
+	if m.removedcomments != nil {
+		edges = append(edges, user.EdgeComments)
+	}
+	if m.removedapi_keys != nil {
+		edges = append(edges, user.EdgeAPIKeys)
+	}
+	if m.removedawards != nil {
+		edges = append(edges, user.EdgeAwards)
+	}
+	if m.removedblocked_users != nil {
+		edges = append(edges, user.EdgeBlockedUsers)
 	}
-	return *v, true
-}
-
-// OldLastPostSeenCursor returns the old "last_post_seen_cursor" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldLastPostSeenCursor(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastPostSeenCursor is only allowed on UpdateOne operations")
+	if m.removedblocked_by != nil {
+		edges = append(edges, user.EdgeBlockedBy)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastPostSeenCursor requires an ID field in the mutation")
+	if m.removedmuted_users != nil {
+		edges = append(edges, user.EdgeMutedUsers)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastPostSeenCursor: %w", err)
+	if m.removedmuted_by != nil {
+		edges = append(edges, user.EdgeMutedBy)
 	}
-	return oldValue.LastPostSeenCursor, nil
-}
-
-// ClearLastPostSeenCursor clears the value of the "last_post_seen_cursor" field.
-func (m *UserMutation) ClearLastPostSeenCursor() {
-	m.last_post_seen_cursor = nil
-	m.clearedFields[user.FieldLastPostSeenCursor] = struct{}{}
-}
-
-// LastPostSeenCursorCleared returns if the "last_post_seen_cursor" field was cleared in this mutation.
-func (m *UserMutation) LastPostSeenCursorCleared() bool {
-	_, ok := m.clearedFields[user.FieldLastPostSeenCursor]
-	return ok
-}
-
-// ResetLastPostSeenCursor resets all changes to the "last_post_seen_cursor" field.
-func (m *UserMutation) ResetLastPostSeenCursor() {
-	m.last_post_seen_cursor = nil
-	delete(m.clearedFields, user.FieldLastPostSeenCursor)
-}
-
-// SetAwards sets the "awards" field.
-func (m *UserMutation) SetAwards(s []string) {
-	m.awards = &s
-	m.appendawards = nil
-}
-
-// Awards returns the value of the "awards" field in the mutation.
-func (m *UserMutation) Awards() (r []string, exists bool) {
-	v := m.awards
-	if v == nil {
-		return
+	if m.removedidentities != nil {
+		edges = append(edges, user.EdgeIdentities)
 	}
-	return *v, true
-}
-
-// OldAwards returns the old "awards" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAwards(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAwards is only allowed on UpdateOne operations")
+	if m.removedrefresh_tokens != nil {
+		edges = append(edges, user.EdgeRefreshTokens)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAwards requires an ID field in the mutation")
+	if m.removedrole_changes != nil {
+		edges = append(edges, user.EdgeRoleChanges)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAwards: %w", err)
+	if m.removedsuspensions != nil {
+		edges = append(edges, user.EdgeSuspensions)
 	}
-	return oldValue.Awards, nil
-}
-
-// AppendAwards adds s to the "awards" field.
-func (m *UserMutation) AppendAwards(s []string) {
-	m.appendawards = append(m.appendawards, s...)
+	return edges
 }
 
-// AppendedAwards returns the list of values that were appended to the "awards" field in this mutation.
-func (m *UserMutation) AppendedAwards() ([]string, bool) {
-	if len(m.appendawards) == 0 {
-		return nil, false
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeSavedPosts:
+		ids := make([]ent.Value, 0, len(m.removedsaved_posts))
+		for id := range m.removedsaved_posts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeLikedPosts:
+		ids := make([]ent.Value, 0, len(m.removedliked_posts))
+		for id := range m.removedliked_posts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePublishedPosts:
+		ids := make([]ent.Value, 0, len(m.removedpublished_posts))
+		for id := range m.removedpublished_posts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.removedcomments))
+		for id := range m.removedcomments {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAPIKeys:
+		ids := make([]ent.Value, 0, len(m.removedapi_keys))
+		for id := range m.removedapi_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAwards:
+		ids := make([]ent.Value, 0, len(m.removedawards))
+		for id := range m.removedawards {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBlockedUsers:
+		ids := make([]ent.Value, 0, len(m.removedblocked_users))
+		for id := range m.removedblocked_users {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBlockedBy:
+		ids := make([]ent.Value, 0, len(m.removedblocked_by))
+		for id := range m.removedblocked_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMutedUsers:
+		ids := make([]ent.Value, 0, len(m.removedmuted_users))
+		for id := range m.removedmuted_users {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMutedBy:
+		ids := make([]ent.Value, 0, len(m.removedmuted_by))
+		for id := range m.removedmuted_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeIdentities:
+		ids := make([]ent.Value, 0, len(m.removedidentities))
+		for id := range m.removedidentities {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRefreshTokens:
+		ids := make([]ent.Value, 0, len(m.removedrefresh_tokens))
+		for id := range m.removedrefresh_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRoleChanges:
+		ids := make([]ent.Value, 0, len(m.removedrole_changes))
+		for id := range m.removedrole_changes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSuspensions:
+		ids := make([]ent.Value, 0, len(m.removedsuspensions))
+		for id := range m.removedsuspensions {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return m.appendawards, true
-}
-
-// ClearAwards clears the value of the "awards" field.
-func (m *UserMutation) ClearAwards() {
-	m.awards = nil
-	m.appendawards = nil
-	m.clearedFields[user.FieldAwards] = struct{}{}
-}
-
-// AwardsCleared returns if the "awards" field was cleared in this mutation.
-func (m *UserMutation) AwardsCleared() bool {
-	_, ok := m.clearedFields[user.FieldAwards]
-	return ok
-}
-
-// ResetAwards resets all changes to the "awards" field.
-func (m *UserMutation) ResetAwards() {
-	m.awards = nil
-	m.appendawards = nil
-	delete(m.clearedFields, user.FieldAwards)
+	return nil
 }
 
-// AddSavedPostIDs adds the "saved_posts" edge to the Post entity by ids.
-func (m *UserMutation) AddSavedPostIDs(ids ...uuid.UUID) {
-	if m.saved_posts == nil {
-		m.saved_posts = make(map[uuid.UUID]struct{})
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 14)
+	if m.clearedsaved_posts {
+		edges = append(edges, user.EdgeSavedPosts)
 	}
-	for i := range ids {
-		m.saved_posts[ids[i]] = struct{}{}
+	if m.clearedliked_posts {
+		edges = append(edges, user.EdgeLikedPosts)
 	}
-}
-
-// ClearSavedPosts clears the "saved_posts" edge to the Post entity.
-func (m *UserMutation) ClearSavedPosts() {
-	m.clearedsaved_posts = true
-}
-
-// SavedPostsCleared reports if the "saved_posts" edge to the Post entity was cleared.
-func (m *UserMutation) SavedPostsCleared() bool {
-	return m.clearedsaved_posts
-}
-
-// RemoveSavedPostIDs removes the "saved_posts" edge to the Post entity by IDs.
-func (m *UserMutation) RemoveSavedPostIDs(ids ...uuid.UUID) {
-	if m.removedsaved_posts == nil {
-		m.removedsaved_posts = make(map[uuid.UUID]struct{})
+	if m.clearedpublished_posts {
+		edges = append(edges, user.EdgePublishedPosts)
 	}
-	for i := range ids {
-		delete(m.saved_posts, ids[i])
-		m.removedsaved_posts[ids[i]] = struct{}{}
+	if m.clearedcomments {
+		edges = append(edges, user.EdgeComments)
 	}
-}
-
-// RemovedSavedPosts returns the removed IDs of the "saved_posts" edge to the Post entity.
-func (m *UserMutation) RemovedSavedPostsIDs() (ids []uuid.UUID) {
-	for id := range m.removedsaved_posts {
-		ids = append(ids, id)
+	if m.clearedapi_keys {
+		edges = append(edges, user.EdgeAPIKeys)
 	}
-	return
+	if m.clearedawards {
+		edges = append(edges, user.EdgeAwards)
+	}
+	if m.clearedblocked_users {
+		edges = append(edges, user.EdgeBlockedUsers)
+	}
+	if m.clearedblocked_by {
+		edges = append(edges, user.EdgeBlockedBy)
+	}
+	if m.clearedmuted_users {
+		edges = append(edges, user.EdgeMutedUsers)
+	}
+	if m.clearedmuted_by {
+		edges = append(edges, user.EdgeMutedBy)
+	}
+	if m.clearedidentities {
+		edges = append(edges, user.EdgeIdentities)
+	}
+	if m.clearedrefresh_tokens {
+		edges = append(edges, user.EdgeRefreshTokens)
+	}
+	if m.clearedrole_changes {
+		edges = append(edges, user.EdgeRoleChanges)
+	}
+	if m.clearedsuspensions {
+		edges = append(edges, user.EdgeSuspensions)
+	}
+	return edges
 }
 
-// SavedPostsIDs returns the "saved_posts" edge IDs in the mutation.
-func (m *UserMutation) SavedPostsIDs() (ids []uuid.UUID) {
-	for id := range m.saved_posts {
-		ids = append(ids, id)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeSavedPosts:
+		return m.clearedsaved_posts
+	case user.EdgeLikedPosts:
+		return m.clearedliked_posts
+	case user.EdgePublishedPosts:
+		return m.clearedpublished_posts
+	case user.EdgeComments:
+		return m.clearedcomments
+	case user.EdgeAPIKeys:
+		return m.clearedapi_keys
+	case user.EdgeAwards:
+		return m.clearedawards
+	case user.EdgeBlockedUsers:
+		return m.clearedblocked_users
+	case user.EdgeBlockedBy:
+		return m.clearedblocked_by
+	case user.EdgeMutedUsers:
+		return m.clearedmuted_users
+	case user.EdgeMutedBy:
+		return m.clearedmuted_by
+	case user.EdgeIdentities:
+		return m.clearedidentities
+	case user.EdgeRefreshTokens:
+		return m.clearedrefresh_tokens
+	case user.EdgeRoleChanges:
+		return m.clearedrole_changes
+	case user.EdgeSuspensions:
+		return m.clearedsuspensions
 	}
-	return
+	return false
 }
 
-// ResetSavedPosts resets all changes to the "saved_posts" edge.
-func (m *UserMutation) ResetSavedPosts() {
-	m.saved_posts = nil
-	m.clearedsaved_posts = false
-	m.removedsaved_posts = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
-// AddLikedPostIDs adds the "liked_posts" edge to the Post entity by ids.
-func (m *UserMutation) AddLikedPostIDs(ids ...uuid.UUID) {
-	if m.liked_posts == nil {
-		m.liked_posts = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.liked_posts[ids[i]] = struct{}{}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeSavedPosts:
+		m.ResetSavedPosts()
+		return nil
+	case user.EdgeLikedPosts:
+		m.ResetLikedPosts()
+		return nil
+	case user.EdgePublishedPosts:
+		m.ResetPublishedPosts()
+		return nil
+	case user.EdgeComments:
+		m.ResetComments()
+		return nil
+	case user.EdgeAPIKeys:
+		m.ResetAPIKeys()
+		return nil
+	case user.EdgeAwards:
+		m.ResetAwards()
+		return nil
+	case user.EdgeBlockedUsers:
+		m.ResetBlockedUsers()
+		return nil
+	case user.EdgeBlockedBy:
+		m.ResetBlockedBy()
+		return nil
+	case user.EdgeMutedUsers:
+		m.ResetMutedUsers()
+		return nil
+	case user.EdgeMutedBy:
+		m.ResetMutedBy()
+		return nil
+	case user.EdgeIdentities:
+		m.ResetIdentities()
+		return nil
+	case user.EdgeRefreshTokens:
+		m.ResetRefreshTokens()
+		return nil
+	case user.EdgeRoleChanges:
+		m.ResetRoleChanges()
+		return nil
+	case user.EdgeSuspensions:
+		m.ResetSuspensions()
+		return nil
 	}
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// ClearLikedPosts clears the "liked_posts" edge to the Post entity.
-func (m *UserMutation) ClearLikedPosts() {
-	m.clearedliked_posts = true
+// UserAwardMutation represents an operation that mutates the UserAward nodes in the graph.
+type UserAwardMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	updated_at    *time.Time
+	created_at    *time.Time
+	granted_at    *time.Time
+	clearedFields map[string]struct{}
+	owner         *uuid.UUID
+	clearedowner  bool
+	award         *uuid.UUID
+	clearedaward  bool
+	done          bool
+	oldValue      func(context.Context) (*UserAward, error)
+	predicates    []predicate.UserAward
 }
 
-// LikedPostsCleared reports if the "liked_posts" edge to the Post entity was cleared.
-func (m *UserMutation) LikedPostsCleared() bool {
-	return m.clearedliked_posts
-}
+var _ ent.Mutation = (*UserAwardMutation)(nil)
 
-// RemoveLikedPostIDs removes the "liked_posts" edge to the Post entity by IDs.
-func (m *UserMutation) RemoveLikedPostIDs(ids ...uuid.UUID) {
-	if m.removedliked_posts == nil {
-		m.removedliked_posts = make(map[uuid.UUID]struct{})
+// userawardOption allows management of the mutation configuration using functional options.
+type userawardOption func(*UserAwardMutation)
+
+// newUserAwardMutation creates new mutation for the UserAward entity.
+func newUserAwardMutation(c config, op Op, opts ...userawardOption) *UserAwardMutation {
+	m := &UserAwardMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUserAward,
+		clearedFields: make(map[string]struct{}),
 	}
-	for i := range ids {
-		delete(m.liked_posts, ids[i])
-		m.removedliked_posts[ids[i]] = struct{}{}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// RemovedLikedPosts returns the removed IDs of the "liked_posts" edge to the Post entity.
-func (m *UserMutation) RemovedLikedPostsIDs() (ids []uuid.UUID) {
-	for id := range m.removedliked_posts {
-		ids = append(ids, id)
+// withUserAwardID sets the ID field of the mutation.
+func withUserAwardID(id uuid.UUID) userawardOption {
+	return func(m *UserAwardMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UserAward
+		)
+		m.oldValue = func(ctx context.Context) (*UserAward, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UserAward.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return
 }
 
-// LikedPostsIDs returns the "liked_posts" edge IDs in the mutation.
-func (m *UserMutation) LikedPostsIDs() (ids []uuid.UUID) {
-	for id := range m.liked_posts {
-		ids = append(ids, id)
+// withUserAward sets the old UserAward of the mutation.
+func withUserAward(node *UserAward) userawardOption {
+	return func(m *UserAwardMutation) {
+		m.oldValue = func(context.Context) (*UserAward, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
 }
 
-// ResetLikedPosts resets all changes to the "liked_posts" edge.
-func (m *UserMutation) ResetLikedPosts() {
-	m.liked_posts = nil
-	m.clearedliked_posts = false
-	m.removedliked_posts = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserAwardMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddPublishedPostIDs adds the "published_posts" edge to the Post entity by ids.
-func (m *UserMutation) AddPublishedPostIDs(ids ...uuid.UUID) {
-	if m.published_posts == nil {
-		m.published_posts = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.published_posts[ids[i]] = struct{}{}
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserAwardMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearPublishedPosts clears the "published_posts" edge to the Post entity.
-func (m *UserMutation) ClearPublishedPosts() {
-	m.clearedpublished_posts = true
-}
-
-// PublishedPostsCleared reports if the "published_posts" edge to the Post entity was cleared.
-func (m *UserMutation) PublishedPostsCleared() bool {
-	return m.clearedpublished_posts
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of UserAward entities.
+func (m *UserAwardMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// RemovePublishedPostIDs removes the "published_posts" edge to the Post entity by IDs.
-func (m *UserMutation) RemovePublishedPostIDs(ids ...uuid.UUID) {
-	if m.removedpublished_posts == nil {
-		m.removedpublished_posts = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.published_posts, ids[i])
-		m.removedpublished_posts[ids[i]] = struct{}{}
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserAwardMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
+	return *m.id, true
 }
 
-// RemovedPublishedPosts returns the removed IDs of the "published_posts" edge to the Post entity.
-func (m *UserMutation) RemovedPublishedPostsIDs() (ids []uuid.UUID) {
-	for id := range m.removedpublished_posts {
-		ids = append(ids, id)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserAwardMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UserAward.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return
 }
 
-// PublishedPostsIDs returns the "published_posts" edge IDs in the mutation.
-func (m *UserMutation) PublishedPostsIDs() (ids []uuid.UUID) {
-	for id := range m.published_posts {
-		ids = append(ids, id)
-	}
-	return
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserAwardMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ResetPublishedPosts resets all changes to the "published_posts" edge.
-func (m *UserMutation) ResetPublishedPosts() {
-	m.published_posts = nil
-	m.clearedpublished_posts = false
-	m.removedpublished_posts = nil
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserAwardMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
-func (m *UserMutation) AddCommentIDs(ids ...uuid.UUID) {
-	if m.comments == nil {
-		m.comments = make(map[uuid.UUID]struct{})
+// OldUpdatedAt returns the old "updated_at" field's value of the UserAward entity.
+// If the UserAward object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserAwardMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.comments[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearComments clears the "comments" edge to the Comment entity.
-func (m *UserMutation) ClearComments() {
-	m.clearedcomments = true
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserAwardMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
-func (m *UserMutation) CommentsCleared() bool {
-	return m.clearedcomments
+// SetCreatedAt sets the "created_at" field.
+func (m *UserAwardMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
-func (m *UserMutation) RemoveCommentIDs(ids ...uuid.UUID) {
-	if m.removedcomments == nil {
-		m.removedcomments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.comments, ids[i])
-		m.removedcomments[ids[i]] = struct{}{}
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserAwardMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
-func (m *UserMutation) RemovedCommentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedcomments {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the UserAward entity.
+// If the UserAward object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserAwardMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// CommentsIDs returns the "comments" edge IDs in the mutation.
-func (m *UserMutation) CommentsIDs() (ids []uuid.UUID) {
-	for id := range m.comments {
-		ids = append(ids, id)
-	}
-	return
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserAwardMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ResetComments resets all changes to the "comments" edge.
-func (m *UserMutation) ResetComments() {
-	m.comments = nil
-	m.clearedcomments = false
-	m.removedcomments = nil
+// SetOwnerID sets the "owner_id" field.
+func (m *UserAwardMutation) SetOwnerID(u uuid.UUID) {
+	m.owner = &u
 }
 
-// AddAPIKeyIDs adds the "api_keys" edge to the ApiKey entity by ids.
-func (m *UserMutation) AddAPIKeyIDs(ids ...uuid.UUID) {
-	if m.api_keys == nil {
-		m.api_keys = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.api_keys[ids[i]] = struct{}{}
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *UserAwardMutation) OwnerID() (r uuid.UUID, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearAPIKeys clears the "api_keys" edge to the ApiKey entity.
-func (m *UserMutation) ClearAPIKeys() {
-	m.clearedapi_keys = true
+// OldOwnerID returns the old "owner_id" field's value of the UserAward entity.
+// If the UserAward object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserAwardMutation) OldOwnerID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
 }
 
-// APIKeysCleared reports if the "api_keys" edge to the ApiKey entity was cleared.
-func (m *UserMutation) APIKeysCleared() bool {
-	return m.clearedapi_keys
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *UserAwardMutation) ResetOwnerID() {
+	m.owner = nil
 }
 
-// RemoveAPIKeyIDs removes the "api_keys" edge to the ApiKey entity by IDs.
-func (m *UserMutation) RemoveAPIKeyIDs(ids ...uuid.UUID) {
-	if m.removedapi_keys == nil {
-		m.removedapi_keys = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.api_keys, ids[i])
-		m.removedapi_keys[ids[i]] = struct{}{}
-	}
+// SetAwardID sets the "award_id" field.
+func (m *UserAwardMutation) SetAwardID(u uuid.UUID) {
+	m.award = &u
 }
 
-// RemovedAPIKeys returns the removed IDs of the "api_keys" edge to the ApiKey entity.
-func (m *UserMutation) RemovedAPIKeysIDs() (ids []uuid.UUID) {
-	for id := range m.removedapi_keys {
-		ids = append(ids, id)
+// AwardID returns the value of the "award_id" field in the mutation.
+func (m *UserAwardMutation) AwardID() (r uuid.UUID, exists bool) {
+	v := m.award
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// APIKeysIDs returns the "api_keys" edge IDs in the mutation.
-func (m *UserMutation) APIKeysIDs() (ids []uuid.UUID) {
-	for id := range m.api_keys {
-		ids = append(ids, id)
+// OldAwardID returns the old "award_id" field's value of the UserAward entity.
+// If the UserAward object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserAwardMutation) OldAwardID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAwardID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAwardID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAwardID: %w", err)
+	}
+	return oldValue.AwardID, nil
 }
 
-// ResetAPIKeys resets all changes to the "api_keys" edge.
-func (m *UserMutation) ResetAPIKeys() {
-	m.api_keys = nil
-	m.clearedapi_keys = false
-	m.removedapi_keys = nil
+// ResetAwardID resets all changes to the "award_id" field.
+func (m *UserAwardMutation) ResetAwardID() {
+	m.award = nil
 }
 
-// AddRefreshTokenIDs adds the "refresh_tokens" edge to the RefreshToken entity by ids.
-func (m *UserMutation) AddRefreshTokenIDs(ids ...uuid.UUID) {
-	if m.refresh_tokens == nil {
-		m.refresh_tokens = make(map[uuid.UUID]struct{})
+// SetGrantedAt sets the "granted_at" field.
+func (m *UserAwardMutation) SetGrantedAt(t time.Time) {
+	m.granted_at = &t
+}
+
+// GrantedAt returns the value of the "granted_at" field in the mutation.
+func (m *UserAwardMutation) GrantedAt() (r time.Time, exists bool) {
+	v := m.granted_at
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.refresh_tokens[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldGrantedAt returns the old "granted_at" field's value of the UserAward entity.
+// If the UserAward object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserAwardMutation) OldGrantedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGrantedAt is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGrantedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGrantedAt: %w", err)
+	}
+	return oldValue.GrantedAt, nil
 }
 
-// ClearRefreshTokens clears the "refresh_tokens" edge to the RefreshToken entity.
-func (m *UserMutation) ClearRefreshTokens() {
-	m.clearedrefresh_tokens = true
+// ResetGrantedAt resets all changes to the "granted_at" field.
+func (m *UserAwardMutation) ResetGrantedAt() {
+	m.granted_at = nil
 }
 
-// RefreshTokensCleared reports if the "refresh_tokens" edge to the RefreshToken entity was cleared.
-func (m *UserMutation) RefreshTokensCleared() bool {
-	return m.clearedrefresh_tokens
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *UserAwardMutation) ClearOwner() {
+	m.clearedowner = true
+	m.clearedFields[useraward.FieldOwnerID] = struct{}{}
 }
 
-// RemoveRefreshTokenIDs removes the "refresh_tokens" edge to the RefreshToken entity by IDs.
-func (m *UserMutation) RemoveRefreshTokenIDs(ids ...uuid.UUID) {
-	if m.removedrefresh_tokens == nil {
-		m.removedrefresh_tokens = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.refresh_tokens, ids[i])
-		m.removedrefresh_tokens[ids[i]] = struct{}{}
-	}
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *UserAwardMutation) OwnerCleared() bool {
+	return m.clearedowner
 }
 
-// RemovedRefreshTokens returns the removed IDs of the "refresh_tokens" edge to the RefreshToken entity.
-func (m *UserMutation) RemovedRefreshTokensIDs() (ids []uuid.UUID) {
-	for id := range m.removedrefresh_tokens {
-		ids = append(ids, id)
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *UserAwardMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// RefreshTokensIDs returns the "refresh_tokens" edge IDs in the mutation.
-func (m *UserMutation) RefreshTokensIDs() (ids []uuid.UUID) {
-	for id := range m.refresh_tokens {
-		ids = append(ids, id)
+// ResetOwner resets all changes to the "owner" edge.
+func (m *UserAwardMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// ClearAward clears the "award" edge to the AwardDefinition entity.
+func (m *UserAwardMutation) ClearAward() {
+	m.clearedaward = true
+	m.clearedFields[useraward.FieldAwardID] = struct{}{}
+}
+
+// AwardCleared reports if the "award" edge to the AwardDefinition entity was cleared.
+func (m *UserAwardMutation) AwardCleared() bool {
+	return m.clearedaward
+}
+
+// AwardIDs returns the "award" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AwardID instead. It exists only for internal usage by the builders.
+func (m *UserAwardMutation) AwardIDs() (ids []uuid.UUID) {
+	if id := m.award; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetRefreshTokens resets all changes to the "refresh_tokens" edge.
-func (m *UserMutation) ResetRefreshTokens() {
-	m.refresh_tokens = nil
-	m.clearedrefresh_tokens = false
-	m.removedrefresh_tokens = nil
+// ResetAward resets all changes to the "award" edge.
+func (m *UserAwardMutation) ResetAward() {
+	m.award = nil
+	m.clearedaward = false
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the UserAwardMutation builder.
+func (m *UserAwardMutation) Where(ps ...predicate.UserAward) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserAwardMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *UserAwardMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserAward, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5330,63 +12384,39 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *UserAwardMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *UserAwardMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (UserAward).
+func (m *UserAwardMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 13)
+func (m *UserAwardMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
+		fields = append(fields, useraward.FieldUpdatedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
-	}
-	if m.deleted_at != nil {
-		fields = append(fields, user.FieldDeletedAt)
-	}
-	if m.deleted_by != nil {
-		fields = append(fields, user.FieldDeletedBy)
-	}
-	if m.display_name != nil {
-		fields = append(fields, user.FieldDisplayName)
-	}
-	if m.alias != nil {
-		fields = append(fields, user.FieldAlias)
-	}
-	if m.profile_image != nil {
-		fields = append(fields, user.FieldProfileImage)
-	}
-	if m.external_id != nil {
-		fields = append(fields, user.FieldExternalID)
-	}
-	if m.auth_provider != nil {
-		fields = append(fields, user.FieldAuthProvider)
-	}
-	if m.role != nil {
-		fields = append(fields, user.FieldRole)
+		fields = append(fields, useraward.FieldCreatedAt)
 	}
-	if m.last_seen_at != nil {
-		fields = append(fields, user.FieldLastSeenAt)
+	if m.owner != nil {
+		fields = append(fields, useraward.FieldOwnerID)
 	}
-	if m.last_post_seen_cursor != nil {
-		fields = append(fields, user.FieldLastPostSeenCursor)
+	if m.award != nil {
+		fields = append(fields, useraward.FieldAwardID)
 	}
-	if m.awards != nil {
-		fields = append(fields, user.FieldAwards)
+	if m.granted_at != nil {
+		fields = append(fields, useraward.FieldGrantedAt)
 	}
 	return fields
 }
@@ -5394,34 +12424,18 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *UserAwardMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldUpdatedAt:
+	case useraward.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case user.FieldCreatedAt:
+	case useraward.FieldCreatedAt:
 		return m.CreatedAt()
-	case user.FieldDeletedAt:
-		return m.DeletedAt()
-	case user.FieldDeletedBy:
-		return m.DeletedBy()
-	case user.FieldDisplayName:
-		return m.DisplayName()
-	case user.FieldAlias:
-		return m.Alias()
-	case user.FieldProfileImage:
-		return m.ProfileImage()
-	case user.FieldExternalID:
-		return m.ExternalID()
-	case user.FieldAuthProvider:
-		return m.AuthProvider()
-	case user.FieldRole:
-		return m.Role()
-	case user.FieldLastSeenAt:
-		return m.LastSeenAt()
-	case user.FieldLastPostSeenCursor:
-		return m.LastPostSeenCursor()
-	case user.FieldAwards:
-		return m.Awards()
+	case useraward.FieldOwnerID:
+		return m.OwnerID()
+	case useraward.FieldAwardID:
+		return m.AwardID()
+	case useraward.FieldGrantedAt:
+		return m.GrantedAt()
 	}
 	return nil, false
 }
@@ -5429,481 +12443,218 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserAwardMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldUpdatedAt:
+	case useraward.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case user.FieldCreatedAt:
+	case useraward.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case user.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case user.FieldDeletedBy:
-		return m.OldDeletedBy(ctx)
-	case user.FieldDisplayName:
-		return m.OldDisplayName(ctx)
-	case user.FieldAlias:
-		return m.OldAlias(ctx)
-	case user.FieldProfileImage:
-		return m.OldProfileImage(ctx)
-	case user.FieldExternalID:
-		return m.OldExternalID(ctx)
-	case user.FieldAuthProvider:
-		return m.OldAuthProvider(ctx)
-	case user.FieldRole:
-		return m.OldRole(ctx)
-	case user.FieldLastSeenAt:
-		return m.OldLastSeenAt(ctx)
-	case user.FieldLastPostSeenCursor:
-		return m.OldLastPostSeenCursor(ctx)
-	case user.FieldAwards:
-		return m.OldAwards(ctx)
+	case useraward.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case useraward.FieldAwardID:
+		return m.OldAwardID(ctx)
+	case useraward.FieldGrantedAt:
+		return m.OldGrantedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown UserAward field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *UserAwardMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldUpdatedAt:
+	case useraward.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case user.FieldCreatedAt:
+	case useraward.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case user.FieldDeletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
-		return nil
-	case user.FieldDeletedBy:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedBy(v)
-		return nil
-	case user.FieldDisplayName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDisplayName(v)
-		return nil
-	case user.FieldAlias:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAlias(v)
-		return nil
-	case user.FieldProfileImage:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProfileImage(v)
-		return nil
-	case user.FieldExternalID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExternalID(v)
-		return nil
-	case user.FieldAuthProvider:
-		v, ok := value.(user.AuthProvider)
+	case useraward.FieldOwnerID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAuthProvider(v)
+		m.SetOwnerID(v)
 		return nil
-	case user.FieldRole:
-		v, ok := value.(user.Role)
+	case useraward.FieldAwardID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRole(v)
+		m.SetAwardID(v)
 		return nil
-	case user.FieldLastSeenAt:
+	case useraward.FieldGrantedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastSeenAt(v)
-		return nil
-	case user.FieldLastPostSeenCursor:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastPostSeenCursor(v)
-		return nil
-	case user.FieldAwards:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAwards(v)
+		m.SetGrantedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UserAward field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
+func (m *UserAwardMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserAwardMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *UserAwardMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown UserAward numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(user.FieldDeletedAt) {
-		fields = append(fields, user.FieldDeletedAt)
-	}
-	if m.FieldCleared(user.FieldDeletedBy) {
-		fields = append(fields, user.FieldDeletedBy)
-	}
-	if m.FieldCleared(user.FieldAlias) {
-		fields = append(fields, user.FieldAlias)
-	}
-	if m.FieldCleared(user.FieldProfileImage) {
-		fields = append(fields, user.FieldProfileImage)
-	}
-	if m.FieldCleared(user.FieldLastSeenAt) {
-		fields = append(fields, user.FieldLastSeenAt)
-	}
-	if m.FieldCleared(user.FieldLastPostSeenCursor) {
-		fields = append(fields, user.FieldLastPostSeenCursor)
-	}
-	if m.FieldCleared(user.FieldAwards) {
-		fields = append(fields, user.FieldAwards)
-	}
-	return fields
+func (m *UserAwardMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *UserAwardMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	switch name {
-	case user.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	case user.FieldDeletedBy:
-		m.ClearDeletedBy()
-		return nil
-	case user.FieldAlias:
-		m.ClearAlias()
-		return nil
-	case user.FieldProfileImage:
-		m.ClearProfileImage()
-		return nil
-	case user.FieldLastSeenAt:
-		m.ClearLastSeenAt()
-		return nil
-	case user.FieldLastPostSeenCursor:
-		m.ClearLastPostSeenCursor()
-		return nil
-	case user.FieldAwards:
-		m.ClearAwards()
-		return nil
-	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *UserAwardMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UserAward nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *UserAwardMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldUpdatedAt:
+	case useraward.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case user.FieldCreatedAt:
+	case useraward.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case user.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
-	case user.FieldDeletedBy:
-		m.ResetDeletedBy()
-		return nil
-	case user.FieldDisplayName:
-		m.ResetDisplayName()
-		return nil
-	case user.FieldAlias:
-		m.ResetAlias()
-		return nil
-	case user.FieldProfileImage:
-		m.ResetProfileImage()
-		return nil
-	case user.FieldExternalID:
-		m.ResetExternalID()
-		return nil
-	case user.FieldAuthProvider:
-		m.ResetAuthProvider()
-		return nil
-	case user.FieldRole:
-		m.ResetRole()
-		return nil
-	case user.FieldLastSeenAt:
-		m.ResetLastSeenAt()
+	case useraward.FieldOwnerID:
+		m.ResetOwnerID()
 		return nil
-	case user.FieldLastPostSeenCursor:
-		m.ResetLastPostSeenCursor()
+	case useraward.FieldAwardID:
+		m.ResetAwardID()
 		return nil
-	case user.FieldAwards:
-		m.ResetAwards()
+	case useraward.FieldGrantedAt:
+		m.ResetGrantedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UserAward field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 6)
-	if m.saved_posts != nil {
-		edges = append(edges, user.EdgeSavedPosts)
-	}
-	if m.liked_posts != nil {
-		edges = append(edges, user.EdgeLikedPosts)
-	}
-	if m.published_posts != nil {
-		edges = append(edges, user.EdgePublishedPosts)
-	}
-	if m.comments != nil {
-		edges = append(edges, user.EdgeComments)
-	}
-	if m.api_keys != nil {
-		edges = append(edges, user.EdgeAPIKeys)
+func (m *UserAwardMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.owner != nil {
+		edges = append(edges, useraward.EdgeOwner)
 	}
-	if m.refresh_tokens != nil {
-		edges = append(edges, user.EdgeRefreshTokens)
+	if m.award != nil {
+		edges = append(edges, useraward.EdgeAward)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *UserAwardMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeSavedPosts:
-		ids := make([]ent.Value, 0, len(m.saved_posts))
-		for id := range m.saved_posts {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeLikedPosts:
-		ids := make([]ent.Value, 0, len(m.liked_posts))
-		for id := range m.liked_posts {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgePublishedPosts:
-		ids := make([]ent.Value, 0, len(m.published_posts))
-		for id := range m.published_posts {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeComments:
-		ids := make([]ent.Value, 0, len(m.comments))
-		for id := range m.comments {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeAPIKeys:
-		ids := make([]ent.Value, 0, len(m.api_keys))
-		for id := range m.api_keys {
-			ids = append(ids, id)
+	case useraward.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case user.EdgeRefreshTokens:
-		ids := make([]ent.Value, 0, len(m.refresh_tokens))
-		for id := range m.refresh_tokens {
-			ids = append(ids, id)
+	case useraward.EdgeAward:
+		if id := m.award; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 6)
-	if m.removedsaved_posts != nil {
-		edges = append(edges, user.EdgeSavedPosts)
-	}
-	if m.removedliked_posts != nil {
-		edges = append(edges, user.EdgeLikedPosts)
-	}
-	if m.removedpublished_posts != nil {
-		edges = append(edges, user.EdgePublishedPosts)
-	}
-	if m.removedcomments != nil {
-		edges = append(edges, user.EdgeComments)
-	}
-	if m.removedapi_keys != nil {
-		edges = append(edges, user.EdgeAPIKeys)
-	}
-	if m.removedrefresh_tokens != nil {
-		edges = append(edges, user.EdgeRefreshTokens)
-	}
+func (m *UserAwardMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeSavedPosts:
-		ids := make([]ent.Value, 0, len(m.removedsaved_posts))
-		for id := range m.removedsaved_posts {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeLikedPosts:
-		ids := make([]ent.Value, 0, len(m.removedliked_posts))
-		for id := range m.removedliked_posts {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgePublishedPosts:
-		ids := make([]ent.Value, 0, len(m.removedpublished_posts))
-		for id := range m.removedpublished_posts {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeComments:
-		ids := make([]ent.Value, 0, len(m.removedcomments))
-		for id := range m.removedcomments {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeAPIKeys:
-		ids := make([]ent.Value, 0, len(m.removedapi_keys))
-		for id := range m.removedapi_keys {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeRefreshTokens:
-		ids := make([]ent.Value, 0, len(m.removedrefresh_tokens))
-		for id := range m.removedrefresh_tokens {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UserAwardMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 6)
-	if m.clearedsaved_posts {
-		edges = append(edges, user.EdgeSavedPosts)
-	}
-	if m.clearedliked_posts {
-		edges = append(edges, user.EdgeLikedPosts)
-	}
-	if m.clearedpublished_posts {
-		edges = append(edges, user.EdgePublishedPosts)
-	}
-	if m.clearedcomments {
-		edges = append(edges, user.EdgeComments)
-	}
-	if m.clearedapi_keys {
-		edges = append(edges, user.EdgeAPIKeys)
+func (m *UserAwardMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedowner {
+		edges = append(edges, useraward.EdgeOwner)
 	}
-	if m.clearedrefresh_tokens {
-		edges = append(edges, user.EdgeRefreshTokens)
+	if m.clearedaward {
+		edges = append(edges, useraward.EdgeAward)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *UserAwardMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgeSavedPosts:
-		return m.clearedsaved_posts
-	case user.EdgeLikedPosts:
-		return m.clearedliked_posts
-	case user.EdgePublishedPosts:
-		return m.clearedpublished_posts
-	case user.EdgeComments:
-		return m.clearedcomments
-	case user.EdgeAPIKeys:
-		return m.clearedapi_keys
-	case user.EdgeRefreshTokens:
-		return m.clearedrefresh_tokens
+	case useraward.EdgeOwner:
+		return m.clearedowner
+	case useraward.EdgeAward:
+		return m.clearedaward
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *UserAwardMutation) ClearEdge(name string) error {
 	switch name {
+	case useraward.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	case useraward.EdgeAward:
+		m.ClearAward()
+		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown UserAward unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *UserAwardMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeSavedPosts:
-		m.ResetSavedPosts()
-		return nil
-	case user.EdgeLikedPosts:
-		m.ResetLikedPosts()
-		return nil
-	case user.EdgePublishedPosts:
-		m.ResetPublishedPosts()
-		return nil
-	case user.EdgeComments:
-		m.ResetComments()
-		return nil
-	case user.EdgeAPIKeys:
-		m.ResetAPIKeys()
+	case useraward.EdgeOwner:
+		m.ResetOwner()
 		return nil
-	case user.EdgeRefreshTokens:
-		m.ResetRefreshTokens()
+	case useraward.EdgeAward:
+		m.ResetAward()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown UserAward edge %s", name)
 }
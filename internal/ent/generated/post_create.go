@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/comment"
@@ -23,6 +25,7 @@ type PostCreate struct {
 	config
 	mutation *PostMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUpdatedAt sets the "updated_at" field.
@@ -411,6 +414,7 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_node = &Post{config: pc.config}
 		_spec = sqlgraph.NewCreateSpec(post.Table, sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = pc.conflict
 	if id, ok := pc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -551,11 +555,543 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Post.Create().
+//		SetUpdatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostUpsert) {
+//			SetUpdatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pc *PostCreate) OnConflict(opts ...sql.ConflictOption) *PostUpsertOne {
+	pc.conflict = opts
+	return &PostUpsertOne{
+		create: pc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Post.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pc *PostCreate) OnConflictColumns(columns ...string) *PostUpsertOne {
+	pc.conflict = append(pc.conflict, sql.ConflictColumns(columns...))
+	return &PostUpsertOne{
+		create: pc,
+	}
+}
+
+type (
+	// PostUpsertOne is the builder for "upsert"-ing
+	//  one Post node.
+	PostUpsertOne struct {
+		create *PostCreate
+	}
+
+	// PostUpsert is the "OnConflict" setter.
+	PostUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PostUpsert) SetUpdatedAt(v time.Time) *PostUpsert {
+	u.Set(post.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PostUpsert) UpdateUpdatedAt() *PostUpsert {
+	u.SetExcluded(post.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PostUpsert) SetDeletedAt(v time.Time) *PostUpsert {
+	u.Set(post.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PostUpsert) UpdateDeletedAt() *PostUpsert {
+	u.SetExcluded(post.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PostUpsert) ClearDeletedAt() *PostUpsert {
+	u.SetNull(post.FieldDeletedAt)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *PostUpsert) SetDeletedBy(v string) *PostUpsert {
+	u.Set(post.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *PostUpsert) UpdateDeletedBy() *PostUpsert {
+	u.SetExcluded(post.FieldDeletedBy)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *PostUpsert) ClearDeletedBy() *PostUpsert {
+	u.SetNull(post.FieldDeletedBy)
+	return u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *PostUpsert) SetOwnerID(v uuid.UUID) *PostUpsert {
+	u.Set(post.FieldOwnerID, v)
+	return u
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *PostUpsert) UpdateOwnerID() *PostUpsert {
+	u.SetExcluded(post.FieldOwnerID)
+	return u
+}
+
+// SetPinned sets the "pinned" field.
+func (u *PostUpsert) SetPinned(v bool) *PostUpsert {
+	u.Set(post.FieldPinned, v)
+	return u
+}
+
+// UpdatePinned sets the "pinned" field to the value that was provided on create.
+func (u *PostUpsert) UpdatePinned() *PostUpsert {
+	u.SetExcluded(post.FieldPinned)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *PostUpsert) SetTitle(v string) *PostUpsert {
+	u.Set(post.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *PostUpsert) UpdateTitle() *PostUpsert {
+	u.SetExcluded(post.FieldTitle)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *PostUpsert) SetContent(v string) *PostUpsert {
+	u.Set(post.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *PostUpsert) UpdateContent() *PostUpsert {
+	u.SetExcluded(post.FieldContent)
+	return u
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *PostUpsert) ClearContent() *PostUpsert {
+	u.SetNull(post.FieldContent)
+	return u
+}
+
+// SetLink sets the "link" field.
+func (u *PostUpsert) SetLink(v string) *PostUpsert {
+	u.Set(post.FieldLink, v)
+	return u
+}
+
+// UpdateLink sets the "link" field to the value that was provided on create.
+func (u *PostUpsert) UpdateLink() *PostUpsert {
+	u.SetExcluded(post.FieldLink)
+	return u
+}
+
+// SetModerationComment sets the "moderation_comment" field.
+func (u *PostUpsert) SetModerationComment(v string) *PostUpsert {
+	u.Set(post.FieldModerationComment, v)
+	return u
+}
+
+// UpdateModerationComment sets the "moderation_comment" field to the value that was provided on create.
+func (u *PostUpsert) UpdateModerationComment() *PostUpsert {
+	u.SetExcluded(post.FieldModerationComment)
+	return u
+}
+
+// ClearModerationComment clears the value of the "moderation_comment" field.
+func (u *PostUpsert) ClearModerationComment() *PostUpsert {
+	u.SetNull(post.FieldModerationComment)
+	return u
+}
+
+// SetIsModerated sets the "is_moderated" field.
+func (u *PostUpsert) SetIsModerated(v bool) *PostUpsert {
+	u.Set(post.FieldIsModerated, v)
+	return u
+}
+
+// UpdateIsModerated sets the "is_moderated" field to the value that was provided on create.
+func (u *PostUpsert) UpdateIsModerated() *PostUpsert {
+	u.SetExcluded(post.FieldIsModerated)
+	return u
+}
+
+// SetModeratedAt sets the "moderated_at" field.
+func (u *PostUpsert) SetModeratedAt(v time.Time) *PostUpsert {
+	u.Set(post.FieldModeratedAt, v)
+	return u
+}
+
+// UpdateModeratedAt sets the "moderated_at" field to the value that was provided on create.
+func (u *PostUpsert) UpdateModeratedAt() *PostUpsert {
+	u.SetExcluded(post.FieldModeratedAt)
+	return u
+}
+
+// ClearModeratedAt clears the value of the "moderated_at" field.
+func (u *PostUpsert) ClearModeratedAt() *PostUpsert {
+	u.SetNull(post.FieldModeratedAt)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *PostUpsert) SetMetadata(v extramodel.PostMetadata) *PostUpsert {
+	u.Set(post.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *PostUpsert) UpdateMetadata() *PostUpsert {
+	u.SetExcluded(post.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *PostUpsert) ClearMetadata() *PostUpsert {
+	u.SetNull(post.FieldMetadata)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Post.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(post.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PostUpsertOne) UpdateNewValues() *PostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(post.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(post.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.EntityVector(); exists {
+			s.SetIgnore(post.FieldEntityVector)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Post.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PostUpsertOne) Ignore() *PostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostUpsertOne) DoNothing() *PostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostCreate.OnConflict
+// documentation for more info.
+func (u *PostUpsertOne) Update(set func(*PostUpsert)) *PostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PostUpsertOne) SetUpdatedAt(v time.Time) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateUpdatedAt() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PostUpsertOne) SetDeletedAt(v time.Time) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateDeletedAt() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PostUpsertOne) ClearDeletedAt() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *PostUpsertOne) SetDeletedBy(v string) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateDeletedBy() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *PostUpsertOne) ClearDeletedBy() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *PostUpsertOne) SetOwnerID(v uuid.UUID) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetOwnerID(v)
+	})
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateOwnerID() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateOwnerID()
+	})
+}
+
+// SetPinned sets the "pinned" field.
+func (u *PostUpsertOne) SetPinned(v bool) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetPinned(v)
+	})
+}
+
+// UpdatePinned sets the "pinned" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdatePinned() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdatePinned()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *PostUpsertOne) SetTitle(v string) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateTitle() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *PostUpsertOne) SetContent(v string) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateContent() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *PostUpsertOne) ClearContent() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearContent()
+	})
+}
+
+// SetLink sets the "link" field.
+func (u *PostUpsertOne) SetLink(v string) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetLink(v)
+	})
+}
+
+// UpdateLink sets the "link" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateLink() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateLink()
+	})
+}
+
+// SetModerationComment sets the "moderation_comment" field.
+func (u *PostUpsertOne) SetModerationComment(v string) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetModerationComment(v)
+	})
+}
+
+// UpdateModerationComment sets the "moderation_comment" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateModerationComment() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateModerationComment()
+	})
+}
+
+// ClearModerationComment clears the value of the "moderation_comment" field.
+func (u *PostUpsertOne) ClearModerationComment() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearModerationComment()
+	})
+}
+
+// SetIsModerated sets the "is_moderated" field.
+func (u *PostUpsertOne) SetIsModerated(v bool) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetIsModerated(v)
+	})
+}
+
+// UpdateIsModerated sets the "is_moderated" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateIsModerated() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateIsModerated()
+	})
+}
+
+// SetModeratedAt sets the "moderated_at" field.
+func (u *PostUpsertOne) SetModeratedAt(v time.Time) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetModeratedAt(v)
+	})
+}
+
+// UpdateModeratedAt sets the "moderated_at" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateModeratedAt() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateModeratedAt()
+	})
+}
+
+// ClearModeratedAt clears the value of the "moderated_at" field.
+func (u *PostUpsertOne) ClearModeratedAt() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearModeratedAt()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *PostUpsertOne) SetMetadata(v extramodel.PostMetadata) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateMetadata() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *PostUpsertOne) ClearMetadata() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *PostUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for PostCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PostUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: PostUpsertOne.ID is not supported by MySQL driver. Use PostUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PostUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // PostCreateBulk is the builder for creating many Post entities in bulk.
 type PostCreateBulk struct {
 	config
 	err      error
 	builders []*PostCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Post entities in the database.
@@ -585,6 +1121,7 @@ func (pcb *PostCreateBulk) Save(ctx context.Context) ([]*Post, error) {
 					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -634,3 +1171,333 @@ func (pcb *PostCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Post.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostUpsert) {
+//			SetUpdatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pcb *PostCreateBulk) OnConflict(opts ...sql.ConflictOption) *PostUpsertBulk {
+	pcb.conflict = opts
+	return &PostUpsertBulk{
+		create: pcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Post.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcb *PostCreateBulk) OnConflictColumns(columns ...string) *PostUpsertBulk {
+	pcb.conflict = append(pcb.conflict, sql.ConflictColumns(columns...))
+	return &PostUpsertBulk{
+		create: pcb,
+	}
+}
+
+// PostUpsertBulk is the builder for "upsert"-ing
+// a bulk of Post nodes.
+type PostUpsertBulk struct {
+	create *PostCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Post.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(post.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PostUpsertBulk) UpdateNewValues() *PostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(post.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(post.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.EntityVector(); exists {
+				s.SetIgnore(post.FieldEntityVector)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Post.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PostUpsertBulk) Ignore() *PostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostUpsertBulk) DoNothing() *PostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostCreateBulk.OnConflict
+// documentation for more info.
+func (u *PostUpsertBulk) Update(set func(*PostUpsert)) *PostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PostUpsertBulk) SetUpdatedAt(v time.Time) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateUpdatedAt() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PostUpsertBulk) SetDeletedAt(v time.Time) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateDeletedAt() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PostUpsertBulk) ClearDeletedAt() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *PostUpsertBulk) SetDeletedBy(v string) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateDeletedBy() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *PostUpsertBulk) ClearDeletedBy() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *PostUpsertBulk) SetOwnerID(v uuid.UUID) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetOwnerID(v)
+	})
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateOwnerID() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateOwnerID()
+	})
+}
+
+// SetPinned sets the "pinned" field.
+func (u *PostUpsertBulk) SetPinned(v bool) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetPinned(v)
+	})
+}
+
+// UpdatePinned sets the "pinned" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdatePinned() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdatePinned()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *PostUpsertBulk) SetTitle(v string) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateTitle() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *PostUpsertBulk) SetContent(v string) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateContent() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *PostUpsertBulk) ClearContent() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearContent()
+	})
+}
+
+// SetLink sets the "link" field.
+func (u *PostUpsertBulk) SetLink(v string) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetLink(v)
+	})
+}
+
+// UpdateLink sets the "link" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateLink() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateLink()
+	})
+}
+
+// SetModerationComment sets the "moderation_comment" field.
+func (u *PostUpsertBulk) SetModerationComment(v string) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetModerationComment(v)
+	})
+}
+
+// UpdateModerationComment sets the "moderation_comment" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateModerationComment() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateModerationComment()
+	})
+}
+
+// ClearModerationComment clears the value of the "moderation_comment" field.
+func (u *PostUpsertBulk) ClearModerationComment() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearModerationComment()
+	})
+}
+
+// SetIsModerated sets the "is_moderated" field.
+func (u *PostUpsertBulk) SetIsModerated(v bool) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetIsModerated(v)
+	})
+}
+
+// UpdateIsModerated sets the "is_moderated" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateIsModerated() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateIsModerated()
+	})
+}
+
+// SetModeratedAt sets the "moderated_at" field.
+func (u *PostUpsertBulk) SetModeratedAt(v time.Time) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetModeratedAt(v)
+	})
+}
+
+// UpdateModeratedAt sets the "moderated_at" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateModeratedAt() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateModeratedAt()
+	})
+}
+
+// ClearModeratedAt clears the value of the "moderated_at" field.
+func (u *PostUpsertBulk) ClearModeratedAt() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearModeratedAt()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *PostUpsertBulk) SetMetadata(v extramodel.PostMetadata) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateMetadata() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *PostUpsertBulk) ClearMetadata() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *PostUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the PostCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for PostCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/post"
@@ -20,6 +22,7 @@ type PostCategoryCreate struct {
 	config
 	mutation *PostCategoryMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUpdatedAt sets the "updated_at" field.
@@ -96,7 +99,9 @@ func (pcc *PostCategoryCreate) Mutation() *PostCategoryMutation {
 
 // Save creates the PostCategory in the database.
 func (pcc *PostCategoryCreate) Save(ctx context.Context) (*PostCategory, error) {
-	pcc.defaults()
+	if err := pcc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, pcc.sqlSave, pcc.mutation, pcc.hooks)
 }
 
@@ -123,19 +128,29 @@ func (pcc *PostCategoryCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (pcc *PostCategoryCreate) defaults() {
+func (pcc *PostCategoryCreate) defaults() error {
 	if _, ok := pcc.mutation.UpdatedAt(); !ok {
+		if postcategory.DefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized postcategory.DefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
 		v := postcategory.DefaultUpdatedAt()
 		pcc.mutation.SetUpdatedAt(v)
 	}
 	if _, ok := pcc.mutation.CreatedAt(); !ok {
+		if postcategory.DefaultCreatedAt == nil {
+			return fmt.Errorf("generated: uninitialized postcategory.DefaultCreatedAt (forgotten import generated/runtime?)")
+		}
 		v := postcategory.DefaultCreatedAt()
 		pcc.mutation.SetCreatedAt(v)
 	}
 	if _, ok := pcc.mutation.ID(); !ok {
+		if postcategory.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized postcategory.DefaultID (forgotten import generated/runtime?)")
+		}
 		v := postcategory.DefaultID()
 		pcc.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -185,6 +200,7 @@ func (pcc *PostCategoryCreate) createSpec() (*PostCategory, *sqlgraph.CreateSpec
 		_node = &PostCategory{config: pcc.config}
 		_spec = sqlgraph.NewCreateSpec(postcategory.Table, sqlgraph.NewFieldSpec(postcategory.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = pcc.conflict
 	if id, ok := pcc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -221,11 +237,202 @@ func (pcc *PostCategoryCreate) createSpec() (*PostCategory, *sqlgraph.CreateSpec
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PostCategory.Create().
+//		SetUpdatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostCategoryUpsert) {
+//			SetUpdatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pcc *PostCategoryCreate) OnConflict(opts ...sql.ConflictOption) *PostCategoryUpsertOne {
+	pcc.conflict = opts
+	return &PostCategoryUpsertOne{
+		create: pcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PostCategory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcc *PostCategoryCreate) OnConflictColumns(columns ...string) *PostCategoryUpsertOne {
+	pcc.conflict = append(pcc.conflict, sql.ConflictColumns(columns...))
+	return &PostCategoryUpsertOne{
+		create: pcc,
+	}
+}
+
+type (
+	// PostCategoryUpsertOne is the builder for "upsert"-ing
+	//  one PostCategory node.
+	PostCategoryUpsertOne struct {
+		create *PostCategoryCreate
+	}
+
+	// PostCategoryUpsert is the "OnConflict" setter.
+	PostCategoryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PostCategoryUpsert) SetUpdatedAt(v time.Time) *PostCategoryUpsert {
+	u.Set(postcategory.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PostCategoryUpsert) UpdateUpdatedAt() *PostCategoryUpsert {
+	u.SetExcluded(postcategory.FieldUpdatedAt)
+	return u
+}
+
+// SetCategory sets the "category" field.
+func (u *PostCategoryUpsert) SetCategory(v postcategory.Category) *PostCategoryUpsert {
+	u.Set(postcategory.FieldCategory, v)
+	return u
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *PostCategoryUpsert) UpdateCategory() *PostCategoryUpsert {
+	u.SetExcluded(postcategory.FieldCategory)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PostCategory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(postcategory.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PostCategoryUpsertOne) UpdateNewValues() *PostCategoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(postcategory.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(postcategory.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PostCategory.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PostCategoryUpsertOne) Ignore() *PostCategoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostCategoryUpsertOne) DoNothing() *PostCategoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostCategoryCreate.OnConflict
+// documentation for more info.
+func (u *PostCategoryUpsertOne) Update(set func(*PostCategoryUpsert)) *PostCategoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostCategoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PostCategoryUpsertOne) SetUpdatedAt(v time.Time) *PostCategoryUpsertOne {
+	return u.Update(func(s *PostCategoryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PostCategoryUpsertOne) UpdateUpdatedAt() *PostCategoryUpsertOne {
+	return u.Update(func(s *PostCategoryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *PostCategoryUpsertOne) SetCategory(v postcategory.Category) *PostCategoryUpsertOne {
+	return u.Update(func(s *PostCategoryUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *PostCategoryUpsertOne) UpdateCategory() *PostCategoryUpsertOne {
+	return u.Update(func(s *PostCategoryUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// Exec executes the query.
+func (u *PostCategoryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for PostCategoryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostCategoryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PostCategoryUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: PostCategoryUpsertOne.ID is not supported by MySQL driver. Use PostCategoryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PostCategoryUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // PostCategoryCreateBulk is the builder for creating many PostCategory entities in bulk.
 type PostCategoryCreateBulk struct {
 	config
 	err      error
 	builders []*PostCategoryCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the PostCategory entities in the database.
@@ -255,6 +462,7 @@ func (pccb *PostCategoryCreateBulk) Save(ctx context.Context) ([]*PostCategory,
 					_, err = mutators[i+1].Mutate(root, pccb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pccb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, pccb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -304,3 +512,148 @@ func (pccb *PostCategoryCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PostCategory.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostCategoryUpsert) {
+//			SetUpdatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pccb *PostCategoryCreateBulk) OnConflict(opts ...sql.ConflictOption) *PostCategoryUpsertBulk {
+	pccb.conflict = opts
+	return &PostCategoryUpsertBulk{
+		create: pccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PostCategory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pccb *PostCategoryCreateBulk) OnConflictColumns(columns ...string) *PostCategoryUpsertBulk {
+	pccb.conflict = append(pccb.conflict, sql.ConflictColumns(columns...))
+	return &PostCategoryUpsertBulk{
+		create: pccb,
+	}
+}
+
+// PostCategoryUpsertBulk is the builder for "upsert"-ing
+// a bulk of PostCategory nodes.
+type PostCategoryUpsertBulk struct {
+	create *PostCategoryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PostCategory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(postcategory.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PostCategoryUpsertBulk) UpdateNewValues() *PostCategoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(postcategory.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(postcategory.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PostCategory.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PostCategoryUpsertBulk) Ignore() *PostCategoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostCategoryUpsertBulk) DoNothing() *PostCategoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostCategoryCreateBulk.OnConflict
+// documentation for more info.
+func (u *PostCategoryUpsertBulk) Update(set func(*PostCategoryUpsert)) *PostCategoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostCategoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PostCategoryUpsertBulk) SetUpdatedAt(v time.Time) *PostCategoryUpsertBulk {
+	return u.Update(func(s *PostCategoryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PostCategoryUpsertBulk) UpdateUpdatedAt() *PostCategoryUpsertBulk {
+	return u.Update(func(s *PostCategoryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *PostCategoryUpsertBulk) SetCategory(v postcategory.Category) *PostCategoryUpsertBulk {
+	return u.Update(func(s *PostCategoryUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *PostCategoryUpsertBulk) UpdateCategory() *PostCategoryUpsertBulk {
+	return u.Update(func(s *PostCategoryUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// Exec executes the query.
+func (u *PostCategoryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the PostCategoryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for PostCategoryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostCategoryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
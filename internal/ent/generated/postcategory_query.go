@@ -4,6 +4,7 @@ package generated
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 
@@ -369,6 +370,12 @@ func (pcq *PostCategoryQuery) prepareQuery(ctx context.Context) error {
 		}
 		pcq.sql = prev
 	}
+	if postcategory.Policy == nil {
+		return errors.New("generated: uninitialized postcategory.Policy (forgotten import generated/runtime?)")
+	}
+	if err := postcategory.Policy.EvalQuery(ctx, pcq); err != nil {
+		return err
+	}
 	return nil
 }
 
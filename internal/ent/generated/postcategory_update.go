@@ -83,7 +83,9 @@ func (pcu *PostCategoryUpdate) ClearPost() *PostCategoryUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pcu *PostCategoryUpdate) Save(ctx context.Context) (int, error) {
-	pcu.defaults()
+	if err := pcu.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, pcu.sqlSave, pcu.mutation, pcu.hooks)
 }
 
@@ -110,11 +112,15 @@ func (pcu *PostCategoryUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (pcu *PostCategoryUpdate) defaults() {
+func (pcu *PostCategoryUpdate) defaults() error {
 	if _, ok := pcu.mutation.UpdatedAt(); !ok {
+		if postcategory.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized postcategory.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
 		v := postcategory.UpdateDefaultUpdatedAt()
 		pcu.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -267,7 +273,9 @@ func (pcuo *PostCategoryUpdateOne) Select(field string, fields ...string) *PostC
 
 // Save executes the query and returns the updated PostCategory entity.
 func (pcuo *PostCategoryUpdateOne) Save(ctx context.Context) (*PostCategory, error) {
-	pcuo.defaults()
+	if err := pcuo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, pcuo.sqlSave, pcuo.mutation, pcuo.hooks)
 }
 
@@ -294,11 +302,15 @@ func (pcuo *PostCategoryUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (pcuo *PostCategoryUpdateOne) defaults() {
+func (pcuo *PostCategoryUpdateOne) defaults() error {
 	if _, ok := pcuo.mutation.UpdatedAt(); !ok {
+		if postcategory.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized postcategory.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
 		v := postcategory.UpdateDefaultUpdatedAt()
 		pcuo.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -9,9 +9,18 @@ import (
 // ApiKey is the predicate function for apikey builders.
 type ApiKey func(*sql.Selector)
 
+// AwardDefinition is the predicate function for awarddefinition builders.
+type AwardDefinition func(*sql.Selector)
+
+// CategoryRestriction is the predicate function for categoryrestriction builders.
+type CategoryRestriction func(*sql.Selector)
+
 // Comment is the predicate function for comment builders.
 type Comment func(*sql.Selector)
 
+// Identity is the predicate function for identity builders.
+type Identity func(*sql.Selector)
+
 // Post is the predicate function for post builders.
 type Post func(*sql.Selector)
 
@@ -21,5 +30,20 @@ type PostCategory func(*sql.Selector)
 // RefreshToken is the predicate function for refreshtoken builders.
 type RefreshToken func(*sql.Selector)
 
+// RoleChange is the predicate function for rolechange builders.
+type RoleChange func(*sql.Selector)
+
+// RolePermission is the predicate function for rolepermission builders.
+type RolePermission func(*sql.Selector)
+
+// Suspension is the predicate function for suspension builders.
+type Suspension func(*sql.Selector)
+
+// TwitchToken is the predicate function for twitchtoken builders.
+type TwitchToken func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// UserAward is the predicate function for useraward builders.
+type UserAward func(*sql.Selector)
@@ -135,6 +135,54 @@ func (f ApiKeyMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mu
 	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.ApiKeyMutation", m)
 }
 
+// The AwardDefinitionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type AwardDefinitionQueryRuleFunc func(context.Context, *generated.AwardDefinitionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f AwardDefinitionQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.AwardDefinitionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.AwardDefinitionQuery", q)
+}
+
+// The AwardDefinitionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type AwardDefinitionMutationRuleFunc func(context.Context, *generated.AwardDefinitionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f AwardDefinitionMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.AwardDefinitionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.AwardDefinitionMutation", m)
+}
+
+// The CategoryRestrictionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type CategoryRestrictionQueryRuleFunc func(context.Context, *generated.CategoryRestrictionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f CategoryRestrictionQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.CategoryRestrictionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.CategoryRestrictionQuery", q)
+}
+
+// The CategoryRestrictionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type CategoryRestrictionMutationRuleFunc func(context.Context, *generated.CategoryRestrictionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f CategoryRestrictionMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.CategoryRestrictionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.CategoryRestrictionMutation", m)
+}
+
 // The CommentQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type CommentQueryRuleFunc func(context.Context, *generated.CommentQuery) error
@@ -159,6 +207,30 @@ func (f CommentMutationRuleFunc) EvalMutation(ctx context.Context, m generated.M
 	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.CommentMutation", m)
 }
 
+// The IdentityQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type IdentityQueryRuleFunc func(context.Context, *generated.IdentityQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f IdentityQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.IdentityQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.IdentityQuery", q)
+}
+
+// The IdentityMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type IdentityMutationRuleFunc func(context.Context, *generated.IdentityMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f IdentityMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.IdentityMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.IdentityMutation", m)
+}
+
 // The PostQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type PostQueryRuleFunc func(context.Context, *generated.PostQuery) error
@@ -231,6 +303,102 @@ func (f RefreshTokenMutationRuleFunc) EvalMutation(ctx context.Context, m genera
 	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.RefreshTokenMutation", m)
 }
 
+// The RoleChangeQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type RoleChangeQueryRuleFunc func(context.Context, *generated.RoleChangeQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f RoleChangeQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.RoleChangeQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.RoleChangeQuery", q)
+}
+
+// The RoleChangeMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type RoleChangeMutationRuleFunc func(context.Context, *generated.RoleChangeMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f RoleChangeMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.RoleChangeMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.RoleChangeMutation", m)
+}
+
+// The RolePermissionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type RolePermissionQueryRuleFunc func(context.Context, *generated.RolePermissionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f RolePermissionQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.RolePermissionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.RolePermissionQuery", q)
+}
+
+// The RolePermissionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type RolePermissionMutationRuleFunc func(context.Context, *generated.RolePermissionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f RolePermissionMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.RolePermissionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.RolePermissionMutation", m)
+}
+
+// The SuspensionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SuspensionQueryRuleFunc func(context.Context, *generated.SuspensionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SuspensionQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.SuspensionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.SuspensionQuery", q)
+}
+
+// The SuspensionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SuspensionMutationRuleFunc func(context.Context, *generated.SuspensionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SuspensionMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.SuspensionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.SuspensionMutation", m)
+}
+
+// The TwitchTokenQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TwitchTokenQueryRuleFunc func(context.Context, *generated.TwitchTokenQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TwitchTokenQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.TwitchTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.TwitchTokenQuery", q)
+}
+
+// The TwitchTokenMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TwitchTokenMutationRuleFunc func(context.Context, *generated.TwitchTokenMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TwitchTokenMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.TwitchTokenMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.TwitchTokenMutation", m)
+}
+
 // The UserQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type UserQueryRuleFunc func(context.Context, *generated.UserQuery) error
@@ -255,6 +423,30 @@ func (f UserMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Muta
 	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.UserMutation", m)
 }
 
+// The UserAwardQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type UserAwardQueryRuleFunc func(context.Context, *generated.UserAwardQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f UserAwardQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.UserAwardQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.UserAwardQuery", q)
+}
+
+// The UserAwardMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type UserAwardMutationRuleFunc func(context.Context, *generated.UserAwardMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f UserAwardMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.UserAwardMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.UserAwardMutation", m)
+}
+
 type (
 	// Filter is the interface that wraps the Where function
 	// for filtering nodes in queries and mutations.
@@ -292,16 +484,32 @@ func queryFilter(q generated.Query) (Filter, error) {
 	switch q := q.(type) {
 	case *generated.ApiKeyQuery:
 		return q.Filter(), nil
+	case *generated.AwardDefinitionQuery:
+		return q.Filter(), nil
+	case *generated.CategoryRestrictionQuery:
+		return q.Filter(), nil
 	case *generated.CommentQuery:
 		return q.Filter(), nil
+	case *generated.IdentityQuery:
+		return q.Filter(), nil
 	case *generated.PostQuery:
 		return q.Filter(), nil
 	case *generated.PostCategoryQuery:
 		return q.Filter(), nil
 	case *generated.RefreshTokenQuery:
 		return q.Filter(), nil
+	case *generated.RoleChangeQuery:
+		return q.Filter(), nil
+	case *generated.RolePermissionQuery:
+		return q.Filter(), nil
+	case *generated.SuspensionQuery:
+		return q.Filter(), nil
+	case *generated.TwitchTokenQuery:
+		return q.Filter(), nil
 	case *generated.UserQuery:
 		return q.Filter(), nil
+	case *generated.UserAwardQuery:
+		return q.Filter(), nil
 	default:
 		return nil, Denyf("generated/privacy: unexpected query type %T for query filter", q)
 	}
@@ -311,16 +519,32 @@ func mutationFilter(m generated.Mutation) (Filter, error) {
 	switch m := m.(type) {
 	case *generated.ApiKeyMutation:
 		return m.Filter(), nil
+	case *generated.AwardDefinitionMutation:
+		return m.Filter(), nil
+	case *generated.CategoryRestrictionMutation:
+		return m.Filter(), nil
 	case *generated.CommentMutation:
 		return m.Filter(), nil
+	case *generated.IdentityMutation:
+		return m.Filter(), nil
 	case *generated.PostMutation:
 		return m.Filter(), nil
 	case *generated.PostCategoryMutation:
 		return m.Filter(), nil
 	case *generated.RefreshTokenMutation:
 		return m.Filter(), nil
+	case *generated.RoleChangeMutation:
+		return m.Filter(), nil
+	case *generated.RolePermissionMutation:
+		return m.Filter(), nil
+	case *generated.SuspensionMutation:
+		return m.Filter(), nil
+	case *generated.TwitchTokenMutation:
+		return m.Filter(), nil
 	case *generated.UserMutation:
 		return m.Filter(), nil
+	case *generated.UserAwardMutation:
+		return m.Filter(), nil
 	default:
 		return nil, Denyf("generated/privacy: unexpected mutation type %T for mutation filter", m)
 	}
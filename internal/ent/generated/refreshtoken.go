@@ -35,6 +35,10 @@ type RefreshToken struct {
 	IPAddress string `json:"ip_address,omitempty"`
 	// UserAgent holds the value of the "user_agent" field.
 	UserAgent string `json:"user_agent,omitempty"`
+	// FamilyID holds the value of the "family_id" field.
+	FamilyID uuid.UUID `json:"family_id,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID *uuid.UUID `json:"parent_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the RefreshTokenQuery when eager-loading is set.
 	Edges        RefreshTokenEdges `json:"edges"`
@@ -68,13 +72,15 @@ func (*RefreshToken) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case refreshtoken.FieldParentID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case refreshtoken.FieldRevoked:
 			values[i] = new(sql.NullBool)
 		case refreshtoken.FieldTokenHash, refreshtoken.FieldIPAddress, refreshtoken.FieldUserAgent:
 			values[i] = new(sql.NullString)
 		case refreshtoken.FieldUpdatedAt, refreshtoken.FieldCreatedAt, refreshtoken.FieldExpiresAt:
 			values[i] = new(sql.NullTime)
-		case refreshtoken.FieldID, refreshtoken.FieldOwnerID:
+		case refreshtoken.FieldID, refreshtoken.FieldOwnerID, refreshtoken.FieldFamilyID:
 			values[i] = new(uuid.UUID)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -145,6 +151,19 @@ func (rt *RefreshToken) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				rt.UserAgent = value.String
 			}
+		case refreshtoken.FieldFamilyID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field family_id", values[i])
+			} else if value != nil {
+				rt.FamilyID = *value
+			}
+		case refreshtoken.FieldParentID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				rt.ParentID = new(uuid.UUID)
+				*rt.ParentID = *value.S.(*uuid.UUID)
+			}
 		default:
 			rt.selectValues.Set(columns[i], values[i])
 		}
@@ -209,6 +228,14 @@ func (rt *RefreshToken) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("user_agent=")
 	builder.WriteString(rt.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("family_id=")
+	builder.WriteString(fmt.Sprintf("%v", rt.FamilyID))
+	builder.WriteString(", ")
+	if v := rt.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -246,6 +246,9 @@ func (rtu *RefreshTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if rtu.mutation.UserAgentCleared() {
 		_spec.ClearField(refreshtoken.FieldUserAgent, field.TypeString)
 	}
+	if rtu.mutation.ParentIDCleared() {
+		_spec.ClearField(refreshtoken.FieldParentID, field.TypeUUID)
+	}
 	if rtu.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -542,6 +545,9 @@ func (rtuo *RefreshTokenUpdateOne) sqlSave(ctx context.Context) (_node *RefreshT
 	if rtuo.mutation.UserAgentCleared() {
 		_spec.ClearField(refreshtoken.FieldUserAgent, field.TypeString)
 	}
+	if rtuo.mutation.ParentIDCleared() {
+		_spec.ClearField(refreshtoken.FieldParentID, field.TypeUUID)
+	}
 	if rtuo.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
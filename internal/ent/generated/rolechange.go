@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/google/uuid"
+)
+
+// RoleChange is the model entity for the RoleChange schema.
+type RoleChange struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Attribute holds the value of the "attribute" field.
+	Attribute rolechange.Attribute `json:"attribute,omitempty"`
+	// OldValue holds the value of the "old_value" field.
+	OldValue string `json:"old_value,omitempty"`
+	// NewValue holds the value of the "new_value" field.
+	NewValue string `json:"new_value,omitempty"`
+	// Source holds the value of the "source" field.
+	Source rolechange.Source `json:"source,omitempty"`
+	// ChangedByID holds the value of the "changed_by_id" field.
+	ChangedByID *uuid.UUID `json:"changed_by_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RoleChangeQuery when eager-loading is set.
+	Edges        RoleChangeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RoleChangeEdges holds the relations/edges for other nodes in the graph.
+type RoleChangeEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoleChangeEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RoleChange) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rolechange.FieldChangedByID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case rolechange.FieldAttribute, rolechange.FieldOldValue, rolechange.FieldNewValue, rolechange.FieldSource:
+			values[i] = new(sql.NullString)
+		case rolechange.FieldUpdatedAt, rolechange.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case rolechange.FieldID, rolechange.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RoleChange fields.
+func (rc *RoleChange) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rolechange.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rc.ID = *value
+			}
+		case rolechange.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rc.UpdatedAt = value.Time
+			}
+		case rolechange.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rc.CreatedAt = value.Time
+			}
+		case rolechange.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				rc.UserID = *value
+			}
+		case rolechange.FieldAttribute:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attribute", values[i])
+			} else if value.Valid {
+				rc.Attribute = rolechange.Attribute(value.String)
+			}
+		case rolechange.FieldOldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field old_value", values[i])
+			} else if value.Valid {
+				rc.OldValue = value.String
+			}
+		case rolechange.FieldNewValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field new_value", values[i])
+			} else if value.Valid {
+				rc.NewValue = value.String
+			}
+		case rolechange.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				rc.Source = rolechange.Source(value.String)
+			}
+		case rolechange.FieldChangedByID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_by_id", values[i])
+			} else if value.Valid {
+				rc.ChangedByID = new(uuid.UUID)
+				*rc.ChangedByID = *value.S.(*uuid.UUID)
+			}
+		default:
+			rc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RoleChange.
+// This includes values selected through modifiers, order, etc.
+func (rc *RoleChange) Value(name string) (ent.Value, error) {
+	return rc.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the RoleChange entity.
+func (rc *RoleChange) QueryUser() *UserQuery {
+	return NewRoleChangeClient(rc.config).QueryUser(rc)
+}
+
+// Update returns a builder for updating this RoleChange.
+// Note that you need to call RoleChange.Unwrap() before calling this method if this RoleChange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rc *RoleChange) Update() *RoleChangeUpdateOne {
+	return NewRoleChangeClient(rc.config).UpdateOne(rc)
+}
+
+// Unwrap unwraps the RoleChange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rc *RoleChange) Unwrap() *RoleChange {
+	_tx, ok := rc.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: RoleChange is not a transactional entity")
+	}
+	rc.config.driver = _tx.drv
+	return rc
+}
+
+// String implements the fmt.Stringer.
+func (rc *RoleChange) String() string {
+	var builder strings.Builder
+	builder.WriteString("RoleChange(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rc.ID))
+	builder.WriteString("updated_at=")
+	builder.WriteString(rc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", rc.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("attribute=")
+	builder.WriteString(fmt.Sprintf("%v", rc.Attribute))
+	builder.WriteString(", ")
+	builder.WriteString("old_value=")
+	builder.WriteString(rc.OldValue)
+	builder.WriteString(", ")
+	builder.WriteString("new_value=")
+	builder.WriteString(rc.NewValue)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(fmt.Sprintf("%v", rc.Source))
+	builder.WriteString(", ")
+	if v := rc.ChangedByID; v != nil {
+		builder.WriteString("changed_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RoleChanges is a parsable slice of RoleChange.
+type RoleChanges []*RoleChange
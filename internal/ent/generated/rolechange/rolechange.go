@@ -0,0 +1,252 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rolechange
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the rolechange type in the database.
+	Label = "role_change"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldAttribute holds the string denoting the attribute field in the database.
+	FieldAttribute = "attribute"
+	// FieldOldValue holds the string denoting the old_value field in the database.
+	FieldOldValue = "old_value"
+	// FieldNewValue holds the string denoting the new_value field in the database.
+	FieldNewValue = "new_value"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldChangedByID holds the string denoting the changed_by_id field in the database.
+	FieldChangedByID = "changed_by_id"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the rolechange in the database.
+	Table = "role_changes"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "role_changes"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+)
+
+// Columns holds all SQL columns for rolechange fields.
+var Columns = []string{
+	FieldID,
+	FieldUpdatedAt,
+	FieldCreatedAt,
+	FieldUserID,
+	FieldAttribute,
+	FieldOldValue,
+	FieldNewValue,
+	FieldSource,
+	FieldChangedByID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/caliecode/la-clipasa/internal/ent/generated/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Attribute defines the type for the "attribute" enum field.
+type Attribute string
+
+// Attribute values.
+const (
+	AttributeROLE       Attribute = "ROLE"
+	AttributeTWITCH_VIP Attribute = "TWITCH_VIP"
+)
+
+func (a Attribute) String() string {
+	return string(a)
+}
+
+// AttributeValidator is a validator for the "attribute" field enum values. It is called by the builders before save.
+func AttributeValidator(a Attribute) error {
+	switch a {
+	case AttributeROLE, AttributeTWITCH_VIP:
+		return nil
+	default:
+		return fmt.Errorf("rolechange: invalid enum value for attribute field: %q", a)
+	}
+}
+
+// AllAttributes returns all Attribute values.
+func AllAttributes() []Attribute {
+	return []Attribute{
+		AttributeROLE,
+		AttributeTWITCH_VIP,
+	}
+}
+
+// Source defines the type for the "source" enum field.
+type Source string
+
+// Source values.
+const (
+	SourceMANUAL      Source = "MANUAL"
+	SourceTWITCH_SYNC Source = "TWITCH_SYNC"
+	SourceSYSTEM      Source = "SYSTEM"
+)
+
+func (s Source) String() string {
+	return string(s)
+}
+
+// SourceValidator is a validator for the "source" field enum values. It is called by the builders before save.
+func SourceValidator(s Source) error {
+	switch s {
+	case SourceMANUAL, SourceTWITCH_SYNC, SourceSYSTEM:
+		return nil
+	default:
+		return fmt.Errorf("rolechange: invalid enum value for source field: %q", s)
+	}
+}
+
+// AllSources returns all Source values.
+func AllSources() []Source {
+	return []Source{
+		SourceMANUAL,
+		SourceTWITCH_SYNC,
+		SourceSYSTEM,
+	}
+}
+
+// OrderOption defines the ordering options for the RoleChange queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByAttribute orders the results by the attribute field.
+func ByAttribute(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttribute, opts...).ToFunc()
+}
+
+// ByOldValue orders the results by the old_value field.
+func ByOldValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOldValue, opts...).ToFunc()
+}
+
+// ByNewValue orders the results by the new_value field.
+func ByNewValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNewValue, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByChangedByID orders the results by the changed_by_id field.
+func ByChangedByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChangedByID, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Attribute) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Attribute) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Attribute(str)
+	if err := AttributeValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Attribute", str)
+	}
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Source) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Source) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Source(str)
+	if err := SourceValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Source", str)
+	}
+	return nil
+}
@@ -0,0 +1,445 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rolechange
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldLTE(FieldID, id))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldEQ(FieldUserID, v))
+}
+
+// OldValue applies equality check predicate on the "old_value" field. It's identical to OldValueEQ.
+func OldValue(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldEQ(FieldOldValue, v))
+}
+
+// NewValue applies equality check predicate on the "new_value" field. It's identical to NewValueEQ.
+func NewValue(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldEQ(FieldNewValue, v))
+}
+
+// ChangedByID applies equality check predicate on the "changed_by_id" field. It's identical to ChangedByIDEQ.
+func ChangedByID(v uuid.UUID) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldEQ(FieldChangedByID, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// AttributeEQ applies the EQ predicate on the "attribute" field.
+func AttributeEQ(v Attribute) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldEQ(FieldAttribute, v))
+}
+
+// AttributeNEQ applies the NEQ predicate on the "attribute" field.
+func AttributeNEQ(v Attribute) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldNEQ(FieldAttribute, v))
+}
+
+// AttributeIn applies the In predicate on the "attribute" field.
+func AttributeIn(vs ...Attribute) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldIn(FieldAttribute, vs...))
+}
+
+// AttributeNotIn applies the NotIn predicate on the "attribute" field.
+func AttributeNotIn(vs ...Attribute) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldNotIn(FieldAttribute, vs...))
+}
+
+// OldValueEQ applies the EQ predicate on the "old_value" field.
+func OldValueEQ(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldEQ(FieldOldValue, v))
+}
+
+// OldValueNEQ applies the NEQ predicate on the "old_value" field.
+func OldValueNEQ(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldNEQ(FieldOldValue, v))
+}
+
+// OldValueIn applies the In predicate on the "old_value" field.
+func OldValueIn(vs ...string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldIn(FieldOldValue, vs...))
+}
+
+// OldValueNotIn applies the NotIn predicate on the "old_value" field.
+func OldValueNotIn(vs ...string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldNotIn(FieldOldValue, vs...))
+}
+
+// OldValueGT applies the GT predicate on the "old_value" field.
+func OldValueGT(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldGT(FieldOldValue, v))
+}
+
+// OldValueGTE applies the GTE predicate on the "old_value" field.
+func OldValueGTE(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldGTE(FieldOldValue, v))
+}
+
+// OldValueLT applies the LT predicate on the "old_value" field.
+func OldValueLT(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldLT(FieldOldValue, v))
+}
+
+// OldValueLTE applies the LTE predicate on the "old_value" field.
+func OldValueLTE(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldLTE(FieldOldValue, v))
+}
+
+// OldValueContains applies the Contains predicate on the "old_value" field.
+func OldValueContains(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldContains(FieldOldValue, v))
+}
+
+// OldValueHasPrefix applies the HasPrefix predicate on the "old_value" field.
+func OldValueHasPrefix(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldHasPrefix(FieldOldValue, v))
+}
+
+// OldValueHasSuffix applies the HasSuffix predicate on the "old_value" field.
+func OldValueHasSuffix(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldHasSuffix(FieldOldValue, v))
+}
+
+// OldValueEqualFold applies the EqualFold predicate on the "old_value" field.
+func OldValueEqualFold(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldEqualFold(FieldOldValue, v))
+}
+
+// OldValueContainsFold applies the ContainsFold predicate on the "old_value" field.
+func OldValueContainsFold(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldContainsFold(FieldOldValue, v))
+}
+
+// NewValueEQ applies the EQ predicate on the "new_value" field.
+func NewValueEQ(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldEQ(FieldNewValue, v))
+}
+
+// NewValueNEQ applies the NEQ predicate on the "new_value" field.
+func NewValueNEQ(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldNEQ(FieldNewValue, v))
+}
+
+// NewValueIn applies the In predicate on the "new_value" field.
+func NewValueIn(vs ...string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldIn(FieldNewValue, vs...))
+}
+
+// NewValueNotIn applies the NotIn predicate on the "new_value" field.
+func NewValueNotIn(vs ...string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldNotIn(FieldNewValue, vs...))
+}
+
+// NewValueGT applies the GT predicate on the "new_value" field.
+func NewValueGT(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldGT(FieldNewValue, v))
+}
+
+// NewValueGTE applies the GTE predicate on the "new_value" field.
+func NewValueGTE(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldGTE(FieldNewValue, v))
+}
+
+// NewValueLT applies the LT predicate on the "new_value" field.
+func NewValueLT(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldLT(FieldNewValue, v))
+}
+
+// NewValueLTE applies the LTE predicate on the "new_value" field.
+func NewValueLTE(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldLTE(FieldNewValue, v))
+}
+
+// NewValueContains applies the Contains predicate on the "new_value" field.
+func NewValueContains(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldContains(FieldNewValue, v))
+}
+
+// NewValueHasPrefix applies the HasPrefix predicate on the "new_value" field.
+func NewValueHasPrefix(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldHasPrefix(FieldNewValue, v))
+}
+
+// NewValueHasSuffix applies the HasSuffix predicate on the "new_value" field.
+func NewValueHasSuffix(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldHasSuffix(FieldNewValue, v))
+}
+
+// NewValueEqualFold applies the EqualFold predicate on the "new_value" field.
+func NewValueEqualFold(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldEqualFold(FieldNewValue, v))
+}
+
+// NewValueContainsFold applies the ContainsFold predicate on the "new_value" field.
+func NewValueContainsFold(v string) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldContainsFold(FieldNewValue, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v Source) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v Source) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...Source) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...Source) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// ChangedByIDEQ applies the EQ predicate on the "changed_by_id" field.
+func ChangedByIDEQ(v uuid.UUID) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldEQ(FieldChangedByID, v))
+}
+
+// ChangedByIDNEQ applies the NEQ predicate on the "changed_by_id" field.
+func ChangedByIDNEQ(v uuid.UUID) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldNEQ(FieldChangedByID, v))
+}
+
+// ChangedByIDIn applies the In predicate on the "changed_by_id" field.
+func ChangedByIDIn(vs ...uuid.UUID) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldIn(FieldChangedByID, vs...))
+}
+
+// ChangedByIDNotIn applies the NotIn predicate on the "changed_by_id" field.
+func ChangedByIDNotIn(vs ...uuid.UUID) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldNotIn(FieldChangedByID, vs...))
+}
+
+// ChangedByIDGT applies the GT predicate on the "changed_by_id" field.
+func ChangedByIDGT(v uuid.UUID) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldGT(FieldChangedByID, v))
+}
+
+// ChangedByIDGTE applies the GTE predicate on the "changed_by_id" field.
+func ChangedByIDGTE(v uuid.UUID) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldGTE(FieldChangedByID, v))
+}
+
+// ChangedByIDLT applies the LT predicate on the "changed_by_id" field.
+func ChangedByIDLT(v uuid.UUID) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldLT(FieldChangedByID, v))
+}
+
+// ChangedByIDLTE applies the LTE predicate on the "changed_by_id" field.
+func ChangedByIDLTE(v uuid.UUID) predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldLTE(FieldChangedByID, v))
+}
+
+// ChangedByIDIsNil applies the IsNil predicate on the "changed_by_id" field.
+func ChangedByIDIsNil() predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldIsNull(FieldChangedByID))
+}
+
+// ChangedByIDNotNil applies the NotNil predicate on the "changed_by_id" field.
+func ChangedByIDNotNil() predicate.RoleChange {
+	return predicate.RoleChange(sql.FieldNotNull(FieldChangedByID))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.RoleChange {
+	return predicate.RoleChange(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.RoleChange {
+	return predicate.RoleChange(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RoleChange) predicate.RoleChange {
+	return predicate.RoleChange(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RoleChange) predicate.RoleChange {
+	return predicate.RoleChange(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RoleChange) predicate.RoleChange {
+	return predicate.RoleChange(sql.NotPredicates(p))
+}
@@ -0,0 +1,715 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/google/uuid"
+)
+
+// RoleChangeCreate is the builder for creating a RoleChange entity.
+type RoleChangeCreate struct {
+	config
+	mutation *RoleChangeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rcc *RoleChangeCreate) SetUpdatedAt(t time.Time) *RoleChangeCreate {
+	rcc.mutation.SetUpdatedAt(t)
+	return rcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rcc *RoleChangeCreate) SetNillableUpdatedAt(t *time.Time) *RoleChangeCreate {
+	if t != nil {
+		rcc.SetUpdatedAt(*t)
+	}
+	return rcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rcc *RoleChangeCreate) SetCreatedAt(t time.Time) *RoleChangeCreate {
+	rcc.mutation.SetCreatedAt(t)
+	return rcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rcc *RoleChangeCreate) SetNillableCreatedAt(t *time.Time) *RoleChangeCreate {
+	if t != nil {
+		rcc.SetCreatedAt(*t)
+	}
+	return rcc
+}
+
+// SetUserID sets the "user_id" field.
+func (rcc *RoleChangeCreate) SetUserID(u uuid.UUID) *RoleChangeCreate {
+	rcc.mutation.SetUserID(u)
+	return rcc
+}
+
+// SetAttribute sets the "attribute" field.
+func (rcc *RoleChangeCreate) SetAttribute(r rolechange.Attribute) *RoleChangeCreate {
+	rcc.mutation.SetAttribute(r)
+	return rcc
+}
+
+// SetOldValue sets the "old_value" field.
+func (rcc *RoleChangeCreate) SetOldValue(s string) *RoleChangeCreate {
+	rcc.mutation.SetOldValue(s)
+	return rcc
+}
+
+// SetNewValue sets the "new_value" field.
+func (rcc *RoleChangeCreate) SetNewValue(s string) *RoleChangeCreate {
+	rcc.mutation.SetNewValue(s)
+	return rcc
+}
+
+// SetSource sets the "source" field.
+func (rcc *RoleChangeCreate) SetSource(r rolechange.Source) *RoleChangeCreate {
+	rcc.mutation.SetSource(r)
+	return rcc
+}
+
+// SetChangedByID sets the "changed_by_id" field.
+func (rcc *RoleChangeCreate) SetChangedByID(u uuid.UUID) *RoleChangeCreate {
+	rcc.mutation.SetChangedByID(u)
+	return rcc
+}
+
+// SetNillableChangedByID sets the "changed_by_id" field if the given value is not nil.
+func (rcc *RoleChangeCreate) SetNillableChangedByID(u *uuid.UUID) *RoleChangeCreate {
+	if u != nil {
+		rcc.SetChangedByID(*u)
+	}
+	return rcc
+}
+
+// SetID sets the "id" field.
+func (rcc *RoleChangeCreate) SetID(u uuid.UUID) *RoleChangeCreate {
+	rcc.mutation.SetID(u)
+	return rcc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rcc *RoleChangeCreate) SetNillableID(u *uuid.UUID) *RoleChangeCreate {
+	if u != nil {
+		rcc.SetID(*u)
+	}
+	return rcc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rcc *RoleChangeCreate) SetUser(u *User) *RoleChangeCreate {
+	return rcc.SetUserID(u.ID)
+}
+
+// Mutation returns the RoleChangeMutation object of the builder.
+func (rcc *RoleChangeCreate) Mutation() *RoleChangeMutation {
+	return rcc.mutation
+}
+
+// Save creates the RoleChange in the database.
+func (rcc *RoleChangeCreate) Save(ctx context.Context) (*RoleChange, error) {
+	if err := rcc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, rcc.sqlSave, rcc.mutation, rcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rcc *RoleChangeCreate) SaveX(ctx context.Context) *RoleChange {
+	v, err := rcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcc *RoleChangeCreate) Exec(ctx context.Context) error {
+	_, err := rcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcc *RoleChangeCreate) ExecX(ctx context.Context) {
+	if err := rcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcc *RoleChangeCreate) defaults() error {
+	if _, ok := rcc.mutation.UpdatedAt(); !ok {
+		if rolechange.DefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized rolechange.DefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := rolechange.DefaultUpdatedAt()
+		rcc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rcc.mutation.CreatedAt(); !ok {
+		if rolechange.DefaultCreatedAt == nil {
+			return fmt.Errorf("generated: uninitialized rolechange.DefaultCreatedAt (forgotten import generated/runtime?)")
+		}
+		v := rolechange.DefaultCreatedAt()
+		rcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rcc.mutation.ID(); !ok {
+		if rolechange.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized rolechange.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := rolechange.DefaultID()
+		rcc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcc *RoleChangeCreate) check() error {
+	if _, ok := rcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "RoleChange.updated_at"`)}
+	}
+	if _, ok := rcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "RoleChange.created_at"`)}
+	}
+	if _, ok := rcc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "RoleChange.user_id"`)}
+	}
+	if _, ok := rcc.mutation.Attribute(); !ok {
+		return &ValidationError{Name: "attribute", err: errors.New(`generated: missing required field "RoleChange.attribute"`)}
+	}
+	if v, ok := rcc.mutation.Attribute(); ok {
+		if err := rolechange.AttributeValidator(v); err != nil {
+			return &ValidationError{Name: "attribute", err: fmt.Errorf(`generated: validator failed for field "RoleChange.attribute": %w`, err)}
+		}
+	}
+	if _, ok := rcc.mutation.OldValue(); !ok {
+		return &ValidationError{Name: "old_value", err: errors.New(`generated: missing required field "RoleChange.old_value"`)}
+	}
+	if _, ok := rcc.mutation.NewValue(); !ok {
+		return &ValidationError{Name: "new_value", err: errors.New(`generated: missing required field "RoleChange.new_value"`)}
+	}
+	if _, ok := rcc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`generated: missing required field "RoleChange.source"`)}
+	}
+	if v, ok := rcc.mutation.Source(); ok {
+		if err := rolechange.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`generated: validator failed for field "RoleChange.source": %w`, err)}
+		}
+	}
+	if len(rcc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`generated: missing required edge "RoleChange.user"`)}
+	}
+	return nil
+}
+
+func (rcc *RoleChangeCreate) sqlSave(ctx context.Context) (*RoleChange, error) {
+	if err := rcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rcc.mutation.id = &_node.ID
+	rcc.mutation.done = true
+	return _node, nil
+}
+
+func (rcc *RoleChangeCreate) createSpec() (*RoleChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RoleChange{config: rcc.config}
+		_spec = sqlgraph.NewCreateSpec(rolechange.Table, sqlgraph.NewFieldSpec(rolechange.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = rcc.conflict
+	if id, ok := rcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(rolechange.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rcc.mutation.CreatedAt(); ok {
+		_spec.SetField(rolechange.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rcc.mutation.Attribute(); ok {
+		_spec.SetField(rolechange.FieldAttribute, field.TypeEnum, value)
+		_node.Attribute = value
+	}
+	if value, ok := rcc.mutation.OldValue(); ok {
+		_spec.SetField(rolechange.FieldOldValue, field.TypeString, value)
+		_node.OldValue = value
+	}
+	if value, ok := rcc.mutation.NewValue(); ok {
+		_spec.SetField(rolechange.FieldNewValue, field.TypeString, value)
+		_node.NewValue = value
+	}
+	if value, ok := rcc.mutation.Source(); ok {
+		_spec.SetField(rolechange.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if value, ok := rcc.mutation.ChangedByID(); ok {
+		_spec.SetField(rolechange.FieldChangedByID, field.TypeUUID, value)
+		_node.ChangedByID = &value
+	}
+	if nodes := rcc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rolechange.UserTable,
+			Columns: []string{rolechange.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RoleChange.Create().
+//		SetUpdatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RoleChangeUpsert) {
+//			SetUpdatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rcc *RoleChangeCreate) OnConflict(opts ...sql.ConflictOption) *RoleChangeUpsertOne {
+	rcc.conflict = opts
+	return &RoleChangeUpsertOne{
+		create: rcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RoleChange.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rcc *RoleChangeCreate) OnConflictColumns(columns ...string) *RoleChangeUpsertOne {
+	rcc.conflict = append(rcc.conflict, sql.ConflictColumns(columns...))
+	return &RoleChangeUpsertOne{
+		create: rcc,
+	}
+}
+
+type (
+	// RoleChangeUpsertOne is the builder for "upsert"-ing
+	//  one RoleChange node.
+	RoleChangeUpsertOne struct {
+		create *RoleChangeCreate
+	}
+
+	// RoleChangeUpsert is the "OnConflict" setter.
+	RoleChangeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RoleChangeUpsert) SetUpdatedAt(v time.Time) *RoleChangeUpsert {
+	u.Set(rolechange.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RoleChangeUpsert) UpdateUpdatedAt() *RoleChangeUpsert {
+	u.SetExcluded(rolechange.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RoleChange.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(rolechange.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RoleChangeUpsertOne) UpdateNewValues() *RoleChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(rolechange.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(rolechange.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(rolechange.FieldUserID)
+		}
+		if _, exists := u.create.mutation.Attribute(); exists {
+			s.SetIgnore(rolechange.FieldAttribute)
+		}
+		if _, exists := u.create.mutation.OldValue(); exists {
+			s.SetIgnore(rolechange.FieldOldValue)
+		}
+		if _, exists := u.create.mutation.NewValue(); exists {
+			s.SetIgnore(rolechange.FieldNewValue)
+		}
+		if _, exists := u.create.mutation.Source(); exists {
+			s.SetIgnore(rolechange.FieldSource)
+		}
+		if _, exists := u.create.mutation.ChangedByID(); exists {
+			s.SetIgnore(rolechange.FieldChangedByID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RoleChange.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RoleChangeUpsertOne) Ignore() *RoleChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RoleChangeUpsertOne) DoNothing() *RoleChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RoleChangeCreate.OnConflict
+// documentation for more info.
+func (u *RoleChangeUpsertOne) Update(set func(*RoleChangeUpsert)) *RoleChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RoleChangeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RoleChangeUpsertOne) SetUpdatedAt(v time.Time) *RoleChangeUpsertOne {
+	return u.Update(func(s *RoleChangeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RoleChangeUpsertOne) UpdateUpdatedAt() *RoleChangeUpsertOne {
+	return u.Update(func(s *RoleChangeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RoleChangeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for RoleChangeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RoleChangeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RoleChangeUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: RoleChangeUpsertOne.ID is not supported by MySQL driver. Use RoleChangeUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RoleChangeUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RoleChangeCreateBulk is the builder for creating many RoleChange entities in bulk.
+type RoleChangeCreateBulk struct {
+	config
+	err      error
+	builders []*RoleChangeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RoleChange entities in the database.
+func (rccb *RoleChangeCreateBulk) Save(ctx context.Context) ([]*RoleChange, error) {
+	if rccb.err != nil {
+		return nil, rccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rccb.builders))
+	nodes := make([]*RoleChange, len(rccb.builders))
+	mutators := make([]Mutator, len(rccb.builders))
+	for i := range rccb.builders {
+		func(i int, root context.Context) {
+			builder := rccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RoleChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rccb *RoleChangeCreateBulk) SaveX(ctx context.Context) []*RoleChange {
+	v, err := rccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rccb *RoleChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := rccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rccb *RoleChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := rccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RoleChange.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RoleChangeUpsert) {
+//			SetUpdatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rccb *RoleChangeCreateBulk) OnConflict(opts ...sql.ConflictOption) *RoleChangeUpsertBulk {
+	rccb.conflict = opts
+	return &RoleChangeUpsertBulk{
+		create: rccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RoleChange.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rccb *RoleChangeCreateBulk) OnConflictColumns(columns ...string) *RoleChangeUpsertBulk {
+	rccb.conflict = append(rccb.conflict, sql.ConflictColumns(columns...))
+	return &RoleChangeUpsertBulk{
+		create: rccb,
+	}
+}
+
+// RoleChangeUpsertBulk is the builder for "upsert"-ing
+// a bulk of RoleChange nodes.
+type RoleChangeUpsertBulk struct {
+	create *RoleChangeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RoleChange.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(rolechange.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RoleChangeUpsertBulk) UpdateNewValues() *RoleChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(rolechange.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(rolechange.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(rolechange.FieldUserID)
+			}
+			if _, exists := b.mutation.Attribute(); exists {
+				s.SetIgnore(rolechange.FieldAttribute)
+			}
+			if _, exists := b.mutation.OldValue(); exists {
+				s.SetIgnore(rolechange.FieldOldValue)
+			}
+			if _, exists := b.mutation.NewValue(); exists {
+				s.SetIgnore(rolechange.FieldNewValue)
+			}
+			if _, exists := b.mutation.Source(); exists {
+				s.SetIgnore(rolechange.FieldSource)
+			}
+			if _, exists := b.mutation.ChangedByID(); exists {
+				s.SetIgnore(rolechange.FieldChangedByID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RoleChange.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RoleChangeUpsertBulk) Ignore() *RoleChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RoleChangeUpsertBulk) DoNothing() *RoleChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RoleChangeCreateBulk.OnConflict
+// documentation for more info.
+func (u *RoleChangeUpsertBulk) Update(set func(*RoleChangeUpsert)) *RoleChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RoleChangeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RoleChangeUpsertBulk) SetUpdatedAt(v time.Time) *RoleChangeUpsertBulk {
+	return u.Update(func(s *RoleChangeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RoleChangeUpsertBulk) UpdateUpdatedAt() *RoleChangeUpsertBulk {
+	return u.Update(func(s *RoleChangeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RoleChangeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the RoleChangeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for RoleChangeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RoleChangeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
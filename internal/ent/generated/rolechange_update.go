@@ -0,0 +1,268 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
+)
+
+// RoleChangeUpdate is the builder for updating RoleChange entities.
+type RoleChangeUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RoleChangeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RoleChangeUpdate builder.
+func (rcu *RoleChangeUpdate) Where(ps ...predicate.RoleChange) *RoleChangeUpdate {
+	rcu.mutation.Where(ps...)
+	return rcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rcu *RoleChangeUpdate) SetUpdatedAt(t time.Time) *RoleChangeUpdate {
+	rcu.mutation.SetUpdatedAt(t)
+	return rcu
+}
+
+// Mutation returns the RoleChangeMutation object of the builder.
+func (rcu *RoleChangeUpdate) Mutation() *RoleChangeMutation {
+	return rcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rcu *RoleChangeUpdate) Save(ctx context.Context) (int, error) {
+	if err := rcu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, rcu.sqlSave, rcu.mutation, rcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcu *RoleChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := rcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rcu *RoleChangeUpdate) Exec(ctx context.Context) error {
+	_, err := rcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcu *RoleChangeUpdate) ExecX(ctx context.Context) {
+	if err := rcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcu *RoleChangeUpdate) defaults() error {
+	if _, ok := rcu.mutation.UpdatedAt(); !ok {
+		if rolechange.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized rolechange.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := rolechange.UpdateDefaultUpdatedAt()
+		rcu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcu *RoleChangeUpdate) check() error {
+	if rcu.mutation.UserCleared() && len(rcu.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "RoleChange.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rcu *RoleChangeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RoleChangeUpdate {
+	rcu.modifiers = append(rcu.modifiers, modifiers...)
+	return rcu
+}
+
+func (rcu *RoleChangeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rolechange.Table, rolechange.Columns, sqlgraph.NewFieldSpec(rolechange.FieldID, field.TypeUUID))
+	if ps := rcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(rolechange.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if rcu.mutation.ChangedByIDCleared() {
+		_spec.ClearField(rolechange.FieldChangedByID, field.TypeUUID)
+	}
+	_spec.AddModifiers(rcu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, rcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rolechange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rcu.mutation.done = true
+	return n, nil
+}
+
+// RoleChangeUpdateOne is the builder for updating a single RoleChange entity.
+type RoleChangeUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RoleChangeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rcuo *RoleChangeUpdateOne) SetUpdatedAt(t time.Time) *RoleChangeUpdateOne {
+	rcuo.mutation.SetUpdatedAt(t)
+	return rcuo
+}
+
+// Mutation returns the RoleChangeMutation object of the builder.
+func (rcuo *RoleChangeUpdateOne) Mutation() *RoleChangeMutation {
+	return rcuo.mutation
+}
+
+// Where appends a list predicates to the RoleChangeUpdate builder.
+func (rcuo *RoleChangeUpdateOne) Where(ps ...predicate.RoleChange) *RoleChangeUpdateOne {
+	rcuo.mutation.Where(ps...)
+	return rcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rcuo *RoleChangeUpdateOne) Select(field string, fields ...string) *RoleChangeUpdateOne {
+	rcuo.fields = append([]string{field}, fields...)
+	return rcuo
+}
+
+// Save executes the query and returns the updated RoleChange entity.
+func (rcuo *RoleChangeUpdateOne) Save(ctx context.Context) (*RoleChange, error) {
+	if err := rcuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, rcuo.sqlSave, rcuo.mutation, rcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcuo *RoleChangeUpdateOne) SaveX(ctx context.Context) *RoleChange {
+	node, err := rcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rcuo *RoleChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := rcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcuo *RoleChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := rcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcuo *RoleChangeUpdateOne) defaults() error {
+	if _, ok := rcuo.mutation.UpdatedAt(); !ok {
+		if rolechange.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized rolechange.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := rolechange.UpdateDefaultUpdatedAt()
+		rcuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcuo *RoleChangeUpdateOne) check() error {
+	if rcuo.mutation.UserCleared() && len(rcuo.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "RoleChange.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rcuo *RoleChangeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RoleChangeUpdateOne {
+	rcuo.modifiers = append(rcuo.modifiers, modifiers...)
+	return rcuo
+}
+
+func (rcuo *RoleChangeUpdateOne) sqlSave(ctx context.Context) (_node *RoleChange, err error) {
+	if err := rcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rolechange.Table, rolechange.Columns, sqlgraph.NewFieldSpec(rolechange.FieldID, field.TypeUUID))
+	id, ok := rcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "RoleChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rolechange.FieldID)
+		for _, f := range fields {
+			if !rolechange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != rolechange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(rolechange.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if rcuo.mutation.ChangedByIDCleared() {
+		_spec.ClearField(rolechange.FieldChangedByID, field.TypeUUID)
+	}
+	_spec.AddModifiers(rcuo.modifiers...)
+	_node = &RoleChange{config: rcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rolechange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rcuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,140 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/google/uuid"
+)
+
+// RolePermission is the model entity for the RolePermission schema.
+type RolePermission struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Role holds the value of the "role" field.
+	Role rolepermission.Role `json:"role,omitempty"`
+	// Permission holds the value of the "permission" field.
+	Permission   rolepermission.Permission `json:"permission,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RolePermission) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rolepermission.FieldRole, rolepermission.FieldPermission:
+			values[i] = new(sql.NullString)
+		case rolepermission.FieldUpdatedAt, rolepermission.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case rolepermission.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RolePermission fields.
+func (rp *RolePermission) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rolepermission.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rp.ID = *value
+			}
+		case rolepermission.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rp.UpdatedAt = value.Time
+			}
+		case rolepermission.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rp.CreatedAt = value.Time
+			}
+		case rolepermission.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				rp.Role = rolepermission.Role(value.String)
+			}
+		case rolepermission.FieldPermission:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field permission", values[i])
+			} else if value.Valid {
+				rp.Permission = rolepermission.Permission(value.String)
+			}
+		default:
+			rp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RolePermission.
+// This includes values selected through modifiers, order, etc.
+func (rp *RolePermission) Value(name string) (ent.Value, error) {
+	return rp.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RolePermission.
+// Note that you need to call RolePermission.Unwrap() before calling this method if this RolePermission
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rp *RolePermission) Update() *RolePermissionUpdateOne {
+	return NewRolePermissionClient(rp.config).UpdateOne(rp)
+}
+
+// Unwrap unwraps the RolePermission entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rp *RolePermission) Unwrap() *RolePermission {
+	_tx, ok := rp.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: RolePermission is not a transactional entity")
+	}
+	rp.config.driver = _tx.drv
+	return rp
+}
+
+// String implements the fmt.Stringer.
+func (rp *RolePermission) String() string {
+	var builder strings.Builder
+	builder.WriteString("RolePermission(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rp.ID))
+	builder.WriteString("updated_at=")
+	builder.WriteString(rp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", rp.Role))
+	builder.WriteString(", ")
+	builder.WriteString("permission=")
+	builder.WriteString(fmt.Sprintf("%v", rp.Permission))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RolePermissions is a parsable slice of RolePermission.
+type RolePermissions []*RolePermission
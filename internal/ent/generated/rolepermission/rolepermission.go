@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rolepermission
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the rolepermission type in the database.
+	Label = "role_permission"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
+	// FieldPermission holds the string denoting the permission field in the database.
+	FieldPermission = "permission"
+	// Table holds the table name of the rolepermission in the database.
+	Table = "role_permissions"
+)
+
+// Columns holds all SQL columns for rolepermission fields.
+var Columns = []string{
+	FieldID,
+	FieldUpdatedAt,
+	FieldCreatedAt,
+	FieldRole,
+	FieldPermission,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/caliecode/la-clipasa/internal/ent/generated/runtime"
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Role defines the type for the "role" enum field.
+type Role string
+
+// Role values.
+const (
+	RoleGUEST     Role = "GUEST"
+	RoleUSER      Role = "USER"
+	RoleADMIN     Role = "ADMIN"
+	RoleMODERATOR Role = "MODERATOR"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(r Role) error {
+	switch r {
+	case RoleGUEST, RoleUSER, RoleADMIN, RoleMODERATOR:
+		return nil
+	default:
+		return fmt.Errorf("rolepermission: invalid enum value for role field: %q", r)
+	}
+}
+
+// AllRoles returns all Role values.
+func AllRoles() []Role {
+	return []Role{
+		RoleGUEST,
+		RoleUSER,
+		RoleADMIN,
+		RoleMODERATOR,
+	}
+}
+
+// Permission defines the type for the "permission" enum field.
+type Permission string
+
+// Permission values.
+const (
+	PermissionPostsCategorize  Permission = "POSTS_CATEGORIZE"
+	PermissionPostsModerate    Permission = "POSTS_MODERATE"
+	PermissionPostsDelete      Permission = "POSTS_DELETE"
+	PermissionCategoriesManage Permission = "CATEGORIES_MANAGE"
+	PermissionUsersManage      Permission = "USERS_MANAGE"
+	PermissionUsersSuspend     Permission = "USERS_SUSPEND"
+	PermissionAwardsManage     Permission = "AWARDS_MANAGE"
+	PermissionRolesManage      Permission = "ROLES_MANAGE"
+)
+
+func (pe Permission) String() string {
+	return string(pe)
+}
+
+// PermissionValidator is a validator for the "permission" field enum values. It is called by the builders before save.
+func PermissionValidator(pe Permission) error {
+	switch pe {
+	case PermissionPostsCategorize, PermissionPostsModerate, PermissionPostsDelete, PermissionCategoriesManage, PermissionUsersManage, PermissionUsersSuspend, PermissionAwardsManage, PermissionRolesManage:
+		return nil
+	default:
+		return fmt.Errorf("rolepermission: invalid enum value for permission field: %q", pe)
+	}
+}
+
+// AllPermissions returns all Permission values.
+func AllPermissions() []Permission {
+	return []Permission{
+		PermissionPostsCategorize,
+		PermissionPostsModerate,
+		PermissionPostsDelete,
+		PermissionCategoriesManage,
+		PermissionUsersManage,
+		PermissionUsersSuspend,
+		PermissionAwardsManage,
+		PermissionRolesManage,
+	}
+}
+
+// OrderOption defines the ordering options for the RolePermission queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
+// ByPermission orders the results by the permission field.
+func ByPermission(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPermission, opts...).ToFunc()
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Role) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Role) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Role(str)
+	if err := RoleValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Role", str)
+	}
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Permission) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Permission) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Permission(str)
+	if err := PermissionValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Permission", str)
+	}
+	return nil
+}
@@ -0,0 +1,680 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/google/uuid"
+)
+
+// RolePermissionCreate is the builder for creating a RolePermission entity.
+type RolePermissionCreate struct {
+	config
+	mutation *RolePermissionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rpc *RolePermissionCreate) SetUpdatedAt(t time.Time) *RolePermissionCreate {
+	rpc.mutation.SetUpdatedAt(t)
+	return rpc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rpc *RolePermissionCreate) SetNillableUpdatedAt(t *time.Time) *RolePermissionCreate {
+	if t != nil {
+		rpc.SetUpdatedAt(*t)
+	}
+	return rpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rpc *RolePermissionCreate) SetCreatedAt(t time.Time) *RolePermissionCreate {
+	rpc.mutation.SetCreatedAt(t)
+	return rpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rpc *RolePermissionCreate) SetNillableCreatedAt(t *time.Time) *RolePermissionCreate {
+	if t != nil {
+		rpc.SetCreatedAt(*t)
+	}
+	return rpc
+}
+
+// SetRole sets the "role" field.
+func (rpc *RolePermissionCreate) SetRole(r rolepermission.Role) *RolePermissionCreate {
+	rpc.mutation.SetRole(r)
+	return rpc
+}
+
+// SetPermission sets the "permission" field.
+func (rpc *RolePermissionCreate) SetPermission(r rolepermission.Permission) *RolePermissionCreate {
+	rpc.mutation.SetPermission(r)
+	return rpc
+}
+
+// SetID sets the "id" field.
+func (rpc *RolePermissionCreate) SetID(u uuid.UUID) *RolePermissionCreate {
+	rpc.mutation.SetID(u)
+	return rpc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rpc *RolePermissionCreate) SetNillableID(u *uuid.UUID) *RolePermissionCreate {
+	if u != nil {
+		rpc.SetID(*u)
+	}
+	return rpc
+}
+
+// Mutation returns the RolePermissionMutation object of the builder.
+func (rpc *RolePermissionCreate) Mutation() *RolePermissionMutation {
+	return rpc.mutation
+}
+
+// Save creates the RolePermission in the database.
+func (rpc *RolePermissionCreate) Save(ctx context.Context) (*RolePermission, error) {
+	if err := rpc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, rpc.sqlSave, rpc.mutation, rpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rpc *RolePermissionCreate) SaveX(ctx context.Context) *RolePermission {
+	v, err := rpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rpc *RolePermissionCreate) Exec(ctx context.Context) error {
+	_, err := rpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpc *RolePermissionCreate) ExecX(ctx context.Context) {
+	if err := rpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rpc *RolePermissionCreate) defaults() error {
+	if _, ok := rpc.mutation.UpdatedAt(); !ok {
+		if rolepermission.DefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized rolepermission.DefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := rolepermission.DefaultUpdatedAt()
+		rpc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rpc.mutation.CreatedAt(); !ok {
+		if rolepermission.DefaultCreatedAt == nil {
+			return fmt.Errorf("generated: uninitialized rolepermission.DefaultCreatedAt (forgotten import generated/runtime?)")
+		}
+		v := rolepermission.DefaultCreatedAt()
+		rpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rpc.mutation.ID(); !ok {
+		if rolepermission.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized rolepermission.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := rolepermission.DefaultID()
+		rpc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rpc *RolePermissionCreate) check() error {
+	if _, ok := rpc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "RolePermission.updated_at"`)}
+	}
+	if _, ok := rpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "RolePermission.created_at"`)}
+	}
+	if _, ok := rpc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`generated: missing required field "RolePermission.role"`)}
+	}
+	if v, ok := rpc.mutation.Role(); ok {
+		if err := rolepermission.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`generated: validator failed for field "RolePermission.role": %w`, err)}
+		}
+	}
+	if _, ok := rpc.mutation.Permission(); !ok {
+		return &ValidationError{Name: "permission", err: errors.New(`generated: missing required field "RolePermission.permission"`)}
+	}
+	if v, ok := rpc.mutation.Permission(); ok {
+		if err := rolepermission.PermissionValidator(v); err != nil {
+			return &ValidationError{Name: "permission", err: fmt.Errorf(`generated: validator failed for field "RolePermission.permission": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rpc *RolePermissionCreate) sqlSave(ctx context.Context) (*RolePermission, error) {
+	if err := rpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rpc.mutation.id = &_node.ID
+	rpc.mutation.done = true
+	return _node, nil
+}
+
+func (rpc *RolePermissionCreate) createSpec() (*RolePermission, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RolePermission{config: rpc.config}
+		_spec = sqlgraph.NewCreateSpec(rolepermission.Table, sqlgraph.NewFieldSpec(rolepermission.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = rpc.conflict
+	if id, ok := rpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rpc.mutation.UpdatedAt(); ok {
+		_spec.SetField(rolepermission.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rpc.mutation.CreatedAt(); ok {
+		_spec.SetField(rolepermission.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rpc.mutation.Role(); ok {
+		_spec.SetField(rolepermission.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := rpc.mutation.Permission(); ok {
+		_spec.SetField(rolepermission.FieldPermission, field.TypeEnum, value)
+		_node.Permission = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RolePermission.Create().
+//		SetUpdatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RolePermissionUpsert) {
+//			SetUpdatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rpc *RolePermissionCreate) OnConflict(opts ...sql.ConflictOption) *RolePermissionUpsertOne {
+	rpc.conflict = opts
+	return &RolePermissionUpsertOne{
+		create: rpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RolePermission.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rpc *RolePermissionCreate) OnConflictColumns(columns ...string) *RolePermissionUpsertOne {
+	rpc.conflict = append(rpc.conflict, sql.ConflictColumns(columns...))
+	return &RolePermissionUpsertOne{
+		create: rpc,
+	}
+}
+
+type (
+	// RolePermissionUpsertOne is the builder for "upsert"-ing
+	//  one RolePermission node.
+	RolePermissionUpsertOne struct {
+		create *RolePermissionCreate
+	}
+
+	// RolePermissionUpsert is the "OnConflict" setter.
+	RolePermissionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RolePermissionUpsert) SetUpdatedAt(v time.Time) *RolePermissionUpsert {
+	u.Set(rolepermission.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RolePermissionUpsert) UpdateUpdatedAt() *RolePermissionUpsert {
+	u.SetExcluded(rolepermission.FieldUpdatedAt)
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *RolePermissionUpsert) SetRole(v rolepermission.Role) *RolePermissionUpsert {
+	u.Set(rolepermission.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *RolePermissionUpsert) UpdateRole() *RolePermissionUpsert {
+	u.SetExcluded(rolepermission.FieldRole)
+	return u
+}
+
+// SetPermission sets the "permission" field.
+func (u *RolePermissionUpsert) SetPermission(v rolepermission.Permission) *RolePermissionUpsert {
+	u.Set(rolepermission.FieldPermission, v)
+	return u
+}
+
+// UpdatePermission sets the "permission" field to the value that was provided on create.
+func (u *RolePermissionUpsert) UpdatePermission() *RolePermissionUpsert {
+	u.SetExcluded(rolepermission.FieldPermission)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RolePermission.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(rolepermission.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RolePermissionUpsertOne) UpdateNewValues() *RolePermissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(rolepermission.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(rolepermission.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RolePermission.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RolePermissionUpsertOne) Ignore() *RolePermissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RolePermissionUpsertOne) DoNothing() *RolePermissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RolePermissionCreate.OnConflict
+// documentation for more info.
+func (u *RolePermissionUpsertOne) Update(set func(*RolePermissionUpsert)) *RolePermissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RolePermissionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RolePermissionUpsertOne) SetUpdatedAt(v time.Time) *RolePermissionUpsertOne {
+	return u.Update(func(s *RolePermissionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RolePermissionUpsertOne) UpdateUpdatedAt() *RolePermissionUpsertOne {
+	return u.Update(func(s *RolePermissionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *RolePermissionUpsertOne) SetRole(v rolepermission.Role) *RolePermissionUpsertOne {
+	return u.Update(func(s *RolePermissionUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *RolePermissionUpsertOne) UpdateRole() *RolePermissionUpsertOne {
+	return u.Update(func(s *RolePermissionUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetPermission sets the "permission" field.
+func (u *RolePermissionUpsertOne) SetPermission(v rolepermission.Permission) *RolePermissionUpsertOne {
+	return u.Update(func(s *RolePermissionUpsert) {
+		s.SetPermission(v)
+	})
+}
+
+// UpdatePermission sets the "permission" field to the value that was provided on create.
+func (u *RolePermissionUpsertOne) UpdatePermission() *RolePermissionUpsertOne {
+	return u.Update(func(s *RolePermissionUpsert) {
+		s.UpdatePermission()
+	})
+}
+
+// Exec executes the query.
+func (u *RolePermissionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for RolePermissionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RolePermissionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RolePermissionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: RolePermissionUpsertOne.ID is not supported by MySQL driver. Use RolePermissionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RolePermissionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RolePermissionCreateBulk is the builder for creating many RolePermission entities in bulk.
+type RolePermissionCreateBulk struct {
+	config
+	err      error
+	builders []*RolePermissionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RolePermission entities in the database.
+func (rpcb *RolePermissionCreateBulk) Save(ctx context.Context) ([]*RolePermission, error) {
+	if rpcb.err != nil {
+		return nil, rpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rpcb.builders))
+	nodes := make([]*RolePermission, len(rpcb.builders))
+	mutators := make([]Mutator, len(rpcb.builders))
+	for i := range rpcb.builders {
+		func(i int, root context.Context) {
+			builder := rpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RolePermissionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rpcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpcb *RolePermissionCreateBulk) SaveX(ctx context.Context) []*RolePermission {
+	v, err := rpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rpcb *RolePermissionCreateBulk) Exec(ctx context.Context) error {
+	_, err := rpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpcb *RolePermissionCreateBulk) ExecX(ctx context.Context) {
+	if err := rpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RolePermission.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RolePermissionUpsert) {
+//			SetUpdatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rpcb *RolePermissionCreateBulk) OnConflict(opts ...sql.ConflictOption) *RolePermissionUpsertBulk {
+	rpcb.conflict = opts
+	return &RolePermissionUpsertBulk{
+		create: rpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RolePermission.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rpcb *RolePermissionCreateBulk) OnConflictColumns(columns ...string) *RolePermissionUpsertBulk {
+	rpcb.conflict = append(rpcb.conflict, sql.ConflictColumns(columns...))
+	return &RolePermissionUpsertBulk{
+		create: rpcb,
+	}
+}
+
+// RolePermissionUpsertBulk is the builder for "upsert"-ing
+// a bulk of RolePermission nodes.
+type RolePermissionUpsertBulk struct {
+	create *RolePermissionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RolePermission.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(rolepermission.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RolePermissionUpsertBulk) UpdateNewValues() *RolePermissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(rolepermission.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(rolepermission.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RolePermission.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RolePermissionUpsertBulk) Ignore() *RolePermissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RolePermissionUpsertBulk) DoNothing() *RolePermissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RolePermissionCreateBulk.OnConflict
+// documentation for more info.
+func (u *RolePermissionUpsertBulk) Update(set func(*RolePermissionUpsert)) *RolePermissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RolePermissionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RolePermissionUpsertBulk) SetUpdatedAt(v time.Time) *RolePermissionUpsertBulk {
+	return u.Update(func(s *RolePermissionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RolePermissionUpsertBulk) UpdateUpdatedAt() *RolePermissionUpsertBulk {
+	return u.Update(func(s *RolePermissionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *RolePermissionUpsertBulk) SetRole(v rolepermission.Role) *RolePermissionUpsertBulk {
+	return u.Update(func(s *RolePermissionUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *RolePermissionUpsertBulk) UpdateRole() *RolePermissionUpsertBulk {
+	return u.Update(func(s *RolePermissionUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetPermission sets the "permission" field.
+func (u *RolePermissionUpsertBulk) SetPermission(v rolepermission.Permission) *RolePermissionUpsertBulk {
+	return u.Update(func(s *RolePermissionUpsert) {
+		s.SetPermission(v)
+	})
+}
+
+// UpdatePermission sets the "permission" field to the value that was provided on create.
+func (u *RolePermissionUpsertBulk) UpdatePermission() *RolePermissionUpsertBulk {
+	return u.Update(func(s *RolePermissionUpsert) {
+		s.UpdatePermission()
+	})
+}
+
+// Exec executes the query.
+func (u *RolePermissionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the RolePermissionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for RolePermissionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RolePermissionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,344 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+)
+
+// RolePermissionUpdate is the builder for updating RolePermission entities.
+type RolePermissionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RolePermissionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RolePermissionUpdate builder.
+func (rpu *RolePermissionUpdate) Where(ps ...predicate.RolePermission) *RolePermissionUpdate {
+	rpu.mutation.Where(ps...)
+	return rpu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rpu *RolePermissionUpdate) SetUpdatedAt(t time.Time) *RolePermissionUpdate {
+	rpu.mutation.SetUpdatedAt(t)
+	return rpu
+}
+
+// SetRole sets the "role" field.
+func (rpu *RolePermissionUpdate) SetRole(r rolepermission.Role) *RolePermissionUpdate {
+	rpu.mutation.SetRole(r)
+	return rpu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (rpu *RolePermissionUpdate) SetNillableRole(r *rolepermission.Role) *RolePermissionUpdate {
+	if r != nil {
+		rpu.SetRole(*r)
+	}
+	return rpu
+}
+
+// SetPermission sets the "permission" field.
+func (rpu *RolePermissionUpdate) SetPermission(r rolepermission.Permission) *RolePermissionUpdate {
+	rpu.mutation.SetPermission(r)
+	return rpu
+}
+
+// SetNillablePermission sets the "permission" field if the given value is not nil.
+func (rpu *RolePermissionUpdate) SetNillablePermission(r *rolepermission.Permission) *RolePermissionUpdate {
+	if r != nil {
+		rpu.SetPermission(*r)
+	}
+	return rpu
+}
+
+// Mutation returns the RolePermissionMutation object of the builder.
+func (rpu *RolePermissionUpdate) Mutation() *RolePermissionMutation {
+	return rpu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rpu *RolePermissionUpdate) Save(ctx context.Context) (int, error) {
+	if err := rpu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, rpu.sqlSave, rpu.mutation, rpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpu *RolePermissionUpdate) SaveX(ctx context.Context) int {
+	affected, err := rpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rpu *RolePermissionUpdate) Exec(ctx context.Context) error {
+	_, err := rpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpu *RolePermissionUpdate) ExecX(ctx context.Context) {
+	if err := rpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rpu *RolePermissionUpdate) defaults() error {
+	if _, ok := rpu.mutation.UpdatedAt(); !ok {
+		if rolepermission.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized rolepermission.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := rolepermission.UpdateDefaultUpdatedAt()
+		rpu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rpu *RolePermissionUpdate) check() error {
+	if v, ok := rpu.mutation.Role(); ok {
+		if err := rolepermission.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`generated: validator failed for field "RolePermission.role": %w`, err)}
+		}
+	}
+	if v, ok := rpu.mutation.Permission(); ok {
+		if err := rolepermission.PermissionValidator(v); err != nil {
+			return &ValidationError{Name: "permission", err: fmt.Errorf(`generated: validator failed for field "RolePermission.permission": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rpu *RolePermissionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RolePermissionUpdate {
+	rpu.modifiers = append(rpu.modifiers, modifiers...)
+	return rpu
+}
+
+func (rpu *RolePermissionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rolepermission.Table, rolepermission.Columns, sqlgraph.NewFieldSpec(rolepermission.FieldID, field.TypeUUID))
+	if ps := rpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rpu.mutation.UpdatedAt(); ok {
+		_spec.SetField(rolepermission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rpu.mutation.Role(); ok {
+		_spec.SetField(rolepermission.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := rpu.mutation.Permission(); ok {
+		_spec.SetField(rolepermission.FieldPermission, field.TypeEnum, value)
+	}
+	_spec.AddModifiers(rpu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, rpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rolepermission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rpu.mutation.done = true
+	return n, nil
+}
+
+// RolePermissionUpdateOne is the builder for updating a single RolePermission entity.
+type RolePermissionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RolePermissionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rpuo *RolePermissionUpdateOne) SetUpdatedAt(t time.Time) *RolePermissionUpdateOne {
+	rpuo.mutation.SetUpdatedAt(t)
+	return rpuo
+}
+
+// SetRole sets the "role" field.
+func (rpuo *RolePermissionUpdateOne) SetRole(r rolepermission.Role) *RolePermissionUpdateOne {
+	rpuo.mutation.SetRole(r)
+	return rpuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (rpuo *RolePermissionUpdateOne) SetNillableRole(r *rolepermission.Role) *RolePermissionUpdateOne {
+	if r != nil {
+		rpuo.SetRole(*r)
+	}
+	return rpuo
+}
+
+// SetPermission sets the "permission" field.
+func (rpuo *RolePermissionUpdateOne) SetPermission(r rolepermission.Permission) *RolePermissionUpdateOne {
+	rpuo.mutation.SetPermission(r)
+	return rpuo
+}
+
+// SetNillablePermission sets the "permission" field if the given value is not nil.
+func (rpuo *RolePermissionUpdateOne) SetNillablePermission(r *rolepermission.Permission) *RolePermissionUpdateOne {
+	if r != nil {
+		rpuo.SetPermission(*r)
+	}
+	return rpuo
+}
+
+// Mutation returns the RolePermissionMutation object of the builder.
+func (rpuo *RolePermissionUpdateOne) Mutation() *RolePermissionMutation {
+	return rpuo.mutation
+}
+
+// Where appends a list predicates to the RolePermissionUpdate builder.
+func (rpuo *RolePermissionUpdateOne) Where(ps ...predicate.RolePermission) *RolePermissionUpdateOne {
+	rpuo.mutation.Where(ps...)
+	return rpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rpuo *RolePermissionUpdateOne) Select(field string, fields ...string) *RolePermissionUpdateOne {
+	rpuo.fields = append([]string{field}, fields...)
+	return rpuo
+}
+
+// Save executes the query and returns the updated RolePermission entity.
+func (rpuo *RolePermissionUpdateOne) Save(ctx context.Context) (*RolePermission, error) {
+	if err := rpuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, rpuo.sqlSave, rpuo.mutation, rpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpuo *RolePermissionUpdateOne) SaveX(ctx context.Context) *RolePermission {
+	node, err := rpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rpuo *RolePermissionUpdateOne) Exec(ctx context.Context) error {
+	_, err := rpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpuo *RolePermissionUpdateOne) ExecX(ctx context.Context) {
+	if err := rpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rpuo *RolePermissionUpdateOne) defaults() error {
+	if _, ok := rpuo.mutation.UpdatedAt(); !ok {
+		if rolepermission.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized rolepermission.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := rolepermission.UpdateDefaultUpdatedAt()
+		rpuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rpuo *RolePermissionUpdateOne) check() error {
+	if v, ok := rpuo.mutation.Role(); ok {
+		if err := rolepermission.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`generated: validator failed for field "RolePermission.role": %w`, err)}
+		}
+	}
+	if v, ok := rpuo.mutation.Permission(); ok {
+		if err := rolepermission.PermissionValidator(v); err != nil {
+			return &ValidationError{Name: "permission", err: fmt.Errorf(`generated: validator failed for field "RolePermission.permission": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rpuo *RolePermissionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RolePermissionUpdateOne {
+	rpuo.modifiers = append(rpuo.modifiers, modifiers...)
+	return rpuo
+}
+
+func (rpuo *RolePermissionUpdateOne) sqlSave(ctx context.Context) (_node *RolePermission, err error) {
+	if err := rpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rolepermission.Table, rolepermission.Columns, sqlgraph.NewFieldSpec(rolepermission.FieldID, field.TypeUUID))
+	id, ok := rpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "RolePermission.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rolepermission.FieldID)
+		for _, f := range fields {
+			if !rolepermission.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != rolepermission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rpuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(rolepermission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rpuo.mutation.Role(); ok {
+		_spec.SetField(rolepermission.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := rpuo.mutation.Permission(); ok {
+		_spec.SetField(rolepermission.FieldPermission, field.TypeEnum, value)
+	}
+	_spec.AddModifiers(rpuo.modifiers...)
+	_node = &RolePermission{config: rpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rolepermission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rpuo.mutation.done = true
+	return _node, nil
+}
@@ -7,11 +7,19 @@ import (
 	"time"
 
 	"github.com/caliecode/la-clipasa/internal/ent/generated/apikey"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/categoryrestriction"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/comment"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/post"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/postcategory"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/refreshtoken"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/twitchtoken"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
 	"github.com/caliecode/la-clipasa/internal/ent/schema"
 	"github.com/google/uuid"
 
@@ -54,19 +62,139 @@ func init() {
 	apikeyDescCreatedAt := apikeyMixinFields0[1].Descriptor()
 	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
 	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[0].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = apikeyDescName.Validators[0].(func(string) error)
+	// apikeyDescPrefix is the schema descriptor for prefix field.
+	apikeyDescPrefix := apikeyFields[1].Descriptor()
+	// apikey.PrefixValidator is a validator for the "prefix" field. It is called by the builders before save.
+	apikey.PrefixValidator = apikeyDescPrefix.Validators[0].(func(string) error)
+	// apikeyDescKeyHash is the schema descriptor for key_hash field.
+	apikeyDescKeyHash := apikeyFields[2].Descriptor()
+	// apikey.KeyHashValidator is a validator for the "key_hash" field. It is called by the builders before save.
+	apikey.KeyHashValidator = apikeyDescKeyHash.Validators[0].(func(string) error)
+	// apikeyDescScopes is the schema descriptor for scopes field.
+	apikeyDescScopes := apikeyFields[3].Descriptor()
+	// apikey.DefaultScopes holds the default value on creation for the scopes field.
+	apikey.DefaultScopes = apikeyDescScopes.Default.([]string)
+	// apikey.ScopesValidator is a validator for the "scopes" field. It is called by the builders before save.
+	apikey.ScopesValidator = apikeyDescScopes.Validators[0].(func([]string) error)
 	// apikeyDescID is the schema descriptor for id field.
 	apikeyDescID := apikeyMixinFields1[0].Descriptor()
 	// apikey.DefaultID holds the default value on creation for the id field.
 	apikey.DefaultID = apikeyDescID.Default.(func() uuid.UUID)
+	awarddefinitionMixin := schema.AwardDefinition{}.Mixin()
+	awarddefinition.Policy = privacy.NewPolicies(schema.AwardDefinition{})
+	awarddefinition.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := awarddefinition.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	awarddefinitionHooks := schema.AwardDefinition{}.Hooks()
+
+	awarddefinition.Hooks[1] = awarddefinitionHooks[0]
+	awarddefinitionMixinFields0 := awarddefinitionMixin[0].Fields()
+	_ = awarddefinitionMixinFields0
+	awarddefinitionMixinFields1 := awarddefinitionMixin[1].Fields()
+	_ = awarddefinitionMixinFields1
+	awarddefinitionFields := schema.AwardDefinition{}.Fields()
+	_ = awarddefinitionFields
+	// awarddefinitionDescUpdatedAt is the schema descriptor for updated_at field.
+	awarddefinitionDescUpdatedAt := awarddefinitionMixinFields0[0].Descriptor()
+	// awarddefinition.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	awarddefinition.DefaultUpdatedAt = awarddefinitionDescUpdatedAt.Default.(func() time.Time)
+	// awarddefinition.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	awarddefinition.UpdateDefaultUpdatedAt = awarddefinitionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// awarddefinitionDescCreatedAt is the schema descriptor for created_at field.
+	awarddefinitionDescCreatedAt := awarddefinitionMixinFields0[1].Descriptor()
+	// awarddefinition.DefaultCreatedAt holds the default value on creation for the created_at field.
+	awarddefinition.DefaultCreatedAt = awarddefinitionDescCreatedAt.Default.(func() time.Time)
+	// awarddefinitionDescName is the schema descriptor for name field.
+	awarddefinitionDescName := awarddefinitionFields[0].Descriptor()
+	// awarddefinition.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	awarddefinition.NameValidator = awarddefinitionDescName.Validators[0].(func(string) error)
+	// awarddefinitionDescRuleThreshold is the schema descriptor for rule_threshold field.
+	awarddefinitionDescRuleThreshold := awarddefinitionFields[5].Descriptor()
+	// awarddefinition.DefaultRuleThreshold holds the default value on creation for the rule_threshold field.
+	awarddefinition.DefaultRuleThreshold = awarddefinitionDescRuleThreshold.Default.(int)
+	// awarddefinition.RuleThresholdValidator is a validator for the "rule_threshold" field. It is called by the builders before save.
+	awarddefinition.RuleThresholdValidator = awarddefinitionDescRuleThreshold.Validators[0].(func(int) error)
+	// awarddefinitionDescID is the schema descriptor for id field.
+	awarddefinitionDescID := awarddefinitionMixinFields1[0].Descriptor()
+	// awarddefinition.DefaultID holds the default value on creation for the id field.
+	awarddefinition.DefaultID = awarddefinitionDescID.Default.(func() uuid.UUID)
+	categoryrestrictionMixin := schema.CategoryRestriction{}.Mixin()
+	categoryrestriction.Policy = privacy.NewPolicies(schema.CategoryRestriction{})
+	categoryrestriction.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := categoryrestriction.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	categoryrestrictionHooks := schema.CategoryRestriction{}.Hooks()
+
+	categoryrestriction.Hooks[1] = categoryrestrictionHooks[0]
+	categoryrestrictionMixinFields0 := categoryrestrictionMixin[0].Fields()
+	_ = categoryrestrictionMixinFields0
+	categoryrestrictionMixinFields1 := categoryrestrictionMixin[1].Fields()
+	_ = categoryrestrictionMixinFields1
+	categoryrestrictionFields := schema.CategoryRestriction{}.Fields()
+	_ = categoryrestrictionFields
+	// categoryrestrictionDescUpdatedAt is the schema descriptor for updated_at field.
+	categoryrestrictionDescUpdatedAt := categoryrestrictionMixinFields0[0].Descriptor()
+	// categoryrestriction.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	categoryrestriction.DefaultUpdatedAt = categoryrestrictionDescUpdatedAt.Default.(func() time.Time)
+	// categoryrestriction.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	categoryrestriction.UpdateDefaultUpdatedAt = categoryrestrictionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// categoryrestrictionDescCreatedAt is the schema descriptor for created_at field.
+	categoryrestrictionDescCreatedAt := categoryrestrictionMixinFields0[1].Descriptor()
+	// categoryrestriction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	categoryrestriction.DefaultCreatedAt = categoryrestrictionDescCreatedAt.Default.(func() time.Time)
+	// categoryrestrictionDescSubscriberOnlyPost is the schema descriptor for subscriber_only_post field.
+	categoryrestrictionDescSubscriberOnlyPost := categoryrestrictionFields[1].Descriptor()
+	// categoryrestriction.DefaultSubscriberOnlyPost holds the default value on creation for the subscriber_only_post field.
+	categoryrestriction.DefaultSubscriberOnlyPost = categoryrestrictionDescSubscriberOnlyPost.Default.(bool)
+	// categoryrestrictionDescSubscriberOnlyView is the schema descriptor for subscriber_only_view field.
+	categoryrestrictionDescSubscriberOnlyView := categoryrestrictionFields[2].Descriptor()
+	// categoryrestriction.DefaultSubscriberOnlyView holds the default value on creation for the subscriber_only_view field.
+	categoryrestriction.DefaultSubscriberOnlyView = categoryrestrictionDescSubscriberOnlyView.Default.(bool)
+	// categoryrestrictionDescID is the schema descriptor for id field.
+	categoryrestrictionDescID := categoryrestrictionMixinFields1[0].Descriptor()
+	// categoryrestriction.DefaultID holds the default value on creation for the id field.
+	categoryrestriction.DefaultID = categoryrestrictionDescID.Default.(func() uuid.UUID)
 	commentMixin := schema.Comment{}.Mixin()
+	comment.Policy = privacy.NewPolicies(schema.Comment{})
+	comment.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := comment.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
 	commentMixinHooks2 := commentMixin[2].Hooks()
 	commentMixinHooks3 := commentMixin[3].Hooks()
-	comment.Hooks[0] = commentMixinHooks2[0]
-	comment.Hooks[1] = commentMixinHooks3[0]
+	commentHooks := schema.Comment{}.Hooks()
+
+	comment.Hooks[1] = commentMixinHooks2[0]
+
+	comment.Hooks[2] = commentMixinHooks3[0]
+
+	comment.Hooks[3] = commentHooks[0]
 	commentMixinInters2 := commentMixin[2].Interceptors()
 	commentMixinInters3 := commentMixin[3].Interceptors()
+	commentMixinInters4 := commentMixin[4].Interceptors()
+	commentMixinInters5 := commentMixin[5].Interceptors()
 	comment.Interceptors[0] = commentMixinInters2[0]
 	comment.Interceptors[1] = commentMixinInters3[0]
+	comment.Interceptors[2] = commentMixinInters4[0]
+	comment.Interceptors[3] = commentMixinInters5[0]
 	commentMixinFields0 := commentMixin[0].Fields()
 	_ = commentMixinFields0
 	commentMixinFields1 := commentMixin[1].Fields()
@@ -91,6 +219,45 @@ func init() {
 	commentDescID := commentMixinFields1[0].Descriptor()
 	// comment.DefaultID holds the default value on creation for the id field.
 	comment.DefaultID = commentDescID.Default.(func() uuid.UUID)
+	identityMixin := schema.Identity{}.Mixin()
+	identity.Policy = privacy.NewPolicies(schema.Identity{})
+	identity.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := identity.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	identityMixinHooks2 := identityMixin[2].Hooks()
+
+	identity.Hooks[1] = identityMixinHooks2[0]
+	identityMixinInters2 := identityMixin[2].Interceptors()
+	identity.Interceptors[0] = identityMixinInters2[0]
+	identityMixinFields0 := identityMixin[0].Fields()
+	_ = identityMixinFields0
+	identityMixinFields1 := identityMixin[1].Fields()
+	_ = identityMixinFields1
+	identityFields := schema.Identity{}.Fields()
+	_ = identityFields
+	// identityDescUpdatedAt is the schema descriptor for updated_at field.
+	identityDescUpdatedAt := identityMixinFields0[0].Descriptor()
+	// identity.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	identity.DefaultUpdatedAt = identityDescUpdatedAt.Default.(func() time.Time)
+	// identity.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	identity.UpdateDefaultUpdatedAt = identityDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// identityDescCreatedAt is the schema descriptor for created_at field.
+	identityDescCreatedAt := identityMixinFields0[1].Descriptor()
+	// identity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	identity.DefaultCreatedAt = identityDescCreatedAt.Default.(func() time.Time)
+	// identityDescSubject is the schema descriptor for subject field.
+	identityDescSubject := identityFields[1].Descriptor()
+	// identity.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	identity.SubjectValidator = identityDescSubject.Validators[0].(func(string) error)
+	// identityDescID is the schema descriptor for id field.
+	identityDescID := identityMixinFields1[0].Descriptor()
+	// identity.DefaultID holds the default value on creation for the id field.
+	identity.DefaultID = identityDescID.Default.(func() uuid.UUID)
 	postMixin := schema.Post{}.Mixin()
 	post.Policy = privacy.NewPolicies(schema.Post{})
 	post.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -110,10 +277,16 @@ func init() {
 	post.Hooks[2] = postMixinHooks3[0]
 
 	post.Hooks[3] = postHooks[0]
+
+	post.Hooks[4] = postHooks[1]
 	postMixinInters2 := postMixin[2].Interceptors()
 	postMixinInters3 := postMixin[3].Interceptors()
+	postMixinInters4 := postMixin[4].Interceptors()
+	postMixinInters5 := postMixin[5].Interceptors()
 	post.Interceptors[0] = postMixinInters2[0]
 	post.Interceptors[1] = postMixinInters3[0]
+	post.Interceptors[2] = postMixinInters4[0]
+	post.Interceptors[3] = postMixinInters5[0]
 	postMixinFields0 := postMixin[0].Fields()
 	_ = postMixinFields0
 	postMixinFields1 := postMixin[1].Fields()
@@ -151,6 +324,18 @@ func init() {
 	// post.DefaultID holds the default value on creation for the id field.
 	post.DefaultID = postDescID.Default.(func() uuid.UUID)
 	postcategoryMixin := schema.PostCategory{}.Mixin()
+	postcategory.Policy = privacy.NewPolicies(schema.PostCategory{})
+	postcategory.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := postcategory.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	postcategoryHooks := schema.PostCategory{}.Hooks()
+
+	postcategory.Hooks[1] = postcategoryHooks[0]
 	postcategoryMixinFields0 := postcategoryMixin[0].Fields()
 	_ = postcategoryMixinFields0
 	postcategoryMixinFields1 := postcategoryMixin[1].Fields()
@@ -208,10 +393,152 @@ func init() {
 	refreshtokenDescRevoked := refreshtokenFields[3].Descriptor()
 	// refreshtoken.DefaultRevoked holds the default value on creation for the revoked field.
 	refreshtoken.DefaultRevoked = refreshtokenDescRevoked.Default.(bool)
+	// refreshtokenDescFamilyID is the schema descriptor for family_id field.
+	refreshtokenDescFamilyID := refreshtokenFields[6].Descriptor()
+	// refreshtoken.DefaultFamilyID holds the default value on creation for the family_id field.
+	refreshtoken.DefaultFamilyID = refreshtokenDescFamilyID.Default.(func() uuid.UUID)
 	// refreshtokenDescID is the schema descriptor for id field.
 	refreshtokenDescID := refreshtokenFields[0].Descriptor()
 	// refreshtoken.DefaultID holds the default value on creation for the id field.
 	refreshtoken.DefaultID = refreshtokenDescID.Default.(func() uuid.UUID)
+	rolechangeMixin := schema.RoleChange{}.Mixin()
+	rolechange.Policy = privacy.NewPolicies(schema.RoleChange{})
+	rolechange.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := rolechange.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	rolechangeMixinFields0 := rolechangeMixin[0].Fields()
+	_ = rolechangeMixinFields0
+	rolechangeMixinFields1 := rolechangeMixin[1].Fields()
+	_ = rolechangeMixinFields1
+	rolechangeFields := schema.RoleChange{}.Fields()
+	_ = rolechangeFields
+	// rolechangeDescUpdatedAt is the schema descriptor for updated_at field.
+	rolechangeDescUpdatedAt := rolechangeMixinFields0[0].Descriptor()
+	// rolechange.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	rolechange.DefaultUpdatedAt = rolechangeDescUpdatedAt.Default.(func() time.Time)
+	// rolechange.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	rolechange.UpdateDefaultUpdatedAt = rolechangeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// rolechangeDescCreatedAt is the schema descriptor for created_at field.
+	rolechangeDescCreatedAt := rolechangeMixinFields0[1].Descriptor()
+	// rolechange.DefaultCreatedAt holds the default value on creation for the created_at field.
+	rolechange.DefaultCreatedAt = rolechangeDescCreatedAt.Default.(func() time.Time)
+	// rolechangeDescID is the schema descriptor for id field.
+	rolechangeDescID := rolechangeMixinFields1[0].Descriptor()
+	// rolechange.DefaultID holds the default value on creation for the id field.
+	rolechange.DefaultID = rolechangeDescID.Default.(func() uuid.UUID)
+	rolepermissionMixin := schema.RolePermission{}.Mixin()
+	rolepermission.Policy = privacy.NewPolicies(schema.RolePermission{})
+	rolepermission.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := rolepermission.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	rolepermissionHooks := schema.RolePermission{}.Hooks()
+
+	rolepermission.Hooks[1] = rolepermissionHooks[0]
+	rolepermissionMixinFields0 := rolepermissionMixin[0].Fields()
+	_ = rolepermissionMixinFields0
+	rolepermissionMixinFields1 := rolepermissionMixin[1].Fields()
+	_ = rolepermissionMixinFields1
+	rolepermissionFields := schema.RolePermission{}.Fields()
+	_ = rolepermissionFields
+	// rolepermissionDescUpdatedAt is the schema descriptor for updated_at field.
+	rolepermissionDescUpdatedAt := rolepermissionMixinFields0[0].Descriptor()
+	// rolepermission.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	rolepermission.DefaultUpdatedAt = rolepermissionDescUpdatedAt.Default.(func() time.Time)
+	// rolepermission.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	rolepermission.UpdateDefaultUpdatedAt = rolepermissionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// rolepermissionDescCreatedAt is the schema descriptor for created_at field.
+	rolepermissionDescCreatedAt := rolepermissionMixinFields0[1].Descriptor()
+	// rolepermission.DefaultCreatedAt holds the default value on creation for the created_at field.
+	rolepermission.DefaultCreatedAt = rolepermissionDescCreatedAt.Default.(func() time.Time)
+	// rolepermissionDescID is the schema descriptor for id field.
+	rolepermissionDescID := rolepermissionMixinFields1[0].Descriptor()
+	// rolepermission.DefaultID holds the default value on creation for the id field.
+	rolepermission.DefaultID = rolepermissionDescID.Default.(func() uuid.UUID)
+	suspensionMixin := schema.Suspension{}.Mixin()
+	suspension.Policy = privacy.NewPolicies(schema.Suspension{})
+	suspension.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := suspension.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	suspensionHooks := schema.Suspension{}.Hooks()
+
+	suspension.Hooks[1] = suspensionHooks[0]
+	suspensionMixinFields0 := suspensionMixin[0].Fields()
+	_ = suspensionMixinFields0
+	suspensionMixinFields1 := suspensionMixin[1].Fields()
+	_ = suspensionMixinFields1
+	suspensionFields := schema.Suspension{}.Fields()
+	_ = suspensionFields
+	// suspensionDescUpdatedAt is the schema descriptor for updated_at field.
+	suspensionDescUpdatedAt := suspensionMixinFields0[0].Descriptor()
+	// suspension.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	suspension.DefaultUpdatedAt = suspensionDescUpdatedAt.Default.(func() time.Time)
+	// suspension.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	suspension.UpdateDefaultUpdatedAt = suspensionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// suspensionDescCreatedAt is the schema descriptor for created_at field.
+	suspensionDescCreatedAt := suspensionMixinFields0[1].Descriptor()
+	// suspension.DefaultCreatedAt holds the default value on creation for the created_at field.
+	suspension.DefaultCreatedAt = suspensionDescCreatedAt.Default.(func() time.Time)
+	// suspensionDescReason is the schema descriptor for reason field.
+	suspensionDescReason := suspensionFields[2].Descriptor()
+	// suspension.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	suspension.ReasonValidator = suspensionDescReason.Validators[0].(func(string) error)
+	// suspensionDescStartsAt is the schema descriptor for starts_at field.
+	suspensionDescStartsAt := suspensionFields[4].Descriptor()
+	// suspension.DefaultStartsAt holds the default value on creation for the starts_at field.
+	suspension.DefaultStartsAt = suspensionDescStartsAt.Default.(func() time.Time)
+	// suspensionDescID is the schema descriptor for id field.
+	suspensionDescID := suspensionMixinFields1[0].Descriptor()
+	// suspension.DefaultID holds the default value on creation for the id field.
+	suspension.DefaultID = suspensionDescID.Default.(func() uuid.UUID)
+	twitchtokenMixin := schema.TwitchToken{}.Mixin()
+	twitchtoken.Policy = privacy.NewPolicies(schema.TwitchToken{})
+	twitchtoken.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := twitchtoken.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	twitchtokenMixinFields0 := twitchtokenMixin[0].Fields()
+	_ = twitchtokenMixinFields0
+	twitchtokenMixinFields1 := twitchtokenMixin[1].Fields()
+	_ = twitchtokenMixinFields1
+	twitchtokenFields := schema.TwitchToken{}.Fields()
+	_ = twitchtokenFields
+	// twitchtokenDescUpdatedAt is the schema descriptor for updated_at field.
+	twitchtokenDescUpdatedAt := twitchtokenMixinFields0[0].Descriptor()
+	// twitchtoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	twitchtoken.DefaultUpdatedAt = twitchtokenDescUpdatedAt.Default.(func() time.Time)
+	// twitchtoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	twitchtoken.UpdateDefaultUpdatedAt = twitchtokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// twitchtokenDescCreatedAt is the schema descriptor for created_at field.
+	twitchtokenDescCreatedAt := twitchtokenMixinFields0[1].Descriptor()
+	// twitchtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	twitchtoken.DefaultCreatedAt = twitchtokenDescCreatedAt.Default.(func() time.Time)
+	// twitchtokenDescTwitchUserID is the schema descriptor for twitch_user_id field.
+	twitchtokenDescTwitchUserID := twitchtokenFields[0].Descriptor()
+	// twitchtoken.TwitchUserIDValidator is a validator for the "twitch_user_id" field. It is called by the builders before save.
+	twitchtoken.TwitchUserIDValidator = twitchtokenDescTwitchUserID.Validators[0].(func(string) error)
+	// twitchtokenDescID is the schema descriptor for id field.
+	twitchtokenDescID := twitchtokenMixinFields1[0].Descriptor()
+	// twitchtoken.DefaultID holds the default value on creation for the id field.
+	twitchtoken.DefaultID = twitchtokenDescID.Default.(func() uuid.UUID)
 	userMixin := schema.User{}.Mixin()
 	user.Policy = privacy.NewPolicies(schema.User{})
 	user.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -228,6 +555,10 @@ func init() {
 	user.Hooks[1] = userMixinHooks2[0]
 
 	user.Hooks[2] = userHooks[0]
+
+	user.Hooks[3] = userHooks[1]
+
+	user.Hooks[4] = userHooks[2]
 	userMixinInters2 := userMixin[2].Interceptors()
 	user.Interceptors[0] = userMixinInters2[0]
 	userMixinFields0 := userMixin[0].Fields()
@@ -246,14 +577,57 @@ func init() {
 	userDescCreatedAt := userMixinFields0[1].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescTwitchVip is the schema descriptor for twitch_vip field.
+	userDescTwitchVip := userFields[7].Descriptor()
+	// user.DefaultTwitchVip holds the default value on creation for the twitch_vip field.
+	user.DefaultTwitchVip = userDescTwitchVip.Default.(bool)
 	// userDescLastSeenAt is the schema descriptor for last_seen_at field.
-	userDescLastSeenAt := userFields[6].Descriptor()
+	userDescLastSeenAt := userFields[8].Descriptor()
 	// user.UpdateDefaultLastSeenAt holds the default value on update for the last_seen_at field.
 	user.UpdateDefaultLastSeenAt = userDescLastSeenAt.UpdateDefault.(func() time.Time)
 	// userDescID is the schema descriptor for id field.
 	userDescID := userMixinFields1[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	userawardMixin := schema.UserAward{}.Mixin()
+	useraward.Policy = privacy.NewPolicies(schema.UserAward{})
+	useraward.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := useraward.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	userawardMixinHooks2 := userawardMixin[2].Hooks()
+
+	useraward.Hooks[1] = userawardMixinHooks2[0]
+	userawardMixinInters2 := userawardMixin[2].Interceptors()
+	useraward.Interceptors[0] = userawardMixinInters2[0]
+	userawardMixinFields0 := userawardMixin[0].Fields()
+	_ = userawardMixinFields0
+	userawardMixinFields1 := userawardMixin[1].Fields()
+	_ = userawardMixinFields1
+	userawardFields := schema.UserAward{}.Fields()
+	_ = userawardFields
+	// userawardDescUpdatedAt is the schema descriptor for updated_at field.
+	userawardDescUpdatedAt := userawardMixinFields0[0].Descriptor()
+	// useraward.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	useraward.DefaultUpdatedAt = userawardDescUpdatedAt.Default.(func() time.Time)
+	// useraward.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	useraward.UpdateDefaultUpdatedAt = userawardDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userawardDescCreatedAt is the schema descriptor for created_at field.
+	userawardDescCreatedAt := userawardMixinFields0[1].Descriptor()
+	// useraward.DefaultCreatedAt holds the default value on creation for the created_at field.
+	useraward.DefaultCreatedAt = userawardDescCreatedAt.Default.(func() time.Time)
+	// userawardDescGrantedAt is the schema descriptor for granted_at field.
+	userawardDescGrantedAt := userawardFields[1].Descriptor()
+	// useraward.DefaultGrantedAt holds the default value on creation for the granted_at field.
+	useraward.DefaultGrantedAt = userawardDescGrantedAt.Default.(func() time.Time)
+	// userawardDescID is the schema descriptor for id field.
+	userawardDescID := userawardMixinFields1[0].Descriptor()
+	// useraward.DefaultID holds the default value on creation for the id field.
+	useraward.DefaultID = userawardDescID.Default.(func() uuid.UUID)
 }
 
 const (
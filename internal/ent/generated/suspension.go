@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/google/uuid"
+)
+
+// Suspension is the model entity for the Suspension schema.
+type Suspension struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// IssuerID holds the value of the "issuer_id" field.
+	IssuerID *uuid.UUID `json:"issuer_id,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Scope holds the value of the "scope" field.
+	Scope suspension.Scope `json:"scope,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	StartsAt time.Time `json:"starts_at,omitempty"`
+	// EndsAt holds the value of the "ends_at" field.
+	EndsAt *time.Time `json:"ends_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SuspensionQuery when eager-loading is set.
+	Edges        SuspensionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SuspensionEdges holds the relations/edges for other nodes in the graph.
+type SuspensionEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Issuer holds the value of the issuer edge.
+	Issuer *User `json:"issuer,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SuspensionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// IssuerOrErr returns the Issuer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SuspensionEdges) IssuerOrErr() (*User, error) {
+	if e.Issuer != nil {
+		return e.Issuer, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "issuer"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Suspension) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case suspension.FieldIssuerID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case suspension.FieldReason, suspension.FieldScope:
+			values[i] = new(sql.NullString)
+		case suspension.FieldUpdatedAt, suspension.FieldCreatedAt, suspension.FieldStartsAt, suspension.FieldEndsAt:
+			values[i] = new(sql.NullTime)
+		case suspension.FieldID, suspension.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Suspension fields.
+func (s *Suspension) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case suspension.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				s.ID = *value
+			}
+		case suspension.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
+		case suspension.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case suspension.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				s.UserID = *value
+			}
+		case suspension.FieldIssuerID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field issuer_id", values[i])
+			} else if value.Valid {
+				s.IssuerID = new(uuid.UUID)
+				*s.IssuerID = *value.S.(*uuid.UUID)
+			}
+		case suspension.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				s.Reason = value.String
+			}
+		case suspension.FieldScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope", values[i])
+			} else if value.Valid {
+				s.Scope = suspension.Scope(value.String)
+			}
+		case suspension.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				s.StartsAt = value.Time
+			}
+		case suspension.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				s.EndsAt = new(time.Time)
+				*s.EndsAt = value.Time
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Suspension.
+// This includes values selected through modifiers, order, etc.
+func (s *Suspension) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Suspension entity.
+func (s *Suspension) QueryUser() *UserQuery {
+	return NewSuspensionClient(s.config).QueryUser(s)
+}
+
+// QueryIssuer queries the "issuer" edge of the Suspension entity.
+func (s *Suspension) QueryIssuer() *UserQuery {
+	return NewSuspensionClient(s.config).QueryIssuer(s)
+}
+
+// Update returns a builder for updating this Suspension.
+// Note that you need to call Suspension.Unwrap() before calling this method if this Suspension
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Suspension) Update() *SuspensionUpdateOne {
+	return NewSuspensionClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Suspension entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Suspension) Unwrap() *Suspension {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: Suspension is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Suspension) String() string {
+	var builder strings.Builder
+	builder.WriteString("Suspension(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.UserID))
+	builder.WriteString(", ")
+	if v := s.IssuerID; v != nil {
+		builder.WriteString("issuer_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(s.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("scope=")
+	builder.WriteString(fmt.Sprintf("%v", s.Scope))
+	builder.WriteString(", ")
+	builder.WriteString("starts_at=")
+	builder.WriteString(s.StartsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := s.EndsAt; v != nil {
+		builder.WriteString("ends_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Suspensions is a parsable slice of Suspension.
+type Suspensions []*Suspension
@@ -0,0 +1,921 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/google/uuid"
+)
+
+// SuspensionCreate is the builder for creating a Suspension entity.
+type SuspensionCreate struct {
+	config
+	mutation *SuspensionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *SuspensionCreate) SetUpdatedAt(t time.Time) *SuspensionCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *SuspensionCreate) SetNillableUpdatedAt(t *time.Time) *SuspensionCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *SuspensionCreate) SetCreatedAt(t time.Time) *SuspensionCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *SuspensionCreate) SetNillableCreatedAt(t *time.Time) *SuspensionCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUserID sets the "user_id" field.
+func (sc *SuspensionCreate) SetUserID(u uuid.UUID) *SuspensionCreate {
+	sc.mutation.SetUserID(u)
+	return sc
+}
+
+// SetIssuerID sets the "issuer_id" field.
+func (sc *SuspensionCreate) SetIssuerID(u uuid.UUID) *SuspensionCreate {
+	sc.mutation.SetIssuerID(u)
+	return sc
+}
+
+// SetNillableIssuerID sets the "issuer_id" field if the given value is not nil.
+func (sc *SuspensionCreate) SetNillableIssuerID(u *uuid.UUID) *SuspensionCreate {
+	if u != nil {
+		sc.SetIssuerID(*u)
+	}
+	return sc
+}
+
+// SetReason sets the "reason" field.
+func (sc *SuspensionCreate) SetReason(s string) *SuspensionCreate {
+	sc.mutation.SetReason(s)
+	return sc
+}
+
+// SetScope sets the "scope" field.
+func (sc *SuspensionCreate) SetScope(s suspension.Scope) *SuspensionCreate {
+	sc.mutation.SetScope(s)
+	return sc
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (sc *SuspensionCreate) SetNillableScope(s *suspension.Scope) *SuspensionCreate {
+	if s != nil {
+		sc.SetScope(*s)
+	}
+	return sc
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (sc *SuspensionCreate) SetStartsAt(t time.Time) *SuspensionCreate {
+	sc.mutation.SetStartsAt(t)
+	return sc
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (sc *SuspensionCreate) SetNillableStartsAt(t *time.Time) *SuspensionCreate {
+	if t != nil {
+		sc.SetStartsAt(*t)
+	}
+	return sc
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (sc *SuspensionCreate) SetEndsAt(t time.Time) *SuspensionCreate {
+	sc.mutation.SetEndsAt(t)
+	return sc
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (sc *SuspensionCreate) SetNillableEndsAt(t *time.Time) *SuspensionCreate {
+	if t != nil {
+		sc.SetEndsAt(*t)
+	}
+	return sc
+}
+
+// SetID sets the "id" field.
+func (sc *SuspensionCreate) SetID(u uuid.UUID) *SuspensionCreate {
+	sc.mutation.SetID(u)
+	return sc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sc *SuspensionCreate) SetNillableID(u *uuid.UUID) *SuspensionCreate {
+	if u != nil {
+		sc.SetID(*u)
+	}
+	return sc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (sc *SuspensionCreate) SetUser(u *User) *SuspensionCreate {
+	return sc.SetUserID(u.ID)
+}
+
+// SetIssuer sets the "issuer" edge to the User entity.
+func (sc *SuspensionCreate) SetIssuer(u *User) *SuspensionCreate {
+	return sc.SetIssuerID(u.ID)
+}
+
+// Mutation returns the SuspensionMutation object of the builder.
+func (sc *SuspensionCreate) Mutation() *SuspensionMutation {
+	return sc.mutation
+}
+
+// Save creates the Suspension in the database.
+func (sc *SuspensionCreate) Save(ctx context.Context) (*Suspension, error) {
+	if err := sc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *SuspensionCreate) SaveX(ctx context.Context) *Suspension {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *SuspensionCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *SuspensionCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SuspensionCreate) defaults() error {
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		if suspension.DefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized suspension.DefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := suspension.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		if suspension.DefaultCreatedAt == nil {
+			return fmt.Errorf("generated: uninitialized suspension.DefaultCreatedAt (forgotten import generated/runtime?)")
+		}
+		v := suspension.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.Scope(); !ok {
+		v := suspension.DefaultScope
+		sc.mutation.SetScope(v)
+	}
+	if _, ok := sc.mutation.StartsAt(); !ok {
+		if suspension.DefaultStartsAt == nil {
+			return fmt.Errorf("generated: uninitialized suspension.DefaultStartsAt (forgotten import generated/runtime?)")
+		}
+		v := suspension.DefaultStartsAt()
+		sc.mutation.SetStartsAt(v)
+	}
+	if _, ok := sc.mutation.ID(); !ok {
+		if suspension.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized suspension.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := suspension.DefaultID()
+		sc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SuspensionCreate) check() error {
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "Suspension.updated_at"`)}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "Suspension.created_at"`)}
+	}
+	if _, ok := sc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "Suspension.user_id"`)}
+	}
+	if _, ok := sc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`generated: missing required field "Suspension.reason"`)}
+	}
+	if v, ok := sc.mutation.Reason(); ok {
+		if err := suspension.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`generated: validator failed for field "Suspension.reason": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`generated: missing required field "Suspension.scope"`)}
+	}
+	if v, ok := sc.mutation.Scope(); ok {
+		if err := suspension.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`generated: validator failed for field "Suspension.scope": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.StartsAt(); !ok {
+		return &ValidationError{Name: "starts_at", err: errors.New(`generated: missing required field "Suspension.starts_at"`)}
+	}
+	if len(sc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`generated: missing required edge "Suspension.user"`)}
+	}
+	return nil
+}
+
+func (sc *SuspensionCreate) sqlSave(ctx context.Context) (*Suspension, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *SuspensionCreate) createSpec() (*Suspension, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Suspension{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(suspension.Table, sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = sc.conflict
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.SetField(suspension.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(suspension.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.Reason(); ok {
+		_spec.SetField(suspension.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := sc.mutation.Scope(); ok {
+		_spec.SetField(suspension.FieldScope, field.TypeEnum, value)
+		_node.Scope = value
+	}
+	if value, ok := sc.mutation.StartsAt(); ok {
+		_spec.SetField(suspension.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = value
+	}
+	if value, ok := sc.mutation.EndsAt(); ok {
+		_spec.SetField(suspension.FieldEndsAt, field.TypeTime, value)
+		_node.EndsAt = &value
+	}
+	if nodes := sc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   suspension.UserTable,
+			Columns: []string{suspension.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.IssuerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   suspension.IssuerTable,
+			Columns: []string{suspension.IssuerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.IssuerID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Suspension.Create().
+//		SetUpdatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SuspensionUpsert) {
+//			SetUpdatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sc *SuspensionCreate) OnConflict(opts ...sql.ConflictOption) *SuspensionUpsertOne {
+	sc.conflict = opts
+	return &SuspensionUpsertOne{
+		create: sc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Suspension.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sc *SuspensionCreate) OnConflictColumns(columns ...string) *SuspensionUpsertOne {
+	sc.conflict = append(sc.conflict, sql.ConflictColumns(columns...))
+	return &SuspensionUpsertOne{
+		create: sc,
+	}
+}
+
+type (
+	// SuspensionUpsertOne is the builder for "upsert"-ing
+	//  one Suspension node.
+	SuspensionUpsertOne struct {
+		create *SuspensionCreate
+	}
+
+	// SuspensionUpsert is the "OnConflict" setter.
+	SuspensionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SuspensionUpsert) SetUpdatedAt(v time.Time) *SuspensionUpsert {
+	u.Set(suspension.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SuspensionUpsert) UpdateUpdatedAt() *SuspensionUpsert {
+	u.SetExcluded(suspension.FieldUpdatedAt)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *SuspensionUpsert) SetReason(v string) *SuspensionUpsert {
+	u.Set(suspension.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *SuspensionUpsert) UpdateReason() *SuspensionUpsert {
+	u.SetExcluded(suspension.FieldReason)
+	return u
+}
+
+// SetScope sets the "scope" field.
+func (u *SuspensionUpsert) SetScope(v suspension.Scope) *SuspensionUpsert {
+	u.Set(suspension.FieldScope, v)
+	return u
+}
+
+// UpdateScope sets the "scope" field to the value that was provided on create.
+func (u *SuspensionUpsert) UpdateScope() *SuspensionUpsert {
+	u.SetExcluded(suspension.FieldScope)
+	return u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *SuspensionUpsert) SetStartsAt(v time.Time) *SuspensionUpsert {
+	u.Set(suspension.FieldStartsAt, v)
+	return u
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *SuspensionUpsert) UpdateStartsAt() *SuspensionUpsert {
+	u.SetExcluded(suspension.FieldStartsAt)
+	return u
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (u *SuspensionUpsert) SetEndsAt(v time.Time) *SuspensionUpsert {
+	u.Set(suspension.FieldEndsAt, v)
+	return u
+}
+
+// UpdateEndsAt sets the "ends_at" field to the value that was provided on create.
+func (u *SuspensionUpsert) UpdateEndsAt() *SuspensionUpsert {
+	u.SetExcluded(suspension.FieldEndsAt)
+	return u
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (u *SuspensionUpsert) ClearEndsAt() *SuspensionUpsert {
+	u.SetNull(suspension.FieldEndsAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Suspension.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(suspension.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SuspensionUpsertOne) UpdateNewValues() *SuspensionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(suspension.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(suspension.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(suspension.FieldUserID)
+		}
+		if _, exists := u.create.mutation.IssuerID(); exists {
+			s.SetIgnore(suspension.FieldIssuerID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Suspension.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SuspensionUpsertOne) Ignore() *SuspensionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SuspensionUpsertOne) DoNothing() *SuspensionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SuspensionCreate.OnConflict
+// documentation for more info.
+func (u *SuspensionUpsertOne) Update(set func(*SuspensionUpsert)) *SuspensionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SuspensionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SuspensionUpsertOne) SetUpdatedAt(v time.Time) *SuspensionUpsertOne {
+	return u.Update(func(s *SuspensionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SuspensionUpsertOne) UpdateUpdatedAt() *SuspensionUpsertOne {
+	return u.Update(func(s *SuspensionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *SuspensionUpsertOne) SetReason(v string) *SuspensionUpsertOne {
+	return u.Update(func(s *SuspensionUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *SuspensionUpsertOne) UpdateReason() *SuspensionUpsertOne {
+	return u.Update(func(s *SuspensionUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetScope sets the "scope" field.
+func (u *SuspensionUpsertOne) SetScope(v suspension.Scope) *SuspensionUpsertOne {
+	return u.Update(func(s *SuspensionUpsert) {
+		s.SetScope(v)
+	})
+}
+
+// UpdateScope sets the "scope" field to the value that was provided on create.
+func (u *SuspensionUpsertOne) UpdateScope() *SuspensionUpsertOne {
+	return u.Update(func(s *SuspensionUpsert) {
+		s.UpdateScope()
+	})
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *SuspensionUpsertOne) SetStartsAt(v time.Time) *SuspensionUpsertOne {
+	return u.Update(func(s *SuspensionUpsert) {
+		s.SetStartsAt(v)
+	})
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *SuspensionUpsertOne) UpdateStartsAt() *SuspensionUpsertOne {
+	return u.Update(func(s *SuspensionUpsert) {
+		s.UpdateStartsAt()
+	})
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (u *SuspensionUpsertOne) SetEndsAt(v time.Time) *SuspensionUpsertOne {
+	return u.Update(func(s *SuspensionUpsert) {
+		s.SetEndsAt(v)
+	})
+}
+
+// UpdateEndsAt sets the "ends_at" field to the value that was provided on create.
+func (u *SuspensionUpsertOne) UpdateEndsAt() *SuspensionUpsertOne {
+	return u.Update(func(s *SuspensionUpsert) {
+		s.UpdateEndsAt()
+	})
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (u *SuspensionUpsertOne) ClearEndsAt() *SuspensionUpsertOne {
+	return u.Update(func(s *SuspensionUpsert) {
+		s.ClearEndsAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SuspensionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for SuspensionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SuspensionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SuspensionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: SuspensionUpsertOne.ID is not supported by MySQL driver. Use SuspensionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SuspensionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SuspensionCreateBulk is the builder for creating many Suspension entities in bulk.
+type SuspensionCreateBulk struct {
+	config
+	err      error
+	builders []*SuspensionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Suspension entities in the database.
+func (scb *SuspensionCreateBulk) Save(ctx context.Context) ([]*Suspension, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Suspension, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SuspensionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = scb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SuspensionCreateBulk) SaveX(ctx context.Context) []*Suspension {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *SuspensionCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *SuspensionCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Suspension.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SuspensionUpsert) {
+//			SetUpdatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (scb *SuspensionCreateBulk) OnConflict(opts ...sql.ConflictOption) *SuspensionUpsertBulk {
+	scb.conflict = opts
+	return &SuspensionUpsertBulk{
+		create: scb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Suspension.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scb *SuspensionCreateBulk) OnConflictColumns(columns ...string) *SuspensionUpsertBulk {
+	scb.conflict = append(scb.conflict, sql.ConflictColumns(columns...))
+	return &SuspensionUpsertBulk{
+		create: scb,
+	}
+}
+
+// SuspensionUpsertBulk is the builder for "upsert"-ing
+// a bulk of Suspension nodes.
+type SuspensionUpsertBulk struct {
+	create *SuspensionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Suspension.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(suspension.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SuspensionUpsertBulk) UpdateNewValues() *SuspensionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(suspension.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(suspension.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(suspension.FieldUserID)
+			}
+			if _, exists := b.mutation.IssuerID(); exists {
+				s.SetIgnore(suspension.FieldIssuerID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Suspension.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SuspensionUpsertBulk) Ignore() *SuspensionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SuspensionUpsertBulk) DoNothing() *SuspensionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SuspensionCreateBulk.OnConflict
+// documentation for more info.
+func (u *SuspensionUpsertBulk) Update(set func(*SuspensionUpsert)) *SuspensionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SuspensionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SuspensionUpsertBulk) SetUpdatedAt(v time.Time) *SuspensionUpsertBulk {
+	return u.Update(func(s *SuspensionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SuspensionUpsertBulk) UpdateUpdatedAt() *SuspensionUpsertBulk {
+	return u.Update(func(s *SuspensionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *SuspensionUpsertBulk) SetReason(v string) *SuspensionUpsertBulk {
+	return u.Update(func(s *SuspensionUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *SuspensionUpsertBulk) UpdateReason() *SuspensionUpsertBulk {
+	return u.Update(func(s *SuspensionUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetScope sets the "scope" field.
+func (u *SuspensionUpsertBulk) SetScope(v suspension.Scope) *SuspensionUpsertBulk {
+	return u.Update(func(s *SuspensionUpsert) {
+		s.SetScope(v)
+	})
+}
+
+// UpdateScope sets the "scope" field to the value that was provided on create.
+func (u *SuspensionUpsertBulk) UpdateScope() *SuspensionUpsertBulk {
+	return u.Update(func(s *SuspensionUpsert) {
+		s.UpdateScope()
+	})
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *SuspensionUpsertBulk) SetStartsAt(v time.Time) *SuspensionUpsertBulk {
+	return u.Update(func(s *SuspensionUpsert) {
+		s.SetStartsAt(v)
+	})
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *SuspensionUpsertBulk) UpdateStartsAt() *SuspensionUpsertBulk {
+	return u.Update(func(s *SuspensionUpsert) {
+		s.UpdateStartsAt()
+	})
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (u *SuspensionUpsertBulk) SetEndsAt(v time.Time) *SuspensionUpsertBulk {
+	return u.Update(func(s *SuspensionUpsert) {
+		s.SetEndsAt(v)
+	})
+}
+
+// UpdateEndsAt sets the "ends_at" field to the value that was provided on create.
+func (u *SuspensionUpsertBulk) UpdateEndsAt() *SuspensionUpsertBulk {
+	return u.Update(func(s *SuspensionUpsert) {
+		s.UpdateEndsAt()
+	})
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (u *SuspensionUpsertBulk) ClearEndsAt() *SuspensionUpsertBulk {
+	return u.Update(func(s *SuspensionUpsert) {
+		s.ClearEndsAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SuspensionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the SuspensionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for SuspensionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SuspensionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,436 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
+)
+
+// SuspensionUpdate is the builder for updating Suspension entities.
+type SuspensionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SuspensionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SuspensionUpdate builder.
+func (su *SuspensionUpdate) Where(ps ...predicate.Suspension) *SuspensionUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *SuspensionUpdate) SetUpdatedAt(t time.Time) *SuspensionUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// SetReason sets the "reason" field.
+func (su *SuspensionUpdate) SetReason(s string) *SuspensionUpdate {
+	su.mutation.SetReason(s)
+	return su
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (su *SuspensionUpdate) SetNillableReason(s *string) *SuspensionUpdate {
+	if s != nil {
+		su.SetReason(*s)
+	}
+	return su
+}
+
+// SetScope sets the "scope" field.
+func (su *SuspensionUpdate) SetScope(s suspension.Scope) *SuspensionUpdate {
+	su.mutation.SetScope(s)
+	return su
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (su *SuspensionUpdate) SetNillableScope(s *suspension.Scope) *SuspensionUpdate {
+	if s != nil {
+		su.SetScope(*s)
+	}
+	return su
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (su *SuspensionUpdate) SetStartsAt(t time.Time) *SuspensionUpdate {
+	su.mutation.SetStartsAt(t)
+	return su
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (su *SuspensionUpdate) SetNillableStartsAt(t *time.Time) *SuspensionUpdate {
+	if t != nil {
+		su.SetStartsAt(*t)
+	}
+	return su
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (su *SuspensionUpdate) SetEndsAt(t time.Time) *SuspensionUpdate {
+	su.mutation.SetEndsAt(t)
+	return su
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (su *SuspensionUpdate) SetNillableEndsAt(t *time.Time) *SuspensionUpdate {
+	if t != nil {
+		su.SetEndsAt(*t)
+	}
+	return su
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (su *SuspensionUpdate) ClearEndsAt() *SuspensionUpdate {
+	su.mutation.ClearEndsAt()
+	return su
+}
+
+// Mutation returns the SuspensionMutation object of the builder.
+func (su *SuspensionUpdate) Mutation() *SuspensionMutation {
+	return su.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *SuspensionUpdate) Save(ctx context.Context) (int, error) {
+	if err := su.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SuspensionUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SuspensionUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SuspensionUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *SuspensionUpdate) defaults() error {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		if suspension.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized suspension.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := suspension.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *SuspensionUpdate) check() error {
+	if v, ok := su.mutation.Reason(); ok {
+		if err := suspension.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`generated: validator failed for field "Suspension.reason": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.Scope(); ok {
+		if err := suspension.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`generated: validator failed for field "Suspension.scope": %w`, err)}
+		}
+	}
+	if su.mutation.UserCleared() && len(su.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "Suspension.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (su *SuspensionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SuspensionUpdate {
+	su.modifiers = append(su.modifiers, modifiers...)
+	return su
+}
+
+func (su *SuspensionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(suspension.Table, suspension.Columns, sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(suspension.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.Reason(); ok {
+		_spec.SetField(suspension.FieldReason, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Scope(); ok {
+		_spec.SetField(suspension.FieldScope, field.TypeEnum, value)
+	}
+	if value, ok := su.mutation.StartsAt(); ok {
+		_spec.SetField(suspension.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.EndsAt(); ok {
+		_spec.SetField(suspension.FieldEndsAt, field.TypeTime, value)
+	}
+	if su.mutation.EndsAtCleared() {
+		_spec.ClearField(suspension.FieldEndsAt, field.TypeTime)
+	}
+	_spec.AddModifiers(su.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{suspension.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// SuspensionUpdateOne is the builder for updating a single Suspension entity.
+type SuspensionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SuspensionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *SuspensionUpdateOne) SetUpdatedAt(t time.Time) *SuspensionUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// SetReason sets the "reason" field.
+func (suo *SuspensionUpdateOne) SetReason(s string) *SuspensionUpdateOne {
+	suo.mutation.SetReason(s)
+	return suo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (suo *SuspensionUpdateOne) SetNillableReason(s *string) *SuspensionUpdateOne {
+	if s != nil {
+		suo.SetReason(*s)
+	}
+	return suo
+}
+
+// SetScope sets the "scope" field.
+func (suo *SuspensionUpdateOne) SetScope(s suspension.Scope) *SuspensionUpdateOne {
+	suo.mutation.SetScope(s)
+	return suo
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (suo *SuspensionUpdateOne) SetNillableScope(s *suspension.Scope) *SuspensionUpdateOne {
+	if s != nil {
+		suo.SetScope(*s)
+	}
+	return suo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (suo *SuspensionUpdateOne) SetStartsAt(t time.Time) *SuspensionUpdateOne {
+	suo.mutation.SetStartsAt(t)
+	return suo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (suo *SuspensionUpdateOne) SetNillableStartsAt(t *time.Time) *SuspensionUpdateOne {
+	if t != nil {
+		suo.SetStartsAt(*t)
+	}
+	return suo
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (suo *SuspensionUpdateOne) SetEndsAt(t time.Time) *SuspensionUpdateOne {
+	suo.mutation.SetEndsAt(t)
+	return suo
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (suo *SuspensionUpdateOne) SetNillableEndsAt(t *time.Time) *SuspensionUpdateOne {
+	if t != nil {
+		suo.SetEndsAt(*t)
+	}
+	return suo
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (suo *SuspensionUpdateOne) ClearEndsAt() *SuspensionUpdateOne {
+	suo.mutation.ClearEndsAt()
+	return suo
+}
+
+// Mutation returns the SuspensionMutation object of the builder.
+func (suo *SuspensionUpdateOne) Mutation() *SuspensionMutation {
+	return suo.mutation
+}
+
+// Where appends a list predicates to the SuspensionUpdate builder.
+func (suo *SuspensionUpdateOne) Where(ps ...predicate.Suspension) *SuspensionUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *SuspensionUpdateOne) Select(field string, fields ...string) *SuspensionUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Suspension entity.
+func (suo *SuspensionUpdateOne) Save(ctx context.Context) (*Suspension, error) {
+	if err := suo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SuspensionUpdateOne) SaveX(ctx context.Context) *Suspension {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SuspensionUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SuspensionUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *SuspensionUpdateOne) defaults() error {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		if suspension.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized suspension.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := suspension.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *SuspensionUpdateOne) check() error {
+	if v, ok := suo.mutation.Reason(); ok {
+		if err := suspension.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`generated: validator failed for field "Suspension.reason": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.Scope(); ok {
+		if err := suspension.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`generated: validator failed for field "Suspension.scope": %w`, err)}
+		}
+	}
+	if suo.mutation.UserCleared() && len(suo.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "Suspension.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (suo *SuspensionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SuspensionUpdateOne {
+	suo.modifiers = append(suo.modifiers, modifiers...)
+	return suo
+}
+
+func (suo *SuspensionUpdateOne) sqlSave(ctx context.Context) (_node *Suspension, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(suspension.Table, suspension.Columns, sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "Suspension.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, suspension.FieldID)
+		for _, f := range fields {
+			if !suspension.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != suspension.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(suspension.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.Reason(); ok {
+		_spec.SetField(suspension.FieldReason, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Scope(); ok {
+		_spec.SetField(suspension.FieldScope, field.TypeEnum, value)
+	}
+	if value, ok := suo.mutation.StartsAt(); ok {
+		_spec.SetField(suspension.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.EndsAt(); ok {
+		_spec.SetField(suspension.FieldEndsAt, field.TypeTime, value)
+	}
+	if suo.mutation.EndsAtCleared() {
+		_spec.ClearField(suspension.FieldEndsAt, field.TypeTime)
+	}
+	_spec.AddModifiers(suo.modifiers...)
+	_node = &Suspension{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{suspension.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
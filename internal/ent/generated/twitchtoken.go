@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/twitchtoken"
+	"github.com/google/uuid"
+)
+
+// TwitchToken is the model entity for the TwitchToken schema.
+type TwitchToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// TwitchUserID holds the value of the "twitch_user_id" field.
+	TwitchUserID string `json:"twitch_user_id,omitempty"`
+	// SessionID holds the value of the "session_id" field.
+	SessionID *uuid.UUID `json:"session_id,omitempty"`
+	// AccessToken holds the value of the "access_token" field.
+	AccessToken []byte `json:"-"`
+	// RefreshToken holds the value of the "refresh_token" field.
+	RefreshToken []byte `json:"-"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes       []string `json:"scopes,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TwitchToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case twitchtoken.FieldSessionID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case twitchtoken.FieldAccessToken, twitchtoken.FieldRefreshToken, twitchtoken.FieldScopes:
+			values[i] = new([]byte)
+		case twitchtoken.FieldTwitchUserID:
+			values[i] = new(sql.NullString)
+		case twitchtoken.FieldUpdatedAt, twitchtoken.FieldCreatedAt, twitchtoken.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case twitchtoken.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TwitchToken fields.
+func (tt *TwitchToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case twitchtoken.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				tt.ID = *value
+			}
+		case twitchtoken.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tt.UpdatedAt = value.Time
+			}
+		case twitchtoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tt.CreatedAt = value.Time
+			}
+		case twitchtoken.FieldTwitchUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field twitch_user_id", values[i])
+			} else if value.Valid {
+				tt.TwitchUserID = value.String
+			}
+		case twitchtoken.FieldSessionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value.Valid {
+				tt.SessionID = new(uuid.UUID)
+				*tt.SessionID = *value.S.(*uuid.UUID)
+			}
+		case twitchtoken.FieldAccessToken:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field access_token", values[i])
+			} else if value != nil {
+				tt.AccessToken = *value
+			}
+		case twitchtoken.FieldRefreshToken:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field refresh_token", values[i])
+			} else if value != nil {
+				tt.RefreshToken = *value
+			}
+		case twitchtoken.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				tt.ExpiresAt = value.Time
+			}
+		case twitchtoken.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tt.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		default:
+			tt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TwitchToken.
+// This includes values selected through modifiers, order, etc.
+func (tt *TwitchToken) Value(name string) (ent.Value, error) {
+	return tt.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TwitchToken.
+// Note that you need to call TwitchToken.Unwrap() before calling this method if this TwitchToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tt *TwitchToken) Update() *TwitchTokenUpdateOne {
+	return NewTwitchTokenClient(tt.config).UpdateOne(tt)
+}
+
+// Unwrap unwraps the TwitchToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tt *TwitchToken) Unwrap() *TwitchToken {
+	_tx, ok := tt.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: TwitchToken is not a transactional entity")
+	}
+	tt.config.driver = _tx.drv
+	return tt
+}
+
+// String implements the fmt.Stringer.
+func (tt *TwitchToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("TwitchToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tt.ID))
+	builder.WriteString("updated_at=")
+	builder.WriteString(tt.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("twitch_user_id=")
+	builder.WriteString(tt.TwitchUserID)
+	builder.WriteString(", ")
+	if v := tt.SessionID; v != nil {
+		builder.WriteString("session_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("access_token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("refresh_token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(tt.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", tt.Scopes))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TwitchTokens is a parsable slice of TwitchToken.
+type TwitchTokens []*TwitchToken
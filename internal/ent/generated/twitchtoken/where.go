@@ -0,0 +1,431 @@
+// Code generated by ent, DO NOT EDIT.
+
+package twitchtoken
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldLTE(FieldID, id))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TwitchUserID applies equality check predicate on the "twitch_user_id" field. It's identical to TwitchUserIDEQ.
+func TwitchUserID(v string) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldEQ(FieldTwitchUserID, v))
+}
+
+// SessionID applies equality check predicate on the "session_id" field. It's identical to SessionIDEQ.
+func SessionID(v uuid.UUID) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldEQ(FieldSessionID, v))
+}
+
+// AccessToken applies equality check predicate on the "access_token" field. It's identical to AccessTokenEQ.
+func AccessToken(v []byte) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldEQ(FieldAccessToken, v))
+}
+
+// RefreshToken applies equality check predicate on the "refresh_token" field. It's identical to RefreshTokenEQ.
+func RefreshToken(v []byte) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldEQ(FieldRefreshToken, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// TwitchUserIDEQ applies the EQ predicate on the "twitch_user_id" field.
+func TwitchUserIDEQ(v string) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldEQ(FieldTwitchUserID, v))
+}
+
+// TwitchUserIDNEQ applies the NEQ predicate on the "twitch_user_id" field.
+func TwitchUserIDNEQ(v string) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldNEQ(FieldTwitchUserID, v))
+}
+
+// TwitchUserIDIn applies the In predicate on the "twitch_user_id" field.
+func TwitchUserIDIn(vs ...string) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldIn(FieldTwitchUserID, vs...))
+}
+
+// TwitchUserIDNotIn applies the NotIn predicate on the "twitch_user_id" field.
+func TwitchUserIDNotIn(vs ...string) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldNotIn(FieldTwitchUserID, vs...))
+}
+
+// TwitchUserIDGT applies the GT predicate on the "twitch_user_id" field.
+func TwitchUserIDGT(v string) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldGT(FieldTwitchUserID, v))
+}
+
+// TwitchUserIDGTE applies the GTE predicate on the "twitch_user_id" field.
+func TwitchUserIDGTE(v string) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldGTE(FieldTwitchUserID, v))
+}
+
+// TwitchUserIDLT applies the LT predicate on the "twitch_user_id" field.
+func TwitchUserIDLT(v string) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldLT(FieldTwitchUserID, v))
+}
+
+// TwitchUserIDLTE applies the LTE predicate on the "twitch_user_id" field.
+func TwitchUserIDLTE(v string) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldLTE(FieldTwitchUserID, v))
+}
+
+// TwitchUserIDContains applies the Contains predicate on the "twitch_user_id" field.
+func TwitchUserIDContains(v string) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldContains(FieldTwitchUserID, v))
+}
+
+// TwitchUserIDHasPrefix applies the HasPrefix predicate on the "twitch_user_id" field.
+func TwitchUserIDHasPrefix(v string) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldHasPrefix(FieldTwitchUserID, v))
+}
+
+// TwitchUserIDHasSuffix applies the HasSuffix predicate on the "twitch_user_id" field.
+func TwitchUserIDHasSuffix(v string) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldHasSuffix(FieldTwitchUserID, v))
+}
+
+// TwitchUserIDEqualFold applies the EqualFold predicate on the "twitch_user_id" field.
+func TwitchUserIDEqualFold(v string) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldEqualFold(FieldTwitchUserID, v))
+}
+
+// TwitchUserIDContainsFold applies the ContainsFold predicate on the "twitch_user_id" field.
+func TwitchUserIDContainsFold(v string) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldContainsFold(FieldTwitchUserID, v))
+}
+
+// SessionIDEQ applies the EQ predicate on the "session_id" field.
+func SessionIDEQ(v uuid.UUID) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldEQ(FieldSessionID, v))
+}
+
+// SessionIDNEQ applies the NEQ predicate on the "session_id" field.
+func SessionIDNEQ(v uuid.UUID) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldNEQ(FieldSessionID, v))
+}
+
+// SessionIDIn applies the In predicate on the "session_id" field.
+func SessionIDIn(vs ...uuid.UUID) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldIn(FieldSessionID, vs...))
+}
+
+// SessionIDNotIn applies the NotIn predicate on the "session_id" field.
+func SessionIDNotIn(vs ...uuid.UUID) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldNotIn(FieldSessionID, vs...))
+}
+
+// SessionIDGT applies the GT predicate on the "session_id" field.
+func SessionIDGT(v uuid.UUID) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldGT(FieldSessionID, v))
+}
+
+// SessionIDGTE applies the GTE predicate on the "session_id" field.
+func SessionIDGTE(v uuid.UUID) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldGTE(FieldSessionID, v))
+}
+
+// SessionIDLT applies the LT predicate on the "session_id" field.
+func SessionIDLT(v uuid.UUID) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldLT(FieldSessionID, v))
+}
+
+// SessionIDLTE applies the LTE predicate on the "session_id" field.
+func SessionIDLTE(v uuid.UUID) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldLTE(FieldSessionID, v))
+}
+
+// SessionIDIsNil applies the IsNil predicate on the "session_id" field.
+func SessionIDIsNil() predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldIsNull(FieldSessionID))
+}
+
+// SessionIDNotNil applies the NotNil predicate on the "session_id" field.
+func SessionIDNotNil() predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldNotNull(FieldSessionID))
+}
+
+// AccessTokenEQ applies the EQ predicate on the "access_token" field.
+func AccessTokenEQ(v []byte) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldEQ(FieldAccessToken, v))
+}
+
+// AccessTokenNEQ applies the NEQ predicate on the "access_token" field.
+func AccessTokenNEQ(v []byte) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldNEQ(FieldAccessToken, v))
+}
+
+// AccessTokenIn applies the In predicate on the "access_token" field.
+func AccessTokenIn(vs ...[]byte) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldIn(FieldAccessToken, vs...))
+}
+
+// AccessTokenNotIn applies the NotIn predicate on the "access_token" field.
+func AccessTokenNotIn(vs ...[]byte) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldNotIn(FieldAccessToken, vs...))
+}
+
+// AccessTokenGT applies the GT predicate on the "access_token" field.
+func AccessTokenGT(v []byte) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldGT(FieldAccessToken, v))
+}
+
+// AccessTokenGTE applies the GTE predicate on the "access_token" field.
+func AccessTokenGTE(v []byte) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldGTE(FieldAccessToken, v))
+}
+
+// AccessTokenLT applies the LT predicate on the "access_token" field.
+func AccessTokenLT(v []byte) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldLT(FieldAccessToken, v))
+}
+
+// AccessTokenLTE applies the LTE predicate on the "access_token" field.
+func AccessTokenLTE(v []byte) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldLTE(FieldAccessToken, v))
+}
+
+// RefreshTokenEQ applies the EQ predicate on the "refresh_token" field.
+func RefreshTokenEQ(v []byte) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldEQ(FieldRefreshToken, v))
+}
+
+// RefreshTokenNEQ applies the NEQ predicate on the "refresh_token" field.
+func RefreshTokenNEQ(v []byte) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldNEQ(FieldRefreshToken, v))
+}
+
+// RefreshTokenIn applies the In predicate on the "refresh_token" field.
+func RefreshTokenIn(vs ...[]byte) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldIn(FieldRefreshToken, vs...))
+}
+
+// RefreshTokenNotIn applies the NotIn predicate on the "refresh_token" field.
+func RefreshTokenNotIn(vs ...[]byte) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldNotIn(FieldRefreshToken, vs...))
+}
+
+// RefreshTokenGT applies the GT predicate on the "refresh_token" field.
+func RefreshTokenGT(v []byte) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldGT(FieldRefreshToken, v))
+}
+
+// RefreshTokenGTE applies the GTE predicate on the "refresh_token" field.
+func RefreshTokenGTE(v []byte) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldGTE(FieldRefreshToken, v))
+}
+
+// RefreshTokenLT applies the LT predicate on the "refresh_token" field.
+func RefreshTokenLT(v []byte) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldLT(FieldRefreshToken, v))
+}
+
+// RefreshTokenLTE applies the LTE predicate on the "refresh_token" field.
+func RefreshTokenLTE(v []byte) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldLTE(FieldRefreshToken, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ScopesIsNil applies the IsNil predicate on the "scopes" field.
+func ScopesIsNil() predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldIsNull(FieldScopes))
+}
+
+// ScopesNotNil applies the NotNil predicate on the "scopes" field.
+func ScopesNotNil() predicate.TwitchToken {
+	return predicate.TwitchToken(sql.FieldNotNull(FieldScopes))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TwitchToken) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TwitchToken) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TwitchToken) predicate.TwitchToken {
+	return predicate.TwitchToken(sql.NotPredicates(p))
+}
@@ -0,0 +1,841 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/twitchtoken"
+	"github.com/google/uuid"
+)
+
+// TwitchTokenCreate is the builder for creating a TwitchToken entity.
+type TwitchTokenCreate struct {
+	config
+	mutation *TwitchTokenMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ttc *TwitchTokenCreate) SetUpdatedAt(t time.Time) *TwitchTokenCreate {
+	ttc.mutation.SetUpdatedAt(t)
+	return ttc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ttc *TwitchTokenCreate) SetNillableUpdatedAt(t *time.Time) *TwitchTokenCreate {
+	if t != nil {
+		ttc.SetUpdatedAt(*t)
+	}
+	return ttc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ttc *TwitchTokenCreate) SetCreatedAt(t time.Time) *TwitchTokenCreate {
+	ttc.mutation.SetCreatedAt(t)
+	return ttc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ttc *TwitchTokenCreate) SetNillableCreatedAt(t *time.Time) *TwitchTokenCreate {
+	if t != nil {
+		ttc.SetCreatedAt(*t)
+	}
+	return ttc
+}
+
+// SetTwitchUserID sets the "twitch_user_id" field.
+func (ttc *TwitchTokenCreate) SetTwitchUserID(s string) *TwitchTokenCreate {
+	ttc.mutation.SetTwitchUserID(s)
+	return ttc
+}
+
+// SetSessionID sets the "session_id" field.
+func (ttc *TwitchTokenCreate) SetSessionID(u uuid.UUID) *TwitchTokenCreate {
+	ttc.mutation.SetSessionID(u)
+	return ttc
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (ttc *TwitchTokenCreate) SetNillableSessionID(u *uuid.UUID) *TwitchTokenCreate {
+	if u != nil {
+		ttc.SetSessionID(*u)
+	}
+	return ttc
+}
+
+// SetAccessToken sets the "access_token" field.
+func (ttc *TwitchTokenCreate) SetAccessToken(b []byte) *TwitchTokenCreate {
+	ttc.mutation.SetAccessToken(b)
+	return ttc
+}
+
+// SetRefreshToken sets the "refresh_token" field.
+func (ttc *TwitchTokenCreate) SetRefreshToken(b []byte) *TwitchTokenCreate {
+	ttc.mutation.SetRefreshToken(b)
+	return ttc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ttc *TwitchTokenCreate) SetExpiresAt(t time.Time) *TwitchTokenCreate {
+	ttc.mutation.SetExpiresAt(t)
+	return ttc
+}
+
+// SetScopes sets the "scopes" field.
+func (ttc *TwitchTokenCreate) SetScopes(s []string) *TwitchTokenCreate {
+	ttc.mutation.SetScopes(s)
+	return ttc
+}
+
+// SetID sets the "id" field.
+func (ttc *TwitchTokenCreate) SetID(u uuid.UUID) *TwitchTokenCreate {
+	ttc.mutation.SetID(u)
+	return ttc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ttc *TwitchTokenCreate) SetNillableID(u *uuid.UUID) *TwitchTokenCreate {
+	if u != nil {
+		ttc.SetID(*u)
+	}
+	return ttc
+}
+
+// Mutation returns the TwitchTokenMutation object of the builder.
+func (ttc *TwitchTokenCreate) Mutation() *TwitchTokenMutation {
+	return ttc.mutation
+}
+
+// Save creates the TwitchToken in the database.
+func (ttc *TwitchTokenCreate) Save(ctx context.Context) (*TwitchToken, error) {
+	if err := ttc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, ttc.sqlSave, ttc.mutation, ttc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ttc *TwitchTokenCreate) SaveX(ctx context.Context) *TwitchToken {
+	v, err := ttc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ttc *TwitchTokenCreate) Exec(ctx context.Context) error {
+	_, err := ttc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttc *TwitchTokenCreate) ExecX(ctx context.Context) {
+	if err := ttc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ttc *TwitchTokenCreate) defaults() error {
+	if _, ok := ttc.mutation.UpdatedAt(); !ok {
+		if twitchtoken.DefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized twitchtoken.DefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := twitchtoken.DefaultUpdatedAt()
+		ttc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ttc.mutation.CreatedAt(); !ok {
+		if twitchtoken.DefaultCreatedAt == nil {
+			return fmt.Errorf("generated: uninitialized twitchtoken.DefaultCreatedAt (forgotten import generated/runtime?)")
+		}
+		v := twitchtoken.DefaultCreatedAt()
+		ttc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ttc.mutation.ID(); !ok {
+		if twitchtoken.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized twitchtoken.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := twitchtoken.DefaultID()
+		ttc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ttc *TwitchTokenCreate) check() error {
+	if _, ok := ttc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "TwitchToken.updated_at"`)}
+	}
+	if _, ok := ttc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "TwitchToken.created_at"`)}
+	}
+	if _, ok := ttc.mutation.TwitchUserID(); !ok {
+		return &ValidationError{Name: "twitch_user_id", err: errors.New(`generated: missing required field "TwitchToken.twitch_user_id"`)}
+	}
+	if v, ok := ttc.mutation.TwitchUserID(); ok {
+		if err := twitchtoken.TwitchUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "twitch_user_id", err: fmt.Errorf(`generated: validator failed for field "TwitchToken.twitch_user_id": %w`, err)}
+		}
+	}
+	if _, ok := ttc.mutation.AccessToken(); !ok {
+		return &ValidationError{Name: "access_token", err: errors.New(`generated: missing required field "TwitchToken.access_token"`)}
+	}
+	if _, ok := ttc.mutation.RefreshToken(); !ok {
+		return &ValidationError{Name: "refresh_token", err: errors.New(`generated: missing required field "TwitchToken.refresh_token"`)}
+	}
+	if _, ok := ttc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`generated: missing required field "TwitchToken.expires_at"`)}
+	}
+	return nil
+}
+
+func (ttc *TwitchTokenCreate) sqlSave(ctx context.Context) (*TwitchToken, error) {
+	if err := ttc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ttc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ttc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ttc.mutation.id = &_node.ID
+	ttc.mutation.done = true
+	return _node, nil
+}
+
+func (ttc *TwitchTokenCreate) createSpec() (*TwitchToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TwitchToken{config: ttc.config}
+		_spec = sqlgraph.NewCreateSpec(twitchtoken.Table, sqlgraph.NewFieldSpec(twitchtoken.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = ttc.conflict
+	if id, ok := ttc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ttc.mutation.UpdatedAt(); ok {
+		_spec.SetField(twitchtoken.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ttc.mutation.CreatedAt(); ok {
+		_spec.SetField(twitchtoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ttc.mutation.TwitchUserID(); ok {
+		_spec.SetField(twitchtoken.FieldTwitchUserID, field.TypeString, value)
+		_node.TwitchUserID = value
+	}
+	if value, ok := ttc.mutation.SessionID(); ok {
+		_spec.SetField(twitchtoken.FieldSessionID, field.TypeUUID, value)
+		_node.SessionID = &value
+	}
+	if value, ok := ttc.mutation.AccessToken(); ok {
+		_spec.SetField(twitchtoken.FieldAccessToken, field.TypeBytes, value)
+		_node.AccessToken = value
+	}
+	if value, ok := ttc.mutation.RefreshToken(); ok {
+		_spec.SetField(twitchtoken.FieldRefreshToken, field.TypeBytes, value)
+		_node.RefreshToken = value
+	}
+	if value, ok := ttc.mutation.ExpiresAt(); ok {
+		_spec.SetField(twitchtoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := ttc.mutation.Scopes(); ok {
+		_spec.SetField(twitchtoken.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TwitchToken.Create().
+//		SetUpdatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TwitchTokenUpsert) {
+//			SetUpdatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ttc *TwitchTokenCreate) OnConflict(opts ...sql.ConflictOption) *TwitchTokenUpsertOne {
+	ttc.conflict = opts
+	return &TwitchTokenUpsertOne{
+		create: ttc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TwitchToken.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ttc *TwitchTokenCreate) OnConflictColumns(columns ...string) *TwitchTokenUpsertOne {
+	ttc.conflict = append(ttc.conflict, sql.ConflictColumns(columns...))
+	return &TwitchTokenUpsertOne{
+		create: ttc,
+	}
+}
+
+type (
+	// TwitchTokenUpsertOne is the builder for "upsert"-ing
+	//  one TwitchToken node.
+	TwitchTokenUpsertOne struct {
+		create *TwitchTokenCreate
+	}
+
+	// TwitchTokenUpsert is the "OnConflict" setter.
+	TwitchTokenUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TwitchTokenUpsert) SetUpdatedAt(v time.Time) *TwitchTokenUpsert {
+	u.Set(twitchtoken.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TwitchTokenUpsert) UpdateUpdatedAt() *TwitchTokenUpsert {
+	u.SetExcluded(twitchtoken.FieldUpdatedAt)
+	return u
+}
+
+// SetAccessToken sets the "access_token" field.
+func (u *TwitchTokenUpsert) SetAccessToken(v []byte) *TwitchTokenUpsert {
+	u.Set(twitchtoken.FieldAccessToken, v)
+	return u
+}
+
+// UpdateAccessToken sets the "access_token" field to the value that was provided on create.
+func (u *TwitchTokenUpsert) UpdateAccessToken() *TwitchTokenUpsert {
+	u.SetExcluded(twitchtoken.FieldAccessToken)
+	return u
+}
+
+// SetRefreshToken sets the "refresh_token" field.
+func (u *TwitchTokenUpsert) SetRefreshToken(v []byte) *TwitchTokenUpsert {
+	u.Set(twitchtoken.FieldRefreshToken, v)
+	return u
+}
+
+// UpdateRefreshToken sets the "refresh_token" field to the value that was provided on create.
+func (u *TwitchTokenUpsert) UpdateRefreshToken() *TwitchTokenUpsert {
+	u.SetExcluded(twitchtoken.FieldRefreshToken)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TwitchTokenUpsert) SetExpiresAt(v time.Time) *TwitchTokenUpsert {
+	u.Set(twitchtoken.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TwitchTokenUpsert) UpdateExpiresAt() *TwitchTokenUpsert {
+	u.SetExcluded(twitchtoken.FieldExpiresAt)
+	return u
+}
+
+// SetScopes sets the "scopes" field.
+func (u *TwitchTokenUpsert) SetScopes(v []string) *TwitchTokenUpsert {
+	u.Set(twitchtoken.FieldScopes, v)
+	return u
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *TwitchTokenUpsert) UpdateScopes() *TwitchTokenUpsert {
+	u.SetExcluded(twitchtoken.FieldScopes)
+	return u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *TwitchTokenUpsert) ClearScopes() *TwitchTokenUpsert {
+	u.SetNull(twitchtoken.FieldScopes)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TwitchToken.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(twitchtoken.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TwitchTokenUpsertOne) UpdateNewValues() *TwitchTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(twitchtoken.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(twitchtoken.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TwitchUserID(); exists {
+			s.SetIgnore(twitchtoken.FieldTwitchUserID)
+		}
+		if _, exists := u.create.mutation.SessionID(); exists {
+			s.SetIgnore(twitchtoken.FieldSessionID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TwitchToken.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TwitchTokenUpsertOne) Ignore() *TwitchTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TwitchTokenUpsertOne) DoNothing() *TwitchTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TwitchTokenCreate.OnConflict
+// documentation for more info.
+func (u *TwitchTokenUpsertOne) Update(set func(*TwitchTokenUpsert)) *TwitchTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TwitchTokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TwitchTokenUpsertOne) SetUpdatedAt(v time.Time) *TwitchTokenUpsertOne {
+	return u.Update(func(s *TwitchTokenUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TwitchTokenUpsertOne) UpdateUpdatedAt() *TwitchTokenUpsertOne {
+	return u.Update(func(s *TwitchTokenUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAccessToken sets the "access_token" field.
+func (u *TwitchTokenUpsertOne) SetAccessToken(v []byte) *TwitchTokenUpsertOne {
+	return u.Update(func(s *TwitchTokenUpsert) {
+		s.SetAccessToken(v)
+	})
+}
+
+// UpdateAccessToken sets the "access_token" field to the value that was provided on create.
+func (u *TwitchTokenUpsertOne) UpdateAccessToken() *TwitchTokenUpsertOne {
+	return u.Update(func(s *TwitchTokenUpsert) {
+		s.UpdateAccessToken()
+	})
+}
+
+// SetRefreshToken sets the "refresh_token" field.
+func (u *TwitchTokenUpsertOne) SetRefreshToken(v []byte) *TwitchTokenUpsertOne {
+	return u.Update(func(s *TwitchTokenUpsert) {
+		s.SetRefreshToken(v)
+	})
+}
+
+// UpdateRefreshToken sets the "refresh_token" field to the value that was provided on create.
+func (u *TwitchTokenUpsertOne) UpdateRefreshToken() *TwitchTokenUpsertOne {
+	return u.Update(func(s *TwitchTokenUpsert) {
+		s.UpdateRefreshToken()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TwitchTokenUpsertOne) SetExpiresAt(v time.Time) *TwitchTokenUpsertOne {
+	return u.Update(func(s *TwitchTokenUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TwitchTokenUpsertOne) UpdateExpiresAt() *TwitchTokenUpsertOne {
+	return u.Update(func(s *TwitchTokenUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetScopes sets the "scopes" field.
+func (u *TwitchTokenUpsertOne) SetScopes(v []string) *TwitchTokenUpsertOne {
+	return u.Update(func(s *TwitchTokenUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *TwitchTokenUpsertOne) UpdateScopes() *TwitchTokenUpsertOne {
+	return u.Update(func(s *TwitchTokenUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *TwitchTokenUpsertOne) ClearScopes() *TwitchTokenUpsertOne {
+	return u.Update(func(s *TwitchTokenUpsert) {
+		s.ClearScopes()
+	})
+}
+
+// Exec executes the query.
+func (u *TwitchTokenUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for TwitchTokenCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TwitchTokenUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TwitchTokenUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: TwitchTokenUpsertOne.ID is not supported by MySQL driver. Use TwitchTokenUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TwitchTokenUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TwitchTokenCreateBulk is the builder for creating many TwitchToken entities in bulk.
+type TwitchTokenCreateBulk struct {
+	config
+	err      error
+	builders []*TwitchTokenCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TwitchToken entities in the database.
+func (ttcb *TwitchTokenCreateBulk) Save(ctx context.Context) ([]*TwitchToken, error) {
+	if ttcb.err != nil {
+		return nil, ttcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ttcb.builders))
+	nodes := make([]*TwitchToken, len(ttcb.builders))
+	mutators := make([]Mutator, len(ttcb.builders))
+	for i := range ttcb.builders {
+		func(i int, root context.Context) {
+			builder := ttcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TwitchTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ttcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ttcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ttcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ttcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ttcb *TwitchTokenCreateBulk) SaveX(ctx context.Context) []*TwitchToken {
+	v, err := ttcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ttcb *TwitchTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := ttcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttcb *TwitchTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := ttcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TwitchToken.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TwitchTokenUpsert) {
+//			SetUpdatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ttcb *TwitchTokenCreateBulk) OnConflict(opts ...sql.ConflictOption) *TwitchTokenUpsertBulk {
+	ttcb.conflict = opts
+	return &TwitchTokenUpsertBulk{
+		create: ttcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TwitchToken.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ttcb *TwitchTokenCreateBulk) OnConflictColumns(columns ...string) *TwitchTokenUpsertBulk {
+	ttcb.conflict = append(ttcb.conflict, sql.ConflictColumns(columns...))
+	return &TwitchTokenUpsertBulk{
+		create: ttcb,
+	}
+}
+
+// TwitchTokenUpsertBulk is the builder for "upsert"-ing
+// a bulk of TwitchToken nodes.
+type TwitchTokenUpsertBulk struct {
+	create *TwitchTokenCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TwitchToken.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(twitchtoken.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TwitchTokenUpsertBulk) UpdateNewValues() *TwitchTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(twitchtoken.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(twitchtoken.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TwitchUserID(); exists {
+				s.SetIgnore(twitchtoken.FieldTwitchUserID)
+			}
+			if _, exists := b.mutation.SessionID(); exists {
+				s.SetIgnore(twitchtoken.FieldSessionID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TwitchToken.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TwitchTokenUpsertBulk) Ignore() *TwitchTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TwitchTokenUpsertBulk) DoNothing() *TwitchTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TwitchTokenCreateBulk.OnConflict
+// documentation for more info.
+func (u *TwitchTokenUpsertBulk) Update(set func(*TwitchTokenUpsert)) *TwitchTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TwitchTokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TwitchTokenUpsertBulk) SetUpdatedAt(v time.Time) *TwitchTokenUpsertBulk {
+	return u.Update(func(s *TwitchTokenUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TwitchTokenUpsertBulk) UpdateUpdatedAt() *TwitchTokenUpsertBulk {
+	return u.Update(func(s *TwitchTokenUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAccessToken sets the "access_token" field.
+func (u *TwitchTokenUpsertBulk) SetAccessToken(v []byte) *TwitchTokenUpsertBulk {
+	return u.Update(func(s *TwitchTokenUpsert) {
+		s.SetAccessToken(v)
+	})
+}
+
+// UpdateAccessToken sets the "access_token" field to the value that was provided on create.
+func (u *TwitchTokenUpsertBulk) UpdateAccessToken() *TwitchTokenUpsertBulk {
+	return u.Update(func(s *TwitchTokenUpsert) {
+		s.UpdateAccessToken()
+	})
+}
+
+// SetRefreshToken sets the "refresh_token" field.
+func (u *TwitchTokenUpsertBulk) SetRefreshToken(v []byte) *TwitchTokenUpsertBulk {
+	return u.Update(func(s *TwitchTokenUpsert) {
+		s.SetRefreshToken(v)
+	})
+}
+
+// UpdateRefreshToken sets the "refresh_token" field to the value that was provided on create.
+func (u *TwitchTokenUpsertBulk) UpdateRefreshToken() *TwitchTokenUpsertBulk {
+	return u.Update(func(s *TwitchTokenUpsert) {
+		s.UpdateRefreshToken()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TwitchTokenUpsertBulk) SetExpiresAt(v time.Time) *TwitchTokenUpsertBulk {
+	return u.Update(func(s *TwitchTokenUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TwitchTokenUpsertBulk) UpdateExpiresAt() *TwitchTokenUpsertBulk {
+	return u.Update(func(s *TwitchTokenUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetScopes sets the "scopes" field.
+func (u *TwitchTokenUpsertBulk) SetScopes(v []string) *TwitchTokenUpsertBulk {
+	return u.Update(func(s *TwitchTokenUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *TwitchTokenUpsertBulk) UpdateScopes() *TwitchTokenUpsertBulk {
+	return u.Update(func(s *TwitchTokenUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (u *TwitchTokenUpsertBulk) ClearScopes() *TwitchTokenUpsertBulk {
+	return u.Update(func(s *TwitchTokenUpsert) {
+		s.ClearScopes()
+	})
+}
+
+// Exec executes the query.
+func (u *TwitchTokenUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the TwitchTokenCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for TwitchTokenCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TwitchTokenUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
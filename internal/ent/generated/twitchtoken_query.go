@@ -0,0 +1,591 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/twitchtoken"
+	"github.com/google/uuid"
+)
+
+// TwitchTokenQuery is the builder for querying TwitchToken entities.
+type TwitchTokenQuery struct {
+	config
+	ctx        *QueryContext
+	order      []twitchtoken.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TwitchToken
+	loadTotal  []func(context.Context, []*TwitchToken) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TwitchTokenQuery builder.
+func (ttq *TwitchTokenQuery) Where(ps ...predicate.TwitchToken) *TwitchTokenQuery {
+	ttq.predicates = append(ttq.predicates, ps...)
+	return ttq
+}
+
+// Limit the number of records to be returned by this query.
+func (ttq *TwitchTokenQuery) Limit(limit int) *TwitchTokenQuery {
+	ttq.ctx.Limit = &limit
+	return ttq
+}
+
+// Offset to start from.
+func (ttq *TwitchTokenQuery) Offset(offset int) *TwitchTokenQuery {
+	ttq.ctx.Offset = &offset
+	return ttq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ttq *TwitchTokenQuery) Unique(unique bool) *TwitchTokenQuery {
+	ttq.ctx.Unique = &unique
+	return ttq
+}
+
+// Order specifies how the records should be ordered.
+func (ttq *TwitchTokenQuery) Order(o ...twitchtoken.OrderOption) *TwitchTokenQuery {
+	ttq.order = append(ttq.order, o...)
+	return ttq
+}
+
+// First returns the first TwitchToken entity from the query.
+// Returns a *NotFoundError when no TwitchToken was found.
+func (ttq *TwitchTokenQuery) First(ctx context.Context) (*TwitchToken, error) {
+	nodes, err := ttq.Limit(1).All(setContextOp(ctx, ttq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{twitchtoken.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ttq *TwitchTokenQuery) FirstX(ctx context.Context) *TwitchToken {
+	node, err := ttq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TwitchToken ID from the query.
+// Returns a *NotFoundError when no TwitchToken ID was found.
+func (ttq *TwitchTokenQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ttq.Limit(1).IDs(setContextOp(ctx, ttq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{twitchtoken.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ttq *TwitchTokenQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := ttq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TwitchToken entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TwitchToken entity is found.
+// Returns a *NotFoundError when no TwitchToken entities are found.
+func (ttq *TwitchTokenQuery) Only(ctx context.Context) (*TwitchToken, error) {
+	nodes, err := ttq.Limit(2).All(setContextOp(ctx, ttq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{twitchtoken.Label}
+	default:
+		return nil, &NotSingularError{twitchtoken.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ttq *TwitchTokenQuery) OnlyX(ctx context.Context) *TwitchToken {
+	node, err := ttq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TwitchToken ID in the query.
+// Returns a *NotSingularError when more than one TwitchToken ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ttq *TwitchTokenQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ttq.Limit(2).IDs(setContextOp(ctx, ttq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{twitchtoken.Label}
+	default:
+		err = &NotSingularError{twitchtoken.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ttq *TwitchTokenQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := ttq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TwitchTokens.
+func (ttq *TwitchTokenQuery) All(ctx context.Context) ([]*TwitchToken, error) {
+	ctx = setContextOp(ctx, ttq.ctx, ent.OpQueryAll)
+	if err := ttq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TwitchToken, *TwitchTokenQuery]()
+	return withInterceptors[[]*TwitchToken](ctx, ttq, qr, ttq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ttq *TwitchTokenQuery) AllX(ctx context.Context) []*TwitchToken {
+	nodes, err := ttq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TwitchToken IDs.
+func (ttq *TwitchTokenQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if ttq.ctx.Unique == nil && ttq.path != nil {
+		ttq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ttq.ctx, ent.OpQueryIDs)
+	if err = ttq.Select(twitchtoken.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ttq *TwitchTokenQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := ttq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ttq *TwitchTokenQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ttq.ctx, ent.OpQueryCount)
+	if err := ttq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ttq, querierCount[*TwitchTokenQuery](), ttq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ttq *TwitchTokenQuery) CountX(ctx context.Context) int {
+	count, err := ttq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ttq *TwitchTokenQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ttq.ctx, ent.OpQueryExist)
+	switch _, err := ttq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("generated: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ttq *TwitchTokenQuery) ExistX(ctx context.Context) bool {
+	exist, err := ttq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TwitchTokenQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ttq *TwitchTokenQuery) Clone() *TwitchTokenQuery {
+	if ttq == nil {
+		return nil
+	}
+	return &TwitchTokenQuery{
+		config:     ttq.config,
+		ctx:        ttq.ctx.Clone(),
+		order:      append([]twitchtoken.OrderOption{}, ttq.order...),
+		inters:     append([]Interceptor{}, ttq.inters...),
+		predicates: append([]predicate.TwitchToken{}, ttq.predicates...),
+		// clone intermediate query.
+		sql:       ttq.sql.Clone(),
+		path:      ttq.path,
+		modifiers: append([]func(*sql.Selector){}, ttq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UpdatedAt time.Time `json:"updated_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TwitchToken.Query().
+//		GroupBy(twitchtoken.FieldUpdatedAt).
+//		Aggregate(generated.Count()).
+//		Scan(ctx, &v)
+func (ttq *TwitchTokenQuery) GroupBy(field string, fields ...string) *TwitchTokenGroupBy {
+	ttq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TwitchTokenGroupBy{build: ttq}
+	grbuild.flds = &ttq.ctx.Fields
+	grbuild.label = twitchtoken.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UpdatedAt time.Time `json:"updated_at,omitempty"`
+//	}
+//
+//	client.TwitchToken.Query().
+//		Select(twitchtoken.FieldUpdatedAt).
+//		Scan(ctx, &v)
+func (ttq *TwitchTokenQuery) Select(fields ...string) *TwitchTokenSelect {
+	ttq.ctx.Fields = append(ttq.ctx.Fields, fields...)
+	sbuild := &TwitchTokenSelect{TwitchTokenQuery: ttq}
+	sbuild.label = twitchtoken.Label
+	sbuild.flds, sbuild.scan = &ttq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TwitchTokenSelect configured with the given aggregations.
+func (ttq *TwitchTokenQuery) Aggregate(fns ...AggregateFunc) *TwitchTokenSelect {
+	return ttq.Select().Aggregate(fns...)
+}
+
+func (ttq *TwitchTokenQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ttq.inters {
+		if inter == nil {
+			return fmt.Errorf("generated: uninitialized interceptor (forgotten import generated/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ttq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ttq.ctx.Fields {
+		if !twitchtoken.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+		}
+	}
+	if ttq.path != nil {
+		prev, err := ttq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ttq.sql = prev
+	}
+	if twitchtoken.Policy == nil {
+		return errors.New("generated: uninitialized twitchtoken.Policy (forgotten import generated/runtime?)")
+	}
+	if err := twitchtoken.Policy.EvalQuery(ctx, ttq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (ttq *TwitchTokenQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TwitchToken, error) {
+	var (
+		nodes = []*TwitchToken{}
+		_spec = ttq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TwitchToken).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TwitchToken{config: ttq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(ttq.modifiers) > 0 {
+		_spec.Modifiers = ttq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ttq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range ttq.loadTotal {
+		if err := ttq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ttq *TwitchTokenQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ttq.querySpec()
+	if len(ttq.modifiers) > 0 {
+		_spec.Modifiers = ttq.modifiers
+	}
+	_spec.Node.Columns = ttq.ctx.Fields
+	if len(ttq.ctx.Fields) > 0 {
+		_spec.Unique = ttq.ctx.Unique != nil && *ttq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ttq.driver, _spec)
+}
+
+func (ttq *TwitchTokenQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(twitchtoken.Table, twitchtoken.Columns, sqlgraph.NewFieldSpec(twitchtoken.FieldID, field.TypeUUID))
+	_spec.From = ttq.sql
+	if unique := ttq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ttq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ttq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, twitchtoken.FieldID)
+		for i := range fields {
+			if fields[i] != twitchtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ttq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ttq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ttq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ttq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ttq *TwitchTokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ttq.driver.Dialect())
+	t1 := builder.Table(twitchtoken.Table)
+	columns := ttq.ctx.Fields
+	if len(columns) == 0 {
+		columns = twitchtoken.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ttq.sql != nil {
+		selector = ttq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ttq.ctx.Unique != nil && *ttq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ttq.modifiers {
+		m(selector)
+	}
+	for _, p := range ttq.predicates {
+		p(selector)
+	}
+	for _, p := range ttq.order {
+		p(selector)
+	}
+	if offset := ttq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ttq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ttq *TwitchTokenQuery) ForUpdate(opts ...sql.LockOption) *TwitchTokenQuery {
+	if ttq.driver.Dialect() == dialect.Postgres {
+		ttq.Unique(false)
+	}
+	ttq.modifiers = append(ttq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ttq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ttq *TwitchTokenQuery) ForShare(opts ...sql.LockOption) *TwitchTokenQuery {
+	if ttq.driver.Dialect() == dialect.Postgres {
+		ttq.Unique(false)
+	}
+	ttq.modifiers = append(ttq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ttq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ttq *TwitchTokenQuery) Modify(modifiers ...func(s *sql.Selector)) *TwitchTokenSelect {
+	ttq.modifiers = append(ttq.modifiers, modifiers...)
+	return ttq.Select()
+}
+
+// TwitchTokenGroupBy is the group-by builder for TwitchToken entities.
+type TwitchTokenGroupBy struct {
+	selector
+	build *TwitchTokenQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ttgb *TwitchTokenGroupBy) Aggregate(fns ...AggregateFunc) *TwitchTokenGroupBy {
+	ttgb.fns = append(ttgb.fns, fns...)
+	return ttgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ttgb *TwitchTokenGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ttgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ttgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TwitchTokenQuery, *TwitchTokenGroupBy](ctx, ttgb.build, ttgb, ttgb.build.inters, v)
+}
+
+func (ttgb *TwitchTokenGroupBy) sqlScan(ctx context.Context, root *TwitchTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ttgb.fns))
+	for _, fn := range ttgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ttgb.flds)+len(ttgb.fns))
+		for _, f := range *ttgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ttgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ttgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TwitchTokenSelect is the builder for selecting fields of TwitchToken entities.
+type TwitchTokenSelect struct {
+	*TwitchTokenQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tts *TwitchTokenSelect) Aggregate(fns ...AggregateFunc) *TwitchTokenSelect {
+	tts.fns = append(tts.fns, fns...)
+	return tts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tts *TwitchTokenSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tts.ctx, ent.OpQuerySelect)
+	if err := tts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TwitchTokenQuery, *TwitchTokenSelect](ctx, tts.TwitchTokenQuery, tts, tts.inters, v)
+}
+
+func (tts *TwitchTokenSelect) sqlScan(ctx context.Context, root *TwitchTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tts.fns))
+	for _, fn := range tts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tts *TwitchTokenSelect) Modify(modifiers ...func(s *sql.Selector)) *TwitchTokenSelect {
+	tts.modifiers = append(tts.modifiers, modifiers...)
+	return tts
+}
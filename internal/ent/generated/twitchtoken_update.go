@@ -0,0 +1,375 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/twitchtoken"
+)
+
+// TwitchTokenUpdate is the builder for updating TwitchToken entities.
+type TwitchTokenUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TwitchTokenMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TwitchTokenUpdate builder.
+func (ttu *TwitchTokenUpdate) Where(ps ...predicate.TwitchToken) *TwitchTokenUpdate {
+	ttu.mutation.Where(ps...)
+	return ttu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ttu *TwitchTokenUpdate) SetUpdatedAt(t time.Time) *TwitchTokenUpdate {
+	ttu.mutation.SetUpdatedAt(t)
+	return ttu
+}
+
+// SetAccessToken sets the "access_token" field.
+func (ttu *TwitchTokenUpdate) SetAccessToken(b []byte) *TwitchTokenUpdate {
+	ttu.mutation.SetAccessToken(b)
+	return ttu
+}
+
+// SetRefreshToken sets the "refresh_token" field.
+func (ttu *TwitchTokenUpdate) SetRefreshToken(b []byte) *TwitchTokenUpdate {
+	ttu.mutation.SetRefreshToken(b)
+	return ttu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ttu *TwitchTokenUpdate) SetExpiresAt(t time.Time) *TwitchTokenUpdate {
+	ttu.mutation.SetExpiresAt(t)
+	return ttu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (ttu *TwitchTokenUpdate) SetNillableExpiresAt(t *time.Time) *TwitchTokenUpdate {
+	if t != nil {
+		ttu.SetExpiresAt(*t)
+	}
+	return ttu
+}
+
+// SetScopes sets the "scopes" field.
+func (ttu *TwitchTokenUpdate) SetScopes(s []string) *TwitchTokenUpdate {
+	ttu.mutation.SetScopes(s)
+	return ttu
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (ttu *TwitchTokenUpdate) AppendScopes(s []string) *TwitchTokenUpdate {
+	ttu.mutation.AppendScopes(s)
+	return ttu
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (ttu *TwitchTokenUpdate) ClearScopes() *TwitchTokenUpdate {
+	ttu.mutation.ClearScopes()
+	return ttu
+}
+
+// Mutation returns the TwitchTokenMutation object of the builder.
+func (ttu *TwitchTokenUpdate) Mutation() *TwitchTokenMutation {
+	return ttu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ttu *TwitchTokenUpdate) Save(ctx context.Context) (int, error) {
+	if err := ttu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, ttu.sqlSave, ttu.mutation, ttu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ttu *TwitchTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := ttu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ttu *TwitchTokenUpdate) Exec(ctx context.Context) error {
+	_, err := ttu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttu *TwitchTokenUpdate) ExecX(ctx context.Context) {
+	if err := ttu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ttu *TwitchTokenUpdate) defaults() error {
+	if _, ok := ttu.mutation.UpdatedAt(); !ok {
+		if twitchtoken.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized twitchtoken.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := twitchtoken.UpdateDefaultUpdatedAt()
+		ttu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ttu *TwitchTokenUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TwitchTokenUpdate {
+	ttu.modifiers = append(ttu.modifiers, modifiers...)
+	return ttu
+}
+
+func (ttu *TwitchTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(twitchtoken.Table, twitchtoken.Columns, sqlgraph.NewFieldSpec(twitchtoken.FieldID, field.TypeUUID))
+	if ps := ttu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ttu.mutation.UpdatedAt(); ok {
+		_spec.SetField(twitchtoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ttu.mutation.SessionIDCleared() {
+		_spec.ClearField(twitchtoken.FieldSessionID, field.TypeUUID)
+	}
+	if value, ok := ttu.mutation.AccessToken(); ok {
+		_spec.SetField(twitchtoken.FieldAccessToken, field.TypeBytes, value)
+	}
+	if value, ok := ttu.mutation.RefreshToken(); ok {
+		_spec.SetField(twitchtoken.FieldRefreshToken, field.TypeBytes, value)
+	}
+	if value, ok := ttu.mutation.ExpiresAt(); ok {
+		_spec.SetField(twitchtoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := ttu.mutation.Scopes(); ok {
+		_spec.SetField(twitchtoken.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := ttu.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, twitchtoken.FieldScopes, value)
+		})
+	}
+	if ttu.mutation.ScopesCleared() {
+		_spec.ClearField(twitchtoken.FieldScopes, field.TypeJSON)
+	}
+	_spec.AddModifiers(ttu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ttu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{twitchtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ttu.mutation.done = true
+	return n, nil
+}
+
+// TwitchTokenUpdateOne is the builder for updating a single TwitchToken entity.
+type TwitchTokenUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TwitchTokenMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ttuo *TwitchTokenUpdateOne) SetUpdatedAt(t time.Time) *TwitchTokenUpdateOne {
+	ttuo.mutation.SetUpdatedAt(t)
+	return ttuo
+}
+
+// SetAccessToken sets the "access_token" field.
+func (ttuo *TwitchTokenUpdateOne) SetAccessToken(b []byte) *TwitchTokenUpdateOne {
+	ttuo.mutation.SetAccessToken(b)
+	return ttuo
+}
+
+// SetRefreshToken sets the "refresh_token" field.
+func (ttuo *TwitchTokenUpdateOne) SetRefreshToken(b []byte) *TwitchTokenUpdateOne {
+	ttuo.mutation.SetRefreshToken(b)
+	return ttuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ttuo *TwitchTokenUpdateOne) SetExpiresAt(t time.Time) *TwitchTokenUpdateOne {
+	ttuo.mutation.SetExpiresAt(t)
+	return ttuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (ttuo *TwitchTokenUpdateOne) SetNillableExpiresAt(t *time.Time) *TwitchTokenUpdateOne {
+	if t != nil {
+		ttuo.SetExpiresAt(*t)
+	}
+	return ttuo
+}
+
+// SetScopes sets the "scopes" field.
+func (ttuo *TwitchTokenUpdateOne) SetScopes(s []string) *TwitchTokenUpdateOne {
+	ttuo.mutation.SetScopes(s)
+	return ttuo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (ttuo *TwitchTokenUpdateOne) AppendScopes(s []string) *TwitchTokenUpdateOne {
+	ttuo.mutation.AppendScopes(s)
+	return ttuo
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (ttuo *TwitchTokenUpdateOne) ClearScopes() *TwitchTokenUpdateOne {
+	ttuo.mutation.ClearScopes()
+	return ttuo
+}
+
+// Mutation returns the TwitchTokenMutation object of the builder.
+func (ttuo *TwitchTokenUpdateOne) Mutation() *TwitchTokenMutation {
+	return ttuo.mutation
+}
+
+// Where appends a list predicates to the TwitchTokenUpdate builder.
+func (ttuo *TwitchTokenUpdateOne) Where(ps ...predicate.TwitchToken) *TwitchTokenUpdateOne {
+	ttuo.mutation.Where(ps...)
+	return ttuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ttuo *TwitchTokenUpdateOne) Select(field string, fields ...string) *TwitchTokenUpdateOne {
+	ttuo.fields = append([]string{field}, fields...)
+	return ttuo
+}
+
+// Save executes the query and returns the updated TwitchToken entity.
+func (ttuo *TwitchTokenUpdateOne) Save(ctx context.Context) (*TwitchToken, error) {
+	if err := ttuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, ttuo.sqlSave, ttuo.mutation, ttuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ttuo *TwitchTokenUpdateOne) SaveX(ctx context.Context) *TwitchToken {
+	node, err := ttuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ttuo *TwitchTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := ttuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttuo *TwitchTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := ttuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ttuo *TwitchTokenUpdateOne) defaults() error {
+	if _, ok := ttuo.mutation.UpdatedAt(); !ok {
+		if twitchtoken.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized twitchtoken.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := twitchtoken.UpdateDefaultUpdatedAt()
+		ttuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ttuo *TwitchTokenUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TwitchTokenUpdateOne {
+	ttuo.modifiers = append(ttuo.modifiers, modifiers...)
+	return ttuo
+}
+
+func (ttuo *TwitchTokenUpdateOne) sqlSave(ctx context.Context) (_node *TwitchToken, err error) {
+	_spec := sqlgraph.NewUpdateSpec(twitchtoken.Table, twitchtoken.Columns, sqlgraph.NewFieldSpec(twitchtoken.FieldID, field.TypeUUID))
+	id, ok := ttuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "TwitchToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ttuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, twitchtoken.FieldID)
+		for _, f := range fields {
+			if !twitchtoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != twitchtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ttuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ttuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(twitchtoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ttuo.mutation.SessionIDCleared() {
+		_spec.ClearField(twitchtoken.FieldSessionID, field.TypeUUID)
+	}
+	if value, ok := ttuo.mutation.AccessToken(); ok {
+		_spec.SetField(twitchtoken.FieldAccessToken, field.TypeBytes, value)
+	}
+	if value, ok := ttuo.mutation.RefreshToken(); ok {
+		_spec.SetField(twitchtoken.FieldRefreshToken, field.TypeBytes, value)
+	}
+	if value, ok := ttuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(twitchtoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := ttuo.mutation.Scopes(); ok {
+		_spec.SetField(twitchtoken.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := ttuo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, twitchtoken.FieldScopes, value)
+		})
+	}
+	if ttuo.mutation.ScopesCleared() {
+		_spec.ClearField(twitchtoken.FieldScopes, field.TypeJSON)
+	}
+	_spec.AddModifiers(ttuo.modifiers...)
+	_node = &TwitchToken{config: ttuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ttuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{twitchtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ttuo.mutation.done = true
+	return _node, nil
+}
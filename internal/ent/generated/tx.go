@@ -14,16 +14,32 @@ type Tx struct {
 	config
 	// ApiKey is the client for interacting with the ApiKey builders.
 	ApiKey *ApiKeyClient
+	// AwardDefinition is the client for interacting with the AwardDefinition builders.
+	AwardDefinition *AwardDefinitionClient
+	// CategoryRestriction is the client for interacting with the CategoryRestriction builders.
+	CategoryRestriction *CategoryRestrictionClient
 	// Comment is the client for interacting with the Comment builders.
 	Comment *CommentClient
+	// Identity is the client for interacting with the Identity builders.
+	Identity *IdentityClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
 	// PostCategory is the client for interacting with the PostCategory builders.
 	PostCategory *PostCategoryClient
 	// RefreshToken is the client for interacting with the RefreshToken builders.
 	RefreshToken *RefreshTokenClient
+	// RoleChange is the client for interacting with the RoleChange builders.
+	RoleChange *RoleChangeClient
+	// RolePermission is the client for interacting with the RolePermission builders.
+	RolePermission *RolePermissionClient
+	// Suspension is the client for interacting with the Suspension builders.
+	Suspension *SuspensionClient
+	// TwitchToken is the client for interacting with the TwitchToken builders.
+	TwitchToken *TwitchTokenClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserAward is the client for interacting with the UserAward builders.
+	UserAward *UserAwardClient
 
 	// lazily loaded.
 	client     *Client
@@ -156,11 +172,19 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.ApiKey = NewApiKeyClient(tx.config)
+	tx.AwardDefinition = NewAwardDefinitionClient(tx.config)
+	tx.CategoryRestriction = NewCategoryRestrictionClient(tx.config)
 	tx.Comment = NewCommentClient(tx.config)
+	tx.Identity = NewIdentityClient(tx.config)
 	tx.Post = NewPostClient(tx.config)
 	tx.PostCategory = NewPostCategoryClient(tx.config)
 	tx.RefreshToken = NewRefreshTokenClient(tx.config)
+	tx.RoleChange = NewRoleChangeClient(tx.config)
+	tx.RolePermission = NewRolePermissionClient(tx.config)
+	tx.Suspension = NewSuspensionClient(tx.config)
+	tx.TwitchToken = NewTwitchTokenClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserAward = NewUserAwardClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
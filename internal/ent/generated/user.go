@@ -3,7 +3,6 @@
 package generated
 
 import (
-	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -33,18 +32,20 @@ type User struct {
 	Alias string `json:"alias,omitempty"`
 	// ProfileImage holds the value of the "profile_image" field.
 	ProfileImage *string `json:"profile_image,omitempty"`
-	// the auth_provider unique id of the user
+	// the unique id of the user, derived from the identity the user registered with
 	ExternalID string `json:"external_id,omitempty"`
-	// AuthProvider holds the value of the "auth_provider" field.
+	// the provider the user registered with
 	AuthProvider user.AuthProvider `json:"auth_provider,omitempty"`
 	// the role of the user
 	Role user.Role `json:"role,omitempty"`
+	// how the role was assigned, unset for the default role. Manually assigned roles are not synced from Twitch
+	RoleSource *user.RoleSource `json:"role_source,omitempty"`
+	// whether the user is a VIP in the broadcaster Twitch channel
+	TwitchVip bool `json:"twitch_vip,omitempty"`
 	// the time the user was last seen
 	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
 	// cursor for last post seen
 	LastPostSeenCursor *string `json:"last_post_seen_cursor,omitempty"`
-	// Awards holds the value of the "awards" field.
-	Awards []string `json:"awards,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -63,20 +64,44 @@ type UserEdges struct {
 	Comments []*Comment `json:"comments,omitempty"`
 	// APIKeys holds the value of the api_keys edge.
 	APIKeys []*ApiKey `json:"api_keys,omitempty"`
+	// Awards holds the value of the awards edge.
+	Awards []*UserAward `json:"awards,omitempty"`
+	// BlockedUsers holds the value of the blocked_users edge.
+	BlockedUsers []*User `json:"blocked_users,omitempty"`
+	// BlockedBy holds the value of the blocked_by edge.
+	BlockedBy []*User `json:"blocked_by,omitempty"`
+	// MutedUsers holds the value of the muted_users edge.
+	MutedUsers []*User `json:"muted_users,omitempty"`
+	// MutedBy holds the value of the muted_by edge.
+	MutedBy []*User `json:"muted_by,omitempty"`
+	// Identities holds the value of the identities edge.
+	Identities []*Identity `json:"identities,omitempty"`
 	// RefreshTokens holds the value of the refresh_tokens edge.
 	RefreshTokens []*RefreshToken `json:"refresh_tokens,omitempty"`
+	// RoleChanges holds the value of the role_changes edge.
+	RoleChanges []*RoleChange `json:"role_changes,omitempty"`
+	// Suspensions holds the value of the suspensions edge.
+	Suspensions []*Suspension `json:"suspensions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [6]bool
+	loadedTypes [14]bool
 	// totalCount holds the count of the edges above.
-	totalCount [5]map[string]int
+	totalCount [7]map[string]int
 
 	namedSavedPosts     map[string][]*Post
 	namedLikedPosts     map[string][]*Post
 	namedPublishedPosts map[string][]*Post
 	namedComments       map[string][]*Comment
 	namedAPIKeys        map[string][]*ApiKey
+	namedAwards         map[string][]*UserAward
+	namedBlockedUsers   map[string][]*User
+	namedBlockedBy      map[string][]*User
+	namedMutedUsers     map[string][]*User
+	namedMutedBy        map[string][]*User
+	namedIdentities     map[string][]*Identity
 	namedRefreshTokens  map[string][]*RefreshToken
+	namedRoleChanges    map[string][]*RoleChange
+	namedSuspensions    map[string][]*Suspension
 }
 
 // SavedPostsOrErr returns the SavedPosts value or an error if the edge
@@ -124,23 +149,95 @@ func (e UserEdges) APIKeysOrErr() ([]*ApiKey, error) {
 	return nil, &NotLoadedError{edge: "api_keys"}
 }
 
+// AwardsOrErr returns the Awards value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AwardsOrErr() ([]*UserAward, error) {
+	if e.loadedTypes[5] {
+		return e.Awards, nil
+	}
+	return nil, &NotLoadedError{edge: "awards"}
+}
+
+// BlockedUsersOrErr returns the BlockedUsers value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BlockedUsersOrErr() ([]*User, error) {
+	if e.loadedTypes[6] {
+		return e.BlockedUsers, nil
+	}
+	return nil, &NotLoadedError{edge: "blocked_users"}
+}
+
+// BlockedByOrErr returns the BlockedBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BlockedByOrErr() ([]*User, error) {
+	if e.loadedTypes[7] {
+		return e.BlockedBy, nil
+	}
+	return nil, &NotLoadedError{edge: "blocked_by"}
+}
+
+// MutedUsersOrErr returns the MutedUsers value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MutedUsersOrErr() ([]*User, error) {
+	if e.loadedTypes[8] {
+		return e.MutedUsers, nil
+	}
+	return nil, &NotLoadedError{edge: "muted_users"}
+}
+
+// MutedByOrErr returns the MutedBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MutedByOrErr() ([]*User, error) {
+	if e.loadedTypes[9] {
+		return e.MutedBy, nil
+	}
+	return nil, &NotLoadedError{edge: "muted_by"}
+}
+
+// IdentitiesOrErr returns the Identities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) IdentitiesOrErr() ([]*Identity, error) {
+	if e.loadedTypes[10] {
+		return e.Identities, nil
+	}
+	return nil, &NotLoadedError{edge: "identities"}
+}
+
 // RefreshTokensOrErr returns the RefreshTokens value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) RefreshTokensOrErr() ([]*RefreshToken, error) {
-	if e.loadedTypes[5] {
+	if e.loadedTypes[11] {
 		return e.RefreshTokens, nil
 	}
 	return nil, &NotLoadedError{edge: "refresh_tokens"}
 }
 
+// RoleChangesOrErr returns the RoleChanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RoleChangesOrErr() ([]*RoleChange, error) {
+	if e.loadedTypes[12] {
+		return e.RoleChanges, nil
+	}
+	return nil, &NotLoadedError{edge: "role_changes"}
+}
+
+// SuspensionsOrErr returns the Suspensions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SuspensionsOrErr() ([]*Suspension, error) {
+	if e.loadedTypes[13] {
+		return e.Suspensions, nil
+	}
+	return nil, &NotLoadedError{edge: "suspensions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldAwards:
-			values[i] = new([]byte)
-		case user.FieldDeletedBy, user.FieldDisplayName, user.FieldAlias, user.FieldProfileImage, user.FieldExternalID, user.FieldAuthProvider, user.FieldRole, user.FieldLastPostSeenCursor:
+		case user.FieldTwitchVip:
+			values[i] = new(sql.NullBool)
+		case user.FieldDeletedBy, user.FieldDisplayName, user.FieldAlias, user.FieldProfileImage, user.FieldExternalID, user.FieldAuthProvider, user.FieldRole, user.FieldRoleSource, user.FieldLastPostSeenCursor:
 			values[i] = new(sql.NullString)
 		case user.FieldUpdatedAt, user.FieldCreatedAt, user.FieldDeletedAt, user.FieldLastSeenAt:
 			values[i] = new(sql.NullTime)
@@ -228,6 +325,19 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Role = user.Role(value.String)
 			}
+		case user.FieldRoleSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role_source", values[i])
+			} else if value.Valid {
+				u.RoleSource = new(user.RoleSource)
+				*u.RoleSource = user.RoleSource(value.String)
+			}
+		case user.FieldTwitchVip:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field twitch_vip", values[i])
+			} else if value.Valid {
+				u.TwitchVip = value.Bool
+			}
 		case user.FieldLastSeenAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
@@ -242,14 +352,6 @@ func (u *User) assignValues(columns []string, values []any) error {
 				u.LastPostSeenCursor = new(string)
 				*u.LastPostSeenCursor = value.String
 			}
-		case user.FieldAwards:
-			if value, ok := values[i].(*[]byte); !ok {
-				return fmt.Errorf("unexpected type %T for field awards", values[i])
-			} else if value != nil && len(*value) > 0 {
-				if err := json.Unmarshal(*value, &u.Awards); err != nil {
-					return fmt.Errorf("unmarshal field awards: %w", err)
-				}
-			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -288,11 +390,51 @@ func (u *User) QueryAPIKeys() *ApiKeyQuery {
 	return NewUserClient(u.config).QueryAPIKeys(u)
 }
 
+// QueryAwards queries the "awards" edge of the User entity.
+func (u *User) QueryAwards() *UserAwardQuery {
+	return NewUserClient(u.config).QueryAwards(u)
+}
+
+// QueryBlockedUsers queries the "blocked_users" edge of the User entity.
+func (u *User) QueryBlockedUsers() *UserQuery {
+	return NewUserClient(u.config).QueryBlockedUsers(u)
+}
+
+// QueryBlockedBy queries the "blocked_by" edge of the User entity.
+func (u *User) QueryBlockedBy() *UserQuery {
+	return NewUserClient(u.config).QueryBlockedBy(u)
+}
+
+// QueryMutedUsers queries the "muted_users" edge of the User entity.
+func (u *User) QueryMutedUsers() *UserQuery {
+	return NewUserClient(u.config).QueryMutedUsers(u)
+}
+
+// QueryMutedBy queries the "muted_by" edge of the User entity.
+func (u *User) QueryMutedBy() *UserQuery {
+	return NewUserClient(u.config).QueryMutedBy(u)
+}
+
+// QueryIdentities queries the "identities" edge of the User entity.
+func (u *User) QueryIdentities() *IdentityQuery {
+	return NewUserClient(u.config).QueryIdentities(u)
+}
+
 // QueryRefreshTokens queries the "refresh_tokens" edge of the User entity.
 func (u *User) QueryRefreshTokens() *RefreshTokenQuery {
 	return NewUserClient(u.config).QueryRefreshTokens(u)
 }
 
+// QueryRoleChanges queries the "role_changes" edge of the User entity.
+func (u *User) QueryRoleChanges() *RoleChangeQuery {
+	return NewUserClient(u.config).QueryRoleChanges(u)
+}
+
+// QuerySuspensions queries the "suspensions" edge of the User entity.
+func (u *User) QuerySuspensions() *SuspensionQuery {
+	return NewUserClient(u.config).QuerySuspensions(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -348,6 +490,14 @@ func (u *User) String() string {
 	builder.WriteString("role=")
 	builder.WriteString(fmt.Sprintf("%v", u.Role))
 	builder.WriteString(", ")
+	if v := u.RoleSource; v != nil {
+		builder.WriteString("role_source=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("twitch_vip=")
+	builder.WriteString(fmt.Sprintf("%v", u.TwitchVip))
+	builder.WriteString(", ")
 	if v := u.LastSeenAt; v != nil {
 		builder.WriteString("last_seen_at=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -357,9 +507,6 @@ func (u *User) String() string {
 		builder.WriteString("last_post_seen_cursor=")
 		builder.WriteString(*v)
 	}
-	builder.WriteString(", ")
-	builder.WriteString("awards=")
-	builder.WriteString(fmt.Sprintf("%v", u.Awards))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -484,6 +631,150 @@ func (u *User) appendNamedAPIKeys(name string, edges ...*ApiKey) {
 	}
 }
 
+// NamedAwards returns the Awards named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedAwards(name string) ([]*UserAward, error) {
+	if u.Edges.namedAwards == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedAwards[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedAwards(name string, edges ...*UserAward) {
+	if u.Edges.namedAwards == nil {
+		u.Edges.namedAwards = make(map[string][]*UserAward)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedAwards[name] = []*UserAward{}
+	} else {
+		u.Edges.namedAwards[name] = append(u.Edges.namedAwards[name], edges...)
+	}
+}
+
+// NamedBlockedUsers returns the BlockedUsers named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedBlockedUsers(name string) ([]*User, error) {
+	if u.Edges.namedBlockedUsers == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedBlockedUsers[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedBlockedUsers(name string, edges ...*User) {
+	if u.Edges.namedBlockedUsers == nil {
+		u.Edges.namedBlockedUsers = make(map[string][]*User)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedBlockedUsers[name] = []*User{}
+	} else {
+		u.Edges.namedBlockedUsers[name] = append(u.Edges.namedBlockedUsers[name], edges...)
+	}
+}
+
+// NamedBlockedBy returns the BlockedBy named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedBlockedBy(name string) ([]*User, error) {
+	if u.Edges.namedBlockedBy == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedBlockedBy[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedBlockedBy(name string, edges ...*User) {
+	if u.Edges.namedBlockedBy == nil {
+		u.Edges.namedBlockedBy = make(map[string][]*User)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedBlockedBy[name] = []*User{}
+	} else {
+		u.Edges.namedBlockedBy[name] = append(u.Edges.namedBlockedBy[name], edges...)
+	}
+}
+
+// NamedMutedUsers returns the MutedUsers named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedMutedUsers(name string) ([]*User, error) {
+	if u.Edges.namedMutedUsers == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedMutedUsers[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedMutedUsers(name string, edges ...*User) {
+	if u.Edges.namedMutedUsers == nil {
+		u.Edges.namedMutedUsers = make(map[string][]*User)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedMutedUsers[name] = []*User{}
+	} else {
+		u.Edges.namedMutedUsers[name] = append(u.Edges.namedMutedUsers[name], edges...)
+	}
+}
+
+// NamedMutedBy returns the MutedBy named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedMutedBy(name string) ([]*User, error) {
+	if u.Edges.namedMutedBy == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedMutedBy[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedMutedBy(name string, edges ...*User) {
+	if u.Edges.namedMutedBy == nil {
+		u.Edges.namedMutedBy = make(map[string][]*User)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedMutedBy[name] = []*User{}
+	} else {
+		u.Edges.namedMutedBy[name] = append(u.Edges.namedMutedBy[name], edges...)
+	}
+}
+
+// NamedIdentities returns the Identities named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedIdentities(name string) ([]*Identity, error) {
+	if u.Edges.namedIdentities == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedIdentities[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedIdentities(name string, edges ...*Identity) {
+	if u.Edges.namedIdentities == nil {
+		u.Edges.namedIdentities = make(map[string][]*Identity)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedIdentities[name] = []*Identity{}
+	} else {
+		u.Edges.namedIdentities[name] = append(u.Edges.namedIdentities[name], edges...)
+	}
+}
+
 // NamedRefreshTokens returns the RefreshTokens named value or an error if the edge was not
 // loaded in eager-loading with this name.
 func (u *User) NamedRefreshTokens(name string) ([]*RefreshToken, error) {
@@ -508,5 +799,53 @@ func (u *User) appendNamedRefreshTokens(name string, edges ...*RefreshToken) {
 	}
 }
 
+// NamedRoleChanges returns the RoleChanges named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedRoleChanges(name string) ([]*RoleChange, error) {
+	if u.Edges.namedRoleChanges == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedRoleChanges[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedRoleChanges(name string, edges ...*RoleChange) {
+	if u.Edges.namedRoleChanges == nil {
+		u.Edges.namedRoleChanges = make(map[string][]*RoleChange)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedRoleChanges[name] = []*RoleChange{}
+	} else {
+		u.Edges.namedRoleChanges[name] = append(u.Edges.namedRoleChanges[name], edges...)
+	}
+}
+
+// NamedSuspensions returns the Suspensions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedSuspensions(name string) ([]*Suspension, error) {
+	if u.Edges.namedSuspensions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedSuspensions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedSuspensions(name string, edges ...*Suspension) {
+	if u.Edges.namedSuspensions == nil {
+		u.Edges.namedSuspensions = make(map[string][]*Suspension)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedSuspensions[name] = []*Suspension{}
+	} else {
+		u.Edges.namedSuspensions[name] = append(u.Edges.namedSuspensions[name], edges...)
+	}
+}
+
 // Users is a parsable slice of User.
 type Users []*User
@@ -39,12 +39,14 @@ const (
 	FieldAuthProvider = "auth_provider"
 	// FieldRole holds the string denoting the role field in the database.
 	FieldRole = "role"
+	// FieldRoleSource holds the string denoting the role_source field in the database.
+	FieldRoleSource = "role_source"
+	// FieldTwitchVip holds the string denoting the twitch_vip field in the database.
+	FieldTwitchVip = "twitch_vip"
 	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
 	FieldLastSeenAt = "last_seen_at"
 	// FieldLastPostSeenCursor holds the string denoting the last_post_seen_cursor field in the database.
 	FieldLastPostSeenCursor = "last_post_seen_cursor"
-	// FieldAwards holds the string denoting the awards field in the database.
-	FieldAwards = "awards"
 	// EdgeSavedPosts holds the string denoting the saved_posts edge name in mutations.
 	EdgeSavedPosts = "saved_posts"
 	// EdgeLikedPosts holds the string denoting the liked_posts edge name in mutations.
@@ -55,8 +57,24 @@ const (
 	EdgeComments = "comments"
 	// EdgeAPIKeys holds the string denoting the api_keys edge name in mutations.
 	EdgeAPIKeys = "api_keys"
+	// EdgeAwards holds the string denoting the awards edge name in mutations.
+	EdgeAwards = "awards"
+	// EdgeBlockedUsers holds the string denoting the blocked_users edge name in mutations.
+	EdgeBlockedUsers = "blocked_users"
+	// EdgeBlockedBy holds the string denoting the blocked_by edge name in mutations.
+	EdgeBlockedBy = "blocked_by"
+	// EdgeMutedUsers holds the string denoting the muted_users edge name in mutations.
+	EdgeMutedUsers = "muted_users"
+	// EdgeMutedBy holds the string denoting the muted_by edge name in mutations.
+	EdgeMutedBy = "muted_by"
+	// EdgeIdentities holds the string denoting the identities edge name in mutations.
+	EdgeIdentities = "identities"
 	// EdgeRefreshTokens holds the string denoting the refresh_tokens edge name in mutations.
 	EdgeRefreshTokens = "refresh_tokens"
+	// EdgeRoleChanges holds the string denoting the role_changes edge name in mutations.
+	EdgeRoleChanges = "role_changes"
+	// EdgeSuspensions holds the string denoting the suspensions edge name in mutations.
+	EdgeSuspensions = "suspensions"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// SavedPostsTable is the table that holds the saved_posts relation/edge. The primary key declared below.
@@ -90,6 +108,28 @@ const (
 	APIKeysInverseTable = "api_keys"
 	// APIKeysColumn is the table column denoting the api_keys relation/edge.
 	APIKeysColumn = "owner_id"
+	// AwardsTable is the table that holds the awards relation/edge.
+	AwardsTable = "user_awards"
+	// AwardsInverseTable is the table name for the UserAward entity.
+	// It exists in this package in order to avoid circular dependency with the "useraward" package.
+	AwardsInverseTable = "user_awards"
+	// AwardsColumn is the table column denoting the awards relation/edge.
+	AwardsColumn = "owner_id"
+	// BlockedUsersTable is the table that holds the blocked_users relation/edge. The primary key declared below.
+	BlockedUsersTable = "user_blocked_users"
+	// BlockedByTable is the table that holds the blocked_by relation/edge. The primary key declared below.
+	BlockedByTable = "user_blocked_users"
+	// MutedUsersTable is the table that holds the muted_users relation/edge. The primary key declared below.
+	MutedUsersTable = "user_muted_users"
+	// MutedByTable is the table that holds the muted_by relation/edge. The primary key declared below.
+	MutedByTable = "user_muted_users"
+	// IdentitiesTable is the table that holds the identities relation/edge.
+	IdentitiesTable = "identities"
+	// IdentitiesInverseTable is the table name for the Identity entity.
+	// It exists in this package in order to avoid circular dependency with the "identity" package.
+	IdentitiesInverseTable = "identities"
+	// IdentitiesColumn is the table column denoting the identities relation/edge.
+	IdentitiesColumn = "owner_id"
 	// RefreshTokensTable is the table that holds the refresh_tokens relation/edge.
 	RefreshTokensTable = "refresh_tokens"
 	// RefreshTokensInverseTable is the table name for the RefreshToken entity.
@@ -97,6 +137,20 @@ const (
 	RefreshTokensInverseTable = "refresh_tokens"
 	// RefreshTokensColumn is the table column denoting the refresh_tokens relation/edge.
 	RefreshTokensColumn = "owner_id"
+	// RoleChangesTable is the table that holds the role_changes relation/edge.
+	RoleChangesTable = "role_changes"
+	// RoleChangesInverseTable is the table name for the RoleChange entity.
+	// It exists in this package in order to avoid circular dependency with the "rolechange" package.
+	RoleChangesInverseTable = "role_changes"
+	// RoleChangesColumn is the table column denoting the role_changes relation/edge.
+	RoleChangesColumn = "user_id"
+	// SuspensionsTable is the table that holds the suspensions relation/edge.
+	SuspensionsTable = "suspensions"
+	// SuspensionsInverseTable is the table name for the Suspension entity.
+	// It exists in this package in order to avoid circular dependency with the "suspension" package.
+	SuspensionsInverseTable = "suspensions"
+	// SuspensionsColumn is the table column denoting the suspensions relation/edge.
+	SuspensionsColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -112,9 +166,10 @@ var Columns = []string{
 	FieldExternalID,
 	FieldAuthProvider,
 	FieldRole,
+	FieldRoleSource,
+	FieldTwitchVip,
 	FieldLastSeenAt,
 	FieldLastPostSeenCursor,
-	FieldAwards,
 }
 
 var (
@@ -124,6 +179,18 @@ var (
 	// LikedPostsPrimaryKey and LikedPostsColumn2 are the table columns denoting the
 	// primary key for the liked_posts relation (M2M).
 	LikedPostsPrimaryKey = []string{"user_id", "post_id"}
+	// BlockedUsersPrimaryKey and BlockedUsersColumn2 are the table columns denoting the
+	// primary key for the blocked_users relation (M2M).
+	BlockedUsersPrimaryKey = []string{"user_id", "blocked_user_id"}
+	// BlockedByPrimaryKey and BlockedByColumn2 are the table columns denoting the
+	// primary key for the blocked_by relation (M2M).
+	BlockedByPrimaryKey = []string{"user_id", "blocked_user_id"}
+	// MutedUsersPrimaryKey and MutedUsersColumn2 are the table columns denoting the
+	// primary key for the muted_users relation (M2M).
+	MutedUsersPrimaryKey = []string{"user_id", "muted_user_id"}
+	// MutedByPrimaryKey and MutedByColumn2 are the table columns denoting the
+	// primary key for the muted_by relation (M2M).
+	MutedByPrimaryKey = []string{"user_id", "muted_user_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -142,7 +209,7 @@ func ValidColumn(column string) bool {
 //
 //	import _ "github.com/caliecode/la-clipasa/internal/ent/generated/runtime"
 var (
-	Hooks        [3]ent.Hook
+	Hooks        [5]ent.Hook
 	Interceptors [1]ent.Interceptor
 	Policy       ent.Policy
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -151,6 +218,8 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
+	// DefaultTwitchVip holds the default value on creation for the "twitch_vip" field.
+	DefaultTwitchVip bool
 	// UpdateDefaultLastSeenAt holds the default value on update for the "last_seen_at" field.
 	UpdateDefaultLastSeenAt func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
@@ -165,7 +234,9 @@ const DefaultAuthProvider = AuthProviderTWITCH
 
 // AuthProvider values.
 const (
-	AuthProviderTWITCH AuthProvider = "TWITCH"
+	AuthProviderTWITCH  AuthProvider = "TWITCH"
+	AuthProviderDISCORD AuthProvider = "DISCORD"
+	AuthProviderGOOGLE  AuthProvider = "GOOGLE"
 )
 
 func (ap AuthProvider) String() string {
@@ -175,7 +246,7 @@ func (ap AuthProvider) String() string {
 // AuthProviderValidator is a validator for the "auth_provider" field enum values. It is called by the builders before save.
 func AuthProviderValidator(ap AuthProvider) error {
 	switch ap {
-	case AuthProviderTWITCH:
+	case AuthProviderTWITCH, AuthProviderDISCORD, AuthProviderGOOGLE:
 		return nil
 	default:
 		return fmt.Errorf("user: invalid enum value for auth_provider field: %q", ap)
@@ -186,6 +257,8 @@ func AuthProviderValidator(ap AuthProvider) error {
 func AllAuthProviders() []AuthProvider {
 	return []AuthProvider{
 		AuthProviderTWITCH,
+		AuthProviderDISCORD,
+		AuthProviderGOOGLE,
 	}
 }
 
@@ -227,6 +300,37 @@ func AllRoles() []Role {
 	}
 }
 
+// RoleSource defines the type for the "role_source" enum field.
+type RoleSource string
+
+// RoleSource values.
+const (
+	RoleSourceMANUAL RoleSource = "MANUAL"
+	RoleSourceTWITCH RoleSource = "TWITCH"
+)
+
+func (rs RoleSource) String() string {
+	return string(rs)
+}
+
+// RoleSourceValidator is a validator for the "role_source" field enum values. It is called by the builders before save.
+func RoleSourceValidator(rs RoleSource) error {
+	switch rs {
+	case RoleSourceMANUAL, RoleSourceTWITCH:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for role_source field: %q", rs)
+	}
+}
+
+// AllRoleSources returns all RoleSource values.
+func AllRoleSources() []RoleSource {
+	return []RoleSource{
+		RoleSourceMANUAL,
+		RoleSourceTWITCH,
+	}
+}
+
 // OrderOption defines the ordering options for the User queries.
 type OrderOption func(*sql.Selector)
 
@@ -285,6 +389,16 @@ func ByRole(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRole, opts...).ToFunc()
 }
 
+// ByRoleSource orders the results by the role_source field.
+func ByRoleSource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoleSource, opts...).ToFunc()
+}
+
+// ByTwitchVip orders the results by the twitch_vip field.
+func ByTwitchVip(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTwitchVip, opts...).ToFunc()
+}
+
 // ByLastSeenAt orders the results by the last_seen_at field.
 func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
@@ -365,6 +479,90 @@ func ByAPIKeys(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByAwardsCount orders the results by awards count.
+func ByAwardsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAwardsStep(), opts...)
+	}
+}
+
+// ByAwards orders the results by awards terms.
+func ByAwards(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAwardsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBlockedUsersCount orders the results by blocked_users count.
+func ByBlockedUsersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlockedUsersStep(), opts...)
+	}
+}
+
+// ByBlockedUsers orders the results by blocked_users terms.
+func ByBlockedUsers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlockedUsersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBlockedByCount orders the results by blocked_by count.
+func ByBlockedByCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlockedByStep(), opts...)
+	}
+}
+
+// ByBlockedBy orders the results by blocked_by terms.
+func ByBlockedBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlockedByStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMutedUsersCount orders the results by muted_users count.
+func ByMutedUsersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMutedUsersStep(), opts...)
+	}
+}
+
+// ByMutedUsers orders the results by muted_users terms.
+func ByMutedUsers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMutedUsersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMutedByCount orders the results by muted_by count.
+func ByMutedByCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMutedByStep(), opts...)
+	}
+}
+
+// ByMutedBy orders the results by muted_by terms.
+func ByMutedBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMutedByStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByIdentitiesCount orders the results by identities count.
+func ByIdentitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newIdentitiesStep(), opts...)
+	}
+}
+
+// ByIdentities orders the results by identities terms.
+func ByIdentities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIdentitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByRefreshTokensCount orders the results by refresh_tokens count.
 func ByRefreshTokensCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -378,6 +576,34 @@ func ByRefreshTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newRefreshTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByRoleChangesCount orders the results by role_changes count.
+func ByRoleChangesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRoleChangesStep(), opts...)
+	}
+}
+
+// ByRoleChanges orders the results by role_changes terms.
+func ByRoleChanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRoleChangesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySuspensionsCount orders the results by suspensions count.
+func BySuspensionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSuspensionsStep(), opts...)
+	}
+}
+
+// BySuspensions orders the results by suspensions terms.
+func BySuspensions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSuspensionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newSavedPostsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -413,6 +639,48 @@ func newAPIKeysStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, APIKeysTable, APIKeysColumn),
 	)
 }
+func newAwardsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AwardsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AwardsTable, AwardsColumn),
+	)
+}
+func newBlockedUsersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, BlockedUsersTable, BlockedUsersPrimaryKey...),
+	)
+}
+func newBlockedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, BlockedByTable, BlockedByPrimaryKey...),
+	)
+}
+func newMutedUsersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, MutedUsersTable, MutedUsersPrimaryKey...),
+	)
+}
+func newMutedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, MutedByTable, MutedByPrimaryKey...),
+	)
+}
+func newIdentitiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IdentitiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, IdentitiesTable, IdentitiesColumn),
+	)
+}
 func newRefreshTokensStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -420,6 +688,20 @@ func newRefreshTokensStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, RefreshTokensTable, RefreshTokensColumn),
 	)
 }
+func newRoleChangesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RoleChangesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RoleChangesTable, RoleChangesColumn),
+	)
+}
+func newSuspensionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SuspensionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SuspensionsTable, SuspensionsColumn),
+	)
+}
 
 // MarshalGQL implements graphql.Marshaler interface.
 func (e AuthProvider) MarshalGQL(w io.Writer) {
@@ -456,3 +738,21 @@ func (e *Role) UnmarshalGQL(val interface{}) error {
 	}
 	return nil
 }
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e RoleSource) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *RoleSource) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = RoleSource(str)
+	if err := RoleSourceValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid RoleSource", str)
+	}
+	return nil
+}
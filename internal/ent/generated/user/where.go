@@ -96,6 +96,11 @@ func ExternalID(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldExternalID, v))
 }
 
+// TwitchVip applies equality check predicate on the "twitch_vip" field. It's identical to TwitchVipEQ.
+func TwitchVip(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTwitchVip, v))
+}
+
 // LastSeenAt applies equality check predicate on the "last_seen_at" field. It's identical to LastSeenAtEQ.
 func LastSeenAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldLastSeenAt, v))
@@ -631,6 +636,46 @@ func RoleNotIn(vs ...Role) predicate.User {
 	return predicate.User(sql.FieldNotIn(FieldRole, vs...))
 }
 
+// RoleSourceEQ applies the EQ predicate on the "role_source" field.
+func RoleSourceEQ(v RoleSource) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRoleSource, v))
+}
+
+// RoleSourceNEQ applies the NEQ predicate on the "role_source" field.
+func RoleSourceNEQ(v RoleSource) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldRoleSource, v))
+}
+
+// RoleSourceIn applies the In predicate on the "role_source" field.
+func RoleSourceIn(vs ...RoleSource) predicate.User {
+	return predicate.User(sql.FieldIn(FieldRoleSource, vs...))
+}
+
+// RoleSourceNotIn applies the NotIn predicate on the "role_source" field.
+func RoleSourceNotIn(vs ...RoleSource) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldRoleSource, vs...))
+}
+
+// RoleSourceIsNil applies the IsNil predicate on the "role_source" field.
+func RoleSourceIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldRoleSource))
+}
+
+// RoleSourceNotNil applies the NotNil predicate on the "role_source" field.
+func RoleSourceNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldRoleSource))
+}
+
+// TwitchVipEQ applies the EQ predicate on the "twitch_vip" field.
+func TwitchVipEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTwitchVip, v))
+}
+
+// TwitchVipNEQ applies the NEQ predicate on the "twitch_vip" field.
+func TwitchVipNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldTwitchVip, v))
+}
+
 // LastSeenAtEQ applies the EQ predicate on the "last_seen_at" field.
 func LastSeenAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldLastSeenAt, v))
@@ -756,16 +801,6 @@ func LastPostSeenCursorContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldLastPostSeenCursor, v))
 }
 
-// AwardsIsNil applies the IsNil predicate on the "awards" field.
-func AwardsIsNil() predicate.User {
-	return predicate.User(sql.FieldIsNull(FieldAwards))
-}
-
-// AwardsNotNil applies the NotNil predicate on the "awards" field.
-func AwardsNotNil() predicate.User {
-	return predicate.User(sql.FieldNotNull(FieldAwards))
-}
-
 // HasSavedPosts applies the HasEdge predicate on the "saved_posts" edge.
 func HasSavedPosts() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -881,6 +916,144 @@ func HasAPIKeysWith(preds ...predicate.ApiKey) predicate.User {
 	})
 }
 
+// HasAwards applies the HasEdge predicate on the "awards" edge.
+func HasAwards() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AwardsTable, AwardsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAwardsWith applies the HasEdge predicate on the "awards" edge with a given conditions (other predicates).
+func HasAwardsWith(preds ...predicate.UserAward) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newAwardsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlockedUsers applies the HasEdge predicate on the "blocked_users" edge.
+func HasBlockedUsers() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, BlockedUsersTable, BlockedUsersPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockedUsersWith applies the HasEdge predicate on the "blocked_users" edge with a given conditions (other predicates).
+func HasBlockedUsersWith(preds ...predicate.User) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newBlockedUsersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlockedBy applies the HasEdge predicate on the "blocked_by" edge.
+func HasBlockedBy() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, BlockedByTable, BlockedByPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockedByWith applies the HasEdge predicate on the "blocked_by" edge with a given conditions (other predicates).
+func HasBlockedByWith(preds ...predicate.User) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newBlockedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMutedUsers applies the HasEdge predicate on the "muted_users" edge.
+func HasMutedUsers() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, MutedUsersTable, MutedUsersPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMutedUsersWith applies the HasEdge predicate on the "muted_users" edge with a given conditions (other predicates).
+func HasMutedUsersWith(preds ...predicate.User) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMutedUsersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMutedBy applies the HasEdge predicate on the "muted_by" edge.
+func HasMutedBy() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, MutedByTable, MutedByPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMutedByWith applies the HasEdge predicate on the "muted_by" edge with a given conditions (other predicates).
+func HasMutedByWith(preds ...predicate.User) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMutedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIdentities applies the HasEdge predicate on the "identities" edge.
+func HasIdentities() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, IdentitiesTable, IdentitiesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIdentitiesWith applies the HasEdge predicate on the "identities" edge with a given conditions (other predicates).
+func HasIdentitiesWith(preds ...predicate.Identity) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newIdentitiesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasRefreshTokens applies the HasEdge predicate on the "refresh_tokens" edge.
 func HasRefreshTokens() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -904,6 +1077,52 @@ func HasRefreshTokensWith(preds ...predicate.RefreshToken) predicate.User {
 	})
 }
 
+// HasRoleChanges applies the HasEdge predicate on the "role_changes" edge.
+func HasRoleChanges() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RoleChangesTable, RoleChangesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRoleChangesWith applies the HasEdge predicate on the "role_changes" edge with a given conditions (other predicates).
+func HasRoleChangesWith(preds ...predicate.RoleChange) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newRoleChangesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSuspensions applies the HasEdge predicate on the "suspensions" edge.
+func HasSuspensions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SuspensionsTable, SuspensionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSuspensionsWith applies the HasEdge predicate on the "suspensions" edge with a given conditions (other predicates).
+func HasSuspensionsWith(preds ...predicate.Suspension) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSuspensionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
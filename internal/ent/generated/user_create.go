@@ -8,13 +8,19 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/apikey"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/comment"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/post"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/refreshtoken"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
 	"github.com/google/uuid"
 )
 
@@ -23,6 +29,7 @@ type UserCreate struct {
 	config
 	mutation *UserMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUpdatedAt sets the "updated_at" field.
@@ -149,6 +156,34 @@ func (uc *UserCreate) SetNillableRole(u *user.Role) *UserCreate {
 	return uc
 }
 
+// SetRoleSource sets the "role_source" field.
+func (uc *UserCreate) SetRoleSource(us user.RoleSource) *UserCreate {
+	uc.mutation.SetRoleSource(us)
+	return uc
+}
+
+// SetNillableRoleSource sets the "role_source" field if the given value is not nil.
+func (uc *UserCreate) SetNillableRoleSource(us *user.RoleSource) *UserCreate {
+	if us != nil {
+		uc.SetRoleSource(*us)
+	}
+	return uc
+}
+
+// SetTwitchVip sets the "twitch_vip" field.
+func (uc *UserCreate) SetTwitchVip(b bool) *UserCreate {
+	uc.mutation.SetTwitchVip(b)
+	return uc
+}
+
+// SetNillableTwitchVip sets the "twitch_vip" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTwitchVip(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetTwitchVip(*b)
+	}
+	return uc
+}
+
 // SetLastSeenAt sets the "last_seen_at" field.
 func (uc *UserCreate) SetLastSeenAt(t time.Time) *UserCreate {
 	uc.mutation.SetLastSeenAt(t)
@@ -177,12 +212,6 @@ func (uc *UserCreate) SetNillableLastPostSeenCursor(s *string) *UserCreate {
 	return uc
 }
 
-// SetAwards sets the "awards" field.
-func (uc *UserCreate) SetAwards(s []string) *UserCreate {
-	uc.mutation.SetAwards(s)
-	return uc
-}
-
 // SetID sets the "id" field.
 func (uc *UserCreate) SetID(u uuid.UUID) *UserCreate {
 	uc.mutation.SetID(u)
@@ -272,6 +301,96 @@ func (uc *UserCreate) AddAPIKeys(a ...*ApiKey) *UserCreate {
 	return uc.AddAPIKeyIDs(ids...)
 }
 
+// AddAwardIDs adds the "awards" edge to the UserAward entity by IDs.
+func (uc *UserCreate) AddAwardIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddAwardIDs(ids...)
+	return uc
+}
+
+// AddAwards adds the "awards" edges to the UserAward entity.
+func (uc *UserCreate) AddAwards(u ...*UserAward) *UserCreate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uc.AddAwardIDs(ids...)
+}
+
+// AddBlockedUserIDs adds the "blocked_users" edge to the User entity by IDs.
+func (uc *UserCreate) AddBlockedUserIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddBlockedUserIDs(ids...)
+	return uc
+}
+
+// AddBlockedUsers adds the "blocked_users" edges to the User entity.
+func (uc *UserCreate) AddBlockedUsers(u ...*User) *UserCreate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uc.AddBlockedUserIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the User entity by IDs.
+func (uc *UserCreate) AddBlockedByIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddBlockedByIDs(ids...)
+	return uc
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the User entity.
+func (uc *UserCreate) AddBlockedBy(u ...*User) *UserCreate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uc.AddBlockedByIDs(ids...)
+}
+
+// AddMutedUserIDs adds the "muted_users" edge to the User entity by IDs.
+func (uc *UserCreate) AddMutedUserIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddMutedUserIDs(ids...)
+	return uc
+}
+
+// AddMutedUsers adds the "muted_users" edges to the User entity.
+func (uc *UserCreate) AddMutedUsers(u ...*User) *UserCreate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uc.AddMutedUserIDs(ids...)
+}
+
+// AddMutedByIDs adds the "muted_by" edge to the User entity by IDs.
+func (uc *UserCreate) AddMutedByIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddMutedByIDs(ids...)
+	return uc
+}
+
+// AddMutedBy adds the "muted_by" edges to the User entity.
+func (uc *UserCreate) AddMutedBy(u ...*User) *UserCreate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uc.AddMutedByIDs(ids...)
+}
+
+// AddIdentityIDs adds the "identities" edge to the Identity entity by IDs.
+func (uc *UserCreate) AddIdentityIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddIdentityIDs(ids...)
+	return uc
+}
+
+// AddIdentities adds the "identities" edges to the Identity entity.
+func (uc *UserCreate) AddIdentities(i ...*Identity) *UserCreate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uc.AddIdentityIDs(ids...)
+}
+
 // AddRefreshTokenIDs adds the "refresh_tokens" edge to the RefreshToken entity by IDs.
 func (uc *UserCreate) AddRefreshTokenIDs(ids ...uuid.UUID) *UserCreate {
 	uc.mutation.AddRefreshTokenIDs(ids...)
@@ -287,6 +406,36 @@ func (uc *UserCreate) AddRefreshTokens(r ...*RefreshToken) *UserCreate {
 	return uc.AddRefreshTokenIDs(ids...)
 }
 
+// AddRoleChangeIDs adds the "role_changes" edge to the RoleChange entity by IDs.
+func (uc *UserCreate) AddRoleChangeIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddRoleChangeIDs(ids...)
+	return uc
+}
+
+// AddRoleChanges adds the "role_changes" edges to the RoleChange entity.
+func (uc *UserCreate) AddRoleChanges(r ...*RoleChange) *UserCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddRoleChangeIDs(ids...)
+}
+
+// AddSuspensionIDs adds the "suspensions" edge to the Suspension entity by IDs.
+func (uc *UserCreate) AddSuspensionIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddSuspensionIDs(ids...)
+	return uc
+}
+
+// AddSuspensions adds the "suspensions" edges to the Suspension entity.
+func (uc *UserCreate) AddSuspensions(s ...*Suspension) *UserCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddSuspensionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -346,6 +495,10 @@ func (uc *UserCreate) defaults() error {
 		v := user.DefaultRole
 		uc.mutation.SetRole(v)
 	}
+	if _, ok := uc.mutation.TwitchVip(); !ok {
+		v := user.DefaultTwitchVip
+		uc.mutation.SetTwitchVip(v)
+	}
 	if _, ok := uc.mutation.ID(); !ok {
 		if user.DefaultID == nil {
 			return fmt.Errorf("generated: uninitialized user.DefaultID (forgotten import generated/runtime?)")
@@ -386,6 +539,14 @@ func (uc *UserCreate) check() error {
 			return &ValidationError{Name: "role", err: fmt.Errorf(`generated: validator failed for field "User.role": %w`, err)}
 		}
 	}
+	if v, ok := uc.mutation.RoleSource(); ok {
+		if err := user.RoleSourceValidator(v); err != nil {
+			return &ValidationError{Name: "role_source", err: fmt.Errorf(`generated: validator failed for field "User.role_source": %w`, err)}
+		}
+	}
+	if _, ok := uc.mutation.TwitchVip(); !ok {
+		return &ValidationError{Name: "twitch_vip", err: errors.New(`generated: missing required field "User.twitch_vip"`)}
+	}
 	return nil
 }
 
@@ -417,6 +578,7 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_node = &User{config: uc.config}
 		_spec = sqlgraph.NewCreateSpec(user.Table, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = uc.conflict
 	if id, ok := uc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -461,6 +623,14 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldRole, field.TypeEnum, value)
 		_node.Role = value
 	}
+	if value, ok := uc.mutation.RoleSource(); ok {
+		_spec.SetField(user.FieldRoleSource, field.TypeEnum, value)
+		_node.RoleSource = &value
+	}
+	if value, ok := uc.mutation.TwitchVip(); ok {
+		_spec.SetField(user.FieldTwitchVip, field.TypeBool, value)
+		_node.TwitchVip = value
+	}
 	if value, ok := uc.mutation.LastSeenAt(); ok {
 		_spec.SetField(user.FieldLastSeenAt, field.TypeTime, value)
 		_node.LastSeenAt = &value
@@ -469,10 +639,6 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldLastPostSeenCursor, field.TypeString, value)
 		_node.LastPostSeenCursor = &value
 	}
-	if value, ok := uc.mutation.Awards(); ok {
-		_spec.SetField(user.FieldAwards, field.TypeJSON, value)
-		_node.Awards = value
-	}
 	if nodes := uc.mutation.SavedPostsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -553,6 +719,102 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.AwardsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AwardsTable,
+			Columns: []string{user.AwardsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useraward.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.BlockedUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.BlockedUsersTable,
+			Columns: user.BlockedUsersPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.BlockedByTable,
+			Columns: user.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.MutedUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.MutedUsersTable,
+			Columns: user.MutedUsersPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.MutedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MutedByTable,
+			Columns: user.MutedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := uc.mutation.RefreshTokensIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -569,14 +831,614 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.RoleChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoleChangesTable,
+			Columns: []string{user.RoleChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolechange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.SuspensionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SuspensionsTable,
+			Columns: []string{user.SuspensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.User.Create().
+//		SetUpdatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserUpsert) {
+//			SetUpdatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (uc *UserCreate) OnConflict(opts ...sql.ConflictOption) *UserUpsertOne {
+	uc.conflict = opts
+	return &UserUpsertOne{
+		create: uc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (uc *UserCreate) OnConflictColumns(columns ...string) *UserUpsertOne {
+	uc.conflict = append(uc.conflict, sql.ConflictColumns(columns...))
+	return &UserUpsertOne{
+		create: uc,
+	}
+}
+
+type (
+	// UserUpsertOne is the builder for "upsert"-ing
+	//  one User node.
+	UserUpsertOne struct {
+		create *UserCreate
+	}
+
+	// UserUpsert is the "OnConflict" setter.
+	UserUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserUpsert) SetUpdatedAt(v time.Time) *UserUpsert {
+	u.Set(user.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserUpsert) UpdateUpdatedAt() *UserUpsert {
+	u.SetExcluded(user.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *UserUpsert) SetDeletedAt(v time.Time) *UserUpsert {
+	u.Set(user.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *UserUpsert) UpdateDeletedAt() *UserUpsert {
+	u.SetExcluded(user.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *UserUpsert) ClearDeletedAt() *UserUpsert {
+	u.SetNull(user.FieldDeletedAt)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *UserUpsert) SetDeletedBy(v string) *UserUpsert {
+	u.Set(user.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *UserUpsert) UpdateDeletedBy() *UserUpsert {
+	u.SetExcluded(user.FieldDeletedBy)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *UserUpsert) ClearDeletedBy() *UserUpsert {
+	u.SetNull(user.FieldDeletedBy)
+	return u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *UserUpsert) SetDisplayName(v string) *UserUpsert {
+	u.Set(user.FieldDisplayName, v)
+	return u
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *UserUpsert) UpdateDisplayName() *UserUpsert {
+	u.SetExcluded(user.FieldDisplayName)
+	return u
+}
+
+// SetAlias sets the "alias" field.
+func (u *UserUpsert) SetAlias(v string) *UserUpsert {
+	u.Set(user.FieldAlias, v)
+	return u
+}
+
+// UpdateAlias sets the "alias" field to the value that was provided on create.
+func (u *UserUpsert) UpdateAlias() *UserUpsert {
+	u.SetExcluded(user.FieldAlias)
+	return u
+}
+
+// ClearAlias clears the value of the "alias" field.
+func (u *UserUpsert) ClearAlias() *UserUpsert {
+	u.SetNull(user.FieldAlias)
+	return u
+}
+
+// SetProfileImage sets the "profile_image" field.
+func (u *UserUpsert) SetProfileImage(v string) *UserUpsert {
+	u.Set(user.FieldProfileImage, v)
+	return u
+}
+
+// UpdateProfileImage sets the "profile_image" field to the value that was provided on create.
+func (u *UserUpsert) UpdateProfileImage() *UserUpsert {
+	u.SetExcluded(user.FieldProfileImage)
+	return u
+}
+
+// ClearProfileImage clears the value of the "profile_image" field.
+func (u *UserUpsert) ClearProfileImage() *UserUpsert {
+	u.SetNull(user.FieldProfileImage)
+	return u
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *UserUpsert) SetExternalID(v string) *UserUpsert {
+	u.Set(user.FieldExternalID, v)
+	return u
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *UserUpsert) UpdateExternalID() *UserUpsert {
+	u.SetExcluded(user.FieldExternalID)
+	return u
+}
+
+// SetAuthProvider sets the "auth_provider" field.
+func (u *UserUpsert) SetAuthProvider(v user.AuthProvider) *UserUpsert {
+	u.Set(user.FieldAuthProvider, v)
+	return u
+}
+
+// UpdateAuthProvider sets the "auth_provider" field to the value that was provided on create.
+func (u *UserUpsert) UpdateAuthProvider() *UserUpsert {
+	u.SetExcluded(user.FieldAuthProvider)
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *UserUpsert) SetRole(v user.Role) *UserUpsert {
+	u.Set(user.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *UserUpsert) UpdateRole() *UserUpsert {
+	u.SetExcluded(user.FieldRole)
+	return u
+}
+
+// SetRoleSource sets the "role_source" field.
+func (u *UserUpsert) SetRoleSource(v user.RoleSource) *UserUpsert {
+	u.Set(user.FieldRoleSource, v)
+	return u
+}
+
+// UpdateRoleSource sets the "role_source" field to the value that was provided on create.
+func (u *UserUpsert) UpdateRoleSource() *UserUpsert {
+	u.SetExcluded(user.FieldRoleSource)
+	return u
+}
+
+// ClearRoleSource clears the value of the "role_source" field.
+func (u *UserUpsert) ClearRoleSource() *UserUpsert {
+	u.SetNull(user.FieldRoleSource)
+	return u
+}
+
+// SetTwitchVip sets the "twitch_vip" field.
+func (u *UserUpsert) SetTwitchVip(v bool) *UserUpsert {
+	u.Set(user.FieldTwitchVip, v)
+	return u
+}
+
+// UpdateTwitchVip sets the "twitch_vip" field to the value that was provided on create.
+func (u *UserUpsert) UpdateTwitchVip() *UserUpsert {
+	u.SetExcluded(user.FieldTwitchVip)
+	return u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *UserUpsert) SetLastSeenAt(v time.Time) *UserUpsert {
+	u.Set(user.FieldLastSeenAt, v)
+	return u
+}
+
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *UserUpsert) UpdateLastSeenAt() *UserUpsert {
+	u.SetExcluded(user.FieldLastSeenAt)
+	return u
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (u *UserUpsert) ClearLastSeenAt() *UserUpsert {
+	u.SetNull(user.FieldLastSeenAt)
+	return u
+}
+
+// SetLastPostSeenCursor sets the "last_post_seen_cursor" field.
+func (u *UserUpsert) SetLastPostSeenCursor(v string) *UserUpsert {
+	u.Set(user.FieldLastPostSeenCursor, v)
+	return u
+}
+
+// UpdateLastPostSeenCursor sets the "last_post_seen_cursor" field to the value that was provided on create.
+func (u *UserUpsert) UpdateLastPostSeenCursor() *UserUpsert {
+	u.SetExcluded(user.FieldLastPostSeenCursor)
+	return u
+}
+
+// ClearLastPostSeenCursor clears the value of the "last_post_seen_cursor" field.
+func (u *UserUpsert) ClearLastPostSeenCursor() *UserUpsert {
+	u.SetNull(user.FieldLastPostSeenCursor)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(user.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserUpsertOne) UpdateNewValues() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(user.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(user.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.User.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserUpsertOne) Ignore() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserUpsertOne) DoNothing() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserCreate.OnConflict
+// documentation for more info.
+func (u *UserUpsertOne) Update(set func(*UserUpsert)) *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserUpsertOne) SetUpdatedAt(v time.Time) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateUpdatedAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *UserUpsertOne) SetDeletedAt(v time.Time) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateDeletedAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *UserUpsertOne) ClearDeletedAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *UserUpsertOne) SetDeletedBy(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateDeletedBy() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *UserUpsertOne) ClearDeletedBy() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *UserUpsertOne) SetDisplayName(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateDisplayName() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// SetAlias sets the "alias" field.
+func (u *UserUpsertOne) SetAlias(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetAlias(v)
+	})
+}
+
+// UpdateAlias sets the "alias" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateAlias() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateAlias()
+	})
+}
+
+// ClearAlias clears the value of the "alias" field.
+func (u *UserUpsertOne) ClearAlias() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearAlias()
+	})
+}
+
+// SetProfileImage sets the "profile_image" field.
+func (u *UserUpsertOne) SetProfileImage(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetProfileImage(v)
+	})
+}
+
+// UpdateProfileImage sets the "profile_image" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateProfileImage() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateProfileImage()
+	})
+}
+
+// ClearProfileImage clears the value of the "profile_image" field.
+func (u *UserUpsertOne) ClearProfileImage() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearProfileImage()
+	})
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *UserUpsertOne) SetExternalID(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetExternalID(v)
+	})
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateExternalID() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateExternalID()
+	})
+}
+
+// SetAuthProvider sets the "auth_provider" field.
+func (u *UserUpsertOne) SetAuthProvider(v user.AuthProvider) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetAuthProvider(v)
+	})
+}
+
+// UpdateAuthProvider sets the "auth_provider" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateAuthProvider() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateAuthProvider()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *UserUpsertOne) SetRole(v user.Role) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateRole() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetRoleSource sets the "role_source" field.
+func (u *UserUpsertOne) SetRoleSource(v user.RoleSource) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetRoleSource(v)
+	})
+}
+
+// UpdateRoleSource sets the "role_source" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateRoleSource() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateRoleSource()
+	})
+}
+
+// ClearRoleSource clears the value of the "role_source" field.
+func (u *UserUpsertOne) ClearRoleSource() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearRoleSource()
+	})
+}
+
+// SetTwitchVip sets the "twitch_vip" field.
+func (u *UserUpsertOne) SetTwitchVip(v bool) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetTwitchVip(v)
+	})
+}
+
+// UpdateTwitchVip sets the "twitch_vip" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateTwitchVip() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateTwitchVip()
+	})
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *UserUpsertOne) SetLastSeenAt(v time.Time) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetLastSeenAt(v)
+	})
+}
+
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateLastSeenAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateLastSeenAt()
+	})
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (u *UserUpsertOne) ClearLastSeenAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearLastSeenAt()
+	})
+}
+
+// SetLastPostSeenCursor sets the "last_post_seen_cursor" field.
+func (u *UserUpsertOne) SetLastPostSeenCursor(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetLastPostSeenCursor(v)
+	})
+}
+
+// UpdateLastPostSeenCursor sets the "last_post_seen_cursor" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateLastPostSeenCursor() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateLastPostSeenCursor()
+	})
+}
+
+// ClearLastPostSeenCursor clears the value of the "last_post_seen_cursor" field.
+func (u *UserUpsertOne) ClearLastPostSeenCursor() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearLastPostSeenCursor()
+	})
+}
+
+// Exec executes the query.
+func (u *UserUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for UserCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: UserUpsertOne.ID is not supported by MySQL driver. Use UserUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // UserCreateBulk is the builder for creating many User entities in bulk.
 type UserCreateBulk struct {
 	config
 	err      error
 	builders []*UserCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the User entities in the database.
@@ -606,6 +1468,7 @@ func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 					_, err = mutators[i+1].Mutate(root, ucb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ucb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ucb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -655,3 +1518,351 @@ func (ucb *UserCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.User.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserUpsert) {
+//			SetUpdatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ucb *UserCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserUpsertBulk {
+	ucb.conflict = opts
+	return &UserUpsertBulk{
+		create: ucb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ucb *UserCreateBulk) OnConflictColumns(columns ...string) *UserUpsertBulk {
+	ucb.conflict = append(ucb.conflict, sql.ConflictColumns(columns...))
+	return &UserUpsertBulk{
+		create: ucb,
+	}
+}
+
+// UserUpsertBulk is the builder for "upsert"-ing
+// a bulk of User nodes.
+type UserUpsertBulk struct {
+	create *UserCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(user.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserUpsertBulk) UpdateNewValues() *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(user.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(user.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserUpsertBulk) Ignore() *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserUpsertBulk) DoNothing() *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserUpsertBulk) Update(set func(*UserUpsert)) *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserUpsertBulk) SetUpdatedAt(v time.Time) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateUpdatedAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *UserUpsertBulk) SetDeletedAt(v time.Time) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateDeletedAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *UserUpsertBulk) ClearDeletedAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *UserUpsertBulk) SetDeletedBy(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateDeletedBy() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *UserUpsertBulk) ClearDeletedBy() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *UserUpsertBulk) SetDisplayName(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateDisplayName() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// SetAlias sets the "alias" field.
+func (u *UserUpsertBulk) SetAlias(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetAlias(v)
+	})
+}
+
+// UpdateAlias sets the "alias" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateAlias() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateAlias()
+	})
+}
+
+// ClearAlias clears the value of the "alias" field.
+func (u *UserUpsertBulk) ClearAlias() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearAlias()
+	})
+}
+
+// SetProfileImage sets the "profile_image" field.
+func (u *UserUpsertBulk) SetProfileImage(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetProfileImage(v)
+	})
+}
+
+// UpdateProfileImage sets the "profile_image" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateProfileImage() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateProfileImage()
+	})
+}
+
+// ClearProfileImage clears the value of the "profile_image" field.
+func (u *UserUpsertBulk) ClearProfileImage() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearProfileImage()
+	})
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *UserUpsertBulk) SetExternalID(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetExternalID(v)
+	})
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateExternalID() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateExternalID()
+	})
+}
+
+// SetAuthProvider sets the "auth_provider" field.
+func (u *UserUpsertBulk) SetAuthProvider(v user.AuthProvider) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetAuthProvider(v)
+	})
+}
+
+// UpdateAuthProvider sets the "auth_provider" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateAuthProvider() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateAuthProvider()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *UserUpsertBulk) SetRole(v user.Role) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateRole() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetRoleSource sets the "role_source" field.
+func (u *UserUpsertBulk) SetRoleSource(v user.RoleSource) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetRoleSource(v)
+	})
+}
+
+// UpdateRoleSource sets the "role_source" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateRoleSource() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateRoleSource()
+	})
+}
+
+// ClearRoleSource clears the value of the "role_source" field.
+func (u *UserUpsertBulk) ClearRoleSource() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearRoleSource()
+	})
+}
+
+// SetTwitchVip sets the "twitch_vip" field.
+func (u *UserUpsertBulk) SetTwitchVip(v bool) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetTwitchVip(v)
+	})
+}
+
+// UpdateTwitchVip sets the "twitch_vip" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateTwitchVip() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateTwitchVip()
+	})
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *UserUpsertBulk) SetLastSeenAt(v time.Time) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetLastSeenAt(v)
+	})
+}
+
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateLastSeenAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateLastSeenAt()
+	})
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (u *UserUpsertBulk) ClearLastSeenAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearLastSeenAt()
+	})
+}
+
+// SetLastPostSeenCursor sets the "last_post_seen_cursor" field.
+func (u *UserUpsertBulk) SetLastPostSeenCursor(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetLastPostSeenCursor(v)
+	})
+}
+
+// UpdateLastPostSeenCursor sets the "last_post_seen_cursor" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateLastPostSeenCursor() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateLastPostSeenCursor()
+	})
+}
+
+// ClearLastPostSeenCursor clears the value of the "last_post_seen_cursor" field.
+func (u *UserUpsertBulk) ClearLastPostSeenCursor() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearLastPostSeenCursor()
+	})
+}
+
+// Exec executes the query.
+func (u *UserUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the UserCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for UserCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
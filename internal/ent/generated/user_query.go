@@ -16,10 +16,14 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/apikey"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/comment"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/post"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/refreshtoken"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
 	"github.com/google/uuid"
 )
 
@@ -35,7 +39,15 @@ type UserQuery struct {
 	withPublishedPosts      *PostQuery
 	withComments            *CommentQuery
 	withAPIKeys             *ApiKeyQuery
+	withAwards              *UserAwardQuery
+	withBlockedUsers        *UserQuery
+	withBlockedBy           *UserQuery
+	withMutedUsers          *UserQuery
+	withMutedBy             *UserQuery
+	withIdentities          *IdentityQuery
 	withRefreshTokens       *RefreshTokenQuery
+	withRoleChanges         *RoleChangeQuery
+	withSuspensions         *SuspensionQuery
 	loadTotal               []func(context.Context, []*User) error
 	modifiers               []func(*sql.Selector)
 	withNamedSavedPosts     map[string]*PostQuery
@@ -43,7 +55,15 @@ type UserQuery struct {
 	withNamedPublishedPosts map[string]*PostQuery
 	withNamedComments       map[string]*CommentQuery
 	withNamedAPIKeys        map[string]*ApiKeyQuery
+	withNamedAwards         map[string]*UserAwardQuery
+	withNamedBlockedUsers   map[string]*UserQuery
+	withNamedBlockedBy      map[string]*UserQuery
+	withNamedMutedUsers     map[string]*UserQuery
+	withNamedMutedBy        map[string]*UserQuery
+	withNamedIdentities     map[string]*IdentityQuery
 	withNamedRefreshTokens  map[string]*RefreshTokenQuery
+	withNamedRoleChanges    map[string]*RoleChangeQuery
+	withNamedSuspensions    map[string]*SuspensionQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -190,6 +210,138 @@ func (uq *UserQuery) QueryAPIKeys() *ApiKeyQuery {
 	return query
 }
 
+// QueryAwards chains the current query on the "awards" edge.
+func (uq *UserQuery) QueryAwards() *UserAwardQuery {
+	query := (&UserAwardClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(useraward.Table, useraward.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AwardsTable, user.AwardsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBlockedUsers chains the current query on the "blocked_users" edge.
+func (uq *UserQuery) QueryBlockedUsers() *UserQuery {
+	query := (&UserClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, user.BlockedUsersTable, user.BlockedUsersPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBlockedBy chains the current query on the "blocked_by" edge.
+func (uq *UserQuery) QueryBlockedBy() *UserQuery {
+	query := (&UserClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, user.BlockedByTable, user.BlockedByPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMutedUsers chains the current query on the "muted_users" edge.
+func (uq *UserQuery) QueryMutedUsers() *UserQuery {
+	query := (&UserClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, user.MutedUsersTable, user.MutedUsersPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMutedBy chains the current query on the "muted_by" edge.
+func (uq *UserQuery) QueryMutedBy() *UserQuery {
+	query := (&UserClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, user.MutedByTable, user.MutedByPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryIdentities chains the current query on the "identities" edge.
+func (uq *UserQuery) QueryIdentities() *IdentityQuery {
+	query := (&IdentityClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(identity.Table, identity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.IdentitiesTable, user.IdentitiesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryRefreshTokens chains the current query on the "refresh_tokens" edge.
 func (uq *UserQuery) QueryRefreshTokens() *RefreshTokenQuery {
 	query := (&RefreshTokenClient{config: uq.config}).Query()
@@ -212,6 +364,50 @@ func (uq *UserQuery) QueryRefreshTokens() *RefreshTokenQuery {
 	return query
 }
 
+// QueryRoleChanges chains the current query on the "role_changes" edge.
+func (uq *UserQuery) QueryRoleChanges() *RoleChangeQuery {
+	query := (&RoleChangeClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(rolechange.Table, rolechange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RoleChangesTable, user.RoleChangesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySuspensions chains the current query on the "suspensions" edge.
+func (uq *UserQuery) QuerySuspensions() *SuspensionQuery {
+	query := (&SuspensionClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(suspension.Table, suspension.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SuspensionsTable, user.SuspensionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
@@ -409,7 +605,15 @@ func (uq *UserQuery) Clone() *UserQuery {
 		withPublishedPosts: uq.withPublishedPosts.Clone(),
 		withComments:       uq.withComments.Clone(),
 		withAPIKeys:        uq.withAPIKeys.Clone(),
+		withAwards:         uq.withAwards.Clone(),
+		withBlockedUsers:   uq.withBlockedUsers.Clone(),
+		withBlockedBy:      uq.withBlockedBy.Clone(),
+		withMutedUsers:     uq.withMutedUsers.Clone(),
+		withMutedBy:        uq.withMutedBy.Clone(),
+		withIdentities:     uq.withIdentities.Clone(),
 		withRefreshTokens:  uq.withRefreshTokens.Clone(),
+		withRoleChanges:    uq.withRoleChanges.Clone(),
+		withSuspensions:    uq.withSuspensions.Clone(),
 		// clone intermediate query.
 		sql:       uq.sql.Clone(),
 		path:      uq.path,
@@ -472,6 +676,72 @@ func (uq *UserQuery) WithAPIKeys(opts ...func(*ApiKeyQuery)) *UserQuery {
 	return uq
 }
 
+// WithAwards tells the query-builder to eager-load the nodes that are connected to
+// the "awards" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithAwards(opts ...func(*UserAwardQuery)) *UserQuery {
+	query := (&UserAwardClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withAwards = query
+	return uq
+}
+
+// WithBlockedUsers tells the query-builder to eager-load the nodes that are connected to
+// the "blocked_users" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithBlockedUsers(opts ...func(*UserQuery)) *UserQuery {
+	query := (&UserClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withBlockedUsers = query
+	return uq
+}
+
+// WithBlockedBy tells the query-builder to eager-load the nodes that are connected to
+// the "blocked_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithBlockedBy(opts ...func(*UserQuery)) *UserQuery {
+	query := (&UserClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withBlockedBy = query
+	return uq
+}
+
+// WithMutedUsers tells the query-builder to eager-load the nodes that are connected to
+// the "muted_users" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithMutedUsers(opts ...func(*UserQuery)) *UserQuery {
+	query := (&UserClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withMutedUsers = query
+	return uq
+}
+
+// WithMutedBy tells the query-builder to eager-load the nodes that are connected to
+// the "muted_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithMutedBy(opts ...func(*UserQuery)) *UserQuery {
+	query := (&UserClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withMutedBy = query
+	return uq
+}
+
+// WithIdentities tells the query-builder to eager-load the nodes that are connected to
+// the "identities" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithIdentities(opts ...func(*IdentityQuery)) *UserQuery {
+	query := (&IdentityClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withIdentities = query
+	return uq
+}
+
 // WithRefreshTokens tells the query-builder to eager-load the nodes that are connected to
 // the "refresh_tokens" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithRefreshTokens(opts ...func(*RefreshTokenQuery)) *UserQuery {
@@ -483,6 +753,28 @@ func (uq *UserQuery) WithRefreshTokens(opts ...func(*RefreshTokenQuery)) *UserQu
 	return uq
 }
 
+// WithRoleChanges tells the query-builder to eager-load the nodes that are connected to
+// the "role_changes" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithRoleChanges(opts ...func(*RoleChangeQuery)) *UserQuery {
+	query := (&RoleChangeClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withRoleChanges = query
+	return uq
+}
+
+// WithSuspensions tells the query-builder to eager-load the nodes that are connected to
+// the "suspensions" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithSuspensions(opts ...func(*SuspensionQuery)) *UserQuery {
+	query := (&SuspensionClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withSuspensions = query
+	return uq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -567,13 +859,21 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = uq.querySpec()
-		loadedTypes = [6]bool{
+		loadedTypes = [14]bool{
 			uq.withSavedPosts != nil,
 			uq.withLikedPosts != nil,
 			uq.withPublishedPosts != nil,
 			uq.withComments != nil,
 			uq.withAPIKeys != nil,
+			uq.withAwards != nil,
+			uq.withBlockedUsers != nil,
+			uq.withBlockedBy != nil,
+			uq.withMutedUsers != nil,
+			uq.withMutedBy != nil,
+			uq.withIdentities != nil,
 			uq.withRefreshTokens != nil,
+			uq.withRoleChanges != nil,
+			uq.withSuspensions != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -632,6 +932,48 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := uq.withAwards; query != nil {
+		if err := uq.loadAwards(ctx, query, nodes,
+			func(n *User) { n.Edges.Awards = []*UserAward{} },
+			func(n *User, e *UserAward) { n.Edges.Awards = append(n.Edges.Awards, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withBlockedUsers; query != nil {
+		if err := uq.loadBlockedUsers(ctx, query, nodes,
+			func(n *User) { n.Edges.BlockedUsers = []*User{} },
+			func(n *User, e *User) { n.Edges.BlockedUsers = append(n.Edges.BlockedUsers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withBlockedBy; query != nil {
+		if err := uq.loadBlockedBy(ctx, query, nodes,
+			func(n *User) { n.Edges.BlockedBy = []*User{} },
+			func(n *User, e *User) { n.Edges.BlockedBy = append(n.Edges.BlockedBy, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withMutedUsers; query != nil {
+		if err := uq.loadMutedUsers(ctx, query, nodes,
+			func(n *User) { n.Edges.MutedUsers = []*User{} },
+			func(n *User, e *User) { n.Edges.MutedUsers = append(n.Edges.MutedUsers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withMutedBy; query != nil {
+		if err := uq.loadMutedBy(ctx, query, nodes,
+			func(n *User) { n.Edges.MutedBy = []*User{} },
+			func(n *User, e *User) { n.Edges.MutedBy = append(n.Edges.MutedBy, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withIdentities; query != nil {
+		if err := uq.loadIdentities(ctx, query, nodes,
+			func(n *User) { n.Edges.Identities = []*Identity{} },
+			func(n *User, e *Identity) { n.Edges.Identities = append(n.Edges.Identities, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := uq.withRefreshTokens; query != nil {
 		if err := uq.loadRefreshTokens(ctx, query, nodes,
 			func(n *User) { n.Edges.RefreshTokens = []*RefreshToken{} },
@@ -639,6 +981,20 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := uq.withRoleChanges; query != nil {
+		if err := uq.loadRoleChanges(ctx, query, nodes,
+			func(n *User) { n.Edges.RoleChanges = []*RoleChange{} },
+			func(n *User, e *RoleChange) { n.Edges.RoleChanges = append(n.Edges.RoleChanges, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withSuspensions; query != nil {
+		if err := uq.loadSuspensions(ctx, query, nodes,
+			func(n *User) { n.Edges.Suspensions = []*Suspension{} },
+			func(n *User, e *Suspension) { n.Edges.Suspensions = append(n.Edges.Suspensions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range uq.withNamedSavedPosts {
 		if err := uq.loadSavedPosts(ctx, query, nodes,
 			func(n *User) { n.appendNamedSavedPosts(name) },
@@ -674,6 +1030,48 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	for name, query := range uq.withNamedAwards {
+		if err := uq.loadAwards(ctx, query, nodes,
+			func(n *User) { n.appendNamedAwards(name) },
+			func(n *User, e *UserAward) { n.appendNamedAwards(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedBlockedUsers {
+		if err := uq.loadBlockedUsers(ctx, query, nodes,
+			func(n *User) { n.appendNamedBlockedUsers(name) },
+			func(n *User, e *User) { n.appendNamedBlockedUsers(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedBlockedBy {
+		if err := uq.loadBlockedBy(ctx, query, nodes,
+			func(n *User) { n.appendNamedBlockedBy(name) },
+			func(n *User, e *User) { n.appendNamedBlockedBy(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedMutedUsers {
+		if err := uq.loadMutedUsers(ctx, query, nodes,
+			func(n *User) { n.appendNamedMutedUsers(name) },
+			func(n *User, e *User) { n.appendNamedMutedUsers(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedMutedBy {
+		if err := uq.loadMutedBy(ctx, query, nodes,
+			func(n *User) { n.appendNamedMutedBy(name) },
+			func(n *User, e *User) { n.appendNamedMutedBy(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedIdentities {
+		if err := uq.loadIdentities(ctx, query, nodes,
+			func(n *User) { n.appendNamedIdentities(name) },
+			func(n *User, e *Identity) { n.appendNamedIdentities(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range uq.withNamedRefreshTokens {
 		if err := uq.loadRefreshTokens(ctx, query, nodes,
 			func(n *User) { n.appendNamedRefreshTokens(name) },
@@ -681,6 +1079,20 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	for name, query := range uq.withNamedRoleChanges {
+		if err := uq.loadRoleChanges(ctx, query, nodes,
+			func(n *User) { n.appendNamedRoleChanges(name) },
+			func(n *User, e *RoleChange) { n.appendNamedRoleChanges(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedSuspensions {
+		if err := uq.loadSuspensions(ctx, query, nodes,
+			func(n *User) { n.appendNamedSuspensions(name) },
+			func(n *User, e *Suspension) { n.appendNamedSuspensions(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for i := range uq.loadTotal {
 		if err := uq.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
@@ -902,7 +1314,7 @@ func (uq *UserQuery) loadAPIKeys(ctx context.Context, query *ApiKeyQuery, nodes
 	}
 	return nil
 }
-func (uq *UserQuery) loadRefreshTokens(ctx context.Context, query *RefreshTokenQuery, nodes []*User, init func(*User), assign func(*User, *RefreshToken)) error {
+func (uq *UserQuery) loadAwards(ctx context.Context, query *UserAwardQuery, nodes []*User, init func(*User), assign func(*User, *UserAward)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*User)
 	for i := range nodes {
@@ -913,10 +1325,10 @@ func (uq *UserQuery) loadRefreshTokens(ctx context.Context, query *RefreshTokenQ
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(refreshtoken.FieldOwnerID)
+		query.ctx.AppendFieldOnce(useraward.FieldOwnerID)
 	}
-	query.Where(predicate.RefreshToken(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.RefreshTokensColumn), fks...))
+	query.Where(predicate.UserAward(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.AwardsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -932,18 +1344,382 @@ func (uq *UserQuery) loadRefreshTokens(ctx context.Context, query *RefreshTokenQ
 	}
 	return nil
 }
-
-func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := uq.querySpec()
-	if len(uq.modifiers) > 0 {
-		_spec.Modifiers = uq.modifiers
-	}
-	_spec.Node.Columns = uq.ctx.Fields
-	if len(uq.ctx.Fields) > 0 {
-		_spec.Unique = uq.ctx.Unique != nil && *uq.ctx.Unique
+func (uq *UserQuery) loadBlockedUsers(ctx context.Context, query *UserQuery, nodes []*User, init func(*User), assign func(*User, *User)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*User)
+	nids := make(map[uuid.UUID]map[*User]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
 	}
-	return sqlgraph.CountNodes(ctx, uq.driver, _spec)
-}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(user.BlockedUsersTable)
+		s.Join(joinT).On(s.C(user.FieldID), joinT.C(user.BlockedUsersPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(user.BlockedUsersPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(user.BlockedUsersPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*User]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*User](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "blocked_users" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (uq *UserQuery) loadBlockedBy(ctx context.Context, query *UserQuery, nodes []*User, init func(*User), assign func(*User, *User)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*User)
+	nids := make(map[uuid.UUID]map[*User]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(user.BlockedByTable)
+		s.Join(joinT).On(s.C(user.FieldID), joinT.C(user.BlockedByPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(user.BlockedByPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(user.BlockedByPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*User]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*User](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "blocked_by" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (uq *UserQuery) loadMutedUsers(ctx context.Context, query *UserQuery, nodes []*User, init func(*User), assign func(*User, *User)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*User)
+	nids := make(map[uuid.UUID]map[*User]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(user.MutedUsersTable)
+		s.Join(joinT).On(s.C(user.FieldID), joinT.C(user.MutedUsersPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(user.MutedUsersPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(user.MutedUsersPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*User]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*User](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "muted_users" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (uq *UserQuery) loadMutedBy(ctx context.Context, query *UserQuery, nodes []*User, init func(*User), assign func(*User, *User)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*User)
+	nids := make(map[uuid.UUID]map[*User]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(user.MutedByTable)
+		s.Join(joinT).On(s.C(user.FieldID), joinT.C(user.MutedByPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(user.MutedByPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(user.MutedByPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*User]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*User](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "muted_by" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (uq *UserQuery) loadIdentities(ctx context.Context, query *IdentityQuery, nodes []*User, init func(*User), assign func(*User, *Identity)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(identity.FieldOwnerID)
+	}
+	query.Where(predicate.Identity(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.IdentitiesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OwnerID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "owner_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadRefreshTokens(ctx context.Context, query *RefreshTokenQuery, nodes []*User, init func(*User), assign func(*User, *RefreshToken)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(refreshtoken.FieldOwnerID)
+	}
+	query.Where(predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.RefreshTokensColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OwnerID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "owner_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadRoleChanges(ctx context.Context, query *RoleChangeQuery, nodes []*User, init func(*User), assign func(*User, *RoleChange)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(rolechange.FieldUserID)
+	}
+	query.Where(predicate.RoleChange(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.RoleChangesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadSuspensions(ctx context.Context, query *SuspensionQuery, nodes []*User, init func(*User), assign func(*User, *Suspension)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(suspension.FieldUserID)
+	}
+	query.Where(predicate.Suspension(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SuspensionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := uq.querySpec()
+	if len(uq.modifiers) > 0 {
+		_spec.Modifiers = uq.modifiers
+	}
+	_spec.Node.Columns = uq.ctx.Fields
+	if len(uq.ctx.Fields) > 0 {
+		_spec.Unique = uq.ctx.Unique != nil && *uq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, uq.driver, _spec)
+}
 
 func (uq *UserQuery) querySpec() *sqlgraph.QuerySpec {
 	_spec := sqlgraph.NewQuerySpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
@@ -1122,6 +1898,90 @@ func (uq *UserQuery) WithNamedAPIKeys(name string, opts ...func(*ApiKeyQuery)) *
 	return uq
 }
 
+// WithNamedAwards tells the query-builder to eager-load the nodes that are connected to the "awards"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedAwards(name string, opts ...func(*UserAwardQuery)) *UserQuery {
+	query := (&UserAwardClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedAwards == nil {
+		uq.withNamedAwards = make(map[string]*UserAwardQuery)
+	}
+	uq.withNamedAwards[name] = query
+	return uq
+}
+
+// WithNamedBlockedUsers tells the query-builder to eager-load the nodes that are connected to the "blocked_users"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedBlockedUsers(name string, opts ...func(*UserQuery)) *UserQuery {
+	query := (&UserClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedBlockedUsers == nil {
+		uq.withNamedBlockedUsers = make(map[string]*UserQuery)
+	}
+	uq.withNamedBlockedUsers[name] = query
+	return uq
+}
+
+// WithNamedBlockedBy tells the query-builder to eager-load the nodes that are connected to the "blocked_by"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedBlockedBy(name string, opts ...func(*UserQuery)) *UserQuery {
+	query := (&UserClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedBlockedBy == nil {
+		uq.withNamedBlockedBy = make(map[string]*UserQuery)
+	}
+	uq.withNamedBlockedBy[name] = query
+	return uq
+}
+
+// WithNamedMutedUsers tells the query-builder to eager-load the nodes that are connected to the "muted_users"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedMutedUsers(name string, opts ...func(*UserQuery)) *UserQuery {
+	query := (&UserClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedMutedUsers == nil {
+		uq.withNamedMutedUsers = make(map[string]*UserQuery)
+	}
+	uq.withNamedMutedUsers[name] = query
+	return uq
+}
+
+// WithNamedMutedBy tells the query-builder to eager-load the nodes that are connected to the "muted_by"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedMutedBy(name string, opts ...func(*UserQuery)) *UserQuery {
+	query := (&UserClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedMutedBy == nil {
+		uq.withNamedMutedBy = make(map[string]*UserQuery)
+	}
+	uq.withNamedMutedBy[name] = query
+	return uq
+}
+
+// WithNamedIdentities tells the query-builder to eager-load the nodes that are connected to the "identities"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedIdentities(name string, opts ...func(*IdentityQuery)) *UserQuery {
+	query := (&IdentityClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedIdentities == nil {
+		uq.withNamedIdentities = make(map[string]*IdentityQuery)
+	}
+	uq.withNamedIdentities[name] = query
+	return uq
+}
+
 // WithNamedRefreshTokens tells the query-builder to eager-load the nodes that are connected to the "refresh_tokens"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithNamedRefreshTokens(name string, opts ...func(*RefreshTokenQuery)) *UserQuery {
@@ -1136,6 +1996,34 @@ func (uq *UserQuery) WithNamedRefreshTokens(name string, opts ...func(*RefreshTo
 	return uq
 }
 
+// WithNamedRoleChanges tells the query-builder to eager-load the nodes that are connected to the "role_changes"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedRoleChanges(name string, opts ...func(*RoleChangeQuery)) *UserQuery {
+	query := (&RoleChangeClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedRoleChanges == nil {
+		uq.withNamedRoleChanges = make(map[string]*RoleChangeQuery)
+	}
+	uq.withNamedRoleChanges[name] = query
+	return uq
+}
+
+// WithNamedSuspensions tells the query-builder to eager-load the nodes that are connected to the "suspensions"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedSuspensions(name string, opts ...func(*SuspensionQuery)) *UserQuery {
+	query := (&SuspensionClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedSuspensions == nil {
+		uq.withNamedSuspensions = make(map[string]*SuspensionQuery)
+	}
+	uq.withNamedSuspensions[name] = query
+	return uq
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	selector
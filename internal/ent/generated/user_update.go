@@ -10,14 +10,17 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
-	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/apikey"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/comment"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/post"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/refreshtoken"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
 	"github.com/google/uuid"
 )
 
@@ -177,6 +180,40 @@ func (uu *UserUpdate) SetNillableRole(u *user.Role) *UserUpdate {
 	return uu
 }
 
+// SetRoleSource sets the "role_source" field.
+func (uu *UserUpdate) SetRoleSource(us user.RoleSource) *UserUpdate {
+	uu.mutation.SetRoleSource(us)
+	return uu
+}
+
+// SetNillableRoleSource sets the "role_source" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableRoleSource(us *user.RoleSource) *UserUpdate {
+	if us != nil {
+		uu.SetRoleSource(*us)
+	}
+	return uu
+}
+
+// ClearRoleSource clears the value of the "role_source" field.
+func (uu *UserUpdate) ClearRoleSource() *UserUpdate {
+	uu.mutation.ClearRoleSource()
+	return uu
+}
+
+// SetTwitchVip sets the "twitch_vip" field.
+func (uu *UserUpdate) SetTwitchVip(b bool) *UserUpdate {
+	uu.mutation.SetTwitchVip(b)
+	return uu
+}
+
+// SetNillableTwitchVip sets the "twitch_vip" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTwitchVip(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetTwitchVip(*b)
+	}
+	return uu
+}
+
 // SetLastSeenAt sets the "last_seen_at" field.
 func (uu *UserUpdate) SetLastSeenAt(t time.Time) *UserUpdate {
 	uu.mutation.SetLastSeenAt(t)
@@ -209,24 +246,6 @@ func (uu *UserUpdate) ClearLastPostSeenCursor() *UserUpdate {
 	return uu
 }
 
-// SetAwards sets the "awards" field.
-func (uu *UserUpdate) SetAwards(s []string) *UserUpdate {
-	uu.mutation.SetAwards(s)
-	return uu
-}
-
-// AppendAwards appends s to the "awards" field.
-func (uu *UserUpdate) AppendAwards(s []string) *UserUpdate {
-	uu.mutation.AppendAwards(s)
-	return uu
-}
-
-// ClearAwards clears the value of the "awards" field.
-func (uu *UserUpdate) ClearAwards() *UserUpdate {
-	uu.mutation.ClearAwards()
-	return uu
-}
-
 // AddSavedPostIDs adds the "saved_posts" edge to the Post entity by IDs.
 func (uu *UserUpdate) AddSavedPostIDs(ids ...uuid.UUID) *UserUpdate {
 	uu.mutation.AddSavedPostIDs(ids...)
@@ -302,6 +321,96 @@ func (uu *UserUpdate) AddAPIKeys(a ...*ApiKey) *UserUpdate {
 	return uu.AddAPIKeyIDs(ids...)
 }
 
+// AddAwardIDs adds the "awards" edge to the UserAward entity by IDs.
+func (uu *UserUpdate) AddAwardIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddAwardIDs(ids...)
+	return uu
+}
+
+// AddAwards adds the "awards" edges to the UserAward entity.
+func (uu *UserUpdate) AddAwards(u ...*UserAward) *UserUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.AddAwardIDs(ids...)
+}
+
+// AddBlockedUserIDs adds the "blocked_users" edge to the User entity by IDs.
+func (uu *UserUpdate) AddBlockedUserIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddBlockedUserIDs(ids...)
+	return uu
+}
+
+// AddBlockedUsers adds the "blocked_users" edges to the User entity.
+func (uu *UserUpdate) AddBlockedUsers(u ...*User) *UserUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.AddBlockedUserIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the User entity by IDs.
+func (uu *UserUpdate) AddBlockedByIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddBlockedByIDs(ids...)
+	return uu
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the User entity.
+func (uu *UserUpdate) AddBlockedBy(u ...*User) *UserUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.AddBlockedByIDs(ids...)
+}
+
+// AddMutedUserIDs adds the "muted_users" edge to the User entity by IDs.
+func (uu *UserUpdate) AddMutedUserIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddMutedUserIDs(ids...)
+	return uu
+}
+
+// AddMutedUsers adds the "muted_users" edges to the User entity.
+func (uu *UserUpdate) AddMutedUsers(u ...*User) *UserUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.AddMutedUserIDs(ids...)
+}
+
+// AddMutedByIDs adds the "muted_by" edge to the User entity by IDs.
+func (uu *UserUpdate) AddMutedByIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddMutedByIDs(ids...)
+	return uu
+}
+
+// AddMutedBy adds the "muted_by" edges to the User entity.
+func (uu *UserUpdate) AddMutedBy(u ...*User) *UserUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.AddMutedByIDs(ids...)
+}
+
+// AddIdentityIDs adds the "identities" edge to the Identity entity by IDs.
+func (uu *UserUpdate) AddIdentityIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddIdentityIDs(ids...)
+	return uu
+}
+
+// AddIdentities adds the "identities" edges to the Identity entity.
+func (uu *UserUpdate) AddIdentities(i ...*Identity) *UserUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.AddIdentityIDs(ids...)
+}
+
 // AddRefreshTokenIDs adds the "refresh_tokens" edge to the RefreshToken entity by IDs.
 func (uu *UserUpdate) AddRefreshTokenIDs(ids ...uuid.UUID) *UserUpdate {
 	uu.mutation.AddRefreshTokenIDs(ids...)
@@ -317,6 +426,36 @@ func (uu *UserUpdate) AddRefreshTokens(r ...*RefreshToken) *UserUpdate {
 	return uu.AddRefreshTokenIDs(ids...)
 }
 
+// AddRoleChangeIDs adds the "role_changes" edge to the RoleChange entity by IDs.
+func (uu *UserUpdate) AddRoleChangeIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddRoleChangeIDs(ids...)
+	return uu
+}
+
+// AddRoleChanges adds the "role_changes" edges to the RoleChange entity.
+func (uu *UserUpdate) AddRoleChanges(r ...*RoleChange) *UserUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddRoleChangeIDs(ids...)
+}
+
+// AddSuspensionIDs adds the "suspensions" edge to the Suspension entity by IDs.
+func (uu *UserUpdate) AddSuspensionIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddSuspensionIDs(ids...)
+	return uu
+}
+
+// AddSuspensions adds the "suspensions" edges to the Suspension entity.
+func (uu *UserUpdate) AddSuspensions(s ...*Suspension) *UserUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddSuspensionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -427,6 +566,132 @@ func (uu *UserUpdate) RemoveAPIKeys(a ...*ApiKey) *UserUpdate {
 	return uu.RemoveAPIKeyIDs(ids...)
 }
 
+// ClearAwards clears all "awards" edges to the UserAward entity.
+func (uu *UserUpdate) ClearAwards() *UserUpdate {
+	uu.mutation.ClearAwards()
+	return uu
+}
+
+// RemoveAwardIDs removes the "awards" edge to UserAward entities by IDs.
+func (uu *UserUpdate) RemoveAwardIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveAwardIDs(ids...)
+	return uu
+}
+
+// RemoveAwards removes "awards" edges to UserAward entities.
+func (uu *UserUpdate) RemoveAwards(u ...*UserAward) *UserUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.RemoveAwardIDs(ids...)
+}
+
+// ClearBlockedUsers clears all "blocked_users" edges to the User entity.
+func (uu *UserUpdate) ClearBlockedUsers() *UserUpdate {
+	uu.mutation.ClearBlockedUsers()
+	return uu
+}
+
+// RemoveBlockedUserIDs removes the "blocked_users" edge to User entities by IDs.
+func (uu *UserUpdate) RemoveBlockedUserIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveBlockedUserIDs(ids...)
+	return uu
+}
+
+// RemoveBlockedUsers removes "blocked_users" edges to User entities.
+func (uu *UserUpdate) RemoveBlockedUsers(u ...*User) *UserUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.RemoveBlockedUserIDs(ids...)
+}
+
+// ClearBlockedBy clears all "blocked_by" edges to the User entity.
+func (uu *UserUpdate) ClearBlockedBy() *UserUpdate {
+	uu.mutation.ClearBlockedBy()
+	return uu
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to User entities by IDs.
+func (uu *UserUpdate) RemoveBlockedByIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveBlockedByIDs(ids...)
+	return uu
+}
+
+// RemoveBlockedBy removes "blocked_by" edges to User entities.
+func (uu *UserUpdate) RemoveBlockedBy(u ...*User) *UserUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.RemoveBlockedByIDs(ids...)
+}
+
+// ClearMutedUsers clears all "muted_users" edges to the User entity.
+func (uu *UserUpdate) ClearMutedUsers() *UserUpdate {
+	uu.mutation.ClearMutedUsers()
+	return uu
+}
+
+// RemoveMutedUserIDs removes the "muted_users" edge to User entities by IDs.
+func (uu *UserUpdate) RemoveMutedUserIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveMutedUserIDs(ids...)
+	return uu
+}
+
+// RemoveMutedUsers removes "muted_users" edges to User entities.
+func (uu *UserUpdate) RemoveMutedUsers(u ...*User) *UserUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.RemoveMutedUserIDs(ids...)
+}
+
+// ClearMutedBy clears all "muted_by" edges to the User entity.
+func (uu *UserUpdate) ClearMutedBy() *UserUpdate {
+	uu.mutation.ClearMutedBy()
+	return uu
+}
+
+// RemoveMutedByIDs removes the "muted_by" edge to User entities by IDs.
+func (uu *UserUpdate) RemoveMutedByIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveMutedByIDs(ids...)
+	return uu
+}
+
+// RemoveMutedBy removes "muted_by" edges to User entities.
+func (uu *UserUpdate) RemoveMutedBy(u ...*User) *UserUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.RemoveMutedByIDs(ids...)
+}
+
+// ClearIdentities clears all "identities" edges to the Identity entity.
+func (uu *UserUpdate) ClearIdentities() *UserUpdate {
+	uu.mutation.ClearIdentities()
+	return uu
+}
+
+// RemoveIdentityIDs removes the "identities" edge to Identity entities by IDs.
+func (uu *UserUpdate) RemoveIdentityIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveIdentityIDs(ids...)
+	return uu
+}
+
+// RemoveIdentities removes "identities" edges to Identity entities.
+func (uu *UserUpdate) RemoveIdentities(i ...*Identity) *UserUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.RemoveIdentityIDs(ids...)
+}
+
 // ClearRefreshTokens clears all "refresh_tokens" edges to the RefreshToken entity.
 func (uu *UserUpdate) ClearRefreshTokens() *UserUpdate {
 	uu.mutation.ClearRefreshTokens()
@@ -448,6 +713,48 @@ func (uu *UserUpdate) RemoveRefreshTokens(r ...*RefreshToken) *UserUpdate {
 	return uu.RemoveRefreshTokenIDs(ids...)
 }
 
+// ClearRoleChanges clears all "role_changes" edges to the RoleChange entity.
+func (uu *UserUpdate) ClearRoleChanges() *UserUpdate {
+	uu.mutation.ClearRoleChanges()
+	return uu
+}
+
+// RemoveRoleChangeIDs removes the "role_changes" edge to RoleChange entities by IDs.
+func (uu *UserUpdate) RemoveRoleChangeIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveRoleChangeIDs(ids...)
+	return uu
+}
+
+// RemoveRoleChanges removes "role_changes" edges to RoleChange entities.
+func (uu *UserUpdate) RemoveRoleChanges(r ...*RoleChange) *UserUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.RemoveRoleChangeIDs(ids...)
+}
+
+// ClearSuspensions clears all "suspensions" edges to the Suspension entity.
+func (uu *UserUpdate) ClearSuspensions() *UserUpdate {
+	uu.mutation.ClearSuspensions()
+	return uu
+}
+
+// RemoveSuspensionIDs removes the "suspensions" edge to Suspension entities by IDs.
+func (uu *UserUpdate) RemoveSuspensionIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveSuspensionIDs(ids...)
+	return uu
+}
+
+// RemoveSuspensions removes "suspensions" edges to Suspension entities.
+func (uu *UserUpdate) RemoveSuspensions(s ...*Suspension) *UserUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.RemoveSuspensionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	if err := uu.defaults(); err != nil {
@@ -509,6 +816,11 @@ func (uu *UserUpdate) check() error {
 			return &ValidationError{Name: "role", err: fmt.Errorf(`generated: validator failed for field "User.role": %w`, err)}
 		}
 	}
+	if v, ok := uu.mutation.RoleSource(); ok {
+		if err := user.RoleSourceValidator(v); err != nil {
+			return &ValidationError{Name: "role_source", err: fmt.Errorf(`generated: validator failed for field "User.role_source": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -569,6 +881,15 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.Role(); ok {
 		_spec.SetField(user.FieldRole, field.TypeEnum, value)
 	}
+	if value, ok := uu.mutation.RoleSource(); ok {
+		_spec.SetField(user.FieldRoleSource, field.TypeEnum, value)
+	}
+	if uu.mutation.RoleSourceCleared() {
+		_spec.ClearField(user.FieldRoleSource, field.TypeEnum)
+	}
+	if value, ok := uu.mutation.TwitchVip(); ok {
+		_spec.SetField(user.FieldTwitchVip, field.TypeBool, value)
+	}
 	if value, ok := uu.mutation.LastSeenAt(); ok {
 		_spec.SetField(user.FieldLastSeenAt, field.TypeTime, value)
 	}
@@ -581,17 +902,6 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.mutation.LastPostSeenCursorCleared() {
 		_spec.ClearField(user.FieldLastPostSeenCursor, field.TypeString)
 	}
-	if value, ok := uu.mutation.Awards(); ok {
-		_spec.SetField(user.FieldAwards, field.TypeJSON, value)
-	}
-	if value, ok := uu.mutation.AppendedAwards(); ok {
-		_spec.AddModifier(func(u *sql.UpdateBuilder) {
-			sqljson.Append(u, user.FieldAwards, value)
-		})
-	}
-	if uu.mutation.AwardsCleared() {
-		_spec.ClearField(user.FieldAwards, field.TypeJSON)
-	}
 	if uu.mutation.SavedPostsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -817,28 +1127,28 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uu.mutation.RefreshTokensCleared() {
+	if uu.mutation.AwardsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.RefreshTokensTable,
-			Columns: []string{user.RefreshTokensColumn},
+			Table:   user.AwardsTable,
+			Columns: []string{user.AwardsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(refreshtoken.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(useraward.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.RemovedRefreshTokensIDs(); len(nodes) > 0 && !uu.mutation.RefreshTokensCleared() {
+	if nodes := uu.mutation.RemovedAwardsIDs(); len(nodes) > 0 && !uu.mutation.AwardsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.RefreshTokensTable,
-			Columns: []string{user.RefreshTokensColumn},
+			Table:   user.AwardsTable,
+			Columns: []string{user.AwardsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(refreshtoken.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(useraward.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -846,15 +1156,15 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.RefreshTokensIDs(); len(nodes) > 0 {
+	if nodes := uu.mutation.AwardsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.RefreshTokensTable,
-			Columns: []string{user.RefreshTokensColumn},
+			Table:   user.AwardsTable,
+			Columns: []string{user.AwardsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(refreshtoken.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(useraward.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -862,74 +1172,434 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.AddModifiers(uu.modifiers...)
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if uu.mutation.BlockedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.BlockedUsersTable,
+			Columns: user.BlockedUsersPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
-	}
-	uu.mutation.done = true
-	return n, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields    []string
-	hooks     []Hook
-	mutation  *UserMutation
-	modifiers []func(*sql.UpdateBuilder)
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetUpdatedAt(t)
-	return uuo
-}
-
-// SetDeletedAt sets the "deleted_at" field.
-func (uuo *UserUpdateOne) SetDeletedAt(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetDeletedAt(t)
-	return uuo
-}
-
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableDeletedAt(t *time.Time) *UserUpdateOne {
-	if t != nil {
-		uuo.SetDeletedAt(*t)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (uuo *UserUpdateOne) ClearDeletedAt() *UserUpdateOne {
-	uuo.mutation.ClearDeletedAt()
-	return uuo
-}
-
-// SetDeletedBy sets the "deleted_by" field.
-func (uuo *UserUpdateOne) SetDeletedBy(s string) *UserUpdateOne {
-	uuo.mutation.SetDeletedBy(s)
-	return uuo
-}
-
-// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableDeletedBy(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetDeletedBy(*s)
+	if nodes := uu.mutation.RemovedBlockedUsersIDs(); len(nodes) > 0 && !uu.mutation.BlockedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.BlockedUsersTable,
+			Columns: user.BlockedUsersPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
+	if nodes := uu.mutation.BlockedUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.BlockedUsersTable,
+			Columns: user.BlockedUsersPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.BlockedByTable,
+			Columns: user.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedBlockedByIDs(); len(nodes) > 0 && !uu.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.BlockedByTable,
+			Columns: user.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.BlockedByTable,
+			Columns: user.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.MutedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.MutedUsersTable,
+			Columns: user.MutedUsersPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedMutedUsersIDs(); len(nodes) > 0 && !uu.mutation.MutedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.MutedUsersTable,
+			Columns: user.MutedUsersPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.MutedUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.MutedUsersTable,
+			Columns: user.MutedUsersPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.MutedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MutedByTable,
+			Columns: user.MutedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedMutedByIDs(); len(nodes) > 0 && !uu.mutation.MutedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MutedByTable,
+			Columns: user.MutedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.MutedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MutedByTable,
+			Columns: user.MutedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedIdentitiesIDs(); len(nodes) > 0 && !uu.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.RefreshTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshTokensTable,
+			Columns: []string{user.RefreshTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refreshtoken.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedRefreshTokensIDs(); len(nodes) > 0 && !uu.mutation.RefreshTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshTokensTable,
+			Columns: []string{user.RefreshTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refreshtoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RefreshTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshTokensTable,
+			Columns: []string{user.RefreshTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(refreshtoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.RoleChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoleChangesTable,
+			Columns: []string{user.RoleChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolechange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedRoleChangesIDs(); len(nodes) > 0 && !uu.mutation.RoleChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoleChangesTable,
+			Columns: []string{user.RoleChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolechange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RoleChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoleChangesTable,
+			Columns: []string{user.RoleChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolechange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.SuspensionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SuspensionsTable,
+			Columns: []string{user.SuspensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedSuspensionsIDs(); len(nodes) > 0 && !uu.mutation.SuspensionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SuspensionsTable,
+			Columns: []string{user.SuspensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.SuspensionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SuspensionsTable,
+			Columns: []string{user.SuspensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(uu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uu.mutation.done = true
+	return n, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetUpdatedAt(t)
+	return uuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uuo *UserUpdateOne) SetDeletedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetDeletedAt(t)
+	return uuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDeletedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetDeletedAt(*t)
+	}
+	return uuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uuo *UserUpdateOne) ClearDeletedAt() *UserUpdateOne {
+	uuo.mutation.ClearDeletedAt()
+	return uuo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (uuo *UserUpdateOne) SetDeletedBy(s string) *UserUpdateOne {
+	uuo.mutation.SetDeletedBy(s)
+	return uuo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDeletedBy(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetDeletedBy(*s)
+	}
+	return uuo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (uuo *UserUpdateOne) ClearDeletedBy() *UserUpdateOne {
+	uuo.mutation.ClearDeletedBy()
 	return uuo
 }
 
-// ClearDeletedBy clears the value of the "deleted_by" field.
-func (uuo *UserUpdateOne) ClearDeletedBy() *UserUpdateOne {
-	uuo.mutation.ClearDeletedBy()
-	return uuo
-}
-
 // SetDisplayName sets the "display_name" field.
 func (uuo *UserUpdateOne) SetDisplayName(s string) *UserUpdateOne {
 	uuo.mutation.SetDisplayName(s)
@@ -1026,6 +1696,40 @@ func (uuo *UserUpdateOne) SetNillableRole(u *user.Role) *UserUpdateOne {
 	return uuo
 }
 
+// SetRoleSource sets the "role_source" field.
+func (uuo *UserUpdateOne) SetRoleSource(us user.RoleSource) *UserUpdateOne {
+	uuo.mutation.SetRoleSource(us)
+	return uuo
+}
+
+// SetNillableRoleSource sets the "role_source" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableRoleSource(us *user.RoleSource) *UserUpdateOne {
+	if us != nil {
+		uuo.SetRoleSource(*us)
+	}
+	return uuo
+}
+
+// ClearRoleSource clears the value of the "role_source" field.
+func (uuo *UserUpdateOne) ClearRoleSource() *UserUpdateOne {
+	uuo.mutation.ClearRoleSource()
+	return uuo
+}
+
+// SetTwitchVip sets the "twitch_vip" field.
+func (uuo *UserUpdateOne) SetTwitchVip(b bool) *UserUpdateOne {
+	uuo.mutation.SetTwitchVip(b)
+	return uuo
+}
+
+// SetNillableTwitchVip sets the "twitch_vip" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTwitchVip(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetTwitchVip(*b)
+	}
+	return uuo
+}
+
 // SetLastSeenAt sets the "last_seen_at" field.
 func (uuo *UserUpdateOne) SetLastSeenAt(t time.Time) *UserUpdateOne {
 	uuo.mutation.SetLastSeenAt(t)
@@ -1058,24 +1762,6 @@ func (uuo *UserUpdateOne) ClearLastPostSeenCursor() *UserUpdateOne {
 	return uuo
 }
 
-// SetAwards sets the "awards" field.
-func (uuo *UserUpdateOne) SetAwards(s []string) *UserUpdateOne {
-	uuo.mutation.SetAwards(s)
-	return uuo
-}
-
-// AppendAwards appends s to the "awards" field.
-func (uuo *UserUpdateOne) AppendAwards(s []string) *UserUpdateOne {
-	uuo.mutation.AppendAwards(s)
-	return uuo
-}
-
-// ClearAwards clears the value of the "awards" field.
-func (uuo *UserUpdateOne) ClearAwards() *UserUpdateOne {
-	uuo.mutation.ClearAwards()
-	return uuo
-}
-
 // AddSavedPostIDs adds the "saved_posts" edge to the Post entity by IDs.
 func (uuo *UserUpdateOne) AddSavedPostIDs(ids ...uuid.UUID) *UserUpdateOne {
 	uuo.mutation.AddSavedPostIDs(ids...)
@@ -1151,6 +1837,96 @@ func (uuo *UserUpdateOne) AddAPIKeys(a ...*ApiKey) *UserUpdateOne {
 	return uuo.AddAPIKeyIDs(ids...)
 }
 
+// AddAwardIDs adds the "awards" edge to the UserAward entity by IDs.
+func (uuo *UserUpdateOne) AddAwardIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddAwardIDs(ids...)
+	return uuo
+}
+
+// AddAwards adds the "awards" edges to the UserAward entity.
+func (uuo *UserUpdateOne) AddAwards(u ...*UserAward) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.AddAwardIDs(ids...)
+}
+
+// AddBlockedUserIDs adds the "blocked_users" edge to the User entity by IDs.
+func (uuo *UserUpdateOne) AddBlockedUserIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddBlockedUserIDs(ids...)
+	return uuo
+}
+
+// AddBlockedUsers adds the "blocked_users" edges to the User entity.
+func (uuo *UserUpdateOne) AddBlockedUsers(u ...*User) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.AddBlockedUserIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the User entity by IDs.
+func (uuo *UserUpdateOne) AddBlockedByIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddBlockedByIDs(ids...)
+	return uuo
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the User entity.
+func (uuo *UserUpdateOne) AddBlockedBy(u ...*User) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.AddBlockedByIDs(ids...)
+}
+
+// AddMutedUserIDs adds the "muted_users" edge to the User entity by IDs.
+func (uuo *UserUpdateOne) AddMutedUserIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddMutedUserIDs(ids...)
+	return uuo
+}
+
+// AddMutedUsers adds the "muted_users" edges to the User entity.
+func (uuo *UserUpdateOne) AddMutedUsers(u ...*User) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.AddMutedUserIDs(ids...)
+}
+
+// AddMutedByIDs adds the "muted_by" edge to the User entity by IDs.
+func (uuo *UserUpdateOne) AddMutedByIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddMutedByIDs(ids...)
+	return uuo
+}
+
+// AddMutedBy adds the "muted_by" edges to the User entity.
+func (uuo *UserUpdateOne) AddMutedBy(u ...*User) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.AddMutedByIDs(ids...)
+}
+
+// AddIdentityIDs adds the "identities" edge to the Identity entity by IDs.
+func (uuo *UserUpdateOne) AddIdentityIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddIdentityIDs(ids...)
+	return uuo
+}
+
+// AddIdentities adds the "identities" edges to the Identity entity.
+func (uuo *UserUpdateOne) AddIdentities(i ...*Identity) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.AddIdentityIDs(ids...)
+}
+
 // AddRefreshTokenIDs adds the "refresh_tokens" edge to the RefreshToken entity by IDs.
 func (uuo *UserUpdateOne) AddRefreshTokenIDs(ids ...uuid.UUID) *UserUpdateOne {
 	uuo.mutation.AddRefreshTokenIDs(ids...)
@@ -1166,6 +1942,36 @@ func (uuo *UserUpdateOne) AddRefreshTokens(r ...*RefreshToken) *UserUpdateOne {
 	return uuo.AddRefreshTokenIDs(ids...)
 }
 
+// AddRoleChangeIDs adds the "role_changes" edge to the RoleChange entity by IDs.
+func (uuo *UserUpdateOne) AddRoleChangeIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddRoleChangeIDs(ids...)
+	return uuo
+}
+
+// AddRoleChanges adds the "role_changes" edges to the RoleChange entity.
+func (uuo *UserUpdateOne) AddRoleChanges(r ...*RoleChange) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddRoleChangeIDs(ids...)
+}
+
+// AddSuspensionIDs adds the "suspensions" edge to the Suspension entity by IDs.
+func (uuo *UserUpdateOne) AddSuspensionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddSuspensionIDs(ids...)
+	return uuo
+}
+
+// AddSuspensions adds the "suspensions" edges to the Suspension entity.
+func (uuo *UserUpdateOne) AddSuspensions(s ...*Suspension) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddSuspensionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
@@ -1276,6 +2082,132 @@ func (uuo *UserUpdateOne) RemoveAPIKeys(a ...*ApiKey) *UserUpdateOne {
 	return uuo.RemoveAPIKeyIDs(ids...)
 }
 
+// ClearAwards clears all "awards" edges to the UserAward entity.
+func (uuo *UserUpdateOne) ClearAwards() *UserUpdateOne {
+	uuo.mutation.ClearAwards()
+	return uuo
+}
+
+// RemoveAwardIDs removes the "awards" edge to UserAward entities by IDs.
+func (uuo *UserUpdateOne) RemoveAwardIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveAwardIDs(ids...)
+	return uuo
+}
+
+// RemoveAwards removes "awards" edges to UserAward entities.
+func (uuo *UserUpdateOne) RemoveAwards(u ...*UserAward) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.RemoveAwardIDs(ids...)
+}
+
+// ClearBlockedUsers clears all "blocked_users" edges to the User entity.
+func (uuo *UserUpdateOne) ClearBlockedUsers() *UserUpdateOne {
+	uuo.mutation.ClearBlockedUsers()
+	return uuo
+}
+
+// RemoveBlockedUserIDs removes the "blocked_users" edge to User entities by IDs.
+func (uuo *UserUpdateOne) RemoveBlockedUserIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveBlockedUserIDs(ids...)
+	return uuo
+}
+
+// RemoveBlockedUsers removes "blocked_users" edges to User entities.
+func (uuo *UserUpdateOne) RemoveBlockedUsers(u ...*User) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.RemoveBlockedUserIDs(ids...)
+}
+
+// ClearBlockedBy clears all "blocked_by" edges to the User entity.
+func (uuo *UserUpdateOne) ClearBlockedBy() *UserUpdateOne {
+	uuo.mutation.ClearBlockedBy()
+	return uuo
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to User entities by IDs.
+func (uuo *UserUpdateOne) RemoveBlockedByIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveBlockedByIDs(ids...)
+	return uuo
+}
+
+// RemoveBlockedBy removes "blocked_by" edges to User entities.
+func (uuo *UserUpdateOne) RemoveBlockedBy(u ...*User) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.RemoveBlockedByIDs(ids...)
+}
+
+// ClearMutedUsers clears all "muted_users" edges to the User entity.
+func (uuo *UserUpdateOne) ClearMutedUsers() *UserUpdateOne {
+	uuo.mutation.ClearMutedUsers()
+	return uuo
+}
+
+// RemoveMutedUserIDs removes the "muted_users" edge to User entities by IDs.
+func (uuo *UserUpdateOne) RemoveMutedUserIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveMutedUserIDs(ids...)
+	return uuo
+}
+
+// RemoveMutedUsers removes "muted_users" edges to User entities.
+func (uuo *UserUpdateOne) RemoveMutedUsers(u ...*User) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.RemoveMutedUserIDs(ids...)
+}
+
+// ClearMutedBy clears all "muted_by" edges to the User entity.
+func (uuo *UserUpdateOne) ClearMutedBy() *UserUpdateOne {
+	uuo.mutation.ClearMutedBy()
+	return uuo
+}
+
+// RemoveMutedByIDs removes the "muted_by" edge to User entities by IDs.
+func (uuo *UserUpdateOne) RemoveMutedByIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveMutedByIDs(ids...)
+	return uuo
+}
+
+// RemoveMutedBy removes "muted_by" edges to User entities.
+func (uuo *UserUpdateOne) RemoveMutedBy(u ...*User) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.RemoveMutedByIDs(ids...)
+}
+
+// ClearIdentities clears all "identities" edges to the Identity entity.
+func (uuo *UserUpdateOne) ClearIdentities() *UserUpdateOne {
+	uuo.mutation.ClearIdentities()
+	return uuo
+}
+
+// RemoveIdentityIDs removes the "identities" edge to Identity entities by IDs.
+func (uuo *UserUpdateOne) RemoveIdentityIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveIdentityIDs(ids...)
+	return uuo
+}
+
+// RemoveIdentities removes "identities" edges to Identity entities.
+func (uuo *UserUpdateOne) RemoveIdentities(i ...*Identity) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.RemoveIdentityIDs(ids...)
+}
+
 // ClearRefreshTokens clears all "refresh_tokens" edges to the RefreshToken entity.
 func (uuo *UserUpdateOne) ClearRefreshTokens() *UserUpdateOne {
 	uuo.mutation.ClearRefreshTokens()
@@ -1297,6 +2229,48 @@ func (uuo *UserUpdateOne) RemoveRefreshTokens(r ...*RefreshToken) *UserUpdateOne
 	return uuo.RemoveRefreshTokenIDs(ids...)
 }
 
+// ClearRoleChanges clears all "role_changes" edges to the RoleChange entity.
+func (uuo *UserUpdateOne) ClearRoleChanges() *UserUpdateOne {
+	uuo.mutation.ClearRoleChanges()
+	return uuo
+}
+
+// RemoveRoleChangeIDs removes the "role_changes" edge to RoleChange entities by IDs.
+func (uuo *UserUpdateOne) RemoveRoleChangeIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveRoleChangeIDs(ids...)
+	return uuo
+}
+
+// RemoveRoleChanges removes "role_changes" edges to RoleChange entities.
+func (uuo *UserUpdateOne) RemoveRoleChanges(r ...*RoleChange) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveRoleChangeIDs(ids...)
+}
+
+// ClearSuspensions clears all "suspensions" edges to the Suspension entity.
+func (uuo *UserUpdateOne) ClearSuspensions() *UserUpdateOne {
+	uuo.mutation.ClearSuspensions()
+	return uuo
+}
+
+// RemoveSuspensionIDs removes the "suspensions" edge to Suspension entities by IDs.
+func (uuo *UserUpdateOne) RemoveSuspensionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveSuspensionIDs(ids...)
+	return uuo
+}
+
+// RemoveSuspensions removes "suspensions" edges to Suspension entities.
+func (uuo *UserUpdateOne) RemoveSuspensions(s ...*Suspension) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveSuspensionIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	uuo.mutation.Where(ps...)
@@ -1371,6 +2345,11 @@ func (uuo *UserUpdateOne) check() error {
 			return &ValidationError{Name: "role", err: fmt.Errorf(`generated: validator failed for field "User.role": %w`, err)}
 		}
 	}
+	if v, ok := uuo.mutation.RoleSource(); ok {
+		if err := user.RoleSourceValidator(v); err != nil {
+			return &ValidationError{Name: "role_source", err: fmt.Errorf(`generated: validator failed for field "User.role_source": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1448,6 +2427,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if value, ok := uuo.mutation.Role(); ok {
 		_spec.SetField(user.FieldRole, field.TypeEnum, value)
 	}
+	if value, ok := uuo.mutation.RoleSource(); ok {
+		_spec.SetField(user.FieldRoleSource, field.TypeEnum, value)
+	}
+	if uuo.mutation.RoleSourceCleared() {
+		_spec.ClearField(user.FieldRoleSource, field.TypeEnum)
+	}
+	if value, ok := uuo.mutation.TwitchVip(); ok {
+		_spec.SetField(user.FieldTwitchVip, field.TypeBool, value)
+	}
 	if value, ok := uuo.mutation.LastSeenAt(); ok {
 		_spec.SetField(user.FieldLastSeenAt, field.TypeTime, value)
 	}
@@ -1457,19 +2445,8 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if value, ok := uuo.mutation.LastPostSeenCursor(); ok {
 		_spec.SetField(user.FieldLastPostSeenCursor, field.TypeString, value)
 	}
-	if uuo.mutation.LastPostSeenCursorCleared() {
-		_spec.ClearField(user.FieldLastPostSeenCursor, field.TypeString)
-	}
-	if value, ok := uuo.mutation.Awards(); ok {
-		_spec.SetField(user.FieldAwards, field.TypeJSON, value)
-	}
-	if value, ok := uuo.mutation.AppendedAwards(); ok {
-		_spec.AddModifier(func(u *sql.UpdateBuilder) {
-			sqljson.Append(u, user.FieldAwards, value)
-		})
-	}
-	if uuo.mutation.AwardsCleared() {
-		_spec.ClearField(user.FieldAwards, field.TypeJSON)
+	if uuo.mutation.LastPostSeenCursorCleared() {
+		_spec.ClearField(user.FieldLastPostSeenCursor, field.TypeString)
 	}
 	if uuo.mutation.SavedPostsCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -1696,6 +2673,276 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.AwardsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AwardsTable,
+			Columns: []string{user.AwardsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useraward.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedAwardsIDs(); len(nodes) > 0 && !uuo.mutation.AwardsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AwardsTable,
+			Columns: []string{user.AwardsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useraward.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.AwardsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AwardsTable,
+			Columns: []string{user.AwardsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useraward.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.BlockedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.BlockedUsersTable,
+			Columns: user.BlockedUsersPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedBlockedUsersIDs(); len(nodes) > 0 && !uuo.mutation.BlockedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.BlockedUsersTable,
+			Columns: user.BlockedUsersPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.BlockedUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.BlockedUsersTable,
+			Columns: user.BlockedUsersPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.BlockedByTable,
+			Columns: user.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedBlockedByIDs(); len(nodes) > 0 && !uuo.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.BlockedByTable,
+			Columns: user.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.BlockedByTable,
+			Columns: user.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.MutedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.MutedUsersTable,
+			Columns: user.MutedUsersPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedMutedUsersIDs(); len(nodes) > 0 && !uuo.mutation.MutedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.MutedUsersTable,
+			Columns: user.MutedUsersPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.MutedUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.MutedUsersTable,
+			Columns: user.MutedUsersPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.MutedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MutedByTable,
+			Columns: user.MutedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedMutedByIDs(); len(nodes) > 0 && !uuo.mutation.MutedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MutedByTable,
+			Columns: user.MutedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.MutedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MutedByTable,
+			Columns: user.MutedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedIdentitiesIDs(); len(nodes) > 0 && !uuo.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if uuo.mutation.RefreshTokensCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1741,6 +2988,96 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.RoleChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoleChangesTable,
+			Columns: []string{user.RoleChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolechange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedRoleChangesIDs(); len(nodes) > 0 && !uuo.mutation.RoleChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoleChangesTable,
+			Columns: []string{user.RoleChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolechange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RoleChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RoleChangesTable,
+			Columns: []string{user.RoleChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolechange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.SuspensionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SuspensionsTable,
+			Columns: []string{user.SuspensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedSuspensionsIDs(); len(nodes) > 0 && !uuo.mutation.SuspensionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SuspensionsTable,
+			Columns: []string{user.SuspensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.SuspensionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SuspensionsTable,
+			Columns: []string{user.SuspensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(uuo.modifiers...)
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
+	"github.com/google/uuid"
+)
+
+// UserAward is the model entity for the UserAward schema.
+type UserAward struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The user id that owns the object
+	OwnerID uuid.UUID `json:"owner_id,omitempty"`
+	// AwardID holds the value of the "award_id" field.
+	AwardID uuid.UUID `json:"award_id,omitempty"`
+	// GrantedAt holds the value of the "granted_at" field.
+	GrantedAt time.Time `json:"granted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserAwardQuery when eager-loading is set.
+	Edges        UserAwardEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// UserAwardEdges holds the relations/edges for other nodes in the graph.
+type UserAwardEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Award holds the value of the award edge.
+	Award *AwardDefinition `json:"award,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserAwardEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// AwardOrErr returns the Award value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserAwardEdges) AwardOrErr() (*AwardDefinition, error) {
+	if e.Award != nil {
+		return e.Award, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: awarddefinition.Label}
+	}
+	return nil, &NotLoadedError{edge: "award"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserAward) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case useraward.FieldUpdatedAt, useraward.FieldCreatedAt, useraward.FieldGrantedAt:
+			values[i] = new(sql.NullTime)
+		case useraward.FieldID, useraward.FieldOwnerID, useraward.FieldAwardID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserAward fields.
+func (ua *UserAward) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case useraward.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ua.ID = *value
+			}
+		case useraward.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ua.UpdatedAt = value.Time
+			}
+		case useraward.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ua.CreatedAt = value.Time
+			}
+		case useraward.FieldOwnerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value != nil {
+				ua.OwnerID = *value
+			}
+		case useraward.FieldAwardID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field award_id", values[i])
+			} else if value != nil {
+				ua.AwardID = *value
+			}
+		case useraward.FieldGrantedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field granted_at", values[i])
+			} else if value.Valid {
+				ua.GrantedAt = value.Time
+			}
+		default:
+			ua.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserAward.
+// This includes values selected through modifiers, order, etc.
+func (ua *UserAward) Value(name string) (ent.Value, error) {
+	return ua.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the UserAward entity.
+func (ua *UserAward) QueryOwner() *UserQuery {
+	return NewUserAwardClient(ua.config).QueryOwner(ua)
+}
+
+// QueryAward queries the "award" edge of the UserAward entity.
+func (ua *UserAward) QueryAward() *AwardDefinitionQuery {
+	return NewUserAwardClient(ua.config).QueryAward(ua)
+}
+
+// Update returns a builder for updating this UserAward.
+// Note that you need to call UserAward.Unwrap() before calling this method if this UserAward
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ua *UserAward) Update() *UserAwardUpdateOne {
+	return NewUserAwardClient(ua.config).UpdateOne(ua)
+}
+
+// Unwrap unwraps the UserAward entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ua *UserAward) Unwrap() *UserAward {
+	_tx, ok := ua.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: UserAward is not a transactional entity")
+	}
+	ua.config.driver = _tx.drv
+	return ua
+}
+
+// String implements the fmt.Stringer.
+func (ua *UserAward) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserAward(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ua.ID))
+	builder.WriteString("updated_at=")
+	builder.WriteString(ua.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ua.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(fmt.Sprintf("%v", ua.OwnerID))
+	builder.WriteString(", ")
+	builder.WriteString("award_id=")
+	builder.WriteString(fmt.Sprintf("%v", ua.AwardID))
+	builder.WriteString(", ")
+	builder.WriteString("granted_at=")
+	builder.WriteString(ua.GrantedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserAwards is a parsable slice of UserAward.
+type UserAwards []*UserAward
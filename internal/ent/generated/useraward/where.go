@@ -0,0 +1,303 @@
+// Code generated by ent, DO NOT EDIT.
+
+package useraward
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.UserAward {
+	return predicate.UserAward(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.UserAward {
+	return predicate.UserAward(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.UserAward {
+	return predicate.UserAward(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.UserAward {
+	return predicate.UserAward(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.UserAward {
+	return predicate.UserAward(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.UserAward {
+	return predicate.UserAward(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.UserAward {
+	return predicate.UserAward(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.UserAward {
+	return predicate.UserAward(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.UserAward {
+	return predicate.UserAward(sql.FieldLTE(FieldID, id))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v uuid.UUID) predicate.UserAward {
+	return predicate.UserAward(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// AwardID applies equality check predicate on the "award_id" field. It's identical to AwardIDEQ.
+func AwardID(v uuid.UUID) predicate.UserAward {
+	return predicate.UserAward(sql.FieldEQ(FieldAwardID, v))
+}
+
+// GrantedAt applies equality check predicate on the "granted_at" field. It's identical to GrantedAtEQ.
+func GrantedAt(v time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldEQ(FieldGrantedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v uuid.UUID) predicate.UserAward {
+	return predicate.UserAward(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v uuid.UUID) predicate.UserAward {
+	return predicate.UserAward(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...uuid.UUID) predicate.UserAward {
+	return predicate.UserAward(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...uuid.UUID) predicate.UserAward {
+	return predicate.UserAward(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// AwardIDEQ applies the EQ predicate on the "award_id" field.
+func AwardIDEQ(v uuid.UUID) predicate.UserAward {
+	return predicate.UserAward(sql.FieldEQ(FieldAwardID, v))
+}
+
+// AwardIDNEQ applies the NEQ predicate on the "award_id" field.
+func AwardIDNEQ(v uuid.UUID) predicate.UserAward {
+	return predicate.UserAward(sql.FieldNEQ(FieldAwardID, v))
+}
+
+// AwardIDIn applies the In predicate on the "award_id" field.
+func AwardIDIn(vs ...uuid.UUID) predicate.UserAward {
+	return predicate.UserAward(sql.FieldIn(FieldAwardID, vs...))
+}
+
+// AwardIDNotIn applies the NotIn predicate on the "award_id" field.
+func AwardIDNotIn(vs ...uuid.UUID) predicate.UserAward {
+	return predicate.UserAward(sql.FieldNotIn(FieldAwardID, vs...))
+}
+
+// GrantedAtEQ applies the EQ predicate on the "granted_at" field.
+func GrantedAtEQ(v time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldEQ(FieldGrantedAt, v))
+}
+
+// GrantedAtNEQ applies the NEQ predicate on the "granted_at" field.
+func GrantedAtNEQ(v time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldNEQ(FieldGrantedAt, v))
+}
+
+// GrantedAtIn applies the In predicate on the "granted_at" field.
+func GrantedAtIn(vs ...time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldIn(FieldGrantedAt, vs...))
+}
+
+// GrantedAtNotIn applies the NotIn predicate on the "granted_at" field.
+func GrantedAtNotIn(vs ...time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldNotIn(FieldGrantedAt, vs...))
+}
+
+// GrantedAtGT applies the GT predicate on the "granted_at" field.
+func GrantedAtGT(v time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldGT(FieldGrantedAt, v))
+}
+
+// GrantedAtGTE applies the GTE predicate on the "granted_at" field.
+func GrantedAtGTE(v time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldGTE(FieldGrantedAt, v))
+}
+
+// GrantedAtLT applies the LT predicate on the "granted_at" field.
+func GrantedAtLT(v time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldLT(FieldGrantedAt, v))
+}
+
+// GrantedAtLTE applies the LTE predicate on the "granted_at" field.
+func GrantedAtLTE(v time.Time) predicate.UserAward {
+	return predicate.UserAward(sql.FieldLTE(FieldGrantedAt, v))
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.UserAward {
+	return predicate.UserAward(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.User) predicate.UserAward {
+	return predicate.UserAward(func(s *sql.Selector) {
+		step := newOwnerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAward applies the HasEdge predicate on the "award" edge.
+func HasAward() predicate.UserAward {
+	return predicate.UserAward(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AwardTable, AwardColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAwardWith applies the HasEdge predicate on the "award" edge with a given conditions (other predicates).
+func HasAwardWith(preds ...predicate.AwardDefinition) predicate.UserAward {
+	return predicate.UserAward(func(s *sql.Selector) {
+		step := newAwardStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserAward) predicate.UserAward {
+	return predicate.UserAward(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserAward) predicate.UserAward {
+	return predicate.UserAward(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserAward) predicate.UserAward {
+	return predicate.UserAward(sql.NotPredicates(p))
+}
@@ -0,0 +1,714 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
+	"github.com/google/uuid"
+)
+
+// UserAwardCreate is the builder for creating a UserAward entity.
+type UserAwardCreate struct {
+	config
+	mutation *UserAwardMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uac *UserAwardCreate) SetUpdatedAt(t time.Time) *UserAwardCreate {
+	uac.mutation.SetUpdatedAt(t)
+	return uac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (uac *UserAwardCreate) SetNillableUpdatedAt(t *time.Time) *UserAwardCreate {
+	if t != nil {
+		uac.SetUpdatedAt(*t)
+	}
+	return uac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (uac *UserAwardCreate) SetCreatedAt(t time.Time) *UserAwardCreate {
+	uac.mutation.SetCreatedAt(t)
+	return uac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (uac *UserAwardCreate) SetNillableCreatedAt(t *time.Time) *UserAwardCreate {
+	if t != nil {
+		uac.SetCreatedAt(*t)
+	}
+	return uac
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (uac *UserAwardCreate) SetOwnerID(u uuid.UUID) *UserAwardCreate {
+	uac.mutation.SetOwnerID(u)
+	return uac
+}
+
+// SetAwardID sets the "award_id" field.
+func (uac *UserAwardCreate) SetAwardID(u uuid.UUID) *UserAwardCreate {
+	uac.mutation.SetAwardID(u)
+	return uac
+}
+
+// SetGrantedAt sets the "granted_at" field.
+func (uac *UserAwardCreate) SetGrantedAt(t time.Time) *UserAwardCreate {
+	uac.mutation.SetGrantedAt(t)
+	return uac
+}
+
+// SetNillableGrantedAt sets the "granted_at" field if the given value is not nil.
+func (uac *UserAwardCreate) SetNillableGrantedAt(t *time.Time) *UserAwardCreate {
+	if t != nil {
+		uac.SetGrantedAt(*t)
+	}
+	return uac
+}
+
+// SetID sets the "id" field.
+func (uac *UserAwardCreate) SetID(u uuid.UUID) *UserAwardCreate {
+	uac.mutation.SetID(u)
+	return uac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (uac *UserAwardCreate) SetNillableID(u *uuid.UUID) *UserAwardCreate {
+	if u != nil {
+		uac.SetID(*u)
+	}
+	return uac
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (uac *UserAwardCreate) SetOwner(u *User) *UserAwardCreate {
+	return uac.SetOwnerID(u.ID)
+}
+
+// SetAward sets the "award" edge to the AwardDefinition entity.
+func (uac *UserAwardCreate) SetAward(a *AwardDefinition) *UserAwardCreate {
+	return uac.SetAwardID(a.ID)
+}
+
+// Mutation returns the UserAwardMutation object of the builder.
+func (uac *UserAwardCreate) Mutation() *UserAwardMutation {
+	return uac.mutation
+}
+
+// Save creates the UserAward in the database.
+func (uac *UserAwardCreate) Save(ctx context.Context) (*UserAward, error) {
+	if err := uac.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, uac.sqlSave, uac.mutation, uac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (uac *UserAwardCreate) SaveX(ctx context.Context) *UserAward {
+	v, err := uac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uac *UserAwardCreate) Exec(ctx context.Context) error {
+	_, err := uac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uac *UserAwardCreate) ExecX(ctx context.Context) {
+	if err := uac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uac *UserAwardCreate) defaults() error {
+	if _, ok := uac.mutation.UpdatedAt(); !ok {
+		if useraward.DefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized useraward.DefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := useraward.DefaultUpdatedAt()
+		uac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := uac.mutation.CreatedAt(); !ok {
+		if useraward.DefaultCreatedAt == nil {
+			return fmt.Errorf("generated: uninitialized useraward.DefaultCreatedAt (forgotten import generated/runtime?)")
+		}
+		v := useraward.DefaultCreatedAt()
+		uac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := uac.mutation.GrantedAt(); !ok {
+		if useraward.DefaultGrantedAt == nil {
+			return fmt.Errorf("generated: uninitialized useraward.DefaultGrantedAt (forgotten import generated/runtime?)")
+		}
+		v := useraward.DefaultGrantedAt()
+		uac.mutation.SetGrantedAt(v)
+	}
+	if _, ok := uac.mutation.ID(); !ok {
+		if useraward.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized useraward.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := useraward.DefaultID()
+		uac.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uac *UserAwardCreate) check() error {
+	if _, ok := uac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "UserAward.updated_at"`)}
+	}
+	if _, ok := uac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "UserAward.created_at"`)}
+	}
+	if _, ok := uac.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`generated: missing required field "UserAward.owner_id"`)}
+	}
+	if _, ok := uac.mutation.AwardID(); !ok {
+		return &ValidationError{Name: "award_id", err: errors.New(`generated: missing required field "UserAward.award_id"`)}
+	}
+	if _, ok := uac.mutation.GrantedAt(); !ok {
+		return &ValidationError{Name: "granted_at", err: errors.New(`generated: missing required field "UserAward.granted_at"`)}
+	}
+	if len(uac.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`generated: missing required edge "UserAward.owner"`)}
+	}
+	if len(uac.mutation.AwardIDs()) == 0 {
+		return &ValidationError{Name: "award", err: errors.New(`generated: missing required edge "UserAward.award"`)}
+	}
+	return nil
+}
+
+func (uac *UserAwardCreate) sqlSave(ctx context.Context) (*UserAward, error) {
+	if err := uac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := uac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, uac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	uac.mutation.id = &_node.ID
+	uac.mutation.done = true
+	return _node, nil
+}
+
+func (uac *UserAwardCreate) createSpec() (*UserAward, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserAward{config: uac.config}
+		_spec = sqlgraph.NewCreateSpec(useraward.Table, sqlgraph.NewFieldSpec(useraward.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = uac.conflict
+	if id, ok := uac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := uac.mutation.UpdatedAt(); ok {
+		_spec.SetField(useraward.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := uac.mutation.CreatedAt(); ok {
+		_spec.SetField(useraward.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := uac.mutation.GrantedAt(); ok {
+		_spec.SetField(useraward.FieldGrantedAt, field.TypeTime, value)
+		_node.GrantedAt = value
+	}
+	if nodes := uac.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   useraward.OwnerTable,
+			Columns: []string{useraward.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uac.mutation.AwardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   useraward.AwardTable,
+			Columns: []string{useraward.AwardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(awarddefinition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AwardID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserAward.Create().
+//		SetUpdatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserAwardUpsert) {
+//			SetUpdatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (uac *UserAwardCreate) OnConflict(opts ...sql.ConflictOption) *UserAwardUpsertOne {
+	uac.conflict = opts
+	return &UserAwardUpsertOne{
+		create: uac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserAward.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (uac *UserAwardCreate) OnConflictColumns(columns ...string) *UserAwardUpsertOne {
+	uac.conflict = append(uac.conflict, sql.ConflictColumns(columns...))
+	return &UserAwardUpsertOne{
+		create: uac,
+	}
+}
+
+type (
+	// UserAwardUpsertOne is the builder for "upsert"-ing
+	//  one UserAward node.
+	UserAwardUpsertOne struct {
+		create *UserAwardCreate
+	}
+
+	// UserAwardUpsert is the "OnConflict" setter.
+	UserAwardUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserAwardUpsert) SetUpdatedAt(v time.Time) *UserAwardUpsert {
+	u.Set(useraward.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserAwardUpsert) UpdateUpdatedAt() *UserAwardUpsert {
+	u.SetExcluded(useraward.FieldUpdatedAt)
+	return u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *UserAwardUpsert) SetOwnerID(v uuid.UUID) *UserAwardUpsert {
+	u.Set(useraward.FieldOwnerID, v)
+	return u
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *UserAwardUpsert) UpdateOwnerID() *UserAwardUpsert {
+	u.SetExcluded(useraward.FieldOwnerID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.UserAward.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(useraward.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserAwardUpsertOne) UpdateNewValues() *UserAwardUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(useraward.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(useraward.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.AwardID(); exists {
+			s.SetIgnore(useraward.FieldAwardID)
+		}
+		if _, exists := u.create.mutation.GrantedAt(); exists {
+			s.SetIgnore(useraward.FieldGrantedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserAward.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserAwardUpsertOne) Ignore() *UserAwardUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserAwardUpsertOne) DoNothing() *UserAwardUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserAwardCreate.OnConflict
+// documentation for more info.
+func (u *UserAwardUpsertOne) Update(set func(*UserAwardUpsert)) *UserAwardUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserAwardUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserAwardUpsertOne) SetUpdatedAt(v time.Time) *UserAwardUpsertOne {
+	return u.Update(func(s *UserAwardUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserAwardUpsertOne) UpdateUpdatedAt() *UserAwardUpsertOne {
+	return u.Update(func(s *UserAwardUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *UserAwardUpsertOne) SetOwnerID(v uuid.UUID) *UserAwardUpsertOne {
+	return u.Update(func(s *UserAwardUpsert) {
+		s.SetOwnerID(v)
+	})
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *UserAwardUpsertOne) UpdateOwnerID() *UserAwardUpsertOne {
+	return u.Update(func(s *UserAwardUpsert) {
+		s.UpdateOwnerID()
+	})
+}
+
+// Exec executes the query.
+func (u *UserAwardUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for UserAwardCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserAwardUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserAwardUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("generated: UserAwardUpsertOne.ID is not supported by MySQL driver. Use UserAwardUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserAwardUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UserAwardCreateBulk is the builder for creating many UserAward entities in bulk.
+type UserAwardCreateBulk struct {
+	config
+	err      error
+	builders []*UserAwardCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UserAward entities in the database.
+func (uacb *UserAwardCreateBulk) Save(ctx context.Context) ([]*UserAward, error) {
+	if uacb.err != nil {
+		return nil, uacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(uacb.builders))
+	nodes := make([]*UserAward, len(uacb.builders))
+	mutators := make([]Mutator, len(uacb.builders))
+	for i := range uacb.builders {
+		func(i int, root context.Context) {
+			builder := uacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserAwardMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = uacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uacb *UserAwardCreateBulk) SaveX(ctx context.Context) []*UserAward {
+	v, err := uacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uacb *UserAwardCreateBulk) Exec(ctx context.Context) error {
+	_, err := uacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uacb *UserAwardCreateBulk) ExecX(ctx context.Context) {
+	if err := uacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserAward.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserAwardUpsert) {
+//			SetUpdatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (uacb *UserAwardCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserAwardUpsertBulk {
+	uacb.conflict = opts
+	return &UserAwardUpsertBulk{
+		create: uacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserAward.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (uacb *UserAwardCreateBulk) OnConflictColumns(columns ...string) *UserAwardUpsertBulk {
+	uacb.conflict = append(uacb.conflict, sql.ConflictColumns(columns...))
+	return &UserAwardUpsertBulk{
+		create: uacb,
+	}
+}
+
+// UserAwardUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserAward nodes.
+type UserAwardUpsertBulk struct {
+	create *UserAwardCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserAward.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(useraward.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserAwardUpsertBulk) UpdateNewValues() *UserAwardUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(useraward.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(useraward.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.AwardID(); exists {
+				s.SetIgnore(useraward.FieldAwardID)
+			}
+			if _, exists := b.mutation.GrantedAt(); exists {
+				s.SetIgnore(useraward.FieldGrantedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserAward.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserAwardUpsertBulk) Ignore() *UserAwardUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserAwardUpsertBulk) DoNothing() *UserAwardUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserAwardCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserAwardUpsertBulk) Update(set func(*UserAwardUpsert)) *UserAwardUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserAwardUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserAwardUpsertBulk) SetUpdatedAt(v time.Time) *UserAwardUpsertBulk {
+	return u.Update(func(s *UserAwardUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserAwardUpsertBulk) UpdateUpdatedAt() *UserAwardUpsertBulk {
+	return u.Update(func(s *UserAwardUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *UserAwardUpsertBulk) SetOwnerID(v uuid.UUID) *UserAwardUpsertBulk {
+	return u.Update(func(s *UserAwardUpsert) {
+		s.SetOwnerID(v)
+	})
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *UserAwardUpsertBulk) UpdateOwnerID() *UserAwardUpsertBulk {
+	return u.Update(func(s *UserAwardUpsert) {
+		s.UpdateOwnerID()
+	})
+}
+
+// Exec executes the query.
+func (u *UserAwardUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("generated: OnConflict was set for builder %d. Set it on the UserAwardCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("generated: missing options for UserAwardCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserAwardUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
+)
+
+// UserAwardDelete is the builder for deleting a UserAward entity.
+type UserAwardDelete struct {
+	config
+	hooks    []Hook
+	mutation *UserAwardMutation
+}
+
+// Where appends a list predicates to the UserAwardDelete builder.
+func (uad *UserAwardDelete) Where(ps ...predicate.UserAward) *UserAwardDelete {
+	uad.mutation.Where(ps...)
+	return uad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (uad *UserAwardDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, uad.sqlExec, uad.mutation, uad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uad *UserAwardDelete) ExecX(ctx context.Context) int {
+	n, err := uad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (uad *UserAwardDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(useraward.Table, sqlgraph.NewFieldSpec(useraward.FieldID, field.TypeUUID))
+	if ps := uad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, uad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	uad.mutation.done = true
+	return affected, err
+}
+
+// UserAwardDeleteOne is the builder for deleting a single UserAward entity.
+type UserAwardDeleteOne struct {
+	uad *UserAwardDelete
+}
+
+// Where appends a list predicates to the UserAwardDelete builder.
+func (uado *UserAwardDeleteOne) Where(ps ...predicate.UserAward) *UserAwardDeleteOne {
+	uado.uad.mutation.Where(ps...)
+	return uado
+}
+
+// Exec executes the deletion query.
+func (uado *UserAwardDeleteOne) Exec(ctx context.Context) error {
+	n, err := uado.uad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{useraward.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uado *UserAwardDeleteOne) ExecX(ctx context.Context) {
+	if err := uado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
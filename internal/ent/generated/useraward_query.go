@@ -0,0 +1,745 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
+	"github.com/google/uuid"
+)
+
+// UserAwardQuery is the builder for querying UserAward entities.
+type UserAwardQuery struct {
+	config
+	ctx        *QueryContext
+	order      []useraward.OrderOption
+	inters     []Interceptor
+	predicates []predicate.UserAward
+	withOwner  *UserQuery
+	withAward  *AwardDefinitionQuery
+	loadTotal  []func(context.Context, []*UserAward) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UserAwardQuery builder.
+func (uaq *UserAwardQuery) Where(ps ...predicate.UserAward) *UserAwardQuery {
+	uaq.predicates = append(uaq.predicates, ps...)
+	return uaq
+}
+
+// Limit the number of records to be returned by this query.
+func (uaq *UserAwardQuery) Limit(limit int) *UserAwardQuery {
+	uaq.ctx.Limit = &limit
+	return uaq
+}
+
+// Offset to start from.
+func (uaq *UserAwardQuery) Offset(offset int) *UserAwardQuery {
+	uaq.ctx.Offset = &offset
+	return uaq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (uaq *UserAwardQuery) Unique(unique bool) *UserAwardQuery {
+	uaq.ctx.Unique = &unique
+	return uaq
+}
+
+// Order specifies how the records should be ordered.
+func (uaq *UserAwardQuery) Order(o ...useraward.OrderOption) *UserAwardQuery {
+	uaq.order = append(uaq.order, o...)
+	return uaq
+}
+
+// QueryOwner chains the current query on the "owner" edge.
+func (uaq *UserAwardQuery) QueryOwner() *UserQuery {
+	query := (&UserClient{config: uaq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uaq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(useraward.Table, useraward.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, useraward.OwnerTable, useraward.OwnerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uaq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAward chains the current query on the "award" edge.
+func (uaq *UserAwardQuery) QueryAward() *AwardDefinitionQuery {
+	query := (&AwardDefinitionClient{config: uaq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uaq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(useraward.Table, useraward.FieldID, selector),
+			sqlgraph.To(awarddefinition.Table, awarddefinition.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, useraward.AwardTable, useraward.AwardColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uaq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first UserAward entity from the query.
+// Returns a *NotFoundError when no UserAward was found.
+func (uaq *UserAwardQuery) First(ctx context.Context) (*UserAward, error) {
+	nodes, err := uaq.Limit(1).All(setContextOp(ctx, uaq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{useraward.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (uaq *UserAwardQuery) FirstX(ctx context.Context) *UserAward {
+	node, err := uaq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UserAward ID from the query.
+// Returns a *NotFoundError when no UserAward ID was found.
+func (uaq *UserAwardQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = uaq.Limit(1).IDs(setContextOp(ctx, uaq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{useraward.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (uaq *UserAwardQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := uaq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UserAward entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one UserAward entity is found.
+// Returns a *NotFoundError when no UserAward entities are found.
+func (uaq *UserAwardQuery) Only(ctx context.Context) (*UserAward, error) {
+	nodes, err := uaq.Limit(2).All(setContextOp(ctx, uaq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{useraward.Label}
+	default:
+		return nil, &NotSingularError{useraward.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (uaq *UserAwardQuery) OnlyX(ctx context.Context) *UserAward {
+	node, err := uaq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UserAward ID in the query.
+// Returns a *NotSingularError when more than one UserAward ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (uaq *UserAwardQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = uaq.Limit(2).IDs(setContextOp(ctx, uaq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{useraward.Label}
+	default:
+		err = &NotSingularError{useraward.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (uaq *UserAwardQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := uaq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UserAwards.
+func (uaq *UserAwardQuery) All(ctx context.Context) ([]*UserAward, error) {
+	ctx = setContextOp(ctx, uaq.ctx, ent.OpQueryAll)
+	if err := uaq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*UserAward, *UserAwardQuery]()
+	return withInterceptors[[]*UserAward](ctx, uaq, qr, uaq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (uaq *UserAwardQuery) AllX(ctx context.Context) []*UserAward {
+	nodes, err := uaq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UserAward IDs.
+func (uaq *UserAwardQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if uaq.ctx.Unique == nil && uaq.path != nil {
+		uaq.Unique(true)
+	}
+	ctx = setContextOp(ctx, uaq.ctx, ent.OpQueryIDs)
+	if err = uaq.Select(useraward.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (uaq *UserAwardQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := uaq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (uaq *UserAwardQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, uaq.ctx, ent.OpQueryCount)
+	if err := uaq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, uaq, querierCount[*UserAwardQuery](), uaq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (uaq *UserAwardQuery) CountX(ctx context.Context) int {
+	count, err := uaq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (uaq *UserAwardQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, uaq.ctx, ent.OpQueryExist)
+	switch _, err := uaq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("generated: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (uaq *UserAwardQuery) ExistX(ctx context.Context) bool {
+	exist, err := uaq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UserAwardQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (uaq *UserAwardQuery) Clone() *UserAwardQuery {
+	if uaq == nil {
+		return nil
+	}
+	return &UserAwardQuery{
+		config:     uaq.config,
+		ctx:        uaq.ctx.Clone(),
+		order:      append([]useraward.OrderOption{}, uaq.order...),
+		inters:     append([]Interceptor{}, uaq.inters...),
+		predicates: append([]predicate.UserAward{}, uaq.predicates...),
+		withOwner:  uaq.withOwner.Clone(),
+		withAward:  uaq.withAward.Clone(),
+		// clone intermediate query.
+		sql:       uaq.sql.Clone(),
+		path:      uaq.path,
+		modifiers: append([]func(*sql.Selector){}, uaq.modifiers...),
+	}
+}
+
+// WithOwner tells the query-builder to eager-load the nodes that are connected to
+// the "owner" edge. The optional arguments are used to configure the query builder of the edge.
+func (uaq *UserAwardQuery) WithOwner(opts ...func(*UserQuery)) *UserAwardQuery {
+	query := (&UserClient{config: uaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uaq.withOwner = query
+	return uaq
+}
+
+// WithAward tells the query-builder to eager-load the nodes that are connected to
+// the "award" edge. The optional arguments are used to configure the query builder of the edge.
+func (uaq *UserAwardQuery) WithAward(opts ...func(*AwardDefinitionQuery)) *UserAwardQuery {
+	query := (&AwardDefinitionClient{config: uaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uaq.withAward = query
+	return uaq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UpdatedAt time.Time `json:"updated_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UserAward.Query().
+//		GroupBy(useraward.FieldUpdatedAt).
+//		Aggregate(generated.Count()).
+//		Scan(ctx, &v)
+func (uaq *UserAwardQuery) GroupBy(field string, fields ...string) *UserAwardGroupBy {
+	uaq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UserAwardGroupBy{build: uaq}
+	grbuild.flds = &uaq.ctx.Fields
+	grbuild.label = useraward.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UpdatedAt time.Time `json:"updated_at,omitempty"`
+//	}
+//
+//	client.UserAward.Query().
+//		Select(useraward.FieldUpdatedAt).
+//		Scan(ctx, &v)
+func (uaq *UserAwardQuery) Select(fields ...string) *UserAwardSelect {
+	uaq.ctx.Fields = append(uaq.ctx.Fields, fields...)
+	sbuild := &UserAwardSelect{UserAwardQuery: uaq}
+	sbuild.label = useraward.Label
+	sbuild.flds, sbuild.scan = &uaq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a UserAwardSelect configured with the given aggregations.
+func (uaq *UserAwardQuery) Aggregate(fns ...AggregateFunc) *UserAwardSelect {
+	return uaq.Select().Aggregate(fns...)
+}
+
+func (uaq *UserAwardQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range uaq.inters {
+		if inter == nil {
+			return fmt.Errorf("generated: uninitialized interceptor (forgotten import generated/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, uaq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range uaq.ctx.Fields {
+		if !useraward.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+		}
+	}
+	if uaq.path != nil {
+		prev, err := uaq.path(ctx)
+		if err != nil {
+			return err
+		}
+		uaq.sql = prev
+	}
+	if useraward.Policy == nil {
+		return errors.New("generated: uninitialized useraward.Policy (forgotten import generated/runtime?)")
+	}
+	if err := useraward.Policy.EvalQuery(ctx, uaq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (uaq *UserAwardQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UserAward, error) {
+	var (
+		nodes       = []*UserAward{}
+		_spec       = uaq.querySpec()
+		loadedTypes = [2]bool{
+			uaq.withOwner != nil,
+			uaq.withAward != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*UserAward).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &UserAward{config: uaq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(uaq.modifiers) > 0 {
+		_spec.Modifiers = uaq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, uaq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := uaq.withOwner; query != nil {
+		if err := uaq.loadOwner(ctx, query, nodes, nil,
+			func(n *UserAward, e *User) { n.Edges.Owner = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uaq.withAward; query != nil {
+		if err := uaq.loadAward(ctx, query, nodes, nil,
+			func(n *UserAward, e *AwardDefinition) { n.Edges.Award = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range uaq.loadTotal {
+		if err := uaq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (uaq *UserAwardQuery) loadOwner(ctx context.Context, query *UserQuery, nodes []*UserAward, init func(*UserAward), assign func(*UserAward, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*UserAward)
+	for i := range nodes {
+		fk := nodes[i].OwnerID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "owner_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (uaq *UserAwardQuery) loadAward(ctx context.Context, query *AwardDefinitionQuery, nodes []*UserAward, init func(*UserAward), assign func(*UserAward, *AwardDefinition)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*UserAward)
+	for i := range nodes {
+		fk := nodes[i].AwardID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(awarddefinition.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "award_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (uaq *UserAwardQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := uaq.querySpec()
+	if len(uaq.modifiers) > 0 {
+		_spec.Modifiers = uaq.modifiers
+	}
+	_spec.Node.Columns = uaq.ctx.Fields
+	if len(uaq.ctx.Fields) > 0 {
+		_spec.Unique = uaq.ctx.Unique != nil && *uaq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, uaq.driver, _spec)
+}
+
+func (uaq *UserAwardQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(useraward.Table, useraward.Columns, sqlgraph.NewFieldSpec(useraward.FieldID, field.TypeUUID))
+	_spec.From = uaq.sql
+	if unique := uaq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if uaq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := uaq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, useraward.FieldID)
+		for i := range fields {
+			if fields[i] != useraward.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if uaq.withOwner != nil {
+			_spec.Node.AddColumnOnce(useraward.FieldOwnerID)
+		}
+		if uaq.withAward != nil {
+			_spec.Node.AddColumnOnce(useraward.FieldAwardID)
+		}
+	}
+	if ps := uaq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := uaq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := uaq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := uaq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (uaq *UserAwardQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(uaq.driver.Dialect())
+	t1 := builder.Table(useraward.Table)
+	columns := uaq.ctx.Fields
+	if len(columns) == 0 {
+		columns = useraward.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if uaq.sql != nil {
+		selector = uaq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if uaq.ctx.Unique != nil && *uaq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range uaq.modifiers {
+		m(selector)
+	}
+	for _, p := range uaq.predicates {
+		p(selector)
+	}
+	for _, p := range uaq.order {
+		p(selector)
+	}
+	if offset := uaq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := uaq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (uaq *UserAwardQuery) ForUpdate(opts ...sql.LockOption) *UserAwardQuery {
+	if uaq.driver.Dialect() == dialect.Postgres {
+		uaq.Unique(false)
+	}
+	uaq.modifiers = append(uaq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return uaq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (uaq *UserAwardQuery) ForShare(opts ...sql.LockOption) *UserAwardQuery {
+	if uaq.driver.Dialect() == dialect.Postgres {
+		uaq.Unique(false)
+	}
+	uaq.modifiers = append(uaq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return uaq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (uaq *UserAwardQuery) Modify(modifiers ...func(s *sql.Selector)) *UserAwardSelect {
+	uaq.modifiers = append(uaq.modifiers, modifiers...)
+	return uaq.Select()
+}
+
+// UserAwardGroupBy is the group-by builder for UserAward entities.
+type UserAwardGroupBy struct {
+	selector
+	build *UserAwardQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (uagb *UserAwardGroupBy) Aggregate(fns ...AggregateFunc) *UserAwardGroupBy {
+	uagb.fns = append(uagb.fns, fns...)
+	return uagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (uagb *UserAwardGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, uagb.build.ctx, ent.OpQueryGroupBy)
+	if err := uagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserAwardQuery, *UserAwardGroupBy](ctx, uagb.build, uagb, uagb.build.inters, v)
+}
+
+func (uagb *UserAwardGroupBy) sqlScan(ctx context.Context, root *UserAwardQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(uagb.fns))
+	for _, fn := range uagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*uagb.flds)+len(uagb.fns))
+		for _, f := range *uagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*uagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := uagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// UserAwardSelect is the builder for selecting fields of UserAward entities.
+type UserAwardSelect struct {
+	*UserAwardQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (uas *UserAwardSelect) Aggregate(fns ...AggregateFunc) *UserAwardSelect {
+	uas.fns = append(uas.fns, fns...)
+	return uas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (uas *UserAwardSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, uas.ctx, ent.OpQuerySelect)
+	if err := uas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserAwardQuery, *UserAwardSelect](ctx, uas.UserAwardQuery, uas, uas.inters, v)
+}
+
+func (uas *UserAwardSelect) sqlScan(ctx context.Context, root *UserAwardQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(uas.fns))
+	for _, fn := range uas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*uas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := uas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (uas *UserAwardSelect) Modify(modifiers ...func(s *sql.Selector)) *UserAwardSelect {
+	uas.modifiers = append(uas.modifiers, modifiers...)
+	return uas
+}
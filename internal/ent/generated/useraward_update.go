@@ -0,0 +1,378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
+	"github.com/google/uuid"
+)
+
+// UserAwardUpdate is the builder for updating UserAward entities.
+type UserAwardUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *UserAwardMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the UserAwardUpdate builder.
+func (uau *UserAwardUpdate) Where(ps ...predicate.UserAward) *UserAwardUpdate {
+	uau.mutation.Where(ps...)
+	return uau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uau *UserAwardUpdate) SetUpdatedAt(t time.Time) *UserAwardUpdate {
+	uau.mutation.SetUpdatedAt(t)
+	return uau
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (uau *UserAwardUpdate) SetOwnerID(u uuid.UUID) *UserAwardUpdate {
+	uau.mutation.SetOwnerID(u)
+	return uau
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (uau *UserAwardUpdate) SetNillableOwnerID(u *uuid.UUID) *UserAwardUpdate {
+	if u != nil {
+		uau.SetOwnerID(*u)
+	}
+	return uau
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (uau *UserAwardUpdate) SetOwner(u *User) *UserAwardUpdate {
+	return uau.SetOwnerID(u.ID)
+}
+
+// Mutation returns the UserAwardMutation object of the builder.
+func (uau *UserAwardUpdate) Mutation() *UserAwardMutation {
+	return uau.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (uau *UserAwardUpdate) ClearOwner() *UserAwardUpdate {
+	uau.mutation.ClearOwner()
+	return uau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (uau *UserAwardUpdate) Save(ctx context.Context) (int, error) {
+	if err := uau.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, uau.sqlSave, uau.mutation, uau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uau *UserAwardUpdate) SaveX(ctx context.Context) int {
+	affected, err := uau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (uau *UserAwardUpdate) Exec(ctx context.Context) error {
+	_, err := uau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uau *UserAwardUpdate) ExecX(ctx context.Context) {
+	if err := uau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uau *UserAwardUpdate) defaults() error {
+	if _, ok := uau.mutation.UpdatedAt(); !ok {
+		if useraward.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized useraward.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := useraward.UpdateDefaultUpdatedAt()
+		uau.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uau *UserAwardUpdate) check() error {
+	if uau.mutation.OwnerCleared() && len(uau.mutation.OwnerIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "UserAward.owner"`)
+	}
+	if uau.mutation.AwardCleared() && len(uau.mutation.AwardIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "UserAward.award"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (uau *UserAwardUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserAwardUpdate {
+	uau.modifiers = append(uau.modifiers, modifiers...)
+	return uau
+}
+
+func (uau *UserAwardUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := uau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(useraward.Table, useraward.Columns, sqlgraph.NewFieldSpec(useraward.FieldID, field.TypeUUID))
+	if ps := uau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uau.mutation.UpdatedAt(); ok {
+		_spec.SetField(useraward.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if uau.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   useraward.OwnerTable,
+			Columns: []string{useraward.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uau.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   useraward.OwnerTable,
+			Columns: []string{useraward.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(uau.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, uau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{useraward.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uau.mutation.done = true
+	return n, nil
+}
+
+// UserAwardUpdateOne is the builder for updating a single UserAward entity.
+type UserAwardUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *UserAwardMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uauo *UserAwardUpdateOne) SetUpdatedAt(t time.Time) *UserAwardUpdateOne {
+	uauo.mutation.SetUpdatedAt(t)
+	return uauo
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (uauo *UserAwardUpdateOne) SetOwnerID(u uuid.UUID) *UserAwardUpdateOne {
+	uauo.mutation.SetOwnerID(u)
+	return uauo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (uauo *UserAwardUpdateOne) SetNillableOwnerID(u *uuid.UUID) *UserAwardUpdateOne {
+	if u != nil {
+		uauo.SetOwnerID(*u)
+	}
+	return uauo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (uauo *UserAwardUpdateOne) SetOwner(u *User) *UserAwardUpdateOne {
+	return uauo.SetOwnerID(u.ID)
+}
+
+// Mutation returns the UserAwardMutation object of the builder.
+func (uauo *UserAwardUpdateOne) Mutation() *UserAwardMutation {
+	return uauo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (uauo *UserAwardUpdateOne) ClearOwner() *UserAwardUpdateOne {
+	uauo.mutation.ClearOwner()
+	return uauo
+}
+
+// Where appends a list predicates to the UserAwardUpdate builder.
+func (uauo *UserAwardUpdateOne) Where(ps ...predicate.UserAward) *UserAwardUpdateOne {
+	uauo.mutation.Where(ps...)
+	return uauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uauo *UserAwardUpdateOne) Select(field string, fields ...string) *UserAwardUpdateOne {
+	uauo.fields = append([]string{field}, fields...)
+	return uauo
+}
+
+// Save executes the query and returns the updated UserAward entity.
+func (uauo *UserAwardUpdateOne) Save(ctx context.Context) (*UserAward, error) {
+	if err := uauo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, uauo.sqlSave, uauo.mutation, uauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uauo *UserAwardUpdateOne) SaveX(ctx context.Context) *UserAward {
+	node, err := uauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uauo *UserAwardUpdateOne) Exec(ctx context.Context) error {
+	_, err := uauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uauo *UserAwardUpdateOne) ExecX(ctx context.Context) {
+	if err := uauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uauo *UserAwardUpdateOne) defaults() error {
+	if _, ok := uauo.mutation.UpdatedAt(); !ok {
+		if useraward.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized useraward.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := useraward.UpdateDefaultUpdatedAt()
+		uauo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uauo *UserAwardUpdateOne) check() error {
+	if uauo.mutation.OwnerCleared() && len(uauo.mutation.OwnerIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "UserAward.owner"`)
+	}
+	if uauo.mutation.AwardCleared() && len(uauo.mutation.AwardIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "UserAward.award"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (uauo *UserAwardUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserAwardUpdateOne {
+	uauo.modifiers = append(uauo.modifiers, modifiers...)
+	return uauo
+}
+
+func (uauo *UserAwardUpdateOne) sqlSave(ctx context.Context) (_node *UserAward, err error) {
+	if err := uauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(useraward.Table, useraward.Columns, sqlgraph.NewFieldSpec(useraward.FieldID, field.TypeUUID))
+	id, ok := uauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "UserAward.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, useraward.FieldID)
+		for _, f := range fields {
+			if !useraward.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != useraward.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(useraward.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if uauo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   useraward.OwnerTable,
+			Columns: []string{useraward.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uauo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   useraward.OwnerTable,
+			Columns: []string{useraward.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(uauo.modifiers...)
+	_node = &UserAward{config: uauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, uauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{useraward.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	uauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,101 @@
+package hooks
+
+import (
+	"context"
+
+	"entgo.io/ent"
+	"github.com/google/uuid"
+
+	"github.com/caliecode/la-clipasa/internal/awards"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/hook"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/post"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+)
+
+// PostAwards evaluates awards for the owner of a newly created post.
+func PostAwards() ent.Hook {
+	return hook.On(
+		func(next ent.Mutator) ent.Mutator {
+			return hook.PostFunc(func(ctx context.Context, m *generated.PostMutation) (generated.Value, error) {
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return v, err
+				}
+
+				if ownerID, ok := m.OwnerID(); ok {
+					evaluateAwards(ctx, m.Client(), ownerID)
+				}
+
+				return v, nil
+			})
+		},
+		ent.OpCreate,
+	)
+}
+
+// PostCategoryAwards evaluates awards for the owner of a newly categorized post.
+func PostCategoryAwards() ent.Hook {
+	return hook.On(
+		func(next ent.Mutator) ent.Mutator {
+			return hook.PostCategoryFunc(func(ctx context.Context, m *generated.PostCategoryMutation) (generated.Value, error) {
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return v, err
+				}
+
+				postID, ok := m.PostID()
+				if !ok {
+					return v, nil
+				}
+
+				evaluatePostOwnersAwards(ctx, m.Client(), postID)
+
+				return v, nil
+			})
+		},
+		ent.OpCreate,
+	)
+}
+
+// LikedPostAwards evaluates awards for the owners of newly liked posts.
+func LikedPostAwards() ent.Hook {
+	return hook.On(
+		func(next ent.Mutator) ent.Mutator {
+			return hook.UserFunc(func(ctx context.Context, m *generated.UserMutation) (generated.Value, error) {
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return v, err
+				}
+
+				if likedPostIDs := m.LikedPostsIDs(); len(likedPostIDs) > 0 {
+					evaluatePostOwnersAwards(ctx, m.Client(), likedPostIDs...)
+				}
+
+				return v, nil
+			})
+		},
+		ent.OpUpdate|ent.OpUpdateOne,
+	)
+}
+
+func evaluatePostOwnersAwards(ctx context.Context, client *generated.Client, postIDs ...uuid.UUID) {
+	ownerIDs, err := client.Post.Query().
+		Where(post.IDIn(postIDs...)).
+		QueryOwner().
+		IDs(token.NewContextWithSystemCallToken(privacy.DecisionContext(ctx, privacy.Allow)))
+	if err != nil {
+		client.Logger.Errorf("could not query post owners for awards: %v", err)
+		return
+	}
+
+	evaluateAwards(ctx, client, ownerIDs...)
+}
+
+// evaluateAwards grants pending awards without failing the original mutation.
+func evaluateAwards(ctx context.Context, client *generated.Client, userIDs ...uuid.UUID) {
+	if err := awards.NewEvaluator(client).EvaluateUsers(ctx, userIDs...); err != nil {
+		client.Logger.Errorf("could not evaluate awards: %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+package hooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"entgo.io/ent"
+	"github.com/google/uuid"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/hook"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/post"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+)
+
+// CommentBlocks prevents users from replying to or mentioning users that blocked them.
+func CommentBlocks() ent.Hook {
+	return hook.On(
+		func(next ent.Mutator) ent.Mutator {
+			return hook.CommentFunc(func(ctx context.Context, m *generated.CommentMutation) (generated.Value, error) {
+				if _, allow := privacy.DecisionFromContext(ctx); allow {
+					return next.Mutate(ctx, m)
+				}
+
+				u := internal.GetUserFromCtx(ctx)
+				if u == nil {
+					return next.Mutate(ctx, m)
+				}
+
+				// blocks are checked regardless of the user's own blocks and mutes
+				allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+				blockers, err := m.Client().User.Query().
+					Where(user.HasBlockedUsersWith(user.ID(u.ID))).
+					Select(user.FieldID, user.FieldDisplayName).
+					All(allowCtx)
+				if err != nil {
+					return nil, fmt.Errorf("could not query blockers: %w", err)
+				}
+				if len(blockers) == 0 {
+					return next.Mutate(ctx, m)
+				}
+
+				if postID, ok := m.PostID(); ok {
+					blockerIDs := make([]uuid.UUID, len(blockers))
+					for i, b := range blockers {
+						blockerIDs[i] = b.ID
+					}
+					blocked, err := m.Client().Post.Query().
+						Where(post.ID(postID), post.OwnerIDIn(blockerIDs...)).
+						Exist(allowCtx)
+					if err != nil {
+						return nil, fmt.Errorf("could not query post owner: %w", err)
+					}
+					if blocked {
+						return nil, errors.New("cannot comment on posts of users that blocked you")
+					}
+				}
+
+				if content, ok := m.Content(); ok {
+					content = strings.ToLower(content)
+					for _, b := range blockers {
+						if mentions(content, strings.ToLower(b.DisplayName)) {
+							return nil, fmt.Errorf("cannot mention %s", b.DisplayName)
+						}
+					}
+				}
+
+				return next.Mutate(ctx, m)
+			})
+		},
+		ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne,
+	)
+}
+
+// mentions returns whether content mentions name as a whole word, e.g. "@bob" does not mention "bobby".
+func mentions(content, name string) bool {
+	mention := "@" + name
+	for i := strings.Index(content, mention); i >= 0; {
+		rest := content[i+len(mention):]
+		if r, _ := utf8.DecodeRuneInString(rest); rest == "" || !isNameRune(r) {
+			return true
+		}
+
+		next := strings.Index(rest, mention)
+		if next < 0 {
+			return false
+		}
+		i += len(mention) + next
+	}
+
+	return false
+}
+
+func isNameRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
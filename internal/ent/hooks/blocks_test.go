@@ -0,0 +1,28 @@
+package hooks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMentions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		content string
+		want    bool
+	}{
+		{content: "@bob", want: true},
+		{content: "hey @bob!", want: true},
+		{content: "@bob, @alice", want: true},
+		{content: "@bobby", want: false},
+		{content: "@bob_", want: false},
+		{content: "@bob2", want: false},
+		{content: "@bobby and @bob", want: true},
+		{content: "bob", want: false},
+	}
+	for _, tc := range tests {
+		assert.Equal(t, tc.want, mentions(tc.content, "bob"), tc.content)
+	}
+}
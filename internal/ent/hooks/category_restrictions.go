@@ -0,0 +1,27 @@
+package hooks
+
+import (
+	"context"
+
+	"entgo.io/ent"
+
+	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/hook"
+)
+
+// InvalidateCategoryRestrictions clears cached category restrictions after they change.
+func InvalidateCategoryRestrictions() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.CategoryRestrictionFunc(func(ctx context.Context, m *generated.CategoryRestrictionMutation) (generated.Value, error) {
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			auth.InvalidateCategoryRestrictions(ctx)
+
+			return v, nil
+		})
+	}
+}
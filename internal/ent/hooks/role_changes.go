@@ -0,0 +1,100 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"entgo.io/ent"
+	"github.com/google/uuid"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/hook"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+)
+
+// RecordRoleChanges records changes to a user role or Twitch VIP status.
+// Role changes made by a user through the API mark the role as manually assigned,
+// so Twitch syncs leave it alone.
+func RecordRoleChanges() ent.Hook {
+	return hook.On(
+		func(next ent.Mutator) ent.Mutator {
+			return hook.UserFunc(func(ctx context.Context, m *generated.UserMutation) (generated.Value, error) {
+				role, roleSet := m.Role()
+				vip, vipSet := m.TwitchVip()
+				if !roleSet && !vipSet {
+					return next.Mutate(ctx, m)
+				}
+
+				sysCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+				ids, err := m.IDs(sysCtx)
+				if err != nil {
+					return nil, fmt.Errorf("could not get updated users: %w", err)
+				}
+				if len(ids) == 0 {
+					return next.Mutate(ctx, m)
+				}
+				users, err := m.Client().User.Query().
+					Where(user.IDIn(ids...)).
+					Select(user.FieldRole, user.FieldTwitchVip).
+					All(sysCtx)
+				if err != nil {
+					return nil, fmt.Errorf("could not get old roles: %w", err)
+				}
+
+				source := internal.GetRoleChangeSourceFromCtx(ctx)
+				var changedByID *uuid.UUID
+				if u := internal.GetUserFromCtx(ctx); u != nil && source == rolechange.SourceMANUAL {
+					changedByID = &u.ID
+				}
+
+				type change struct {
+					userID             uuid.UUID
+					attribute          rolechange.Attribute
+					oldValue, newValue string
+				}
+				var changes []change
+				roleChanged := false
+				for _, u := range users {
+					if roleSet && u.Role != role {
+						roleChanged = true
+						changes = append(changes, change{u.ID, rolechange.AttributeROLE, u.Role.String(), role.String()})
+					}
+					if vipSet && u.TwitchVip != vip {
+						changes = append(changes, change{u.ID, rolechange.AttributeTWITCH_VIP, strconv.FormatBool(u.TwitchVip), strconv.FormatBool(vip)})
+					}
+				}
+				// only roles assigned by a user through the API are kept from Twitch syncs
+				if roleChanged && changedByID != nil {
+					m.SetRoleSource(user.RoleSourceMANUAL)
+				}
+
+				v, err := next.Mutate(ctx, m)
+				if err != nil || len(changes) == 0 {
+					return v, err
+				}
+
+				for _, c := range changes {
+					err := m.Client().RoleChange.Create().
+						SetUserID(c.userID).
+						SetAttribute(c.attribute).
+						SetOldValue(c.oldValue).
+						SetNewValue(c.newValue).
+						SetSource(source).
+						SetNillableChangedByID(changedByID).
+						Exec(sysCtx)
+					if err != nil {
+						return nil, fmt.Errorf("could not record role change: %w", err)
+					}
+				}
+
+				return v, nil
+			})
+		},
+		ent.OpUpdate|ent.OpUpdateOne,
+	)
+}
@@ -0,0 +1,27 @@
+package hooks
+
+import (
+	"context"
+
+	"entgo.io/ent"
+
+	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/hook"
+)
+
+// InvalidateRolePermissions clears cached role permissions after they change.
+func InvalidateRolePermissions() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.RolePermissionFunc(func(ctx context.Context, m *generated.RolePermissionMutation) (generated.Value, error) {
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			auth.InvalidateRolePermissions(ctx)
+
+			return v, nil
+		})
+	}
+}
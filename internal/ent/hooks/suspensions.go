@@ -0,0 +1,56 @@
+package hooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/hook"
+)
+
+// SuspensionIssuer records the caller as the suspension issuer
+// and ensures suspensions end after they start.
+func SuspensionIssuer() ent.Hook {
+	return hook.On(
+		func(next ent.Mutator) ent.Mutator {
+			return hook.SuspensionFunc(func(ctx context.Context, m *generated.SuspensionMutation) (generated.Value, error) {
+				if m.Op().Is(ent.OpCreate) {
+					if u := internal.GetUserFromCtx(ctx); u != nil {
+						m.SetIssuerID(u.ID)
+					}
+				}
+
+				startsAt, startsOk := m.StartsAt()
+				endsAt, endsOk := m.EndsAt()
+				if m.Op().Is(ent.OpUpdateOne) && (startsOk || endsOk) {
+					if !startsOk {
+						old, err := m.OldStartsAt(ctx)
+						if err != nil {
+							return nil, fmt.Errorf("could not get old starts at: %w", err)
+						}
+						startsAt, startsOk = old, true
+					}
+					if !endsOk && !m.EndsAtCleared() {
+						old, err := m.OldEndsAt(ctx)
+						if err != nil {
+							return nil, fmt.Errorf("could not get old ends at: %w", err)
+						}
+						if old != nil {
+							endsAt, endsOk = *old, true
+						}
+					}
+				}
+				if startsOk && endsOk && !endsAt.After(startsAt) {
+					return nil, errors.New("suspension must end after it starts")
+				}
+
+				return next.Mutate(ctx, m)
+			})
+		},
+		ent.OpCreate|ent.OpUpdateOne,
+	)
+}
@@ -4,12 +4,18 @@ import (
 	"entgo.io/ent"
 
 	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/rule"
 )
 
 // prePolicy is executed before privacy policy
 var prePolicy = privacy.Policy{
-	Query:    privacy.QueryPolicy{},
-	Mutation: privacy.MutationPolicy{},
+	Query: privacy.QueryPolicy{
+		rule.DenyIfAPIKeyScopeMissing(),
+	},
+	Mutation: privacy.MutationPolicy{
+		rule.DenyIfAPIKeyScopeMissing(),
+		rule.DenyIfSuspended(),
+	},
 }
 
 // postPolicy is executed after privacy policy
@@ -0,0 +1,55 @@
+package rule
+
+import (
+	"context"
+	"errors"
+
+	"entgo.io/ent"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+)
+
+// AllowIfHasPermission determines whether a query or mutation operation should be allowed
+// based on the permissions granted to the user's role
+func AllowIfHasPermission(permission rolepermission.Permission) privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		u := internal.GetUserFromCtx(ctx)
+		if u == nil {
+			return privacy.Skipf("anonymous viewer")
+		}
+
+		if !auth.HasPermission(ctx, u, permission) {
+			return privacy.Denyf("missing permission %s", permission)
+		}
+
+		return privacy.Allow
+	})
+}
+
+// AllowIfSelfOrHasPermission determines whether a mutation operation should be allowed
+// if the user either owns the entity or has the specified permission
+func AllowIfSelfOrHasPermission(permission rolepermission.Permission) privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, m ent.Mutation) error {
+		if err := AllowIfHasPermission(permission).EvalMutation(ctx, m); errors.Is(err, privacy.Allow) {
+			return privacy.Allow
+		}
+		// adds an owner where clause that can't be removed, therefore call last
+		return AllowIfSelf().EvalMutation(ctx, m)
+	})
+}
+
+// AllowIfSelfOrHasPermissionQuery determines whether a query operation should be allowed
+// if the user either owns the entity or has the specified permission.
+// If the user does not have the permission, the query is modified to filter by owner ID.
+func AllowIfSelfOrHasPermissionQuery(permission rolepermission.Permission) privacy.QueryRule {
+	return privacy.QueryRuleFunc(func(ctx context.Context, q ent.Query) error {
+		if err := AllowIfHasPermission(permission).EvalQuery(ctx, q); errors.Is(err, privacy.Allow) {
+			return privacy.Allow
+		}
+
+		return AllowIfSelf().EvalQuery(ctx, q)
+	})
+}
@@ -0,0 +1,21 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+)
+
+// AllowIfTwitchSubscriber allows users subscribed to the broadcaster Twitch channel,
+// based on the cached subscription status.
+func AllowIfTwitchSubscriber() privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		if auth.TwitchSubscriptionsFromCtx(ctx).IsSubscriber(ctx, internal.GetUserFromCtx(ctx)) {
+			return privacy.Allow
+		}
+
+		return privacy.Skip
+	})
+}
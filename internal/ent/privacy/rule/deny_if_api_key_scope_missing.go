@@ -0,0 +1,68 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/intercept"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+)
+
+// apiKeyScopes are the scopes required for API key requests, by entity type.
+// Reads without a scope are always allowed, writes without a scope are denied
+// and types not listed are never accessible with an API key.
+var apiKeyScopes = map[string]struct{ read, write auth.APIKeyScope }{
+	generated.TypePost:            {read: auth.ScopePostsRead, write: auth.ScopePostsWrite},
+	generated.TypePostCategory:    {read: auth.ScopePostsRead, write: auth.ScopePostsWrite},
+	generated.TypeComment:         {read: auth.ScopePostsRead, write: auth.ScopeCommentsWrite},
+	generated.TypeUser:            {read: ""},
+	generated.TypeAwardDefinition: {read: ""},
+	generated.TypeUserAward:       {read: ""},
+}
+
+type denyIfAPIKeyScopeMissing struct{}
+
+// DenyIfAPIKeyScopeMissing denies queries and mutations for requests authenticated
+// via an API key that lacks the required scope.
+func DenyIfAPIKeyScopeMissing() privacy.QueryMutationRule {
+	return denyIfAPIKeyScopeMissing{}
+}
+
+func (denyIfAPIKeyScopeMissing) EvalQuery(ctx context.Context, q generated.Query) error {
+	gq, err := intercept.NewQuery(q)
+	if err != nil {
+		return privacy.Denyf("unknown query: %v", err)
+	}
+
+	return evalAPIKeyScope(ctx, gq.Type(), false)
+}
+
+func (denyIfAPIKeyScopeMissing) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	return evalAPIKeyScope(ctx, m.Type(), true)
+}
+
+func evalAPIKeyScope(ctx context.Context, typ string, write bool) error {
+	if auth.APIKeyFromCtx(ctx) == nil || ContextHasPrivacyTokenOfType(ctx, &token.SystemCallToken{}) {
+		return privacy.Skip
+	}
+
+	scopes, ok := apiKeyScopes[typ]
+	if !ok {
+		return privacy.Denyf("%s is not accessible with an api key", typ)
+	}
+
+	scope := scopes.read
+	if write {
+		scope = scopes.write
+		if scope == "" {
+			return privacy.Denyf("%s is read only with an api key", typ)
+		}
+	}
+	if scope != "" && !auth.HasScope(ctx, scope) {
+		return privacy.Denyf("api key is missing scope %s", scope)
+	}
+
+	return privacy.Skip
+}
@@ -0,0 +1,43 @@
+package rule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+)
+
+// DenyIfSubscriberOnlyCategory denies non-subscribers categorizing posts in subscriber-only categories.
+// Users with the posts categorize permission are exempt.
+func DenyIfSubscriberOnlyCategory() privacy.MutationRule {
+	return privacy.PostCategoryMutationRuleFunc(func(ctx context.Context, m *generated.PostCategoryMutation) error {
+		if ContextHasPrivacyTokenOfType(ctx, &token.SystemCallToken{}) {
+			return privacy.Skip
+		}
+
+		category, ok := m.Category()
+		if !ok {
+			return privacy.Skip
+		}
+
+		restrictions, err := auth.CategoryRestrictions(ctx, m.Client())
+		if err != nil {
+			return err
+		}
+		if cr := restrictions[category.String()]; cr == nil || !cr.SubscriberOnlyPost {
+			return privacy.Skip
+		}
+
+		if errors.Is(AllowIfTwitchSubscriber().EvalMutation(ctx, m), privacy.Allow) ||
+			errors.Is(AllowIfHasPermission(rolepermission.PermissionPostsCategorize).EvalMutation(ctx, m), privacy.Allow) {
+			return privacy.Skip
+		}
+
+		return &auth.SubscriptionRequiredError{Action: fmt.Sprintf("post in %s", category)}
+	})
+}
@@ -0,0 +1,44 @@
+package rule
+
+import (
+	"context"
+
+	"entgo.io/ent"
+
+	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+)
+
+// DenyIfSuspended denies mutations by users with an active site suspension for the mutated type.
+// Scoped suspensions still allow deleting, full suspensions deny every mutation except signing out.
+// The suspension error is returned as is so that clients know when it ends.
+func DenyIfSuspended() privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, m ent.Mutation) error {
+		if ContextHasPrivacyTokenOfType(ctx, &token.SystemCallToken{}) {
+			return privacy.Skip
+		}
+
+		scope := suspension.ScopeFULL
+		switch m.(type) {
+		case *generated.RefreshTokenMutation:
+			return privacy.Skip
+		case *generated.PostMutation, *generated.PostCategoryMutation:
+			if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+				scope = suspension.ScopePOST
+			}
+		case *generated.CommentMutation:
+			if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+				scope = suspension.ScopeCOMMENT
+			}
+		}
+
+		if s := auth.ActiveSuspension(ctx, scope); s != nil {
+			return auth.NewSuspendedError(s)
+		}
+
+		return privacy.Skip
+	})
+}
@@ -0,0 +1,71 @@
+package rule
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+)
+
+// DenyIfSuspensionTargetNotOutranked denies suspension mutations of users allowed to suspend
+// or manage roles unless the caller manages roles. Users cannot suspend themselves.
+func DenyIfSuspensionTargetNotOutranked() privacy.MutationRule {
+	return privacy.SuspensionMutationRuleFunc(func(ctx context.Context, m *generated.SuspensionMutation) error {
+		if ContextHasPrivacyTokenOfType(ctx, &token.SystemCallToken{}) {
+			return privacy.Skip
+		}
+
+		u := internal.GetUserFromCtx(ctx)
+		if u == nil {
+			return privacy.Denyf("not authenticated")
+		}
+
+		sysCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+		var suspended predicate.User
+		if m.Op().Is(ent.OpCreate) {
+			userID, ok := m.UserID()
+			if !ok {
+				return privacy.Skip
+			}
+			suspended = user.ID(userID)
+		} else {
+			ids, err := m.IDs(sysCtx)
+			if err != nil {
+				return fmt.Errorf("could not get suspensions: %w", err)
+			}
+			suspended = user.HasSuspensionsWith(suspension.IDIn(ids...))
+		}
+
+		targets, err := m.Client().User.Query().
+			Where(suspended).
+			Select(user.FieldRole).
+			All(sysCtx)
+		if err != nil {
+			return fmt.Errorf("could not get suspended users: %w", err)
+		}
+		managesRoles := auth.HasPermission(ctx, u, rolepermission.PermissionRolesManage)
+		for _, t := range targets {
+			if t.ID == u.ID {
+				return privacy.Denyf("users cannot suspend themselves")
+			}
+			if managesRoles {
+				continue
+			}
+			if auth.HasPermission(ctx, t, rolepermission.PermissionUsersSuspend) || auth.HasPermission(ctx, t, rolepermission.PermissionRolesManage) {
+				return privacy.Denyf("cannot suspend users allowed to suspend or manage roles")
+			}
+		}
+
+		return privacy.Skip
+	})
+}
@@ -0,0 +1,38 @@
+package rule
+
+import (
+	"context"
+
+	"entgo.io/ent"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+)
+
+// DenyIfTwitchBanned denies mutations by users banned or timed out in the broadcaster Twitch channel.
+// User mutations are only denied when liking posts.
+func DenyIfTwitchBanned() privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, m ent.Mutation) error {
+		if ContextHasPrivacyTokenOfType(ctx, &token.SystemCallToken{}) {
+			return privacy.Skip
+		}
+
+		u := internal.GetUserFromCtx(ctx)
+		if u == nil {
+			return privacy.Skip
+		}
+
+		if um, ok := m.(*generated.UserMutation); ok && len(um.LikedPostsIDs()) == 0 {
+			return privacy.Skip
+		}
+
+		if auth.TwitchBansFromCtx(ctx).IsBanned(ctx, u) {
+			return privacy.Denyf("user is banned from the twitch channel")
+		}
+
+		return privacy.Skip
+	})
+}
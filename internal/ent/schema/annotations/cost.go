@@ -0,0 +1,33 @@
+package annotations
+
+import (
+	"strconv"
+
+	"entgo.io/contrib/entgql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// CostDirective weighs a field in query cost analysis.
+// The cost of its selections is multiplied by the largest of the given arguments, e.g. first and last.
+func CostDirective(weight int, multipliers ...string) entgql.Directive {
+	args := []*ast.Argument{
+		{
+			Name: "weight",
+			Value: &ast.Value{
+				Raw:  strconv.Itoa(weight),
+				Kind: ast.IntValue,
+			},
+		},
+	}
+	if len(multipliers) > 0 {
+		list := &ast.Value{Kind: ast.ListValue}
+		for _, m := range multipliers {
+			list.Children = append(list.Children, &ast.ChildValue{
+				Value: &ast.Value{Raw: m, Kind: ast.StringValue},
+			})
+		}
+		args = append(args, &ast.Argument{Name: "multipliers", Value: list})
+	}
+
+	return entgql.NewDirective("cost", args...)
+}
@@ -0,0 +1,17 @@
+package annotations
+
+import (
+	"entgo.io/contrib/entgql"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func HasPermissionDirective(permission rolepermission.Permission) entgql.Directive {
+	return entgql.NewDirective("hasPermission", &ast.Argument{
+		Name: "permission",
+		Value: &ast.Value{
+			Raw:  string(permission),
+			Kind: ast.EnumValue,
+		},
+	})
+}
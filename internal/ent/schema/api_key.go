@@ -5,7 +5,9 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/field"
-	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+
+	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
 	"github.com/caliecode/la-clipasa/internal/ent/interceptors"
 	"github.com/caliecode/la-clipasa/internal/ent/privacy/policy"
 	"github.com/caliecode/la-clipasa/internal/ent/privacy/rule"
@@ -21,10 +23,35 @@ type ApiKey struct {
 // Fields of the ApiKey.
 func (ApiKey) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("api_key").Unique().Annotations(
-			entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
-		),
+		field.String("name").
+			NotEmpty(),
+		// Prefix is the visible start of the key to identify it.
+		field.String("prefix").
+			NotEmpty().
+			Immutable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		// KeyHash is the hash of the key, which is only shown on creation.
+		field.String("key_hash").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Sensitive().
+			Annotations(
+				entgql.Skip(),
+			),
+		// Scopes default to read only access.
+		field.Strings("scopes").
+			Default([]string{auth.ScopePostsRead}).
+			Validate(auth.ValidateAPIKeyScopes),
 		field.Time("expires_on"),
+		field.Time("last_used_at").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
 	}
 }
 
@@ -62,11 +89,11 @@ func (ApiKey) Policy() ent.Policy {
 			// token sign up for update operations as well
 			ent.OpCreate|ent.OpUpdateOne,
 			rule.AllowIfContextHasPrivacyTokenOfType(&token.SystemCallToken{}),
-			rule.AllowIfSelfOrHasRole(user.RoleADMIN),
+			rule.AllowIfSelfOrHasPermission(rolepermission.PermissionUsersManage),
 		),
 		policy.WithOnMutationRules(
 			ent.OpUpdate|ent.OpDeleteOne|ent.OpDelete,
-			rule.AllowIfSelfOrHasRole(user.RoleADMIN),
+			rule.AllowIfSelfOrHasPermission(rolepermission.PermissionUsersManage),
 		),
 	)
 }
@@ -0,0 +1,122 @@
+package schema
+
+import (
+	"context"
+	"errors"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/theopenlane/entx"
+
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	genhook "github.com/caliecode/la-clipasa/internal/ent/generated/hook"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/policy"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/rule"
+	"github.com/caliecode/la-clipasa/internal/ent/schema/mixins"
+)
+
+// AwardDefinition holds the schema definition for the AwardDefinition entity.
+// Awards are granted to users by the awards evaluator when their rule is met.
+type AwardDefinition struct {
+	ent.Schema
+}
+
+// Fields of the AwardDefinition.
+func (AwardDefinition) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Unique().
+			Annotations(
+				entx.FieldSearchable(),
+			),
+		field.String("description").
+			Optional(),
+		field.String("icon").
+			Comment("emoji or image URL shown alongside the award"),
+		field.Enum("rule").
+			Comment("the rule evaluated to grant the award").
+			Values("POSTS_PUBLISHED", "POSTS_IN_CATEGORY", "LIKES_RECEIVED", "MEMBERSHIP_DAYS"),
+		field.Enum("rule_category").
+			Comment("the post category counted by POSTS_IN_CATEGORY rules").
+			Values(postCategories...).
+			Optional().
+			Nillable(),
+		field.Int("rule_threshold").
+			Comment("the minimum count (or days for MEMBERSHIP_DAYS) required to grant the award").
+			Positive().
+			Default(1),
+	}
+}
+
+// Edges of the AwardDefinition.
+func (AwardDefinition) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("grants", UserAward.Type).
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+				entsql.OnDelete(entsql.Cascade),
+			),
+	}
+}
+
+func (AwardDefinition) Annotations() []schema.Annotation {
+	return baseGqlAnnotations
+}
+
+func (AwardDefinition) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+		mixins.UUIDMixin{},
+	}
+}
+
+// Hooks of the AwardDefinition.
+func (AwardDefinition) Hooks() []ent.Hook {
+	return []ent.Hook{
+		genhook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return genhook.AwardDefinitionFunc(func(ctx context.Context, m *generated.AwardDefinitionMutation) (generated.Value, error) {
+					r, ok := m.Rule()
+					if !ok && m.Op().Is(ent.OpUpdateOne) {
+						r, _ = m.OldRule(ctx)
+					}
+					if r != awarddefinition.RulePOSTS_IN_CATEGORY {
+						return next.Mutate(ctx, m)
+					}
+
+					_, hasCategory := m.RuleCategory()
+					if !hasCategory && m.Op().Is(ent.OpUpdateOne) && !m.RuleCategoryCleared() {
+						old, err := m.OldRuleCategory(ctx)
+						hasCategory = err == nil && old != nil
+					}
+					if !hasCategory {
+						return nil, errors.New("rule category is required for POSTS_IN_CATEGORY awards")
+					}
+
+					return next.Mutate(ctx, m)
+				})
+			},
+			ent.OpCreate|ent.OpUpdateOne,
+		),
+	}
+}
+
+func (AwardDefinition) Policy() ent.Policy {
+	return policy.NewPolicy(
+		policy.WithQueryRules(
+			privacy.AlwaysAllowRule(),
+		),
+		policy.WithMutationRules(
+			rule.AllowIfSeedingData(),
+			rule.AllowIfHasPermission(rolepermission.PermissionAwardsManage),
+		),
+	)
+}
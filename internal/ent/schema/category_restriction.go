@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/hooks"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/policy"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/rule"
+	"github.com/caliecode/la-clipasa/internal/ent/schema/mixins"
+)
+
+// CategoryRestriction holds the schema definition for the CategoryRestriction entity.
+// It restricts a post category to subscribers of the broadcaster Twitch channel.
+type CategoryRestriction struct {
+	ent.Schema
+}
+
+// Fields of the CategoryRestriction.
+func (CategoryRestriction) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("category").
+			Values(postCategories...).
+			Immutable(),
+		// SubscriberOnlyPost restricts categorizing posts in the category to subscribers.
+		field.Bool("subscriber_only_post").
+			Default(false),
+		// SubscriberOnlyView hides posts in the category from non-subscribers.
+		field.Bool("subscriber_only_view").
+			Default(false),
+	}
+}
+
+// Edges of the CategoryRestriction.
+func (CategoryRestriction) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+// a category has at most one restriction
+func (CategoryRestriction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("category").Unique(),
+	}
+}
+
+func (CategoryRestriction) Annotations() []schema.Annotation {
+	return baseGqlAnnotations
+}
+
+func (CategoryRestriction) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+		mixins.UUIDMixin{},
+	}
+}
+
+// Hooks of the CategoryRestriction.
+func (CategoryRestriction) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.InvalidateCategoryRestrictions(),
+	}
+}
+
+func (CategoryRestriction) Policy() ent.Policy {
+	return policy.NewPolicy(
+		policy.WithQueryRules(
+			privacy.AlwaysAllowRule(),
+		),
+		policy.WithMutationRules(
+			rule.AllowIfHasPermission(rolepermission.PermissionCategoriesManage),
+		),
+	)
+}
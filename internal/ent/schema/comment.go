@@ -1,11 +1,16 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/comment"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/hooks"
 	"github.com/caliecode/la-clipasa/internal/ent/interceptors"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/rule"
 	"github.com/caliecode/la-clipasa/internal/ent/schema/mixins"
 )
 
@@ -28,7 +33,11 @@ func (Comment) Edges() []ent.Edge {
 		// Comment belongs to a post only
 		edge.From("post", Post.Type).
 			Ref("comments").
-			Unique(),
+			Unique().
+			Annotations(
+				// comments cannot be moved to other posts
+				entgql.Skip(entgql.SkipMutationUpdateInput),
+			),
 	}
 }
 
@@ -36,6 +45,13 @@ func (Comment) Annotations() []schema.Annotation {
 	return baseGqlAnnotations
 }
 
+// Hooks of the Comment.
+func (Comment) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.CommentBlocks(),
+	}
+}
+
 func (Comment) Indexes() []ent.Index {
 	return []ent.Index{}
 }
@@ -47,9 +63,24 @@ func (Comment) Mixin() []ent.Mixin {
 		mixins.SoftDeleteMixin{},
 		UserOwnedMixin{
 			Ref:             "comments",
-			AllowUpdate:     true,
 			SkipInterceptor: interceptors.SkipAll,
 			SoftDeleteIndex: true,
 		},
+		HideBlockedOwnersMixin{},
+		HideSubscriberOnlyPostsMixin{PostColumn: comment.PostColumn},
+	}
+}
+
+// Policy of the Comment. There are no access rules besides API key scopes.
+func (Comment) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.DenyIfAPIKeyScopeMissing(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfAPIKeyScopeMissing(),
+			rule.DenyIfSuspended(),
+			privacy.OnMutationOperation(rule.DenyIfTwitchBanned(), ent.OpCreate|ent.OpUpdateOne|ent.OpUpdate),
+		},
 	}
 }
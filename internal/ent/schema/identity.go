@@ -0,0 +1,88 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/theopenlane/entx"
+
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/interceptors"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/policy"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/rule"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+	"github.com/caliecode/la-clipasa/internal/ent/schema/mixins"
+)
+
+// Identity holds the schema definition for the Identity entity.
+// It represents an external login provider account linked to a user.
+type Identity struct {
+	ent.Schema
+}
+
+// Fields of the Identity.
+func (Identity) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("provider").
+			Values(authProviders...).
+			Immutable(),
+		field.String("subject").
+			Comment("the provider unique id of the user").
+			NotEmpty().
+			Immutable(),
+		field.String("email").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (Identity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "subject").
+			Unique(),
+	}
+}
+
+func (Identity) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+		entx.SchemaGenSkip(true),
+		entx.QueryGenSkip(true),
+	}
+}
+
+func (Identity) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+		mixins.UUIDMixin{},
+		UserOwnedMixin{
+			Ref:             "identities",
+			SkipInterceptor: interceptors.SkipAll,
+		},
+	}
+}
+
+func (Identity) Policy() ent.Policy {
+	return policy.NewPolicy(
+		policy.WithQueryRules(
+			rule.AllowIfContextHasPrivacyTokenOfType(&token.SystemCallToken{}),
+			rule.AllowIfContextHasPrivacyTokenOfType(&token.Oauth2Token{}),
+			rule.AllowIfSelfOrHasPermissionQuery(rolepermission.PermissionUsersManage),
+		),
+		policy.WithOnMutationRules(
+			ent.OpCreate,
+			rule.AllowIfSeedingData(),
+			rule.AllowIfContextHasPrivacyTokenOfType(&token.Oauth2Token{}),
+			rule.AllowIfContextHasPrivacyTokenOfType(&token.SystemCallToken{}),
+			rule.AllowIfSelfOrHasPermission(rolepermission.PermissionUsersManage),
+		),
+		policy.WithOnMutationRules(
+			ent.OpUpdate|ent.OpUpdateOne|ent.OpDeleteOne|ent.OpDelete,
+			rule.AllowIfSeedingData(),
+			rule.AllowIfContextHasPrivacyTokenOfType(&token.SystemCallToken{}),
+			rule.AllowIfSelfOrHasPermission(rolepermission.PermissionUsersManage),
+		),
+	)
+}
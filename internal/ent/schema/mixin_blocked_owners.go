@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"context"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/intercept"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+)
+
+// HideBlockedOwnersMixin hides entities owned by users the viewer has blocked or muted.
+// It requires the owner_id field from UserOwnedMixin.
+type HideBlockedOwnersMixin struct {
+	mixin.Schema
+}
+
+func (h HideBlockedOwnersMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			// system queries such as award evaluation must see everything
+			if _, allow := privacy.DecisionFromContext(ctx); allow {
+				return nil
+			}
+
+			u := internal.GetUserFromCtx(ctx)
+			if u == nil {
+				return nil
+			}
+
+			// only connections are filtered, direct lookups by id are unaffected
+			if ctxQuery := ent.QueryFromContext(ctx); ctxQuery != nil && ctxQuery.Op == "Only" {
+				return nil
+			}
+
+			h.P(q, u.ID)
+
+			return nil
+		}),
+	}
+}
+
+// P adds a storage-level predicate to the queries excluding blocked and muted owners.
+func (h HideBlockedOwnersMixin) P(w interface{ WhereP(...func(*sql.Selector)) }, userID uuid.UUID) {
+	w.WhereP(func(s *sql.Selector) {
+		blocked := sql.Select(user.BlockedUsersPrimaryKey[1]).
+			From(sql.Table(user.BlockedUsersTable)).
+			Where(sql.EQ(user.BlockedUsersPrimaryKey[0], userID))
+		muted := sql.Select(user.MutedUsersPrimaryKey[1]).
+			From(sql.Table(user.MutedUsersTable)).
+			Where(sql.EQ(user.MutedUsersPrimaryKey[0], userID))
+
+		s.Where(sql.And(
+			sql.NotIn(s.C(ownerFieldName), blocked),
+			sql.NotIn(s.C(ownerFieldName), muted),
+		))
+	})
+}
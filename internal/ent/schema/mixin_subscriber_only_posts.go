@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"context"
+	"errors"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/mixin"
+
+	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/intercept"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/postcategory"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/rule"
+)
+
+// HideSubscriberOnlyPostsMixin hides posts in subscriber-only categories from non-subscribers,
+// including direct lookups by id, as well as the entities that belong to them.
+// Users with the posts moderate permission see every post.
+type HideSubscriberOnlyPostsMixin struct {
+	mixin.Schema
+	// PostColumn is the column referencing the post, or the id for posts.
+	PostColumn string
+}
+
+func (h HideSubscriberOnlyPostsMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			// system queries such as award evaluation must see everything
+			if _, allow := privacy.DecisionFromContext(ctx); allow {
+				return nil
+			}
+
+			// background jobs without a request client are not user facing
+			entclt := generated.FromContext(ctx)
+			if entclt == nil {
+				return nil
+			}
+
+			restrictions, err := auth.CategoryRestrictions(ctx, entclt)
+			if err != nil {
+				return err
+			}
+			var categories []any
+			for category, cr := range restrictions {
+				if cr.SubscriberOnlyView {
+					categories = append(categories, category)
+				}
+			}
+			if len(categories) == 0 {
+				return nil
+			}
+
+			if errors.Is(rule.AllowIfTwitchSubscriber().EvalQuery(ctx, q), privacy.Allow) ||
+				errors.Is(rule.AllowIfHasPermission(rolepermission.PermissionPostsModerate).EvalQuery(ctx, q), privacy.Allow) {
+				return nil
+			}
+
+			h.P(q, categories)
+
+			return nil
+		}),
+	}
+}
+
+// P adds a storage-level predicate to the queries excluding posts in any of the given categories.
+func (h HideSubscriberOnlyPostsMixin) P(w interface{ WhereP(...func(*sql.Selector)) }, categories []any) {
+	column := h.PostColumn
+	if column == "" {
+		column = "id"
+	}
+
+	w.WhereP(func(s *sql.Selector) {
+		restricted := sql.Select(postcategory.PostColumn).
+			From(sql.Table(postcategory.Table)).
+			Where(sql.And(
+				sql.In(postcategory.FieldCategory, categories...),
+				sql.NotNull(postcategory.PostColumn),
+			))
+
+		s.Where(sql.Or(
+			sql.IsNull(s.C(column)),
+			sql.NotIn(s.C(column), restricted),
+		))
+	})
+}
@@ -17,7 +17,8 @@ import (
 
 	"github.com/caliecode/la-clipasa/internal/ent/generated"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
-	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/hooks"
 	"github.com/caliecode/la-clipasa/internal/ent/interceptors"
 	"github.com/caliecode/la-clipasa/internal/ent/privacy/policy"
 	"github.com/caliecode/la-clipasa/internal/ent/privacy/rule"
@@ -129,6 +130,7 @@ func (Post) Hooks() []ent.Hook {
 			},
 			ent.OpUpdate|ent.OpUpdateOne|ent.OpCreate,
 		),
+		hooks.PostAwards(),
 	}
 }
 
@@ -143,6 +145,8 @@ func (Post) Mixin() []ent.Mixin {
 			SkipInterceptor: interceptors.SkipAll,
 			SoftDeleteIndex: true,
 		},
+		HideBlockedOwnersMixin{},
+		HideSubscriberOnlyPostsMixin{},
 	}
 }
 
@@ -166,18 +170,27 @@ func (Post) Policy() ent.Policy {
 			// interceptors are setup to filter users outside of the organization
 			privacy.AlwaysAllowRule(),
 		),
+		policy.WithOnMutationRules(
+			ent.OpCreate,
+			rule.DenyIfTwitchBanned(),
+		),
 		policy.WithOnMutationRules(
 			// the user hook has update operations on user create so we need to allow email
 			// token sign up for update operations as well
 			ent.OpCreate|ent.OpUpdateOne,
 			rule.AllowIfSeedingData(),
 			rule.AllowIfContextHasPrivacyTokenOfType(&token.SystemCallToken{}), // for discord link update without authn
-			rule.AllowIfSelfOrHasRole(user.RoleMODERATOR),
+			rule.AllowIfSelfOrHasPermission(rolepermission.PermissionPostsModerate),
+		),
+		policy.WithOnMutationRules(
+			ent.OpUpdate,
+			rule.AllowIfSeedingData(),
+			rule.AllowIfSelfOrHasPermission(rolepermission.PermissionPostsModerate),
 		),
 		policy.WithOnMutationRules(
-			ent.OpUpdate|ent.OpDeleteOne|ent.OpDelete,
+			ent.OpDeleteOne|ent.OpDelete,
 			rule.AllowIfSeedingData(),
-			rule.AllowIfSelfOrHasRole(user.RoleMODERATOR),
+			rule.AllowIfSelfOrHasPermission(rolepermission.PermissionPostsDelete),
 		),
 	)
 }
@@ -6,9 +6,15 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/hooks"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/rule"
 	"github.com/caliecode/la-clipasa/internal/ent/schema/mixins"
 )
 
+// postCategories are the available post categories, also used by award rules.
+var postCategories = []string{"RANA", "SIN_SONIDO", "MEME_ARTESANAL", "NO_SE_YO", "ORO", "DIAMANTE", "MEH", "ALERTA_GLONETILLO", "GRR", "ENSORDECEDOR", "RAGUUUL"}
+
 // PostCategory holds the schema definition for the PostCategory entity.
 type PostCategory struct {
 	ent.Schema
@@ -17,7 +23,7 @@ type PostCategory struct {
 // Fields of the PostCategory.
 func (PostCategory) Fields() []ent.Field {
 	return []ent.Field{
-		field.Enum("category").Values("RANA", "SIN_SONIDO", "MEME_ARTESANAL", "NO_SE_YO", "ORO", "DIAMANTE", "MEH", "ALERTA_GLONETILLO", "GRR", "ENSORDECEDOR", "RAGUUUL"),
+		field.Enum("category").Values(postCategories...),
 	}
 }
 
@@ -41,6 +47,7 @@ func (PostCategory) Annotations() []schema.Annotation {
 func (PostCategory) Hooks() []ent.Hook {
 	return []ent.Hook{
 		// hooks.PostCategoryExclusiveCheck(),
+		hooks.PostCategoryAwards(),
 	}
 }
 
@@ -50,3 +57,18 @@ func (PostCategory) Mixin() []ent.Mixin {
 		mixins.UUIDMixin{},
 	}
 }
+
+// Policy of the PostCategory. There are no access rules besides API key scopes, suspensions
+// and subscriber-only categories.
+func (PostCategory) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.DenyIfAPIKeyScopeMissing(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfAPIKeyScopeMissing(),
+			rule.DenyIfSuspended(),
+			rule.DenyIfSubscriberOnlyCategory(),
+		},
+	}
+}
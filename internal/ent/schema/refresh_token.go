@@ -8,7 +8,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 
-	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
 	"github.com/caliecode/la-clipasa/internal/ent/interceptors"
 	"github.com/caliecode/la-clipasa/internal/ent/privacy/policy"
 	"github.com/caliecode/la-clipasa/internal/ent/privacy/rule"
@@ -39,6 +39,21 @@ func (RefreshToken) Fields() []ent.Field {
 			Optional(),
 		field.String("user_agent").
 			Optional(),
+		// FamilyID is shared by all tokens rotated from the same login.
+		field.UUID("family_id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Annotations(
+				entgql.Skip(),
+			),
+		// ParentID is the token this token was rotated from.
+		field.UUID("parent_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			Annotations(
+				entgql.Skip(),
+			),
 	}
 }
 
@@ -62,6 +77,8 @@ func (RefreshToken) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("token_hash"),
 		index.Fields("expires_at"),
+		index.Fields("family_id"),
+		index.Fields("parent_id"),
 		index.Edges("owner").Fields("revoked", "expires_at"),
 	}
 }
@@ -74,20 +91,20 @@ func (RefreshToken) Policy() ent.Policy {
 	return policy.NewPolicy(
 		policy.WithQueryRules(
 			rule.AllowIfContextHasPrivacyTokenOfType(&token.SystemCallToken{}),
-			rule.AllowIfSelfOrHasRoleQuery(user.RoleADMIN),
+			rule.AllowIfSelfOrHasPermissionQuery(rolepermission.PermissionUsersManage),
 		),
 		policy.WithOnMutationRules(
 			ent.OpCreate|ent.OpUpdateOne,
 			rule.AllowIfSeedingData(),
 			rule.AllowIfContextHasPrivacyTokenOfType(&token.Oauth2Token{}),
 			rule.AllowIfContextHasPrivacyTokenOfType(&token.SystemCallToken{}),
-			rule.AllowIfSelfOrHasRole(user.RoleADMIN),
+			rule.AllowIfSelfOrHasPermission(rolepermission.PermissionUsersManage),
 		),
 		policy.WithOnMutationRules(
 			ent.OpUpdate|ent.OpDeleteOne|ent.OpDelete,
 			rule.AllowIfSeedingData(),
 			rule.AllowIfContextHasPrivacyTokenOfType(&token.SystemCallToken{}),
-			rule.AllowIfSelfOrHasRole(user.RoleADMIN),
+			rule.AllowIfSelfOrHasPermission(rolepermission.PermissionUsersManage),
 		),
 	)
 }
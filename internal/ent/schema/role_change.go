@@ -0,0 +1,101 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/theopenlane/entx"
+
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/policy"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/rule"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+	"github.com/caliecode/la-clipasa/internal/ent/schema/mixins"
+)
+
+// RoleChange holds the schema definition for the RoleChange entity.
+// It records changes to a user role or Twitch VIP status.
+type RoleChange struct {
+	ent.Schema
+}
+
+// Fields of the RoleChange.
+func (RoleChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("user_id", uuid.UUID{}).
+			Immutable(),
+		field.Enum("attribute").
+			Values("ROLE", "TWITCH_VIP").
+			Immutable(),
+		field.String("old_value").
+			Immutable(),
+		field.String("new_value").
+			Immutable(),
+		field.Enum("source").
+			Values("MANUAL", "TWITCH_SYNC", "SYSTEM").
+			Immutable(),
+		// ChangedByID is the user that made a manual change.
+		field.UUID("changed_by_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable(),
+	}
+}
+
+// Edges of the RoleChange.
+func (RoleChange) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("role_changes").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (RoleChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+	}
+}
+
+// RoleChange is only created by the user role changes hook.
+func (RoleChange) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+		entx.SchemaGenSkip(true),
+		entx.QueryGenSkip(true),
+	}
+}
+
+func (RoleChange) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+		mixins.UUIDMixin{},
+	}
+}
+
+func (RoleChange) Policy() ent.Policy {
+	return policy.NewPolicy(
+		policy.WithQueryRules(
+			rule.AllowIfContextHasPrivacyTokenOfType(&token.SystemCallToken{}),
+			rule.AllowIfHasPermission(rolepermission.PermissionUsersManage),
+		),
+		policy.WithOnMutationRules(
+			ent.OpCreate|ent.OpUpdateOne|ent.OpUpdate,
+			rule.AllowIfContextHasPrivacyTokenOfType(&token.SystemCallToken{}),
+		),
+		// deleted along with the user
+		policy.WithOnMutationRules(
+			ent.OpDeleteOne|ent.OpDelete,
+			rule.AllowIfContextHasPrivacyTokenOfType(&token.SystemCallToken{}),
+			rule.AllowIfHasPermission(rolepermission.PermissionUsersManage),
+		),
+	)
+}
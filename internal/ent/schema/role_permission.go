@@ -0,0 +1,104 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/theopenlane/entx"
+
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/hooks"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/policy"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/rule"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+	"github.com/caliecode/la-clipasa/internal/ent/schema/mixins"
+)
+
+// RolePermission holds the schema definition for the RolePermission entity.
+// It grants a permission to all users with a role.
+type RolePermission struct {
+	ent.Schema
+}
+
+// Fields of the RolePermission.
+func (RolePermission) Fields() []ent.Field {
+	return []ent.Field{
+		// Role has the same values as the user role.
+		field.Enum("role").
+			Values(userRoles()...),
+		field.Enum("permission").
+			NamedValues(
+				"PostsCategorize", "POSTS_CATEGORIZE",
+				"PostsModerate", "POSTS_MODERATE",
+				"PostsDelete", "POSTS_DELETE",
+				"CategoriesManage", "CATEGORIES_MANAGE",
+				"UsersManage", "USERS_MANAGE",
+				"UsersSuspend", "USERS_SUSPEND",
+				"AwardsManage", "AWARDS_MANAGE",
+				"RolesManage", "ROLES_MANAGE",
+			).
+			Annotations(
+				entgql.Type("Permission"),
+			),
+	}
+}
+
+func userRoles() []string {
+	roles := make([]string, 0, len(user.AllRoles()))
+	for _, r := range user.AllRoles() {
+		roles = append(roles, r.String())
+	}
+
+	return roles
+}
+
+// Edges of the RolePermission.
+func (RolePermission) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+func (RolePermission) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("role", "permission").Unique(),
+	}
+}
+
+// RolePermission is managed via setRolePermissions.
+func (RolePermission) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entx.SchemaGenSkip(true),
+		entx.QueryGenSkip(true),
+	}
+}
+
+// Hooks of the RolePermission.
+func (RolePermission) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.InvalidateRolePermissions(),
+	}
+}
+
+func (RolePermission) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+		mixins.UUIDMixin{},
+	}
+}
+
+func (RolePermission) Policy() ent.Policy {
+	return policy.NewPolicy(
+		policy.WithQueryRules(
+			// permissions are checked for every request
+			privacy.AlwaysAllowRule(),
+		),
+		policy.WithMutationRules(
+			rule.AllowIfSeedingData(),
+			rule.AllowIfContextHasPrivacyTokenOfType(&token.SystemCallToken{}),
+			rule.AllowIfHasPermission(rolepermission.PermissionRolesManage),
+		),
+	)
+}
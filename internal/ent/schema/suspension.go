@@ -0,0 +1,118 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/hooks"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/policy"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/rule"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+	"github.com/caliecode/la-clipasa/internal/ent/schema/mixins"
+)
+
+// Suspension holds the schema definition for the Suspension entity.
+// It restricts a user on the site, independently of Twitch channel bans.
+type Suspension struct {
+	ent.Schema
+}
+
+// Fields of the Suspension.
+func (Suspension) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("user_id", uuid.UUID{}).
+			Immutable(),
+		// IssuerID is the moderator that issued the suspension, set on creation.
+		field.UUID("issuer_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.String("reason").
+			NotEmpty(),
+		// Scope restricts either posting, commenting or any mutation.
+		field.Enum("scope").
+			Values("POST", "COMMENT", "FULL").
+			Default("FULL"),
+		field.Time("starts_at").
+			Default(time.Now).
+			Annotations(
+				entgql.OrderField("STARTS_AT"),
+			),
+		// EndsAt is the time the suspension lifts at. Suspensions without an end are permanent.
+		field.Time("ends_at").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.OrderField("ENDS_AT"),
+			),
+	}
+}
+
+// Edges of the Suspension.
+func (Suspension) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("suspensions").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.To("issuer", User.Type).
+			Field("issuer_id").
+			Unique().
+			Immutable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+	}
+}
+
+// active suspensions are looked up on every authenticated request
+func (Suspension) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "ends_at"),
+	}
+}
+
+func (Suspension) Annotations() []schema.Annotation {
+	return baseGqlAnnotations
+}
+
+func (Suspension) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+		mixins.UUIDMixin{},
+	}
+}
+
+// Hooks of the Suspension.
+func (Suspension) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.SuspensionIssuer(),
+	}
+}
+
+func (Suspension) Policy() ent.Policy {
+	return policy.NewPolicy(
+		policy.WithQueryRules(
+			rule.AllowIfContextHasPrivacyTokenOfType(&token.SystemCallToken{}),
+			rule.AllowIfSelfOrHasPermissionQuery(rolepermission.PermissionUsersSuspend),
+		),
+		policy.WithMutationRules(
+			rule.AllowIfContextHasPrivacyTokenOfType(&token.SystemCallToken{}),
+			rule.DenyIfSuspensionTargetNotOutranked(),
+			rule.AllowIfHasPermission(rolepermission.PermissionUsersSuspend),
+		),
+	)
+}
@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/theopenlane/entx"
+
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/policy"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/rule"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+	"github.com/caliecode/la-clipasa/internal/ent/schema/mixins"
+)
+
+// TwitchToken holds the schema definition for the TwitchToken entity.
+// It stores Twitch OAuth2 tokens used by the server, encrypted at rest:
+// the broadcaster tokens and the tokens of each user session.
+type TwitchToken struct {
+	ent.Schema
+}
+
+// Fields of the TwitchToken.
+func (TwitchToken) Fields() []ent.Field {
+	return []ent.Field{
+		// TwitchUserID is the Twitch user the tokens were issued for.
+		field.String("twitch_user_id").
+			NotEmpty().
+			Immutable(),
+		// SessionID is the refresh token family of the session the tokens were issued for,
+		// unset for the broadcaster tokens.
+		field.UUID("session_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Unique().
+			Immutable(),
+		field.Bytes("access_token").
+			Sensitive(),
+		field.Bytes("refresh_token").
+			Sensitive(),
+		field.Time("expires_at"),
+		field.Strings("scopes").
+			Optional(),
+	}
+}
+
+// Edges of the TwitchToken.
+func (TwitchToken) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+// Indexes of the TwitchToken.
+func (TwitchToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("twitch_user_id").
+			Unique().
+			Annotations(entsql.IndexWhere("(session_id IS NULL)")),
+	}
+}
+
+// TwitchToken is only used by the server.
+func (TwitchToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+		entx.SchemaGenSkip(true),
+		entx.QueryGenSkip(true),
+	}
+}
+
+func (TwitchToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+		mixins.UUIDMixin{},
+	}
+}
+
+func (TwitchToken) Policy() ent.Policy {
+	return policy.NewPolicy(
+		policy.WithQueryRules(
+			rule.AllowIfContextHasPrivacyTokenOfType(&token.SystemCallToken{}),
+		),
+		policy.WithMutationRules(
+			rule.AllowIfContextHasPrivacyTokenOfType(&token.SystemCallToken{}),
+		),
+	)
+}
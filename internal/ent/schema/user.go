@@ -12,7 +12,8 @@ import (
 	"github.com/caliecode/la-clipasa/internal/ent/generated"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/hook"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
-	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/hooks"
 	"github.com/caliecode/la-clipasa/internal/ent/privacy/policy"
 	"github.com/caliecode/la-clipasa/internal/ent/privacy/rule"
 	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
@@ -20,6 +21,9 @@ import (
 	"github.com/theopenlane/entx"
 )
 
+// authProviders are the supported external login providers.
+var authProviders = []string{"TWITCH", "DISCORD", "GOOGLE"}
+
 // User holds the schema definition for the User entity.
 type User struct {
 	ent.Schema
@@ -37,19 +41,37 @@ func (User) Fields() []ent.Field {
 			Optional(),
 		field.String("profile_image").
 			Nillable().
-			Optional(),
+			Optional().
+			Annotations(
+				entx.FieldSearchable(),
+			),
 		field.String("external_id").
-			Comment("the auth_provider unique id of the user").
+			Comment("the unique id of the user, derived from the identity the user registered with").
 			Annotations(
 				entgql.Skip(entgql.SkipAll),
 			).
 			Unique(),
 		field.Enum("auth_provider").
-			Values("TWITCH").Default("TWITCH"),
+			Comment("the provider the user registered with").
+			Values(authProviders...).Default("TWITCH"),
 		field.Enum("role").
 			Comment("the role of the user").
 			Values("GUEST", "USER", "ADMIN", "MODERATOR").
 			Default("GUEST"),
+		field.Enum("role_source").
+			Comment("how the role was assigned, unset for the default role. Manually assigned roles are not synced from Twitch").
+			Values("MANUAL", "TWITCH").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.Bool("twitch_vip").
+			Comment("whether the user is a VIP in the broadcaster Twitch channel").
+			Default(false).
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
 		field.Time("last_seen_at").
 			Comment("the time the user was last seen").
 			UpdateDefault(time.Now).
@@ -59,11 +81,6 @@ func (User) Fields() []ent.Field {
 			Comment("cursor for last post seen").
 			Optional().
 			Nillable(),
-		field.JSON("awards", []string{}).
-			Annotations(
-				entx.FieldSearchable(),
-			).
-			Optional(),
 	}
 }
 
@@ -80,11 +97,53 @@ func (User) Edges() []ent.Edge {
 			Annotations(
 				entx.CascadeAnnotationField("Owner"), // for edge_cleanup gen
 			),
+		edge.To("awards", UserAward.Type).
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+				entx.CascadeAnnotationField("Owner"), // for edge_cleanup gen
+			),
+		// blocked users cannot interact with the blocker and their content is hidden from the blocker
+		edge.To("blocked_users", User.Type).
+			StorageKey(edge.Table("user_blocked_users"), edge.Columns("user_id", "blocked_user_id")).
+			Annotations(
+				entgql.Skip(),
+			),
+		edge.From("blocked_by", User.Type).
+			Ref("blocked_users").
+			Annotations(
+				entgql.Skip(),
+			),
+		// muted users content is hidden from the muter
+		edge.To("muted_users", User.Type).
+			StorageKey(edge.Table("user_muted_users"), edge.Columns("user_id", "muted_user_id")).
+			Annotations(
+				entgql.Skip(),
+			),
+		edge.From("muted_by", User.Type).
+			Ref("muted_users").
+			Annotations(
+				entgql.Skip(),
+			),
+		edge.To("identities", Identity.Type).
+			Annotations(
+				entgql.Skip(),
+				entx.CascadeAnnotationField("Owner"), // for edge_cleanup gen
+			),
 		edge.To("refresh_tokens", RefreshToken.Type).
 			Annotations(
 				entgql.Skip(),
 				entx.CascadeAnnotationField("Owner"), // for edge_cleanup gen
 			),
+		edge.To("role_changes", RoleChange.Type).
+			Annotations(
+				entgql.Skip(),
+				entx.CascadeAnnotationField("User"), // for edge_cleanup gen
+			),
+		// suspensions are kept as moderation history when users are deleted
+		edge.To("suspensions", Suspension.Type).
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
 	}
 }
 
@@ -113,6 +172,8 @@ func (d User) Interceptors() []ent.Interceptor {
 func (User) Hooks() []ent.Hook {
 	return []ent.Hook{
 		DummyHook(),
+		hooks.LikedPostAwards(),
+		hooks.RecordRoleChanges(),
 	}
 }
 
@@ -123,18 +184,22 @@ func (User) Policy() ent.Policy {
 			// interceptors are setup to filter users outside of the organization
 			privacy.AlwaysAllowRule(),
 		),
+		policy.WithMutationRules(
+			// liking posts
+			rule.DenyIfTwitchBanned(),
+		),
 		policy.WithOnMutationRules(
 			// the user hook has update operations on user create so we need to allow email
 			// token sign up for update operations as well
 			ent.OpCreate|ent.OpUpdateOne,
 			rule.AllowIfSeedingData(),
 			rule.AllowIfContextHasPrivacyTokenOfType(&token.Oauth2Token{}),
-			rule.AllowIfSelfOrHasRole(user.RoleADMIN),
+			rule.AllowIfSelfOrHasPermission(rolepermission.PermissionUsersManage),
 		),
 		policy.WithOnMutationRules(
 			ent.OpUpdate|ent.OpDeleteOne|ent.OpDelete,
 			rule.AllowIfSeedingData(),
-			rule.AllowIfSelfOrHasRole(user.RoleADMIN),
+			rule.AllowIfSelfOrHasPermission(rolepermission.PermissionUsersManage),
 		),
 	)
 }
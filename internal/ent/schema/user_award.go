@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/theopenlane/entx"
+
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/interceptors"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/policy"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/rule"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+	"github.com/caliecode/la-clipasa/internal/ent/schema/mixins"
+)
+
+// UserAward holds the schema definition for the UserAward entity.
+// It represents an award granted to a user.
+type UserAward struct {
+	ent.Schema
+}
+
+// Fields of the UserAward.
+func (UserAward) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("award_id", uuid.UUID{}).
+			Immutable().
+			Annotations(
+				entgql.Skip(),
+			),
+		field.Time("granted_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(
+				entgql.OrderField("GRANTED_AT"),
+			),
+	}
+}
+
+// Edges of the UserAward.
+func (UserAward) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("award", AwardDefinition.Type).
+			Ref("grants").
+			Field("award_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// an award is granted at most once per user
+func (UserAward) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id", "award_id").Unique(),
+	}
+}
+
+// UserAward is only granted by the awards evaluator.
+func (UserAward) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+		entx.SchemaGenSkip(true),
+		entx.QueryGenSkip(true),
+	}
+}
+
+func (UserAward) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+		mixins.UUIDMixin{},
+		UserOwnedMixin{
+			Ref:             "awards",
+			SkipInterceptor: interceptors.SkipAll,
+		},
+	}
+}
+
+func (UserAward) Policy() ent.Policy {
+	return policy.NewPolicy(
+		policy.WithQueryRules(
+			privacy.AlwaysAllowRule(),
+		),
+		policy.WithMutationRules(
+			rule.AllowIfSeedingData(),
+			rule.AllowIfContextHasPrivacyTokenOfType(&token.SystemCallToken{}),
+			rule.AllowIfHasPermission(rolepermission.PermissionAwardsManage),
+		),
+	)
+}
@@ -12,13 +12,14 @@ import (
 
 // CreateAPIKey is the resolver for the createApiKey field.
 func (r *mutationResolver) CreateAPIKey(ctx context.Context, input generated.CreateApiKeyInput) (*model.APIKeyCreatePayload, error) {
-	ak, err := r.ent.ApiKey.Create().SetAPIKey("api-key-" + uuid.New().String()).SetInput(input).Save(ctx)
+	ak, key, err := r.authn.CreateAPIKeyForUser(ctx, r.ent.ApiKey.Create().SetInput(input))
 	if err != nil {
 		return nil, parseRequestError(err, action{action: ActionCreate, object: "api key"})
 	}
 
 	return &model.APIKeyCreatePayload{
 		APIKey: ak,
+		Key:    key,
 	}, nil
 }
 
@@ -34,12 +35,25 @@ func (r *mutationResolver) CreateBulkCSVAPIKey(ctx context.Context, input graphq
 
 // UpdateAPIKey is the resolver for the updateApiKey field.
 func (r *mutationResolver) UpdateAPIKey(ctx context.Context, id uuid.UUID, input generated.UpdateApiKeyInput) (*model.APIKeyUpdatePayload, error) {
-	panic(errors.New("not implemented: UpdateAPIKey - updateApiKey"))
+	ak, err := r.ent.ApiKey.UpdateOneID(id).SetInput(input).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "api key"})
+	}
+
+	return &model.APIKeyUpdatePayload{
+		APIKey: ak,
+	}, nil
 }
 
 // DeleteAPIKey is the resolver for the deleteApiKey field.
 func (r *mutationResolver) DeleteAPIKey(ctx context.Context, id uuid.UUID) (*model.APIKeyDeletePayload, error) {
-	panic(errors.New("not implemented: DeleteAPIKey - deleteApiKey"))
+	if err := r.ent.ApiKey.DeleteOneID(id).Exec(ctx); err != nil {
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "api key"})
+	}
+
+	return &model.APIKeyDeletePayload{
+		DeletedID: id,
+	}, nil
 }
 
 // APIKey is the resolver for the apiKey field.
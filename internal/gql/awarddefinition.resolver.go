@@ -0,0 +1,66 @@
+package gql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/gql/model"
+	"github.com/google/uuid"
+)
+
+// CreateAwardDefinition is the resolver for the createAwardDefinition field.
+func (r *mutationResolver) CreateAwardDefinition(ctx context.Context, input generated.CreateAwardDefinitionInput) (*model.AwardDefinitionCreatePayload, error) {
+	ad, err := r.ent.AwardDefinition.Create().SetInput(input).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "award definition"})
+	}
+
+	return &model.AwardDefinitionCreatePayload{
+		AwardDefinition: ad,
+	}, nil
+}
+
+// CreateBulkAwardDefinition is the resolver for the createBulkAwardDefinition field.
+func (r *mutationResolver) CreateBulkAwardDefinition(ctx context.Context, input []*generated.CreateAwardDefinitionInput) (*model.AwardDefinitionBulkCreatePayload, error) {
+	panic(errors.New("not implemented: CreateBulkAwardDefinition - createBulkAwardDefinition"))
+}
+
+// CreateBulkCSVAwardDefinition is the resolver for the createBulkCSVAwardDefinition field.
+func (r *mutationResolver) CreateBulkCSVAwardDefinition(ctx context.Context, input graphql.Upload) (*model.AwardDefinitionBulkCreatePayload, error) {
+	panic(errors.New("not implemented: CreateBulkCSVAwardDefinition - createBulkCSVAwardDefinition"))
+}
+
+// UpdateAwardDefinition is the resolver for the updateAwardDefinition field.
+func (r *mutationResolver) UpdateAwardDefinition(ctx context.Context, id uuid.UUID, input generated.UpdateAwardDefinitionInput) (*model.AwardDefinitionUpdatePayload, error) {
+	ad, err := r.ent.AwardDefinition.UpdateOneID(id).SetInput(input).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "award definition"})
+	}
+
+	return &model.AwardDefinitionUpdatePayload{
+		AwardDefinition: ad,
+	}, nil
+}
+
+// DeleteAwardDefinition is the resolver for the deleteAwardDefinition field.
+func (r *mutationResolver) DeleteAwardDefinition(ctx context.Context, id uuid.UUID) (*model.AwardDefinitionDeletePayload, error) {
+	if err := r.ent.AwardDefinition.DeleteOneID(id).Exec(ctx); err != nil {
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "award definition"})
+	}
+
+	return &model.AwardDefinitionDeletePayload{
+		DeletedID: id,
+	}, nil
+}
+
+// AwardDefinition is the resolver for the awardDefinition field.
+func (r *queryResolver) AwardDefinition(ctx context.Context, id uuid.UUID) (*generated.AwardDefinition, error) {
+	ad, err := r.ent.AwardDefinition.Get(ctx, id)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "award definition"})
+	}
+
+	return ad, nil
+}
@@ -0,0 +1,66 @@
+package gql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/gql/model"
+	"github.com/google/uuid"
+)
+
+// CreateCategoryRestriction is the resolver for the createCategoryRestriction field.
+func (r *mutationResolver) CreateCategoryRestriction(ctx context.Context, input generated.CreateCategoryRestrictionInput) (*model.CategoryRestrictionCreatePayload, error) {
+	cr, err := r.ent.CategoryRestriction.Create().SetInput(input).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "category restriction"})
+	}
+
+	return &model.CategoryRestrictionCreatePayload{
+		CategoryRestriction: cr,
+	}, nil
+}
+
+// CreateBulkCategoryRestriction is the resolver for the createBulkCategoryRestriction field.
+func (r *mutationResolver) CreateBulkCategoryRestriction(ctx context.Context, input []*generated.CreateCategoryRestrictionInput) (*model.CategoryRestrictionBulkCreatePayload, error) {
+	panic(errors.New("not implemented: CreateBulkCategoryRestriction - createBulkCategoryRestriction"))
+}
+
+// CreateBulkCSVCategoryRestriction is the resolver for the createBulkCSVCategoryRestriction field.
+func (r *mutationResolver) CreateBulkCSVCategoryRestriction(ctx context.Context, input graphql.Upload) (*model.CategoryRestrictionBulkCreatePayload, error) {
+	panic(errors.New("not implemented: CreateBulkCSVCategoryRestriction - createBulkCSVCategoryRestriction"))
+}
+
+// UpdateCategoryRestriction is the resolver for the updateCategoryRestriction field.
+func (r *mutationResolver) UpdateCategoryRestriction(ctx context.Context, id uuid.UUID, input generated.UpdateCategoryRestrictionInput) (*model.CategoryRestrictionUpdatePayload, error) {
+	cr, err := r.ent.CategoryRestriction.UpdateOneID(id).SetInput(input).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "category restriction"})
+	}
+
+	return &model.CategoryRestrictionUpdatePayload{
+		CategoryRestriction: cr,
+	}, nil
+}
+
+// DeleteCategoryRestriction is the resolver for the deleteCategoryRestriction field.
+func (r *mutationResolver) DeleteCategoryRestriction(ctx context.Context, id uuid.UUID) (*model.CategoryRestrictionDeletePayload, error) {
+	if err := r.ent.CategoryRestriction.DeleteOneID(id).Exec(ctx); err != nil {
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "category restriction"})
+	}
+
+	return &model.CategoryRestrictionDeletePayload{
+		DeletedID: id,
+	}, nil
+}
+
+// CategoryRestriction is the resolver for the categoryRestriction field.
+func (r *queryResolver) CategoryRestriction(ctx context.Context, id uuid.UUID) (*generated.CategoryRestriction, error) {
+	cr, err := r.ent.CategoryRestriction.Get(ctx, id)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "category restriction"})
+	}
+
+	return cr, nil
+}
@@ -12,7 +12,14 @@ import (
 
 // CreateComment is the resolver for the createComment field.
 func (r *mutationResolver) CreateComment(ctx context.Context, input generated.CreateCommentInput) (*model.CommentCreatePayload, error) {
-	panic(errors.New("not implemented: CreateComment - createComment"))
+	c, err := r.ent.Comment.Create().SetInput(input).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "comment"})
+	}
+
+	return &model.CommentCreatePayload{
+		Comment: c,
+	}, nil
 }
 
 // CreateBulkComment is the resolver for the createBulkComment field.
@@ -27,15 +34,33 @@ func (r *mutationResolver) CreateBulkCSVComment(ctx context.Context, input graph
 
 // UpdateComment is the resolver for the updateComment field.
 func (r *mutationResolver) UpdateComment(ctx context.Context, id uuid.UUID, input generated.UpdateCommentInput) (*model.CommentUpdatePayload, error) {
-	panic(errors.New("not implemented: UpdateComment - updateComment"))
+	c, err := r.ent.Comment.UpdateOneID(id).SetInput(input).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "comment"})
+	}
+
+	return &model.CommentUpdatePayload{
+		Comment: c,
+	}, nil
 }
 
 // DeleteComment is the resolver for the deleteComment field.
 func (r *mutationResolver) DeleteComment(ctx context.Context, id uuid.UUID) (*model.CommentDeletePayload, error) {
-	panic(errors.New("not implemented: DeleteComment - deleteComment"))
+	if err := r.ent.Comment.DeleteOneID(id).Exec(ctx); err != nil {
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "comment"})
+	}
+
+	return &model.CommentDeletePayload{
+		DeletedID: id,
+	}, nil
 }
 
 // Comment is the resolver for the comment field.
 func (r *queryResolver) Comment(ctx context.Context, id uuid.UUID) (*generated.Comment, error) {
-	panic(errors.New("not implemented: Comment - comment"))
+	c, err := r.ent.Comment.Get(ctx, id)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "comment"})
+	}
+
+	return c, nil
 }
@@ -0,0 +1,289 @@
+package gql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/gin-gonic/gin"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/utils/ratelimit"
+)
+
+const (
+	costExtension = "cost"
+	// defaultListSize is the assumed number of items of fields with multipliers when none are given,
+	// e.g. connections without first or last.
+	defaultListSize = 100
+	// defaultUnpaginatedListSize is the assumed number of items of lists without pagination,
+	// e.g. ent edges returned in full. Smaller than defaultListSize, since nested lists multiply.
+	defaultUnpaginatedListSize = 10
+)
+
+// costWalker computes the cost of operations. Fields cost 1 plus the cost of their selections by default,
+// and fields with the @cost directive cost their weight instead, with selections multiplied by the largest
+// multiplier argument: lists are multiplied as a whole, connections only in their list fields, e.g. edges,
+// so that counts and page info cost the same for any page size. Other lists without the directive
+// are multiplied by defaultUnpaginatedListSize.
+type costWalker struct {
+	vars map[string]any
+}
+
+func (w costWalker) selectionSetCost(set ast.SelectionSet, listSize int) int {
+	var cost int
+	for _, sel := range set {
+		switch s := sel.(type) {
+		case *ast.Field:
+			c := w.fieldCost(s)
+			if s.Definition.Type.Elem != nil {
+				c = saturatingMul(listSize, c)
+			}
+			cost = saturatingAdd(cost, c)
+		case *ast.FragmentSpread:
+			cost = saturatingAdd(cost, w.selectionSetCost(s.Definition.SelectionSet, listSize))
+		case *ast.InlineFragment:
+			cost = saturatingAdd(cost, w.selectionSetCost(s.SelectionSet, listSize))
+		}
+	}
+
+	return cost
+}
+
+func (w costWalker) fieldCost(f *ast.Field) int {
+	// introspection
+	if strings.HasPrefix(f.Name, "__") {
+		return 0
+	}
+
+	weight, size := 1, 1
+	if d := f.Definition.Directives.ForName("cost"); d != nil {
+		weight, size = w.directive(d, f.ArgumentMap(w.vars))
+	} else if f.Definition.Type.Elem != nil && !isConnection(f.ObjectDefinition) {
+		size = defaultUnpaginatedListSize
+	}
+	if f.Definition.Type.Elem != nil {
+		return saturatingAdd(weight, saturatingMul(size, w.selectionSetCost(f.SelectionSet, 1)))
+	}
+
+	return saturatingAdd(weight, w.selectionSetCost(f.SelectionSet, size))
+}
+
+// isConnection reports whether a type is a connection, whose list fields are multiplied by the connection field.
+func isConnection(def *ast.Definition) bool {
+	return def != nil && strings.HasSuffix(def.Name, "Connection")
+}
+
+// directive returns the weight of a field and its number of items.
+func (w costWalker) directive(d *ast.Directive, args map[string]any) (int, int) {
+	dargs := d.ArgumentMap(nil)
+	weight, ok := intValue(dargs["weight"])
+	if !ok {
+		weight = 1
+	}
+
+	names, _ := dargs["multipliers"].([]any)
+	if len(names) == 0 {
+		return weight, 1
+	}
+	size := -1
+	for _, name := range names {
+		name, _ := name.(string)
+		if n, ok := intValue(args[name]); ok {
+			size = max(size, n, 0)
+		}
+	}
+	if size < 0 {
+		size = defaultListSize
+	}
+
+	return weight, size
+}
+
+// intValue returns integer arguments, either literals or variables.
+func intValue(v any) (int, bool) {
+	switch v := v.(type) {
+	case int:
+		return v, true
+	case int32:
+		return int(v), true
+	case int64:
+		return int(min(v, math.MaxInt32)), true
+	case float64:
+		return int(min(v, math.MaxInt32)), true
+	case json.Number:
+		n, err := v.Int64()
+		return int(min(n, math.MaxInt32)), err == nil
+	default:
+		return 0, false
+	}
+}
+
+func saturatingAdd(a, b int) int {
+	if a > math.MaxInt-b {
+		return math.MaxInt
+	}
+
+	return a + b
+}
+
+func saturatingMul(a, b int) int {
+	if a != 0 && b > math.MaxInt/a {
+		return math.MaxInt
+	}
+
+	return a * b
+}
+
+// CostBudgets are the query cost budgets of clients, refilled over Window.
+type CostBudgets struct {
+	Window time.Duration
+	// Anonymous is the budget of unauthenticated clients by IP address.
+	Anonymous int
+	User      int
+	APIKey    int
+	// Roles raise the budgets of users having at least a role, and of their API keys.
+	Roles map[user.Role]int
+}
+
+// CostStats is the cost of an operation and the remaining budget of the client,
+// reported in the cost response extension. When limited, ResetAt is when the operation can be retried.
+type CostStats struct {
+	Requested int       `json:"requested"`
+	Limit     int       `json:"limit"`
+	Remaining int       `json:"remaining"`
+	ResetAt   time.Time `json:"resetAt"`
+}
+
+// CostLimitError is returned when the cost of an operation exceeds the remaining budget.
+type CostLimitError struct {
+	Stats CostStats
+}
+
+// Error returns the CostLimitError in string format.
+func (e *CostLimitError) Error() string {
+	if e.Stats.Requested > e.Stats.Limit {
+		return fmt.Sprintf("operation cost %d exceeds the budget of %d", e.Stats.Requested, e.Stats.Limit)
+	}
+
+	return fmt.Sprintf("operation cost %d exceeds the remaining budget of %d, retry after %s",
+		e.Stats.Requested, e.Stats.Remaining, e.Stats.ResetAt.Format(time.RFC3339))
+}
+
+// CostLimit rejects operations exceeding the remaining cost budget of the client.
+type CostLimit struct {
+	limiter ratelimit.Limiter
+	budgets CostBudgets
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+	graphql.ResponseInterceptor
+} = &CostLimit{}
+
+// NewCostLimit returns an extension limiting operation costs per client with the given budgets.
+func NewCostLimit(limiter ratelimit.Limiter, budgets CostBudgets) *CostLimit {
+	return &CostLimit{limiter: limiter, budgets: budgets}
+}
+
+func (c *CostLimit) ExtensionName() string {
+	return "CostLimit"
+}
+
+func (c *CostLimit) Validate(graphql.ExecutableSchema) error {
+	if c.limiter == nil || c.budgets.Window <= 0 {
+		return errors.New("cost limit requires a limiter and a budget window")
+	}
+
+	return nil
+}
+
+func (c *CostLimit) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	cost := costWalker{vars: opCtx.Variables}.selectionSetCost(opCtx.Operation.SelectionSet, 1)
+	key, budget := c.client(ctx)
+
+	limit := ratelimit.Limit{Rate: float64(budget) / c.budgets.Window.Seconds(), Burst: budget}
+	res, err := c.limiter.AllowN(ctx, "cost:"+key, limit, cost)
+	if err != nil {
+		// budgets are best effort, but operations are never allowed more than a full budget
+		if logger := internal.GetLoggerFromCtx(ctx); logger != nil {
+			logger.Warnf("could not check query cost budget: %v", err)
+		}
+		res = ratelimit.Result{Allowed: cost <= budget, Limit: budget, Remaining: budget}
+	}
+
+	stats := &CostStats{
+		Requested: cost,
+		Limit:     budget,
+		Remaining: res.Remaining,
+		ResetAt:   secondsFromNow(res.Reset),
+	}
+	if !res.Allowed {
+		if cost <= budget {
+			stats.ResetAt = secondsFromNow(res.RetryAfter)
+		}
+		return gqlerror.WrapPath(nil, &CostLimitError{Stats: *stats})
+	}
+	opCtx.Stats.SetExtension(costExtension, stats)
+
+	return nil
+}
+
+func (c *CostLimit) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	resp := next(ctx)
+	if resp == nil || !graphql.HasOperationContext(ctx) {
+		return resp
+	}
+
+	if stats, ok := graphql.GetOperationContext(ctx).Stats.GetExtension(costExtension).(*CostStats); ok {
+		if resp.Extensions == nil {
+			resp.Extensions = make(map[string]any)
+		}
+		resp.Extensions[costExtension] = stats
+	}
+
+	return resp
+}
+
+// secondsFromNow returns the time after d, rounded up to seconds.
+func secondsFromNow(d time.Duration) time.Time {
+	return time.Now().Add(d + time.Second - 1).Truncate(time.Second)
+}
+
+// client returns the budget key and budget of the client.
+func (c *CostLimit) client(ctx context.Context) (string, int) {
+	u := internal.GetUserFromCtx(ctx)
+
+	var key string
+	var budget int
+	switch ak := auth.APIKeyFromCtx(ctx); {
+	case ak != nil:
+		key, budget = "apikey:"+ak.ID.String(), c.budgets.APIKey
+	case u != nil:
+		key, budget = "user:"+u.ID.String(), c.budgets.User
+	default:
+		ip := "unknown"
+		if ginCtx, ok := ctx.Value("GinContextKey").(*gin.Context); ok {
+			ip = ginCtx.ClientIP()
+		}
+		return "ip:" + ip, c.budgets.Anonymous
+	}
+
+	for role, b := range c.budgets.Roles {
+		if auth.IsAuthorized(u, role) {
+			budget = max(budget, b)
+		}
+	}
+
+	return key, budget
+}
@@ -0,0 +1,154 @@
+package gql
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const costTestSchema = `
+directive @cost(weight: Int! = 1, multipliers: [String!]) on FIELD_DEFINITION
+
+type Query {
+  me: User
+  posts(first: Int, last: Int): PostConnection! @cost(weight: 1, multipliers: ["first", "last"])
+  tags(first: Int): [Tag!]! @cost(weight: 2, multipliers: ["first"])
+  categories: [Tag!]!
+}
+
+type PageInfo {
+  hasNextPage: Boolean!
+}
+
+type PostConnection {
+  edges: [PostEdge]
+  pageInfo: PageInfo!
+  totalCount: Int!
+}
+
+type PostEdge {
+  node: Post
+  cursor: String!
+}
+
+type Post {
+  id: ID!
+  title: String!
+  likedBy: [User!]
+  related(first: Int): PostConnection! @cost(weight: 1, multipliers: ["first"])
+}
+
+type User {
+  id: ID!
+  name: String!
+}
+
+type Tag {
+  id: ID!
+  name: String!
+}
+`
+
+func TestSelectionSetCost(t *testing.T) {
+	t.Parallel()
+
+	schema, err := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: costTestSchema})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		query string
+		vars  map[string]any
+		want  int
+	}{
+		{
+			name:  "fields",
+			query: `{ me { id name } }`,
+			want:  3,
+		},
+		{
+			name:  "introspection is free",
+			query: `{ __typename me { id } }`,
+			want:  2,
+		},
+		{
+			name:  "connection multiplies list fields only",
+			query: `{ posts(first: 10) { totalCount pageInfo { hasNextPage } edges { cursor node { id } } } }`,
+			// 1 + totalCount + pageInfo + 10 * (edges + cursor + node + id)
+			want: 1 + 1 + 2 + 10*4,
+		},
+		{
+			name:  "list multiplies the whole selection",
+			query: `{ tags(first: 10) { id name } }`,
+			want:  2 + 10*2,
+		},
+		{
+			name:  "largest multiplier",
+			query: `{ posts(first: 2, last: 5) { edges { node { id } } } }`,
+			want:  1 + 5*3,
+		},
+		{
+			name:  "variable multiplier",
+			query: `query ($first: Int) { posts(first: $first) { edges { node { id } } } }`,
+			vars:  map[string]any{"first": json.Number("5")},
+			want:  1 + 5*3,
+		},
+		{
+			name:  "missing multiplier",
+			query: `{ posts { edges { node { id } } } }`,
+			want:  1 + defaultListSize*3,
+		},
+		{
+			name:  "unset variable multiplier",
+			query: `query ($first: Int) { posts(first: $first) { edges { node { id } } } }`,
+			want:  1 + defaultListSize*3,
+		},
+		{
+			name:  "unpaginated list",
+			query: `{ categories { id name } }`,
+			want:  1 + defaultUnpaginatedListSize*2,
+		},
+		{
+			name:  "unpaginated list of connection nodes",
+			query: `{ posts(first: 100) { edges { node { likedBy { id name } } } } }`,
+			// 1 + 100 * (edges + node + likedBy + 10 * (id + name))
+			want: 1 + 100*(3+defaultUnpaginatedListSize*2),
+		},
+		{
+			name: "fragments",
+			query: `
+				{ ...query }
+				fragment query on Query { me { ...user } posts(first: 2) { edges { node { ... on Post { id title } } } } }
+				fragment user on User { id name }`,
+			want: 3 + 1 + 2*4,
+		},
+		{
+			name: "saturation",
+			query: `{ posts(first: 2147483647) { edges { node {
+				related(first: 2147483647) { edges { node {
+					related(first: 2147483647) { edges { node {
+						related(first: 2147483647) { edges { node { id } } }
+					} } }
+				} } }
+			} } } }`,
+			want: math.MaxInt,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, errs := gqlparser.LoadQuery(schema, tc.query)
+			require.Empty(t, errs)
+			require.Len(t, doc.Operations, 1)
+
+			got := costWalker{vars: tc.vars}.selectionSetCost(doc.Operations[0].SelectionSet, 1)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -38,6 +38,42 @@ func (r *queryResolver) APIKeys(ctx context.Context, after *entgql.Cursor[uuid.U
 	return res, nil
 }
 
+// AwardDefinitions is the resolver for the awardDefinitions field.
+func (r *queryResolver) AwardDefinitions(ctx context.Context, after *entgql.Cursor[uuid.UUID], first *int, before *entgql.Cursor[uuid.UUID], last *int, orderBy *generated.AwardDefinitionOrder, where *generated.AwardDefinitionWhereInput) (*generated.AwardDefinitionConnection, error) {
+	res, err := r.ent.AwardDefinition.Query().Paginate(
+		ctx,
+		after,
+		first,
+		before,
+		last,
+		generated.WithAwardDefinitionOrder(orderBy),
+		generated.WithAwardDefinitionFilter(where.Filter),
+	)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "award definition"})
+	}
+
+	return res, nil
+}
+
+// CategoryRestrictions is the resolver for the categoryRestrictions field.
+func (r *queryResolver) CategoryRestrictions(ctx context.Context, after *entgql.Cursor[uuid.UUID], first *int, before *entgql.Cursor[uuid.UUID], last *int, orderBy *generated.CategoryRestrictionOrder, where *generated.CategoryRestrictionWhereInput) (*generated.CategoryRestrictionConnection, error) {
+	res, err := r.ent.CategoryRestriction.Query().Paginate(
+		ctx,
+		after,
+		first,
+		before,
+		last,
+		generated.WithCategoryRestrictionOrder(orderBy),
+		generated.WithCategoryRestrictionFilter(where.Filter),
+	)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "category restriction"})
+	}
+
+	return res, nil
+}
+
 // Comments is the resolver for the comments field.
 func (r *queryResolver) Comments(ctx context.Context, after *entgql.Cursor[uuid.UUID], first *int, before *entgql.Cursor[uuid.UUID], last *int, orderBy *generated.CommentOrder, where *generated.CommentWhereInput) (*generated.CommentConnection, error) {
 	res, err := r.ent.Comment.Query().Paginate(
@@ -117,6 +153,42 @@ func (r *queryResolver) RefreshTokens(ctx context.Context, after *entgql.Cursor[
 	return res, nil
 }
 
+// RoleChanges is the resolver for the roleChanges field.
+func (r *queryResolver) RoleChanges(ctx context.Context, after *entgql.Cursor[uuid.UUID], first *int, before *entgql.Cursor[uuid.UUID], last *int, orderBy *generated.RoleChangeOrder, where *generated.RoleChangeWhereInput) (*generated.RoleChangeConnection, error) {
+	res, err := r.ent.RoleChange.Query().Paginate(
+		ctx,
+		after,
+		first,
+		before,
+		last,
+		generated.WithRoleChangeOrder(orderBy),
+		generated.WithRoleChangeFilter(where.Filter),
+	)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "role change"})
+	}
+
+	return res, nil
+}
+
+// Suspensions is the resolver for the suspensions field.
+func (r *queryResolver) Suspensions(ctx context.Context, after *entgql.Cursor[uuid.UUID], first *int, before *entgql.Cursor[uuid.UUID], last *int, orderBy *generated.SuspensionOrder, where *generated.SuspensionWhereInput) (*generated.SuspensionConnection, error) {
+	res, err := r.ent.Suspension.Query().Paginate(
+		ctx,
+		after,
+		first,
+		before,
+		last,
+		generated.WithSuspensionOrder(orderBy),
+		generated.WithSuspensionFilter(where.Filter),
+	)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "suspension"})
+	}
+
+	return res, nil
+}
+
 // Users is the resolver for the users field.
 func (r *queryResolver) Users(ctx context.Context, after *entgql.Cursor[uuid.UUID], first *int, before *entgql.Cursor[uuid.UUID], last *int, orderBy *generated.UserOrder, where *generated.UserWhereInput) (*generated.UserConnection, error) {
 	res, err := r.ent.User.Query().Paginate(
@@ -141,11 +213,15 @@ func (r *Resolver) Post() PostResolver { return &postResolver{r} }
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// RefreshToken returns RefreshTokenResolver implementation.
+func (r *Resolver) RefreshToken() RefreshTokenResolver { return &refreshTokenResolver{r} }
+
 // User returns UserResolver implementation.
 func (r *Resolver) User() UserResolver { return &userResolver{r} }
 
 type (
-	postResolver  struct{ *Resolver }
-	queryResolver struct{ *Resolver }
-	userResolver  struct{ *Resolver }
+	postResolver         struct{ *Resolver }
+	queryResolver        struct{ *Resolver }
+	refreshTokenResolver struct{ *Resolver }
+	userResolver         struct{ *Resolver }
 )
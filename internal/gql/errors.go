@@ -16,6 +16,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/caliecode/la-clipasa/internal/auth"
 	"github.com/caliecode/la-clipasa/internal/ent/generated"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
 	"github.com/caliecode/la-clipasa/internal/gql/model"
@@ -255,6 +256,9 @@ func GetErrorCode(err error) model.ErrorCode {
 		validationErr      *ValidationError
 		unauthorizedErr    *UnauthorizedError
 		unauthenticatedErr *UnauthenticatedError
+		suspendedErr       *auth.SuspendedError
+		subscriptionErr    *auth.SubscriptionRequiredError
+		costLimitErr       *CostLimitError
 	)
 
 	switch {
@@ -264,6 +268,12 @@ func GetErrorCode(err error) model.ErrorCode {
 		return model.ErrorCodeInternalServerError
 	case errors.Is(err, ErrSearchFailed):
 		return model.ErrorCodeSearchFailed
+	case errors.As(err, &suspendedErr):
+		return model.ErrorCodeSuspended
+	case errors.As(err, &subscriptionErr):
+		return model.ErrorCodeSubscriptionRequired
+	case errors.As(err, &costLimitErr):
+		return model.ErrorCodeCostLimitExceeded
 	case errors.As(err, &unauthorizedErr):
 		return model.ErrorCodeUnauthorized
 	case errors.As(err, &unauthenticatedErr):
@@ -303,6 +313,16 @@ func NewErrorPresenter() func(ctx context.Context, err error) *gqlerror.Error {
 			"code": GetErrorCode(originalErr),
 		}
 
+		// permanent suspensions have no end
+		var suspendedErr *auth.SuspendedError
+		if errors.As(err, &suspendedErr) {
+			gqlErr.Extensions["suspendedUntil"] = suspendedErr.EndsAt
+		}
+		var costLimitErr *CostLimitError
+		if errors.As(err, &costLimitErr) {
+			gqlErr.Extensions[costExtension] = costLimitErr.Stats
+		}
+
 		return gqlErr
 	}
 }
@@ -12,29 +12,48 @@ type DiscordVideoMetadata struct {
 	Expiration time.Time `json:"expiration"`
 }
 
+type TwitchClipMetadata struct {
+	ID              string `json:"id"`
+	BroadcasterID   string `json:"broadcasterID"`
+	BroadcasterName string `json:"broadcasterName"`
+	CreatorID       string `json:"creatorID"`
+	CreatorName     string `json:"creatorName"`
+	ThumbnailURL    string `json:"thumbnailURL"`
+	// Duration is the clip duration in seconds.
+	Duration float64 `json:"duration"`
+	// VideoID is the ID of the VOD the clip was created from, if still available.
+	VideoID string `json:"videoID,omitempty"`
+	// VODOffset is the offset in seconds of the clip in the VOD.
+	VODOffset *int      `json:"vodOffset,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
 type PostMetadata struct {
 	// Version is the version of the Post metadata.
 	Version int `json:"version"`
 	// Service represents the provider of the Post link.
 	Service      PostService           `json:"service,omitempty"`
 	DiscordVideo *DiscordVideoMetadata `json:"discord,omitempty"`
+	TwitchClip   *TwitchClipMetadata   `json:"twitchClip,omitempty"`
 }
 
 type PostService string
 
 const (
 	PostServiceDiscord PostService = "DISCORD"
+	PostServiceTwitch  PostService = "TWITCH"
 	PostServiceUnknown PostService = "UNKNOWN"
 )
 
 var AllPostService = []PostService{
 	PostServiceDiscord,
+	PostServiceTwitch,
 	PostServiceUnknown,
 }
 
 func (e PostService) IsValid() bool {
 	switch e {
-	case PostServiceDiscord, PostServiceUnknown:
+	case PostServiceDiscord, PostServiceTwitch, PostServiceUnknown:
 		return true
 	}
 	return false
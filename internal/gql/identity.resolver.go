@@ -0,0 +1,42 @@
+package gql
+
+import (
+	"context"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
+	"github.com/google/uuid"
+)
+
+// UnlinkIdentity is the resolver for the unlinkIdentity field.
+func (r *mutationResolver) UnlinkIdentity(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	u := internal.GetUserFromCtx(ctx)
+	if u == nil {
+		return uuid.Nil, internal.NewErrorf(internal.ErrorCodeUnauthenticated, "unauthenticated")
+	}
+
+	if err := r.authn.UnlinkIdentity(ctx, u, id); err != nil {
+		return uuid.Nil, parseRequestError(err, action{action: ActionDelete, object: "identity"})
+	}
+
+	return id, nil
+}
+
+// MyIdentities is the resolver for the myIdentities field.
+func (r *queryResolver) MyIdentities(ctx context.Context) ([]*generated.Identity, error) {
+	u := internal.GetUserFromCtx(ctx)
+	if u == nil {
+		return nil, internal.NewErrorf(internal.ErrorCodeUnauthenticated, "unauthenticated")
+	}
+
+	identities, err := r.ent.Identity.Query().
+		Where(identity.OwnerID(u.ID)).
+		Order(identity.ByCreatedAt()).
+		All(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "identities"})
+	}
+
+	return identities, nil
+}
@@ -3,10 +3,13 @@ package gql_test
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/cookiejar"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -14,30 +17,51 @@ import (
 	"github.com/Yamashou/gqlgenc/clientv2"
 	"github.com/caliecode/la-clipasa/internal"
 	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/awards"
+	"github.com/caliecode/la-clipasa/internal/client"
 	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/categoryrestriction"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/comment"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/migrate"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/post"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/postcategory"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/refreshtoken"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
 	_ "github.com/caliecode/la-clipasa/internal/ent/generated/runtime"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/twitchtoken"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/useraward"
 	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+	"github.com/caliecode/la-clipasa/internal/gql"
+	"github.com/caliecode/la-clipasa/internal/gql/extramodel"
 	"github.com/caliecode/la-clipasa/internal/gql/model"
 	"github.com/caliecode/la-clipasa/internal/gql/testclient"
 	"github.com/caliecode/la-clipasa/internal/gql/testutils"
 	httpServer "github.com/caliecode/la-clipasa/internal/http"
 	"github.com/caliecode/la-clipasa/internal/http/httputil"
+	"github.com/caliecode/la-clipasa/internal/leaderboard"
+	"github.com/caliecode/la-clipasa/internal/models"
+	"github.com/caliecode/la-clipasa/internal/oidcmock"
 	"github.com/caliecode/la-clipasa/internal/testutil"
+	"github.com/caliecode/la-clipasa/internal/twitchsync"
+	"github.com/caliecode/la-clipasa/internal/utils/cache"
 	"github.com/caliecode/la-clipasa/internal/utils/pointers"
 
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+	"github.com/go-jose/go-jose/v4"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/theopenlane/entx"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"go.uber.org/zap"
 )
 
@@ -48,6 +72,27 @@ var (
 	testAuthn   *auth.Authentication
 	testLogger  *zap.SugaredLogger
 	testServer  *httptest.Server
+	testCache   cache.Store
+
+	// Twitch channel bans and subscriptions checked by the test server
+	testTwitchBans          = &fakeTwitchBans{banned: map[string]bool{}}
+	testTwitchSubscriptions = &fakeTwitchSubscriptions{subscribed: map[string]bool{}}
+	testBans                *auth.TwitchBans
+
+	// broadcaster stream fetched by the test server
+	testStreamFetcher = &fakeStreamFetcher{}
+	testStreams       *twitchsync.Streams
+
+	// clips fetched by the test server
+	testClipFetcher = &fakeClipFetcher{clips: map[string]models.TwitchClip{}}
+
+	testOIDCUser = oidcmock.User{
+		Subject:           uuid.NewString(),
+		PreferredUsername: "oidc_user",
+		Email:             "oidc_user@example.com",
+		EmailVerified:     true,
+		Picture:           "https://example.com/oidc_user.png",
+	}
 )
 
 func newCookieAuthClient(refreshToken string) testclient.TestGraphClient {
@@ -124,13 +169,22 @@ func TestMain(m *testing.M) {
 		testLogger.Fatalf("Failed to create schema resources: %v", err)
 	}
 
-	testAuthn = auth.NewAuthentication(testClient)
-
 	ctx := context.Background()
 
 	ctx = generated.NewContext(ctx, testClient)
 	ctx = internal.SetLoggerCtx(ctx, testLogger)
 
+	// login flows run against an in-process provider instead of id.twitch.tv
+	oidcProvider, err := httpServer.NewMockOIDCProvider(internal.Config, []oidcmock.User{testOIDCUser})
+	if err != nil {
+		testLogger.Fatalf("Failed to create mock OIDC provider: %v", err)
+	}
+	testOIDCServer := httptest.NewServer(oidcProvider)
+	defer testOIDCServer.Close()
+
+	internal.Config.TwitchOIDC.Issuer = testOIDCServer.URL
+	internal.Config.TwitchOIDC.UserInfoURL = testOIDCServer.URL + oidcmock.UserInfoPath
+
 	serverConf := httpServer.Config{
 		Address: ":0",
 		Pool:    testPool,
@@ -138,12 +192,16 @@ func TestMain(m *testing.M) {
 		Logger:  testLogger,
 	}
 
-	srv, err := httpServer.NewServer(ctx, serverConf)
+	srv, err := httpServer.NewServer(ctx, serverConf, httpServer.WithTwitchCheckers(testTwitchBans, testTwitchSubscriptions), httpServer.WithStreamFetcher(testStreamFetcher), httpServer.WithClipFetcher(testClipFetcher))
 	if err != nil {
 		testLogger.Fatalf("Failed to create test server using NewServer: %v", err)
 	}
 
 	testServer = httptest.NewServer(srv.Httpsrv.Handler)
+	testAuthn = srv.Authn
+	testCache = srv.Cache
+	testBans = srv.TwitchBans
+	testStreams = srv.Streams
 
 	code := m.Run()
 	testServer.Close()
@@ -883,6 +941,396 @@ func TestRefreshTokenResolvers(t *testing.T) {
 	})
 }
 
+func TestAwards(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx = generated.NewContext(ctx, testClient)
+
+	_, adminToken := createTestUser(ctx, t, user.RoleADMIN)
+	_, userToken := createTestUser(ctx, t, user.RoleUSER)
+
+	adminGQLClient := newAuthClient(adminToken)
+	userGQLClient := newAuthClient(userToken)
+
+	t.Run("CreateAwardDefinition_Fail_NonAdmin", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := userGQLClient.CreateAwardDefinition(ctx, testclient.CreateAwardDefinitionInput{
+			Name: "Award " + testutil.RandomString(10),
+			Icon: "🏆",
+			Rule: awarddefinition.RulePOSTS_PUBLISHED,
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("CreateAwardDefinition_Fail_MissingCategory", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := adminGQLClient.CreateAwardDefinition(ctx, testclient.CreateAwardDefinitionInput{
+			Name: "Award " + testutil.RandomString(10),
+			Icon: "🏆",
+			Rule: awarddefinition.RulePOSTS_IN_CATEGORY,
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("GrantedOnPostCreation", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := adminGQLClient.CreateAwardDefinition(ctx, testclient.CreateAwardDefinitionInput{
+			Name:          "Award " + testutil.RandomString(10),
+			Icon:          "🏆",
+			Rule:          awarddefinition.RulePOSTS_PUBLISHED,
+			RuleThreshold: pointers.New(int64(2)),
+		})
+		require.NoError(t, err)
+		award := resp.GetCreateAwardDefinition().GetAwardDefinition()
+		require.NotNil(t, award)
+
+		_, authorToken := createTestUser(ctx, t, user.RoleUSER)
+		authorGQLClient := newAuthClient(authorToken)
+
+		hasAward := func() int {
+			meResp, err := authorGQLClient.MeAwards(ctx)
+			require.NoError(t, err)
+
+			count := 0
+			for _, ua := range meResp.GetMe().GetAwards() {
+				if *ua.GetAward().GetID() == award.ID {
+					count++
+				}
+			}
+
+			return count
+		}
+
+		for i := range 3 {
+			_, err := authorGQLClient.CreatePostMutation(ctx, testclient.CreatePostInput{
+				Title: testutil.RandomLoremIpsum(5, 10),
+				Link:  testutil.RandomLink(),
+			})
+			require.NoError(t, err)
+
+			if i == 0 {
+				assert.Equal(t, 0, hasAward(), "award should not be granted below threshold")
+			}
+		}
+
+		assert.Equal(t, 1, hasAward(), "award should be granted exactly once")
+	})
+
+	t.Run("ConcurrentEvaluations", func(t *testing.T) {
+		t.Parallel()
+
+		author, _ := createTestUser(ctx, t, user.RoleUSER)
+		createTestPost(ctx, t, author)
+
+		systemCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+		award := testClient.AwardDefinition.Create().
+			SetName("Award " + testutil.RandomString(10)).
+			SetIcon("🏆").
+			SetRule(awarddefinition.RulePOSTS_PUBLISHED).
+			SetRuleThreshold(1).
+			SaveX(systemCtx)
+
+		// evaluations within mutation transactions must not fail them when racing
+		var wg sync.WaitGroup
+		errs := make(chan error, 5)
+		for range 5 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				tx, err := testClient.Tx(ctx)
+				if err != nil {
+					errs <- err
+					return
+				}
+				if err := awards.NewEvaluator(tx.Client()).EvaluateUsers(ctx, author.ID); err != nil {
+					_ = tx.Rollback()
+					errs <- err
+					return
+				}
+				errs <- tx.Commit()
+			}()
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			require.NoError(t, err)
+		}
+
+		granted := testClient.UserAward.Query().
+			Where(useraward.OwnerID(author.ID), useraward.AwardID(award.ID)).
+			CountX(systemCtx)
+		assert.Equal(t, 1, granted)
+	})
+}
+
+func TestLeaderboard(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx = generated.NewContext(ctx, testClient)
+	sysCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+
+	author, _ := createTestUser(ctx, t, user.RoleUSER)
+	_, viewerToken := createTestUser(ctx, t, user.RoleUSER)
+	p := createTestPost(ctx, t, author)
+
+	likes := 3
+	for range likes {
+		liker, _ := createTestUser(ctx, t, user.RoleUSER)
+		testClient.User.UpdateOne(liker).AddLikedPosts(p).SaveX(sysCtx)
+	}
+
+	leaderboard.New(testClient, testCache).Refresh(ctx)
+
+	resp, err := newAuthClient(viewerToken).Leaderboard(ctx, testclient.LeaderboardPeriodWeek, testclient.LeaderboardMetricLikesReceived, pointers.New(int64(leaderboard.MaxEntries)))
+	require.NoError(t, err)
+
+	var authorEntry *testclient.Leaderboard_Leaderboard
+	for _, e := range resp.GetLeaderboard() {
+		if e.GetUser().GetID() != nil && *e.GetUser().GetID() == author.ID {
+			authorEntry = e
+		}
+	}
+	require.NotNil(t, authorEntry, "author should be in the leaderboard")
+	assert.EqualValues(t, likes, authorEntry.GetScore())
+
+	_, err = newAuthClient(viewerToken).Leaderboard(ctx, testclient.LeaderboardPeriodWeek, testclient.LeaderboardMetricLikesReceived, pointers.New(int64(leaderboard.MaxEntries+1)))
+	require.Error(t, err)
+}
+
+func TestUserBlocks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx = generated.NewContext(ctx, testClient)
+
+	blocker, blockerToken := createTestUser(ctx, t, user.RoleUSER)
+	troll, trollToken := createTestUser(ctx, t, user.RoleUSER)
+	muted, _ := createTestUser(ctx, t, user.RoleUSER)
+
+	blockerPost := createTestPost(ctx, t, blocker)
+	trollPost := createTestPost(ctx, t, troll)
+	mutedPost := createTestPost(ctx, t, muted)
+
+	blockerClient := newAuthClient(blockerToken)
+	trollClient := newAuthClient(trollToken)
+
+	_, err := blockerClient.BlockUser(ctx, blocker.ID)
+	require.Error(t, err, "should not be able to block yourself")
+
+	_, err = blockerClient.BlockUser(ctx, troll.ID)
+	require.NoError(t, err)
+	_, err = blockerClient.MuteUser(ctx, muted.ID)
+	require.NoError(t, err)
+
+	t.Run("HidesBlockedAndMutedPosts", func(t *testing.T) {
+		first := int64(50)
+		where := testclient.PostWhereInput{
+			IDIn: []uuid.UUID{blockerPost.ID, trollPost.ID, mutedPost.ID},
+		}
+
+		resp, err := blockerClient.GetPostsQuery(ctx, &first, nil, nil, nil, &where)
+		require.NoError(t, err)
+
+		ids := []uuid.UUID{}
+		for _, edge := range resp.GetPosts().GetEdges() {
+			ids = append(ids, *edge.GetNode().GetID())
+		}
+		assert.ElementsMatch(t, []uuid.UUID{blockerPost.ID}, ids)
+
+		resp, err = trollClient.GetPostsQuery(ctx, &first, nil, nil, nil, &where)
+		require.NoError(t, err)
+		assert.Len(t, resp.GetPosts().GetEdges(), 3, "blocks only apply to the blocker")
+	})
+
+	t.Run("BlockedUserCannotReplyOrMention", func(t *testing.T) {
+		_, err := trollClient.CreateComment(ctx, testclient.CreateCommentInput{
+			Content: "hello",
+			OwnerID: troll.ID,
+			PostID:  &blockerPost.ID,
+		})
+		require.Error(t, err)
+
+		_, err = trollClient.CreateComment(ctx, testclient.CreateCommentInput{
+			Content: "hey @" + blocker.DisplayName,
+			OwnerID: troll.ID,
+			PostID:  &trollPost.ID,
+		})
+		require.Error(t, err)
+
+		resp, err := trollClient.CreateComment(ctx, testclient.CreateCommentInput{
+			Content: "hello",
+			OwnerID: troll.ID,
+			PostID:  &trollPost.ID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "hello", resp.GetCreateComment().GetComment().GetContent())
+	})
+
+	t.Run("ListAndRemoveBlocks", func(t *testing.T) {
+		resp, err := blockerClient.BlockedUsers(ctx)
+		require.NoError(t, err)
+		require.Len(t, resp.GetBlockedUsers(), 1)
+		assert.Equal(t, troll.ID, *resp.GetBlockedUsers()[0].GetID())
+
+		_, err = blockerClient.UnblockUser(ctx, troll.ID)
+		require.NoError(t, err)
+
+		resp, err = blockerClient.BlockedUsers(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, resp.GetBlockedUsers())
+
+		_, err = trollClient.CreateComment(ctx, testclient.CreateCommentInput{
+			Content: "hello again",
+			OwnerID: troll.ID,
+			PostID:  &blockerPost.ID,
+		})
+		require.NoError(t, err)
+	})
+}
+
+func TestCommentMutations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx = generated.NewContext(ctx, testClient)
+	systemCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+
+	author, authorToken := createTestUser(ctx, t, user.RoleUSER)
+	other, otherToken := createTestUser(ctx, t, user.RoleUSER)
+	authorPost := createTestPost(ctx, t, author)
+	otherPost := createTestPost(ctx, t, other)
+
+	authorClient := newAuthClient(authorToken)
+	otherClient := newAuthClient(otherToken)
+
+	createComment := func(t *testing.T) uuid.UUID {
+		t.Helper()
+
+		resp, err := authorClient.CreateComment(ctx, testclient.CreateCommentInput{
+			Content: "hello",
+			OwnerID: author.ID,
+			PostID:  &authorPost.ID,
+		})
+		require.NoError(t, err)
+
+		return *resp.GetCreateComment().GetComment().GetID()
+	}
+
+	t.Run("OwnerUpdatesContent", func(t *testing.T) {
+		t.Parallel()
+
+		id := createComment(t)
+		resp, err := authorClient.UpdateComment(ctx, id, testclient.UpdateCommentInput{Content: pointers.New("edited")})
+		require.NoError(t, err)
+		assert.Equal(t, "edited", resp.GetUpdateComment().GetComment().GetContent())
+	})
+
+	t.Run("UpdateComment_Fail_NonOwner", func(t *testing.T) {
+		t.Parallel()
+
+		id := createComment(t)
+		_, err := otherClient.UpdateComment(ctx, id, testclient.UpdateCommentInput{Content: pointers.New("edited")})
+		require.Error(t, err)
+
+		c := testClient.Comment.GetX(systemCtx, id)
+		assert.Equal(t, "hello", c.Content)
+	})
+
+	t.Run("UpdateComment_Fail_Reassignment", func(t *testing.T) {
+		t.Parallel()
+
+		id := createComment(t)
+
+		// owners and posts are not part of the update input
+		for _, input := range []map[string]any{
+			{"ownerID": other.ID},
+			{"postID": otherPost.ID},
+			{"clearPost": true},
+		} {
+			for _, client := range []testclient.TestGraphClient{authorClient, otherClient} {
+				var res map[string]any
+				err := client.(*testclient.Client).Client.Post(ctx, "UpdateComment", testclient.UpdateCommentDocument, &res, map[string]any{
+					"id":    id,
+					"input": input,
+				})
+				require.Error(t, err, "input %v should be rejected", input)
+			}
+		}
+
+		c := testClient.Comment.Query().Where(comment.ID(id)).WithPost().OnlyX(systemCtx)
+		assert.Equal(t, author.ID, c.OwnerID)
+		require.NotNil(t, c.Edges.Post)
+		assert.Equal(t, authorPost.ID, c.Edges.Post.ID)
+	})
+
+	t.Run("DeleteComment", func(t *testing.T) {
+		t.Parallel()
+
+		id := createComment(t)
+
+		_, err := otherClient.DeleteComment(ctx, id)
+		require.Error(t, err, "non-owners cannot delete comments")
+		require.True(t, testClient.Comment.Query().Where(comment.ID(id)).ExistX(systemCtx))
+
+		resp, err := authorClient.DeleteComment(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, *resp.GetDeleteComment().GetDeletedID())
+		assert.False(t, testClient.Comment.Query().Where(comment.ID(id)).ExistX(systemCtx))
+	})
+}
+
+func TestIdentities(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx = generated.NewContext(ctx, testClient)
+	sysCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+
+	u, userToken := createTestUser(ctx, t, user.RoleUSER)
+	_, otherToken := createTestUser(ctx, t, user.RoleUSER)
+
+	twitchIdentity := testClient.Identity.Create().
+		SetOwnerID(u.ID).
+		SetProvider(identity.ProviderTWITCH).
+		SetSubject(u.ExternalID).
+		SaveX(sysCtx)
+	discordIdentity := testClient.Identity.Create().
+		SetOwnerID(u.ID).
+		SetProvider(identity.ProviderDISCORD).
+		SetSubject(uuid.NewString()).
+		SaveX(sysCtx)
+
+	userClient := newAuthClient(userToken)
+
+	resp, err := userClient.MyIdentities(ctx)
+	require.NoError(t, err)
+	require.Len(t, resp.GetMyIdentities(), 2)
+
+	otherResp, err := newAuthClient(otherToken).MyIdentities(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, otherResp.GetMyIdentities())
+
+	_, err = newAuthClient(otherToken).UnlinkIdentity(ctx, discordIdentity.ID)
+	require.Error(t, err, "should not unlink identities of other users")
+
+	_, err = userClient.UnlinkIdentity(ctx, twitchIdentity.ID)
+	require.Error(t, err, "should not unlink the registration identity")
+
+	_, err = userClient.UnlinkIdentity(ctx, discordIdentity.ID)
+	require.NoError(t, err)
+
+	resp, err = userClient.MyIdentities(ctx)
+	require.NoError(t, err)
+	require.Len(t, resp.GetMyIdentities(), 1)
+	assert.Equal(t, twitchIdentity.ID, resp.GetMyIdentities()[0].GetID())
+}
+
 // newAuthClientWithoutToken creates a client that doesn't automatically add an Authorization header.
 // Useful for testing token logic where the access token might be missing or expired.
 func newAuthClientWithoutToken() testclient.TestGraphClient {
@@ -900,3 +1348,1243 @@ func newAuthClientWithoutToken() testclient.TestGraphClient {
 
 	return gqlClient
 }
+
+func TestOIDCLogin(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sysCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(generated.NewContext(ctx, testClient)), privacy.Allow)
+
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+	httpClient := &http.Client{
+		Jar: jar,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	get := func(t *testing.T, url string, wantStatus int) *http.Response {
+		t.Helper()
+
+		resp, err := httpClient.Get(url)
+		require.NoError(t, err)
+		t.Cleanup(func() { resp.Body.Close() })
+		require.Equal(t, wantStatus, resp.StatusCode, "GET %s", url)
+
+		return resp
+	}
+
+	resp := get(t, testServer.URL+internal.Config.APIVersion+"/auth/twitch/login", http.StatusFound)
+	authorizeURL, err := resp.Location()
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(authorizeURL.String(), internal.Config.TwitchOIDC.Issuer), "should redirect to mock provider")
+
+	q := authorizeURL.Query()
+	q.Set("login_hint", testOIDCUser.PreferredUsername)
+	authorizeURL.RawQuery = q.Encode()
+
+	resp = get(t, authorizeURL.String(), http.StatusFound)
+	callbackURL, err := resp.Location()
+	require.NoError(t, err)
+
+	// callback URL points to the configured API domain
+	resp = get(t, testServer.URL+internal.Config.APIVersion+"/auth/twitch/callback?"+callbackURL.RawQuery, http.StatusOK)
+
+	cookies := map[string]string{}
+	for _, c := range resp.Cookies() {
+		cookies[c.Name] = c.Value
+	}
+	require.NotEmpty(t, cookies[httputil.RefreshTokenCookieName])
+	require.NotEmpty(t, cookies[internal.Config.LoginCookieKey])
+	assert.Empty(t, cookies[internal.Config.Twitch.AuthInfoCookieKey], "twitch tokens are stored server-side")
+
+	u, err := testClient.User.Query().Where(user.ExternalID(testOIDCUser.Subject)).Only(sysCtx)
+	require.NoError(t, err)
+
+	rt, err := testClient.RefreshToken.Query().Where(refreshtoken.TokenHash(auth.HashRefreshToken(cookies[httputil.RefreshTokenCookieName]))).Only(sysCtx)
+	require.NoError(t, err)
+	tt, err := testClient.TwitchToken.Query().Where(twitchtoken.SessionID(rt.FamilyID)).Only(sysCtx)
+	require.NoError(t, err, "twitch tokens are stored for the session")
+	assert.Equal(t, testOIDCUser.Subject, tt.TwitchUserID)
+	assert.Equal(t, testOIDCUser.PreferredUsername, u.DisplayName)
+	assert.Equal(t, user.AuthProviderTWITCH, u.AuthProvider)
+	assert.Equal(t, user.RoleUSER, u.Role)
+	require.NotNil(t, u.ProfileImage)
+	assert.Equal(t, testOIDCUser.Picture, *u.ProfileImage)
+
+	accessTokenUser, err := testAuthn.GetUserFromAccessToken(sysCtx, cookies[internal.Config.LoginCookieKey])
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, accessTokenUser.ID)
+
+	// refresh cycle with the session cookie only
+	me, err := newCookieAuthClient(cookies[httputil.RefreshTokenCookieName]).Me(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, u.ID.String(), me.Me.ID)
+}
+
+func TestJWKS(t *testing.T) {
+	t.Parallel()
+
+	_, accessToken := createTestUser(context.Background(), t, user.RoleUSER)
+
+	resp, err := testServer.Client().Get(testServer.URL + "/.well-known/jwks.json")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var jwks jose.JSONWebKeySet
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&jwks))
+
+	// access tokens are verifiable by other services with the public keys only
+	sig, err := jose.ParseSigned(accessToken, []jose.SignatureAlgorithm{jose.EdDSA, jose.RS256})
+	require.NoError(t, err)
+	require.Len(t, sig.Signatures, 1)
+
+	keys := jwks.Key(sig.Signatures[0].Header.KeyID)
+	require.Len(t, keys, 1)
+	assert.True(t, keys[0].IsPublic())
+
+	_, err = sig.Verify(keys[0])
+	require.NoError(t, err)
+}
+
+func TestSessions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx = generated.NewContext(ctx, testClient)
+	sysCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+
+	u, userToken := createTestUser(ctx, t, user.RoleUSER)
+	_, otherToken := createTestUser(ctx, t, user.RoleUSER)
+	_, adminToken := createTestUser(ctx, t, user.RoleADMIN)
+
+	issueSession := func(ip, ua string) (*auth.TokenPair, *generated.RefreshToken) {
+		t.Helper()
+
+		tp, err := testAuthn.IssueNewTokenPair(sysCtx, testClient, u, ip, ua, nil)
+		require.NoError(t, err)
+		rt, err := testClient.RefreshToken.Query().
+			Where(refreshtoken.TokenHash(auth.HashRefreshToken(tp.RefreshToken))).
+			Only(sysCtx)
+		require.NoError(t, err)
+
+		return tp, rt
+	}
+
+	current, currentRT := issueSession("1.1.1.1", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/130.0.0.0 Safari/537.36")
+	_, phoneRT := issueSession("1.1.1.2", "Mozilla/5.0 (iPhone; CPU iPhone OS 18_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/18.0 Mobile/15E148 Safari/604.1")
+	issueSession("1.1.1.3", "Mozilla/5.0 (X11; Linux x86_64; rv:131.0) Gecko/20100101 Firefox/131.0")
+
+	// session cookie identifies the current session
+	userClient := testclient.NewClient(testServer.Client(), testServer.URL+internal.Config.APIVersion+"/graphql",
+		&clientv2.Options{ParseDataAlongWithErrors: false},
+		func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res any, next clientv2.RequestInterceptorFunc) error {
+			req.Header.Set("Authorization", "Bearer "+userToken)
+			req.AddCookie(&http.Cookie{Name: httputil.RefreshTokenCookieName, Value: current.RefreshToken})
+			return next(ctx, req, gqlInfo, res)
+		},
+	)
+
+	sessions, err := userClient.MySessions(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, sessions.MySessions.TotalCount)
+
+	sessionsByID := map[uuid.UUID]*testclient.MySessions_MySessions_Edges_Node{}
+	for _, edge := range sessions.MySessions.Edges {
+		sessionsByID[edge.Node.FamilyID] = edge.Node
+	}
+	require.Contains(t, sessionsByID, currentRT.FamilyID)
+	assert.True(t, sessionsByID[currentRT.FamilyID].IsCurrent)
+	assert.Equal(t, "Chrome on Windows", sessionsByID[currentRT.FamilyID].Device)
+	assert.Equal(t, "1.1.1.1", *sessionsByID[currentRT.FamilyID].IPAddress)
+	assert.False(t, sessionsByID[phoneRT.FamilyID].IsCurrent)
+	assert.Equal(t, "Safari on iOS", sessionsByID[phoneRT.FamilyID].Device)
+
+	otherClient := newAuthClient(otherToken)
+	otherSessions, err := otherClient.MySessions(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, otherSessions.MySessions.TotalCount)
+
+	_, err = otherClient.RevokeSession(ctx, phoneRT.FamilyID)
+	require.Error(t, err, "should not revoke sessions of other users")
+
+	// the session ID outlives the rotated refresh token
+	_, err = testAuthn.IssueNewTokenPair(sysCtx, testClient, u, "1.1.1.2", phoneRT.UserAgent, phoneRT)
+	require.NoError(t, err)
+	_, err = userClient.RevokeSession(ctx, phoneRT.FamilyID)
+	require.NoError(t, err)
+
+	sessions, err = userClient.MySessions(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, sessions.MySessions.TotalCount)
+
+	revoked, err := userClient.RevokeAllOtherSessions(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, revoked.RevokeAllOtherSessions)
+
+	sessions, err = userClient.MySessions(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, sessions.MySessions.TotalCount)
+	assert.True(t, sessions.MySessions.Edges[0].Node.IsCurrent)
+
+	_, err = otherClient.RevokeUserSessions(ctx, u.ID)
+	require.Error(t, err, "only admins can force logout users")
+
+	revokedByAdmin, err := newAuthClient(adminToken).RevokeUserSessions(ctx, u.ID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, revokedByAdmin.RevokeUserSessions)
+
+	_, err = newCookieAuthClient(current.RefreshToken).Me(ctx)
+	require.Error(t, err, "revoked session cannot be refreshed")
+}
+
+func TestRefreshTokenReuseDetection(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx = generated.NewContext(ctx, testClient)
+	sysCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+
+	u, _ := createTestUser(ctx, t, user.RoleUSER)
+
+	tp, err := testAuthn.IssueNewTokenPair(sysCtx, testClient, u, "1.1.1.1", "Browser", nil)
+	require.NoError(t, err)
+	initialRT := testClient.RefreshToken.Query().
+		Where(refreshtoken.TokenHash(auth.HashRefreshToken(tp.RefreshToken))).
+		OnlyX(sysCtx)
+
+	// rotation
+	_, err = newCookieAuthClient(tp.RefreshToken).Me(ctx)
+	require.NoError(t, err)
+
+	rotatedRT, err := testClient.RefreshToken.Query().Where(refreshtoken.ParentID(initialRT.ID)).Only(sysCtx)
+	require.NoError(t, err)
+	assert.Equal(t, initialRT.FamilyID, rotatedRT.FamilyID)
+	assert.False(t, rotatedRT.Revoked)
+	assert.WithinDuration(t, initialRT.CreatedAt, rotatedRT.CreatedAt, time.Millisecond, "session creation time is retained")
+
+	// concurrent requests racing the rotation
+	_, err = newCookieAuthClient(tp.RefreshToken).Me(ctx)
+	require.Error(t, err)
+	assert.False(t, testClient.RefreshToken.GetX(sysCtx, rotatedRT.ID).Revoked, "reuse within grace period should not revoke the family")
+
+	testClient.RefreshToken.UpdateOneID(initialRT.ID).
+		SetUpdatedAt(time.Now().Add(-2 * auth.RefreshTokenReuseGracePeriod)).
+		ExecX(sysCtx)
+
+	testAuthn.CleanupExpiredAndRevokedTokens(ctx, u.ID)
+	require.True(t, testClient.RefreshToken.Query().Where(refreshtoken.ID(initialRT.ID)).ExistX(sysCtx),
+		"rotated tokens of active sessions should be kept by cleanups")
+
+	_, err = newCookieAuthClient(tp.RefreshToken).Me(ctx)
+	require.Error(t, err)
+	assert.True(t, testClient.RefreshToken.GetX(sysCtx, rotatedRT.ID).Revoked, "reuse should revoke the whole family")
+
+	testAuthn.CleanupExpiredAndRevokedTokens(ctx, u.ID)
+	assert.False(t, testClient.RefreshToken.Query().Where(refreshtoken.FamilyID(initialRT.FamilyID)).ExistX(sysCtx),
+		"revoked sessions should be cleaned up")
+}
+
+func newAPIKeyClient(key string) testclient.TestGraphClient {
+	return testclient.NewClient(testServer.Client(), testServer.URL+internal.Config.APIVersion+"/graphql",
+		&clientv2.Options{ParseDataAlongWithErrors: false},
+		func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res any, next clientv2.RequestInterceptorFunc) error {
+			req.Header.Set(httpServer.ApiKeyHeaderKey, key)
+			return next(ctx, req, gqlInfo, res)
+		},
+	)
+}
+
+func TestAPIKeys(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx = generated.NewContext(ctx, testClient)
+	sysCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+
+	u, userToken := createTestUser(ctx, t, user.RoleMODERATOR)
+	userClient := newAuthClient(userToken)
+
+	createKey := func(name string, scopes ...string) *testclient.CreateApiKey_CreateAPIKey {
+		t.Helper()
+
+		resp, err := userClient.CreateAPIKey(ctx, testclient.CreateAPIKeyInput{
+			Name:      name,
+			Scopes:    scopes,
+			ExpiresOn: time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+
+		return &resp.CreateAPIKey
+	}
+
+	_, err := userClient.CreateAPIKey(ctx, testclient.CreateAPIKeyInput{
+		Name:      "invalid",
+		Scopes:    []string{"admin"},
+		ExpiresOn: time.Now().Add(time.Hour),
+	})
+	require.Error(t, err, "unknown scopes should be rejected")
+
+	readOnly := createKey("bot")
+	assert.Equal(t, []string{auth.ScopePostsRead}, readOnly.APIKey.Scopes, "keys are read only by default")
+	assert.True(t, strings.HasPrefix(readOnly.Key, readOnly.APIKey.Prefix))
+	assert.Nil(t, readOnly.APIKey.LastUsedAt)
+
+	stored := testClient.ApiKey.GetX(sysCtx, readOnly.APIKey.ID)
+	assert.Equal(t, auth.HashAPIKey(readOnly.Key), stored.KeyHash, "only the key hash is stored")
+	assert.Equal(t, u.ID, stored.OwnerID)
+
+	readOnlyClient := newAPIKeyClient(readOnly.Key)
+	_, err = readOnlyClient.GetPostsQuery(ctx, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.NotNil(t, testClient.ApiKey.GetX(sysCtx, readOnly.APIKey.ID).LastUsedAt)
+
+	postInput := testclient.CreatePostInput{
+		Title:   testutil.RandomLoremIpsum(5, 10),
+		Link:    testutil.RandomLink(),
+		OwnerID: u.ID,
+	}
+	_, err = readOnlyClient.CreatePostMutation(ctx, postInput)
+	require.Error(t, err, "read only keys cannot create posts")
+
+	_, err = readOnlyClient.CreateAPIKey(ctx, testclient.CreateAPIKeyInput{Name: "escalated", Scopes: auth.APIKeyScopes, ExpiresOn: time.Now().Add(time.Hour)})
+	require.Error(t, err, "api keys cannot manage api keys")
+
+	writer := createKey("poster", auth.ScopePostsRead, auth.ScopePostsWrite)
+	created, err := newAPIKeyClient(writer.Key).CreatePostMutation(ctx, postInput)
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, created.CreatePost.Post.Owner.ID)
+
+	// role is capped without the moderate scope
+	u, _, err = testAuthn.GetUserFromAPIKey(sysCtx, writer.Key)
+	require.NoError(t, err)
+	assert.Equal(t, user.RoleUSER, u.Role)
+
+	moderator := createKey("moderator", auth.ScopeModerate)
+	u, _, err = testAuthn.GetUserFromAPIKey(sysCtx, moderator.Key)
+	require.NoError(t, err)
+	assert.Equal(t, user.RoleMODERATOR, u.Role)
+}
+
+func TestRolePermissions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx = generated.NewContext(ctx, testClient)
+	sysCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+
+	_, adminToken := createTestUser(ctx, t, user.RoleADMIN)
+	_, moderatorToken := createTestUser(ctx, t, user.RoleMODERATOR)
+	author, _ := createTestUser(ctx, t, user.RoleUSER)
+	_, guestToken := createTestUser(ctx, t, user.RoleGUEST)
+	adminClient := newAuthClient(adminToken)
+	guestClient := newAuthClient(guestToken)
+
+	t.Cleanup(func() {
+		_, err := testAuthn.SetRolePermissions(sysCtx, user.RoleGUEST, auth.DefaultRolePermissions[user.RoleGUEST])
+		require.NoError(t, err)
+	})
+
+	// migrations seed the defaults
+	rps, err := adminClient.RolePermissions(ctx)
+	require.NoError(t, err)
+	require.Len(t, rps.RolePermissions, len(user.AllRoles()))
+	for _, rp := range rps.RolePermissions {
+		assert.ElementsMatch(t, auth.DefaultRolePermissions[rp.Role], rp.Permissions, "role %s", rp.Role)
+	}
+
+	me, err := newAuthClient(moderatorToken).Me(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, me.Me.Permissions, rolepermission.PermissionPostsModerate)
+	assert.NotContains(t, me.Me.Permissions, rolepermission.PermissionRolesManage)
+
+	_, err = newAuthClient(moderatorToken).RolePermissions(ctx)
+	require.Error(t, err)
+	_, err = newAuthClient(moderatorToken).SetRolePermissions(ctx, user.RoleMODERATOR, rolepermission.AllPermissions())
+	require.Error(t, err, "only role managers can change permissions")
+
+	_, err = adminClient.SetRolePermissions(ctx, user.RoleADMIN, []rolepermission.Permission{rolepermission.PermissionUsersManage})
+	require.Error(t, err, "admins cannot lock themselves out")
+
+	p := createTestPost(ctx, t, author)
+	category := testClient.PostCategory.Create().
+		SetCategory(postcategory.CategoryMEME_ARTESANAL).
+		SetPostID(p.ID).
+		SaveX(sysCtx)
+	other := createTestPost(ctx, t, author)
+	otherCategory := testClient.PostCategory.Create().
+		SetCategory(postcategory.CategoryMEH).
+		SetPostID(other.ID).
+		SaveX(sysCtx)
+
+	_, err = guestClient.UpdatePostMutation(ctx, p.ID, testclient.UpdatePostInput{AddCategoryIDs: []uuid.UUID{otherCategory.ID}})
+	require.Error(t, err)
+
+	// curator: can recategorize but not edit or delete
+	set, err := adminClient.SetRolePermissions(ctx, user.RoleGUEST, []rolepermission.Permission{rolepermission.PermissionPostsCategorize})
+	require.NoError(t, err)
+	assert.Equal(t, []rolepermission.Permission{rolepermission.PermissionPostsCategorize}, set.SetRolePermissions.Permissions)
+
+	_, err = guestClient.UpdatePostMutation(ctx, p.ID, testclient.UpdatePostInput{AddCategoryIDs: []uuid.UUID{otherCategory.ID}})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{category.ID, otherCategory.ID}, testClient.Post.GetX(sysCtx, p.ID).QueryCategories().IDsX(sysCtx))
+
+	title := "renamed"
+	_, err = guestClient.UpdatePostMutation(ctx, p.ID, testclient.UpdatePostInput{Title: &title})
+	require.Error(t, err)
+	_, err = guestClient.DeletePostMutation(ctx, p.ID)
+	require.Error(t, err)
+}
+
+type fakeChannelMembers struct {
+	mods, vips []string
+}
+
+func (f *fakeChannelMembers) ChannelModerators(ctx context.Context) ([]string, error) {
+	return f.mods, nil
+}
+
+func (f *fakeChannelMembers) ChannelVIPs(ctx context.Context) ([]string, error) {
+	return f.vips, nil
+}
+
+func TestTwitchRoleSync(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx = generated.NewContext(ctx, testClient)
+	sysCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+
+	mod, _ := createTestUser(ctx, t, user.RoleUSER)
+	vip, _ := createTestUser(ctx, t, user.RoleGUEST)
+	admin, adminToken := createTestUser(ctx, t, user.RoleADMIN)
+	manual, _ := createTestUser(ctx, t, user.RoleUSER)
+	adminClient := newAuthClient(adminToken)
+
+	// manually assigned roles are never synced
+	_, err := adminClient.UpdateUserRole(ctx, manual.ID, user.RoleUSER)
+	require.NoError(t, err)
+	_, err = adminClient.UpdateUserRole(ctx, manual.ID, user.RoleGUEST)
+	require.NoError(t, err)
+
+	members := &fakeChannelMembers{
+		mods: []string{mod.ExternalID, admin.ExternalID, manual.ExternalID},
+		vips: []string{vip.ExternalID},
+	}
+	syncer := twitchsync.NewRoleSyncer(testClient, members)
+	require.NoError(t, syncer.Sync(ctx))
+
+	mod = testClient.User.GetX(sysCtx, mod.ID)
+	assert.Equal(t, user.RoleMODERATOR, mod.Role)
+	assert.Equal(t, pointers.New(user.RoleSourceTWITCH), mod.RoleSource)
+	assert.True(t, testClient.User.GetX(sysCtx, vip.ID).TwitchVip)
+	assert.Equal(t, user.RoleADMIN, testClient.User.GetX(sysCtx, admin.ID).Role)
+	assert.Equal(t, user.RoleGUEST, testClient.User.GetX(sysCtx, manual.ID).Role)
+
+	// idempotent
+	require.NoError(t, syncer.Sync(ctx))
+	assert.Equal(t, 1, testClient.RoleChange.Query().Where(rolechange.UserID(mod.ID)).CountX(sysCtx))
+
+	members.mods, members.vips = []string{admin.ExternalID, manual.ExternalID}, nil
+	require.NoError(t, syncer.Sync(ctx))
+
+	assert.Equal(t, user.RoleUSER, testClient.User.GetX(sysCtx, mod.ID).Role)
+	assert.False(t, testClient.User.GetX(sysCtx, vip.ID).TwitchVip)
+	assert.Equal(t, user.RoleADMIN, testClient.User.GetX(sysCtx, admin.ID).Role)
+
+	changes, err := adminClient.UserRoleChanges(ctx, &testclient.RoleChangeWhereInput{UserID: &mod.ID})
+	require.NoError(t, err)
+	require.Len(t, changes.RoleChanges.Edges, 2)
+	for _, e := range changes.RoleChanges.Edges {
+		assert.Equal(t, rolechange.AttributeROLE, e.Node.Attribute)
+		assert.Equal(t, rolechange.SourceTWITCH_SYNC, e.Node.Source)
+		assert.Nil(t, e.Node.ChangedByID)
+	}
+
+	changes, err = adminClient.UserRoleChanges(ctx, &testclient.RoleChangeWhereInput{UserID: &vip.ID})
+	require.NoError(t, err)
+	require.Len(t, changes.RoleChanges.Edges, 2)
+	assert.Equal(t, rolechange.AttributeTWITCH_VIP, changes.RoleChanges.Edges[0].Node.Attribute)
+
+	changes, err = adminClient.UserRoleChanges(ctx, &testclient.RoleChangeWhereInput{UserID: &manual.ID})
+	require.NoError(t, err)
+	require.Len(t, changes.RoleChanges.Edges, 1, "unchanged roles are not recorded")
+	assert.Equal(t, rolechange.SourceMANUAL, changes.RoleChanges.Edges[0].Node.Source)
+	assert.Equal(t, pointers.New(admin.ID.String()), changes.RoleChanges.Edges[0].Node.ChangedByID)
+	assert.Equal(t, pointers.New(user.RoleSourceMANUAL), testClient.User.GetX(sysCtx, manual.ID).RoleSource)
+
+	_, moderatorToken := createTestUser(ctx, t, user.RoleMODERATOR)
+	_, err = newAuthClient(moderatorToken).UserRoleChanges(ctx, nil)
+	require.Error(t, err, "role changes require the users manage permission")
+}
+
+func TestRoleChanges(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx = generated.NewContext(ctx, testClient)
+	sysCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+
+	t.Run("LoginPromotion", func(t *testing.T) {
+		t.Parallel()
+
+		guest, _ := createTestUser(ctx, t, user.RoleGUEST)
+
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil).WithContext(sysCtx)
+		u, err := testAuthn.GetOrRegisterUserFromUserInfo(c, identity.ProviderTWITCH, &oidc.UserInfo{
+			Subject: guest.ExternalID,
+			UserInfoProfile: oidc.UserInfoProfile{
+				PreferredUsername: guest.DisplayName,
+				Picture:           "https://example.com/picture.png",
+			},
+			UserInfoEmail: oidc.UserInfoEmail{EmailVerified: true},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, user.RoleUSER, u.Role)
+		assert.Nil(t, u.RoleSource, "login promotions are not manual assignments")
+
+		changes := testClient.RoleChange.Query().Where(rolechange.UserID(guest.ID)).AllX(sysCtx)
+		require.Len(t, changes, 1)
+		assert.Equal(t, rolechange.SourceSYSTEM, changes[0].Source)
+		assert.Nil(t, changes[0].ChangedByID)
+
+		syncer := twitchsync.NewRoleSyncer(testClient, &fakeChannelMembers{mods: []string{guest.ExternalID}})
+		require.NoError(t, syncer.Sync(ctx))
+		assert.Equal(t, user.RoleMODERATOR, testClient.User.GetX(sysCtx, guest.ID).Role)
+	})
+
+	t.Run("BulkUpdate", func(t *testing.T) {
+		t.Parallel()
+
+		u1, _ := createTestUser(ctx, t, user.RoleUSER)
+		u2, _ := createTestUser(ctx, t, user.RoleUSER)
+
+		testClient.User.Update().
+			Where(user.IDIn(u1.ID, u2.ID)).
+			SetTwitchVip(true).
+			ExecX(sysCtx)
+
+		for _, u := range []*generated.User{u1, u2} {
+			changes := testClient.RoleChange.Query().Where(rolechange.UserID(u.ID)).AllX(sysCtx)
+			require.Len(t, changes, 1)
+			assert.Equal(t, rolechange.AttributeTWITCH_VIP, changes[0].Attribute)
+			assert.Equal(t, "true", changes[0].NewValue)
+		}
+	})
+}
+
+func TestTwitchModeratorEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx = generated.NewContext(ctx, testClient)
+	sysCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+
+	mod, _ := createTestUser(ctx, t, user.RoleUSER)
+	admin, _ := createTestUser(ctx, t, user.RoleADMIN)
+
+	syncer := twitchsync.NewRoleSyncer(testClient, &fakeChannelMembers{})
+
+	require.NoError(t, syncer.SetModerator(ctx, mod.ExternalID, true))
+	require.NoError(t, syncer.SetModerator(ctx, admin.ExternalID, true))
+	assert.Equal(t, user.RoleMODERATOR, testClient.User.GetX(sysCtx, mod.ID).Role)
+	assert.Equal(t, user.RoleADMIN, testClient.User.GetX(sysCtx, admin.ID).Role)
+
+	require.NoError(t, syncer.SetModerator(ctx, mod.ExternalID, false))
+	require.NoError(t, syncer.SetModerator(ctx, admin.ExternalID, false))
+	assert.Equal(t, user.RoleUSER, testClient.User.GetX(sysCtx, mod.ID).Role)
+	assert.Equal(t, user.RoleADMIN, testClient.User.GetX(sysCtx, admin.ID).Role)
+}
+
+func TestBroadcasterTokens(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sysCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(generated.NewContext(ctx, testClient)), privacy.Allow)
+
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+	httpClient := &http.Client{
+		Jar: jar,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := httpClient.Get(testServer.URL + internal.Config.APIVersion + "/auth/twitch/login?auth:login-mode=broadcaster")
+	require.NoError(t, err)
+	resp.Body.Close()
+	authorizeURL, err := resp.Location()
+	require.NoError(t, err)
+
+	q := authorizeURL.Query()
+	q.Set("login_hint", testOIDCUser.PreferredUsername)
+	authorizeURL.RawQuery = q.Encode()
+
+	resp, err = httpClient.Get(authorizeURL.String())
+	require.NoError(t, err)
+	resp.Body.Close()
+	callbackURL, err := resp.Location()
+	require.NoError(t, err)
+
+	resp, err = httpClient.Get(testServer.URL + internal.Config.APIVersion + "/auth/twitch/callback?" + callbackURL.RawQuery)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode, "only the broadcaster can store tokens")
+	assert.False(t, testClient.TwitchToken.Query().Where(twitchtoken.TwitchUserID(testOIDCUser.Subject), twitchtoken.SessionIDIsNil()).ExistX(sysCtx))
+
+	tokens := client.NewBroadcasterTokenSource(testClient)
+	tokenInfo := &models.TwitchTokenInfo{
+		AccessToken:  "access-" + testutil.RandomString(10),
+		RefreshToken: "refresh-" + testutil.RandomString(10),
+		Expiry:       time.Now().Add(time.Hour).Truncate(time.Microsecond),
+		TokenType:    "bearer",
+	}
+	require.Error(t, tokens.Store(ctx, testOIDCUser.Subject, tokenInfo, nil))
+	require.NoError(t, tokens.Store(ctx, internal.Config.Twitch.BroadcasterID, tokenInfo, []string{"moderation:read"}))
+
+	tt := testClient.TwitchToken.Query().Where(twitchtoken.TwitchUserID(internal.Config.Twitch.BroadcasterID)).OnlyX(sysCtx)
+	assert.NotContains(t, string(tt.AccessToken), tokenInfo.AccessToken, "tokens are encrypted")
+	assert.NotContains(t, string(tt.RefreshToken), tokenInfo.RefreshToken, "tokens are encrypted")
+
+	_, err = testClient.TwitchToken.Query().All(ctx)
+	require.Error(t, err, "tokens are only accessible to the server")
+
+	// loaded from the database by other instances
+	got, err := client.NewBroadcasterTokenSource(testClient).Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, tokenInfo.AccessToken, got.AccessToken)
+	assert.Equal(t, tokenInfo.RefreshToken, got.RefreshToken)
+	assert.True(t, tokenInfo.Expiry.Equal(got.Expiry))
+}
+
+type fakeTwitchBans struct {
+	mu     sync.Mutex
+	banned map[string]bool
+}
+
+func (f *fakeTwitchBans) UserBan(ctx context.Context, twitchUserID string) (bool, time.Time, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.banned[twitchUserID], time.Time{}, nil
+}
+
+func (f *fakeTwitchBans) set(twitchUserID string, banned bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.banned[twitchUserID] = banned
+}
+
+func TestTwitchBans(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx = generated.NewContext(ctx, testClient)
+
+	author, _ := createTestUser(ctx, t, user.RoleUSER)
+	p := createTestPost(ctx, t, author)
+	banned, bannedToken := createTestUser(ctx, t, user.RoleUSER)
+	bannedClient := newAuthClient(bannedToken)
+	bannedCtx := auth.WithTwitchBans(internal.SetUserCtx(ctx, banned), testBans)
+
+	testTwitchBans.set(banned.ExternalID, true)
+
+	_, err := bannedClient.CreatePostMutation(ctx, testclient.CreatePostInput{
+		Title: testutil.RandomLoremIpsum(5, 10),
+		Link:  testutil.RandomLink(),
+	})
+	require.Error(t, err)
+	_, err = bannedClient.CreateComment(ctx, testclient.CreateCommentInput{
+		Content: "hello",
+		OwnerID: banned.ID,
+		PostID:  &p.ID,
+	})
+	require.Error(t, err)
+	err = testClient.User.UpdateOneID(banned.ID).AddLikedPostIDs(p.ID).Exec(bannedCtx)
+	require.Error(t, err)
+
+	// other user updates are allowed
+	err = testClient.User.UpdateOneID(banned.ID).SetAlias("banned-" + testutil.RandomString(5)).Exec(bannedCtx)
+	require.NoError(t, err)
+
+	_, err = newAuthClient(bannedToken).Me(ctx)
+	require.NoError(t, err, "banned users can still browse")
+
+	// unbans apply once cached bans expire or on login
+	testTwitchBans.set(banned.ExternalID, false)
+	_, err = testBans.Refresh(ctx, banned.ExternalID)
+	require.NoError(t, err)
+
+	_, err = bannedClient.CreateComment(ctx, testclient.CreateCommentInput{
+		Content: "hello",
+		OwnerID: banned.ID,
+		PostID:  &p.ID,
+	})
+	require.NoError(t, err)
+	err = testClient.User.UpdateOneID(banned.ID).AddLikedPostIDs(p.ID).Exec(bannedCtx)
+	require.NoError(t, err)
+}
+
+func TestSuspensions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx = generated.NewContext(ctx, testClient)
+	sysCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+
+	author, authorToken := createTestUser(ctx, t, user.RoleUSER)
+	p := createTestPost(ctx, t, author)
+	mod, modToken := createTestUser(ctx, t, user.RoleMODERATOR)
+	suspended, suspendedToken := createTestUser(ctx, t, user.RoleUSER)
+	suspendedClient := newAuthClient(suspendedToken)
+
+	_, err := suspendedClient.CreateSuspension(ctx, testclient.CreateSuspensionInput{
+		Reason: "spam",
+		UserID: author.ID,
+	})
+	require.Error(t, err, "users cannot suspend")
+
+	endsAt := time.Now().Add(time.Hour)
+	res, err := newAuthClient(modToken).CreateSuspension(ctx, testclient.CreateSuspensionInput{
+		Reason: "spam",
+		Scope:  pointers.New(suspension.ScopePOST),
+		EndsAt: &endsAt,
+		UserID: suspended.ID,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, res.CreateSuspension.Suspension.IssuerID)
+	assert.Equal(t, mod.ID, *res.CreateSuspension.Suspension.IssuerID)
+
+	otherMod, _ := createTestUser(ctx, t, user.RoleMODERATOR)
+	for name, target := range map[string]uuid.UUID{
+		"self":      mod.ID,
+		"same role": otherMod.ID,
+	} {
+		_, err = newAuthClient(modToken).CreateSuspension(ctx, testclient.CreateSuspensionInput{
+			Reason: "spam",
+			UserID: target,
+		})
+		require.Error(t, err, name)
+	}
+
+	_, adminToken := createTestUser(ctx, t, user.RoleADMIN)
+	_, err = newAuthClient(adminToken).CreateSuspension(ctx, testclient.CreateSuspensionInput{
+		Reason: "spam",
+		Scope:  pointers.New(suspension.ScopeCOMMENT),
+		EndsAt: &endsAt,
+		UserID: otherMod.ID,
+	})
+	require.NoError(t, err, "role managers can suspend moderators")
+
+	// suspensions of users that are not outranked cannot be changed either
+	otherModSuspension := testClient.Suspension.Create().
+		SetUserID(otherMod.ID).
+		SetReason("spam").
+		SaveX(sysCtx)
+	err = testClient.Suspension.UpdateOneID(otherModSuspension.ID).
+		SetReason("lifted").
+		Exec(internal.SetUserCtx(ctx, mod))
+	require.Error(t, err)
+	err = testClient.Suspension.DeleteOneID(otherModSuspension.ID).Exec(internal.SetUserCtx(ctx, mod))
+	require.Error(t, err)
+
+	_, err = suspendedClient.CreatePostMutation(ctx, testclient.CreatePostInput{
+		Title: testutil.RandomLoremIpsum(5, 10),
+		Link:  testutil.RandomLink(),
+	})
+	testutils.AssertGraphQLErrorCodeField(t, err, model.ErrorCodeSuspended)
+	assert.ErrorContains(t, err, "suspended from posting until")
+
+	_, err = suspendedClient.CreateComment(ctx, testclient.CreateCommentInput{
+		Content: "hello",
+		OwnerID: suspended.ID,
+		PostID:  &p.ID,
+	})
+	require.NoError(t, err, "post suspensions allow commenting")
+
+	// expired suspensions lift without further action
+	err = testClient.Suspension.Create().
+		SetUserID(author.ID).
+		SetReason("spam").
+		SetStartsAt(time.Now().Add(-2 * time.Hour)).
+		SetEndsAt(time.Now().Add(-time.Hour)).
+		Exec(sysCtx)
+	require.NoError(t, err)
+	_, err = newAuthClient(authorToken).CreateComment(ctx, testclient.CreateCommentInput{
+		Content: "hello",
+		OwnerID: author.ID,
+		PostID:  &p.ID,
+	})
+	require.NoError(t, err)
+
+	err = testClient.Suspension.Create().
+		SetUserID(suspended.ID).
+		SetReason("abuse").
+		Exec(sysCtx)
+	require.NoError(t, err)
+
+	_, err = suspendedClient.Me(ctx)
+	testutils.AssertGraphQLErrorCodeField(t, err, model.ErrorCodeSuspended)
+	assert.ErrorContains(t, err, "suspended indefinitely")
+
+	// every operation is rejected, not only mutations
+	_, err = suspendedClient.MySessions(ctx)
+	testutils.AssertGraphQLErrorCodeField(t, err, model.ErrorCodeSuspended)
+
+	err = testClient.Suspension.Create().
+		SetUserID(author.ID).
+		SetReason("spam").
+		SetEndsAt(time.Now().Add(-time.Hour)).
+		Exec(sysCtx)
+	require.Error(t, err, "suspensions end after they start")
+}
+
+type fakeTwitchSubscriptions struct {
+	mu         sync.Mutex
+	subscribed map[string]bool
+}
+
+func (f *fakeTwitchSubscriptions) UserSubscribed(ctx context.Context, twitchUserID string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.subscribed[twitchUserID], nil
+}
+
+func (f *fakeTwitchSubscriptions) set(twitchUserID string, subscribed bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.subscribed[twitchUserID] = subscribed
+}
+
+func TestSubscriberOnlyCategories(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx = generated.NewContext(ctx, testClient)
+
+	_, adminToken := createTestUser(ctx, t, user.RoleADMIN)
+	_, modToken := createTestUser(ctx, t, user.RoleMODERATOR)
+	_, userToken := createTestUser(ctx, t, user.RoleUSER)
+	subscriber, subscriberToken := createTestUser(ctx, t, user.RoleUSER)
+	userClient := newAuthClient(userToken)
+
+	testTwitchSubscriptions.set(subscriber.ExternalID, true)
+
+	input := testclient.CreateCategoryRestrictionInput{
+		Category:           categoryrestriction.CategoryENSORDECEDOR,
+		SubscriberOnlyPost: pointers.New(true),
+		SubscriberOnlyView: pointers.New(true),
+	}
+	_, err := newAuthClient(modToken).CreateCategoryRestriction(ctx, input)
+	require.Error(t, err, "moderators cannot restrict categories")
+	_, err = newAuthClient(adminToken).CreateCategoryRestriction(ctx, input)
+	require.NoError(t, err)
+
+	postInput := func() testclient.CreatePostWithCategoriesInput {
+		return testclient.CreatePostWithCategoriesInput{
+			Base: &testclient.CreatePostInput{
+				Title: testutil.RandomLoremIpsum(5, 10),
+				Link:  testutil.RandomLink(),
+			},
+			Categories: []postcategory.Category{postcategory.CategoryENSORDECEDOR},
+		}
+	}
+
+	_, err = userClient.CreatePostWithCategoriesMutation(ctx, postInput())
+	testutils.AssertGraphQLErrorCodeField(t, err, model.ErrorCodeSubscriptionRequired)
+	assert.ErrorContains(t, err, "subscription to the channel is required to post in ENSORDECEDOR")
+
+	res, err := newAuthClient(subscriberToken).CreatePostWithCategoriesMutation(ctx, postInput())
+	require.NoError(t, err)
+	postID := res.GetCreatePostWithCategories().GetPost().GetID()
+	_, err = newAuthClient(subscriberToken).CreateComment(ctx, testclient.CreateCommentInput{
+		Content: "hello",
+		OwnerID: subscriber.ID,
+		PostID:  postID,
+	})
+	require.NoError(t, err)
+
+	first := int64(10)
+	where := testclient.PostWhereInput{ID: postID}
+	commentsWhere := testclient.CommentWhereInput{HasPostWith: []*testclient.PostWhereInput{&where}}
+	for name, tc := range map[string]struct {
+		token   string
+		visible bool
+	}{
+		"non-subscriber": {token: userToken, visible: false},
+		"subscriber":     {token: subscriberToken, visible: true},
+		"moderator":      {token: modToken, visible: true},
+	} {
+		client := newAuthClient(tc.token)
+		posts, err := client.GetPostsQuery(ctx, &first, nil, nil, nil, &where)
+		require.NoError(t, err, name)
+		assert.Equal(t, tc.visible, len(posts.GetPosts().GetEdges()) == 1, name)
+
+		// direct lookups are filtered as well
+		_, err = client.GetPost(ctx, *postID)
+		assert.Equal(t, tc.visible, err == nil, name)
+
+		comments, err := client.GetComments(ctx, &commentsWhere)
+		require.NoError(t, err, name)
+		assert.Equal(t, tc.visible, len(comments.GetComments().GetEdges()) == 1, name)
+	}
+}
+
+type fakeStreamFetcher struct {
+	mu     sync.Mutex
+	stream *models.TwitchStream
+}
+
+func (f *fakeStreamFetcher) BroadcasterStream(ctx context.Context) (*models.TwitchStream, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.stream, nil
+}
+
+func (f *fakeStreamFetcher) set(stream *models.TwitchStream) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.stream = stream
+}
+
+func TestStreamStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testStreamFetcher.set(&models.TwitchStream{
+		Title:        "Just Chatting con la comunidad",
+		GameName:     "Just Chatting",
+		ViewerCount:  1234,
+		StartedAt:    "2026-10-19T10:11:12Z",
+		ThumbnailURL: "https://static-cdn.jtvnw.net/previews-ttv/live_user_caliebre-{width}x{height}.jpg",
+	})
+	testStreams.Invalidate(ctx)
+	t.Cleanup(func() {
+		testStreamFetcher.set(nil)
+		testStreams.Invalidate(ctx)
+	})
+
+	anonClient := newAuthClientWithoutToken()
+
+	status, err := anonClient.BroadcasterStreamStatus(ctx)
+	require.NoError(t, err)
+	assert.True(t, status.StreamStatus.Live)
+	assert.Equal(t, pointers.New("Just Chatting"), status.StreamStatus.GameName)
+	assert.Equal(t, pointers.New(1234), status.StreamStatus.ViewerCount)
+	assert.Equal(t, pointers.New("https://static-cdn.jtvnw.net/previews-ttv/live_user_caliebre-640x360.jpg"), status.StreamStatus.ThumbnailURL)
+	require.NotNil(t, status.StreamStatus.StartedAt)
+	assert.True(t, status.StreamStatus.StartedAt.Equal(time.Date(2026, 10, 19, 10, 11, 12, 0, time.UTC)))
+
+	testStreamFetcher.set(nil)
+	testStreams.Invalidate(ctx)
+	status, err = anonClient.BroadcasterStreamStatus(ctx)
+	require.NoError(t, err)
+	assert.False(t, status.StreamStatus.Live)
+	assert.Nil(t, status.StreamStatus.Title)
+}
+
+type fakeClipFetcher struct {
+	mu    sync.Mutex
+	clips map[string]models.TwitchClip
+	top   []models.TwitchClip
+}
+
+func (f *fakeClipFetcher) Clip(ctx context.Context, id string) (*models.TwitchClip, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	clip, ok := f.clips[id]
+	if !ok {
+		return nil, nil
+	}
+
+	return &clip, nil
+}
+
+func (f *fakeClipFetcher) TopClips(ctx context.Context, startedAt time.Time, first int) ([]models.TwitchClip, error) {
+	return f.top, nil
+}
+
+func (f *fakeClipFetcher) add(clips ...models.TwitchClip) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, clip := range clips {
+		f.clips[clip.ID] = clip
+	}
+}
+
+func newTestClip(broadcasterID string) models.TwitchClip {
+	id := "Clip" + strings.ReplaceAll(uuid.NewString(), "-", "")
+
+	return models.TwitchClip{
+		ID:              id,
+		URL:             "https://clips.twitch.tv/" + id,
+		BroadcasterID:   broadcasterID,
+		BroadcasterName: "caliebre",
+		CreatorID:       "12345",
+		CreatorName:     "clipper",
+		VideoID:         "987654321",
+		Title:           testutil.RandomLoremIpsum(5, 10),
+		CreatedAt:       "2026-10-19T10:11:12Z",
+		ThumbnailURL:    "https://clips-media-assets2.twitch.tv/" + id + "-preview-480x272.jpg",
+		Duration:        29.9,
+		VODOffset:       pointers.New(3600),
+	}
+}
+
+func TestTwitchClipPosts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx = generated.NewContext(ctx, testClient)
+
+	_, userToken := createTestUser(ctx, t, user.RoleUSER)
+	_, modToken := createTestUser(ctx, t, user.RoleMODERATOR)
+	userClient := newAuthClient(userToken)
+
+	channelClip := newTestClip(internal.Config.Twitch.BroadcasterID)
+	otherClip := newTestClip("11111111")
+	testClipFetcher.add(channelClip, otherClip)
+
+	_, err := newAuthClientWithoutToken().CreatePostFromTwitchClipMutation(ctx, channelClip.URL)
+	testutils.AssertGraphQLErrorCodeField(t, err, model.ErrorCodeUnauthenticated)
+
+	_, err = userClient.CreatePostFromTwitchClipMutation(ctx, "https://www.youtube.com/watch?v="+channelClip.ID)
+	testutils.AssertGraphQLErrorCodeField(t, err, model.ErrorCodeValidationError)
+
+	_, err = userClient.CreatePostFromTwitchClipMutation(ctx, "https://clips.twitch.tv/MissingClip")
+	testutils.AssertGraphQLErrorCodeField(t, err, model.ErrorCodeNotFound)
+
+	res, err := userClient.CreatePostFromTwitchClipMutation(ctx, "https://www.twitch.tv/caliebre/clip/"+channelClip.ID)
+	require.NoError(t, err)
+	p := res.GetCreatePostFromTwitchClip().GetPost()
+	assert.Equal(t, channelClip.Title, p.Title)
+	assert.Equal(t, channelClip.URL, p.Link)
+	assert.False(t, p.IsModerated)
+	require.NotNil(t, p.Metadata)
+	assert.Equal(t, extramodel.PostServiceTwitch, p.Metadata.Service)
+	require.NotNil(t, p.Metadata.TwitchClip)
+	assert.Equal(t, channelClip.ID, p.Metadata.TwitchClip.ID)
+	assert.Equal(t, channelClip.BroadcasterID, p.Metadata.TwitchClip.BroadcasterID)
+	assert.Equal(t, channelClip.CreatorName, p.Metadata.TwitchClip.CreatorName)
+	assert.Equal(t, channelClip.ThumbnailURL, p.Metadata.TwitchClip.ThumbnailURL)
+	assert.InDelta(t, channelClip.Duration, p.Metadata.TwitchClip.Duration, 0.001)
+	assert.Equal(t, channelClip.VODOffset, p.Metadata.TwitchClip.VodOffset)
+
+	_, err = newAuthClient(modToken).CreatePostFromTwitchClipMutation(ctx, channelClip.URL)
+	testutils.AssertGraphQLErrorCodeField(t, err, model.ErrorCodeAlreadyExists)
+
+	_, err = userClient.CreatePostFromTwitchClipMutation(ctx, otherClip.URL)
+	testutils.AssertGraphQLErrorCodeField(t, err, model.ErrorCodeUnauthorized)
+
+	_, err = newAuthClient(modToken).CreatePostFromTwitchClipMutation(ctx, otherClip.URL)
+	require.NoError(t, err, "moderators can post clips from other channels")
+}
+
+func TestTwitchClipImport(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx = generated.NewContext(ctx, testClient)
+	systemCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+
+	posted, deleted, suggested := newTestClip(internal.Config.Twitch.BroadcasterID),
+		newTestClip(internal.Config.Twitch.BroadcasterID),
+		newTestClip(internal.Config.Twitch.BroadcasterID)
+
+	author, _ := createTestUser(ctx, t, user.RoleUSER)
+	for _, clip := range []models.TwitchClip{posted, deleted} {
+		p := testClient.Post.Create().
+			SetTitle(clip.Title).
+			SetLink(clip.URL).
+			SetMetadata(twitchsync.ClipPostMetadata(&clip)).
+			SetOwner(author).
+			SaveX(systemCtx)
+		if clip.ID == deleted.ID {
+			testClient.Post.DeleteOneID(p.ID).ExecX(internal.SetUserCtx(systemCtx, author))
+		}
+	}
+
+	importer := twitchsync.NewClipImporter(testClient, &fakeClipFetcher{top: []models.TwitchClip{posted, deleted, suggested}})
+
+	created, err := importer.Import(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, created, "posted and deleted clips are not suggested again")
+
+	created, err = importer.Import(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, created)
+
+	p, err := testClient.Post.Query().
+		Where(post.Link(suggested.URL)).
+		WithOwner().
+		Only(systemCtx)
+	require.NoError(t, err)
+	assert.False(t, p.IsModerated)
+	assert.Equal(t, twitchsync.ClipsUserExternalID, p.Edges.Owner.ExternalID)
+	assert.Equal(t, suggested.ID, p.Metadata.TwitchClip.ID)
+}
+
+func TestSessionTwitchTokens(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx = generated.NewContext(ctx, testClient)
+	sysCtx := privacy.DecisionContext(token.NewContextWithSystemCallToken(ctx), privacy.Allow)
+
+	u, _ := createTestUser(ctx, t, user.RoleUSER)
+	tokens := client.NewUserTokenSource(testClient)
+
+	_, err := tokens.SessionID(ctx)
+	require.ErrorIs(t, err, client.ErrTwitchTokenNotFound, "no session")
+
+	tp, err := testAuthn.IssueNewTokenPair(sysCtx, testClient, u, "", "", nil)
+	require.NoError(t, err)
+	sessionCtx := internal.SetRefreshTokenHashCtx(ctx, auth.HashRefreshToken(tp.RefreshToken))
+
+	sessionID, err := tokens.SessionID(sessionCtx)
+	require.NoError(t, err)
+	assert.Equal(t, tp.SessionID, sessionID)
+
+	_, err = tokens.Token(ctx, sessionID)
+	require.ErrorIs(t, err, client.ErrTwitchTokenNotFound)
+
+	tokenInfo := &models.TwitchTokenInfo{
+		AccessToken:  "access-" + testutil.RandomString(10),
+		RefreshToken: "refresh-" + testutil.RandomString(10),
+		Expiry:       time.Now().Add(time.Hour).Truncate(time.Microsecond),
+		TokenType:    "bearer",
+	}
+	twitchUserID := testutil.RandomString(10)
+	require.NoError(t, tokens.Store(ctx, sessionID, testutil.RandomString(10), tokenInfo))
+	// replaced when linking another twitch user
+	require.NoError(t, tokens.Store(ctx, sessionID, twitchUserID, tokenInfo))
+
+	tt := testClient.TwitchToken.Query().Where(twitchtoken.SessionID(sessionID)).OnlyX(sysCtx)
+	assert.Equal(t, twitchUserID, tt.TwitchUserID)
+	assert.NotContains(t, string(tt.AccessToken), tokenInfo.AccessToken, "tokens are encrypted")
+	assert.NotContains(t, string(tt.RefreshToken), tokenInfo.RefreshToken, "tokens are encrypted")
+
+	got, err := tokens.Token(ctx, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, tokenInfo.AccessToken, got.AccessToken)
+	assert.Equal(t, tokenInfo.RefreshToken, got.RefreshToken)
+
+	// rotated refresh tokens keep the session
+	rt := testClient.RefreshToken.Query().Where(refreshtoken.TokenHash(auth.HashRefreshToken(tp.RefreshToken))).OnlyX(sysCtx)
+	rotated, err := testAuthn.IssueNewTokenPair(sysCtx, testClient, u, "", "", rt)
+	require.NoError(t, err)
+	assert.Equal(t, sessionID, rotated.SessionID)
+
+	// other sessions are unaffected by revocation
+	other, err := testAuthn.IssueNewTokenPair(sysCtx, testClient, u, "", "", nil)
+	require.NoError(t, err)
+	require.NoError(t, tokens.Store(ctx, other.SessionID, twitchUserID, tokenInfo))
+	// tokens of unrelated inactive sessions are left to the cleanup job
+	endedSessionID := uuid.New()
+	require.NoError(t, tokens.Store(ctx, endedSessionID, twitchUserID, tokenInfo))
+
+	require.NoError(t, testAuthn.RevokeSession(ctx, u.ID, sessionID))
+	_, err = tokens.Token(ctx, sessionID)
+	require.ErrorIs(t, err, client.ErrTwitchTokenNotFound, "tokens of revoked sessions are deleted")
+
+	_, err = tokens.Token(ctx, other.SessionID)
+	require.NoError(t, err)
+	_, err = tokens.Token(ctx, endedSessionID)
+	require.NoError(t, err)
+
+	testAuthn.CleanupExpiredAndRevokedTokens(ctx, u.ID)
+	_, err = tokens.Token(ctx, endedSessionID)
+	require.ErrorIs(t, err, client.ErrTwitchTokenNotFound)
+	_, err = tokens.Token(ctx, other.SessionID)
+	require.NoError(t, err)
+}
+
+func TestQueryCostLimit(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type costResponse struct {
+		Errors []struct {
+			Extensions struct {
+				Code model.ErrorCode `json:"code"`
+				Cost gql.CostStats   `json:"cost"`
+			} `json:"extensions"`
+		} `json:"errors"`
+		Extensions struct {
+			Cost *gql.CostStats `json:"cost"`
+		} `json:"extensions"`
+	}
+
+	postQuery := func(t *testing.T, accessToken, query string) costResponse {
+		t.Helper()
+
+		body, err := json.Marshal(map[string]any{"query": query})
+		require.NoError(t, err)
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, testServer.URL+internal.Config.APIVersion+"/graphql", strings.NewReader(string(body)))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+
+		resp, err := testServer.Client().Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		var res costResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+
+		return res
+	}
+
+	_, userToken := createTestUser(ctx, t, user.RoleUSER)
+	_, modToken := createTestUser(ctx, t, user.RoleMODERATOR)
+
+	t.Run("cost is reported", func(t *testing.T) {
+		res := postQuery(t, userToken, `{ posts(first: 5) { edges { node { id } } totalCount } }`)
+		require.Empty(t, res.Errors)
+		require.NotNil(t, res.Extensions.Cost)
+		assert.Equal(t, 17, res.Extensions.Cost.Requested, "edges are multiplied by first")
+		assert.Positive(t, res.Extensions.Cost.Limit)
+		assert.LessOrEqual(t, res.Extensions.Cost.Remaining, res.Extensions.Cost.Limit-17)
+	})
+
+	// 100 posts with 100 likes each
+	expensiveQuery := `{ posts(first: 100) { edges { node { likedBy(first: 100) { edges { node { id } } } } } } }`
+
+	t.Run("operations exceeding the budget are rejected", func(t *testing.T) {
+		res := postQuery(t, userToken, expensiveQuery)
+		require.Len(t, res.Errors, 1)
+		assert.Equal(t, model.ErrorCodeCostLimitExceeded, res.Errors[0].Extensions.Code)
+		assert.Equal(t, 30301, res.Errors[0].Extensions.Cost.Requested)
+	})
+
+	t.Run("moderators have higher budgets", func(t *testing.T) {
+		res := postQuery(t, modToken, expensiveQuery)
+		require.Empty(t, res.Errors)
+		require.NotNil(t, res.Extensions.Cost)
+		assert.Equal(t, 30301, res.Extensions.Cost.Requested)
+	})
+
+	t.Run("unpaginated lists are multiplied", func(t *testing.T) {
+		res := postQuery(t, userToken, `{ posts(first: 100) { edges { node { savedBy { likedPosts { id } } } } } }`)
+		require.Empty(t, res.Errors)
+		require.NotNil(t, res.Extensions.Cost)
+		assert.Equal(t, 11301, res.Extensions.Cost.Requested)
+
+		res = postQuery(t, modToken, `{ posts(first: 100) { edges { node { savedBy { likedPosts { savedBy { id } } } } } } }`)
+		require.Len(t, res.Errors, 1)
+		assert.Equal(t, model.ErrorCodeCostLimitExceeded, res.Errors[0].Extensions.Code)
+		assert.Equal(t, 111301, res.Errors[0].Extensions.Cost.Requested)
+	})
+}
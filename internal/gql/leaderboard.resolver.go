@@ -0,0 +1,36 @@
+package gql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/caliecode/la-clipasa/internal/gql/model"
+	"github.com/caliecode/la-clipasa/internal/leaderboard"
+)
+
+// Leaderboard is the resolver for the leaderboard field.
+func (r *queryResolver) Leaderboard(ctx context.Context, period model.LeaderboardPeriod, metric model.LeaderboardMetric, first *int) ([]*model.LeaderboardEntry, error) {
+	limit := 10
+	if first != nil {
+		limit = *first
+	}
+	if limit < 1 || limit > leaderboard.MaxEntries {
+		return nil, newValidationError(fmt.Sprintf("first must be between 1 and %d", leaderboard.MaxEntries))
+	}
+
+	entries, err := r.leaderboards.Top(ctx, period.String(), metric.String(), limit)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "leaderboard"})
+	}
+
+	res := make([]*model.LeaderboardEntry, len(entries))
+	for i, e := range entries {
+		res[i] = &model.LeaderboardEntry{
+			Rank:  e.Rank,
+			Score: e.Score,
+			User:  e.User,
+		}
+	}
+
+	return res, nil
+}
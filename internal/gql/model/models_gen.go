@@ -7,11 +7,14 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 
 	"entgo.io/contrib/entgql"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/caliecode/la-clipasa/internal/ent/generated"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/postcategory"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
 	"github.com/google/uuid"
 )
 
@@ -29,6 +32,8 @@ type APIKeyBulkCreatePayload struct {
 type APIKeyCreatePayload struct {
 	// Created apiKey
 	APIKey *generated.ApiKey `json:"apiKey"`
+	// The API key, which is only returned on creation
+	Key string `json:"key"`
 }
 
 // Return response for deleteApiKey mutation
@@ -43,6 +48,54 @@ type APIKeyUpdatePayload struct {
 	APIKey *generated.ApiKey `json:"apiKey"`
 }
 
+// Return response for createBulkAwardDefinition mutation
+type AwardDefinitionBulkCreatePayload struct {
+	// Created awardDefinitions
+	AwardDefinitions []*generated.AwardDefinition `json:"awardDefinitions,omitempty"`
+}
+
+// Return response for createAwardDefinition mutation
+type AwardDefinitionCreatePayload struct {
+	// Created awardDefinition
+	AwardDefinition *generated.AwardDefinition `json:"awardDefinition"`
+}
+
+// Return response for deleteAwardDefinition mutation
+type AwardDefinitionDeletePayload struct {
+	// Deleted awardDefinition ID
+	DeletedID uuid.UUID `json:"deletedID"`
+}
+
+// Return response for updateAwardDefinition mutation
+type AwardDefinitionUpdatePayload struct {
+	// Updated awardDefinition
+	AwardDefinition *generated.AwardDefinition `json:"awardDefinition"`
+}
+
+// Return response for createBulkCategoryRestriction mutation
+type CategoryRestrictionBulkCreatePayload struct {
+	// Created categoryRestrictions
+	CategoryRestrictions []*generated.CategoryRestriction `json:"categoryRestrictions,omitempty"`
+}
+
+// Return response for createCategoryRestriction mutation
+type CategoryRestrictionCreatePayload struct {
+	// Created categoryRestriction
+	CategoryRestriction *generated.CategoryRestriction `json:"categoryRestriction"`
+}
+
+// Return response for deleteCategoryRestriction mutation
+type CategoryRestrictionDeletePayload struct {
+	// Deleted categoryRestriction ID
+	DeletedID uuid.UUID `json:"deletedID"`
+}
+
+// Return response for updateCategoryRestriction mutation
+type CategoryRestrictionUpdatePayload struct {
+	// Updated categoryRestriction
+	CategoryRestriction *generated.CategoryRestriction `json:"categoryRestriction"`
+}
+
 // Return response for createBulkComment mutation
 type CommentBulkCreatePayload struct {
 	// Created comments
@@ -73,6 +126,12 @@ type CreatePostWithCategoriesInput struct {
 	Video      *graphql.Upload            `json:"video,omitempty"`
 }
 
+type LeaderboardEntry struct {
+	Rank  int             `json:"rank"`
+	Score int             `json:"score"`
+	User  *generated.User `json:"user"`
+}
+
 // Return response for createBulkPost mutation
 type PostBulkCreatePayload struct {
 	// Created posts
@@ -145,6 +204,12 @@ type RefreshTokenUpdatePayload struct {
 	RefreshToken *generated.RefreshToken `json:"refreshToken"`
 }
 
+// Permissions granted to a role.
+type RolePermissionSet struct {
+	Role        user.Role                   `json:"role"`
+	Permissions []rolepermission.Permission `json:"permissions"`
+}
+
 type SearchResultConnection struct {
 	// Information to aid in pagination.
 	Page *entgql.PageInfo[uuid.UUID] `json:"page"`
@@ -154,6 +219,41 @@ type SearchResultConnection struct {
 	Nodes []SearchResult `json:"nodes"`
 }
 
+// Broadcaster Twitch stream status.
+type StreamStatus struct {
+	Live        bool       `json:"live"`
+	Title       *string    `json:"title,omitempty"`
+	GameName    *string    `json:"gameName,omitempty"`
+	ViewerCount *int       `json:"viewerCount,omitempty"`
+	StartedAt   *time.Time `json:"startedAt,omitempty"`
+	// Thumbnail of the live stream, sized 640x360.
+	ThumbnailURL *string `json:"thumbnailURL,omitempty"`
+}
+
+// Return response for createBulkSuspension mutation
+type SuspensionBulkCreatePayload struct {
+	// Created suspensions
+	Suspensions []*generated.Suspension `json:"suspensions,omitempty"`
+}
+
+// Return response for createSuspension mutation
+type SuspensionCreatePayload struct {
+	// Created suspension
+	Suspension *generated.Suspension `json:"suspension"`
+}
+
+// Return response for deleteSuspension mutation
+type SuspensionDeletePayload struct {
+	// Deleted suspension ID
+	DeletedID uuid.UUID `json:"deletedID"`
+}
+
+// Return response for updateSuspension mutation
+type SuspensionUpdatePayload struct {
+	// Updated suspension
+	Suspension *generated.Suspension `json:"suspension"`
+}
+
 type UpdatePostWithCategoriesInput struct {
 	Base       *generated.UpdatePostInput `json:"base"`
 	Categories []postcategory.Category    `json:"categories,omitempty"`
@@ -211,6 +311,9 @@ const (
 	ErrorCodeInternalServerError  ErrorCode = "INTERNAL_SERVER_ERROR"
 	ErrorCodeCascadeDelete        ErrorCode = "CASCADE_DELETE"
 	ErrorCodeSearchFailed         ErrorCode = "SEARCH_FAILED"
+	ErrorCodeSuspended            ErrorCode = "SUSPENDED"
+	ErrorCodeSubscriptionRequired ErrorCode = "SUBSCRIPTION_REQUIRED"
+	ErrorCodeCostLimitExceeded    ErrorCode = "COST_LIMIT_EXCEEDED"
 )
 
 var AllErrorCode = []ErrorCode{
@@ -224,11 +327,14 @@ var AllErrorCode = []ErrorCode{
 	ErrorCodeInternalServerError,
 	ErrorCodeCascadeDelete,
 	ErrorCodeSearchFailed,
+	ErrorCodeSuspended,
+	ErrorCodeSubscriptionRequired,
+	ErrorCodeCostLimitExceeded,
 }
 
 func (e ErrorCode) IsValid() bool {
 	switch e {
-	case ErrorCodeNotFound, ErrorCodeAlreadyExists, ErrorCodeForeignKeyConstraint, ErrorCodeValidationError, ErrorCodeConstraintError, ErrorCodeUnauthorized, ErrorCodeUnauthenticated, ErrorCodeInternalServerError, ErrorCodeCascadeDelete, ErrorCodeSearchFailed:
+	case ErrorCodeNotFound, ErrorCodeAlreadyExists, ErrorCodeForeignKeyConstraint, ErrorCodeValidationError, ErrorCodeConstraintError, ErrorCodeUnauthorized, ErrorCodeUnauthenticated, ErrorCodeInternalServerError, ErrorCodeCascadeDelete, ErrorCodeSearchFailed, ErrorCodeSuspended, ErrorCodeSubscriptionRequired, ErrorCodeCostLimitExceeded:
 		return true
 	}
 	return false
@@ -268,3 +374,120 @@ func (e ErrorCode) MarshalJSON() ([]byte, error) {
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
+
+type LeaderboardMetric string
+
+const (
+	// Likes received on published posts.
+	LeaderboardMetricLikesReceived LeaderboardMetric = "LIKES_RECEIVED"
+	// Published posts categorized ORO or DIAMANTE.
+	LeaderboardMetricOroDiamantePosts LeaderboardMetric = "ORO_DIAMANTE_POSTS"
+	// Published comments.
+	LeaderboardMetricComments LeaderboardMetric = "COMMENTS"
+)
+
+var AllLeaderboardMetric = []LeaderboardMetric{
+	LeaderboardMetricLikesReceived,
+	LeaderboardMetricOroDiamantePosts,
+	LeaderboardMetricComments,
+}
+
+func (e LeaderboardMetric) IsValid() bool {
+	switch e {
+	case LeaderboardMetricLikesReceived, LeaderboardMetricOroDiamantePosts, LeaderboardMetricComments:
+		return true
+	}
+	return false
+}
+
+func (e LeaderboardMetric) String() string {
+	return string(e)
+}
+
+func (e *LeaderboardMetric) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LeaderboardMetric(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LeaderboardMetric", str)
+	}
+	return nil
+}
+
+func (e LeaderboardMetric) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *LeaderboardMetric) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e LeaderboardMetric) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type LeaderboardPeriod string
+
+const (
+	LeaderboardPeriodWeek    LeaderboardPeriod = "WEEK"
+	LeaderboardPeriodMonth   LeaderboardPeriod = "MONTH"
+	LeaderboardPeriodAllTime LeaderboardPeriod = "ALL_TIME"
+)
+
+var AllLeaderboardPeriod = []LeaderboardPeriod{
+	LeaderboardPeriodWeek,
+	LeaderboardPeriodMonth,
+	LeaderboardPeriodAllTime,
+}
+
+func (e LeaderboardPeriod) IsValid() bool {
+	switch e {
+	case LeaderboardPeriodWeek, LeaderboardPeriodMonth, LeaderboardPeriodAllTime:
+		return true
+	}
+	return false
+}
+
+func (e LeaderboardPeriod) String() string {
+	return string(e)
+}
+
+func (e *LeaderboardPeriod) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LeaderboardPeriod(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LeaderboardPeriod", str)
+	}
+	return nil
+}
+
+func (e LeaderboardPeriod) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *LeaderboardPeriod) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e LeaderboardPeriod) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,45 @@
+package gql
+
+import (
+	"context"
+
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/gql/model"
+)
+
+// SetRolePermissions is the resolver for the setRolePermissions field.
+func (r *mutationResolver) SetRolePermissions(ctx context.Context, role user.Role, permissions []rolepermission.Permission) (*model.RolePermissionSet, error) {
+	permissions, err := r.authn.SetRolePermissions(ctx, role, permissions)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "role permissions"})
+	}
+
+	return &model.RolePermissionSet{
+		Role:        role,
+		Permissions: permissions,
+	}, nil
+}
+
+// RolePermissions is the resolver for the rolePermissions field.
+func (r *queryResolver) RolePermissions(ctx context.Context) ([]*model.RolePermissionSet, error) {
+	sets := make([]*model.RolePermissionSet, 0, len(user.AllRoles()))
+	for _, role := range user.AllRoles() {
+		permissions, err := rolePermissions(ctx, role)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, &model.RolePermissionSet{
+			Role:        role,
+			Permissions: permissions,
+		})
+	}
+
+	return sets, nil
+}
+
+// Permissions is the resolver for the permissions field.
+func (r *userResolver) Permissions(ctx context.Context, obj *generated.User) ([]rolepermission.Permission, error) {
+	return rolePermissions(ctx, obj.Role)
+}
@@ -9,7 +9,7 @@ import (
 	"github.com/caliecode/la-clipasa/internal/auth"
 	"github.com/caliecode/la-clipasa/internal/ent/generated"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
-	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
 	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
 	"github.com/caliecode/la-clipasa/internal/gql/model"
 	"github.com/google/uuid"
@@ -42,8 +42,10 @@ func (r *mutationResolver) CreateBulkCSVPost(ctx context.Context, input graphql.
 
 // UpdatePost is the resolver for the updatePost field.
 func (r *mutationResolver) UpdatePost(ctx context.Context, id uuid.UUID, input generated.UpdatePostInput) (*model.PostUpdatePayload, error) {
-	if auth.IsAuthorized(internal.GetUserFromCtx(ctx), user.RoleMODERATOR) {
-		// allow moderators to update any post field
+	u := internal.GetUserFromCtx(ctx)
+	if auth.HasPermission(ctx, u, rolepermission.PermissionPostsModerate) ||
+		isCategoryOnlyUpdate(input) && auth.HasPermission(ctx, u, rolepermission.PermissionPostsCategorize) {
+		// allow moderators to update any post field and curators to recategorize any post
 		ctx = privacy.DecisionContext(ctx, privacy.Allow)
 		ctx = token.NewContextWithSystemCallToken(ctx)
 	}
@@ -59,7 +61,7 @@ func (r *mutationResolver) UpdatePost(ctx context.Context, id uuid.UUID, input g
 
 // DeletePost is the resolver for the deletePost field.
 func (r *mutationResolver) DeletePost(ctx context.Context, id uuid.UUID) (*model.PostDeletePayload, error) {
-	if auth.IsAuthorized(internal.GetUserFromCtx(ctx), user.RoleMODERATOR) {
+	if auth.HasPermission(ctx, internal.GetUserFromCtx(ctx), rolepermission.PermissionPostsDelete) {
 		// allow moderators to delete any post
 		ctx = privacy.DecisionContext(ctx, privacy.Allow)
 		ctx = token.NewContextWithSystemCallToken(ctx)
@@ -75,5 +77,10 @@ func (r *mutationResolver) DeletePost(ctx context.Context, id uuid.UUID) (*model
 
 // Post is the resolver for the post field.
 func (r *queryResolver) Post(ctx context.Context, id uuid.UUID) (*generated.Post, error) {
-	panic(errors.New("not implemented: Post - post"))
+	p, err := r.ent.Post.Get(ctx, id)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "post"})
+	}
+
+	return p, nil
 }
@@ -6,13 +6,18 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/client"
 	"github.com/caliecode/la-clipasa/internal/ent/generated"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/post"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/postcategory"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
 	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
 	"github.com/caliecode/la-clipasa/internal/gql/extramodel"
 	"github.com/caliecode/la-clipasa/internal/gql/model"
+	"github.com/caliecode/la-clipasa/internal/twitchsync"
 	"github.com/caliecode/la-clipasa/internal/utils/pointers"
 	"github.com/google/uuid"
 	"github.com/theopenlane/entx"
@@ -22,7 +27,11 @@ import (
 func (r *mutationResolver) CreatePostWithCategories(ctx context.Context, input model.CreatePostWithCategoriesInput) (*model.PostCreatePayload, error) {
 	metadata := newPostMetadata()
 	if input.Video != nil {
-		input.Base.Link, metadata, _ = r.DiscordUpload(ctx, *input.Video)
+		link, meta, err := r.DiscordUpload(ctx, *input.Video)
+		if err != nil {
+			return nil, fmt.Errorf("failed to process video upload: %w", err)
+		}
+		input.Base.Link, metadata = link, meta
 	}
 
 	postPayload, err := r.CreatePost(ctx, *input.Base)
@@ -95,6 +104,54 @@ func (r *mutationResolver) RefreshDiscordLink(ctx context.Context, id uuid.UUID)
 	return pointers.New(res.URL), nil
 }
 
+// CreatePostFromTwitchClip is the resolver for the createPostFromTwitchClip field.
+func (r *mutationResolver) CreatePostFromTwitchClip(ctx context.Context, url string) (*model.PostCreatePayload, error) {
+	u := internal.GetUserFromCtx(ctx)
+	if u == nil {
+		return nil, newUnauthenticatedError("twitch clip post")
+	}
+
+	clipID, err := client.ParseTwitchClipID(url)
+	if err != nil {
+		return nil, newValidationError(err.Error())
+	}
+
+	clip, err := r.clips.Clip(ctx, clipID)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "twitch clip"})
+	}
+	if clip == nil {
+		return nil, newNotFoundError("twitch clip")
+	}
+
+	if clip.BroadcasterID != internal.Config.Twitch.BroadcasterID &&
+		!auth.HasPermission(ctx, u, rolepermission.PermissionPostsModerate) {
+		return nil, newUnauthorizedError("clips from other channels")
+	}
+
+	exists, err := twitchsync.ClipPostExists(ctx, r.ent, clip.ID)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "post"})
+	}
+	if exists {
+		return nil, newAlreadyExistsError("twitch clip post")
+	}
+
+	p, err := r.ent.Post.Create().
+		SetTitle(clip.Title).
+		SetLink(clip.URL).
+		SetMetadata(twitchsync.ClipPostMetadata(clip)).
+		SetOwner(u).
+		Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "post"})
+	}
+
+	return &model.PostCreatePayload{
+		Post: p,
+	}, nil
+}
+
 // UpdatePostWithCategories is the resolver for the updatePostWithCategories field.
 func (r *mutationResolver) UpdatePostWithCategories(ctx context.Context, id uuid.UUID, input model.UpdatePostWithCategoriesInput) (*model.PostUpdatePayload, error) {
 	var metadata *extramodel.PostMetadata
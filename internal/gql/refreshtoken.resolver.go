@@ -9,7 +9,7 @@ import (
 	"github.com/caliecode/la-clipasa/internal/auth"
 	"github.com/caliecode/la-clipasa/internal/ent/generated"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
-	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
 	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
 	"github.com/caliecode/la-clipasa/internal/gql/model"
 	"github.com/google/uuid"
@@ -37,7 +37,7 @@ func (r *mutationResolver) UpdateRefreshToken(ctx context.Context, id uuid.UUID,
 
 // DeleteRefreshToken is the resolver for the deleteRefreshToken field.
 func (r *mutationResolver) DeleteRefreshToken(ctx context.Context, id uuid.UUID) (*model.RefreshTokenDeletePayload, error) {
-	if auth.IsAuthorized(internal.GetUserFromCtx(ctx), user.RoleADMIN) {
+	if auth.HasPermission(ctx, internal.GetUserFromCtx(ctx), rolepermission.PermissionUsersManage) {
 		ctx = privacy.DecisionContext(ctx, privacy.Allow)
 		ctx = token.NewContextWithSystemCallToken(ctx)
 	}
@@ -16,7 +16,11 @@ import (
 	"github.com/caliecode/la-clipasa/internal/auth"
 	"github.com/caliecode/la-clipasa/internal/client"
 	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/leaderboard"
+	"github.com/caliecode/la-clipasa/internal/twitchsync"
+	"github.com/caliecode/la-clipasa/internal/utils/cache"
 )
 
 type Action string
@@ -33,6 +37,11 @@ type Resolver struct {
 	twitch  *client.TwitchHandlers
 	discord *client.DiscordHandlers
 	authn   *auth.Authentication
+	streams *twitchsync.Streams
+	clips   twitchsync.ClipFetcher
+
+	leaderboards *leaderboard.Leaderboards
+	cache        cache.Store
 }
 
 func GinContextFromCtx(ctx context.Context) (*gin.Context, error) {
@@ -56,6 +65,19 @@ func hasRoleDirective(ctx context.Context, obj any, next graphql.Resolver, role
 	return next(ctx)
 }
 
+func hasPermissionDirective(ctx context.Context, obj any, next graphql.Resolver, permission rolepermission.Permission) (res any, err error) {
+	u := internal.GetUserFromCtx(ctx)
+	if u == nil {
+		return nil, newUnauthenticatedError("permission directive: not authenticated")
+	}
+
+	if !auth.HasPermission(ctx, u, permission) {
+		return nil, newUnauthorizedError("permission directive: not authorized")
+	}
+
+	return next(ctx)
+}
+
 func skipSoftDeleteDirective(ctx context.Context, obj any, next graphql.Resolver) (res any, err error) {
 	// could also be https://github.com/99designs/gqlgen/issues/1084#issuecomment-795663385
 	// if we must know the current field
@@ -68,16 +90,22 @@ func skipSoftDeleteDirective(ctx context.Context, obj any, next graphql.Resolver
 	return next(ctx)
 }
 
-func NewResolver(entClient *generated.Client) Config {
+func NewResolver(entClient *generated.Client, authn *auth.Authentication, twitch *client.TwitchHandlers, streams *twitchsync.Streams, clips twitchsync.ClipFetcher, leaderboards *leaderboard.Leaderboards, cacheStore cache.Store) Config {
 	return Config{
 		Resolvers: &Resolver{
 			ent:     entClient,
-			twitch:  client.NewTwitchHandlers(entClient),
+			twitch:  twitch,
 			discord: client.NewDiscordHandlers(),
-			authn:   auth.NewAuthentication(entClient),
+			authn:   authn,
+			streams: streams,
+			clips:   clips,
+
+			leaderboards: leaderboards,
+			cache:        cacheStore,
 		},
 		Directives: DirectiveRoot{
 			HasRole:        hasRoleDirective,
+			HasPermission:  hasPermissionDirective,
 			SkipSoftDelete: skipSoftDeleteDirective,
 		},
 	}
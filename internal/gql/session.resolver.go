@@ -0,0 +1,89 @@
+package gql
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/refreshtoken"
+	"github.com/google/uuid"
+)
+
+// RevokeSession is the resolver for the revokeSession field.
+func (r *mutationResolver) RevokeSession(ctx context.Context, familyID uuid.UUID) (uuid.UUID, error) {
+	u := internal.GetUserFromCtx(ctx)
+	if u == nil {
+		return uuid.Nil, internal.NewErrorf(internal.ErrorCodeUnauthenticated, "unauthenticated")
+	}
+
+	if err := r.authn.RevokeSession(ctx, u.ID, familyID); err != nil {
+		return uuid.Nil, parseRequestError(err, action{action: ActionUpdate, object: "session"})
+	}
+
+	return familyID, nil
+}
+
+// RevokeAllOtherSessions is the resolver for the revokeAllOtherSessions field.
+func (r *mutationResolver) RevokeAllOtherSessions(ctx context.Context) (int, error) {
+	u := internal.GetUserFromCtx(ctx)
+	if u == nil {
+		return 0, internal.NewErrorf(internal.ErrorCodeUnauthenticated, "unauthenticated")
+	}
+
+	// without a session cookie, e.g. API clients, there is no current session to keep
+	n, err := r.authn.RevokeSessions(ctx, u.ID, internal.GetRefreshTokenHashFromCtx(ctx))
+	if err != nil {
+		return 0, parseRequestError(err, action{action: ActionUpdate, object: "sessions"})
+	}
+
+	return n, nil
+}
+
+// RevokeUserSessions is the resolver for the revokeUserSessions field.
+func (r *mutationResolver) RevokeUserSessions(ctx context.Context, userID uuid.UUID) (int, error) {
+	n, err := r.authn.RevokeSessions(ctx, userID, "")
+	if err != nil {
+		return 0, parseRequestError(err, action{action: ActionUpdate, object: "sessions"})
+	}
+
+	return n, nil
+}
+
+// MySessions is the resolver for the mySessions field.
+func (r *queryResolver) MySessions(ctx context.Context, after *entgql.Cursor[uuid.UUID], first *int, before *entgql.Cursor[uuid.UUID], last *int, orderBy *generated.RefreshTokenOrder) (*generated.RefreshTokenConnection, error) {
+	u := internal.GetUserFromCtx(ctx)
+	if u == nil {
+		return nil, internal.NewErrorf(internal.ErrorCodeUnauthenticated, "unauthenticated")
+	}
+
+	res, err := r.ent.RefreshToken.Query().
+		Where(refreshtoken.OwnerID(u.ID)).
+		Where(auth.ActiveSessions()...).
+		Paginate(ctx, after, first, before, last, generated.WithRefreshTokenOrder(orderBy))
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "sessions"})
+	}
+
+	return res, nil
+}
+
+// Device is the resolver for the device field.
+func (r *refreshTokenResolver) Device(ctx context.Context, obj *generated.RefreshToken) (string, error) {
+	return deviceFromUserAgent(obj.UserAgent), nil
+}
+
+// LastRotatedAt is the resolver for the lastRotatedAt field.
+func (r *refreshTokenResolver) LastRotatedAt(ctx context.Context, obj *generated.RefreshToken) (*time.Time, error) {
+	// rotated tokens are new rows retaining the session creation time
+	return &obj.UpdatedAt, nil
+}
+
+// IsCurrent is the resolver for the isCurrent field.
+func (r *refreshTokenResolver) IsCurrent(ctx context.Context, obj *generated.RefreshToken) (bool, error) {
+	h := internal.GetRefreshTokenHashFromCtx(ctx)
+
+	return h != "" && h == obj.TokenHash, nil
+}
@@ -0,0 +1,34 @@
+package gql
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/caliecode/la-clipasa/internal/gql/model"
+	"github.com/caliecode/la-clipasa/internal/utils/pointers"
+)
+
+// StreamStatus is the resolver for the streamStatus field.
+func (r *queryResolver) StreamStatus(ctx context.Context) (*model.StreamStatus, error) {
+	s, err := r.streams.BroadcasterStream(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "stream status"})
+	}
+	if s == nil {
+		return &model.StreamStatus{Live: false}, nil
+	}
+
+	status := &model.StreamStatus{
+		Live:         true,
+		Title:        pointers.New(s.Title),
+		GameName:     pointers.New(s.GameName),
+		ViewerCount:  pointers.New(s.ViewerCount),
+		ThumbnailURL: pointers.New(strings.NewReplacer("{width}", "640", "{height}", "360").Replace(s.ThumbnailURL)),
+	}
+	if startedAt, err := time.Parse(time.RFC3339, s.StartedAt); err == nil {
+		status.StartedAt = &startedAt
+	}
+
+	return status, nil
+}
@@ -0,0 +1,37 @@
+package gql
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
+)
+
+// SuspensionGuard rejects every operation of fully suspended users before any resolver runs,
+// so that clients know when the suspension ends.
+// Scoped suspensions are enforced by privacy rules.
+type SuspensionGuard struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = SuspensionGuard{}
+
+func (SuspensionGuard) ExtensionName() string {
+	return "SuspensionGuard"
+}
+
+func (SuspensionGuard) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (SuspensionGuard) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	if s := auth.ActiveSuspension(ctx, suspension.ScopeFULL); s != nil {
+		return gqlerror.WrapPath(nil, auth.NewSuspendedError(s))
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package gql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/gql/model"
+	"github.com/google/uuid"
+)
+
+// CreateSuspension is the resolver for the createSuspension field.
+func (r *mutationResolver) CreateSuspension(ctx context.Context, input generated.CreateSuspensionInput) (*model.SuspensionCreatePayload, error) {
+	s, err := r.ent.Suspension.Create().SetInput(input).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "suspension"})
+	}
+
+	return &model.SuspensionCreatePayload{
+		Suspension: s,
+	}, nil
+}
+
+// CreateBulkSuspension is the resolver for the createBulkSuspension field.
+func (r *mutationResolver) CreateBulkSuspension(ctx context.Context, input []*generated.CreateSuspensionInput) (*model.SuspensionBulkCreatePayload, error) {
+	panic(errors.New("not implemented: CreateBulkSuspension - createBulkSuspension"))
+}
+
+// CreateBulkCSVSuspension is the resolver for the createBulkCSVSuspension field.
+func (r *mutationResolver) CreateBulkCSVSuspension(ctx context.Context, input graphql.Upload) (*model.SuspensionBulkCreatePayload, error) {
+	panic(errors.New("not implemented: CreateBulkCSVSuspension - createBulkCSVSuspension"))
+}
+
+// UpdateSuspension is the resolver for the updateSuspension field.
+func (r *mutationResolver) UpdateSuspension(ctx context.Context, id uuid.UUID, input generated.UpdateSuspensionInput) (*model.SuspensionUpdatePayload, error) {
+	s, err := r.ent.Suspension.UpdateOneID(id).SetInput(input).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "suspension"})
+	}
+
+	return &model.SuspensionUpdatePayload{
+		Suspension: s,
+	}, nil
+}
+
+// DeleteSuspension is the resolver for the deleteSuspension field.
+func (r *mutationResolver) DeleteSuspension(ctx context.Context, id uuid.UUID) (*model.SuspensionDeletePayload, error) {
+	if err := r.ent.Suspension.DeleteOneID(id).Exec(ctx); err != nil {
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "suspension"})
+	}
+
+	return &model.SuspensionDeletePayload{
+		DeletedID: id,
+	}, nil
+}
+
+// Suspension is the resolver for the suspension field.
+func (r *queryResolver) Suspension(ctx context.Context, id uuid.UUID) (*generated.Suspension, error) {
+	s, err := r.ent.Suspension.Get(ctx, id)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "suspension"})
+	}
+
+	return s, nil
+}
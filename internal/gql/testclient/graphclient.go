@@ -7,20 +7,54 @@ import (
 	"time"
 
 	"github.com/Yamashou/gqlgenc/clientv2"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/categoryrestriction"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/postcategory"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
 	"github.com/google/uuid"
 )
 
 type TestGraphClient interface {
 	CreatePostMutation(ctx context.Context, input CreatePostInput, interceptors ...clientv2.RequestInterceptor) (*CreatePostMutation, error)
 	CreatePostWithCategoriesMutation(ctx context.Context, input CreatePostWithCategoriesInput, interceptors ...clientv2.RequestInterceptor) (*CreatePostWithCategoriesMutation, error)
+	CreatePostFromTwitchClipMutation(ctx context.Context, url string, interceptors ...clientv2.RequestInterceptor) (*CreatePostFromTwitchClipMutation, error)
 	UpdatePostMutation(ctx context.Context, id uuid.UUID, input UpdatePostInput, interceptors ...clientv2.RequestInterceptor) (*UpdatePostMutation, error)
 	DeletePostMutation(ctx context.Context, id uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*DeletePostMutation, error)
 	RestorePostMutation(ctx context.Context, id uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*RestorePostMutation, error)
 	GetPostsQuery(ctx context.Context, first *int64, after *string, last *int64, before *string, where *PostWhereInput, interceptors ...clientv2.RequestInterceptor) (*GetPostsQuery, error)
+	GetPost(ctx context.Context, id uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*GetPost, error)
+	GetComments(ctx context.Context, where *CommentWhereInput, interceptors ...clientv2.RequestInterceptor) (*GetComments, error)
 	GetAllRefreshTokens(ctx context.Context, first *int64, after *string, last *int64, before *string, where *RefreshTokenWhereInput, interceptors ...clientv2.RequestInterceptor) (*GetAllRefreshTokens, error)
 	DeleteRefreshToken(ctx context.Context, id uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*DeleteRefreshToken, error)
 	Me(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*Me, error)
+	CreateAwardDefinition(ctx context.Context, input CreateAwardDefinitionInput, interceptors ...clientv2.RequestInterceptor) (*CreateAwardDefinition, error)
+	MeAwards(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*MeAwards, error)
+	Leaderboard(ctx context.Context, period LeaderboardPeriod, metric LeaderboardMetric, first *int64, interceptors ...clientv2.RequestInterceptor) (*Leaderboard, error)
+	BlockUser(ctx context.Context, id uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*BlockUser, error)
+	UnblockUser(ctx context.Context, id uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*UnblockUser, error)
+	MuteUser(ctx context.Context, id uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*MuteUser, error)
+	BlockedUsers(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*BlockedUsers, error)
+	CreateComment(ctx context.Context, input CreateCommentInput, interceptors ...clientv2.RequestInterceptor) (*CreateComment, error)
+	UpdateComment(ctx context.Context, id uuid.UUID, input UpdateCommentInput, interceptors ...clientv2.RequestInterceptor) (*UpdateComment, error)
+	DeleteComment(ctx context.Context, id uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*DeleteComment, error)
+	MyIdentities(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*MyIdentities, error)
+	UnlinkIdentity(ctx context.Context, id uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*UnlinkIdentity, error)
+	MySessions(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*MySessions, error)
+	RevokeSession(ctx context.Context, familyID uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*RevokeSession, error)
+	RevokeAllOtherSessions(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*RevokeAllOtherSessions, error)
+	RevokeUserSessions(ctx context.Context, userID uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*RevokeUserSessions, error)
+	CreateAPIKey(ctx context.Context, input CreateAPIKeyInput, interceptors ...clientv2.RequestInterceptor) (*CreateAPIKey, error)
+	RolePermissions(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*RolePermissions, error)
+	SetRolePermissions(ctx context.Context, role user.Role, permissions []rolepermission.Permission, interceptors ...clientv2.RequestInterceptor) (*SetRolePermissions, error)
+	UpdateUserRole(ctx context.Context, id uuid.UUID, role user.Role, interceptors ...clientv2.RequestInterceptor) (*UpdateUserRole, error)
+	UserRoleChanges(ctx context.Context, where *RoleChangeWhereInput, interceptors ...clientv2.RequestInterceptor) (*UserRoleChanges, error)
+	CreateSuspension(ctx context.Context, input CreateSuspensionInput, interceptors ...clientv2.RequestInterceptor) (*CreateSuspension, error)
+	CreateCategoryRestriction(ctx context.Context, input CreateCategoryRestrictionInput, interceptors ...clientv2.RequestInterceptor) (*CreateCategoryRestriction, error)
+	BroadcasterStreamStatus(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*BroadcasterStreamStatus, error)
 }
 
 type Client struct {
@@ -201,6 +235,52 @@ func (t *RefreshTokenConnectionFields) GetEdges() []*RefreshTokenConnectionField
 	return t.Edges
 }
 
+type AwardDefinitionFields struct {
+	ID            uuid.UUID                     "json:\"id\" graphql:\"id\""
+	Name          string                        "json:\"name\" graphql:\"name\""
+	Icon          string                        "json:\"icon\" graphql:\"icon\""
+	Rule          awarddefinition.Rule          "json:\"rule\" graphql:\"rule\""
+	RuleCategory  *awarddefinition.RuleCategory "json:\"ruleCategory,omitempty\" graphql:\"ruleCategory\""
+	RuleThreshold int64                         "json:\"ruleThreshold\" graphql:\"ruleThreshold\""
+}
+
+func (t *AwardDefinitionFields) GetID() *uuid.UUID {
+	if t == nil {
+		t = &AwardDefinitionFields{}
+	}
+	return &t.ID
+}
+func (t *AwardDefinitionFields) GetName() string {
+	if t == nil {
+		t = &AwardDefinitionFields{}
+	}
+	return t.Name
+}
+func (t *AwardDefinitionFields) GetIcon() string {
+	if t == nil {
+		t = &AwardDefinitionFields{}
+	}
+	return t.Icon
+}
+func (t *AwardDefinitionFields) GetRule() *awarddefinition.Rule {
+	if t == nil {
+		t = &AwardDefinitionFields{}
+	}
+	return &t.Rule
+}
+func (t *AwardDefinitionFields) GetRuleCategory() *awarddefinition.RuleCategory {
+	if t == nil {
+		t = &AwardDefinitionFields{}
+	}
+	return t.RuleCategory
+}
+func (t *AwardDefinitionFields) GetRuleThreshold() int64 {
+	if t == nil {
+		t = &AwardDefinitionFields{}
+	}
+	return t.RuleThreshold
+}
+
 type PostFields_Owner struct {
 	DisplayName string    "json:\"displayName\" graphql:\"displayName\""
 	ID          uuid.UUID "json:\"id\" graphql:\"id\""
@@ -421,6 +501,205 @@ func (t *CreatePostWithCategoriesMutation_CreatePostWithCategories) GetPost() *P
 	return t.Post
 }
 
+type CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_PostFields_Owner struct {
+	DisplayName string    "json:\"displayName\" graphql:\"displayName\""
+	ID          uuid.UUID "json:\"id\" graphql:\"id\""
+}
+
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_PostFields_Owner) GetDisplayName() string {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_PostFields_Owner{}
+	}
+	return t.DisplayName
+}
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_PostFields_Owner) GetID() *uuid.UUID {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_PostFields_Owner{}
+	}
+	return &t.ID
+}
+
+type CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_PostFields_Categories struct {
+	Category postcategory.Category "json:\"category\" graphql:\"category\""
+	ID       uuid.UUID             "json:\"id\" graphql:\"id\""
+}
+
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_PostFields_Categories) GetCategory() *postcategory.Category {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_PostFields_Categories{}
+	}
+	return &t.Category
+}
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_PostFields_Categories) GetID() *uuid.UUID {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_PostFields_Categories{}
+	}
+	return &t.ID
+}
+
+type CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata_TwitchClip struct {
+	BroadcasterID string  "json:\"broadcasterID\" graphql:\"broadcasterID\""
+	CreatorName   string  "json:\"creatorName\" graphql:\"creatorName\""
+	Duration      float64 "json:\"duration\" graphql:\"duration\""
+	ID            string  "json:\"id\" graphql:\"id\""
+	ThumbnailURL  string  "json:\"thumbnailURL\" graphql:\"thumbnailURL\""
+	VideoID       *string "json:\"videoID,omitempty\" graphql:\"videoID\""
+	VodOffset     *int64  "json:\"vodOffset,omitempty\" graphql:\"vodOffset\""
+}
+
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata_TwitchClip) GetBroadcasterID() string {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata_TwitchClip{}
+	}
+	return t.BroadcasterID
+}
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata_TwitchClip) GetCreatorName() string {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata_TwitchClip{}
+	}
+	return t.CreatorName
+}
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata_TwitchClip) GetDuration() float64 {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata_TwitchClip{}
+	}
+	return t.Duration
+}
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata_TwitchClip) GetID() string {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata_TwitchClip{}
+	}
+	return t.ID
+}
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata_TwitchClip) GetThumbnailURL() string {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata_TwitchClip{}
+	}
+	return t.ThumbnailURL
+}
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata_TwitchClip) GetVideoID() *string {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata_TwitchClip{}
+	}
+	return t.VideoID
+}
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata_TwitchClip) GetVodOffset() *int64 {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata_TwitchClip{}
+	}
+	return t.VodOffset
+}
+
+type CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata struct {
+	Service    PostService                                                                         "json:\"service\" graphql:\"service\""
+	TwitchClip *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata_TwitchClip "json:\"twitchClip,omitempty\" graphql:\"twitchClip\""
+}
+
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata) GetService() *PostService {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata{}
+	}
+	return &t.Service
+}
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata) GetTwitchClip() *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata_TwitchClip {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata{}
+	}
+	return t.TwitchClip
+}
+
+type CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post struct {
+	Categories        []*CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_PostFields_Categories "json:\"categories,omitempty\" graphql:\"categories\""
+	Content           *string                                                                                 "json:\"content,omitempty\" graphql:\"content\""
+	DeletedAt         *time.Time                                                                              "json:\"deletedAt,omitempty\" graphql:\"deletedAt\""
+	ID                uuid.UUID                                                                               "json:\"id\" graphql:\"id\""
+	IsModerated       bool                                                                                    "json:\"isModerated\" graphql:\"isModerated\""
+	Link              string                                                                                  "json:\"link\" graphql:\"link\""
+	Metadata          *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata                "json:\"metadata,omitempty\" graphql:\"metadata\""
+	ModeratedAt       *time.Time                                                                              "json:\"moderatedAt,omitempty\" graphql:\"moderatedAt\""
+	ModerationComment *string                                                                                 "json:\"moderationComment,omitempty\" graphql:\"moderationComment\""
+	Owner             CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_PostFields_Owner         "json:\"owner\" graphql:\"owner\""
+	Title             string                                                                                  "json:\"title\" graphql:\"title\""
+}
+
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post) GetCategories() []*CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_PostFields_Categories {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post{}
+	}
+	return t.Categories
+}
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post) GetContent() *string {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post{}
+	}
+	return t.Content
+}
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post) GetDeletedAt() *time.Time {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post{}
+	}
+	return t.DeletedAt
+}
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post) GetID() *uuid.UUID {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post{}
+	}
+	return &t.ID
+}
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post) GetIsModerated() bool {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post{}
+	}
+	return t.IsModerated
+}
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post) GetLink() string {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post{}
+	}
+	return t.Link
+}
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post) GetMetadata() *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_Metadata {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post{}
+	}
+	return t.Metadata
+}
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post) GetModeratedAt() *time.Time {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post{}
+	}
+	return t.ModeratedAt
+}
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post) GetModerationComment() *string {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post{}
+	}
+	return t.ModerationComment
+}
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post) GetOwner() *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post_PostFields_Owner {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post{}
+	}
+	return &t.Owner
+}
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post) GetTitle() string {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post{}
+	}
+	return t.Title
+}
+
+type CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip struct {
+	Post CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post "json:\"post\" graphql:\"post\""
+}
+
+func (t *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip) GetPost() *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip_Post {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip{}
+	}
+	return &t.Post
+}
+
 type UpdatePostMutation_UpdatePost_Post_PostFields_Owner struct {
 	DisplayName string    "json:\"displayName\" graphql:\"displayName\""
 	ID          uuid.UUID "json:\"id\" graphql:\"id\""
@@ -526,6 +805,50 @@ func (t *GetPostsQuery_Posts_PostConnectionFields_Edges) GetNode() *PostFields {
 	return t.Node
 }
 
+type GetPost_Post struct {
+	ID uuid.UUID "json:\"id\" graphql:\"id\""
+}
+
+func (t *GetPost_Post) GetID() *uuid.UUID {
+	if t == nil {
+		t = &GetPost_Post{}
+	}
+	return &t.ID
+}
+
+type GetComments_Comments_Edges_Node struct {
+	ID uuid.UUID "json:\"id\" graphql:\"id\""
+}
+
+func (t *GetComments_Comments_Edges_Node) GetID() *uuid.UUID {
+	if t == nil {
+		t = &GetComments_Comments_Edges_Node{}
+	}
+	return &t.ID
+}
+
+type GetComments_Comments_Edges struct {
+	Node *GetComments_Comments_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
+}
+
+func (t *GetComments_Comments_Edges) GetNode() *GetComments_Comments_Edges_Node {
+	if t == nil {
+		t = &GetComments_Comments_Edges{}
+	}
+	return t.Node
+}
+
+type GetComments_Comments struct {
+	Edges []*GetComments_Comments_Edges "json:\"edges,omitempty\" graphql:\"edges\""
+}
+
+func (t *GetComments_Comments) GetEdges() []*GetComments_Comments_Edges {
+	if t == nil {
+		t = &GetComments_Comments{}
+	}
+	return t.Edges
+}
+
 type GetAllRefreshTokens_RefreshTokens_RefreshTokenConnectionFields_PageInfo struct {
 	EndCursor       *string "json:\"endCursor,omitempty\" graphql:\"endCursor\""
 	HasNextPage     bool    "json:\"hasNextPage\" graphql:\"hasNextPage\""
@@ -581,8 +904,9 @@ func (t *DeleteRefreshToken_DeleteRefreshToken) GetDeletedID() *uuid.UUID {
 }
 
 type Me_Me struct {
-	DisplayName string    "json:\"displayName\" graphql:\"displayName\""
-	ID          uuid.UUID "json:\"id\" graphql:\"id\""
+	DisplayName string                      "json:\"displayName\" graphql:\"displayName\""
+	ID          uuid.UUID                   "json:\"id\" graphql:\"id\""
+	Permissions []rolepermission.Permission "json:\"permissions\" graphql:\"permissions\""
 }
 
 func (t *Me_Me) GetDisplayName() string {
@@ -597,118 +921,1301 @@ func (t *Me_Me) GetID() *uuid.UUID {
 	}
 	return &t.ID
 }
+func (t *Me_Me) GetPermissions() []rolepermission.Permission {
+	if t == nil {
+		t = &Me_Me{}
+	}
+	return t.Permissions
+}
 
-type CreatePostMutation struct {
-	CreatePost CreatePostMutation_CreatePost "json:\"createPost\" graphql:\"createPost\""
+type CreateAwardDefinition_CreateAwardDefinition struct {
+	AwardDefinition *AwardDefinitionFields "json:\"awardDefinition\" graphql:\"awardDefinition\""
 }
 
-func (t *CreatePostMutation) GetCreatePost() *CreatePostMutation_CreatePost {
+func (t *CreateAwardDefinition_CreateAwardDefinition) GetAwardDefinition() *AwardDefinitionFields {
 	if t == nil {
-		t = &CreatePostMutation{}
+		t = &CreateAwardDefinition_CreateAwardDefinition{}
 	}
-	return &t.CreatePost
+	return t.AwardDefinition
 }
 
-type CreatePostWithCategoriesMutation struct {
-	CreatePostWithCategories CreatePostWithCategoriesMutation_CreatePostWithCategories "json:\"createPostWithCategories\" graphql:\"createPostWithCategories\""
+type MeAwards_Me_Awards struct {
+	Award     *AwardDefinitionFields "json:\"award\" graphql:\"award\""
+	GrantedAt time.Time              "json:\"grantedAt\" graphql:\"grantedAt\""
 }
 
-func (t *CreatePostWithCategoriesMutation) GetCreatePostWithCategories() *CreatePostWithCategoriesMutation_CreatePostWithCategories {
+func (t *MeAwards_Me_Awards) GetAward() *AwardDefinitionFields {
 	if t == nil {
-		t = &CreatePostWithCategoriesMutation{}
+		t = &MeAwards_Me_Awards{}
 	}
-	return &t.CreatePostWithCategories
+	return t.Award
+}
+func (t *MeAwards_Me_Awards) GetGrantedAt() *time.Time {
+	if t == nil {
+		t = &MeAwards_Me_Awards{}
+	}
+	return &t.GrantedAt
 }
 
-type UpdatePostMutation struct {
-	UpdatePost UpdatePostMutation_UpdatePost "json:\"updatePost\" graphql:\"updatePost\""
+type MeAwards_Me struct {
+	Awards []*MeAwards_Me_Awards "json:\"awards,omitempty\" graphql:\"awards\""
+	ID     uuid.UUID             "json:\"id\" graphql:\"id\""
 }
 
-func (t *UpdatePostMutation) GetUpdatePost() *UpdatePostMutation_UpdatePost {
+func (t *MeAwards_Me) GetAwards() []*MeAwards_Me_Awards {
 	if t == nil {
-		t = &UpdatePostMutation{}
+		t = &MeAwards_Me{}
 	}
-	return &t.UpdatePost
+	return t.Awards
+}
+func (t *MeAwards_Me) GetID() *uuid.UUID {
+	if t == nil {
+		t = &MeAwards_Me{}
+	}
+	return &t.ID
 }
 
-type DeletePostMutation struct {
-	DeletePost DeletePostMutation_DeletePost "json:\"deletePost\" graphql:\"deletePost\""
+type Leaderboard_Leaderboard_User struct {
+	DisplayName string    "json:\"displayName\" graphql:\"displayName\""
+	ID          uuid.UUID "json:\"id\" graphql:\"id\""
 }
 
-func (t *DeletePostMutation) GetDeletePost() *DeletePostMutation_DeletePost {
+func (t *Leaderboard_Leaderboard_User) GetDisplayName() string {
 	if t == nil {
-		t = &DeletePostMutation{}
+		t = &Leaderboard_Leaderboard_User{}
 	}
-	return &t.DeletePost
+	return t.DisplayName
+}
+func (t *Leaderboard_Leaderboard_User) GetID() *uuid.UUID {
+	if t == nil {
+		t = &Leaderboard_Leaderboard_User{}
+	}
+	return &t.ID
 }
 
-type RestorePostMutation struct {
-	RestorePost *bool "json:\"restorePost,omitempty\" graphql:\"restorePost\""
+type Leaderboard_Leaderboard struct {
+	Rank  int64                        "json:\"rank\" graphql:\"rank\""
+	Score int64                        "json:\"score\" graphql:\"score\""
+	User  Leaderboard_Leaderboard_User "json:\"user\" graphql:\"user\""
 }
 
-func (t *RestorePostMutation) GetRestorePost() *bool {
+func (t *Leaderboard_Leaderboard) GetRank() int64 {
 	if t == nil {
-		t = &RestorePostMutation{}
+		t = &Leaderboard_Leaderboard{}
 	}
-	return t.RestorePost
+	return t.Rank
+}
+func (t *Leaderboard_Leaderboard) GetScore() int64 {
+	if t == nil {
+		t = &Leaderboard_Leaderboard{}
+	}
+	return t.Score
+}
+func (t *Leaderboard_Leaderboard) GetUser() *Leaderboard_Leaderboard_User {
+	if t == nil {
+		t = &Leaderboard_Leaderboard{}
+	}
+	return &t.User
 }
 
-type GetPostsQuery struct {
-	Posts *PostConnectionFields "json:\"posts\" graphql:\"posts\""
+type BlockUser_BlockUser struct {
+	ID uuid.UUID "json:\"id\" graphql:\"id\""
 }
 
-func (t *GetPostsQuery) GetPosts() *PostConnectionFields {
+func (t *BlockUser_BlockUser) GetID() *uuid.UUID {
 	if t == nil {
-		t = &GetPostsQuery{}
+		t = &BlockUser_BlockUser{}
 	}
-	return t.Posts
+	return &t.ID
 }
 
-type GetAllRefreshTokens struct {
-	RefreshTokens *RefreshTokenConnectionFields "json:\"refreshTokens\" graphql:\"refreshTokens\""
+type UnblockUser_UnblockUser struct {
+	ID uuid.UUID "json:\"id\" graphql:\"id\""
 }
 
-func (t *GetAllRefreshTokens) GetRefreshTokens() *RefreshTokenConnectionFields {
+func (t *UnblockUser_UnblockUser) GetID() *uuid.UUID {
 	if t == nil {
-		t = &GetAllRefreshTokens{}
+		t = &UnblockUser_UnblockUser{}
 	}
-	return t.RefreshTokens
+	return &t.ID
 }
 
-type DeleteRefreshToken struct {
-	DeleteRefreshToken DeleteRefreshToken_DeleteRefreshToken "json:\"deleteRefreshToken\" graphql:\"deleteRefreshToken\""
+type MuteUser_MuteUser struct {
+	ID uuid.UUID "json:\"id\" graphql:\"id\""
 }
 
-func (t *DeleteRefreshToken) GetDeleteRefreshToken() *DeleteRefreshToken_DeleteRefreshToken {
+func (t *MuteUser_MuteUser) GetID() *uuid.UUID {
 	if t == nil {
-		t = &DeleteRefreshToken{}
+		t = &MuteUser_MuteUser{}
 	}
-	return &t.DeleteRefreshToken
+	return &t.ID
 }
 
-type Me struct {
-	Me *Me_Me "json:\"me,omitempty\" graphql:\"me\""
+type BlockedUsers_BlockedUsers struct {
+	DisplayName string    "json:\"displayName\" graphql:\"displayName\""
+	ID          uuid.UUID "json:\"id\" graphql:\"id\""
 }
 
-func (t *Me) GetMe() *Me_Me {
+func (t *BlockedUsers_BlockedUsers) GetDisplayName() string {
 	if t == nil {
-		t = &Me{}
+		t = &BlockedUsers_BlockedUsers{}
 	}
-	return t.Me
+	return t.DisplayName
+}
+func (t *BlockedUsers_BlockedUsers) GetID() *uuid.UUID {
+	if t == nil {
+		t = &BlockedUsers_BlockedUsers{}
+	}
+	return &t.ID
 }
 
-const CreatePostMutationDocument = `mutation CreatePostMutation ($input: CreatePostInput!) {
-	createPost(input: $input) {
-		post {
-			... PostFields
-		}
+type CreateComment_CreateComment_Comment struct {
+	Content string    "json:\"content\" graphql:\"content\""
+	ID      uuid.UUID "json:\"id\" graphql:\"id\""
+}
+
+func (t *CreateComment_CreateComment_Comment) GetContent() string {
+	if t == nil {
+		t = &CreateComment_CreateComment_Comment{}
 	}
+	return t.Content
 }
-fragment PostFields on Post {
-	id
-	title
-	link
-	content
+func (t *CreateComment_CreateComment_Comment) GetID() *uuid.UUID {
+	if t == nil {
+		t = &CreateComment_CreateComment_Comment{}
+	}
+	return &t.ID
+}
+
+type CreateComment_CreateComment struct {
+	Comment CreateComment_CreateComment_Comment "json:\"comment\" graphql:\"comment\""
+}
+
+func (t *CreateComment_CreateComment) GetComment() *CreateComment_CreateComment_Comment {
+	if t == nil {
+		t = &CreateComment_CreateComment{}
+	}
+	return &t.Comment
+}
+
+type UpdateComment_UpdateComment_Comment struct {
+	Content string    "json:\"content\" graphql:\"content\""
+	ID      uuid.UUID "json:\"id\" graphql:\"id\""
+}
+
+func (t *UpdateComment_UpdateComment_Comment) GetContent() string {
+	if t == nil {
+		t = &UpdateComment_UpdateComment_Comment{}
+	}
+	return t.Content
+}
+func (t *UpdateComment_UpdateComment_Comment) GetID() *uuid.UUID {
+	if t == nil {
+		t = &UpdateComment_UpdateComment_Comment{}
+	}
+	return &t.ID
+}
+
+type UpdateComment_UpdateComment struct {
+	Comment UpdateComment_UpdateComment_Comment "json:\"comment\" graphql:\"comment\""
+}
+
+func (t *UpdateComment_UpdateComment) GetComment() *UpdateComment_UpdateComment_Comment {
+	if t == nil {
+		t = &UpdateComment_UpdateComment{}
+	}
+	return &t.Comment
+}
+
+type DeleteComment_DeleteComment struct {
+	DeletedID uuid.UUID "json:\"deletedID\" graphql:\"deletedID\""
+}
+
+func (t *DeleteComment_DeleteComment) GetDeletedID() *uuid.UUID {
+	if t == nil {
+		t = &DeleteComment_DeleteComment{}
+	}
+	return &t.DeletedID
+}
+
+type MyIdentities_MyIdentities struct {
+	ID       uuid.UUID         "json:\"id\" graphql:\"id\""
+	Provider identity.Provider "json:\"provider\" graphql:\"provider\""
+	Subject  string            "json:\"subject\" graphql:\"subject\""
+}
+
+func (t *MyIdentities_MyIdentities) GetID() *uuid.UUID {
+	if t == nil {
+		t = &MyIdentities_MyIdentities{}
+	}
+	return &t.ID
+}
+func (t *MyIdentities_MyIdentities) GetProvider() *identity.Provider {
+	if t == nil {
+		t = &MyIdentities_MyIdentities{}
+	}
+	return &t.Provider
+}
+func (t *MyIdentities_MyIdentities) GetSubject() string {
+	if t == nil {
+		t = &MyIdentities_MyIdentities{}
+	}
+	return t.Subject
+}
+
+type MySessions_MySessions_Edges_Node struct {
+	Device        string    "json:\"device\" graphql:\"device\""
+	FamilyID      uuid.UUID "json:\"familyID\" graphql:\"familyID\""
+	ID            uuid.UUID "json:\"id\" graphql:\"id\""
+	IPAddress     *string   "json:\"ipAddress,omitempty\" graphql:\"ipAddress\""
+	IsCurrent     bool      "json:\"isCurrent\" graphql:\"isCurrent\""
+	LastRotatedAt time.Time "json:\"lastRotatedAt\" graphql:\"lastRotatedAt\""
+}
+
+func (t *MySessions_MySessions_Edges_Node) GetDevice() string {
+	if t == nil {
+		t = &MySessions_MySessions_Edges_Node{}
+	}
+	return t.Device
+}
+func (t *MySessions_MySessions_Edges_Node) GetFamilyID() *uuid.UUID {
+	if t == nil {
+		t = &MySessions_MySessions_Edges_Node{}
+	}
+	return &t.FamilyID
+}
+func (t *MySessions_MySessions_Edges_Node) GetID() *uuid.UUID {
+	if t == nil {
+		t = &MySessions_MySessions_Edges_Node{}
+	}
+	return &t.ID
+}
+func (t *MySessions_MySessions_Edges_Node) GetIPAddress() *string {
+	if t == nil {
+		t = &MySessions_MySessions_Edges_Node{}
+	}
+	return t.IPAddress
+}
+func (t *MySessions_MySessions_Edges_Node) GetIsCurrent() bool {
+	if t == nil {
+		t = &MySessions_MySessions_Edges_Node{}
+	}
+	return t.IsCurrent
+}
+func (t *MySessions_MySessions_Edges_Node) GetLastRotatedAt() *time.Time {
+	if t == nil {
+		t = &MySessions_MySessions_Edges_Node{}
+	}
+	return &t.LastRotatedAt
+}
+
+type MySessions_MySessions_Edges struct {
+	Node *MySessions_MySessions_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
+}
+
+func (t *MySessions_MySessions_Edges) GetNode() *MySessions_MySessions_Edges_Node {
+	if t == nil {
+		t = &MySessions_MySessions_Edges{}
+	}
+	return t.Node
+}
+
+type MySessions_MySessions struct {
+	Edges      []*MySessions_MySessions_Edges "json:\"edges,omitempty\" graphql:\"edges\""
+	TotalCount int64                          "json:\"totalCount\" graphql:\"totalCount\""
+}
+
+func (t *MySessions_MySessions) GetEdges() []*MySessions_MySessions_Edges {
+	if t == nil {
+		t = &MySessions_MySessions{}
+	}
+	return t.Edges
+}
+func (t *MySessions_MySessions) GetTotalCount() int64 {
+	if t == nil {
+		t = &MySessions_MySessions{}
+	}
+	return t.TotalCount
+}
+
+type CreateApiKey_CreateAPIKey_APIKey struct {
+	ID         uuid.UUID  "json:\"id\" graphql:\"id\""
+	LastUsedAt *time.Time "json:\"lastUsedAt,omitempty\" graphql:\"lastUsedAt\""
+	Name       string     "json:\"name\" graphql:\"name\""
+	Prefix     string     "json:\"prefix\" graphql:\"prefix\""
+	Scopes     []string   "json:\"scopes\" graphql:\"scopes\""
+}
+
+func (t *CreateApiKey_CreateAPIKey_APIKey) GetID() *uuid.UUID {
+	if t == nil {
+		t = &CreateApiKey_CreateAPIKey_APIKey{}
+	}
+	return &t.ID
+}
+func (t *CreateApiKey_CreateAPIKey_APIKey) GetLastUsedAt() *time.Time {
+	if t == nil {
+		t = &CreateApiKey_CreateAPIKey_APIKey{}
+	}
+	return t.LastUsedAt
+}
+func (t *CreateApiKey_CreateAPIKey_APIKey) GetName() string {
+	if t == nil {
+		t = &CreateApiKey_CreateAPIKey_APIKey{}
+	}
+	return t.Name
+}
+func (t *CreateApiKey_CreateAPIKey_APIKey) GetPrefix() string {
+	if t == nil {
+		t = &CreateApiKey_CreateAPIKey_APIKey{}
+	}
+	return t.Prefix
+}
+func (t *CreateApiKey_CreateAPIKey_APIKey) GetScopes() []string {
+	if t == nil {
+		t = &CreateApiKey_CreateAPIKey_APIKey{}
+	}
+	return t.Scopes
+}
+
+type CreateApiKey_CreateAPIKey struct {
+	APIKey CreateApiKey_CreateAPIKey_APIKey "json:\"apiKey\" graphql:\"apiKey\""
+	Key    string                           "json:\"key\" graphql:\"key\""
+}
+
+func (t *CreateApiKey_CreateAPIKey) GetAPIKey() *CreateApiKey_CreateAPIKey_APIKey {
+	if t == nil {
+		t = &CreateApiKey_CreateAPIKey{}
+	}
+	return &t.APIKey
+}
+func (t *CreateApiKey_CreateAPIKey) GetKey() string {
+	if t == nil {
+		t = &CreateApiKey_CreateAPIKey{}
+	}
+	return t.Key
+}
+
+type RolePermissions_RolePermissions struct {
+	Permissions []rolepermission.Permission "json:\"permissions\" graphql:\"permissions\""
+	Role        user.Role                   "json:\"role\" graphql:\"role\""
+}
+
+func (t *RolePermissions_RolePermissions) GetPermissions() []rolepermission.Permission {
+	if t == nil {
+		t = &RolePermissions_RolePermissions{}
+	}
+	return t.Permissions
+}
+func (t *RolePermissions_RolePermissions) GetRole() *user.Role {
+	if t == nil {
+		t = &RolePermissions_RolePermissions{}
+	}
+	return &t.Role
+}
+
+type SetRolePermissions_SetRolePermissions struct {
+	Permissions []rolepermission.Permission "json:\"permissions\" graphql:\"permissions\""
+	Role        user.Role                   "json:\"role\" graphql:\"role\""
+}
+
+func (t *SetRolePermissions_SetRolePermissions) GetPermissions() []rolepermission.Permission {
+	if t == nil {
+		t = &SetRolePermissions_SetRolePermissions{}
+	}
+	return t.Permissions
+}
+func (t *SetRolePermissions_SetRolePermissions) GetRole() *user.Role {
+	if t == nil {
+		t = &SetRolePermissions_SetRolePermissions{}
+	}
+	return &t.Role
+}
+
+type UpdateUserRole_UpdateUser_User struct {
+	ID   uuid.UUID "json:\"id\" graphql:\"id\""
+	Role user.Role "json:\"role\" graphql:\"role\""
+}
+
+func (t *UpdateUserRole_UpdateUser_User) GetID() *uuid.UUID {
+	if t == nil {
+		t = &UpdateUserRole_UpdateUser_User{}
+	}
+	return &t.ID
+}
+func (t *UpdateUserRole_UpdateUser_User) GetRole() *user.Role {
+	if t == nil {
+		t = &UpdateUserRole_UpdateUser_User{}
+	}
+	return &t.Role
+}
+
+type UpdateUserRole_UpdateUser struct {
+	User UpdateUserRole_UpdateUser_User "json:\"user\" graphql:\"user\""
+}
+
+func (t *UpdateUserRole_UpdateUser) GetUser() *UpdateUserRole_UpdateUser_User {
+	if t == nil {
+		t = &UpdateUserRole_UpdateUser{}
+	}
+	return &t.User
+}
+
+type UserRoleChanges_RoleChanges_Edges_Node struct {
+	Attribute   rolechange.Attribute "json:\"attribute\" graphql:\"attribute\""
+	ChangedByID *string              "json:\"changedByID,omitempty\" graphql:\"changedByID\""
+	ID          uuid.UUID            "json:\"id\" graphql:\"id\""
+	NewValue    string               "json:\"newValue\" graphql:\"newValue\""
+	OldValue    string               "json:\"oldValue\" graphql:\"oldValue\""
+	Source      rolechange.Source    "json:\"source\" graphql:\"source\""
+}
+
+func (t *UserRoleChanges_RoleChanges_Edges_Node) GetAttribute() *rolechange.Attribute {
+	if t == nil {
+		t = &UserRoleChanges_RoleChanges_Edges_Node{}
+	}
+	return &t.Attribute
+}
+func (t *UserRoleChanges_RoleChanges_Edges_Node) GetChangedByID() *string {
+	if t == nil {
+		t = &UserRoleChanges_RoleChanges_Edges_Node{}
+	}
+	return t.ChangedByID
+}
+func (t *UserRoleChanges_RoleChanges_Edges_Node) GetID() *uuid.UUID {
+	if t == nil {
+		t = &UserRoleChanges_RoleChanges_Edges_Node{}
+	}
+	return &t.ID
+}
+func (t *UserRoleChanges_RoleChanges_Edges_Node) GetNewValue() string {
+	if t == nil {
+		t = &UserRoleChanges_RoleChanges_Edges_Node{}
+	}
+	return t.NewValue
+}
+func (t *UserRoleChanges_RoleChanges_Edges_Node) GetOldValue() string {
+	if t == nil {
+		t = &UserRoleChanges_RoleChanges_Edges_Node{}
+	}
+	return t.OldValue
+}
+func (t *UserRoleChanges_RoleChanges_Edges_Node) GetSource() *rolechange.Source {
+	if t == nil {
+		t = &UserRoleChanges_RoleChanges_Edges_Node{}
+	}
+	return &t.Source
+}
+
+type UserRoleChanges_RoleChanges_Edges struct {
+	Node *UserRoleChanges_RoleChanges_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
+}
+
+func (t *UserRoleChanges_RoleChanges_Edges) GetNode() *UserRoleChanges_RoleChanges_Edges_Node {
+	if t == nil {
+		t = &UserRoleChanges_RoleChanges_Edges{}
+	}
+	return t.Node
+}
+
+type UserRoleChanges_RoleChanges struct {
+	Edges []*UserRoleChanges_RoleChanges_Edges "json:\"edges,omitempty\" graphql:\"edges\""
+}
+
+func (t *UserRoleChanges_RoleChanges) GetEdges() []*UserRoleChanges_RoleChanges_Edges {
+	if t == nil {
+		t = &UserRoleChanges_RoleChanges{}
+	}
+	return t.Edges
+}
+
+type CreateSuspension_CreateSuspension_Suspension struct {
+	EndsAt   *time.Time       "json:\"endsAt,omitempty\" graphql:\"endsAt\""
+	ID       uuid.UUID        "json:\"id\" graphql:\"id\""
+	IssuerID *uuid.UUID       "json:\"issuerID,omitempty\" graphql:\"issuerID\""
+	Reason   string           "json:\"reason\" graphql:\"reason\""
+	Scope    suspension.Scope "json:\"scope\" graphql:\"scope\""
+}
+
+func (t *CreateSuspension_CreateSuspension_Suspension) GetEndsAt() *time.Time {
+	if t == nil {
+		t = &CreateSuspension_CreateSuspension_Suspension{}
+	}
+	return t.EndsAt
+}
+func (t *CreateSuspension_CreateSuspension_Suspension) GetID() *uuid.UUID {
+	if t == nil {
+		t = &CreateSuspension_CreateSuspension_Suspension{}
+	}
+	return &t.ID
+}
+func (t *CreateSuspension_CreateSuspension_Suspension) GetIssuerID() *uuid.UUID {
+	if t == nil {
+		t = &CreateSuspension_CreateSuspension_Suspension{}
+	}
+	return t.IssuerID
+}
+func (t *CreateSuspension_CreateSuspension_Suspension) GetReason() string {
+	if t == nil {
+		t = &CreateSuspension_CreateSuspension_Suspension{}
+	}
+	return t.Reason
+}
+func (t *CreateSuspension_CreateSuspension_Suspension) GetScope() *suspension.Scope {
+	if t == nil {
+		t = &CreateSuspension_CreateSuspension_Suspension{}
+	}
+	return &t.Scope
+}
+
+type CreateSuspension_CreateSuspension struct {
+	Suspension CreateSuspension_CreateSuspension_Suspension "json:\"suspension\" graphql:\"suspension\""
+}
+
+func (t *CreateSuspension_CreateSuspension) GetSuspension() *CreateSuspension_CreateSuspension_Suspension {
+	if t == nil {
+		t = &CreateSuspension_CreateSuspension{}
+	}
+	return &t.Suspension
+}
+
+type CreateCategoryRestriction_CreateCategoryRestriction_CategoryRestriction struct {
+	Category           categoryrestriction.Category "json:\"category\" graphql:\"category\""
+	ID                 uuid.UUID                    "json:\"id\" graphql:\"id\""
+	SubscriberOnlyPost bool                         "json:\"subscriberOnlyPost\" graphql:\"subscriberOnlyPost\""
+	SubscriberOnlyView bool                         "json:\"subscriberOnlyView\" graphql:\"subscriberOnlyView\""
+}
+
+func (t *CreateCategoryRestriction_CreateCategoryRestriction_CategoryRestriction) GetCategory() *categoryrestriction.Category {
+	if t == nil {
+		t = &CreateCategoryRestriction_CreateCategoryRestriction_CategoryRestriction{}
+	}
+	return &t.Category
+}
+func (t *CreateCategoryRestriction_CreateCategoryRestriction_CategoryRestriction) GetID() *uuid.UUID {
+	if t == nil {
+		t = &CreateCategoryRestriction_CreateCategoryRestriction_CategoryRestriction{}
+	}
+	return &t.ID
+}
+func (t *CreateCategoryRestriction_CreateCategoryRestriction_CategoryRestriction) GetSubscriberOnlyPost() bool {
+	if t == nil {
+		t = &CreateCategoryRestriction_CreateCategoryRestriction_CategoryRestriction{}
+	}
+	return t.SubscriberOnlyPost
+}
+func (t *CreateCategoryRestriction_CreateCategoryRestriction_CategoryRestriction) GetSubscriberOnlyView() bool {
+	if t == nil {
+		t = &CreateCategoryRestriction_CreateCategoryRestriction_CategoryRestriction{}
+	}
+	return t.SubscriberOnlyView
+}
+
+type CreateCategoryRestriction_CreateCategoryRestriction struct {
+	CategoryRestriction CreateCategoryRestriction_CreateCategoryRestriction_CategoryRestriction "json:\"categoryRestriction\" graphql:\"categoryRestriction\""
+}
+
+func (t *CreateCategoryRestriction_CreateCategoryRestriction) GetCategoryRestriction() *CreateCategoryRestriction_CreateCategoryRestriction_CategoryRestriction {
+	if t == nil {
+		t = &CreateCategoryRestriction_CreateCategoryRestriction{}
+	}
+	return &t.CategoryRestriction
+}
+
+type BroadcasterStreamStatus_StreamStatus struct {
+	GameName     *string    "json:\"gameName,omitempty\" graphql:\"gameName\""
+	Live         bool       "json:\"live\" graphql:\"live\""
+	StartedAt    *time.Time "json:\"startedAt,omitempty\" graphql:\"startedAt\""
+	ThumbnailURL *string    "json:\"thumbnailURL,omitempty\" graphql:\"thumbnailURL\""
+	Title        *string    "json:\"title,omitempty\" graphql:\"title\""
+	ViewerCount  *int64     "json:\"viewerCount,omitempty\" graphql:\"viewerCount\""
+}
+
+func (t *BroadcasterStreamStatus_StreamStatus) GetGameName() *string {
+	if t == nil {
+		t = &BroadcasterStreamStatus_StreamStatus{}
+	}
+	return t.GameName
+}
+func (t *BroadcasterStreamStatus_StreamStatus) GetLive() bool {
+	if t == nil {
+		t = &BroadcasterStreamStatus_StreamStatus{}
+	}
+	return t.Live
+}
+func (t *BroadcasterStreamStatus_StreamStatus) GetStartedAt() *time.Time {
+	if t == nil {
+		t = &BroadcasterStreamStatus_StreamStatus{}
+	}
+	return t.StartedAt
+}
+func (t *BroadcasterStreamStatus_StreamStatus) GetThumbnailURL() *string {
+	if t == nil {
+		t = &BroadcasterStreamStatus_StreamStatus{}
+	}
+	return t.ThumbnailURL
+}
+func (t *BroadcasterStreamStatus_StreamStatus) GetTitle() *string {
+	if t == nil {
+		t = &BroadcasterStreamStatus_StreamStatus{}
+	}
+	return t.Title
+}
+func (t *BroadcasterStreamStatus_StreamStatus) GetViewerCount() *int64 {
+	if t == nil {
+		t = &BroadcasterStreamStatus_StreamStatus{}
+	}
+	return t.ViewerCount
+}
+
+type CreatePostMutation struct {
+	CreatePost CreatePostMutation_CreatePost "json:\"createPost\" graphql:\"createPost\""
+}
+
+func (t *CreatePostMutation) GetCreatePost() *CreatePostMutation_CreatePost {
+	if t == nil {
+		t = &CreatePostMutation{}
+	}
+	return &t.CreatePost
+}
+
+type CreatePostWithCategoriesMutation struct {
+	CreatePostWithCategories CreatePostWithCategoriesMutation_CreatePostWithCategories "json:\"createPostWithCategories\" graphql:\"createPostWithCategories\""
+}
+
+func (t *CreatePostWithCategoriesMutation) GetCreatePostWithCategories() *CreatePostWithCategoriesMutation_CreatePostWithCategories {
+	if t == nil {
+		t = &CreatePostWithCategoriesMutation{}
+	}
+	return &t.CreatePostWithCategories
+}
+
+type CreatePostFromTwitchClipMutation struct {
+	CreatePostFromTwitchClip CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip "json:\"createPostFromTwitchClip\" graphql:\"createPostFromTwitchClip\""
+}
+
+func (t *CreatePostFromTwitchClipMutation) GetCreatePostFromTwitchClip() *CreatePostFromTwitchClipMutation_CreatePostFromTwitchClip {
+	if t == nil {
+		t = &CreatePostFromTwitchClipMutation{}
+	}
+	return &t.CreatePostFromTwitchClip
+}
+
+type UpdatePostMutation struct {
+	UpdatePost UpdatePostMutation_UpdatePost "json:\"updatePost\" graphql:\"updatePost\""
+}
+
+func (t *UpdatePostMutation) GetUpdatePost() *UpdatePostMutation_UpdatePost {
+	if t == nil {
+		t = &UpdatePostMutation{}
+	}
+	return &t.UpdatePost
+}
+
+type DeletePostMutation struct {
+	DeletePost DeletePostMutation_DeletePost "json:\"deletePost\" graphql:\"deletePost\""
+}
+
+func (t *DeletePostMutation) GetDeletePost() *DeletePostMutation_DeletePost {
+	if t == nil {
+		t = &DeletePostMutation{}
+	}
+	return &t.DeletePost
+}
+
+type RestorePostMutation struct {
+	RestorePost *bool "json:\"restorePost,omitempty\" graphql:\"restorePost\""
+}
+
+func (t *RestorePostMutation) GetRestorePost() *bool {
+	if t == nil {
+		t = &RestorePostMutation{}
+	}
+	return t.RestorePost
+}
+
+type GetPostsQuery struct {
+	Posts *PostConnectionFields "json:\"posts\" graphql:\"posts\""
+}
+
+func (t *GetPostsQuery) GetPosts() *PostConnectionFields {
+	if t == nil {
+		t = &GetPostsQuery{}
+	}
+	return t.Posts
+}
+
+type GetPost struct {
+	Post GetPost_Post "json:\"post\" graphql:\"post\""
+}
+
+func (t *GetPost) GetPost() *GetPost_Post {
+	if t == nil {
+		t = &GetPost{}
+	}
+	return &t.Post
+}
+
+type GetComments struct {
+	Comments GetComments_Comments "json:\"comments\" graphql:\"comments\""
+}
+
+func (t *GetComments) GetComments() *GetComments_Comments {
+	if t == nil {
+		t = &GetComments{}
+	}
+	return &t.Comments
+}
+
+type GetAllRefreshTokens struct {
+	RefreshTokens *RefreshTokenConnectionFields "json:\"refreshTokens\" graphql:\"refreshTokens\""
+}
+
+func (t *GetAllRefreshTokens) GetRefreshTokens() *RefreshTokenConnectionFields {
+	if t == nil {
+		t = &GetAllRefreshTokens{}
+	}
+	return t.RefreshTokens
+}
+
+type DeleteRefreshToken struct {
+	DeleteRefreshToken DeleteRefreshToken_DeleteRefreshToken "json:\"deleteRefreshToken\" graphql:\"deleteRefreshToken\""
+}
+
+func (t *DeleteRefreshToken) GetDeleteRefreshToken() *DeleteRefreshToken_DeleteRefreshToken {
+	if t == nil {
+		t = &DeleteRefreshToken{}
+	}
+	return &t.DeleteRefreshToken
+}
+
+type Me struct {
+	Me *Me_Me "json:\"me,omitempty\" graphql:\"me\""
+}
+
+func (t *Me) GetMe() *Me_Me {
+	if t == nil {
+		t = &Me{}
+	}
+	return t.Me
+}
+
+type CreateAwardDefinition struct {
+	CreateAwardDefinition CreateAwardDefinition_CreateAwardDefinition "json:\"createAwardDefinition\" graphql:\"createAwardDefinition\""
+}
+
+func (t *CreateAwardDefinition) GetCreateAwardDefinition() *CreateAwardDefinition_CreateAwardDefinition {
+	if t == nil {
+		t = &CreateAwardDefinition{}
+	}
+	return &t.CreateAwardDefinition
+}
+
+type MeAwards struct {
+	Me *MeAwards_Me "json:\"me,omitempty\" graphql:\"me\""
+}
+
+func (t *MeAwards) GetMe() *MeAwards_Me {
+	if t == nil {
+		t = &MeAwards{}
+	}
+	return t.Me
+}
+
+type Leaderboard struct {
+	Leaderboard []*Leaderboard_Leaderboard "json:\"leaderboard\" graphql:\"leaderboard\""
+}
+
+func (t *Leaderboard) GetLeaderboard() []*Leaderboard_Leaderboard {
+	if t == nil {
+		t = &Leaderboard{}
+	}
+	return t.Leaderboard
+}
+
+type BlockUser struct {
+	BlockUser BlockUser_BlockUser "json:\"blockUser\" graphql:\"blockUser\""
+}
+
+func (t *BlockUser) GetBlockUser() *BlockUser_BlockUser {
+	if t == nil {
+		t = &BlockUser{}
+	}
+	return &t.BlockUser
+}
+
+type UnblockUser struct {
+	UnblockUser UnblockUser_UnblockUser "json:\"unblockUser\" graphql:\"unblockUser\""
+}
+
+func (t *UnblockUser) GetUnblockUser() *UnblockUser_UnblockUser {
+	if t == nil {
+		t = &UnblockUser{}
+	}
+	return &t.UnblockUser
+}
+
+type MuteUser struct {
+	MuteUser MuteUser_MuteUser "json:\"muteUser\" graphql:\"muteUser\""
+}
+
+func (t *MuteUser) GetMuteUser() *MuteUser_MuteUser {
+	if t == nil {
+		t = &MuteUser{}
+	}
+	return &t.MuteUser
+}
+
+type BlockedUsers struct {
+	BlockedUsers []*BlockedUsers_BlockedUsers "json:\"blockedUsers\" graphql:\"blockedUsers\""
+}
+
+func (t *BlockedUsers) GetBlockedUsers() []*BlockedUsers_BlockedUsers {
+	if t == nil {
+		t = &BlockedUsers{}
+	}
+	return t.BlockedUsers
+}
+
+type CreateComment struct {
+	CreateComment CreateComment_CreateComment "json:\"createComment\" graphql:\"createComment\""
+}
+
+func (t *CreateComment) GetCreateComment() *CreateComment_CreateComment {
+	if t == nil {
+		t = &CreateComment{}
+	}
+	return &t.CreateComment
+}
+
+type UpdateComment struct {
+	UpdateComment UpdateComment_UpdateComment "json:\"updateComment\" graphql:\"updateComment\""
+}
+
+func (t *UpdateComment) GetUpdateComment() *UpdateComment_UpdateComment {
+	if t == nil {
+		t = &UpdateComment{}
+	}
+	return &t.UpdateComment
+}
+
+type DeleteComment struct {
+	DeleteComment DeleteComment_DeleteComment "json:\"deleteComment\" graphql:\"deleteComment\""
+}
+
+func (t *DeleteComment) GetDeleteComment() *DeleteComment_DeleteComment {
+	if t == nil {
+		t = &DeleteComment{}
+	}
+	return &t.DeleteComment
+}
+
+type MyIdentities struct {
+	MyIdentities []*MyIdentities_MyIdentities "json:\"myIdentities\" graphql:\"myIdentities\""
+}
+
+func (t *MyIdentities) GetMyIdentities() []*MyIdentities_MyIdentities {
+	if t == nil {
+		t = &MyIdentities{}
+	}
+	return t.MyIdentities
+}
+
+type UnlinkIdentity struct {
+	UnlinkIdentity uuid.UUID "json:\"unlinkIdentity\" graphql:\"unlinkIdentity\""
+}
+
+func (t *UnlinkIdentity) GetUnlinkIdentity() *uuid.UUID {
+	if t == nil {
+		t = &UnlinkIdentity{}
+	}
+	return &t.UnlinkIdentity
+}
+
+type MySessions struct {
+	MySessions MySessions_MySessions "json:\"mySessions\" graphql:\"mySessions\""
+}
+
+func (t *MySessions) GetMySessions() *MySessions_MySessions {
+	if t == nil {
+		t = &MySessions{}
+	}
+	return &t.MySessions
+}
+
+type RevokeSession struct {
+	RevokeSession uuid.UUID "json:\"revokeSession\" graphql:\"revokeSession\""
+}
+
+func (t *RevokeSession) GetRevokeSession() *uuid.UUID {
+	if t == nil {
+		t = &RevokeSession{}
+	}
+	return &t.RevokeSession
+}
+
+type RevokeAllOtherSessions struct {
+	RevokeAllOtherSessions int64 "json:\"revokeAllOtherSessions\" graphql:\"revokeAllOtherSessions\""
+}
+
+func (t *RevokeAllOtherSessions) GetRevokeAllOtherSessions() int64 {
+	if t == nil {
+		t = &RevokeAllOtherSessions{}
+	}
+	return t.RevokeAllOtherSessions
+}
+
+type RevokeUserSessions struct {
+	RevokeUserSessions int64 "json:\"revokeUserSessions\" graphql:\"revokeUserSessions\""
+}
+
+func (t *RevokeUserSessions) GetRevokeUserSessions() int64 {
+	if t == nil {
+		t = &RevokeUserSessions{}
+	}
+	return t.RevokeUserSessions
+}
+
+type CreateAPIKey struct {
+	CreateAPIKey CreateApiKey_CreateAPIKey "json:\"createApiKey\" graphql:\"createApiKey\""
+}
+
+func (t *CreateAPIKey) GetCreateAPIKey() *CreateApiKey_CreateAPIKey {
+	if t == nil {
+		t = &CreateAPIKey{}
+	}
+	return &t.CreateAPIKey
+}
+
+type RolePermissions struct {
+	RolePermissions []*RolePermissions_RolePermissions "json:\"rolePermissions\" graphql:\"rolePermissions\""
+}
+
+func (t *RolePermissions) GetRolePermissions() []*RolePermissions_RolePermissions {
+	if t == nil {
+		t = &RolePermissions{}
+	}
+	return t.RolePermissions
+}
+
+type SetRolePermissions struct {
+	SetRolePermissions SetRolePermissions_SetRolePermissions "json:\"setRolePermissions\" graphql:\"setRolePermissions\""
+}
+
+func (t *SetRolePermissions) GetSetRolePermissions() *SetRolePermissions_SetRolePermissions {
+	if t == nil {
+		t = &SetRolePermissions{}
+	}
+	return &t.SetRolePermissions
+}
+
+type UpdateUserRole struct {
+	UpdateUser UpdateUserRole_UpdateUser "json:\"updateUser\" graphql:\"updateUser\""
+}
+
+func (t *UpdateUserRole) GetUpdateUser() *UpdateUserRole_UpdateUser {
+	if t == nil {
+		t = &UpdateUserRole{}
+	}
+	return &t.UpdateUser
+}
+
+type UserRoleChanges struct {
+	RoleChanges UserRoleChanges_RoleChanges "json:\"roleChanges\" graphql:\"roleChanges\""
+}
+
+func (t *UserRoleChanges) GetRoleChanges() *UserRoleChanges_RoleChanges {
+	if t == nil {
+		t = &UserRoleChanges{}
+	}
+	return &t.RoleChanges
+}
+
+type CreateSuspension struct {
+	CreateSuspension CreateSuspension_CreateSuspension "json:\"createSuspension\" graphql:\"createSuspension\""
+}
+
+func (t *CreateSuspension) GetCreateSuspension() *CreateSuspension_CreateSuspension {
+	if t == nil {
+		t = &CreateSuspension{}
+	}
+	return &t.CreateSuspension
+}
+
+type CreateCategoryRestriction struct {
+	CreateCategoryRestriction CreateCategoryRestriction_CreateCategoryRestriction "json:\"createCategoryRestriction\" graphql:\"createCategoryRestriction\""
+}
+
+func (t *CreateCategoryRestriction) GetCreateCategoryRestriction() *CreateCategoryRestriction_CreateCategoryRestriction {
+	if t == nil {
+		t = &CreateCategoryRestriction{}
+	}
+	return &t.CreateCategoryRestriction
+}
+
+type BroadcasterStreamStatus struct {
+	StreamStatus BroadcasterStreamStatus_StreamStatus "json:\"streamStatus\" graphql:\"streamStatus\""
+}
+
+func (t *BroadcasterStreamStatus) GetStreamStatus() *BroadcasterStreamStatus_StreamStatus {
+	if t == nil {
+		t = &BroadcasterStreamStatus{}
+	}
+	return &t.StreamStatus
+}
+
+const CreatePostMutationDocument = `mutation CreatePostMutation ($input: CreatePostInput!) {
+	createPost(input: $input) {
+		post {
+			... PostFields
+		}
+	}
+}
+fragment PostFields on Post {
+	id
+	title
+	link
+	content
+	moderationComment
+	isModerated
+	moderatedAt
+	deletedAt
+	owner {
+		id
+		displayName
+	}
+	categories {
+		id
+		category
+	}
+}
+`
+
+func (c *Client) CreatePostMutation(ctx context.Context, input CreatePostInput, interceptors ...clientv2.RequestInterceptor) (*CreatePostMutation, error) {
+	vars := map[string]any{
+		"input": input,
+	}
+
+	var res CreatePostMutation
+	if err := c.Client.Post(ctx, "CreatePostMutation", CreatePostMutationDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const CreatePostWithCategoriesMutationDocument = `mutation CreatePostWithCategoriesMutation ($input: CreatePostWithCategoriesInput!) {
+	createPostWithCategories(input: $input) {
+		post {
+			... PostFields
+		}
+	}
+}
+fragment PostFields on Post {
+	id
+	title
+	link
+	content
+	moderationComment
+	isModerated
+	moderatedAt
+	deletedAt
+	owner {
+		id
+		displayName
+	}
+	categories {
+		id
+		category
+	}
+}
+`
+
+func (c *Client) CreatePostWithCategoriesMutation(ctx context.Context, input CreatePostWithCategoriesInput, interceptors ...clientv2.RequestInterceptor) (*CreatePostWithCategoriesMutation, error) {
+	vars := map[string]any{
+		"input": input,
+	}
+
+	var res CreatePostWithCategoriesMutation
+	if err := c.Client.Post(ctx, "CreatePostWithCategoriesMutation", CreatePostWithCategoriesMutationDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const CreatePostFromTwitchClipMutationDocument = `mutation CreatePostFromTwitchClipMutation ($url: String!) {
+	createPostFromTwitchClip(url: $url) {
+		post {
+			... PostFields
+			metadata {
+				service
+				twitchClip {
+					id
+					broadcasterID
+					creatorName
+					thumbnailURL
+					duration
+					videoID
+					vodOffset
+				}
+			}
+		}
+	}
+}
+fragment PostFields on Post {
+	id
+	title
+	link
+	content
+	moderationComment
+	isModerated
+	moderatedAt
+	deletedAt
+	owner {
+		id
+		displayName
+	}
+	categories {
+		id
+		category
+	}
+}
+`
+
+func (c *Client) CreatePostFromTwitchClipMutation(ctx context.Context, url string, interceptors ...clientv2.RequestInterceptor) (*CreatePostFromTwitchClipMutation, error) {
+	vars := map[string]any{
+		"url": url,
+	}
+
+	var res CreatePostFromTwitchClipMutation
+	if err := c.Client.Post(ctx, "CreatePostFromTwitchClipMutation", CreatePostFromTwitchClipMutationDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const UpdatePostMutationDocument = `mutation UpdatePostMutation ($id: ID!, $input: UpdatePostInput!) {
+	updatePost(id: $id, input: $input) {
+		post {
+			... PostFields
+		}
+	}
+}
+fragment PostFields on Post {
+	id
+	title
+	link
+	content
+	moderationComment
+	isModerated
+	moderatedAt
+	deletedAt
+	owner {
+		id
+		displayName
+	}
+	categories {
+		id
+		category
+	}
+}
+`
+
+func (c *Client) UpdatePostMutation(ctx context.Context, id uuid.UUID, input UpdatePostInput, interceptors ...clientv2.RequestInterceptor) (*UpdatePostMutation, error) {
+	vars := map[string]any{
+		"id":    id,
+		"input": input,
+	}
+
+	var res UpdatePostMutation
+	if err := c.Client.Post(ctx, "UpdatePostMutation", UpdatePostMutationDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const DeletePostMutationDocument = `mutation DeletePostMutation ($id: ID!) {
+	deletePost(id: $id) {
+		deletedID
+	}
+}
+`
+
+func (c *Client) DeletePostMutation(ctx context.Context, id uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*DeletePostMutation, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res DeletePostMutation
+	if err := c.Client.Post(ctx, "DeletePostMutation", DeletePostMutationDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const RestorePostMutationDocument = `mutation RestorePostMutation ($id: ID!) {
+	restorePost(id: $id)
+}
+`
+
+func (c *Client) RestorePostMutation(ctx context.Context, id uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*RestorePostMutation, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res RestorePostMutation
+	if err := c.Client.Post(ctx, "RestorePostMutation", RestorePostMutationDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetPostsQueryDocument = `query GetPostsQuery ($first: Int, $after: Cursor, $last: Int, $before: Cursor, $where: PostWhereInput) {
+	posts(first: $first, after: $after, last: $last, before: $before, where: $where) {
+		... PostConnectionFields
+	}
+}
+fragment PostConnectionFields on PostConnection {
+	totalCount
+	edges {
+		node {
+			... PostFields
+		}
+	}
+}
+fragment PostFields on Post {
+	id
+	title
+	link
+	content
 	moderationComment
 	isModerated
 	moderatedAt
@@ -717,20 +2224,426 @@ fragment PostFields on Post {
 		id
 		displayName
 	}
-	categories {
-		id
-		category
+	categories {
+		id
+		category
+	}
+}
+`
+
+func (c *Client) GetPostsQuery(ctx context.Context, first *int64, after *string, last *int64, before *string, where *PostWhereInput, interceptors ...clientv2.RequestInterceptor) (*GetPostsQuery, error) {
+	vars := map[string]any{
+		"first":  first,
+		"after":  after,
+		"last":   last,
+		"before": before,
+		"where":  where,
+	}
+
+	var res GetPostsQuery
+	if err := c.Client.Post(ctx, "GetPostsQuery", GetPostsQueryDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetPostDocument = `query GetPost ($id: ID!) {
+	post(id: $id) {
+		id
+	}
+}
+`
+
+func (c *Client) GetPost(ctx context.Context, id uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*GetPost, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetPost
+	if err := c.Client.Post(ctx, "GetPost", GetPostDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetCommentsDocument = `query GetComments ($where: CommentWhereInput) {
+	comments(where: $where) {
+		edges {
+			node {
+				id
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetComments(ctx context.Context, where *CommentWhereInput, interceptors ...clientv2.RequestInterceptor) (*GetComments, error) {
+	vars := map[string]any{
+		"where": where,
+	}
+
+	var res GetComments
+	if err := c.Client.Post(ctx, "GetComments", GetCommentsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetAllRefreshTokensDocument = `query GetAllRefreshTokens ($first: Int, $after: Cursor, $last: Int, $before: Cursor, $where: RefreshTokenWhereInput) {
+	refreshTokens(first: $first, after: $after, last: $last, before: $before, where: $where) {
+		... RefreshTokenConnectionFields
+	}
+}
+fragment RefreshTokenConnectionFields on RefreshTokenConnection {
+	totalCount
+	pageInfo {
+		startCursor
+		endCursor
+		hasPreviousPage
+		hasNextPage
+	}
+	edges {
+		node {
+			... RefreshTokenFields
+		}
+	}
+}
+fragment RefreshTokenFields on RefreshToken {
+	id
+	createdAt
+	updatedAt
+	expiresAt
+	revoked
+	ipAddress
+	userAgent
+}
+`
+
+func (c *Client) GetAllRefreshTokens(ctx context.Context, first *int64, after *string, last *int64, before *string, where *RefreshTokenWhereInput, interceptors ...clientv2.RequestInterceptor) (*GetAllRefreshTokens, error) {
+	vars := map[string]any{
+		"first":  first,
+		"after":  after,
+		"last":   last,
+		"before": before,
+		"where":  where,
+	}
+
+	var res GetAllRefreshTokens
+	if err := c.Client.Post(ctx, "GetAllRefreshTokens", GetAllRefreshTokensDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const DeleteRefreshTokenDocument = `mutation DeleteRefreshToken ($id: ID!) {
+	deleteRefreshToken(id: $id) {
+		deletedID
+	}
+}
+`
+
+func (c *Client) DeleteRefreshToken(ctx context.Context, id uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*DeleteRefreshToken, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res DeleteRefreshToken
+	if err := c.Client.Post(ctx, "DeleteRefreshToken", DeleteRefreshTokenDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const MeDocument = `query Me {
+	me {
+		id
+		displayName
+		permissions
+	}
+}
+`
+
+func (c *Client) Me(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*Me, error) {
+	vars := map[string]any{}
+
+	var res Me
+	if err := c.Client.Post(ctx, "Me", MeDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const CreateAwardDefinitionDocument = `mutation CreateAwardDefinition ($input: CreateAwardDefinitionInput!) {
+	createAwardDefinition(input: $input) {
+		awardDefinition {
+			... AwardDefinitionFields
+		}
+	}
+}
+fragment AwardDefinitionFields on AwardDefinition {
+	id
+	name
+	icon
+	rule
+	ruleCategory
+	ruleThreshold
+}
+`
+
+func (c *Client) CreateAwardDefinition(ctx context.Context, input CreateAwardDefinitionInput, interceptors ...clientv2.RequestInterceptor) (*CreateAwardDefinition, error) {
+	vars := map[string]any{
+		"input": input,
+	}
+
+	var res CreateAwardDefinition
+	if err := c.Client.Post(ctx, "CreateAwardDefinition", CreateAwardDefinitionDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const MeAwardsDocument = `query MeAwards {
+	me {
+		id
+		awards {
+			grantedAt
+			award {
+				... AwardDefinitionFields
+			}
+		}
+	}
+}
+fragment AwardDefinitionFields on AwardDefinition {
+	id
+	name
+	icon
+	rule
+	ruleCategory
+	ruleThreshold
+}
+`
+
+func (c *Client) MeAwards(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*MeAwards, error) {
+	vars := map[string]any{}
+
+	var res MeAwards
+	if err := c.Client.Post(ctx, "MeAwards", MeAwardsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const LeaderboardDocument = `query Leaderboard ($period: LeaderboardPeriod!, $metric: LeaderboardMetric!, $first: Int) {
+	leaderboard(period: $period, metric: $metric, first: $first) {
+		rank
+		score
+		user {
+			id
+			displayName
+		}
+	}
+}
+`
+
+func (c *Client) Leaderboard(ctx context.Context, period LeaderboardPeriod, metric LeaderboardMetric, first *int64, interceptors ...clientv2.RequestInterceptor) (*Leaderboard, error) {
+	vars := map[string]any{
+		"period": period,
+		"metric": metric,
+		"first":  first,
+	}
+
+	var res Leaderboard
+	if err := c.Client.Post(ctx, "Leaderboard", LeaderboardDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const BlockUserDocument = `mutation BlockUser ($id: ID!) {
+	blockUser(id: $id) {
+		id
+	}
+}
+`
+
+func (c *Client) BlockUser(ctx context.Context, id uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*BlockUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res BlockUser
+	if err := c.Client.Post(ctx, "BlockUser", BlockUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const UnblockUserDocument = `mutation UnblockUser ($id: ID!) {
+	unblockUser(id: $id) {
+		id
+	}
+}
+`
+
+func (c *Client) UnblockUser(ctx context.Context, id uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*UnblockUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res UnblockUser
+	if err := c.Client.Post(ctx, "UnblockUser", UnblockUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const MuteUserDocument = `mutation MuteUser ($id: ID!) {
+	muteUser(id: $id) {
+		id
+	}
+}
+`
+
+func (c *Client) MuteUser(ctx context.Context, id uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*MuteUser, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res MuteUser
+	if err := c.Client.Post(ctx, "MuteUser", MuteUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const BlockedUsersDocument = `query BlockedUsers {
+	blockedUsers {
+		id
+		displayName
+	}
+}
+`
+
+func (c *Client) BlockedUsers(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*BlockedUsers, error) {
+	vars := map[string]any{}
+
+	var res BlockedUsers
+	if err := c.Client.Post(ctx, "BlockedUsers", BlockedUsersDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const CreateCommentDocument = `mutation CreateComment ($input: CreateCommentInput!) {
+	createComment(input: $input) {
+		comment {
+			id
+			content
+		}
+	}
+}
+`
+
+func (c *Client) CreateComment(ctx context.Context, input CreateCommentInput, interceptors ...clientv2.RequestInterceptor) (*CreateComment, error) {
+	vars := map[string]any{
+		"input": input,
+	}
+
+	var res CreateComment
+	if err := c.Client.Post(ctx, "CreateComment", CreateCommentDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const UpdateCommentDocument = `mutation UpdateComment ($id: ID!, $input: UpdateCommentInput!) {
+	updateComment(id: $id, input: $input) {
+		comment {
+			id
+			content
+		}
 	}
 }
 `
 
-func (c *Client) CreatePostMutation(ctx context.Context, input CreatePostInput, interceptors ...clientv2.RequestInterceptor) (*CreatePostMutation, error) {
+func (c *Client) UpdateComment(ctx context.Context, id uuid.UUID, input UpdateCommentInput, interceptors ...clientv2.RequestInterceptor) (*UpdateComment, error) {
 	vars := map[string]any{
+		"id":    id,
 		"input": input,
 	}
 
-	var res CreatePostMutation
-	if err := c.Client.Post(ctx, "CreatePostMutation", CreatePostMutationDocument, &res, vars, interceptors...); err != nil {
+	var res UpdateComment
+	if err := c.Client.Post(ctx, "UpdateComment", UpdateCommentDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -741,40 +2654,66 @@ func (c *Client) CreatePostMutation(ctx context.Context, input CreatePostInput,
 	return &res, nil
 }
 
-const CreatePostWithCategoriesMutationDocument = `mutation CreatePostWithCategoriesMutation ($input: CreatePostWithCategoriesInput!) {
-	createPostWithCategories(input: $input) {
-		post {
-			... PostFields
+const DeleteCommentDocument = `mutation DeleteComment ($id: ID!) {
+	deleteComment(id: $id) {
+		deletedID
+	}
+}
+`
+
+func (c *Client) DeleteComment(ctx context.Context, id uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*DeleteComment, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res DeleteComment
+	if err := c.Client.Post(ctx, "DeleteComment", DeleteCommentDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
 		}
+
+		return nil, err
 	}
+
+	return &res, nil
 }
-fragment PostFields on Post {
-	id
-	title
-	link
-	content
-	moderationComment
-	isModerated
-	moderatedAt
-	deletedAt
-	owner {
+
+const MyIdentitiesDocument = `query MyIdentities {
+	myIdentities {
 		id
-		displayName
+		provider
+		subject
 	}
-	categories {
-		id
-		category
+}
+`
+
+func (c *Client) MyIdentities(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*MyIdentities, error) {
+	vars := map[string]any{}
+
+	var res MyIdentities
+	if err := c.Client.Post(ctx, "MyIdentities", MyIdentitiesDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
 	}
+
+	return &res, nil
+}
+
+const UnlinkIdentityDocument = `mutation UnlinkIdentity ($id: ID!) {
+	unlinkIdentity(id: $id)
 }
 `
 
-func (c *Client) CreatePostWithCategoriesMutation(ctx context.Context, input CreatePostWithCategoriesInput, interceptors ...clientv2.RequestInterceptor) (*CreatePostWithCategoriesMutation, error) {
+func (c *Client) UnlinkIdentity(ctx context.Context, id uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*UnlinkIdentity, error) {
 	vars := map[string]any{
-		"input": input,
+		"id": id,
 	}
 
-	var res CreatePostWithCategoriesMutation
-	if err := c.Client.Post(ctx, "CreatePostWithCategoriesMutation", CreatePostWithCategoriesMutationDocument, &res, vars, interceptors...); err != nil {
+	var res UnlinkIdentity
+	if err := c.Client.Post(ctx, "UnlinkIdentity", UnlinkIdentityDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -785,41 +2724,50 @@ func (c *Client) CreatePostWithCategoriesMutation(ctx context.Context, input Cre
 	return &res, nil
 }
 
-const UpdatePostMutationDocument = `mutation UpdatePostMutation ($id: ID!, $input: UpdatePostInput!) {
-	updatePost(id: $id, input: $input) {
-		post {
-			... PostFields
+const MySessionsDocument = `query MySessions {
+	mySessions(first: 20) {
+		totalCount
+		edges {
+			node {
+				id
+				familyID
+				ipAddress
+				device
+				lastRotatedAt
+				isCurrent
+			}
 		}
 	}
 }
-fragment PostFields on Post {
-	id
-	title
-	link
-	content
-	moderationComment
-	isModerated
-	moderatedAt
-	deletedAt
-	owner {
-		id
-		displayName
-	}
-	categories {
-		id
-		category
+`
+
+func (c *Client) MySessions(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*MySessions, error) {
+	vars := map[string]any{}
+
+	var res MySessions
+	if err := c.Client.Post(ctx, "MySessions", MySessionsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
 	}
+
+	return &res, nil
+}
+
+const RevokeSessionDocument = `mutation RevokeSession ($familyID: ID!) {
+	revokeSession(familyID: $familyID)
 }
 `
 
-func (c *Client) UpdatePostMutation(ctx context.Context, id uuid.UUID, input UpdatePostInput, interceptors ...clientv2.RequestInterceptor) (*UpdatePostMutation, error) {
+func (c *Client) RevokeSession(ctx context.Context, familyID uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*RevokeSession, error) {
 	vars := map[string]any{
-		"id":    id,
-		"input": input,
+		"familyID": familyID,
 	}
 
-	var res UpdatePostMutation
-	if err := c.Client.Post(ctx, "UpdatePostMutation", UpdatePostMutationDocument, &res, vars, interceptors...); err != nil {
+	var res RevokeSession
+	if err := c.Client.Post(ctx, "RevokeSession", RevokeSessionDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -830,20 +2778,38 @@ func (c *Client) UpdatePostMutation(ctx context.Context, id uuid.UUID, input Upd
 	return &res, nil
 }
 
-const DeletePostMutationDocument = `mutation DeletePostMutation ($id: ID!) {
-	deletePost(id: $id) {
-		deletedID
+const RevokeAllOtherSessionsDocument = `mutation RevokeAllOtherSessions {
+	revokeAllOtherSessions
+}
+`
+
+func (c *Client) RevokeAllOtherSessions(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*RevokeAllOtherSessions, error) {
+	vars := map[string]any{}
+
+	var res RevokeAllOtherSessions
+	if err := c.Client.Post(ctx, "RevokeAllOtherSessions", RevokeAllOtherSessionsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
 	}
+
+	return &res, nil
+}
+
+const RevokeUserSessionsDocument = `mutation RevokeUserSessions ($userID: ID!) {
+	revokeUserSessions(userID: $userID)
 }
 `
 
-func (c *Client) DeletePostMutation(ctx context.Context, id uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*DeletePostMutation, error) {
+func (c *Client) RevokeUserSessions(ctx context.Context, userID uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*RevokeUserSessions, error) {
 	vars := map[string]any{
-		"id": id,
+		"userID": userID,
 	}
 
-	var res DeletePostMutation
-	if err := c.Client.Post(ctx, "DeletePostMutation", DeletePostMutationDocument, &res, vars, interceptors...); err != nil {
+	var res RevokeUserSessions
+	if err := c.Client.Post(ctx, "RevokeUserSessions", RevokeUserSessionsDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -854,18 +2820,27 @@ func (c *Client) DeletePostMutation(ctx context.Context, id uuid.UUID, intercept
 	return &res, nil
 }
 
-const RestorePostMutationDocument = `mutation RestorePostMutation ($id: ID!) {
-	restorePost(id: $id)
+const CreateAPIKeyDocument = `mutation CreateApiKey ($input: CreateApiKeyInput!) {
+	createApiKey(input: $input) {
+		key
+		apiKey {
+			id
+			name
+			prefix
+			scopes
+			lastUsedAt
+		}
+	}
 }
 `
 
-func (c *Client) RestorePostMutation(ctx context.Context, id uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*RestorePostMutation, error) {
+func (c *Client) CreateAPIKey(ctx context.Context, input CreateAPIKeyInput, interceptors ...clientv2.RequestInterceptor) (*CreateAPIKey, error) {
 	vars := map[string]any{
-		"id": id,
+		"input": input,
 	}
 
-	var res RestorePostMutation
-	if err := c.Client.Post(ctx, "RestorePostMutation", RestorePostMutationDocument, &res, vars, interceptors...); err != nil {
+	var res CreateAPIKey
+	if err := c.Client.Post(ctx, "CreateApiKey", CreateAPIKeyDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -876,50 +2851,73 @@ func (c *Client) RestorePostMutation(ctx context.Context, id uuid.UUID, intercep
 	return &res, nil
 }
 
-const GetPostsQueryDocument = `query GetPostsQuery ($first: Int, $after: Cursor, $last: Int, $before: Cursor, $where: PostWhereInput) {
-	posts(first: $first, after: $after, last: $last, before: $before, where: $where) {
-		... PostConnectionFields
+const RolePermissionsDocument = `query RolePermissions {
+	rolePermissions {
+		role
+		permissions
 	}
 }
-fragment PostConnectionFields on PostConnection {
-	totalCount
-	edges {
-		node {
-			... PostFields
+`
+
+func (c *Client) RolePermissions(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*RolePermissions, error) {
+	vars := map[string]any{}
+
+	var res RolePermissions
+	if err := c.Client.Post(ctx, "RolePermissions", RolePermissionsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
 		}
+
+		return nil, err
 	}
+
+	return &res, nil
 }
-fragment PostFields on Post {
-	id
-	title
-	link
-	content
-	moderationComment
-	isModerated
-	moderatedAt
-	deletedAt
-	owner {
-		id
-		displayName
+
+const SetRolePermissionsDocument = `mutation SetRolePermissions ($role: UserRole!, $permissions: [Permission!]!) {
+	setRolePermissions(role: $role, permissions: $permissions) {
+		role
+		permissions
 	}
-	categories {
-		id
-		category
+}
+`
+
+func (c *Client) SetRolePermissions(ctx context.Context, role user.Role, permissions []rolepermission.Permission, interceptors ...clientv2.RequestInterceptor) (*SetRolePermissions, error) {
+	vars := map[string]any{
+		"role":        role,
+		"permissions": permissions,
+	}
+
+	var res SetRolePermissions
+	if err := c.Client.Post(ctx, "SetRolePermissions", SetRolePermissionsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const UpdateUserRoleDocument = `mutation UpdateUserRole ($id: ID!, $role: UserRole!) {
+	updateUser(id: $id, input: {role:$role}) {
+		user {
+			id
+			role
+		}
 	}
 }
 `
 
-func (c *Client) GetPostsQuery(ctx context.Context, first *int64, after *string, last *int64, before *string, where *PostWhereInput, interceptors ...clientv2.RequestInterceptor) (*GetPostsQuery, error) {
+func (c *Client) UpdateUserRole(ctx context.Context, id uuid.UUID, role user.Role, interceptors ...clientv2.RequestInterceptor) (*UpdateUserRole, error) {
 	vars := map[string]any{
-		"first":  first,
-		"after":  after,
-		"last":   last,
-		"before": before,
-		"where":  where,
+		"id":   id,
+		"role": role,
 	}
 
-	var res GetPostsQuery
-	if err := c.Client.Post(ctx, "GetPostsQuery", GetPostsQueryDocument, &res, vars, interceptors...); err != nil {
+	var res UpdateUserRole
+	if err := c.Client.Post(ctx, "UpdateUserRole", UpdateUserRoleDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -930,47 +2928,59 @@ func (c *Client) GetPostsQuery(ctx context.Context, first *int64, after *string,
 	return &res, nil
 }
 
-const GetAllRefreshTokensDocument = `query GetAllRefreshTokens ($first: Int, $after: Cursor, $last: Int, $before: Cursor, $where: RefreshTokenWhereInput) {
-	refreshTokens(first: $first, after: $after, last: $last, before: $before, where: $where) {
-		... RefreshTokenConnectionFields
+const UserRoleChangesDocument = `query UserRoleChanges ($where: RoleChangeWhereInput) {
+	roleChanges(first: 50, where: $where) {
+		edges {
+			node {
+				id
+				attribute
+				oldValue
+				newValue
+				source
+				changedByID
+			}
+		}
 	}
 }
-fragment RefreshTokenConnectionFields on RefreshTokenConnection {
-	totalCount
-	pageInfo {
-		startCursor
-		endCursor
-		hasPreviousPage
-		hasNextPage
+`
+
+func (c *Client) UserRoleChanges(ctx context.Context, where *RoleChangeWhereInput, interceptors ...clientv2.RequestInterceptor) (*UserRoleChanges, error) {
+	vars := map[string]any{
+		"where": where,
 	}
-	edges {
-		node {
-			... RefreshTokenFields
+
+	var res UserRoleChanges
+	if err := c.Client.Post(ctx, "UserRoleChanges", UserRoleChangesDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
 		}
+
+		return nil, err
 	}
+
+	return &res, nil
 }
-fragment RefreshTokenFields on RefreshToken {
-	id
-	createdAt
-	updatedAt
-	expiresAt
-	revoked
-	ipAddress
-	userAgent
+
+const CreateSuspensionDocument = `mutation CreateSuspension ($input: CreateSuspensionInput!) {
+	createSuspension(input: $input) {
+		suspension {
+			id
+			scope
+			reason
+			endsAt
+			issuerID
+		}
+	}
 }
 `
 
-func (c *Client) GetAllRefreshTokens(ctx context.Context, first *int64, after *string, last *int64, before *string, where *RefreshTokenWhereInput, interceptors ...clientv2.RequestInterceptor) (*GetAllRefreshTokens, error) {
+func (c *Client) CreateSuspension(ctx context.Context, input CreateSuspensionInput, interceptors ...clientv2.RequestInterceptor) (*CreateSuspension, error) {
 	vars := map[string]any{
-		"first":  first,
-		"after":  after,
-		"last":   last,
-		"before": before,
-		"where":  where,
+		"input": input,
 	}
 
-	var res GetAllRefreshTokens
-	if err := c.Client.Post(ctx, "GetAllRefreshTokens", GetAllRefreshTokensDocument, &res, vars, interceptors...); err != nil {
+	var res CreateSuspension
+	if err := c.Client.Post(ctx, "CreateSuspension", CreateSuspensionDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -981,20 +2991,25 @@ func (c *Client) GetAllRefreshTokens(ctx context.Context, first *int64, after *s
 	return &res, nil
 }
 
-const DeleteRefreshTokenDocument = `mutation DeleteRefreshToken ($id: ID!) {
-	deleteRefreshToken(id: $id) {
-		deletedID
+const CreateCategoryRestrictionDocument = `mutation CreateCategoryRestriction ($input: CreateCategoryRestrictionInput!) {
+	createCategoryRestriction(input: $input) {
+		categoryRestriction {
+			id
+			category
+			subscriberOnlyPost
+			subscriberOnlyView
+		}
 	}
 }
 `
 
-func (c *Client) DeleteRefreshToken(ctx context.Context, id uuid.UUID, interceptors ...clientv2.RequestInterceptor) (*DeleteRefreshToken, error) {
+func (c *Client) CreateCategoryRestriction(ctx context.Context, input CreateCategoryRestrictionInput, interceptors ...clientv2.RequestInterceptor) (*CreateCategoryRestriction, error) {
 	vars := map[string]any{
-		"id": id,
+		"input": input,
 	}
 
-	var res DeleteRefreshToken
-	if err := c.Client.Post(ctx, "DeleteRefreshToken", DeleteRefreshTokenDocument, &res, vars, interceptors...); err != nil {
+	var res CreateCategoryRestriction
+	if err := c.Client.Post(ctx, "CreateCategoryRestriction", CreateCategoryRestrictionDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -1005,19 +3020,23 @@ func (c *Client) DeleteRefreshToken(ctx context.Context, id uuid.UUID, intercept
 	return &res, nil
 }
 
-const MeDocument = `query Me {
-	me {
-		id
-		displayName
+const BroadcasterStreamStatusDocument = `query BroadcasterStreamStatus {
+	streamStatus {
+		live
+		title
+		gameName
+		viewerCount
+		startedAt
+		thumbnailURL
 	}
 }
 `
 
-func (c *Client) Me(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*Me, error) {
+func (c *Client) BroadcasterStreamStatus(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*BroadcasterStreamStatus, error) {
 	vars := map[string]any{}
 
-	var res Me
-	if err := c.Client.Post(ctx, "Me", MeDocument, &res, vars, interceptors...); err != nil {
+	var res BroadcasterStreamStatus
+	if err := c.Client.Post(ctx, "BroadcasterStreamStatus", BroadcasterStreamStatusDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -1031,11 +3050,38 @@ func (c *Client) Me(ctx context.Context, interceptors ...clientv2.RequestInterce
 var DocumentOperationNames = map[string]string{
 	CreatePostMutationDocument:               "CreatePostMutation",
 	CreatePostWithCategoriesMutationDocument: "CreatePostWithCategoriesMutation",
+	CreatePostFromTwitchClipMutationDocument: "CreatePostFromTwitchClipMutation",
 	UpdatePostMutationDocument:               "UpdatePostMutation",
 	DeletePostMutationDocument:               "DeletePostMutation",
 	RestorePostMutationDocument:              "RestorePostMutation",
 	GetPostsQueryDocument:                    "GetPostsQuery",
+	GetPostDocument:                          "GetPost",
+	GetCommentsDocument:                      "GetComments",
 	GetAllRefreshTokensDocument:              "GetAllRefreshTokens",
 	DeleteRefreshTokenDocument:               "DeleteRefreshToken",
 	MeDocument:                               "Me",
+	CreateAwardDefinitionDocument:            "CreateAwardDefinition",
+	MeAwardsDocument:                         "MeAwards",
+	LeaderboardDocument:                      "Leaderboard",
+	BlockUserDocument:                        "BlockUser",
+	UnblockUserDocument:                      "UnblockUser",
+	MuteUserDocument:                         "MuteUser",
+	BlockedUsersDocument:                     "BlockedUsers",
+	CreateCommentDocument:                    "CreateComment",
+	UpdateCommentDocument:                    "UpdateComment",
+	DeleteCommentDocument:                    "DeleteComment",
+	MyIdentitiesDocument:                     "MyIdentities",
+	UnlinkIdentityDocument:                   "UnlinkIdentity",
+	MySessionsDocument:                       "MySessions",
+	RevokeSessionDocument:                    "RevokeSession",
+	RevokeAllOtherSessionsDocument:           "RevokeAllOtherSessions",
+	RevokeUserSessionsDocument:               "RevokeUserSessions",
+	CreateAPIKeyDocument:                     "CreateApiKey",
+	RolePermissionsDocument:                  "RolePermissions",
+	SetRolePermissionsDocument:               "SetRolePermissions",
+	UpdateUserRoleDocument:                   "UpdateUserRole",
+	UserRoleChangesDocument:                  "UserRoleChanges",
+	CreateSuspensionDocument:                 "CreateSuspension",
+	CreateCategoryRestrictionDocument:        "CreateCategoryRestriction",
+	BroadcasterStreamStatusDocument:          "BroadcasterStreamStatus",
 }
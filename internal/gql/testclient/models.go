@@ -10,7 +10,13 @@ import (
 	"time"
 
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/awarddefinition"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/categoryrestriction"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/postcategory"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/suspension"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
 	"github.com/google/uuid"
 )
@@ -20,12 +26,15 @@ type SearchResult interface {
 }
 
 type APIKey struct {
-	ID        uuid.UUID `json:"id"`
-	UpdatedAt time.Time `json:"updatedAt"`
-	CreatedAt time.Time `json:"createdAt"`
-	APIKey    string    `json:"apiKey"`
-	ExpiresOn time.Time `json:"expiresOn"`
-	Owner     *User     `json:"owner"`
+	ID         uuid.UUID  `json:"id"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresOn  time.Time  `json:"expiresOn"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty,omitzero"`
+	Owner      *User      `json:"owner"`
 }
 
 func (APIKey) IsNode() {}
@@ -50,6 +59,8 @@ type APIKeyConnection struct {
 type APIKeyCreatePayload struct {
 	// Created apiKey
 	APIKey *APIKey `json:"apiKey"`
+	// The API key, which is only returned on creation
+	Key string `json:"key"`
 }
 
 // Return response for deleteApiKey mutation
@@ -113,20 +124,34 @@ type APIKeyWhereInput struct {
 	CreatedAtGte   *time.Time   `json:"createdAtGTE,omitempty"`
 	CreatedAtLt    *time.Time   `json:"createdAtLT,omitempty"`
 	CreatedAtLte   *time.Time   `json:"createdAtLTE,omitempty"`
-	// api_key field predicates
-	APIKey             *string  `json:"apiKey,omitempty"`
-	APIKeyNeq          *string  `json:"apiKeyNEQ,omitempty"`
-	APIKeyIn           []string `json:"apiKeyIn,omitempty"`
-	APIKeyNotIn        []string `json:"apiKeyNotIn,omitempty"`
-	APIKeyGt           *string  `json:"apiKeyGT,omitempty"`
-	APIKeyGte          *string  `json:"apiKeyGTE,omitempty"`
-	APIKeyLt           *string  `json:"apiKeyLT,omitempty"`
-	APIKeyLte          *string  `json:"apiKeyLTE,omitempty"`
-	APIKeyContains     *string  `json:"apiKeyContains,omitempty"`
-	APIKeyHasPrefix    *string  `json:"apiKeyHasPrefix,omitempty"`
-	APIKeyHasSuffix    *string  `json:"apiKeyHasSuffix,omitempty"`
-	APIKeyEqualFold    *string  `json:"apiKeyEqualFold,omitempty"`
-	APIKeyContainsFold *string  `json:"apiKeyContainsFold,omitempty"`
+	// name field predicates
+	Name             *string  `json:"name,omitempty"`
+	NameNeq          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGt           *string  `json:"nameGT,omitempty"`
+	NameGte          *string  `json:"nameGTE,omitempty"`
+	NameLt           *string  `json:"nameLT,omitempty"`
+	NameLte          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+	// prefix field predicates
+	Prefix             *string  `json:"prefix,omitempty"`
+	PrefixNeq          *string  `json:"prefixNEQ,omitempty"`
+	PrefixIn           []string `json:"prefixIn,omitempty"`
+	PrefixNotIn        []string `json:"prefixNotIn,omitempty"`
+	PrefixGt           *string  `json:"prefixGT,omitempty"`
+	PrefixGte          *string  `json:"prefixGTE,omitempty"`
+	PrefixLt           *string  `json:"prefixLT,omitempty"`
+	PrefixLte          *string  `json:"prefixLTE,omitempty"`
+	PrefixContains     *string  `json:"prefixContains,omitempty"`
+	PrefixHasPrefix    *string  `json:"prefixHasPrefix,omitempty"`
+	PrefixHasSuffix    *string  `json:"prefixHasSuffix,omitempty"`
+	PrefixEqualFold    *string  `json:"prefixEqualFold,omitempty"`
+	PrefixContainsFold *string  `json:"prefixContainsFold,omitempty"`
 	// expires_on field predicates
 	ExpiresOn      *time.Time   `json:"expiresOn,omitempty"`
 	ExpiresOnNeq   *time.Time   `json:"expiresOnNEQ,omitempty"`
@@ -136,11 +161,301 @@ type APIKeyWhereInput struct {
 	ExpiresOnGte   *time.Time   `json:"expiresOnGTE,omitempty"`
 	ExpiresOnLt    *time.Time   `json:"expiresOnLT,omitempty"`
 	ExpiresOnLte   *time.Time   `json:"expiresOnLTE,omitempty"`
+	// last_used_at field predicates
+	LastUsedAt       *time.Time   `json:"lastUsedAt,omitempty"`
+	LastUsedAtNeq    *time.Time   `json:"lastUsedAtNEQ,omitempty"`
+	LastUsedAtIn     []*time.Time `json:"lastUsedAtIn,omitempty"`
+	LastUsedAtNotIn  []*time.Time `json:"lastUsedAtNotIn,omitempty"`
+	LastUsedAtGt     *time.Time   `json:"lastUsedAtGT,omitempty"`
+	LastUsedAtGte    *time.Time   `json:"lastUsedAtGTE,omitempty"`
+	LastUsedAtLt     *time.Time   `json:"lastUsedAtLT,omitempty"`
+	LastUsedAtLte    *time.Time   `json:"lastUsedAtLTE,omitempty"`
+	LastUsedAtIsNil  *bool        `json:"lastUsedAtIsNil,omitempty"`
+	LastUsedAtNotNil *bool        `json:"lastUsedAtNotNil,omitempty"`
 	// owner edge predicates
 	HasOwner     *bool             `json:"hasOwner,omitempty"`
 	HasOwnerWith []*UserWhereInput `json:"hasOwnerWith,omitempty"`
 }
 
+type AwardDefinition struct {
+	ID          uuid.UUID `json:"id"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+	CreatedAt   time.Time `json:"createdAt"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description,omitempty,omitzero"`
+	// emoji or image URL shown alongside the award
+	Icon string `json:"icon"`
+	// the rule evaluated to grant the award
+	Rule awarddefinition.Rule `json:"rule"`
+	// the post category counted by POSTS_IN_CATEGORY rules
+	RuleCategory *awarddefinition.RuleCategory `json:"ruleCategory,omitempty,omitzero"`
+	// the minimum count (or days for MEMBERSHIP_DAYS) required to grant the award
+	RuleThreshold int64        `json:"ruleThreshold"`
+	Grants        []*UserAward `json:"grants,omitempty,omitzero"`
+}
+
+func (AwardDefinition) IsNode() {}
+
+// Return response for createBulkAwardDefinition mutation
+type AwardDefinitionBulkCreatePayload struct {
+	// Created awardDefinitions
+	AwardDefinitions []*AwardDefinition `json:"awardDefinitions,omitempty,omitzero"`
+}
+
+// A connection to a list of items.
+type AwardDefinitionConnection struct {
+	// A list of edges.
+	Edges []*AwardDefinitionEdge `json:"edges,omitempty,omitzero"`
+	// Information to aid in pagination.
+	PageInfo *PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+}
+
+// Return response for createAwardDefinition mutation
+type AwardDefinitionCreatePayload struct {
+	// Created awardDefinition
+	AwardDefinition *AwardDefinition `json:"awardDefinition"`
+}
+
+// Return response for deleteAwardDefinition mutation
+type AwardDefinitionDeletePayload struct {
+	// Deleted awardDefinition ID
+	DeletedID uuid.UUID `json:"deletedID"`
+}
+
+// An edge in a connection.
+type AwardDefinitionEdge struct {
+	// The item at the end of the edge.
+	Node *AwardDefinition `json:"node,omitempty,omitzero"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+}
+
+// Ordering options for AwardDefinition connections
+type AwardDefinitionOrder struct {
+	// The ordering direction.
+	Direction OrderDirection `json:"direction"`
+	// The field by which to order AwardDefinitions.
+	Field AwardDefinitionOrderField `json:"field"`
+}
+
+// Return response for updateAwardDefinition mutation
+type AwardDefinitionUpdatePayload struct {
+	// Updated awardDefinition
+	AwardDefinition *AwardDefinition `json:"awardDefinition"`
+}
+
+// AwardDefinitionWhereInput is used for filtering AwardDefinition objects.
+// Input was generated by ent.
+type AwardDefinitionWhereInput struct {
+	Not *AwardDefinitionWhereInput   `json:"not,omitempty"`
+	And []*AwardDefinitionWhereInput `json:"and,omitempty"`
+	Or  []*AwardDefinitionWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNeq   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGt    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGte   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLt    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLte   *uuid.UUID  `json:"idLTE,omitempty"`
+	// updated_at field predicates
+	UpdatedAt      *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq   *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt    *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte   *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt    *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte   *time.Time   `json:"updatedAtLTE,omitempty"`
+	// created_at field predicates
+	CreatedAt      *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq   *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt    *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte   *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt    *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte   *time.Time   `json:"createdAtLTE,omitempty"`
+	// name field predicates
+	Name             *string  `json:"name,omitempty"`
+	NameNeq          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGt           *string  `json:"nameGT,omitempty"`
+	NameGte          *string  `json:"nameGTE,omitempty"`
+	NameLt           *string  `json:"nameLT,omitempty"`
+	NameLte          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+	// description field predicates
+	Description             *string  `json:"description,omitempty"`
+	DescriptionNeq          *string  `json:"descriptionNEQ,omitempty"`
+	DescriptionIn           []string `json:"descriptionIn,omitempty"`
+	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
+	DescriptionGt           *string  `json:"descriptionGT,omitempty"`
+	DescriptionGte          *string  `json:"descriptionGTE,omitempty"`
+	DescriptionLt           *string  `json:"descriptionLT,omitempty"`
+	DescriptionLte          *string  `json:"descriptionLTE,omitempty"`
+	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
+	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
+	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
+	DescriptionIsNil        *bool    `json:"descriptionIsNil,omitempty"`
+	DescriptionNotNil       *bool    `json:"descriptionNotNil,omitempty"`
+	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
+	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
+	// icon field predicates
+	Icon             *string  `json:"icon,omitempty"`
+	IconNeq          *string  `json:"iconNEQ,omitempty"`
+	IconIn           []string `json:"iconIn,omitempty"`
+	IconNotIn        []string `json:"iconNotIn,omitempty"`
+	IconGt           *string  `json:"iconGT,omitempty"`
+	IconGte          *string  `json:"iconGTE,omitempty"`
+	IconLt           *string  `json:"iconLT,omitempty"`
+	IconLte          *string  `json:"iconLTE,omitempty"`
+	IconContains     *string  `json:"iconContains,omitempty"`
+	IconHasPrefix    *string  `json:"iconHasPrefix,omitempty"`
+	IconHasSuffix    *string  `json:"iconHasSuffix,omitempty"`
+	IconEqualFold    *string  `json:"iconEqualFold,omitempty"`
+	IconContainsFold *string  `json:"iconContainsFold,omitempty"`
+	// rule field predicates
+	Rule      *awarddefinition.Rule  `json:"rule,omitempty"`
+	RuleNeq   *awarddefinition.Rule  `json:"ruleNEQ,omitempty"`
+	RuleIn    []awarddefinition.Rule `json:"ruleIn,omitempty"`
+	RuleNotIn []awarddefinition.Rule `json:"ruleNotIn,omitempty"`
+	// rule_category field predicates
+	RuleCategory       *awarddefinition.RuleCategory  `json:"ruleCategory,omitempty"`
+	RuleCategoryNeq    *awarddefinition.RuleCategory  `json:"ruleCategoryNEQ,omitempty"`
+	RuleCategoryIn     []awarddefinition.RuleCategory `json:"ruleCategoryIn,omitempty"`
+	RuleCategoryNotIn  []awarddefinition.RuleCategory `json:"ruleCategoryNotIn,omitempty"`
+	RuleCategoryIsNil  *bool                          `json:"ruleCategoryIsNil,omitempty"`
+	RuleCategoryNotNil *bool                          `json:"ruleCategoryNotNil,omitempty"`
+	// rule_threshold field predicates
+	RuleThreshold      *int64  `json:"ruleThreshold,omitempty"`
+	RuleThresholdNeq   *int64  `json:"ruleThresholdNEQ,omitempty"`
+	RuleThresholdIn    []int64 `json:"ruleThresholdIn,omitempty"`
+	RuleThresholdNotIn []int64 `json:"ruleThresholdNotIn,omitempty"`
+	RuleThresholdGt    *int64  `json:"ruleThresholdGT,omitempty"`
+	RuleThresholdGte   *int64  `json:"ruleThresholdGTE,omitempty"`
+	RuleThresholdLt    *int64  `json:"ruleThresholdLT,omitempty"`
+	RuleThresholdLte   *int64  `json:"ruleThresholdLTE,omitempty"`
+	// grants edge predicates
+	HasGrants     *bool                  `json:"hasGrants,omitempty"`
+	HasGrantsWith []*UserAwardWhereInput `json:"hasGrantsWith,omitempty"`
+}
+
+type CategoryRestriction struct {
+	ID                 uuid.UUID                    `json:"id"`
+	UpdatedAt          time.Time                    `json:"updatedAt"`
+	CreatedAt          time.Time                    `json:"createdAt"`
+	Category           categoryrestriction.Category `json:"category"`
+	SubscriberOnlyPost bool                         `json:"subscriberOnlyPost"`
+	SubscriberOnlyView bool                         `json:"subscriberOnlyView"`
+}
+
+func (CategoryRestriction) IsNode() {}
+
+// Return response for createBulkCategoryRestriction mutation
+type CategoryRestrictionBulkCreatePayload struct {
+	// Created categoryRestrictions
+	CategoryRestrictions []*CategoryRestriction `json:"categoryRestrictions,omitempty,omitzero"`
+}
+
+// A connection to a list of items.
+type CategoryRestrictionConnection struct {
+	// A list of edges.
+	Edges []*CategoryRestrictionEdge `json:"edges,omitempty,omitzero"`
+	// Information to aid in pagination.
+	PageInfo *PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+}
+
+// Return response for createCategoryRestriction mutation
+type CategoryRestrictionCreatePayload struct {
+	// Created categoryRestriction
+	CategoryRestriction *CategoryRestriction `json:"categoryRestriction"`
+}
+
+// Return response for deleteCategoryRestriction mutation
+type CategoryRestrictionDeletePayload struct {
+	// Deleted categoryRestriction ID
+	DeletedID uuid.UUID `json:"deletedID"`
+}
+
+// An edge in a connection.
+type CategoryRestrictionEdge struct {
+	// The item at the end of the edge.
+	Node *CategoryRestriction `json:"node,omitempty,omitzero"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+}
+
+// Ordering options for CategoryRestriction connections
+type CategoryRestrictionOrder struct {
+	// The ordering direction.
+	Direction OrderDirection `json:"direction"`
+	// The field by which to order CategoryRestrictions.
+	Field CategoryRestrictionOrderField `json:"field"`
+}
+
+// Return response for updateCategoryRestriction mutation
+type CategoryRestrictionUpdatePayload struct {
+	// Updated categoryRestriction
+	CategoryRestriction *CategoryRestriction `json:"categoryRestriction"`
+}
+
+// CategoryRestrictionWhereInput is used for filtering CategoryRestriction objects.
+// Input was generated by ent.
+type CategoryRestrictionWhereInput struct {
+	Not *CategoryRestrictionWhereInput   `json:"not,omitempty"`
+	And []*CategoryRestrictionWhereInput `json:"and,omitempty"`
+	Or  []*CategoryRestrictionWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNeq   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGt    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGte   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLt    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLte   *uuid.UUID  `json:"idLTE,omitempty"`
+	// updated_at field predicates
+	UpdatedAt      *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq   *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt    *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte   *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt    *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte   *time.Time   `json:"updatedAtLTE,omitempty"`
+	// created_at field predicates
+	CreatedAt      *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq   *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt    *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte   *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt    *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte   *time.Time   `json:"createdAtLTE,omitempty"`
+	// category field predicates
+	Category      *categoryrestriction.Category  `json:"category,omitempty"`
+	CategoryNeq   *categoryrestriction.Category  `json:"categoryNEQ,omitempty"`
+	CategoryIn    []categoryrestriction.Category `json:"categoryIn,omitempty"`
+	CategoryNotIn []categoryrestriction.Category `json:"categoryNotIn,omitempty"`
+	// subscriber_only_post field predicates
+	SubscriberOnlyPost    *bool `json:"subscriberOnlyPost,omitempty"`
+	SubscriberOnlyPostNeq *bool `json:"subscriberOnlyPostNEQ,omitempty"`
+	// subscriber_only_view field predicates
+	SubscriberOnlyView    *bool `json:"subscriberOnlyView,omitempty"`
+	SubscriberOnlyViewNeq *bool `json:"subscriberOnlyViewNEQ,omitempty"`
+}
+
 type Comment struct {
 	ID        uuid.UUID  `json:"id"`
 	UpdatedAt time.Time  `json:"updatedAt"`
@@ -293,9 +608,34 @@ type CommentWhereInput struct {
 // CreateApiKeyInput is used for create ApiKey object.
 // Input was generated by ent.
 type CreateAPIKeyInput struct {
+	Name      string    `json:"name"`
+	Scopes    []string  `json:"scopes,omitempty"`
 	ExpiresOn time.Time `json:"expiresOn"`
 }
 
+// CreateAwardDefinitionInput is used for create AwardDefinition object.
+// Input was generated by ent.
+type CreateAwardDefinitionInput struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	// emoji or image URL shown alongside the award
+	Icon string `json:"icon"`
+	// the rule evaluated to grant the award
+	Rule awarddefinition.Rule `json:"rule"`
+	// the post category counted by POSTS_IN_CATEGORY rules
+	RuleCategory *awarddefinition.RuleCategory `json:"ruleCategory,omitempty"`
+	// the minimum count (or days for MEMBERSHIP_DAYS) required to grant the award
+	RuleThreshold *int64 `json:"ruleThreshold,omitempty"`
+}
+
+// CreateCategoryRestrictionInput is used for create CategoryRestriction object.
+// Input was generated by ent.
+type CreateCategoryRestrictionInput struct {
+	Category           categoryrestriction.Category `json:"category"`
+	SubscriberOnlyPost *bool                        `json:"subscriberOnlyPost,omitempty"`
+	SubscriberOnlyView *bool                        `json:"subscriberOnlyView,omitempty"`
+}
+
 // CreateCommentInput is used for create Comment object.
 // Input was generated by ent.
 type CreateCommentInput struct {
@@ -343,13 +683,24 @@ type CreateRefreshTokenInput struct {
 	OwnerID   uuid.UUID `json:"ownerID"`
 }
 
+// CreateSuspensionInput is used for create Suspension object.
+// Input was generated by ent.
+type CreateSuspensionInput struct {
+	Reason   string            `json:"reason"`
+	Scope    *suspension.Scope `json:"scope,omitempty"`
+	StartsAt *time.Time        `json:"startsAt,omitempty"`
+	EndsAt   *time.Time        `json:"endsAt,omitempty"`
+	UserID   uuid.UUID         `json:"userID"`
+}
+
 // CreateUserInput is used for create User object.
 // Input was generated by ent.
 type CreateUserInput struct {
 	DisplayName string `json:"displayName"`
 	// the alias of the user is shown alongside the display name
-	Alias        *string            `json:"alias,omitempty"`
-	ProfileImage *string            `json:"profileImage,omitempty"`
+	Alias        *string `json:"alias,omitempty"`
+	ProfileImage *string `json:"profileImage,omitempty"`
+	// the provider the user registered with
 	AuthProvider *user.AuthProvider `json:"authProvider,omitempty"`
 	// the role of the user
 	Role *user.Role `json:"role,omitempty"`
@@ -357,7 +708,6 @@ type CreateUserInput struct {
 	LastSeenAt *time.Time `json:"lastSeenAt,omitempty"`
 	// cursor for last post seen
 	LastPostSeenCursor *string     `json:"lastPostSeenCursor,omitempty"`
-	Awards             []string    `json:"awards,omitempty"`
 	SavedPostIDs       []uuid.UUID `json:"savedPostIDs,omitempty"`
 	LikedPostIDs       []uuid.UUID `json:"likedPostIDs,omitempty"`
 	PublishedPostIDs   []uuid.UUID `json:"publishedPostIDs,omitempty"`
@@ -370,6 +720,124 @@ type DiscordVideoMetadata struct {
 	Expiration *time.Time `json:"expiration,omitempty,omitzero"`
 }
 
+type Identity struct {
+	ID        uuid.UUID         `json:"id"`
+	UpdatedAt time.Time         `json:"updatedAt"`
+	CreatedAt time.Time         `json:"createdAt"`
+	Provider  identity.Provider `json:"provider"`
+	// the provider unique id of the user
+	Subject string  `json:"subject"`
+	Email   *string `json:"email,omitempty,omitzero"`
+	Owner   *User   `json:"owner"`
+}
+
+func (Identity) IsNode() {}
+
+// A connection to a list of items.
+type IdentityConnection struct {
+	// A list of edges.
+	Edges []*IdentityEdge `json:"edges,omitempty,omitzero"`
+	// Information to aid in pagination.
+	PageInfo *PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+}
+
+// An edge in a connection.
+type IdentityEdge struct {
+	// The item at the end of the edge.
+	Node *Identity `json:"node,omitempty,omitzero"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+}
+
+// Ordering options for Identity connections
+type IdentityOrder struct {
+	// The ordering direction.
+	Direction OrderDirection `json:"direction"`
+	// The field by which to order Identities.
+	Field IdentityOrderField `json:"field"`
+}
+
+// IdentityWhereInput is used for filtering Identity objects.
+// Input was generated by ent.
+type IdentityWhereInput struct {
+	Not *IdentityWhereInput   `json:"not,omitempty"`
+	And []*IdentityWhereInput `json:"and,omitempty"`
+	Or  []*IdentityWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNeq   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGt    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGte   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLt    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLte   *uuid.UUID  `json:"idLTE,omitempty"`
+	// updated_at field predicates
+	UpdatedAt      *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq   *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt    *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte   *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt    *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte   *time.Time   `json:"updatedAtLTE,omitempty"`
+	// created_at field predicates
+	CreatedAt      *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq   *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt    *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte   *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt    *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte   *time.Time   `json:"createdAtLTE,omitempty"`
+	// provider field predicates
+	Provider      *identity.Provider  `json:"provider,omitempty"`
+	ProviderNeq   *identity.Provider  `json:"providerNEQ,omitempty"`
+	ProviderIn    []identity.Provider `json:"providerIn,omitempty"`
+	ProviderNotIn []identity.Provider `json:"providerNotIn,omitempty"`
+	// subject field predicates
+	Subject             *string  `json:"subject,omitempty"`
+	SubjectNeq          *string  `json:"subjectNEQ,omitempty"`
+	SubjectIn           []string `json:"subjectIn,omitempty"`
+	SubjectNotIn        []string `json:"subjectNotIn,omitempty"`
+	SubjectGt           *string  `json:"subjectGT,omitempty"`
+	SubjectGte          *string  `json:"subjectGTE,omitempty"`
+	SubjectLt           *string  `json:"subjectLT,omitempty"`
+	SubjectLte          *string  `json:"subjectLTE,omitempty"`
+	SubjectContains     *string  `json:"subjectContains,omitempty"`
+	SubjectHasPrefix    *string  `json:"subjectHasPrefix,omitempty"`
+	SubjectHasSuffix    *string  `json:"subjectHasSuffix,omitempty"`
+	SubjectEqualFold    *string  `json:"subjectEqualFold,omitempty"`
+	SubjectContainsFold *string  `json:"subjectContainsFold,omitempty"`
+	// email field predicates
+	Email             *string  `json:"email,omitempty"`
+	EmailNeq          *string  `json:"emailNEQ,omitempty"`
+	EmailIn           []string `json:"emailIn,omitempty"`
+	EmailNotIn        []string `json:"emailNotIn,omitempty"`
+	EmailGt           *string  `json:"emailGT,omitempty"`
+	EmailGte          *string  `json:"emailGTE,omitempty"`
+	EmailLt           *string  `json:"emailLT,omitempty"`
+	EmailLte          *string  `json:"emailLTE,omitempty"`
+	EmailContains     *string  `json:"emailContains,omitempty"`
+	EmailHasPrefix    *string  `json:"emailHasPrefix,omitempty"`
+	EmailHasSuffix    *string  `json:"emailHasSuffix,omitempty"`
+	EmailIsNil        *bool    `json:"emailIsNil,omitempty"`
+	EmailNotNil       *bool    `json:"emailNotNil,omitempty"`
+	EmailEqualFold    *string  `json:"emailEqualFold,omitempty"`
+	EmailContainsFold *string  `json:"emailContainsFold,omitempty"`
+	// owner edge predicates
+	HasOwner     *bool             `json:"hasOwner,omitempty"`
+	HasOwnerWith []*UserWhereInput `json:"hasOwnerWith,omitempty"`
+}
+
+type LeaderboardEntry struct {
+	Rank  int64 `json:"rank"`
+	Score int64 `json:"score"`
+	User  *User `json:"user"`
+}
+
 type Mutation struct {
 }
 
@@ -555,8 +1023,9 @@ type PostMetadata struct {
 	// Version is the version of the Post metadata.
 	Version int64 `json:"version"`
 	// Service represents the provider of the Post link.
-	Service PostService           `json:"service"`
-	Discord *DiscordVideoMetadata `json:"discord,omitempty,omitzero"`
+	Service    PostService           `json:"service"`
+	Discord    *DiscordVideoMetadata `json:"discord,omitempty,omitzero"`
+	TwitchClip *TwitchClipMetadata   `json:"twitchClip,omitempty,omitzero"`
 }
 
 // Ordering options for Post connections
@@ -759,6 +1228,14 @@ type RefreshToken struct {
 	IPAddress *string   `json:"ipAddress,omitempty,omitzero"`
 	UserAgent *string   `json:"userAgent,omitempty,omitzero"`
 	Owner     *User     `json:"owner"`
+	// ID of the session, shared by all refresh tokens rotated from the same login
+	FamilyID uuid.UUID `json:"familyID"`
+	// Browser and operating system derived from the user agent
+	Device string `json:"device"`
+	// Time the session tokens were last refreshed
+	LastRotatedAt time.Time `json:"lastRotatedAt"`
+	// Whether this is the session of the current request
+	IsCurrent bool `json:"isCurrent"`
 }
 
 func (RefreshToken) IsNode() {}
@@ -895,53 +1372,464 @@ type RefreshTokenWhereInput struct {
 	HasOwnerWith []*UserWhereInput `json:"hasOwnerWith,omitempty"`
 }
 
-type SearchResultConnection struct {
+type RoleChange struct {
+	ID          uuid.UUID            `json:"id"`
+	UpdatedAt   time.Time            `json:"updatedAt"`
+	CreatedAt   time.Time            `json:"createdAt"`
+	UserID      uuid.UUID            `json:"userID"`
+	Attribute   rolechange.Attribute `json:"attribute"`
+	OldValue    string               `json:"oldValue"`
+	NewValue    string               `json:"newValue"`
+	Source      rolechange.Source    `json:"source"`
+	ChangedByID *string              `json:"changedByID,omitempty,omitzero"`
+	User        *User                `json:"user"`
+}
+
+func (RoleChange) IsNode() {}
+
+// A connection to a list of items.
+type RoleChangeConnection struct {
+	// A list of edges.
+	Edges []*RoleChangeEdge `json:"edges,omitempty,omitzero"`
 	// Information to aid in pagination.
-	Page *PageInfo `json:"page"`
+	PageInfo *PageInfo `json:"pageInfo"`
 	// Identifies the total count of items in the connection.
 	TotalCount int64 `json:"totalCount"`
-	// A list of nodes with results.
-	Nodes []SearchResult `json:"nodes"`
 }
 
-// UpdateApiKeyInput is used for update ApiKey object.
-// Input was generated by ent.
-type UpdateAPIKeyInput struct {
-	ExpiresOn *time.Time `json:"expiresOn,omitempty"`
+// An edge in a connection.
+type RoleChangeEdge struct {
+	// The item at the end of the edge.
+	Node *RoleChange `json:"node,omitempty,omitzero"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
 }
 
-// UpdateCommentInput is used for update Comment object.
-// Input was generated by ent.
-type UpdateCommentInput struct {
-	Content   *string    `json:"content,omitempty"`
-	OwnerID   *uuid.UUID `json:"ownerID,omitempty"`
-	PostID    *uuid.UUID `json:"postID,omitempty"`
-	ClearPost *bool      `json:"clearPost,omitempty"`
+// Ordering options for RoleChange connections
+type RoleChangeOrder struct {
+	// The ordering direction.
+	Direction OrderDirection `json:"direction"`
+	// The field by which to order RoleChanges.
+	Field RoleChangeOrderField `json:"field"`
 }
 
-// UpdatePostCategoryInput is used for update PostCategory object.
+// RoleChangeWhereInput is used for filtering RoleChange objects.
 // Input was generated by ent.
-type UpdatePostCategoryInput struct {
-	Category  *postcategory.Category `json:"category,omitempty"`
-	PostID    *uuid.UUID             `json:"postID,omitempty"`
-	ClearPost *bool                  `json:"clearPost,omitempty"`
+type RoleChangeWhereInput struct {
+	Not *RoleChangeWhereInput   `json:"not,omitempty"`
+	And []*RoleChangeWhereInput `json:"and,omitempty"`
+	Or  []*RoleChangeWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNeq   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGt    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGte   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLt    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLte   *uuid.UUID  `json:"idLTE,omitempty"`
+	// updated_at field predicates
+	UpdatedAt      *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq   *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt    *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte   *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt    *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte   *time.Time   `json:"updatedAtLTE,omitempty"`
+	// created_at field predicates
+	CreatedAt      *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq   *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt    *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte   *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt    *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte   *time.Time   `json:"createdAtLTE,omitempty"`
+	// user_id field predicates
+	UserID      *uuid.UUID  `json:"userID,omitempty"`
+	UserIdneq   *uuid.UUID  `json:"userIDNEQ,omitempty"`
+	UserIDIn    []uuid.UUID `json:"userIDIn,omitempty"`
+	UserIDNotIn []uuid.UUID `json:"userIDNotIn,omitempty"`
+	// attribute field predicates
+	Attribute      *rolechange.Attribute  `json:"attribute,omitempty"`
+	AttributeNeq   *rolechange.Attribute  `json:"attributeNEQ,omitempty"`
+	AttributeIn    []rolechange.Attribute `json:"attributeIn,omitempty"`
+	AttributeNotIn []rolechange.Attribute `json:"attributeNotIn,omitempty"`
+	// old_value field predicates
+	OldValue             *string  `json:"oldValue,omitempty"`
+	OldValueNeq          *string  `json:"oldValueNEQ,omitempty"`
+	OldValueIn           []string `json:"oldValueIn,omitempty"`
+	OldValueNotIn        []string `json:"oldValueNotIn,omitempty"`
+	OldValueGt           *string  `json:"oldValueGT,omitempty"`
+	OldValueGte          *string  `json:"oldValueGTE,omitempty"`
+	OldValueLt           *string  `json:"oldValueLT,omitempty"`
+	OldValueLte          *string  `json:"oldValueLTE,omitempty"`
+	OldValueContains     *string  `json:"oldValueContains,omitempty"`
+	OldValueHasPrefix    *string  `json:"oldValueHasPrefix,omitempty"`
+	OldValueHasSuffix    *string  `json:"oldValueHasSuffix,omitempty"`
+	OldValueEqualFold    *string  `json:"oldValueEqualFold,omitempty"`
+	OldValueContainsFold *string  `json:"oldValueContainsFold,omitempty"`
+	// new_value field predicates
+	NewValue             *string  `json:"newValue,omitempty"`
+	NewValueNeq          *string  `json:"newValueNEQ,omitempty"`
+	NewValueIn           []string `json:"newValueIn,omitempty"`
+	NewValueNotIn        []string `json:"newValueNotIn,omitempty"`
+	NewValueGt           *string  `json:"newValueGT,omitempty"`
+	NewValueGte          *string  `json:"newValueGTE,omitempty"`
+	NewValueLt           *string  `json:"newValueLT,omitempty"`
+	NewValueLte          *string  `json:"newValueLTE,omitempty"`
+	NewValueContains     *string  `json:"newValueContains,omitempty"`
+	NewValueHasPrefix    *string  `json:"newValueHasPrefix,omitempty"`
+	NewValueHasSuffix    *string  `json:"newValueHasSuffix,omitempty"`
+	NewValueEqualFold    *string  `json:"newValueEqualFold,omitempty"`
+	NewValueContainsFold *string  `json:"newValueContainsFold,omitempty"`
+	// source field predicates
+	Source      *rolechange.Source  `json:"source,omitempty"`
+	SourceNeq   *rolechange.Source  `json:"sourceNEQ,omitempty"`
+	SourceIn    []rolechange.Source `json:"sourceIn,omitempty"`
+	SourceNotIn []rolechange.Source `json:"sourceNotIn,omitempty"`
+	// changed_by_id field predicates
+	ChangedByID       *string  `json:"changedByID,omitempty"`
+	ChangedByIdneq    *string  `json:"changedByIDNEQ,omitempty"`
+	ChangedByIDIn     []string `json:"changedByIDIn,omitempty"`
+	ChangedByIDNotIn  []string `json:"changedByIDNotIn,omitempty"`
+	ChangedByIdgt     *string  `json:"changedByIDGT,omitempty"`
+	ChangedByIdgte    *string  `json:"changedByIDGTE,omitempty"`
+	ChangedByIdlt     *string  `json:"changedByIDLT,omitempty"`
+	ChangedByIdlte    *string  `json:"changedByIDLTE,omitempty"`
+	ChangedByIDIsNil  *bool    `json:"changedByIDIsNil,omitempty"`
+	ChangedByIDNotNil *bool    `json:"changedByIDNotNil,omitempty"`
+	// user edge predicates
+	HasUser     *bool             `json:"hasUser,omitempty"`
+	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
+}
+
+type RolePermission struct {
+	ID         uuid.UUID                 `json:"id"`
+	UpdatedAt  time.Time                 `json:"updatedAt"`
+	CreatedAt  time.Time                 `json:"createdAt"`
+	Role       rolepermission.Role       `json:"role"`
+	Permission rolepermission.Permission `json:"permission"`
+}
+
+func (RolePermission) IsNode() {}
+
+// Ordering options for RolePermission connections
+type RolePermissionOrder struct {
+	// The ordering direction.
+	Direction OrderDirection `json:"direction"`
+	// The field by which to order RolePermissions.
+	Field RolePermissionOrderField `json:"field"`
 }
 
-// UpdatePostInput is used for update Post object.
+// Permissions granted to a role.
+type RolePermissionSet struct {
+	Role        user.Role                   `json:"role"`
+	Permissions []rolepermission.Permission `json:"permissions"`
+}
+
+// RolePermissionWhereInput is used for filtering RolePermission objects.
 // Input was generated by ent.
-type UpdatePostInput struct {
-	Pinned                 *bool       `json:"pinned,omitempty"`
-	Title                  *string     `json:"title,omitempty"`
-	Content                *string     `json:"content,omitempty"`
-	ClearContent           *bool       `json:"clearContent,omitempty"`
-	Link                   *string     `json:"link,omitempty"`
-	ModerationComment      *string     `json:"moderationComment,omitempty"`
-	ClearModerationComment *bool       `json:"clearModerationComment,omitempty"`
-	IsModerated            *bool       `json:"isModerated,omitempty"`
-	AddCommentIDs          []uuid.UUID `json:"addCommentIDs,omitempty"`
-	RemoveCommentIDs       []uuid.UUID `json:"removeCommentIDs,omitempty"`
-	ClearComments          *bool       `json:"clearComments,omitempty"`
-	AddSavedByIDs          []uuid.UUID `json:"addSavedByIDs,omitempty"`
+type RolePermissionWhereInput struct {
+	Not *RolePermissionWhereInput   `json:"not,omitempty"`
+	And []*RolePermissionWhereInput `json:"and,omitempty"`
+	Or  []*RolePermissionWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNeq   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGt    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGte   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLt    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLte   *uuid.UUID  `json:"idLTE,omitempty"`
+	// updated_at field predicates
+	UpdatedAt      *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq   *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt    *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte   *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt    *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte   *time.Time   `json:"updatedAtLTE,omitempty"`
+	// created_at field predicates
+	CreatedAt      *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq   *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt    *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte   *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt    *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte   *time.Time   `json:"createdAtLTE,omitempty"`
+	// role field predicates
+	Role      *rolepermission.Role  `json:"role,omitempty"`
+	RoleNeq   *rolepermission.Role  `json:"roleNEQ,omitempty"`
+	RoleIn    []rolepermission.Role `json:"roleIn,omitempty"`
+	RoleNotIn []rolepermission.Role `json:"roleNotIn,omitempty"`
+	// permission field predicates
+	Permission      *rolepermission.Permission  `json:"permission,omitempty"`
+	PermissionNeq   *rolepermission.Permission  `json:"permissionNEQ,omitempty"`
+	PermissionIn    []rolepermission.Permission `json:"permissionIn,omitempty"`
+	PermissionNotIn []rolepermission.Permission `json:"permissionNotIn,omitempty"`
+}
+
+type SearchResultConnection struct {
+	// Information to aid in pagination.
+	Page *PageInfo `json:"page"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+	// A list of nodes with results.
+	Nodes []SearchResult `json:"nodes"`
+}
+
+// Broadcaster Twitch stream status.
+type StreamStatus struct {
+	Live        bool       `json:"live"`
+	Title       *string    `json:"title,omitempty,omitzero"`
+	GameName    *string    `json:"gameName,omitempty,omitzero"`
+	ViewerCount *int64     `json:"viewerCount,omitempty,omitzero"`
+	StartedAt   *time.Time `json:"startedAt,omitempty,omitzero"`
+	// Thumbnail of the live stream, sized 640x360.
+	ThumbnailURL *string `json:"thumbnailURL,omitempty,omitzero"`
+}
+
+type Suspension struct {
+	ID        uuid.UUID        `json:"id"`
+	UpdatedAt time.Time        `json:"updatedAt"`
+	CreatedAt time.Time        `json:"createdAt"`
+	UserID    uuid.UUID        `json:"userID"`
+	IssuerID  *uuid.UUID       `json:"issuerID,omitempty,omitzero"`
+	Reason    string           `json:"reason"`
+	Scope     suspension.Scope `json:"scope"`
+	StartsAt  time.Time        `json:"startsAt"`
+	EndsAt    *time.Time       `json:"endsAt,omitempty,omitzero"`
+	User      *User            `json:"user"`
+	Issuer    *User            `json:"issuer,omitempty,omitzero"`
+}
+
+func (Suspension) IsNode() {}
+
+// Return response for createBulkSuspension mutation
+type SuspensionBulkCreatePayload struct {
+	// Created suspensions
+	Suspensions []*Suspension `json:"suspensions,omitempty,omitzero"`
+}
+
+// A connection to a list of items.
+type SuspensionConnection struct {
+	// A list of edges.
+	Edges []*SuspensionEdge `json:"edges,omitempty,omitzero"`
+	// Information to aid in pagination.
+	PageInfo *PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+}
+
+// Return response for createSuspension mutation
+type SuspensionCreatePayload struct {
+	// Created suspension
+	Suspension *Suspension `json:"suspension"`
+}
+
+// Return response for deleteSuspension mutation
+type SuspensionDeletePayload struct {
+	// Deleted suspension ID
+	DeletedID uuid.UUID `json:"deletedID"`
+}
+
+// An edge in a connection.
+type SuspensionEdge struct {
+	// The item at the end of the edge.
+	Node *Suspension `json:"node,omitempty,omitzero"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+}
+
+// Ordering options for Suspension connections
+type SuspensionOrder struct {
+	// The ordering direction.
+	Direction OrderDirection `json:"direction"`
+	// The field by which to order Suspensions.
+	Field SuspensionOrderField `json:"field"`
+}
+
+// Return response for updateSuspension mutation
+type SuspensionUpdatePayload struct {
+	// Updated suspension
+	Suspension *Suspension `json:"suspension"`
+}
+
+// SuspensionWhereInput is used for filtering Suspension objects.
+// Input was generated by ent.
+type SuspensionWhereInput struct {
+	Not *SuspensionWhereInput   `json:"not,omitempty"`
+	And []*SuspensionWhereInput `json:"and,omitempty"`
+	Or  []*SuspensionWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNeq   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGt    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGte   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLt    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLte   *uuid.UUID  `json:"idLTE,omitempty"`
+	// updated_at field predicates
+	UpdatedAt      *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq   *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt    *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte   *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt    *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte   *time.Time   `json:"updatedAtLTE,omitempty"`
+	// created_at field predicates
+	CreatedAt      *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq   *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt    *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte   *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt    *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte   *time.Time   `json:"createdAtLTE,omitempty"`
+	// user_id field predicates
+	UserID      *uuid.UUID  `json:"userID,omitempty"`
+	UserIdneq   *uuid.UUID  `json:"userIDNEQ,omitempty"`
+	UserIDIn    []uuid.UUID `json:"userIDIn,omitempty"`
+	UserIDNotIn []uuid.UUID `json:"userIDNotIn,omitempty"`
+	// issuer_id field predicates
+	IssuerID       *uuid.UUID  `json:"issuerID,omitempty"`
+	IssuerIdneq    *uuid.UUID  `json:"issuerIDNEQ,omitempty"`
+	IssuerIDIn     []uuid.UUID `json:"issuerIDIn,omitempty"`
+	IssuerIDNotIn  []uuid.UUID `json:"issuerIDNotIn,omitempty"`
+	IssuerIDIsNil  *bool       `json:"issuerIDIsNil,omitempty"`
+	IssuerIDNotNil *bool       `json:"issuerIDNotNil,omitempty"`
+	// reason field predicates
+	Reason             *string  `json:"reason,omitempty"`
+	ReasonNeq          *string  `json:"reasonNEQ,omitempty"`
+	ReasonIn           []string `json:"reasonIn,omitempty"`
+	ReasonNotIn        []string `json:"reasonNotIn,omitempty"`
+	ReasonGt           *string  `json:"reasonGT,omitempty"`
+	ReasonGte          *string  `json:"reasonGTE,omitempty"`
+	ReasonLt           *string  `json:"reasonLT,omitempty"`
+	ReasonLte          *string  `json:"reasonLTE,omitempty"`
+	ReasonContains     *string  `json:"reasonContains,omitempty"`
+	ReasonHasPrefix    *string  `json:"reasonHasPrefix,omitempty"`
+	ReasonHasSuffix    *string  `json:"reasonHasSuffix,omitempty"`
+	ReasonEqualFold    *string  `json:"reasonEqualFold,omitempty"`
+	ReasonContainsFold *string  `json:"reasonContainsFold,omitempty"`
+	// scope field predicates
+	Scope      *suspension.Scope  `json:"scope,omitempty"`
+	ScopeNeq   *suspension.Scope  `json:"scopeNEQ,omitempty"`
+	ScopeIn    []suspension.Scope `json:"scopeIn,omitempty"`
+	ScopeNotIn []suspension.Scope `json:"scopeNotIn,omitempty"`
+	// starts_at field predicates
+	StartsAt      *time.Time   `json:"startsAt,omitempty"`
+	StartsAtNeq   *time.Time   `json:"startsAtNEQ,omitempty"`
+	StartsAtIn    []*time.Time `json:"startsAtIn,omitempty"`
+	StartsAtNotIn []*time.Time `json:"startsAtNotIn,omitempty"`
+	StartsAtGt    *time.Time   `json:"startsAtGT,omitempty"`
+	StartsAtGte   *time.Time   `json:"startsAtGTE,omitempty"`
+	StartsAtLt    *time.Time   `json:"startsAtLT,omitempty"`
+	StartsAtLte   *time.Time   `json:"startsAtLTE,omitempty"`
+	// ends_at field predicates
+	EndsAt       *time.Time   `json:"endsAt,omitempty"`
+	EndsAtNeq    *time.Time   `json:"endsAtNEQ,omitempty"`
+	EndsAtIn     []*time.Time `json:"endsAtIn,omitempty"`
+	EndsAtNotIn  []*time.Time `json:"endsAtNotIn,omitempty"`
+	EndsAtGt     *time.Time   `json:"endsAtGT,omitempty"`
+	EndsAtGte    *time.Time   `json:"endsAtGTE,omitempty"`
+	EndsAtLt     *time.Time   `json:"endsAtLT,omitempty"`
+	EndsAtLte    *time.Time   `json:"endsAtLTE,omitempty"`
+	EndsAtIsNil  *bool        `json:"endsAtIsNil,omitempty"`
+	EndsAtNotNil *bool        `json:"endsAtNotNil,omitempty"`
+	// user edge predicates
+	HasUser     *bool             `json:"hasUser,omitempty"`
+	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
+	// issuer edge predicates
+	HasIssuer     *bool             `json:"hasIssuer,omitempty"`
+	HasIssuerWith []*UserWhereInput `json:"hasIssuerWith,omitempty"`
+}
+
+type TwitchClipMetadata struct {
+	ID              string `json:"id"`
+	BroadcasterID   string `json:"broadcasterID"`
+	BroadcasterName string `json:"broadcasterName"`
+	CreatorID       string `json:"creatorID"`
+	CreatorName     string `json:"creatorName"`
+	ThumbnailURL    string `json:"thumbnailURL"`
+	// Duration is the clip duration in seconds.
+	Duration float64 `json:"duration"`
+	// VideoID is the ID of the VOD the clip was created from, if still available.
+	VideoID *string `json:"videoID,omitempty,omitzero"`
+	// VODOffset is the offset in seconds of the clip in the VOD.
+	VodOffset *int64    `json:"vodOffset,omitempty,omitzero"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// UpdateApiKeyInput is used for update ApiKey object.
+// Input was generated by ent.
+type UpdateAPIKeyInput struct {
+	Name         *string    `json:"name,omitempty"`
+	Scopes       []string   `json:"scopes,omitempty"`
+	AppendScopes []string   `json:"appendScopes,omitempty"`
+	ExpiresOn    *time.Time `json:"expiresOn,omitempty"`
+}
+
+// UpdateAwardDefinitionInput is used for update AwardDefinition object.
+// Input was generated by ent.
+type UpdateAwardDefinitionInput struct {
+	Name             *string `json:"name,omitempty"`
+	Description      *string `json:"description,omitempty"`
+	ClearDescription *bool   `json:"clearDescription,omitempty"`
+	// emoji or image URL shown alongside the award
+	Icon *string `json:"icon,omitempty"`
+	// the rule evaluated to grant the award
+	Rule *awarddefinition.Rule `json:"rule,omitempty"`
+	// the post category counted by POSTS_IN_CATEGORY rules
+	RuleCategory      *awarddefinition.RuleCategory `json:"ruleCategory,omitempty"`
+	ClearRuleCategory *bool                         `json:"clearRuleCategory,omitempty"`
+	// the minimum count (or days for MEMBERSHIP_DAYS) required to grant the award
+	RuleThreshold *int64 `json:"ruleThreshold,omitempty"`
+}
+
+// UpdateCategoryRestrictionInput is used for update CategoryRestriction object.
+// Input was generated by ent.
+type UpdateCategoryRestrictionInput struct {
+	SubscriberOnlyPost *bool `json:"subscriberOnlyPost,omitempty"`
+	SubscriberOnlyView *bool `json:"subscriberOnlyView,omitempty"`
+}
+
+// UpdateCommentInput is used for update Comment object.
+// Input was generated by ent.
+type UpdateCommentInput struct {
+	Content *string `json:"content,omitempty"`
+}
+
+// UpdatePostCategoryInput is used for update PostCategory object.
+// Input was generated by ent.
+type UpdatePostCategoryInput struct {
+	Category  *postcategory.Category `json:"category,omitempty"`
+	PostID    *uuid.UUID             `json:"postID,omitempty"`
+	ClearPost *bool                  `json:"clearPost,omitempty"`
+}
+
+// UpdatePostInput is used for update Post object.
+// Input was generated by ent.
+type UpdatePostInput struct {
+	Pinned                 *bool       `json:"pinned,omitempty"`
+	Title                  *string     `json:"title,omitempty"`
+	Content                *string     `json:"content,omitempty"`
+	ClearContent           *bool       `json:"clearContent,omitempty"`
+	Link                   *string     `json:"link,omitempty"`
+	ModerationComment      *string     `json:"moderationComment,omitempty"`
+	ClearModerationComment *bool       `json:"clearModerationComment,omitempty"`
+	IsModerated            *bool       `json:"isModerated,omitempty"`
+	AddCommentIDs          []uuid.UUID `json:"addCommentIDs,omitempty"`
+	RemoveCommentIDs       []uuid.UUID `json:"removeCommentIDs,omitempty"`
+	ClearComments          *bool       `json:"clearComments,omitempty"`
+	AddSavedByIDs          []uuid.UUID `json:"addSavedByIDs,omitempty"`
 	RemoveSavedByIDs       []uuid.UUID `json:"removeSavedByIDs,omitempty"`
 	ClearSavedBy           *bool       `json:"clearSavedBy,omitempty"`
 	AddLikedByIDs          []uuid.UUID `json:"addLikedByIDs,omitempty"`
@@ -970,16 +1858,27 @@ type UpdateRefreshTokenInput struct {
 	OwnerID        *uuid.UUID `json:"ownerID,omitempty"`
 }
 
+// UpdateSuspensionInput is used for update Suspension object.
+// Input was generated by ent.
+type UpdateSuspensionInput struct {
+	Reason      *string           `json:"reason,omitempty"`
+	Scope       *suspension.Scope `json:"scope,omitempty"`
+	StartsAt    *time.Time        `json:"startsAt,omitempty"`
+	EndsAt      *time.Time        `json:"endsAt,omitempty"`
+	ClearEndsAt *bool             `json:"clearEndsAt,omitempty"`
+}
+
 // UpdateUserInput is used for update User object.
 // Input was generated by ent.
 type UpdateUserInput struct {
 	DisplayName *string `json:"displayName,omitempty"`
 	// the alias of the user is shown alongside the display name
-	Alias             *string            `json:"alias,omitempty"`
-	ClearAlias        *bool              `json:"clearAlias,omitempty"`
-	ProfileImage      *string            `json:"profileImage,omitempty"`
-	ClearProfileImage *bool              `json:"clearProfileImage,omitempty"`
-	AuthProvider      *user.AuthProvider `json:"authProvider,omitempty"`
+	Alias             *string `json:"alias,omitempty"`
+	ClearAlias        *bool   `json:"clearAlias,omitempty"`
+	ProfileImage      *string `json:"profileImage,omitempty"`
+	ClearProfileImage *bool   `json:"clearProfileImage,omitempty"`
+	// the provider the user registered with
+	AuthProvider *user.AuthProvider `json:"authProvider,omitempty"`
 	// the role of the user
 	Role *user.Role `json:"role,omitempty"`
 	// the time the user was last seen
@@ -988,9 +1887,6 @@ type UpdateUserInput struct {
 	// cursor for last post seen
 	LastPostSeenCursor      *string     `json:"lastPostSeenCursor,omitempty"`
 	ClearLastPostSeenCursor *bool       `json:"clearLastPostSeenCursor,omitempty"`
-	Awards                  []string    `json:"awards,omitempty"`
-	AppendAwards            []string    `json:"appendAwards,omitempty"`
-	ClearAwards             *bool       `json:"clearAwards,omitempty"`
 	AddSavedPostIDs         []uuid.UUID `json:"addSavedPostIDs,omitempty"`
 	RemoveSavedPostIDs      []uuid.UUID `json:"removeSavedPostIDs,omitempty"`
 	ClearSavedPosts         *bool       `json:"clearSavedPosts,omitempty"`
@@ -1016,26 +1912,121 @@ type User struct {
 	DeletedBy   *string    `json:"deletedBy,omitempty,omitzero"`
 	DisplayName string     `json:"displayName"`
 	// the alias of the user is shown alongside the display name
-	Alias        *string           `json:"alias,omitempty,omitzero"`
-	ProfileImage *string           `json:"profileImage,omitempty,omitzero"`
+	Alias        *string `json:"alias,omitempty,omitzero"`
+	ProfileImage *string `json:"profileImage,omitempty,omitzero"`
+	// the provider the user registered with
 	AuthProvider user.AuthProvider `json:"authProvider"`
 	// the role of the user
 	Role user.Role `json:"role"`
+	// how the role was assigned, unset for the default role. Manually assigned roles are not synced from Twitch
+	RoleSource *user.RoleSource `json:"roleSource,omitempty,omitzero"`
+	// whether the user is a VIP in the broadcaster Twitch channel
+	TwitchVip bool `json:"twitchVip"`
 	// the time the user was last seen
 	LastSeenAt *time.Time `json:"lastSeenAt,omitempty,omitzero"`
 	// cursor for last post seen
-	LastPostSeenCursor *string         `json:"lastPostSeenCursor,omitempty,omitzero"`
-	Awards             []string        `json:"awards,omitempty,omitzero"`
-	SavedPosts         []*Post         `json:"savedPosts,omitempty,omitzero"`
-	LikedPosts         []*Post         `json:"likedPosts,omitempty,omitzero"`
-	PublishedPosts     []*Post         `json:"publishedPosts,omitempty,omitzero"`
-	Comments           []*Comment      `json:"comments,omitempty,omitzero"`
-	APIKeys            []*APIKey       `json:"apiKeys,omitempty,omitzero"`
-	TwitchInfo         *UserTwitchInfo `json:"twitchInfo,omitempty,omitzero"`
+	LastPostSeenCursor *string       `json:"lastPostSeenCursor,omitempty,omitzero"`
+	SavedPosts         []*Post       `json:"savedPosts,omitempty,omitzero"`
+	LikedPosts         []*Post       `json:"likedPosts,omitempty,omitzero"`
+	PublishedPosts     []*Post       `json:"publishedPosts,omitempty,omitzero"`
+	Comments           []*Comment    `json:"comments,omitempty,omitzero"`
+	APIKeys            []*APIKey     `json:"apiKeys,omitempty,omitzero"`
+	Awards             []*UserAward  `json:"awards,omitempty,omitzero"`
+	Suspensions        []*Suspension `json:"suspensions,omitempty,omitzero"`
+	// Permissions granted by the user role.
+	Permissions []rolepermission.Permission `json:"permissions"`
+	TwitchInfo  *UserTwitchInfo             `json:"twitchInfo,omitempty,omitzero"`
 }
 
 func (User) IsNode() {}
 
+type UserAward struct {
+	ID        uuid.UUID        `json:"id"`
+	UpdatedAt time.Time        `json:"updatedAt"`
+	CreatedAt time.Time        `json:"createdAt"`
+	GrantedAt time.Time        `json:"grantedAt"`
+	Owner     *User            `json:"owner"`
+	Award     *AwardDefinition `json:"award"`
+}
+
+func (UserAward) IsNode() {}
+
+// A connection to a list of items.
+type UserAwardConnection struct {
+	// A list of edges.
+	Edges []*UserAwardEdge `json:"edges,omitempty,omitzero"`
+	// Information to aid in pagination.
+	PageInfo *PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+}
+
+// An edge in a connection.
+type UserAwardEdge struct {
+	// The item at the end of the edge.
+	Node *UserAward `json:"node,omitempty,omitzero"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+}
+
+// Ordering options for UserAward connections
+type UserAwardOrder struct {
+	// The ordering direction.
+	Direction OrderDirection `json:"direction"`
+	// The field by which to order UserAwards.
+	Field UserAwardOrderField `json:"field"`
+}
+
+// UserAwardWhereInput is used for filtering UserAward objects.
+// Input was generated by ent.
+type UserAwardWhereInput struct {
+	Not *UserAwardWhereInput   `json:"not,omitempty"`
+	And []*UserAwardWhereInput `json:"and,omitempty"`
+	Or  []*UserAwardWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNeq   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGt    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGte   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLt    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLte   *uuid.UUID  `json:"idLTE,omitempty"`
+	// updated_at field predicates
+	UpdatedAt      *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq   *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt    *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte   *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt    *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte   *time.Time   `json:"updatedAtLTE,omitempty"`
+	// created_at field predicates
+	CreatedAt      *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq   *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt    *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte   *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt    *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte   *time.Time   `json:"createdAtLTE,omitempty"`
+	// granted_at field predicates
+	GrantedAt      *time.Time   `json:"grantedAt,omitempty"`
+	GrantedAtNeq   *time.Time   `json:"grantedAtNEQ,omitempty"`
+	GrantedAtIn    []*time.Time `json:"grantedAtIn,omitempty"`
+	GrantedAtNotIn []*time.Time `json:"grantedAtNotIn,omitempty"`
+	GrantedAtGt    *time.Time   `json:"grantedAtGT,omitempty"`
+	GrantedAtGte   *time.Time   `json:"grantedAtGTE,omitempty"`
+	GrantedAtLt    *time.Time   `json:"grantedAtLT,omitempty"`
+	GrantedAtLte   *time.Time   `json:"grantedAtLTE,omitempty"`
+	// owner edge predicates
+	HasOwner     *bool             `json:"hasOwner,omitempty"`
+	HasOwnerWith []*UserWhereInput `json:"hasOwnerWith,omitempty"`
+	// award edge predicates
+	HasAward     *bool                        `json:"hasAward,omitempty"`
+	HasAwardWith []*AwardDefinitionWhereInput `json:"hasAwardWith,omitempty"`
+}
+
 // Return response for createBulkUser mutation
 type UserBulkCreatePayload struct {
 	// Created users
@@ -1215,6 +2206,16 @@ type UserWhereInput struct {
 	RoleNeq   *user.Role  `json:"roleNEQ,omitempty"`
 	RoleIn    []user.Role `json:"roleIn,omitempty"`
 	RoleNotIn []user.Role `json:"roleNotIn,omitempty"`
+	// role_source field predicates
+	RoleSource       *user.RoleSource  `json:"roleSource,omitempty"`
+	RoleSourceNeq    *user.RoleSource  `json:"roleSourceNEQ,omitempty"`
+	RoleSourceIn     []user.RoleSource `json:"roleSourceIn,omitempty"`
+	RoleSourceNotIn  []user.RoleSource `json:"roleSourceNotIn,omitempty"`
+	RoleSourceIsNil  *bool             `json:"roleSourceIsNil,omitempty"`
+	RoleSourceNotNil *bool             `json:"roleSourceNotNil,omitempty"`
+	// twitch_vip field predicates
+	TwitchVip    *bool `json:"twitchVip,omitempty"`
+	TwitchVipNeq *bool `json:"twitchVipNEQ,omitempty"`
 	// last_seen_at field predicates
 	LastSeenAt       *time.Time   `json:"lastSeenAt,omitempty"`
 	LastSeenAtNeq    *time.Time   `json:"lastSeenAtNEQ,omitempty"`
@@ -1257,6 +2258,12 @@ type UserWhereInput struct {
 	// api_keys edge predicates
 	HasAPIKeys     *bool               `json:"hasAPIKeys,omitempty"`
 	HasAPIKeysWith []*APIKeyWhereInput `json:"hasAPIKeysWith,omitempty"`
+	// awards edge predicates
+	HasAwards     *bool                  `json:"hasAwards,omitempty"`
+	HasAwardsWith []*UserAwardWhereInput `json:"hasAwardsWith,omitempty"`
+	// suspensions edge predicates
+	HasSuspensions     *bool                   `json:"hasSuspensions,omitempty"`
+	HasSuspensionsWith []*SuspensionWhereInput `json:"hasSuspensionsWith,omitempty"`
 	// Include soft-deleted records
 	IncludeDeleted *bool `json:"includeDeleted,omitempty"`
 	// Include only soft-deleted records
@@ -1321,51 +2328,51 @@ func (e APIKeyOrderField) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-// Properties by which Comment connections can be ordered.
-type CommentOrderField string
+// Properties by which AwardDefinition connections can be ordered.
+type AwardDefinitionOrderField string
 
 const (
-	CommentOrderFieldID        CommentOrderField = "ID"
-	CommentOrderFieldUpdatedAt CommentOrderField = "UPDATED_AT"
-	CommentOrderFieldCreatedAt CommentOrderField = "CREATED_AT"
+	AwardDefinitionOrderFieldID        AwardDefinitionOrderField = "ID"
+	AwardDefinitionOrderFieldUpdatedAt AwardDefinitionOrderField = "UPDATED_AT"
+	AwardDefinitionOrderFieldCreatedAt AwardDefinitionOrderField = "CREATED_AT"
 )
 
-var AllCommentOrderField = []CommentOrderField{
-	CommentOrderFieldID,
-	CommentOrderFieldUpdatedAt,
-	CommentOrderFieldCreatedAt,
+var AllAwardDefinitionOrderField = []AwardDefinitionOrderField{
+	AwardDefinitionOrderFieldID,
+	AwardDefinitionOrderFieldUpdatedAt,
+	AwardDefinitionOrderFieldCreatedAt,
 }
 
-func (e CommentOrderField) IsValid() bool {
+func (e AwardDefinitionOrderField) IsValid() bool {
 	switch e {
-	case CommentOrderFieldID, CommentOrderFieldUpdatedAt, CommentOrderFieldCreatedAt:
+	case AwardDefinitionOrderFieldID, AwardDefinitionOrderFieldUpdatedAt, AwardDefinitionOrderFieldCreatedAt:
 		return true
 	}
 	return false
 }
 
-func (e CommentOrderField) String() string {
+func (e AwardDefinitionOrderField) String() string {
 	return string(e)
 }
 
-func (e *CommentOrderField) UnmarshalGQL(v any) error {
+func (e *AwardDefinitionOrderField) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = CommentOrderField(str)
+	*e = AwardDefinitionOrderField(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid CommentOrderField", str)
+		return fmt.Errorf("%s is not a valid AwardDefinitionOrderField", str)
 	}
 	return nil
 }
 
-func (e CommentOrderField) MarshalGQL(w io.Writer) {
+func (e AwardDefinitionOrderField) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *CommentOrderField) UnmarshalJSON(b []byte) error {
+func (e *AwardDefinitionOrderField) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1373,71 +2380,57 @@ func (e *CommentOrderField) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e CommentOrderField) MarshalJSON() ([]byte, error) {
+func (e AwardDefinitionOrderField) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Standardized error codes returned in GraphQL responses.
-type ErrorCode string
+// Properties by which CategoryRestriction connections can be ordered.
+type CategoryRestrictionOrderField string
 
 const (
-	ErrorCodeNotFound             ErrorCode = "NOT_FOUND"
-	ErrorCodeAlreadyExists        ErrorCode = "ALREADY_EXISTS"
-	ErrorCodeForeignKeyConstraint ErrorCode = "FOREIGN_KEY_CONSTRAINT"
-	ErrorCodeValidationError      ErrorCode = "VALIDATION_ERROR"
-	ErrorCodeConstraintError      ErrorCode = "CONSTRAINT_ERROR"
-	ErrorCodeUnauthorized         ErrorCode = "UNAUTHORIZED"
-	ErrorCodeUnauthenticated      ErrorCode = "UNAUTHENTICATED"
-	ErrorCodeInternalServerError  ErrorCode = "INTERNAL_SERVER_ERROR"
-	ErrorCodeCascadeDelete        ErrorCode = "CASCADE_DELETE"
-	ErrorCodeSearchFailed         ErrorCode = "SEARCH_FAILED"
+	CategoryRestrictionOrderFieldID        CategoryRestrictionOrderField = "ID"
+	CategoryRestrictionOrderFieldUpdatedAt CategoryRestrictionOrderField = "UPDATED_AT"
+	CategoryRestrictionOrderFieldCreatedAt CategoryRestrictionOrderField = "CREATED_AT"
 )
 
-var AllErrorCode = []ErrorCode{
-	ErrorCodeNotFound,
-	ErrorCodeAlreadyExists,
-	ErrorCodeForeignKeyConstraint,
-	ErrorCodeValidationError,
-	ErrorCodeConstraintError,
-	ErrorCodeUnauthorized,
-	ErrorCodeUnauthenticated,
-	ErrorCodeInternalServerError,
-	ErrorCodeCascadeDelete,
-	ErrorCodeSearchFailed,
+var AllCategoryRestrictionOrderField = []CategoryRestrictionOrderField{
+	CategoryRestrictionOrderFieldID,
+	CategoryRestrictionOrderFieldUpdatedAt,
+	CategoryRestrictionOrderFieldCreatedAt,
 }
 
-func (e ErrorCode) IsValid() bool {
+func (e CategoryRestrictionOrderField) IsValid() bool {
 	switch e {
-	case ErrorCodeNotFound, ErrorCodeAlreadyExists, ErrorCodeForeignKeyConstraint, ErrorCodeValidationError, ErrorCodeConstraintError, ErrorCodeUnauthorized, ErrorCodeUnauthenticated, ErrorCodeInternalServerError, ErrorCodeCascadeDelete, ErrorCodeSearchFailed:
+	case CategoryRestrictionOrderFieldID, CategoryRestrictionOrderFieldUpdatedAt, CategoryRestrictionOrderFieldCreatedAt:
 		return true
 	}
 	return false
 }
 
-func (e ErrorCode) String() string {
+func (e CategoryRestrictionOrderField) String() string {
 	return string(e)
 }
 
-func (e *ErrorCode) UnmarshalGQL(v any) error {
+func (e *CategoryRestrictionOrderField) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = ErrorCode(str)
+	*e = CategoryRestrictionOrderField(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid ErrorCode", str)
+		return fmt.Errorf("%s is not a valid CategoryRestrictionOrderField", str)
 	}
 	return nil
 }
 
-func (e ErrorCode) MarshalGQL(w io.Writer) {
+func (e CategoryRestrictionOrderField) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *ErrorCode) UnmarshalJSON(b []byte) error {
+func (e *CategoryRestrictionOrderField) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1445,22 +2438,333 @@ func (e *ErrorCode) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e ErrorCode) MarshalJSON() ([]byte, error) {
+func (e CategoryRestrictionOrderField) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-// Possible directions in which to order a list of items when provided an `orderBy` argument.
-type OrderDirection string
+// Properties by which Comment connections can be ordered.
+type CommentOrderField string
 
 const (
-	// Specifies an ascending order for a given `orderBy` argument.
-	OrderDirectionAsc OrderDirection = "ASC"
-	// Specifies a descending order for a given `orderBy` argument.
-	OrderDirectionDesc OrderDirection = "DESC"
-)
-
+	CommentOrderFieldID        CommentOrderField = "ID"
+	CommentOrderFieldUpdatedAt CommentOrderField = "UPDATED_AT"
+	CommentOrderFieldCreatedAt CommentOrderField = "CREATED_AT"
+)
+
+var AllCommentOrderField = []CommentOrderField{
+	CommentOrderFieldID,
+	CommentOrderFieldUpdatedAt,
+	CommentOrderFieldCreatedAt,
+}
+
+func (e CommentOrderField) IsValid() bool {
+	switch e {
+	case CommentOrderFieldID, CommentOrderFieldUpdatedAt, CommentOrderFieldCreatedAt:
+		return true
+	}
+	return false
+}
+
+func (e CommentOrderField) String() string {
+	return string(e)
+}
+
+func (e *CommentOrderField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CommentOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CommentOrderField", str)
+	}
+	return nil
+}
+
+func (e CommentOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CommentOrderField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CommentOrderField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Standardized error codes returned in GraphQL responses.
+type ErrorCode string
+
+const (
+	ErrorCodeNotFound             ErrorCode = "NOT_FOUND"
+	ErrorCodeAlreadyExists        ErrorCode = "ALREADY_EXISTS"
+	ErrorCodeForeignKeyConstraint ErrorCode = "FOREIGN_KEY_CONSTRAINT"
+	ErrorCodeValidationError      ErrorCode = "VALIDATION_ERROR"
+	ErrorCodeConstraintError      ErrorCode = "CONSTRAINT_ERROR"
+	ErrorCodeUnauthorized         ErrorCode = "UNAUTHORIZED"
+	ErrorCodeUnauthenticated      ErrorCode = "UNAUTHENTICATED"
+	ErrorCodeInternalServerError  ErrorCode = "INTERNAL_SERVER_ERROR"
+	ErrorCodeCascadeDelete        ErrorCode = "CASCADE_DELETE"
+	ErrorCodeSearchFailed         ErrorCode = "SEARCH_FAILED"
+	ErrorCodeSuspended            ErrorCode = "SUSPENDED"
+	ErrorCodeSubscriptionRequired ErrorCode = "SUBSCRIPTION_REQUIRED"
+	ErrorCodeCostLimitExceeded    ErrorCode = "COST_LIMIT_EXCEEDED"
+)
+
+var AllErrorCode = []ErrorCode{
+	ErrorCodeNotFound,
+	ErrorCodeAlreadyExists,
+	ErrorCodeForeignKeyConstraint,
+	ErrorCodeValidationError,
+	ErrorCodeConstraintError,
+	ErrorCodeUnauthorized,
+	ErrorCodeUnauthenticated,
+	ErrorCodeInternalServerError,
+	ErrorCodeCascadeDelete,
+	ErrorCodeSearchFailed,
+	ErrorCodeSuspended,
+	ErrorCodeSubscriptionRequired,
+	ErrorCodeCostLimitExceeded,
+}
+
+func (e ErrorCode) IsValid() bool {
+	switch e {
+	case ErrorCodeNotFound, ErrorCodeAlreadyExists, ErrorCodeForeignKeyConstraint, ErrorCodeValidationError, ErrorCodeConstraintError, ErrorCodeUnauthorized, ErrorCodeUnauthenticated, ErrorCodeInternalServerError, ErrorCodeCascadeDelete, ErrorCodeSearchFailed, ErrorCodeSuspended, ErrorCodeSubscriptionRequired, ErrorCodeCostLimitExceeded:
+		return true
+	}
+	return false
+}
+
+func (e ErrorCode) String() string {
+	return string(e)
+}
+
+func (e *ErrorCode) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ErrorCode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ErrorCode", str)
+	}
+	return nil
+}
+
+func (e ErrorCode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ErrorCode) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ErrorCode) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Properties by which Identity connections can be ordered.
+type IdentityOrderField string
+
+const (
+	IdentityOrderFieldID        IdentityOrderField = "ID"
+	IdentityOrderFieldUpdatedAt IdentityOrderField = "UPDATED_AT"
+	IdentityOrderFieldCreatedAt IdentityOrderField = "CREATED_AT"
+)
+
+var AllIdentityOrderField = []IdentityOrderField{
+	IdentityOrderFieldID,
+	IdentityOrderFieldUpdatedAt,
+	IdentityOrderFieldCreatedAt,
+}
+
+func (e IdentityOrderField) IsValid() bool {
+	switch e {
+	case IdentityOrderFieldID, IdentityOrderFieldUpdatedAt, IdentityOrderFieldCreatedAt:
+		return true
+	}
+	return false
+}
+
+func (e IdentityOrderField) String() string {
+	return string(e)
+}
+
+func (e *IdentityOrderField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = IdentityOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid IdentityOrderField", str)
+	}
+	return nil
+}
+
+func (e IdentityOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *IdentityOrderField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e IdentityOrderField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type LeaderboardMetric string
+
+const (
+	// Likes received on published posts.
+	LeaderboardMetricLikesReceived LeaderboardMetric = "LIKES_RECEIVED"
+	// Published posts categorized ORO or DIAMANTE.
+	LeaderboardMetricOroDiamantePosts LeaderboardMetric = "ORO_DIAMANTE_POSTS"
+	// Published comments.
+	LeaderboardMetricComments LeaderboardMetric = "COMMENTS"
+)
+
+var AllLeaderboardMetric = []LeaderboardMetric{
+	LeaderboardMetricLikesReceived,
+	LeaderboardMetricOroDiamantePosts,
+	LeaderboardMetricComments,
+}
+
+func (e LeaderboardMetric) IsValid() bool {
+	switch e {
+	case LeaderboardMetricLikesReceived, LeaderboardMetricOroDiamantePosts, LeaderboardMetricComments:
+		return true
+	}
+	return false
+}
+
+func (e LeaderboardMetric) String() string {
+	return string(e)
+}
+
+func (e *LeaderboardMetric) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LeaderboardMetric(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LeaderboardMetric", str)
+	}
+	return nil
+}
+
+func (e LeaderboardMetric) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *LeaderboardMetric) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e LeaderboardMetric) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type LeaderboardPeriod string
+
+const (
+	LeaderboardPeriodWeek    LeaderboardPeriod = "WEEK"
+	LeaderboardPeriodMonth   LeaderboardPeriod = "MONTH"
+	LeaderboardPeriodAllTime LeaderboardPeriod = "ALL_TIME"
+)
+
+var AllLeaderboardPeriod = []LeaderboardPeriod{
+	LeaderboardPeriodWeek,
+	LeaderboardPeriodMonth,
+	LeaderboardPeriodAllTime,
+}
+
+func (e LeaderboardPeriod) IsValid() bool {
+	switch e {
+	case LeaderboardPeriodWeek, LeaderboardPeriodMonth, LeaderboardPeriodAllTime:
+		return true
+	}
+	return false
+}
+
+func (e LeaderboardPeriod) String() string {
+	return string(e)
+}
+
+func (e *LeaderboardPeriod) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LeaderboardPeriod(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LeaderboardPeriod", str)
+	}
+	return nil
+}
+
+func (e LeaderboardPeriod) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *LeaderboardPeriod) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e LeaderboardPeriod) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Possible directions in which to order a list of items when provided an `orderBy` argument.
+type OrderDirection string
+
+const (
+	// Specifies an ascending order for a given `orderBy` argument.
+	OrderDirectionAsc OrderDirection = "ASC"
+	// Specifies a descending order for a given `orderBy` argument.
+	OrderDirectionDesc OrderDirection = "DESC"
+)
+
 var AllOrderDirection = []OrderDirection{
 	OrderDirectionAsc,
 	OrderDirectionDesc,
@@ -1635,17 +2939,19 @@ type PostService string
 
 const (
 	PostServiceDiscord PostService = "DISCORD"
+	PostServiceTwitch  PostService = "TWITCH"
 	PostServiceUnknown PostService = "UNKNOWN"
 )
 
 var AllPostService = []PostService{
 	PostServiceDiscord,
+	PostServiceTwitch,
 	PostServiceUnknown,
 }
 
 func (e PostService) IsValid() bool {
 	switch e {
-	case PostServiceDiscord, PostServiceUnknown:
+	case PostServiceDiscord, PostServiceTwitch, PostServiceUnknown:
 		return true
 	}
 	return false
@@ -1742,6 +3048,244 @@ func (e RefreshTokenOrderField) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Properties by which RoleChange connections can be ordered.
+type RoleChangeOrderField string
+
+const (
+	RoleChangeOrderFieldID        RoleChangeOrderField = "ID"
+	RoleChangeOrderFieldUpdatedAt RoleChangeOrderField = "UPDATED_AT"
+	RoleChangeOrderFieldCreatedAt RoleChangeOrderField = "CREATED_AT"
+)
+
+var AllRoleChangeOrderField = []RoleChangeOrderField{
+	RoleChangeOrderFieldID,
+	RoleChangeOrderFieldUpdatedAt,
+	RoleChangeOrderFieldCreatedAt,
+}
+
+func (e RoleChangeOrderField) IsValid() bool {
+	switch e {
+	case RoleChangeOrderFieldID, RoleChangeOrderFieldUpdatedAt, RoleChangeOrderFieldCreatedAt:
+		return true
+	}
+	return false
+}
+
+func (e RoleChangeOrderField) String() string {
+	return string(e)
+}
+
+func (e *RoleChangeOrderField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RoleChangeOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RoleChangeOrderField", str)
+	}
+	return nil
+}
+
+func (e RoleChangeOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RoleChangeOrderField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RoleChangeOrderField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Properties by which RolePermission connections can be ordered.
+type RolePermissionOrderField string
+
+const (
+	RolePermissionOrderFieldID        RolePermissionOrderField = "ID"
+	RolePermissionOrderFieldUpdatedAt RolePermissionOrderField = "UPDATED_AT"
+	RolePermissionOrderFieldCreatedAt RolePermissionOrderField = "CREATED_AT"
+)
+
+var AllRolePermissionOrderField = []RolePermissionOrderField{
+	RolePermissionOrderFieldID,
+	RolePermissionOrderFieldUpdatedAt,
+	RolePermissionOrderFieldCreatedAt,
+}
+
+func (e RolePermissionOrderField) IsValid() bool {
+	switch e {
+	case RolePermissionOrderFieldID, RolePermissionOrderFieldUpdatedAt, RolePermissionOrderFieldCreatedAt:
+		return true
+	}
+	return false
+}
+
+func (e RolePermissionOrderField) String() string {
+	return string(e)
+}
+
+func (e *RolePermissionOrderField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RolePermissionOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RolePermissionOrderField", str)
+	}
+	return nil
+}
+
+func (e RolePermissionOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RolePermissionOrderField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RolePermissionOrderField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Properties by which Suspension connections can be ordered.
+type SuspensionOrderField string
+
+const (
+	SuspensionOrderFieldID        SuspensionOrderField = "ID"
+	SuspensionOrderFieldUpdatedAt SuspensionOrderField = "UPDATED_AT"
+	SuspensionOrderFieldCreatedAt SuspensionOrderField = "CREATED_AT"
+	SuspensionOrderFieldStartsAt  SuspensionOrderField = "STARTS_AT"
+	SuspensionOrderFieldEndsAt    SuspensionOrderField = "ENDS_AT"
+)
+
+var AllSuspensionOrderField = []SuspensionOrderField{
+	SuspensionOrderFieldID,
+	SuspensionOrderFieldUpdatedAt,
+	SuspensionOrderFieldCreatedAt,
+	SuspensionOrderFieldStartsAt,
+	SuspensionOrderFieldEndsAt,
+}
+
+func (e SuspensionOrderField) IsValid() bool {
+	switch e {
+	case SuspensionOrderFieldID, SuspensionOrderFieldUpdatedAt, SuspensionOrderFieldCreatedAt, SuspensionOrderFieldStartsAt, SuspensionOrderFieldEndsAt:
+		return true
+	}
+	return false
+}
+
+func (e SuspensionOrderField) String() string {
+	return string(e)
+}
+
+func (e *SuspensionOrderField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SuspensionOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SuspensionOrderField", str)
+	}
+	return nil
+}
+
+func (e SuspensionOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SuspensionOrderField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SuspensionOrderField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Properties by which UserAward connections can be ordered.
+type UserAwardOrderField string
+
+const (
+	UserAwardOrderFieldID        UserAwardOrderField = "ID"
+	UserAwardOrderFieldUpdatedAt UserAwardOrderField = "UPDATED_AT"
+	UserAwardOrderFieldCreatedAt UserAwardOrderField = "CREATED_AT"
+	UserAwardOrderFieldGrantedAt UserAwardOrderField = "GRANTED_AT"
+)
+
+var AllUserAwardOrderField = []UserAwardOrderField{
+	UserAwardOrderFieldID,
+	UserAwardOrderFieldUpdatedAt,
+	UserAwardOrderFieldCreatedAt,
+	UserAwardOrderFieldGrantedAt,
+}
+
+func (e UserAwardOrderField) IsValid() bool {
+	switch e {
+	case UserAwardOrderFieldID, UserAwardOrderFieldUpdatedAt, UserAwardOrderFieldCreatedAt, UserAwardOrderFieldGrantedAt:
+		return true
+	}
+	return false
+}
+
+func (e UserAwardOrderField) String() string {
+	return string(e)
+}
+
+func (e *UserAwardOrderField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UserAwardOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UserAwardOrderField", str)
+	}
+	return nil
+}
+
+func (e UserAwardOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *UserAwardOrderField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e UserAwardOrderField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Properties by which User connections can be ordered.
 type UserOrderField string
 
@@ -1,32 +1,29 @@
 package gql
 
 import (
-	"fmt"
-	"sync"
+	"context"
 	"time"
 
 	"github.com/caliecode/la-clipasa/internal/gql/model"
-	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/caliecode/la-clipasa/internal/utils/cache"
 )
 
-type CacheEntry struct {
-	Info      *model.UserTwitchInfo
-	Timestamp time.Time
+const twitchCacheTTL = 5 * time.Minute
+
+func twitchInfoCacheKey(twitchUserID string) string {
+	return "twitch-info:" + twitchUserID
 }
 
-var (
-	twitchInfoCache *lru.Cache[string, CacheEntry]
-	cacheOnce       sync.Once
-	twitchCacheTTL  = 5 * time.Minute
-	cacheSize       = 50
-)
+// cachedTwitchInfo returns the cached Twitch info of a user, shared by instances.
+func (r *userResolver) cachedTwitchInfo(ctx context.Context, twitchUserID string) (*model.UserTwitchInfo, bool) {
+	info, ok, err := cache.GetJSON[*model.UserTwitchInfo](ctx, r.cache, twitchInfoCacheKey(twitchUserID))
+	if err != nil || !ok || info == nil {
+		return nil, false
+	}
+
+	return info, true
+}
 
-func initTwitchCache() {
-	cacheOnce.Do(func() {
-		var err error
-		twitchInfoCache, err = lru.New[string, CacheEntry](cacheSize)
-		if err != nil {
-			panic(fmt.Sprintf("failed to create cache: %v", err))
-		}
-	})
+func (r *userResolver) cacheTwitchInfo(ctx context.Context, twitchUserID string, info *model.UserTwitchInfo) {
+	_ = cache.SetJSON(ctx, r.cache, twitchInfoCacheKey(twitchUserID), info, twitchCacheTTL)
 }
@@ -0,0 +1,67 @@
+package gql
+
+import (
+	"context"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/google/uuid"
+)
+
+// BlockUser is the resolver for the blockUser field.
+func (r *mutationResolver) BlockUser(ctx context.Context, id uuid.UUID) (*generated.User, error) {
+	return r.updateUserRelation(ctx, id, "block user", func(uu *generated.UserUpdateOne) {
+		uu.AddBlockedUserIDs(id)
+	})
+}
+
+// UnblockUser is the resolver for the unblockUser field.
+func (r *mutationResolver) UnblockUser(ctx context.Context, id uuid.UUID) (*generated.User, error) {
+	return r.updateUserRelation(ctx, id, "unblock user", func(uu *generated.UserUpdateOne) {
+		uu.RemoveBlockedUserIDs(id)
+	})
+}
+
+// MuteUser is the resolver for the muteUser field.
+func (r *mutationResolver) MuteUser(ctx context.Context, id uuid.UUID) (*generated.User, error) {
+	return r.updateUserRelation(ctx, id, "mute user", func(uu *generated.UserUpdateOne) {
+		uu.AddMutedUserIDs(id)
+	})
+}
+
+// UnmuteUser is the resolver for the unmuteUser field.
+func (r *mutationResolver) UnmuteUser(ctx context.Context, id uuid.UUID) (*generated.User, error) {
+	return r.updateUserRelation(ctx, id, "unmute user", func(uu *generated.UserUpdateOne) {
+		uu.RemoveMutedUserIDs(id)
+	})
+}
+
+// BlockedUsers is the resolver for the blockedUsers field.
+func (r *queryResolver) BlockedUsers(ctx context.Context) ([]*generated.User, error) {
+	u := internal.GetUserFromCtx(ctx)
+	if u == nil {
+		return nil, internal.NewErrorf(internal.ErrorCodeUnauthenticated, "unauthenticated")
+	}
+
+	users, err := r.ent.User.QueryBlockedUsers(u).All(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "blocked users"})
+	}
+
+	return users, nil
+}
+
+// MutedUsers is the resolver for the mutedUsers field.
+func (r *queryResolver) MutedUsers(ctx context.Context) ([]*generated.User, error) {
+	u := internal.GetUserFromCtx(ctx)
+	if u == nil {
+		return nil, internal.NewErrorf(internal.ErrorCodeUnauthenticated, "unauthenticated")
+	}
+
+	users, err := r.ent.User.QueryMutedUsers(u).All(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "muted users"})
+	}
+
+	return users, nil
+}
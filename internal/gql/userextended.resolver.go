@@ -6,9 +6,10 @@ import (
 	"fmt"
 	"net/http"
 	"sync"
-	"time"
 
 	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/client"
 	"github.com/caliecode/la-clipasa/internal/ent/generated"
 	"github.com/caliecode/la-clipasa/internal/gql/model"
 )
@@ -27,7 +28,6 @@ func (r *queryResolver) Me(ctx context.Context) (*generated.User, error) {
 
 // TwitchInfo is the resolver for the twitchInfo field.
 func (r *userResolver) TwitchInfo(ctx context.Context, obj *generated.User) (*model.UserTwitchInfo, error) {
-	initTwitchCache()
 	l := internal.GetLoggerFromCtx(ctx)
 
 	ginCtx, err := GinContextFromCtx(ctx)
@@ -44,12 +44,9 @@ func (r *userResolver) TwitchInfo(ctx context.Context, obj *generated.User) (*mo
 	}
 	twitchUserID := u.Data[0].ID
 
-	if entry, found := twitchInfoCache.Get(twitchUserID); found {
-		if time.Since(entry.Timestamp) < twitchCacheTTL {
-			l.Debugf("Twitch info found in cache for user %s", twitchUserID)
-			return entry.Info, nil
-		}
-		twitchInfoCache.Remove(twitchUserID)
+	if info, found := r.cachedTwitchInfo(ctx, twitchUserID); found {
+		l.Debugf("Twitch info found in cache for user %s", twitchUserID)
+		return info, nil
 	}
 
 	isBroadcaster := (twitchUserID == internal.Config.Twitch.BroadcasterID)
@@ -85,17 +82,19 @@ func (r *userResolver) TwitchInfo(ctx context.Context, obj *generated.User) (*mo
 		}
 	}()
 
-	// TODO: requires broadcaster request
 	go func() {
 		defer wg.Done()
-		// ban, err := r.twitch.GetUserBanStatus(ginCtx, twitchUserID)
-		// if err != nil {
-		// 	banErr = err
-		// 	return
-		// }
-		// if len(ban.Data) > 0 {
-		// 	isBanned = true
-		// }
+		banned, err := auth.TwitchBansFromCtx(ctx).UserBanned(ctx, twitchUserID)
+		if err != nil {
+			// the broadcaster has not logged in yet
+			if errors.Is(err, client.ErrBroadcasterTokenNotFound) {
+				l.Warnf("Skipping twitch ban status: %v", err)
+				return
+			}
+			banErr = err
+			return
+		}
+		isBanned = banned
 	}()
 
 	wg.Wait()
@@ -112,10 +111,10 @@ func (r *userResolver) TwitchInfo(ctx context.Context, obj *generated.User) (*mo
 		IsBanned:      &isBanned,
 	}
 
-	twitchInfoCache.Add(twitchUserID, CacheEntry{
-		Info:      info,
-		Timestamp: time.Now(),
-	})
+	// shared with subscriber-only authorization
+	auth.TwitchSubscriptionsFromCtx(ctx).Cache(ctx, twitchUserID, isSubscriber)
+
+	r.cacheTwitchInfo(ctx, twitchUserID, info)
 
 	return info, nil
 }
@@ -3,11 +3,19 @@ package gql
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"strings"
 
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/auth"
 	"github.com/caliecode/la-clipasa/internal/client"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/postcategory"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolepermission"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
 	"github.com/caliecode/la-clipasa/internal/gql/extramodel"
+	"github.com/google/uuid"
 )
 
 var mutuallyExclCats = map[postcategory.Category]bool{
@@ -23,7 +31,30 @@ func newPostMetadata() *extramodel.PostMetadata {
 	}
 }
 
+const (
+	// UploadSizeLimit is the maximum upload size.
+	UploadSizeLimit = 10 << 20
+	// SubscriberUploadSizeLimit is the maximum upload size for subscribers of the broadcaster Twitch channel.
+	SubscriberUploadSizeLimit = 50 << 20
+)
+
+// checkUploadSize ensures the upload fits the size limit of the current user.
+func checkUploadSize(ctx context.Context, upload graphql.Upload) error {
+	if upload.Size > SubscriberUploadSizeLimit {
+		return newValidationError(fmt.Sprintf("upload exceeds the maximum size of %d MB", SubscriberUploadSizeLimit>>20))
+	}
+	if upload.Size > UploadSizeLimit && !auth.TwitchSubscriptionsFromCtx(ctx).IsSubscriber(ctx, internal.GetUserFromCtx(ctx)) {
+		return &auth.SubscriptionRequiredError{Action: fmt.Sprintf("upload files larger than %d MB", UploadSizeLimit>>20)}
+	}
+
+	return nil
+}
+
 func (r *mutationResolver) DiscordUpload(ctx context.Context, videoUpload graphql.Upload) (string, *extramodel.PostMetadata, error) {
+	if err := checkUploadSize(ctx, videoUpload); err != nil {
+		return "", nil, err
+	}
+
 	video, err := r.discord.UploadFile(ctx, videoUpload)
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to upload video to discord: %w", err)
@@ -46,3 +77,95 @@ func (r *mutationResolver) DiscordUpload(ctx context.Context, videoUpload graphq
 
 	return link, metadata, nil
 }
+
+// updateUserRelation applies a block or mute change from the current user to the target user.
+func (r *mutationResolver) updateUserRelation(ctx context.Context, targetID uuid.UUID, object string, fn func(*generated.UserUpdateOne)) (*generated.User, error) {
+	u := internal.GetUserFromCtx(ctx)
+	if u == nil {
+		return nil, internal.NewErrorf(internal.ErrorCodeUnauthenticated, "unauthenticated")
+	}
+	if u.ID == targetID {
+		return nil, newValidationError(fmt.Sprintf("cannot %s: target is the current user", object))
+	}
+
+	target, err := r.ent.User.Get(ctx, targetID)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "user"})
+	}
+
+	uu := r.ent.User.UpdateOneID(u.ID)
+	fn(uu)
+	if err := uu.Exec(ctx); err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: object})
+	}
+
+	return target, nil
+}
+
+// deviceFromUserAgent returns a short browser and OS description, e.g. "Firefox on Windows".
+func deviceFromUserAgent(ua string) string {
+	contains := func(substr string) bool { return strings.Contains(ua, substr) }
+
+	var browser string
+	switch {
+	case contains("Edg/"):
+		browser = "Edge"
+	case contains("OPR/"):
+		browser = "Opera"
+	case contains("Firefox/"):
+		browser = "Firefox"
+	case contains("Chrome/"):
+		browser = "Chrome"
+	case contains("Safari/"):
+		browser = "Safari"
+	}
+
+	var os string
+	switch {
+	case contains("Windows"):
+		os = "Windows"
+	case contains("Android"):
+		os = "Android"
+	case contains("iPhone"), contains("iPad"):
+		os = "iOS"
+	case contains("Mac OS X"):
+		os = "macOS"
+	case contains("Linux"):
+		os = "Linux"
+	}
+
+	switch {
+	case browser != "" && os != "":
+		return browser + " on " + os
+	case browser != "":
+		return browser
+	case os != "":
+		return os
+	default:
+		return "Unknown device"
+	}
+}
+
+// rolePermissions returns the permissions of a role as a non-nil list.
+func rolePermissions(ctx context.Context, role user.Role) ([]rolepermission.Permission, error) {
+	permissions, err := auth.RolePermissions(ctx, role)
+	if err != nil {
+		return nil, fmt.Errorf("could not get role permissions: %w", err)
+	}
+	if permissions == nil {
+		permissions = []rolepermission.Permission{}
+	}
+
+	return permissions, nil
+}
+
+// isCategoryOnlyUpdate reports whether a post update only changes categories.
+func isCategoryOnlyUpdate(input generated.UpdatePostInput) bool {
+	categories := generated.UpdatePostInput{
+		ClearCategories:   input.ClearCategories,
+		AddCategoryIDs:    input.AddCategoryIDs,
+		RemoveCategoryIDs: input.RemoveCategoryIDs,
+	}
+
+	return reflect.DeepEqual(input, categories)
+}
@@ -1,6 +1,8 @@
 package http
 
 import (
+	"net/http"
+
 	"github.com/caliecode/la-clipasa/internal/http/httputil"
 	"github.com/gin-gonic/gin"
 )
@@ -8,3 +10,9 @@ import (
 func (h *Handlers) SignOut(c *gin.Context) {
 	httputil.SignOutUser(c, *h.client)
 }
+
+// jwks serves the public keys that verify access tokens.
+func (h *Handlers) jwks(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, h.authn.Keyring().JWKS())
+}
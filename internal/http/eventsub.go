@@ -0,0 +1,151 @@
+package http
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/caliecode/la-clipasa/internal/models"
+	"github.com/caliecode/la-clipasa/internal/utils/cache"
+)
+
+// see https://dev.twitch.tv/docs/eventsub/handling-webhook-events/
+const (
+	eventSubMessageIDHeader        = "Twitch-Eventsub-Message-Id"
+	eventSubMessageTimestampHeader = "Twitch-Eventsub-Message-Timestamp"
+	eventSubMessageSignatureHeader = "Twitch-Eventsub-Message-Signature"
+	eventSubMessageTypeHeader      = "Twitch-Eventsub-Message-Type"
+
+	eventSubMessageTypeVerification = "webhook_callback_verification"
+	eventSubMessageTypeNotification = "notification"
+	eventSubMessageTypeRevocation   = "revocation"
+
+	// eventSubMaxMessageAge rejects older messages, so that deduplicating
+	// message ids for as long prevents replays.
+	eventSubMaxMessageAge = 10 * time.Minute
+	eventSubMaxBodySize   = 1 << 20
+)
+
+// eventSubHandler applies EventSub notifications.
+type eventSubHandler interface {
+	Handle(ctx context.Context, subscriptionType string, event json.RawMessage) error
+}
+
+// eventSubReceiver receives Twitch EventSub webhook messages.
+type eventSubReceiver struct {
+	logger  *zap.SugaredLogger
+	secret  []byte
+	handler eventSubHandler
+	// seen holds recent message ids, shared by instances so that
+	// a message retried on another instance is not handled twice.
+	seen cache.Store
+}
+
+func newEventSubReceiver(logger *zap.SugaredLogger, secret string, handler eventSubHandler, seen cache.Store) *eventSubReceiver {
+	return &eventSubReceiver{
+		logger:  logger,
+		secret:  []byte(secret),
+		handler: handler,
+		seen:    seen,
+	}
+}
+
+// Receive verifies and handles an EventSub webhook message.
+// Failed notifications respond with an error so that Twitch retries them.
+func (r *eventSubReceiver) Receive(c *gin.Context) {
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, eventSubMaxBodySize))
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	messageID := c.GetHeader(eventSubMessageIDHeader)
+	timestamp := c.GetHeader(eventSubMessageTimestampHeader)
+	if !verifyEventSubSignature(r.secret, messageID, timestamp, body, c.GetHeader(eventSubMessageSignatureHeader)) {
+		c.AbortWithStatus(http.StatusForbidden)
+		return
+	}
+
+	sentAt, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil || time.Since(sentAt) > eventSubMaxMessageAge {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var msg models.TwitchEventSubMessage
+	if err := json.Unmarshal(body, &msg); err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	switch c.GetHeader(eventSubMessageTypeHeader) {
+	case eventSubMessageTypeVerification:
+		r.logger.Infof("Verified EventSub subscription %s (%s)", msg.Subscription.ID, msg.Subscription.Type)
+		c.String(http.StatusOK, msg.Challenge)
+	case eventSubMessageTypeRevocation:
+		r.logger.Warnf("EventSub subscription %s (%s) revoked: %s", msg.Subscription.ID, msg.Subscription.Type, msg.Subscription.Status)
+		c.Status(http.StatusNoContent)
+	case eventSubMessageTypeNotification:
+		ctx := c.Request.Context()
+		if !r.claim(ctx, messageID) {
+			c.Status(http.StatusNoContent)
+			return
+		}
+		if err := r.handler.Handle(ctx, msg.Subscription.Type, msg.Event); err != nil {
+			r.release(ctx, messageID)
+			r.logger.Errorf("Error handling EventSub %s notification: %v", msg.Subscription.Type, err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.Status(http.StatusNoContent)
+	default:
+		c.AbortWithStatus(http.StatusBadRequest)
+	}
+}
+
+func eventSubMessageCacheKey(messageID string) string {
+	return "eventsub-message:" + messageID
+}
+
+// claim reports whether a message id was not seen recently, and marks it as seen.
+// Messages are handled if the store is unavailable, since handling is idempotent.
+func (r *eventSubReceiver) claim(ctx context.Context, messageID string) bool {
+	ok, err := r.seen.SetNX(ctx, eventSubMessageCacheKey(messageID), []byte{1}, eventSubMaxMessageAge)
+	if err != nil {
+		r.logger.Warnf("Could not deduplicate EventSub message %s: %v", messageID, err)
+		return true
+	}
+
+	return ok
+}
+
+// release allows a message to be retried.
+func (r *eventSubReceiver) release(ctx context.Context, messageID string) {
+	_ = r.seen.Delete(ctx, eventSubMessageCacheKey(messageID))
+}
+
+// verifyEventSubSignature verifies the HMAC of an EventSub message.
+func verifyEventSubSignature(secret []byte, messageID, timestamp string, body []byte, signature string) bool {
+	if len(secret) == 0 || messageID == "" || timestamp == "" {
+		return false
+	}
+
+	return hmac.Equal([]byte(eventSubSignature(secret, messageID, timestamp, body)), []byte(signature))
+}
+
+func eventSubSignature(secret []byte, messageID, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(messageID))
+	mac.Write([]byte(timestamp))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,209 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/models"
+	"github.com/caliecode/la-clipasa/internal/testutil"
+	"github.com/caliecode/la-clipasa/internal/twitchsync"
+	"github.com/caliecode/la-clipasa/internal/utils/cache"
+)
+
+const testEventSubSecret = "eventsub-test-secret"
+
+type fakeModerators map[string]bool
+
+func (f fakeModerators) SetModerator(ctx context.Context, twitchUserID string, moderator bool) error {
+	f[twitchUserID] = moderator
+
+	return nil
+}
+
+// countingEventSubHandler counts handled notifications and optionally fails them.
+type countingEventSubHandler struct {
+	handler eventSubHandler
+	calls   int
+	err     error
+}
+
+func (h *countingEventSubHandler) Handle(ctx context.Context, subscriptionType string, event json.RawMessage) error {
+	h.calls++
+	if h.err != nil {
+		return h.err
+	}
+
+	return h.handler.Handle(ctx, subscriptionType, event)
+}
+
+type fakeStreamFetcher struct {
+	calls int
+}
+
+func (f *fakeStreamFetcher) BroadcasterStream(ctx context.Context) (*models.TwitchStream, error) {
+	f.calls++
+
+	return nil, nil
+}
+
+type failingTwitchChecker struct{}
+
+func (failingTwitchChecker) UserBan(ctx context.Context, twitchUserID string) (bool, time.Time, error) {
+	return false, time.Time{}, errors.New("unexpected twitch call")
+}
+
+func (failingTwitchChecker) UserSubscribed(ctx context.Context, twitchUserID string) (bool, error) {
+	return false, errors.New("unexpected twitch call")
+}
+
+// newEventSubRequest returns a request with a fixture payload signed with secret.
+func newEventSubRequest(t *testing.T, secret, messageType, fixture, messageID string, sentAt time.Time) *http.Request {
+	t.Helper()
+
+	body, err := os.ReadFile(filepath.Join("testdata", "eventsub", fixture+".json"))
+	require.NoError(t, err)
+
+	timestamp := sentAt.UTC().Format(time.RFC3339Nano)
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/twitch/eventsub", bytes.NewReader(body))
+	req.Header.Set(eventSubMessageIDHeader, messageID)
+	req.Header.Set(eventSubMessageTimestampHeader, timestamp)
+	req.Header.Set(eventSubMessageTypeHeader, messageType)
+	req.Header.Set(eventSubMessageSignatureHeader, eventSubSignature([]byte(secret), messageID, timestamp, body))
+
+	return req
+}
+
+func TestEventSubReceiver(t *testing.T) {
+	ctx := context.Background()
+	moderators := fakeModerators{}
+	fetcher := &fakeStreamFetcher{}
+	streams := twitchsync.NewStreams(fetcher, cache.NewMemory(0))
+	bans := auth.NewTwitchBans(failingTwitchChecker{}, cache.NewMemory(0))
+	subscriptions := auth.NewTwitchSubscriptions(failingTwitchChecker{}, cache.NewMemory(0))
+	handler := &countingEventSubHandler{handler: twitchsync.NewEventHandler(moderators, streams, bans, subscriptions)}
+	seen := cache.NewMemory(0)
+
+	newEngine := func() *gin.Engine {
+		receiver := newEventSubReceiver(testutil.NewLogger(t), testEventSubSecret, handler, seen)
+		_, engine := gin.CreateTestContext(httptest.NewRecorder())
+		engine.POST("/webhooks/twitch/eventsub", receiver.Receive)
+
+		return engine
+	}
+	engine := newEngine()
+
+	serve := func(req *http.Request) *httptest.ResponseRecorder {
+		resp := httptest.NewRecorder()
+		engine.ServeHTTP(resp, req)
+
+		return resp
+	}
+
+	t.Run("verification challenge", func(t *testing.T) {
+		resp := serve(newEventSubRequest(t, testEventSubSecret, eventSubMessageTypeVerification, "webhook_callback_verification", "verification-1", time.Now()))
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "pogchamp-kappa-360noscope-vohiyo", resp.Body.String())
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		resp := serve(newEventSubRequest(t, "wrong-secret", eventSubMessageTypeNotification, "channel.ban", "invalid-signature", time.Now()))
+		assert.Equal(t, http.StatusForbidden, resp.Code)
+
+		req := newEventSubRequest(t, testEventSubSecret, eventSubMessageTypeNotification, "channel.ban", "tampered-id", time.Now())
+		req.Header.Set(eventSubMessageIDHeader, "other-id")
+		resp = serve(req)
+		assert.Equal(t, http.StatusForbidden, resp.Code)
+		assert.Zero(t, handler.calls)
+	})
+
+	t.Run("stale message", func(t *testing.T) {
+		resp := serve(newEventSubRequest(t, testEventSubSecret, eventSubMessageTypeNotification, "channel.ban", "stale", time.Now().Add(-eventSubMaxMessageAge-time.Minute)))
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Zero(t, handler.calls)
+	})
+
+	t.Run("notifications", func(t *testing.T) {
+		notify := func(fixture, messageID string) {
+			t.Helper()
+
+			resp := serve(newEventSubRequest(t, testEventSubSecret, eventSubMessageTypeNotification, fixture, messageID, time.Now()))
+			require.Equal(t, http.StatusNoContent, resp.Code)
+		}
+
+		// stream events invalidate the cached stream
+		_, err := streams.BroadcasterStream(ctx)
+		require.NoError(t, err)
+		for i, fixture := range []string{"stream.online", "stream.offline"} {
+			notify(fixture, fixture+"-1")
+			_, err = streams.BroadcasterStream(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, i+2, fetcher.calls)
+		}
+
+		notify("channel.ban", "ban-1")
+		banned, err := bans.UserBanned(ctx, "1337")
+		require.NoError(t, err)
+		assert.True(t, banned)
+		notify("channel.unban", "unban-1")
+		banned, err = bans.UserBanned(ctx, "1337")
+		require.NoError(t, err)
+		assert.False(t, banned)
+
+		notify("channel.subscribe", "subscribe-1")
+		subscribed, err := subscriptions.UserSubscribed(ctx, "1337")
+		require.NoError(t, err)
+		assert.True(t, subscribed)
+
+		notify("channel.moderator.add", "moderator-add-1")
+		assert.True(t, moderators["1337"])
+		notify("channel.moderator.remove", "moderator-remove-1")
+		assert.False(t, moderators["1337"])
+	})
+
+	t.Run("duplicate messages", func(t *testing.T) {
+		calls := handler.calls
+		for range 2 {
+			resp := serve(newEventSubRequest(t, testEventSubSecret, eventSubMessageTypeNotification, "channel.moderator.add", "moderator-add-2", time.Now()))
+			require.Equal(t, http.StatusNoContent, resp.Code)
+		}
+		assert.Equal(t, calls+1, handler.calls)
+
+		// messages are deduplicated across instances sharing the store
+		resp := httptest.NewRecorder()
+		newEngine().ServeHTTP(resp, newEventSubRequest(t, testEventSubSecret, eventSubMessageTypeNotification, "channel.moderator.add", "moderator-add-2", time.Now()))
+		require.Equal(t, http.StatusNoContent, resp.Code)
+		assert.Equal(t, calls+1, handler.calls)
+	})
+
+	t.Run("failed notifications are retried", func(t *testing.T) {
+		handler.err = errors.New("database unavailable")
+		resp := serve(newEventSubRequest(t, testEventSubSecret, eventSubMessageTypeNotification, "channel.ban", "ban-2", time.Now()))
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+		handler.err = nil
+		calls := handler.calls
+		resp = serve(newEventSubRequest(t, testEventSubSecret, eventSubMessageTypeNotification, "channel.ban", "ban-2", time.Now()))
+		assert.Equal(t, http.StatusNoContent, resp.Code)
+		assert.Equal(t, calls+1, handler.calls)
+	})
+
+	t.Run("revocation", func(t *testing.T) {
+		calls := handler.calls
+		resp := serve(newEventSubRequest(t, testEventSubSecret, eventSubMessageTypeRevocation, "revocation", "revocation-1", time.Now()))
+		assert.Equal(t, http.StatusNoContent, resp.Code)
+		assert.Equal(t, calls, handler.calls)
+	})
+}
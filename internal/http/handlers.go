@@ -5,9 +5,9 @@ import (
 	"net/http"
 
 	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/client"
 	"github.com/caliecode/la-clipasa/internal/ent/generated"
 	"github.com/gin-gonic/gin"
-	"github.com/zitadel/oidc/v3/pkg/client/rp"
 	"go.uber.org/zap"
 )
 
@@ -27,10 +27,6 @@ func (w *responseBodyWriter) writeResponse() {
 	w.ResponseWriter.Write(w.body.Bytes())
 }
 
-type OAuth2Provider interface {
-	rp.RelyingParty
-}
-
 type OAuth2LoginMode string
 
 const (
@@ -42,12 +38,16 @@ func (l OAuth2LoginMode) Valid() bool {
 	return l == OAuth2LoginModeUser || l == OAuth2LoginModeBroadcaster
 }
 
-type OAuth2Providers map[OAuth2LoginMode]OAuth2Provider
-
 type Handlers struct {
 	logger          *zap.SugaredLogger
 	authmw          *authMiddleware
 	oauth2Providers OAuth2Providers
 	authn           *auth.Authentication
 	client          *generated.Client
+	// broadcasterTokens stores the tokens obtained via the broadcaster login mode.
+	broadcasterTokens *client.BroadcasterTokenSource
+	// twitchBans checks bans in the broadcaster Twitch channel.
+	twitchBans *auth.TwitchBans
+	// twitchTokens stores the Twitch tokens of user sessions.
+	twitchTokens *client.UserTokenSource
 }
@@ -46,14 +46,21 @@ import (
 	laclipasa "github.com/caliecode/la-clipasa"
 	"github.com/caliecode/la-clipasa/internal"
 	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/awards"
+	"github.com/caliecode/la-clipasa/internal/client"
 	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
 	"github.com/caliecode/la-clipasa/internal/envvar"
 	"github.com/caliecode/la-clipasa/internal/gql"
+	"github.com/caliecode/la-clipasa/internal/leaderboard"
 	postgresql "github.com/caliecode/la-clipasa/internal/postgres"
+	"github.com/caliecode/la-clipasa/internal/twitchsync"
+	"github.com/caliecode/la-clipasa/internal/utils/cache"
 	"github.com/caliecode/la-clipasa/internal/utils/format"
 	"github.com/caliecode/la-clipasa/internal/utils/format/colors"
 	"github.com/caliecode/la-clipasa/internal/utils/logger"
 	postgresqlutils "github.com/caliecode/la-clipasa/internal/utils/postgresql"
+	"github.com/caliecode/la-clipasa/internal/utils/ratelimit"
 )
 
 const (
@@ -114,8 +121,21 @@ func LogResponseMiddleware(out io.Writer) gin.HandlerFunc {
 }
 
 type Server struct {
-	Httpsrv     *http.Server
-	middlewares []gin.HandlerFunc
+	Httpsrv *http.Server
+	Authn   *auth.Authentication
+	// Cache is shared by instances if a key-value db is configured.
+	Cache cache.Store
+	// TwitchBans and TwitchSubscriptions are the checks shared by requests and notifications.
+	TwitchBans          *auth.TwitchBans
+	TwitchSubscriptions *auth.TwitchSubscriptions
+	// Streams is the broadcaster stream status shared by requests and notifications.
+	Streams *twitchsync.Streams
+
+	middlewares         []gin.HandlerFunc
+	banChecker          auth.TwitchBanChecker
+	subscriptionChecker auth.TwitchSubscriptionChecker
+	streamFetcher       twitchsync.StreamFetcher
+	clipFetcher         twitchsync.ClipFetcher
 }
 
 type ServerOption func(*Server)
@@ -127,6 +147,28 @@ func WithMiddlewares(mws ...gin.HandlerFunc) ServerOption {
 	}
 }
 
+// WithTwitchCheckers replaces the broadcaster Twitch client used to check channel bans and subscriptions.
+func WithTwitchCheckers(bans auth.TwitchBanChecker, subscriptions auth.TwitchSubscriptionChecker) ServerOption {
+	return func(s *Server) {
+		s.banChecker = bans
+		s.subscriptionChecker = subscriptions
+	}
+}
+
+// WithStreamFetcher replaces the Twitch app client used to fetch the broadcaster stream.
+func WithStreamFetcher(fetcher twitchsync.StreamFetcher) ServerOption {
+	return func(s *Server) {
+		s.streamFetcher = fetcher
+	}
+}
+
+// WithClipFetcher replaces the Twitch app client used to fetch clips.
+func WithClipFetcher(fetcher twitchsync.ClipFetcher) ServerOption {
+	return func(s *Server) {
+		s.clipFetcher = fetcher
+	}
+}
+
 var key = []byte("test1234test1234")
 
 type responseWriterLogger struct {
@@ -173,6 +215,10 @@ func NewServer(ctx context.Context, conf Config, opts ...ServerOption) (*Server,
 	}))
 	router.Use(ginzap.RecoveryWithZap(conf.Logger.Desugar(), true))
 
+	if err := setClientIPHeaders(router, cfg); err != nil {
+		return nil, fmt.Errorf("invalid trusted proxies: %w", err)
+	}
+
 	var host string
 	switch cfg.AppEnv {
 	case internal.AppEnvProd, internal.AppEnvE2E:
@@ -214,6 +260,10 @@ func NewServer(ctx context.Context, conf Config, opts ...ServerOption) (*Server,
 			"Set-Cookie",
 			"Authorization",
 			"X-Custom-Header",
+			"RateLimit-Limit",
+			"RateLimit-Remaining",
+			"RateLimit-Reset",
+			"Retry-After",
 		},
 		AllowCredentials: true,
 		AllowWebSockets:  true,
@@ -232,21 +282,51 @@ func NewServer(ctx context.Context, conf Config, opts ...ServerOption) (*Server,
 	}
 	entclient := generated.FromContext(ctx)
 
-	apiRouter.Use(func(c *gin.Context) {
+	cacheStore, err := newCacheStore(ctx, conf.Logger)
+	if err != nil {
+		return nil, err
+	}
+	srv.Cache = cacheStore
+	ctx = cache.NewContext(ctx, cacheStore)
+
+	broadcasterTokens := client.NewBroadcasterTokenSource(entclient)
+	broadcasterClient := client.NewTwitchBroadcasterClient(broadcasterTokens)
+	if srv.banChecker == nil {
+		srv.banChecker = broadcasterClient
+	}
+	if srv.subscriptionChecker == nil {
+		srv.subscriptionChecker = broadcasterClient
+	}
+	srv.TwitchBans = auth.NewTwitchBans(srv.banChecker, cacheStore)
+	srv.TwitchSubscriptions = auth.NewTwitchSubscriptions(srv.subscriptionChecker, cacheStore)
+
+	userTokens := client.NewUserTokenSource(entclient)
+	appClient := client.NewTwitchAppClient(client.NewAppTokenSource())
+	twitchHandlers := client.NewTwitchHandlers(entclient, userTokens, appClient, broadcasterClient)
+	if srv.streamFetcher == nil {
+		srv.streamFetcher = appClient
+	}
+	if srv.clipFetcher == nil {
+		srv.clipFetcher = appClient
+	}
+	srv.Streams = twitchsync.NewStreams(srv.streamFetcher, cacheStore)
+
+	requestContext := func(c *gin.Context) {
 		requestCtx := context.WithValue(c.Request.Context(), ginCtxKey, c)
 		requestCtx = internal.SetLoggerCtx(requestCtx, conf.Logger)
 		requestCtx = generated.NewContext(requestCtx, entclient)
+		requestCtx = cache.NewContext(requestCtx, cacheStore)
+		requestCtx = auth.WithTwitchBans(requestCtx, srv.TwitchBans)
+		requestCtx = auth.WithTwitchSubscriptions(requestCtx, srv.TwitchSubscriptions)
 		c.Request = c.Request.WithContext(requestCtx)
 		c.Next()
-	})
+	}
+	apiRouter.Use(requestContext)
 
 	for _, mw := range srv.middlewares {
 		apiRouter.Use(mw)
 	}
 
-	userScopes := strings.Split(cfg.TwitchOIDC.UserScopes, " ")
-	broadcasterScopes := strings.Split(cfg.TwitchOIDC.BroadcasterScopes, " ")
-
 	cookieHandler := httphelper.NewCookieHandler(key, key, httphelper.WithUnsecure())
 
 	providerOptions := []rp.Option{
@@ -255,77 +335,109 @@ func NewServer(ctx context.Context, conf Config, opts ...ServerOption) (*Server,
 		rp.WithSigningAlgsFromDiscovery(),
 	}
 
-	twitchUserProvider, err := rp.NewRelyingPartyOIDC(ctx,
-		cfg.TwitchOIDC.Issuer,
-		cfg.TwitchOIDC.ClientID,
-		cfg.TwitchOIDC.ClientSecret,
-		internal.BuildAPIURL("/auth/twitch/callback"),
-		userScopes,
-		providerOptions...)
-	if err != nil {
-		return nil, fmt.Errorf("error creating twitch user provider: %w", err)
+	if port := cfg.TwitchOIDC.MockServerPort; port != nil && *port != "" {
+		if err := startMockOIDCServer(ctx, cfg, conf.Logger); err != nil {
+			return nil, err
+		}
 	}
 
-	twitchBroadcasterProvider, err := rp.NewRelyingPartyOIDC(ctx,
-		cfg.TwitchOIDC.Issuer,
-		cfg.TwitchOIDC.ClientID,
-		cfg.TwitchOIDC.ClientSecret,
-		internal.BuildAPIURL("/auth/twitch/callback"),
-		broadcasterScopes,
-		providerOptions...)
+	oauth2Providers, err := newOAuth2Providers(ctx, cfg, providerOptions...)
 	if err != nil {
-		return nil, fmt.Errorf("error creating twitch broadcast provider: %w", err)
+		return nil, err
 	}
 
-	authn := auth.NewAuthentication(entclient)
+	authn, err := auth.NewAuthentication(entclient, twitchHandlers)
+	if err != nil {
+		return nil, err
+	}
+	srv.Authn = authn
 	handlers := Handlers{
-		client: entclient,
-		logger: conf.Logger,
-		authmw: NewAuthMiddleware(conf.Logger, authn, entclient),
-		oauth2Providers: OAuth2Providers{
-			OAuth2LoginModeBroadcaster: twitchBroadcasterProvider,
-			OAuth2LoginModeUser:        twitchUserProvider,
-		},
-		authn: authn,
+		client:          entclient,
+		logger:          conf.Logger,
+		authmw:          NewAuthMiddleware(conf.Logger, authn, entclient),
+		oauth2Providers: oauth2Providers,
+		authn:           authn,
+
+		broadcasterTokens: broadcasterTokens,
+		twitchBans:        srv.TwitchBans,
+		twitchTokens:      userTokens,
 	}
 
-	go func() {
+	runPeriodically(ctx, time.Hour, func(ctx context.Context) {
 		authn.CleanupExpiredAndRevokedTokens(ctx)
-		ticker := time.NewTicker(time.Hour)
-		for range ticker.C {
-			authn.CleanupExpiredAndRevokedTokens(ctx)
+	})
+
+	awardsEvaluator := awards.NewEvaluator(entclient)
+	runPeriodically(ctx, 6*time.Hour, awardsEvaluator.Backfill)
+
+	leaderboards := leaderboard.New(entclient, cacheStore)
+	runPeriodically(ctx, leaderboard.RefreshInterval, leaderboards.Refresh)
+
+	runPeriodically(ctx, client.BroadcasterTokenRefreshInterval, func(ctx context.Context) {
+		if _, err := broadcasterTokens.Token(ctx); err != nil && !errors.Is(err, client.ErrBroadcasterTokenNotFound) {
+			conf.Logger.Errorf("Error refreshing broadcaster token: %v", err)
 		}
-	}()
+	})
+
+	if importClips := cfg.Twitch.ImportClips; importClips != nil && *importClips {
+		clipImporter := twitchsync.NewClipImporter(entclient, srv.clipFetcher)
+		runPeriodically(ctx, twitchsync.ClipsImportInterval, func(ctx context.Context) {
+			if _, err := clipImporter.Import(ctx); err != nil {
+				conf.Logger.Errorf("Error importing twitch clips: %v", err)
+			}
+		})
+	}
+
+	roleSyncer := twitchsync.NewRoleSyncer(entclient, broadcasterClient)
+	runPeriodically(ctx, twitchsync.RolesSyncInterval, func(ctx context.Context) {
+		if err := roleSyncer.Sync(ctx); err != nil && !errors.Is(err, client.ErrBroadcasterTokenNotFound) {
+			conf.Logger.Errorf("Error syncing twitch roles: %v", err)
+		}
+	})
+
+	if secret := cfg.Twitch.EventSubSecret; secret != nil && *secret != "" {
+		eventSub := newEventSubReceiver(conf.Logger, *secret, twitchsync.NewEventHandler(roleSyncer, srv.Streams, srv.TwitchBans, srv.TwitchSubscriptions), cacheStore)
+		router.POST("/webhooks/twitch/eventsub", requestContext, eventSub.Receive)
+	}
 
+	rateLimiter := newRateLimiter(cacheStore)
+	rlMw := newRateLimitMiddleware(conf.Logger, rateLimiter)
+	limit := func(string, rateLimits) gin.HandlerFunc { return func(c *gin.Context) { c.Next() } }
 	switch cfg.AppEnv {
 	case internal.AppEnvProd, internal.AppEnvE2E:
-		rlMw := newRateLimitMiddleware(conf.Logger, 15, 5)
-		apiRouter.Use(rlMw.Limit())
+		limit = rlMw.Limit
 	case internal.AppEnvDev, internal.AppEnvCI:
-		rlMw := newRateLimitMiddleware(conf.Logger, 15, 5)
 		if os.Getenv("IS_TESTING") == "" {
-			apiRouter.Use(rlMw.Limit())
+			limit = rlMw.Limit
 			apiRouter.Use(LogResponseMiddleware(os.Stdout))
 		}
 	default:
 		panic("unknown app env: " + cfg.AppEnv)
 	}
+	defaultLimit := limit("default", defaultRateLimits)
 
 	entClient := generated.FromContext(ctx)
 
-	authg := apiRouter.Group("/auth")
+	authg := apiRouter.Group("/auth", limit("auth", authRateLimits))
 	authg.GET("/signout", handlers.SignOut)
-	authg.GET("/twitch/login", handlers.twitchLogin)
-	authg.GET("/twitch/callback", handlers.codeExchange, handlers.twitchCallback)
+	authg.GET("/:provider/login", handlers.oidcLogin)
+	authg.GET("/:provider/callback", handlers.authmw.TryAuthentication(), handlers.codeExchange, handlers.oidcCallback)
+	authg.GET("/:provider/link", handlers.authmw.TryAuthentication(), handlers.oidcLink)
+
+	apiRouter.GET("/oembed", defaultLimit, handlers.oEmbed)
 
 	if cfg.AppEnv != internal.AppEnvProd {
-		apiRouter.GET("/gql-apollo", gin.WrapH(playground.ApolloSandboxHandler("GraphQL", apiRouter.BasePath()+"/graphql")))
-		apiRouter.GET("/gql-altair", gin.WrapH(playground.AltairHandler("GraphQL", apiRouter.BasePath()+"/graphql", map[string]any{})))
+		apiRouter.GET("/gql-apollo", defaultLimit, gin.WrapH(playground.ApolloSandboxHandler("GraphQL", apiRouter.BasePath()+"/graphql")))
+		apiRouter.GET("/gql-altair", defaultLimit, gin.WrapH(playground.AltairHandler("GraphQL", apiRouter.BasePath()+"/graphql", map[string]any{})))
 	}
 
-	apiRouter.Use(handlers.authmw.TryAuthentication())
+	// limited by IP before authentication so that floods don't reach the database,
+	// then after authentication to have separate limits per user and API key
+	apiRouter.Use(limit("graphql-ip", graphqlIPRateLimits), handlers.authmw.TryAuthentication())
+
+	apiRouter.POST("/graphql", limit("graphql", graphqlRateLimits), graphqlHandler(entClient, authn, twitchHandlers, srv.Streams, srv.clipFetcher, leaderboards, cacheStore, rateLimiter))
 
-	apiRouter.POST("/graphql", graphqlHandler(entClient))
+	router.GET("/.well-known/jwks.json", handlers.jwks)
 
 	// have to define before serving static assets.
 	router.GET("/", func(c *gin.Context) {
@@ -339,16 +451,7 @@ func NewServer(ctx context.Context, conf Config, opts ...ServerOption) (*Server,
 	// Client-side routing fallback
 	router.NoRoute(func(c *gin.Context) {
 		if !strings.HasPrefix(c.Request.RequestURI, "/api") {
-			indexFile, err := laclipasa.FrontendBuildFS.Open("frontend/build/index.html")
-			if err != nil {
-				fmt.Printf("err: %v\n", err)
-				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-				return
-			}
-			defer indexFile.Close()
-
-			// will load assets via static.Serve at /
-			c.DataFromReader(http.StatusOK, -1, "text/html", indexFile, nil)
+			handlers.serveFrontendIndex(c)
 		}
 		// default 404 page not found
 	})
@@ -365,6 +468,24 @@ func NewServer(ctx context.Context, conf Config, opts ...ServerOption) (*Server,
 	return srv, nil
 }
 
+// runPeriodically runs fn in the background at once and then every interval, until ctx is done.
+func runPeriodically(ctx context.Context, interval time.Duration, fn func(ctx context.Context)) {
+	go func() {
+		fn(ctx)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				fn(ctx)
+			}
+		}
+	}()
+}
+
 // Run configures a server and underlying services with the given configuration.
 // NewServer takes its own config as is now.
 func Run(env string) (<-chan error, error) {
@@ -427,6 +548,11 @@ func Run(env string) (<-chan error, error) {
 		defer func() {
 			_ = logger.Sync()
 
+			logger.Infof("Closing cache")
+			if err := srv.Cache.Close(); err != nil {
+				logger.Error("srv.Cache.Close", zap.Error(err))
+			}
+
 			logger.Infof("Closing ent client")
 			if err := entClient.Close(); err != nil {
 				logger.Error("entClient.Close", zap.Error(err))
@@ -524,21 +650,45 @@ func migrateUp(logger *zap.SugaredLogger, pool *pgxpool.Pool) {
 	logger.Infof("Migrations completed: %d (dirty=%v)", v, dirty)
 }
 
-func graphqlHandler(entClient *generated.Client) gin.HandlerFunc {
+// graphqlCostBudgets are the query cost budgets of clients per minute.
+// A single operation may cost up to a full budget, e.g. a page of 10 posts costs about 1100.
+var graphqlCostBudgets = gql.CostBudgets{
+	Window:    time.Minute,
+	Anonymous: 15000,
+	User:      25000,
+	APIKey:    25000,
+	Roles: map[user.Role]int{
+		user.RoleMODERATOR: 100000,
+		user.RoleADMIN:     200000,
+	},
+}
+
+func graphqlHandler(
+	entClient *generated.Client,
+	authn *auth.Authentication,
+	twitch *client.TwitchHandlers,
+	streams *twitchsync.Streams,
+	clips twitchsync.ClipFetcher,
+	leaderboards *leaderboard.Leaderboards,
+	cacheStore cache.Store,
+	costLimiter ratelimit.Limiter,
+) gin.HandlerFunc {
 	// NewExecutableSchema and Config are in the generated.go file
-	srv := handler.New(gql.NewExecutableSchema(gql.NewResolver(entClient)))
+	srv := handler.New(gql.NewExecutableSchema(gql.NewResolver(entClient, authn, twitch, streams, clips, leaderboards, cacheStore)))
 	srv.SetErrorPresenter(gql.NewErrorPresenter())
 	srv.Use(entgql.Transactioner{
 		TxOpener: entClient,
 		// see https://entgo.io/docs/tutorial-todo-gql-tx-mutation for skipping tx based on ops, etc.
 	})
-	srv.Use(extension.FixedComplexityLimit(200))
+	// suspended users spend no cost budget
+	srv.Use(gql.SuspensionGuard{})
+	srv.Use(gql.NewCostLimit(costLimiter, graphqlCostBudgets))
 	srv.AddTransport(transport.Options{})
 	srv.AddTransport(transport.GET{})
 	srv.AddTransport(transport.POST{})
 	srv.AddTransport(transport.MultipartForm{
 		MaxMemory:     10 << 20,
-		MaxUploadSize: 10 << 20,
+		MaxUploadSize: gql.SubscriberUploadSizeLimit, // enforced per user on upload
 	})
 	srv.AddTransport(transport.Websocket{
 		KeepAlivePingInterval: 10 * time.Second,
@@ -633,3 +783,75 @@ func (s *staticFileSystem) Exists(prefix string, path string) bool {
 
 	return false
 }
+
+// newCacheStore returns a Redis cache shared by instances if a key-value db is configured,
+// else an in-memory cache.
+func newCacheStore(ctx context.Context, logger *zap.SugaredLogger) (cache.Store, error) {
+	kv := internal.Config.KV
+	if kv.Host == nil || *kv.Host == "" {
+		return cache.NewMemory(cache.DefaultMemorySize), nil
+	}
+
+	opts := cache.RedisOptions{
+		Addr:      *kv.Host,
+		Namespace: internal.Config.ProjectPrefix + ":",
+		LocalSize: cache.DefaultMemorySize,
+		Logger:    logger,
+	}
+	if kv.DB != nil {
+		opts.DB = *kv.DB
+	}
+	if kv.Password != nil {
+		opts.Password = *kv.Password
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	store, err := cache.NewRedis(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to key-value db: %w", err)
+	}
+
+	return store, nil
+}
+
+// newRateLimiter returns a limiter shared by instances if the cache is, else an in-memory limiter.
+func newRateLimiter(cacheStore cache.Store) ratelimit.Limiter {
+	if redis, ok := cacheStore.(*cache.Redis); ok {
+		return ratelimit.NewRedis(redis)
+	}
+
+	return ratelimit.NewMemory()
+}
+
+// setClientIPHeaders sets the reverse proxies trusted to report the client IP.
+// On Fly.io, the Fly proxy sets Fly-Client-IP on every request, so it is trusted
+// unless proxies are configured. X-Forwarded-For is not, since clients can prepend to it.
+func setClientIPHeaders(router *gin.Engine, cfg *internal.AppConfig) error {
+	proxies := trustedProxies(cfg)
+	if proxies == nil && cfg.FlyAppName != nil && *cfg.FlyAppName != "" {
+		router.RemoteIPHeaders = []string{"Fly-Client-IP"}
+
+		return router.SetTrustedProxies([]string{"0.0.0.0/0", "::/0"})
+	}
+
+	router.RemoteIPHeaders = []string{"Fly-Client-IP", "X-Forwarded-For"}
+
+	return router.SetTrustedProxies(proxies)
+}
+
+// trustedProxies returns the configured reverse proxies, trusting none by default.
+func trustedProxies(cfg *internal.AppConfig) []string {
+	tp := cfg.TrustedProxies
+	if tp == nil || strings.TrimSpace(*tp) == "" {
+		return nil
+	}
+
+	proxies := strings.Split(*tp, ",")
+	for i, p := range proxies {
+		proxies[i] = strings.TrimSpace(p)
+	}
+
+	return proxies
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/caliecode/la-clipasa/internal/ent/generated"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
 	"github.com/caliecode/la-clipasa/internal/ent/generated/refreshtoken"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/twitchtoken"
 	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
 	"github.com/gin-gonic/gin"
 )
@@ -26,6 +27,7 @@ func SignOutUser(c *gin.Context, client generated.Client) {
 	// FIXME: works in prod but not localhost (bad domain due to port prob?)
 }
 
+// ClearTwitchAuthCookie removes the cookie Twitch tokens were kept in before being stored server-side.
 func ClearTwitchAuthCookie(c *gin.Context) {
 	http.SetCookie(c.Writer, &http.Cookie{
 		Name:     internal.Config.Twitch.AuthInfoCookieKey,
@@ -49,6 +51,9 @@ func ClearRefreshTokenCookie(c *gin.Context, entClient generated.Client) {
 		refreshTokenHashString := base64.URLEncoding.EncodeToString(refreshTokenHash[:])
 		ctx := privacy.DecisionContext(c.Request.Context(), privacy.Allow) // httpOnly cookie so should be safe
 		ctx = token.NewContextWithSystemCallToken(ctx)                     // for updateone on refresh token
+		if rt, err := entClient.RefreshToken.Query().Where(refreshtoken.TokenHash(refreshTokenHashString)).Only(ctx); err == nil {
+			_, _ = entClient.TwitchToken.Delete().Where(twitchtoken.SessionID(rt.FamilyID)).Exec(ctx)
+		}
 		_, _ = entClient.RefreshToken.Delete().Where(refreshtoken.TokenHash(refreshTokenHashString)).Exec(ctx)
 	}
 
@@ -65,19 +70,6 @@ func ClearRefreshTokenCookie(c *gin.Context, entClient generated.Client) {
 	})
 }
 
-func SetTwitchAuthCookie(c *gin.Context, b64Token string) {
-	http.SetCookie(c.Writer, &http.Cookie{
-		Name:     internal.Config.Twitch.AuthInfoCookieKey,
-		Value:    b64Token,
-		Path:     "/",
-		MaxAge:   3600 * 24 * 365,
-		Secure:   true,
-		HttpOnly: true, // prevent js access
-		Domain:   internal.Config.CookieDomain,
-		SameSite: http.SameSiteLaxMode,
-	})
-}
-
 func SetRefreshTokenCookie(c *gin.Context, token string, ttl time.Duration) {
 	http.SetCookie(c.Writer, &http.Cookie{
 		Name:     RefreshTokenCookieName,
@@ -49,10 +49,13 @@ func (m *authMiddleware) TryAuthentication() gin.HandlerFunc {
 		sysCtx := token.NewContextWithSystemCallToken(ctx)
 		sysCtx = privacy.DecisionContext(sysCtx, privacy.Allow)
 		if apiKey != "" {
-			apiKeyUser, err := m.authn.GetUserFromAPIKey(sysCtx, apiKey)
+			apiKeyUser, ak, err := m.authn.GetUserFromAPIKey(sysCtx, apiKey)
 			if err == nil {
 				u = apiKeyUser
+				// scopes are enforced by privacy rules
+				ctx = auth.WithAPIKey(ctx, ak)
 			}
+			ctx = m.withSuspensions(ctx, u)
 			c.Request = c.Request.WithContext(internal.SetUserCtx(ctx, u))
 			c.Next()
 			return
@@ -62,6 +65,10 @@ func (m *authMiddleware) TryAuthentication() gin.HandlerFunc {
 		if strings.HasPrefix(authHeader, auth.AccessTokenBearerPrefix) || refreshTokenCookie != "" {
 			accessToken := strings.TrimPrefix(authHeader, auth.AccessTokenBearerPrefix)
 
+			if refreshTokenCookie != "" {
+				ctx = internal.SetRefreshTokenHashCtx(ctx, auth.HashRefreshToken(refreshTokenCookie))
+			}
+
 			if tokenUser, err := m.authn.GetUserFromAccessToken(ctx, accessToken); err == nil {
 				u = tokenUser
 			} else {
@@ -78,12 +85,17 @@ func (m *authMiddleware) TryAuthentication() gin.HandlerFunc {
 							m.logger.Debugw("Setting refresh+access token cookie", "user_id", u.ID)
 							httputil.SetRefreshTokenCookie(c, newTokenPair.RefreshToken, auth.RefreshTokenLifeTime) // refresh token rotation
 							httputil.SetAccessTokenCookie(c, newTokenPair.AccessToken)
+							ctx = internal.SetRefreshTokenHashCtx(ctx, auth.HashRefreshToken(newTokenPair.RefreshToken))
 
 							c.Header("X-Access-Token-Refreshed", "true")
 						} else {
 							// invalid, expired, revoked refresh token, db error, etc.
 							logger.Warnw("Failed to refresh token", "error", refreshErr)
 							// httputil.SignOutUser(c, *m.client) // may be concurrent request and previous refreshed the token, don't sign out
+							if errors.Is(refreshErr, auth.ErrRefreshTokenReused) {
+								// outside the concurrency grace period, the whole session was revoked
+								httputil.SignOutUser(c, *m.client)
+							}
 							// u remains nil
 						}
 					} else {
@@ -103,8 +115,28 @@ func (m *authMiddleware) TryAuthentication() gin.HandlerFunc {
 			}
 		}
 
+		ctx = m.withSuspensions(ctx, u)
 		c.Request = c.Request.WithContext(internal.SetUserCtx(ctx, u))
 		c.Next()
 		return
 	}
 }
+
+// withSuspensions stores the active suspensions of the user in the context,
+// enforced by privacy rules and by rejecting GraphQL operations of fully suspended users.
+// Suspensions are queried on every authenticated request, using the user_id and ends_at index,
+// so that they apply immediately. The request continues without suspensions if the query fails,
+// since the rest of the request would most likely fail on the database as well.
+func (m *authMiddleware) withSuspensions(ctx context.Context, u *generated.User) context.Context {
+	if u == nil {
+		return ctx
+	}
+
+	suspensions, err := m.authn.ActiveSuspensions(ctx, u.ID)
+	if err != nil {
+		m.logger.Warnw("Failed to get active suspensions", "user_id", u.ID, "error", err)
+		return ctx
+	}
+
+	return auth.WithSuspensions(ctx, suspensions)
+}
@@ -1,68 +1,81 @@
-// https://www.alexedwards.net/blog/how-to-rate-limit-http-requests
-// TODO check improvements from above:
-// Check the X-Forwarded-For or X-Real-IP headers for the IP address, if you are running your server behind a reverse proxy.
-// Switch to a sync.RWMutex to help reduce contention on the map.
-
 package http
 
 import (
-	"net"
+	"math"
 	"net/http"
-	"sync"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
-	"golang.org/x/time/rate"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/utils/ratelimit"
 )
 
-// Create a custom visitor struct which holds the rate limiter for each
-// visitor and the last time that the visitor was seen.
-type visitor struct {
-	limiter  *rate.Limiter
-	lastSeen time.Time
+// rateLimits are the limits of a route group for each kind of client.
+// Zero limits fall back to Anonymous.
+type rateLimits struct {
+	// Anonymous limits clients by IP address.
+	Anonymous ratelimit.Limit
+	// User limits authenticated users.
+	User ratelimit.Limit
+	// APIKey limits requests authenticated via an API key.
+	APIKey ratelimit.Limit
 }
 
+var (
+	// defaultRateLimits apply to routes without specific limits.
+	defaultRateLimits = rateLimits{Anonymous: ratelimit.Limit{Rate: 15, Burst: 5}}
+	// authRateLimits apply to login flows.
+	authRateLimits = rateLimits{Anonymous: ratelimit.PerMinute(30, 10)}
+	// graphqlIPRateLimits apply to all clients behind an IP before authentication,
+	// so they are looser than per client limits.
+	graphqlIPRateLimits = rateLimits{Anonymous: ratelimit.Limit{Rate: 50, Burst: 100}}
+	// graphqlRateLimits allow authenticated clients more requests, since they don't share a bucket
+	// with other clients behind the same IP.
+	graphqlRateLimits = rateLimits{
+		Anonymous: ratelimit.Limit{Rate: 15, Burst: 5},
+		User:      ratelimit.Limit{Rate: 20, Burst: 40},
+		APIKey:    ratelimit.Limit{Rate: 10, Burst: 20},
+	}
+)
+
 // rateLimitMiddleware allows rate limiting requests.
 type rateLimitMiddleware struct {
-	logger *zap.SugaredLogger
-	// rlim is the number of events per second allowed.
-	rlim rate.Limit
-	// blim is the number of burst allowed.
-	blim     int
-	visitors map[string]*visitor // don't mind pointers, it's for internal struct use only
-
-	mu sync.Mutex
+	logger  *zap.SugaredLogger
+	limiter ratelimit.Limiter
 }
 
-func newRateLimitMiddleware(
-	logger *zap.SugaredLogger, rlim rate.Limit,
-	blim int,
-) *rateLimitMiddleware {
+func newRateLimitMiddleware(logger *zap.SugaredLogger, limiter ratelimit.Limiter) *rateLimitMiddleware {
 	return &rateLimitMiddleware{
-		logger:   logger,
-		rlim:     rlim,
-		blim:     blim,
-		visitors: make(map[string]*visitor),
-		mu:       sync.Mutex{},
+		logger:  logger,
+		limiter: limiter,
 	}
 }
 
-// Limit is the middleware function to rate limits requests.
-func (m *rateLimitMiddleware) Limit() gin.HandlerFunc {
-	go m.cleanupVisitors(3 * time.Minute)
-
+// Limit is the middleware function to rate limit requests of a route group.
+// Requests are limited by API key or user if authenticated by a previous middleware, else by client IP.
+// Responses carry RateLimit-* headers, and Retry-After when limited.
+func (m *rateLimitMiddleware) Limit(group string, limits rateLimits) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		ip, _, err := net.SplitHostPort(c.Request.RemoteAddr)
+		key, limit := m.identify(c, limits)
+
+		res, err := m.limiter.Allow(c.Request.Context(), "ratelimit:"+group+":"+key, limit)
 		if err != nil {
-			ip = c.Request.RemoteAddr
-			if ip == "" {
-				ip = "unknown"
-			}
+			// rate limiting is best effort
+			m.logger.Warnf("could not rate limit request: %v", err)
+			c.Next()
+
+			return
 		}
 
-		limiter := m.getVisitor(ip)
-		if !limiter.Allow() {
+		c.Header("RateLimit-Limit", strconv.Itoa(res.Limit))
+		c.Header("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+		c.Header("RateLimit-Reset", headerSeconds(res.Reset))
+		if !res.Allowed {
+			c.Header("Retry-After", headerSeconds(res.RetryAfter))
 			c.AbortWithStatus(http.StatusTooManyRequests)
 
 			return
@@ -72,37 +85,22 @@ func (m *rateLimitMiddleware) Limit() gin.HandlerFunc {
 	}
 }
 
-func (m *rateLimitMiddleware) getVisitor(ip string) *rate.Limiter {
-	m.mu.Lock()
-	defer m.mu.Unlock()
+// identify returns the bucket key of the client and its limit.
+func (m *rateLimitMiddleware) identify(c *gin.Context, limits rateLimits) (string, ratelimit.Limit) {
+	ctx := c.Request.Context()
 
-	v, exists := m.visitors[ip]
-	if !exists {
-		limiter := rate.NewLimiter(m.rlim, m.blim)
-		// Include the current time when creating a new visitor.
-		m.visitors[ip] = &visitor{limiter, time.Now()}
-
-		return limiter
+	if ak := auth.APIKeyFromCtx(ctx); ak != nil && limits.APIKey.Burst > 0 {
+		return "apikey:" + ak.ID.String(), limits.APIKey
+	}
+	if u := internal.GetUserFromCtx(ctx); u != nil && limits.User.Burst > 0 {
+		return "user:" + u.ID.String(), limits.User
 	}
 
-	// Update the last seen time for the visitor.
-	v.lastSeen = time.Now()
-
-	return v.limiter
+	// resolved from proxy headers only if sent by trusted proxies
+	return "ip:" + c.ClientIP(), limits.Anonymous
 }
 
-// Every minute check the map for visitors that haven't been seen for
-// more than the given duration and delete the entries.
-func (m *rateLimitMiddleware) cleanupVisitors(d time.Duration) {
-	for {
-		time.Sleep(time.Minute)
-
-		m.mu.Lock()
-		for ip, v := range m.visitors {
-			if time.Since(v.lastSeen) > d {
-				delete(m.visitors, ip)
-			}
-		}
-		m.mu.Unlock()
-	}
+// headerSeconds formats a duration as whole seconds, rounded up.
+func headerSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
 }
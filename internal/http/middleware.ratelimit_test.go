@@ -6,10 +6,16 @@ import (
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
-	"golang.org/x/time/rate"
+	"github.com/stretchr/testify/require"
 
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
 	"github.com/caliecode/la-clipasa/internal/testutil"
+	"github.com/caliecode/la-clipasa/internal/utils/pointers"
+	"github.com/caliecode/la-clipasa/internal/utils/ratelimit"
 )
 
 func TestRateLimitMiddleware(t *testing.T) {
@@ -20,9 +26,9 @@ func TestRateLimitMiddleware(t *testing.T) {
 	_, engine := gin.CreateTestContext(resp)
 	rl := 1
 	bl := 3
-	rlmw := newRateLimitMiddleware(logger, rate.Limit(rl), bl)
+	rlmw := newRateLimitMiddleware(logger, ratelimit.NewMemory())
 
-	engine.Use(rlmw.Limit())
+	engine.Use(rlmw.Limit("test", rateLimits{Anonymous: ratelimit.Limit{Rate: float64(rl), Burst: bl}}))
 	engine.GET("/", func(ctx *gin.Context) {
 		ctx.String(http.StatusOK, "ok")
 	})
@@ -36,4 +42,142 @@ func TestRateLimitMiddleware(t *testing.T) {
 	resp = httptest.NewRecorder()
 	engine.ServeHTTP(resp, req)
 	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Equal(t, "3", resp.Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "0", resp.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "3", resp.Header().Get("RateLimit-Reset"))
+	assert.Equal(t, "1", resp.Header().Get("Retry-After"))
+}
+
+func TestRateLimitMiddlewareIdentities(t *testing.T) {
+	t.Parallel()
+
+	logger := testutil.NewLogger(t)
+	engine := gin.New()
+	require.NoError(t, engine.SetTrustedProxies([]string{"10.0.0.0/8"}))
+	engine.RemoteIPHeaders = []string{"Fly-Client-IP", "X-Forwarded-For"}
+
+	user := &generated.User{ID: uuid.New()}
+	apiKey := &generated.ApiKey{ID: uuid.New()}
+	engine.Use(func(c *gin.Context) {
+		ctx := c.Request.Context()
+		switch c.GetHeader("X-Test-Auth") {
+		case "user":
+			ctx = internal.SetUserCtx(ctx, user)
+		case "apikey":
+			ctx = auth.WithAPIKey(internal.SetUserCtx(ctx, user), apiKey)
+		}
+		c.Request = c.Request.WithContext(ctx)
+	})
+	engine.Use(newRateLimitMiddleware(logger, ratelimit.NewMemory()).Limit("test", rateLimits{
+		Anonymous: ratelimit.Limit{Rate: 1, Burst: 1},
+		User:      ratelimit.Limit{Rate: 1, Burst: 2},
+		APIKey:    ratelimit.Limit{Rate: 1, Burst: 3},
+	}))
+	engine.GET("/", func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+
+	serve := func(remoteAddr string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = remoteAddr
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		resp := httptest.NewRecorder()
+		engine.ServeHTTP(resp, req)
+
+		return resp
+	}
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		headers    map[string]string
+		wantLimit  string
+	}{
+		{"direct client", "203.0.113.1:1234", nil, "1"},
+		{"untrusted proxy headers are ignored", "203.0.113.1:1234", map[string]string{"Fly-Client-IP": "198.51.100.1"}, ""},
+		{"fly client ip of trusted proxy", "10.0.0.1:1234", map[string]string{"Fly-Client-IP": "198.51.100.2"}, "1"},
+		{"forwarded for of trusted proxy", "10.0.0.1:1234", map[string]string{"X-Forwarded-For": "198.51.100.3, 10.0.0.2"}, "1"},
+		{"user", "203.0.113.1:1234", map[string]string{"X-Test-Auth": "user"}, "2"},
+		{"api key", "203.0.113.1:1234", map[string]string{"X-Test-Auth": "apikey"}, "3"},
+	}
+	for _, tc := range tests {
+		resp := serve(tc.remoteAddr, tc.headers)
+		if tc.wantLimit == "" {
+			assert.Equal(t, http.StatusTooManyRequests, resp.Code, tc.name)
+			continue
+		}
+		assert.Equal(t, http.StatusOK, resp.Code, tc.name)
+		assert.Equal(t, tc.wantLimit, resp.Header().Get("RateLimit-Limit"), tc.name)
+	}
+}
+
+func TestRateLimitMiddlewareFlyProxy(t *testing.T) {
+	t.Parallel()
+
+	logger := testutil.NewLogger(t)
+	engine := gin.New()
+	require.NoError(t, setClientIPHeaders(engine, &internal.AppConfig{FlyAppName: pointers.New("laclipasa")}))
+	engine.Use(newRateLimitMiddleware(logger, ratelimit.NewMemory()).Limit("test", rateLimits{
+		Anonymous: ratelimit.Limit{Rate: 1, Burst: 1},
+	}))
+	engine.GET("/", func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+
+	serve := func(headers map[string]string) int {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		// every request reaches the machine from the Fly proxy
+		req.RemoteAddr = "[fdaa:0:1::2]:1234"
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		resp := httptest.NewRecorder()
+		engine.ServeHTTP(resp, req)
+
+		return resp.Code
+	}
+
+	assert.Equal(t, http.StatusOK, serve(map[string]string{"Fly-Client-IP": "198.51.100.1"}))
+	assert.Equal(t, http.StatusOK, serve(map[string]string{"Fly-Client-IP": "198.51.100.2"}), "clients behind the proxy have separate buckets")
+	assert.Equal(t, http.StatusTooManyRequests, serve(map[string]string{"Fly-Client-IP": "198.51.100.1"}))
+	assert.Equal(t, http.StatusTooManyRequests, serve(map[string]string{
+		"Fly-Client-IP":   "198.51.100.2",
+		"X-Forwarded-For": "203.0.113.1",
+	}), "forwarded for headers set by clients are ignored")
+}
+
+func TestRateLimitMiddlewareBeforeAuthentication(t *testing.T) {
+	t.Parallel()
+
+	logger := testutil.NewLogger(t)
+	engine := gin.New()
+	rlmw := newRateLimitMiddleware(logger, ratelimit.NewMemory())
+
+	authentications := 0
+	user := &generated.User{ID: uuid.New()}
+	engine.Use(
+		rlmw.Limit("ip", rateLimits{Anonymous: ratelimit.Limit{Rate: 1, Burst: 2}}),
+		func(c *gin.Context) {
+			authentications++
+			c.Request = c.Request.WithContext(internal.SetUserCtx(c.Request.Context(), user))
+		},
+		rlmw.Limit("user", rateLimits{Anonymous: ratelimit.Limit{Rate: 1, Burst: 1}, User: ratelimit.Limit{Rate: 1, Burst: 5}}),
+	)
+	engine.GET("/", func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+
+	for range 2 {
+		resp := httptest.NewRecorder()
+		engine.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "5", resp.Header().Get("RateLimit-Limit"), "authenticated limit applies after the IP limit")
+	}
+
+	resp := httptest.NewRecorder()
+	engine.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Equal(t, 2, authentications, "limited requests are not authenticated")
 }
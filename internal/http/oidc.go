@@ -2,6 +2,7 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
@@ -11,6 +12,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -19,6 +21,8 @@ import (
 
 	"github.com/caliecode/la-clipasa/internal"
 	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/client"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
 	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
 	"github.com/caliecode/la-clipasa/internal/http/httputil"
 	"github.com/caliecode/la-clipasa/internal/models"
@@ -36,25 +40,44 @@ const (
 )
 
 type AuthState struct {
-	Nonce       string          `json:"nonce"`
-	LoginMode   OAuth2LoginMode `json:"login_mode"`
-	RedirectURI string          `json:"redirect_uri"`
-	Signature   string          `json:"signature"`
+	Nonce       string            `json:"nonce"`
+	Provider    identity.Provider `json:"provider"`
+	LoginMode   OAuth2LoginMode   `json:"login_mode"`
+	RedirectURI string            `json:"redirect_uri"`
+	// LinkUserID is the authenticated user the provider identity is linked to, if linking.
+	LinkUserID *uuid.UUID `json:"link_user_id,omitempty"`
+	Signature  string     `json:"signature"`
 }
 
-func generateState(loginMode OAuth2LoginMode, redirectURI string) (string, error) {
+func (s *AuthState) sign() (string, error) {
+	linkUserID := ""
+	if s.LinkUserID != nil {
+		linkUserID = s.LinkUserID.String()
+	}
+
+	mac := hmac.New(sha256.New, []byte(internal.Config.TwitchOIDC.ClientSecret))
+	_, err := mac.Write([]byte(fmt.Sprintf("%s|%s|%s|%s|%s", s.Nonce, s.Provider, s.LoginMode, s.RedirectURI, linkUserID)))
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+func generateState(provider identity.Provider, loginMode OAuth2LoginMode, redirectURI string, linkUserID *uuid.UUID) (string, error) {
 	state := AuthState{
 		Nonce:       uuid.New().String(),
+		Provider:    provider,
 		LoginMode:   loginMode,
 		RedirectURI: redirectURI,
+		LinkUserID:  linkUserID,
 	}
 
-	mac := hmac.New(sha256.New, []byte(internal.Config.TwitchOIDC.ClientSecret))
-	_, err := mac.Write([]byte(fmt.Sprintf("%s|%s|%s", state.Nonce, state.LoginMode, state.RedirectURI)))
+	signature, err := state.sign()
 	if err != nil {
 		return "", err
 	}
-	state.Signature = hex.EncodeToString(mac.Sum(nil))
+	state.Signature = signature
 
 	jsonState, err := json.Marshal(state)
 	if err != nil {
@@ -75,12 +98,10 @@ func parseState(encodedState string) (*AuthState, error) {
 		return nil, err
 	}
 
-	mac := hmac.New(sha256.New, []byte(internal.Config.TwitchOIDC.ClientSecret))
-	_, err = mac.Write([]byte(fmt.Sprintf("%s|%s|%s", state.Nonce, state.LoginMode, state.RedirectURI)))
+	expectedSignature, err := state.sign()
 	if err != nil {
 		return nil, err
 	}
-	expectedSignature := hex.EncodeToString(mac.Sum(nil))
 
 	if !hmac.Equal([]byte(state.Signature), []byte(expectedSignature)) {
 		return nil, errors.New("invalid state signature")
@@ -117,10 +138,22 @@ func (h *Handlers) codeExchange(c *gin.Context) {
 		return
 	}
 
+	routeProvider, err := parseProviderFromParam(c.Param("provider"))
+	if err != nil || routeProvider != state.Provider {
+		httputil.RenderError(c, "OIDC", internal.NewErrorf(internal.ErrorCodeOIDC, "state provider mismatch"))
+		return
+	}
+
+	provider, ok := h.oauth2Providers.Get(state.Provider, state.LoginMode)
+	if !ok {
+		httputil.RenderError(c, "OIDC", internal.NewErrorf(internal.ErrorCodeOIDC, "provider %s is not enabled", state.Provider))
+		return
+	}
+
 	// Store state in context for later use
 	c.Set("auth_state", state)
 
-	rp.CodeExchangeHandler(marshalToken, h.oauth2Providers[state.LoginMode]).ServeHTTP(rbw, c.Request)
+	rp.CodeExchangeHandler(marshalToken, provider).ServeHTTP(rbw, c.Request)
 
 	oauth2TokenRes := rbw.body.Bytes()
 
@@ -131,12 +164,14 @@ func (h *Handlers) codeExchange(c *gin.Context) {
 	}
 
 	if state.LoginMode == OAuth2LoginModeBroadcaster {
-		c.Set(broadCasterTokenCtxKey, oauth2TokenRes)
+		c.Set(broadCasterTokenCtxKey, tr)
+		rbw.body = &bytes.Buffer{}
+		c.Next()
 
 		return
 	}
 
-	req, _ := http.NewRequest(http.MethodGet, "https://id.twitch.tv/oauth2/userinfo", nil)
+	req, _ := http.NewRequest(http.MethodGet, provider.UserInfoURL, nil)
 	req.Header.Set("Authorization", "Bearer "+tr.AccessToken)
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
@@ -145,35 +180,48 @@ func (h *Handlers) codeExchange(c *gin.Context) {
 	}
 	defer resp.Body.Close()
 
-	var userInfo []byte
-	if userInfo, err = io.ReadAll(resp.Body); err != nil {
+	var userInfoRes []byte
+	if userInfoRes, err = io.ReadAll(resp.Body); err != nil {
 		httputil.RenderError(c, "OIDC", internal.WrapErrorf(err, internal.ErrorCodeOIDC, "could not read userinfo"))
 		return
 	}
 
-	internal.CtxWithUserInfo(c, userInfo)
-
-	// tr.ExpiresIn is empty
-	twitchTokenInfo := models.TwitchTokenInfo{
-		AccessToken:  tr.AccessToken,
-		RefreshToken: tr.RefreshToken,
-		Expiry:       tr.Expiry,
-		TokenType:    tr.TokenType,
+	userinfo, err := provider.ParseUserInfo(userInfoRes)
+	if err != nil {
+		httputil.RenderError(c, "OIDC", internal.WrapErrorf(err, internal.ErrorCodeOIDC, "could not parse userinfo"))
+		return
 	}
-	tokenJSON, err := json.Marshal(twitchTokenInfo)
-	if err == nil {
-		c.Set(twitchAuthInfoCtxKey, tokenJSON) // will set cookie in callback so that response set-cookie works as expected
-	} else {
-		h.logger.Errorf("failed to marshal twitch token: %w", err)
-		httputil.RenderError(c, "OIDC", internal.WrapErrorf(err, internal.ErrorCodeOIDC, "code exchange: could not marshal twitch token"))
+	userInfo, err := json.Marshal(userinfo)
+	if err != nil {
+		httputil.RenderError(c, "OIDC", internal.WrapErrorf(err, internal.ErrorCodeOIDC, "could not marshal userinfo"))
 		return
 	}
 
+	internal.CtxWithUserInfo(c, userInfo)
+
+	if state.Provider == identity.ProviderTWITCH {
+		// tr.ExpiresIn is empty
+		twitchTokenInfo := models.TwitchTokenInfo{
+			AccessToken:  tr.AccessToken,
+			RefreshToken: tr.RefreshToken,
+			Expiry:       tr.Expiry,
+			TokenType:    tr.TokenType,
+		}
+		tokenJSON, err := json.Marshal(twitchTokenInfo)
+		if err == nil {
+			c.Set(twitchAuthInfoCtxKey, tokenJSON) // stored for the session in callback, once created
+		} else {
+			h.logger.Errorf("failed to marshal twitch token: %w", err)
+			httputil.RenderError(c, "OIDC", internal.WrapErrorf(err, internal.ErrorCodeOIDC, "code exchange: could not marshal twitch token"))
+			return
+		}
+	}
+
 	rbw.body = &bytes.Buffer{}
 	c.Next()
 }
 
-func (h *Handlers) twitchCallback(c *gin.Context) {
+func (h *Handlers) oidcCallback(c *gin.Context) {
 	stateVal, exists := c.Get("auth_state")
 	if !exists {
 		httputil.RenderError(c, "OIDC", internal.WrapErrorf(nil, internal.ErrorCodeOIDC, "missing auth state"))
@@ -181,43 +229,56 @@ func (h *Handlers) twitchCallback(c *gin.Context) {
 	}
 	state, _ := stateVal.(*AuthState)
 
-	// TODO: for refresh token setup for streamer, the login request will return tokens as string from where we can hardcode the refresh token which shouldnt expire,
-	// why we may need twitch tokens on the backend -> we cannot use a normal user twitch token to check some info from streamer channel, for example, or read the chat.
-	// otherwise, we can use client_credentials flow to get server-server tokens if we just need generic twitch information
+	// broadcaster tokens are required for channel-level requests, e.g. moderators or bans,
+	// that a user Twitch token is not authorized for
 	if state.LoginMode == OAuth2LoginModeBroadcaster {
-		tokenBytes, exists := c.Get(broadCasterTokenCtxKey)
+		h.broadcasterCallback(c, state)
+		return
+	}
+
+	var twitchTokenInfo *models.TwitchTokenInfo
+	if state.Provider == identity.ProviderTWITCH {
+		tokenJSON, exists := c.Get(twitchAuthInfoCtxKey)
 		if !exists {
-			c.AbortWithError(500, fmt.Errorf("broadcast tokens not found in context"))
+			httputil.RenderError(c, "OIDC", internal.WrapErrorf(nil, internal.ErrorCodeOIDC, "twitch token not found in context"))
+			return
+		}
+		if err := json.Unmarshal(tokenJSON.([]byte), &twitchTokenInfo); err != nil {
+			httputil.RenderError(c, "OIDC", internal.WrapErrorf(err, internal.ErrorCodeOIDC, "could not unmarshal twitch token"))
 			return
 		}
-		c.String(200, string(tokenBytes.([]byte)))
-		return
 	}
 
-	tokenJSON, exists := c.Get(twitchAuthInfoCtxKey)
-	if !exists {
-		httputil.RenderError(c, "OIDC", internal.WrapErrorf(nil, internal.ErrorCodeOIDC, "twitch token not found in context"))
-		return
-	}
-	var twitchTokenInfo models.TwitchTokenInfo
-	if err := json.Unmarshal(tokenJSON.([]byte), &twitchTokenInfo); err != nil {
-		httputil.RenderError(c, "OIDC", internal.WrapErrorf(err, internal.ErrorCodeOIDC, "could not unmarshal twitch token"))
+	userinfo, err := internal.GetUserInfoFromCtx(c)
+	if err != nil {
+		httputil.RenderError(c, "OIDC", internal.WrapErrorf(err, internal.ErrorCodeOIDC, "user info not found"))
 		return
 	}
 
-	httputil.SetTwitchAuthCookie(c, base64.URLEncoding.EncodeToString(tokenJSON.([]byte)))
+	redirectURI := state.RedirectURI
+	if redirectURI == "" {
+		redirectURI = internal.BuildAPIURL("docs")
+	}
 
-	userinfo, err := internal.GetUserInfoFromCtx(c)
-	if err != nil {
-		httputil.RenderError(c, "OIDC", internal.WrapErrorf(err, internal.ErrorCodeOIDC, "user info not found"))
+	if state.LinkUserID != nil {
+		h.linkIdentityCallback(c, state, userinfo, twitchTokenInfo, redirectURI)
+		return
 	}
 
 	ctxWithPrivacyToken := token.NewContextWithOauth2Token(c.Request.Context(), userinfo.Subject)
 	c.Request = c.Request.WithContext(ctxWithPrivacyToken)
 
-	u, err := h.authn.GetOrRegisterUserFromUserInfo(c, userinfo)
+	u, err := h.authn.GetOrRegisterUserFromUserInfo(c, state.Provider, userinfo)
 	if err != nil {
 		httputil.RenderError(c, "OIDC", internal.WrapErrorf(err, internal.ErrorCodeOIDC, "could not get or register user"))
+		return
+	}
+
+	// bans are checked on login instead of waiting for the cache to expire
+	if state.Provider == identity.ProviderTWITCH {
+		if _, err := h.twitchBans.Refresh(ctxWithPrivacyToken, userinfo.Subject); err != nil && !errors.Is(err, client.ErrBroadcasterTokenNotFound) {
+			h.logger.Warnf("Failed to refresh twitch ban status for user %s: %v", u.ID, err)
+		}
 	}
 
 	// don't need tx for rt token here - unique call
@@ -228,20 +289,102 @@ func (h *Handlers) twitchCallback(c *gin.Context) {
 		return
 	}
 
+	if twitchTokenInfo != nil {
+		if err := h.twitchTokens.Store(ctxWithPrivacyToken, tokenPair.SessionID, userinfo.Subject, twitchTokenInfo); err != nil {
+			h.logger.Errorf("Failed to store twitch tokens for user %s: %v", u.ID, err)
+			httputil.RenderError(c, "Auth", internal.WrapErrorf(err, internal.ErrorCodeUnknown, "could not store twitch tokens"))
+			return
+		}
+	}
+
 	httputil.SetRefreshTokenCookie(c, tokenPair.RefreshToken, auth.RefreshTokenLifeTime)
 	httputil.SetAccessTokenCookie(c, tokenPair.AccessToken)
+	httputil.ClearTwitchAuthCookie(c)
 
 	c.String(200, "Successfully logged in")
 
-	redirectURI := state.RedirectURI
-	if redirectURI == "" {
-		redirectURI = internal.BuildAPIURL("docs")
+	c.Redirect(http.StatusMovedPermanently, redirectURI)
+}
+
+// broadcasterCallback stores the broadcaster Twitch tokens to be used by the server.
+func (h *Handlers) broadcasterCallback(c *gin.Context, state *AuthState) {
+	trVal, exists := c.Get(broadCasterTokenCtxKey)
+	if !exists {
+		httputil.RenderError(c, "OIDC", internal.NewErrorf(internal.ErrorCodeOIDC, "broadcaster tokens not found in context"))
+		return
 	}
+	tr, _ := trVal.(*oidc.Tokens[*oidc.IDTokenClaims])
 
-	c.Redirect(http.StatusMovedPermanently, redirectURI)
+	if state.Provider != identity.ProviderTWITCH || tr.IDTokenClaims == nil {
+		httputil.RenderError(c, "OIDC", internal.NewErrorf(internal.ErrorCodeOIDC, "broadcaster login requires twitch"))
+		return
+	}
+
+	tokenInfo := &models.TwitchTokenInfo{
+		AccessToken:  tr.AccessToken,
+		RefreshToken: tr.RefreshToken,
+		Expiry:       tr.Expiry,
+		TokenType:    tr.TokenType,
+	}
+	scopes := strings.Fields(internal.Config.TwitchOIDC.BroadcasterScopes)
+	if err := h.broadcasterTokens.Store(c.Request.Context(), tr.IDTokenClaims.Subject, tokenInfo, scopes); err != nil {
+		h.logger.Errorf("Failed to store broadcaster tokens: %v", err)
+		httputil.RenderError(c, "OIDC", err)
+		return
+	}
+
+	if state.RedirectURI != "" {
+		c.Redirect(http.StatusFound, state.RedirectURI)
+		return
+	}
+
+	c.String(http.StatusOK, "Broadcaster tokens stored")
+}
+
+// linkIdentityCallback links the provider identity to the authenticated user that started the link flow.
+// Linked Twitch identities replace the Twitch tokens of the session.
+func (h *Handlers) linkIdentityCallback(c *gin.Context, state *AuthState, userinfo *oidc.UserInfo, twitchTokenInfo *models.TwitchTokenInfo, redirectURI string) {
+	u := internal.GetUserFromCtx(c.Request.Context())
+	if u == nil || u.ID != *state.LinkUserID {
+		httputil.RenderError(c, "OIDC", internal.NewErrorf(internal.ErrorCodeUnauthenticated, "identity linking requires the session that started it"))
+		return
+	}
+
+	if err := h.authn.LinkIdentity(c.Request.Context(), u, state.Provider, userinfo); err != nil {
+		if errors.Is(err, auth.ErrIdentityLinkedToOtherUser) {
+			httputil.RenderError(c, "OIDC", internal.WrapErrorf(err, internal.ErrorCodeAlreadyExists, "could not link identity"))
+			return
+		}
+		httputil.RenderError(c, "OIDC", internal.WrapErrorf(err, internal.ErrorCodeOIDC, "could not link identity"))
+		return
+	}
+
+	if twitchTokenInfo != nil {
+		if err := h.storeSessionTwitchTokens(c.Request.Context(), userinfo.Subject, twitchTokenInfo); err != nil {
+			h.logger.Warnf("Failed to store twitch tokens for user %s: %v", u.ID, err)
+		}
+	}
+
+	c.Redirect(http.StatusFound, redirectURI)
 }
 
-func (h *Handlers) twitchLogin(c *gin.Context) {
+// storeSessionTwitchTokens stores Twitch tokens for the current session.
+func (h *Handlers) storeSessionTwitchTokens(ctx context.Context, twitchUserID string, tokenInfo *models.TwitchTokenInfo) error {
+	sessionID, err := h.twitchTokens.SessionID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return h.twitchTokens.Store(ctx, sessionID, twitchUserID, tokenInfo)
+}
+
+func (h *Handlers) oidcLogin(c *gin.Context) {
+	provider, err := parseProviderFromParam(c.Param("provider"))
+	if err != nil {
+		httputil.RenderError(c, "OIDC", internal.WrapErrorf(err, internal.ErrorCodeOIDC, "invalid provider"))
+		return
+	}
+
 	authRedirectUri := c.Query("auth:redirect-uri")
 	loginMode := OAuth2LoginMode(c.Query("auth:login-mode"))
 	if !loginMode.Valid() {
@@ -249,11 +392,38 @@ func (h *Handlers) twitchLogin(c *gin.Context) {
 		loginMode = OAuth2LoginModeUser
 	}
 
-	state, err := generateState(loginMode, authRedirectUri)
+	h.redirectToProvider(c, provider, loginMode, authRedirectUri, nil)
+}
+
+// oidcLink starts linking a provider identity to the authenticated user.
+func (h *Handlers) oidcLink(c *gin.Context) {
+	u := internal.GetUserFromCtx(c.Request.Context())
+	if u == nil {
+		httputil.RenderError(c, "OIDC", internal.NewErrorf(internal.ErrorCodeUnauthenticated, "identity linking requires an authenticated session"))
+		return
+	}
+
+	provider, err := parseProviderFromParam(c.Param("provider"))
+	if err != nil {
+		httputil.RenderError(c, "OIDC", internal.WrapErrorf(err, internal.ErrorCodeOIDC, "invalid provider"))
+		return
+	}
+
+	h.redirectToProvider(c, provider, OAuth2LoginModeUser, c.Query("auth:redirect-uri"), &u.ID)
+}
+
+func (h *Handlers) redirectToProvider(c *gin.Context, provider identity.Provider, loginMode OAuth2LoginMode, redirectURI string, linkUserID *uuid.UUID) {
+	op, ok := h.oauth2Providers.Get(provider, loginMode)
+	if !ok {
+		httputil.RenderError(c, "OIDC", internal.NewErrorf(internal.ErrorCodeOIDC, "provider %s is not enabled for login mode %s", provider, loginMode))
+		return
+	}
+
+	state, err := generateState(provider, loginMode, redirectURI, linkUserID)
 	if err != nil {
 		httputil.RenderError(c, "OIDC", internal.WrapErrorf(err, internal.ErrorCodeOIDC, "failed to generate state"))
 		return
 	}
 
-	gin.WrapH(rp.AuthURLHandler(func() string { return state }, h.oauth2Providers[loginMode]))(c)
+	gin.WrapH(rp.AuthURLHandler(func() string { return state }, op))(c)
 }
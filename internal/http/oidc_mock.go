@@ -0,0 +1,91 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/oidcmock"
+)
+
+// mockTwitchUsers returns the users that can log in to the mock Twitch provider
+// via the login_hint parameter set to their subject or username.
+func mockTwitchUsers(cfg *internal.AppConfig) []oidcmock.User {
+	return []oidcmock.User{
+		{
+			Subject:           "1000001",
+			PreferredUsername: "mock_user",
+			Email:             "mock_user@example.com",
+			EmailVerified:     true,
+			Picture:           "https://static-cdn.jtvnw.net/user-default-pictures-uv/cdbc8f4d-1b13-4eb5-a283-2f5c8f5f1ba2-profile_image-300x300.png",
+		},
+		{
+			Subject:           "1000002",
+			PreferredUsername: "mock_unverified",
+			Email:             "mock_unverified@example.com",
+			Picture:           "https://static-cdn.jtvnw.net/user-default-pictures-uv/de130ab0-def7-11e9-b668-784f43822e80-profile_image-300x300.png",
+		},
+		{
+			Subject:           cfg.Twitch.BroadcasterID,
+			PreferredUsername: cfg.Twitch.BroadcasterName,
+			EmailVerified:     true,
+			Picture:           "https://static-cdn.jtvnw.net/user-default-pictures-uv/ebe4cd89-b4f4-4cd9-adac-2f30151b4209-profile_image-300x300.png",
+		},
+	}
+}
+
+// NewMockOIDCProvider returns a mock provider accepting the configured Twitch client credentials.
+func NewMockOIDCProvider(cfg *internal.AppConfig, users []oidcmock.User) (*oidcmock.Provider, error) {
+	return oidcmock.New(oidcmock.Config{
+		ClientID:     cfg.TwitchOIDC.ClientID,
+		ClientSecret: cfg.TwitchOIDC.ClientSecret,
+		Users:        users,
+	})
+}
+
+// startMockOIDCServer serves a mock Twitch OIDC provider on localhost and points the
+// Twitch OIDC config to it. It is stopped when ctx is done.
+func startMockOIDCServer(ctx context.Context, cfg *internal.AppConfig, logger *zap.SugaredLogger) error {
+	if cfg.AppEnv != internal.AppEnvDev && cfg.AppEnv != internal.AppEnvE2E {
+		return fmt.Errorf("mock OIDC server is not allowed in %s environment", cfg.AppEnv)
+	}
+
+	provider, err := NewMockOIDCProvider(cfg, mockTwitchUsers(cfg))
+	if err != nil {
+		return fmt.Errorf("could not create mock OIDC provider: %w", err)
+	}
+
+	ln, err := net.Listen("tcp", "localhost:"+*cfg.TwitchOIDC.MockServerPort)
+	if err != nil {
+		return fmt.Errorf("could not listen for mock OIDC server: %w", err)
+	}
+
+	srv := &http.Server{
+		Handler:           provider,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Errorf("mock OIDC server: %v", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+
+	issuer := "http://" + ln.Addr().String()
+	cfg.TwitchOIDC.Issuer = issuer
+	cfg.TwitchOIDC.UserInfoURL = issuer + oidcmock.UserInfoPath
+
+	logger.Infof("mock Twitch OIDC provider listening on %s", issuer)
+
+	return nil
+}
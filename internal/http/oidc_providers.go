@@ -0,0 +1,203 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/zitadel/oidc/v3/pkg/client/rp"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"golang.org/x/oauth2"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
+)
+
+const (
+	googleIssuer      = "https://accounts.google.com"
+	googleUserInfoURL = "https://openidconnect.googleapis.com/v1/userinfo"
+	googleScopes      = "openid email profile"
+
+	// Discord does not support OIDC discovery.
+	discordAuthURL     = "https://discord.com/oauth2/authorize"
+	discordTokenURL    = "https://discord.com/api/oauth2/token"
+	discordUserInfoURL = "https://discord.com/api/users/@me"
+	discordScopes      = "identify email"
+)
+
+// OAuth2Provider is a login provider relying party.
+type OAuth2Provider struct {
+	rp.RelyingParty
+	// UserInfoURL is requested with the access token obtained in the code exchange.
+	UserInfoURL string
+	// ParseUserInfo maps the userinfo response to standard claims.
+	ParseUserInfo func(data []byte) (*oidc.UserInfo, error)
+}
+
+// OAuth2Providers holds the enabled login providers by provider and login mode.
+// Only Twitch supports the broadcaster login mode.
+type OAuth2Providers map[identity.Provider]map[OAuth2LoginMode]*OAuth2Provider
+
+// Get returns the provider for the given login mode, if enabled.
+func (p OAuth2Providers) Get(provider identity.Provider, mode OAuth2LoginMode) (*OAuth2Provider, bool) {
+	op, ok := p[provider][mode]
+
+	return op, ok
+}
+
+// providerCallbackURL returns the redirect URL registered with the provider.
+func providerCallbackURL(provider identity.Provider) string {
+	return internal.BuildAPIURL("/auth/" + strings.ToLower(string(provider)) + "/callback")
+}
+
+// parseProviderFromParam returns the provider for a route parameter such as "twitch".
+func parseProviderFromParam(param string) (identity.Provider, error) {
+	provider := identity.Provider(strings.ToUpper(param))
+	if err := identity.ProviderValidator(provider); err != nil {
+		return "", fmt.Errorf("unknown provider %q", param)
+	}
+
+	return provider, nil
+}
+
+// newOAuth2Providers creates relying parties for all configured login providers.
+func newOAuth2Providers(ctx context.Context, cfg *internal.AppConfig, opts ...rp.Option) (OAuth2Providers, error) {
+	providers := OAuth2Providers{}
+
+	twitchModes := map[OAuth2LoginMode]string{
+		OAuth2LoginModeUser:        cfg.TwitchOIDC.UserScopes,
+		OAuth2LoginModeBroadcaster: cfg.TwitchOIDC.BroadcasterScopes,
+	}
+	providers[identity.ProviderTWITCH] = make(map[OAuth2LoginMode]*OAuth2Provider, len(twitchModes))
+	for mode, scopes := range twitchModes {
+		twitchProvider, err := rp.NewRelyingPartyOIDC(ctx,
+			cfg.TwitchOIDC.Issuer,
+			cfg.TwitchOIDC.ClientID,
+			cfg.TwitchOIDC.ClientSecret,
+			providerCallbackURL(identity.ProviderTWITCH),
+			strings.Split(scopes, " "),
+			opts...)
+		if err != nil {
+			return nil, fmt.Errorf("error creating twitch %s provider: %w", mode, err)
+		}
+
+		providers[identity.ProviderTWITCH][mode] = &OAuth2Provider{
+			RelyingParty:  twitchProvider,
+			UserInfoURL:   cfg.TwitchOIDC.UserInfoURL,
+			ParseUserInfo: parseOIDCUserInfo,
+		}
+	}
+
+	if cfg.GoogleOIDC.ClientID != nil && *cfg.GoogleOIDC.ClientID != "" {
+		googleProvider, err := rp.NewRelyingPartyOIDC(ctx,
+			googleIssuer,
+			*cfg.GoogleOIDC.ClientID,
+			derefString(cfg.GoogleOIDC.ClientSecret),
+			providerCallbackURL(identity.ProviderGOOGLE),
+			strings.Split(googleScopes, " "),
+			opts...)
+		if err != nil {
+			return nil, fmt.Errorf("error creating google provider: %w", err)
+		}
+
+		providers[identity.ProviderGOOGLE] = map[OAuth2LoginMode]*OAuth2Provider{
+			OAuth2LoginModeUser: {
+				RelyingParty:  googleProvider,
+				UserInfoURL:   googleUserInfoURL,
+				ParseUserInfo: parseOIDCUserInfo,
+			},
+		}
+	}
+
+	if cfg.DiscordOIDC.ClientID != nil && *cfg.DiscordOIDC.ClientID != "" {
+		discordProvider, err := rp.NewRelyingPartyOAuth(&oauth2.Config{
+			ClientID:     *cfg.DiscordOIDC.ClientID,
+			ClientSecret: derefString(cfg.DiscordOIDC.ClientSecret),
+			RedirectURL:  providerCallbackURL(identity.ProviderDISCORD),
+			Scopes:       strings.Split(discordScopes, " "),
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  discordAuthURL,
+				TokenURL: discordTokenURL,
+			},
+		}, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("error creating discord provider: %w", err)
+		}
+
+		providers[identity.ProviderDISCORD] = map[OAuth2LoginMode]*OAuth2Provider{
+			OAuth2LoginModeUser: {
+				RelyingParty:  discordProvider,
+				UserInfoURL:   discordUserInfoURL,
+				ParseUserInfo: parseDiscordUserInfo,
+			},
+		}
+	}
+
+	return providers, nil
+}
+
+// parseOIDCUserInfo parses a standard OIDC userinfo response.
+func parseOIDCUserInfo(data []byte) (*oidc.UserInfo, error) {
+	var userinfo oidc.UserInfo
+	if err := json.Unmarshal(data, &userinfo); err != nil {
+		return nil, fmt.Errorf("could not parse userinfo: %w", err)
+	}
+	if userinfo.Subject == "" {
+		return nil, fmt.Errorf("userinfo has no subject")
+	}
+	// e.g. Google has no preferred_username claim
+	if userinfo.PreferredUsername == "" {
+		userinfo.PreferredUsername = userinfo.Name
+	}
+
+	return &userinfo, nil
+}
+
+type discordUser struct {
+	ID         string  `json:"id"`
+	Username   string  `json:"username"`
+	GlobalName *string `json:"global_name"`
+	Avatar     *string `json:"avatar"`
+	Email      string  `json:"email"`
+	Verified   bool    `json:"verified"`
+}
+
+// parseDiscordUserInfo maps a Discord current user response to standard claims.
+func parseDiscordUserInfo(data []byte) (*oidc.UserInfo, error) {
+	var du discordUser
+	if err := json.Unmarshal(data, &du); err != nil {
+		return nil, fmt.Errorf("could not parse discord user: %w", err)
+	}
+	if du.ID == "" {
+		return nil, fmt.Errorf("discord user has no id")
+	}
+
+	userinfo := &oidc.UserInfo{
+		Subject: du.ID,
+		UserInfoProfile: oidc.UserInfoProfile{
+			Name:              du.Username,
+			PreferredUsername: du.Username,
+		},
+		UserInfoEmail: oidc.UserInfoEmail{
+			Email:         du.Email,
+			EmailVerified: oidc.Bool(du.Verified),
+		},
+	}
+	if du.GlobalName != nil && *du.GlobalName != "" {
+		userinfo.PreferredUsername = *du.GlobalName
+	}
+	if du.Avatar != nil {
+		userinfo.Picture = fmt.Sprintf("https://cdn.discordapp.com/avatars/%s/%s.png", du.ID, *du.Avatar)
+	}
+
+	return userinfo, nil
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
@@ -0,0 +1,54 @@
+package http
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
+)
+
+func TestAuthState(t *testing.T) {
+	t.Parallel()
+
+	linkUserID := uuid.New()
+
+	encoded, err := generateState(identity.ProviderDISCORD, OAuth2LoginModeUser, "https://localhost/ui", &linkUserID)
+	require.NoError(t, err)
+
+	state, err := parseState(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, identity.ProviderDISCORD, state.Provider)
+	assert.Equal(t, OAuth2LoginModeUser, state.LoginMode)
+	require.NotNil(t, state.LinkUserID)
+	assert.Equal(t, linkUserID, *state.LinkUserID)
+
+	// linking to another user must invalidate the signature
+	otherUserID := uuid.New()
+	state.LinkUserID = &otherUserID
+	tampered, err := json.Marshal(state)
+	require.NoError(t, err)
+
+	_, err = parseState(base64.URLEncoding.EncodeToString(tampered))
+	require.Error(t, err)
+}
+
+func TestParseDiscordUserInfo(t *testing.T) {
+	t.Parallel()
+
+	userinfo, err := parseDiscordUserInfo([]byte(`{"id":"80351110224678912","username":"nelly","global_name":"Nelly","avatar":"8342729096ea3675442027381ff50dfe","email":"nelly@discord.com","verified":true}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "80351110224678912", userinfo.Subject)
+	assert.Equal(t, "Nelly", userinfo.PreferredUsername)
+	assert.Equal(t, "nelly@discord.com", userinfo.Email)
+	assert.True(t, bool(userinfo.EmailVerified))
+	assert.Equal(t, "https://cdn.discordapp.com/avatars/80351110224678912/8342729096ea3675442027381ff50dfe.png", userinfo.Picture)
+
+	_, err = parseDiscordUserInfo([]byte(`{"username":"nelly"}`))
+	require.Error(t, err)
+}
@@ -0,0 +1,303 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	laclipasa "github.com/caliecode/la-clipasa"
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/post"
+	"github.com/caliecode/la-clipasa/internal/gql/extramodel"
+)
+
+const (
+	siteName        = "La Clipasa"
+	siteDescription = "El mejor evento de todo Twitch International"
+	// siteImagePath is relative to the frontend build root.
+	siteImagePath = "icon_x512.png"
+)
+
+// postRouteRegex matches frontend post routes, e.g. /ui/post/<uuid>.
+var postRouteRegex = regexp.MustCompile(`^/ui/post/([0-9a-fA-F-]{36})/?$`)
+
+var (
+	videoExtensions = []string{".mp4", ".webm", ".mov"}
+	imageExtensions = []string{".png", ".jpg", ".jpeg", ".gif", ".webp"}
+)
+
+// pageMetadata represents the OpenGraph, Twitter card and oEmbed discovery
+// tags injected into the frontend index.
+type pageMetadata struct {
+	Title       string
+	Description string
+	URL         string
+	Image       string
+	Video       string
+	VideoType   string
+	// OEmbedURL is the oEmbed discovery endpoint for the page, if any.
+	OEmbedURL string
+}
+
+func defaultPageMetadata(pageURL string) pageMetadata {
+	return pageMetadata{
+		Title:       siteName,
+		Description: siteDescription,
+		URL:         pageURL,
+		Image:       internal.BuildUIURL(siteImagePath),
+	}
+}
+
+// twitterCard returns the card type based on the available media.
+func (m pageMetadata) twitterCard() string {
+	switch {
+	case m.Video != "":
+		return "player"
+	case m.Image != "":
+		return "summary_large_image"
+	default:
+		return "summary"
+	}
+}
+
+// Tags renders the metadata as HTML head elements.
+func (m pageMetadata) Tags() string {
+	var b strings.Builder
+
+	meta := func(attr, key, value string) {
+		if value == "" {
+			return
+		}
+		fmt.Fprintf(&b, "<meta %s=\"%s\" content=\"%s\" />\n", attr, key, html.EscapeString(value))
+	}
+
+	ogType := "website"
+	if m.Video != "" {
+		ogType = "video.other"
+	}
+
+	meta("property", "og:site_name", siteName)
+	meta("property", "og:type", ogType)
+	meta("property", "og:title", m.Title)
+	meta("property", "og:description", m.Description)
+	meta("property", "og:url", m.URL)
+	meta("property", "og:image", m.Image)
+	meta("property", "og:video", m.Video)
+	meta("property", "og:video:secure_url", m.Video)
+	meta("property", "og:video:type", m.VideoType)
+	meta("name", "twitter:card", m.twitterCard())
+	meta("name", "twitter:title", m.Title)
+	meta("name", "twitter:description", m.Description)
+	meta("name", "twitter:image", m.Image)
+	if m.Video != "" {
+		meta("name", "twitter:player", m.Video)
+		meta("name", "twitter:player:stream", m.Video)
+		meta("name", "twitter:player:stream:content_type", m.VideoType)
+	}
+
+	if m.OEmbedURL != "" {
+		fmt.Fprintf(&b, "<link rel=\"alternate\" type=\"application/json+oembed\" href=\"%s\" title=\"%s\" />\n",
+			html.EscapeString(m.OEmbedURL), html.EscapeString(m.Title))
+	}
+
+	return b.String()
+}
+
+var (
+	titleTagRegex       = regexp.MustCompile(`(?is)<title>.*?</title>`)
+	descriptionTagRegex = regexp.MustCompile(`(?is)<meta\s+name="description"[^>]*>`)
+)
+
+// injectPageMetadata replaces the index title and description and appends the
+// metadata tags to the document head.
+func injectPageMetadata(index []byte, m pageMetadata) []byte {
+	out := titleTagRegex.ReplaceAll(index, []byte("<title>"+html.EscapeString(m.Title)+"</title>"))
+	out = descriptionTagRegex.ReplaceAll(out, []byte(fmt.Sprintf(`<meta name="description" content="%s" />`, html.EscapeString(m.Description))))
+
+	headEnd := bytes.Index(bytes.ToLower(out), []byte("</head>"))
+	if headEnd == -1 {
+		return out
+	}
+
+	res := make([]byte, 0, len(out)+1024)
+	res = append(res, out[:headEnd]...)
+	res = append(res, m.Tags()...)
+	res = append(res, out[headEnd:]...)
+
+	return res
+}
+
+// postFromRoute returns the post for a frontend post route, with privacy applied.
+// Soft-deleted or inaccessible posts are not returned.
+func postFromRoute(ctx context.Context, entClient *generated.Client, routePath string) (*generated.Post, bool) {
+	matches := postRouteRegex.FindStringSubmatch(routePath)
+	if len(matches) != 2 {
+		return nil, false
+	}
+
+	id, err := uuid.Parse(matches[1])
+	if err != nil {
+		return nil, false
+	}
+
+	ctx = generated.NewContext(ctx, entClient)
+	p, err := entClient.Post.Query().Where(post.ID(id)).WithOwner().Only(ctx)
+	if err != nil {
+		return nil, false
+	}
+
+	return p, true
+}
+
+// postPageMetadata builds the page metadata for a given post.
+func postPageMetadata(p *generated.Post) pageMetadata {
+	pageURL := internal.BuildUIURL("post", p.ID.String())
+	m := defaultPageMetadata(pageURL)
+	m.Title = p.Title
+	m.OEmbedURL = internal.BuildAPIURL("oembed") + "?format=json&url=" + url.QueryEscape(pageURL)
+
+	if owner := p.Edges.Owner; owner != nil {
+		m.Description = fmt.Sprintf("Publicado por %s en %s", owner.DisplayName, siteName)
+	}
+	if p.Content != nil && *p.Content != "" {
+		m.Description = *p.Content
+	}
+
+	switch mediaType(p) {
+	case "video":
+		m.Video = p.Link
+		m.VideoType = "video/mp4"
+		if ext := linkExtension(p.Link); ext == ".webm" {
+			m.VideoType = "video/webm"
+		}
+	case "image":
+		m.Image = p.Link
+	}
+
+	return m
+}
+
+// mediaType returns whether the post link points to a video, an image or neither.
+func mediaType(p *generated.Post) string {
+	if p.Metadata.Service == extramodel.PostServiceDiscord {
+		return "video"
+	}
+
+	ext := linkExtension(p.Link)
+	for _, e := range videoExtensions {
+		if ext == e {
+			return "video"
+		}
+	}
+	for _, e := range imageExtensions {
+		if ext == e {
+			return "image"
+		}
+	}
+
+	return ""
+}
+
+func linkExtension(link string) string {
+	u, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+
+	return strings.ToLower(path.Ext(u.Path))
+}
+
+// serveFrontendIndex serves the frontend index, with page metadata for known routes.
+func (h *Handlers) serveFrontendIndex(c *gin.Context) {
+	indexFile, err := laclipasa.FrontendBuildFS.Open("frontend/build/index.html")
+	if err != nil {
+		fmt.Printf("err: %v\n", err)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	defer indexFile.Close()
+
+	index, err := io.ReadAll(indexFile)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	m := defaultPageMetadata(internal.BuildUIURL())
+	if p, ok := postFromRoute(c.Request.Context(), h.client, c.Request.URL.Path); ok {
+		m = postPageMetadata(p)
+	}
+
+	// will load assets via static.Serve at /
+	c.Data(http.StatusOK, "text/html; charset=utf-8", injectPageMetadata(index, m))
+}
+
+// oEmbedResponse represents an oEmbed JSON response.
+// See https://oembed.com/#section2.3
+// nolint: tagliatelle
+type oEmbedResponse struct {
+	Version         string `json:"version"`
+	Type            string `json:"type"`
+	Title           string `json:"title,omitempty"`
+	AuthorName      string `json:"author_name,omitempty"`
+	ProviderName    string `json:"provider_name"`
+	ProviderURL     string `json:"provider_url"`
+	ThumbnailURL    string `json:"thumbnail_url,omitempty"`
+	HTML            string `json:"html,omitempty"`
+	Width           int    `json:"width,omitempty"`
+	Height          int    `json:"height,omitempty"`
+	ThumbnailWidth  int    `json:"thumbnail_width,omitempty"`
+	ThumbnailHeight int    `json:"thumbnail_height,omitempty"`
+}
+
+// oEmbed implements the oEmbed provider endpoint for post URLs.
+func (h *Handlers) oEmbed(c *gin.Context) {
+	if format := c.Query("format"); format != "" && format != "json" {
+		c.AbortWithStatus(http.StatusNotImplemented)
+		return
+	}
+
+	u, err := url.Parse(c.Query("url"))
+	if err != nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	p, ok := postFromRoute(c.Request.Context(), h.client, u.Path)
+	if !ok {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	m := postPageMetadata(p)
+	res := oEmbedResponse{
+		Version:      "1.0",
+		Type:         "link",
+		Title:        m.Title,
+		ProviderName: siteName,
+		ProviderURL:  internal.BuildUIURL(),
+		ThumbnailURL: m.Image,
+	}
+	if owner := p.Edges.Owner; owner != nil {
+		res.AuthorName = owner.DisplayName
+	}
+	if m.Video != "" {
+		res.Type = "video"
+		res.Width = 1280
+		res.Height = 720
+		res.HTML = fmt.Sprintf(`<video src="%s" width="%d" height="%d" controls></video>`, html.EscapeString(m.Video), res.Width, res.Height)
+	}
+
+	c.JSON(http.StatusOK, res)
+}
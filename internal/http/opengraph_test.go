@@ -0,0 +1,59 @@
+package http
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/gql/extramodel"
+	"github.com/caliecode/la-clipasa/internal/utils/pointers"
+)
+
+func TestInjectPageMetadata(t *testing.T) {
+	t.Parallel()
+
+	index := []byte(`<html><head><title>La Clipasa</title><meta name="description" content="generic" title="x" /></head><body></body></html>`)
+
+	p := &generated.Post{
+		ID:      uuid.New(),
+		Title:   `A "quoted" <clip>`,
+		Content: pointers.New("some content"),
+		Link:    "https://cdn.discordapp.com/attachments/1/2/video.mp4?ex=67e5a1b2",
+		Metadata: extramodel.PostMetadata{
+			Service: extramodel.PostServiceDiscord,
+		},
+		Edges: generated.PostEdges{Owner: &generated.User{DisplayName: "caliebre"}},
+	}
+
+	out := string(injectPageMetadata(index, postPageMetadata(p)))
+
+	assert.Contains(t, out, `<title>A &#34;quoted&#34; &lt;clip&gt;</title>`)
+	assert.Contains(t, out, `<meta name="description" content="some content" />`)
+	assert.NotContains(t, out, "generic")
+	assert.Contains(t, out, `<meta property="og:title" content="A &#34;quoted&#34; &lt;clip&gt;" />`)
+	assert.Contains(t, out, `<meta property="og:video" content="https://cdn.discordapp.com/attachments/1/2/video.mp4?ex=67e5a1b2" />`)
+	assert.Contains(t, out, `<meta property="og:video:type" content="video/mp4" />`)
+	assert.Contains(t, out, `<meta name="twitter:card" content="player" />`)
+	assert.Contains(t, out, `type="application/json+oembed"`)
+	assert.Contains(t, out, "/ui/post/"+p.ID.String())
+	assert.True(t, strings.Index(out, "og:title") < strings.Index(out, "</head>"), "tags must be in head")
+}
+
+func TestPostPageMetadata_Image(t *testing.T) {
+	t.Parallel()
+
+	p := &generated.Post{
+		ID:    uuid.New(),
+		Title: "image post",
+		Link:  "https://i.imgur.com/abc.PNG",
+	}
+
+	m := postPageMetadata(p)
+
+	assert.Equal(t, p.Link, m.Image)
+	assert.Empty(t, m.Video)
+	assert.Equal(t, "summary_large_image", m.twitterCard())
+}
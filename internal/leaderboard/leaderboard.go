@@ -0,0 +1,145 @@
+package leaderboard
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/utils/cache"
+)
+
+const (
+	// RefreshInterval is the interval leaderboards are recomputed at.
+	RefreshInterval = 15 * time.Minute
+	// MaxEntries is the maximum number of entries returned per leaderboard.
+	MaxEntries = 100
+)
+
+const topEntriesQuery = `
+SELECT
+	le."user_id",
+	le."score",
+	rank() OVER (ORDER BY le."score" DESC) AS "rank"
+FROM "leaderboard_entries" le
+JOIN "users" u ON u."id" = le."user_id" AND u."deleted_at" IS NULL
+WHERE le."period" = $1 AND le."metric" = $2
+ORDER BY le."score" DESC, le."user_id"
+LIMIT $3
+`
+
+// cacheKeyPrefix prefixes the cache keys of ranked entries.
+const cacheKeyPrefix = "leaderboard:"
+
+// Entry represents a user's position in a leaderboard.
+type Entry struct {
+	Rank  int
+	Score int
+	User  *generated.User
+}
+
+// rankedEntry is a cached entry, whose user is loaded on each request.
+type rankedEntry struct {
+	UserID uuid.UUID `json:"userId"`
+	Rank   int       `json:"rank"`
+	Score  int       `json:"score"`
+}
+
+// Leaderboards serves leaderboards from the leaderboard_entries materialized view.
+type Leaderboards struct {
+	entc  *generated.Client
+	cache cache.Store
+}
+
+// New returns a new Leaderboards caching rankings in store.
+func New(entc *generated.Client, store cache.Store) *Leaderboards {
+	return &Leaderboards{
+		entc:  entc,
+		cache: store,
+	}
+}
+
+// Top returns the top users for a period and metric.
+// Rankings are cached until the next refresh.
+func (l *Leaderboards) Top(ctx context.Context, period, metric string, limit int) ([]*Entry, error) {
+	key := fmt.Sprintf("%s%s:%s:%d", cacheKeyPrefix, period, metric, limit)
+
+	ranked, err := cache.Memoize(ctx, l.cache, key, RefreshInterval, func() ([]rankedEntry, error) {
+		return l.ranked(ctx, period, metric, limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return l.withUsers(ctx, ranked)
+}
+
+func (l *Leaderboards) ranked(ctx context.Context, period, metric string, limit int) ([]rankedEntry, error) {
+	rows, err := l.entc.DB.Query(ctx, topEntriesQuery, period, metric, limit)
+	if err != nil {
+		return nil, fmt.Errorf("could not query leaderboard: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []rankedEntry{}
+	for rows.Next() {
+		var entry rankedEntry
+		if err := rows.Scan(&entry.UserID, &entry.Score, &entry.Rank); err != nil {
+			return nil, fmt.Errorf("could not scan leaderboard entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not read leaderboard: %w", err)
+	}
+
+	return entries, nil
+}
+
+// withUsers loads the users of ranked entries, skipping users that were deleted since.
+func (l *Leaderboards) withUsers(ctx context.Context, ranked []rankedEntry) ([]*Entry, error) {
+	userIDs := make([]uuid.UUID, 0, len(ranked))
+	for _, entry := range ranked {
+		userIDs = append(userIDs, entry.UserID)
+	}
+
+	users, err := l.entc.User.Query().
+		Where(user.IDIn(userIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not query leaderboard users: %w", err)
+	}
+
+	usersByID := make(map[uuid.UUID]*generated.User, len(users))
+	for _, u := range users {
+		usersByID[u.ID] = u
+	}
+
+	res := make([]*Entry, 0, len(ranked))
+	for _, entry := range ranked {
+		u, ok := usersByID[entry.UserID]
+		if !ok {
+			continue
+		}
+		res = append(res, &Entry{Rank: entry.Rank, Score: entry.Score, User: u})
+	}
+
+	return res, nil
+}
+
+// Refresh recomputes all leaderboards and invalidates cached rankings in all instances.
+func (l *Leaderboards) Refresh(ctx context.Context) {
+	l.entc.Logger.Info("Refreshing leaderboards")
+
+	if _, err := l.entc.DB.Exec(ctx, `REFRESH MATERIALIZED VIEW CONCURRENTLY "leaderboard_entries"`); err != nil {
+		l.entc.Logger.Errorf("Error refreshing leaderboards: %v", err)
+		return
+	}
+
+	if err := l.cache.DeletePrefix(ctx, cacheKeyPrefix); err != nil {
+		l.entc.Logger.Errorf("Error invalidating cached leaderboards: %v", err)
+	}
+}
@@ -1,7 +1,10 @@
 // nolint: tagliatelle
 package models
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // TwitchTokenInfo represents the minimal token information stored in a cookie.
 // nolint: tagliatelle
@@ -45,6 +48,33 @@ type TwitchStreamResponse struct {
 	Data []TwitchStream `json:"data"`
 }
 
+// TwitchClip represents a Twitch clip.
+type TwitchClip struct {
+	ID              string  `json:"id"`
+	URL             string  `json:"url"`
+	EmbedURL        string  `json:"embed_url"`
+	BroadcasterID   string  `json:"broadcaster_id"`
+	BroadcasterName string  `json:"broadcaster_name"`
+	CreatorID       string  `json:"creator_id"`
+	CreatorName     string  `json:"creator_name"`
+	VideoID         string  `json:"video_id"`
+	GameID          string  `json:"game_id"`
+	Language        string  `json:"language"`
+	Title           string  `json:"title"`
+	ViewCount       int     `json:"view_count"`
+	CreatedAt       string  `json:"created_at"`
+	ThumbnailURL    string  `json:"thumbnail_url"`
+	Duration        float64 `json:"duration"`
+	// VODOffset is the offset in seconds of the clip in the VOD, if still available.
+	VODOffset *int `json:"vod_offset"`
+}
+
+// TwitchClipsResponse wraps a page of clips.
+type TwitchClipsResponse struct {
+	Data       []TwitchClip     `json:"data"`
+	Pagination TwitchPagination `json:"pagination"`
+}
+
 // TwitchUserFollow represents a follow relationship.
 type TwitchUserFollow struct {
 	FromID     string `json:"from_id"`
@@ -109,3 +139,80 @@ type BanData struct {
 	ModeratorLogin string `json:"moderator_login"`
 	ModeratorName  string `json:"moderator_name"`
 }
+
+// TwitchTokenResponse represents the response from Twitch's token endpoint.
+type TwitchTokenResponse struct {
+	AccessToken  string   `json:"access_token"`
+	RefreshToken string   `json:"refresh_token"`
+	ExpiresIn    int      `json:"expires_in"`
+	Scope        []string `json:"scope"`
+	TokenType    string   `json:"token_type"`
+}
+
+// TwitchPagination is the cursor of paginated Helix responses.
+type TwitchPagination struct {
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// TwitchChannelUser represents a channel moderator or VIP.
+type TwitchChannelUser struct {
+	UserID    string `json:"user_id"`
+	UserLogin string `json:"user_login"`
+	UserName  string `json:"user_name"`
+}
+
+// TwitchChannelUsersResponse wraps a page of channel moderators or VIPs.
+type TwitchChannelUsersResponse struct {
+	Data       []TwitchChannelUser `json:"data"`
+	Pagination TwitchPagination    `json:"pagination"`
+}
+
+// TwitchEventSubTransport is the delivery method of an EventSub subscription.
+type TwitchEventSubTransport struct {
+	Method   string `json:"method"`
+	Callback string `json:"callback,omitempty"`
+	Secret   string `json:"secret,omitempty"`
+}
+
+// TwitchEventSubSubscription represents an EventSub subscription.
+type TwitchEventSubSubscription struct {
+	ID        string                  `json:"id,omitempty"`
+	Status    string                  `json:"status,omitempty"`
+	Type      string                  `json:"type"`
+	Version   string                  `json:"version"`
+	Condition map[string]string       `json:"condition"`
+	Transport TwitchEventSubTransport `json:"transport"`
+	CreatedAt string                  `json:"created_at,omitempty"`
+}
+
+// TwitchEventSubSubscriptionsResponse wraps a page of EventSub subscriptions.
+type TwitchEventSubSubscriptionsResponse struct {
+	Data       []TwitchEventSubSubscription `json:"data"`
+	Pagination TwitchPagination             `json:"pagination"`
+}
+
+// TwitchEventSubMessage is the body of EventSub webhook requests.
+// Challenge is only set for verification requests and Event only for notifications.
+type TwitchEventSubMessage struct {
+	Subscription TwitchEventSubSubscription `json:"subscription"`
+	Challenge    string                     `json:"challenge,omitempty"`
+	Event        json.RawMessage            `json:"event,omitempty"`
+}
+
+// TwitchChannelUserEvent is the event of notifications about a user in the broadcaster channel,
+// e.g. channel.unban, channel.subscribe and channel.moderator.add.
+// UserID is empty for stream.online and stream.offline.
+type TwitchChannelUserEvent struct {
+	BroadcasterUserID string `json:"broadcaster_user_id"`
+	UserID            string `json:"user_id"`
+	UserLogin         string `json:"user_login"`
+}
+
+// TwitchChannelBanEvent is the event of channel.ban notifications.
+// EndsAt is nil for permanent bans.
+type TwitchChannelBanEvent struct {
+	TwitchChannelUserEvent
+	Reason      string     `json:"reason"`
+	IsPermanent bool       `json:"is_permanent"`
+	EndsAt      *time.Time `json:"ends_at"`
+}
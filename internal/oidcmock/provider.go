@@ -0,0 +1,320 @@
+// Package oidcmock implements a minimal in-process OIDC provider for local development, e2e and tests.
+// It supports the authorization code flow only and issues tokens for preconfigured users
+// without any user interaction.
+package oidcmock
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/zitadel/oidc/v3/pkg/crypto"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+)
+
+const (
+	keyID         = "oidcmock"
+	tokenLifetime = time.Hour
+
+	DiscoveryPath = oidc.DiscoveryEndpoint
+	AuthorizePath = "/authorize"
+	TokenPath     = "/token"
+	UserInfoPath  = "/userinfo"
+	KeysPath      = "/keys"
+)
+
+// User is an account that can log in to the provider.
+type User struct {
+	Subject           string
+	PreferredUsername string
+	Email             string
+	EmailVerified     bool
+	Picture           string
+	// Claims are additional userinfo claims.
+	Claims map[string]any
+}
+
+// Config configures the provider.
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	// Users can log in with a login_hint parameter set to their subject or preferred username.
+	// The first user is logged in by default.
+	Users []User
+}
+
+type authorization struct {
+	user        User
+	clientID    string
+	redirectURI string
+	nonce       string
+	expiresAt   time.Time
+}
+
+// Provider is an http.Handler serving the OIDC provider endpoints.
+// The issuer is derived from the request host.
+type Provider struct {
+	cfg    Config
+	key    *rsa.PrivateKey
+	signer jose.Signer
+	mux    *http.ServeMux
+
+	mu           sync.Mutex
+	codes        map[string]authorization
+	accessTokens map[string]User
+}
+
+// New returns a new mock provider with a freshly generated signing key.
+func New(cfg Config) (*Provider, error) {
+	if len(cfg.Users) == 0 {
+		return nil, fmt.Errorf("at least one user is required")
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate signing key: %w", err)
+	}
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: jose.JSONWebKey{Key: key, KeyID: keyID}},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not create signer: %w", err)
+	}
+
+	p := &Provider{
+		cfg:          cfg,
+		key:          key,
+		signer:       signer,
+		mux:          http.NewServeMux(),
+		codes:        make(map[string]authorization),
+		accessTokens: make(map[string]User),
+	}
+
+	p.mux.HandleFunc("GET "+DiscoveryPath, p.discovery)
+	p.mux.HandleFunc("GET "+KeysPath, p.keys)
+	p.mux.HandleFunc("GET "+AuthorizePath, p.authorize)
+	p.mux.HandleFunc("POST "+TokenPath, p.token)
+	p.mux.HandleFunc("GET "+UserInfoPath, p.userinfo)
+
+	return p, nil
+}
+
+func (p *Provider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mux.ServeHTTP(w, r)
+}
+
+func issuer(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+
+	return scheme + "://" + r.Host
+}
+
+func (p *Provider) discovery(w http.ResponseWriter, r *http.Request) {
+	iss := issuer(r)
+
+	writeJSON(w, http.StatusOK, &oidc.DiscoveryConfiguration{
+		Issuer:                            iss,
+		AuthorizationEndpoint:             iss + AuthorizePath,
+		TokenEndpoint:                     iss + TokenPath,
+		UserinfoEndpoint:                  iss + UserInfoPath,
+		JwksURI:                           iss + KeysPath,
+		ScopesSupported:                   []string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail},
+		ResponseTypesSupported:            []string{string(oidc.ResponseTypeCode)},
+		GrantTypesSupported:               []oidc.GrantType{oidc.GrantTypeCode},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{string(jose.RS256)},
+		TokenEndpointAuthMethodsSupported: []oidc.AuthMethod{oidc.AuthMethodBasic, oidc.AuthMethodPost},
+		ClaimsSupported:                   []string{"sub", "iss", "aud", "exp", "iat", "nonce", "preferred_username", "email", "email_verified", "picture"},
+	})
+}
+
+func (p *Provider) keys(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, &jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{{
+			Key:       &p.key.PublicKey,
+			KeyID:     keyID,
+			Algorithm: string(jose.RS256),
+			Use:       "sig",
+		}},
+	})
+}
+
+func (p *Provider) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	if q.Get("client_id") != p.cfg.ClientID {
+		writeError(w, http.StatusBadRequest, oidc.ErrInvalidClient().WithDescription("unknown client"))
+		return
+	}
+	if q.Get("response_type") != string(oidc.ResponseTypeCode) {
+		writeError(w, http.StatusBadRequest, oidc.ErrInvalidRequest().WithDescription("unsupported response_type"))
+		return
+	}
+
+	redirectURI, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil || redirectURI.String() == "" {
+		writeError(w, http.StatusBadRequest, oidc.ErrInvalidRequestRedirectURI().WithDescription("invalid redirect_uri"))
+		return
+	}
+
+	user, ok := p.findUser(q.Get("login_hint"))
+	if !ok {
+		writeError(w, http.StatusBadRequest, oidc.ErrLoginRequired().WithDescription("unknown user"))
+		return
+	}
+
+	code := randomString()
+
+	p.mu.Lock()
+	p.codes[code] = authorization{
+		user:        user,
+		clientID:    p.cfg.ClientID,
+		redirectURI: redirectURI.String(),
+		nonce:       q.Get("nonce"),
+		expiresAt:   time.Now().Add(time.Minute),
+	}
+	p.mu.Unlock()
+
+	params := redirectURI.Query()
+	params.Set("code", code)
+	params.Set("state", q.Get("state"))
+	redirectURI.RawQuery = params.Encode()
+
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+func (p *Provider) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, oidc.ErrInvalidRequest())
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID != p.cfg.ClientID || clientSecret != p.cfg.ClientSecret {
+		writeError(w, http.StatusUnauthorized, oidc.ErrInvalidClient())
+		return
+	}
+
+	if r.PostForm.Get("grant_type") != string(oidc.GrantTypeCode) {
+		writeError(w, http.StatusBadRequest, oidc.ErrUnsupportedGrantType())
+		return
+	}
+
+	code := r.PostForm.Get("code")
+
+	p.mu.Lock()
+	authz, ok := p.codes[code]
+	delete(p.codes, code) // codes are single use
+	p.mu.Unlock()
+
+	if !ok || time.Now().After(authz.expiresAt) || authz.redirectURI != r.PostForm.Get("redirect_uri") {
+		writeError(w, http.StatusBadRequest, oidc.ErrInvalidGrant())
+		return
+	}
+
+	now := time.Now()
+	claims := oidc.NewIDTokenClaims(issuer(r), authz.user.Subject, nil, now.Add(tokenLifetime), now, authz.nonce, "", nil, authz.clientID, 0)
+	idToken, err := crypto.Sign(claims, p.signer)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, oidc.ErrServerError().WithParent(err))
+		return
+	}
+
+	accessToken := randomString()
+
+	p.mu.Lock()
+	p.accessTokens[accessToken] = authz.user
+	p.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, &oidc.AccessTokenResponse{
+		AccessToken: accessToken,
+		TokenType:   oidc.BearerToken,
+		ExpiresIn:   uint64(tokenLifetime.Seconds()),
+		IDToken:     idToken,
+	})
+}
+
+func (p *Provider) userinfo(w http.ResponseWriter, r *http.Request) {
+	accessToken, ok := strings.CutPrefix(r.Header.Get("Authorization"), oidc.PrefixBearer)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, oidc.ErrAccessDenied())
+		return
+	}
+
+	p.mu.Lock()
+	user, ok := p.accessTokens[accessToken]
+	p.mu.Unlock()
+
+	if !ok {
+		writeError(w, http.StatusUnauthorized, oidc.ErrAccessDenied())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, user.userInfo())
+}
+
+func (p *Provider) findUser(loginHint string) (User, bool) {
+	if loginHint == "" {
+		return p.cfg.Users[0], true
+	}
+
+	for _, u := range p.cfg.Users {
+		if u.Subject == loginHint || u.PreferredUsername == loginHint {
+			return u, true
+		}
+	}
+
+	return User{}, false
+}
+
+func (u User) userInfo() *oidc.UserInfo {
+	userinfo := &oidc.UserInfo{
+		Subject: u.Subject,
+		UserInfoProfile: oidc.UserInfoProfile{
+			PreferredUsername: u.PreferredUsername,
+			Picture:           u.Picture,
+		},
+		UserInfoEmail: oidc.UserInfoEmail{
+			Email:         u.Email,
+			EmailVerified: oidc.Bool(u.EmailVerified),
+		},
+	}
+	for k, v := range u.Claims {
+		userinfo.AppendClaims(k, v)
+	}
+
+	return userinfo
+}
+
+func randomString() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err *oidc.Error) {
+	writeJSON(w, status, err)
+}
@@ -0,0 +1,114 @@
+package oidcmock_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/client/rp"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/caliecode/la-clipasa/internal/oidcmock"
+)
+
+const redirectURI = "http://localhost/callback"
+
+func TestProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	provider, err := oidcmock.New(oidcmock.Config{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		Users: []oidcmock.User{
+			{Subject: "1", PreferredUsername: "first"},
+			{Subject: "2", PreferredUsername: "second", Email: "second@example.com", EmailVerified: true, Claims: map[string]any{"custom": "value"}},
+		},
+	})
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(provider)
+	t.Cleanup(srv.Close)
+
+	relyingParty, err := rp.NewRelyingPartyOIDC(ctx, srv.URL, "client", "secret", redirectURI, []string{oidc.ScopeOpenID})
+	require.NoError(t, err)
+
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+
+	authorize := func(t *testing.T, loginHint string) string {
+		t.Helper()
+
+		params := url.Values{
+			"client_id":     {"client"},
+			"response_type": {"code"},
+			"redirect_uri":  {redirectURI},
+			"state":         {"state"},
+			"login_hint":    {loginHint},
+		}
+		resp, err := client.Get(srv.URL + oidcmock.AuthorizePath + "?" + params.Encode())
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusFound, resp.StatusCode)
+		location, err := resp.Location()
+		require.NoError(t, err)
+		assert.Equal(t, "state", location.Query().Get("state"))
+
+		return location.Query().Get("code")
+	}
+
+	t.Run("login_hint", func(t *testing.T) {
+		t.Parallel()
+
+		code := authorize(t, "second")
+
+		tokens, err := rp.CodeExchange[*oidc.IDTokenClaims](ctx, code, relyingParty)
+		require.NoError(t, err)
+		assert.Equal(t, "2", tokens.IDTokenClaims.Subject)
+
+		userinfo, err := rp.Userinfo[*oidc.UserInfo](ctx, tokens.AccessToken, tokens.TokenType, tokens.IDTokenClaims.Subject, relyingParty)
+		require.NoError(t, err)
+		assert.Equal(t, "second", userinfo.PreferredUsername)
+		assert.Equal(t, "second@example.com", userinfo.Email)
+		assert.True(t, bool(userinfo.EmailVerified))
+		assert.Equal(t, "value", userinfo.Claims["custom"])
+
+		_, err = rp.CodeExchange[*oidc.IDTokenClaims](ctx, code, relyingParty)
+		require.Error(t, err, "codes are single use")
+	})
+
+	t.Run("default user", func(t *testing.T) {
+		t.Parallel()
+
+		tokens, err := rp.CodeExchange[*oidc.IDTokenClaims](ctx, authorize(t, ""), relyingParty)
+		require.NoError(t, err)
+		assert.Equal(t, "1", tokens.IDTokenClaims.Subject)
+	})
+
+	t.Run("invalid client secret", func(t *testing.T) {
+		t.Parallel()
+
+		badRelyingParty, err := rp.NewRelyingPartyOIDC(ctx, srv.URL, "client", "bad", redirectURI, []string{oidc.ScopeOpenID})
+		require.NoError(t, err)
+
+		_, err = rp.CodeExchange[*oidc.IDTokenClaims](ctx, authorize(t, "first"), badRelyingParty)
+		require.Error(t, err)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := client.Get(srv.URL + oidcmock.AuthorizePath + "?client_id=client&response_type=code&redirect_uri=" + url.QueryEscape(redirectURI) + "&login_hint=unknown")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
@@ -0,0 +1,164 @@
+package twitchsync
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/theopenlane/entx"
+
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/post"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+	"github.com/caliecode/la-clipasa/internal/gql/extramodel"
+	"github.com/caliecode/la-clipasa/internal/models"
+)
+
+const (
+	// ClipsImportInterval is the interval the top new clips of the channel are imported at.
+	ClipsImportInterval = time.Hour
+	// clipsImportWindow is how far back clips are considered new.
+	clipsImportWindow = 24 * time.Hour
+	// clipsImportLimit is the maximum number of clips suggested per import.
+	clipsImportLimit = 10
+
+	// ClipsUserExternalID identifies the system user that owns imported clips.
+	ClipsUserExternalID = "system:twitch-clips"
+	clipsUserName       = "Twitch Clips"
+)
+
+// ClipFetcher fetches Twitch clips.
+type ClipFetcher interface {
+	// Clip returns a clip of any channel, or nil if it does not exist.
+	Clip(ctx context.Context, id string) (*models.TwitchClip, error)
+	// TopClips returns the most viewed clips of the broadcaster created since startedAt.
+	TopClips(ctx context.Context, startedAt time.Time, first int) ([]models.TwitchClip, error)
+}
+
+// ClipPostMetadata returns the metadata of a post created from a clip.
+func ClipPostMetadata(clip *models.TwitchClip) extramodel.PostMetadata {
+	createdAt, _ := time.Parse(time.RFC3339, clip.CreatedAt)
+
+	return extramodel.PostMetadata{
+		Version: 1,
+		Service: extramodel.PostServiceTwitch,
+		TwitchClip: &extramodel.TwitchClipMetadata{
+			ID:              clip.ID,
+			BroadcasterID:   clip.BroadcasterID,
+			BroadcasterName: clip.BroadcasterName,
+			CreatorID:       clip.CreatorID,
+			CreatorName:     clip.CreatorName,
+			ThumbnailURL:    clip.ThumbnailURL,
+			Duration:        clip.Duration,
+			VideoID:         clip.VideoID,
+			VODOffset:       clip.VODOffset,
+			CreatedAt:       createdAt,
+		},
+	}
+}
+
+// ClipPostExists reports whether a post was created from a clip,
+// including deleted posts and posts hidden from the current user.
+func ClipPostExists(ctx context.Context, entc *generated.Client, clipID string) (bool, error) {
+	ctx = entx.SkipSoftDelete(ctx)
+	ctx = token.NewContextWithSystemCallToken(ctx)
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	exists, err := entc.Post.Query().
+		Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueEQ(post.FieldMetadata, clipID, sqljson.DotPath("twitchClip.id")))
+		}).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("could not query clip posts: %w", err)
+	}
+
+	return exists, nil
+}
+
+// ClipImporter suggests the top new clips of the channel as unmoderated posts,
+// owned by a system user.
+type ClipImporter struct {
+	entc  *generated.Client
+	clips ClipFetcher
+}
+
+// NewClipImporter returns a new ClipImporter.
+func NewClipImporter(entc *generated.Client, clips ClipFetcher) *ClipImporter {
+	return &ClipImporter{
+		entc:  entc,
+		clips: clips,
+	}
+}
+
+// Import creates posts for the top new clips that were not posted yet and
+// returns the number of created posts. Deleted clip posts are not suggested again.
+func (i *ClipImporter) Import(ctx context.Context) (int, error) {
+	topClips, err := i.clips.TopClips(ctx, time.Now().Add(-clipsImportWindow), clipsImportLimit)
+	if err != nil {
+		return 0, fmt.Errorf("could not get top clips: %w", err)
+	}
+
+	ctx = token.NewContextWithSystemCallToken(ctx)
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	var owner *generated.User
+	created := 0
+	for _, clip := range topClips {
+		exists, err := ClipPostExists(ctx, i.entc, clip.ID)
+		if err != nil {
+			return created, err
+		}
+		if exists {
+			continue
+		}
+
+		if owner == nil {
+			if owner, err = i.clipsUser(ctx); err != nil {
+				return created, err
+			}
+		}
+
+		err = i.entc.Post.Create().
+			SetTitle(clip.Title).
+			SetLink(clip.URL).
+			SetMetadata(ClipPostMetadata(&clip)).
+			SetOwner(owner).
+			Exec(ctx)
+		if err != nil {
+			return created, fmt.Errorf("could not create post for clip %s: %w", clip.ID, err)
+		}
+		created++
+	}
+
+	return created, nil
+}
+
+// clipsUser returns the system user owning imported clips, creating it if needed.
+func (i *ClipImporter) clipsUser(ctx context.Context) (*generated.User, error) {
+	u, err := i.entc.User.Query().Where(user.ExternalID(ClipsUserExternalID)).Only(ctx)
+	if err == nil {
+		return u, nil
+	}
+	if !generated.IsNotFound(err) {
+		return nil, fmt.Errorf("could not query clips user: %w", err)
+	}
+
+	u, err = i.entc.User.Create().
+		SetExternalID(ClipsUserExternalID).
+		SetDisplayName(clipsUserName).
+		Save(ctx)
+	if generated.IsConstraintError(err) {
+		// created concurrently by another instance
+		u, err = i.entc.User.Query().Where(user.ExternalID(ClipsUserExternalID)).Only(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not create clips user: %w", err)
+	}
+
+	return u, nil
+}
@@ -0,0 +1,104 @@
+package twitchsync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/auth"
+	"github.com/caliecode/la-clipasa/internal/models"
+)
+
+// EventSub subscription types handled by EventHandler.
+const (
+	EventStreamOnline     = "stream.online"
+	EventStreamOffline    = "stream.offline"
+	EventChannelBan       = "channel.ban"
+	EventChannelUnban     = "channel.unban"
+	EventChannelSubscribe = "channel.subscribe"
+	EventChannelModAdd    = "channel.moderator.add"
+	EventChannelModRemove = "channel.moderator.remove"
+)
+
+// all handled subscription types are at version 1
+const eventSubscriptionVersion = "1"
+
+// EventSubscription is an EventSub subscription handled by EventHandler.
+type EventSubscription struct {
+	Type    string
+	Version string
+}
+
+// EventSubscriptions are the subscriptions to the broadcaster channel handled by EventHandler.
+var EventSubscriptions = []EventSubscription{
+	{Type: EventStreamOnline, Version: eventSubscriptionVersion},
+	{Type: EventStreamOffline, Version: eventSubscriptionVersion},
+	{Type: EventChannelBan, Version: eventSubscriptionVersion},
+	{Type: EventChannelUnban, Version: eventSubscriptionVersion},
+	{Type: EventChannelSubscribe, Version: eventSubscriptionVersion},
+	{Type: EventChannelModAdd, Version: eventSubscriptionVersion},
+	{Type: EventChannelModRemove, Version: eventSubscriptionVersion},
+}
+
+// Condition returns the condition of the subscription for the broadcaster channel.
+func (EventSubscription) Condition() map[string]string {
+	return map[string]string{"broadcaster_user_id": internal.Config.Twitch.BroadcasterID}
+}
+
+// ModeratorSetter updates site roles after channel moderator changes.
+type ModeratorSetter interface {
+	SetModerator(ctx context.Context, twitchUserID string, moderator bool) error
+}
+
+// EventHandler applies EventSub notifications to local state.
+type EventHandler struct {
+	moderators    ModeratorSetter
+	streams       *Streams
+	bans          *auth.TwitchBans
+	subscriptions *auth.TwitchSubscriptions
+}
+
+// NewEventHandler returns a new EventHandler.
+func NewEventHandler(moderators ModeratorSetter, streams *Streams, bans *auth.TwitchBans, subscriptions *auth.TwitchSubscriptions) *EventHandler {
+	return &EventHandler{
+		moderators:    moderators,
+		streams:       streams,
+		bans:          bans,
+		subscriptions: subscriptions,
+	}
+}
+
+// Handle applies a notification event of the given subscription type.
+// Events of other channels and unknown subscription types are ignored.
+func (h *EventHandler) Handle(ctx context.Context, subscriptionType string, event json.RawMessage) error {
+	var e models.TwitchChannelBanEvent
+	if err := json.Unmarshal(event, &e); err != nil {
+		return fmt.Errorf("invalid %s event: %w", subscriptionType, err)
+	}
+	if e.BroadcasterUserID != internal.Config.Twitch.BroadcasterID {
+		return nil
+	}
+
+	switch subscriptionType {
+	case EventStreamOnline, EventStreamOffline:
+		h.streams.Invalidate(ctx)
+	case EventChannelBan:
+		var timeoutExpiry time.Time
+		if !e.IsPermanent && e.EndsAt != nil {
+			timeoutExpiry = *e.EndsAt
+		}
+		h.bans.Cache(ctx, e.UserID, true, timeoutExpiry)
+	case EventChannelUnban:
+		h.bans.Cache(ctx, e.UserID, false, time.Time{})
+	case EventChannelSubscribe:
+		h.subscriptions.Cache(ctx, e.UserID, true)
+	case EventChannelModAdd, EventChannelModRemove:
+		if err := h.moderators.SetModerator(ctx, e.UserID, subscriptionType == EventChannelModAdd); err != nil {
+			return fmt.Errorf("could not update moderator %s: %w", e.UserID, err)
+		}
+	}
+
+	return nil
+}
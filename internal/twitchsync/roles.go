@@ -0,0 +1,161 @@
+package twitchsync
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/caliecode/la-clipasa/internal"
+	"github.com/caliecode/la-clipasa/internal/ent/generated"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/identity"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/predicate"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/privacy"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/rolechange"
+	"github.com/caliecode/la-clipasa/internal/ent/generated/user"
+	"github.com/caliecode/la-clipasa/internal/ent/privacy/token"
+)
+
+// RolesSyncInterval is the interval channel moderators and VIPs are synced at.
+const RolesSyncInterval = 15 * time.Minute
+
+// ChannelMembers lists the broadcaster channel moderators and VIPs by Twitch user id.
+type ChannelMembers interface {
+	ChannelModerators(ctx context.Context) ([]string, error)
+	ChannelVIPs(ctx context.Context) ([]string, error)
+}
+
+// RoleSyncer syncs Twitch channel moderators and VIPs into site roles.
+type RoleSyncer struct {
+	entc    *generated.Client
+	members ChannelMembers
+}
+
+// NewRoleSyncer returns a new RoleSyncer.
+func NewRoleSyncer(entc *generated.Client, members ChannelMembers) *RoleSyncer {
+	return &RoleSyncer{
+		entc:    entc,
+		members: members,
+	}
+}
+
+// Sync promotes channel moderators to moderator and demotes moderators no longer in the channel,
+// and sets the VIP flag. Admins and manually assigned roles are never changed.
+func (s *RoleSyncer) Sync(ctx context.Context) error {
+	// fetch both beforehand so that a failed request never demotes everyone
+	mods, err := s.members.ChannelModerators(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get channel moderators: %w", err)
+	}
+	vips, err := s.members.ChannelVIPs(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get channel vips: %w", err)
+	}
+
+	ctx = syncContext(ctx)
+
+	if err := s.promote(ctx, twitchUsers(mods)); err != nil {
+		return err
+	}
+	if err := s.demote(ctx, user.Not(twitchUsers(mods))); err != nil {
+		return err
+	}
+
+	vipChanges, err := s.entc.User.Query().
+		Where(user.Or(
+			user.And(twitchUsers(vips), user.TwitchVip(false)),
+			user.And(user.Not(twitchUsers(vips)), user.TwitchVip(true)),
+		)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("could not query vip changes: %w", err)
+	}
+	for _, u := range vipChanges {
+		if err := s.entc.User.UpdateOne(u).SetTwitchVip(!u.TwitchVip).Exec(ctx); err != nil {
+			return fmt.Errorf("could not update vip status of user %s: %w", u.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// SetModerator promotes or demotes a single user after a channel moderator change,
+// with the same rules as Sync.
+func (s *RoleSyncer) SetModerator(ctx context.Context, twitchUserID string, moderator bool) error {
+	ctx = syncContext(ctx)
+
+	if moderator {
+		return s.promote(ctx, twitchUsers([]string{twitchUserID}))
+	}
+
+	return s.demote(ctx, twitchUsers([]string{twitchUserID}))
+}
+
+// promote promotes matching users to moderator unless their role was manually assigned.
+func (s *RoleSyncer) promote(ctx context.Context, where predicate.User) error {
+	promote, err := s.entc.User.Query().
+		Where(
+			where,
+			user.RoleIn(user.RoleGUEST, user.RoleUSER),
+			user.Or(user.RoleSourceIsNil(), user.RoleSourceEQ(user.RoleSourceTWITCH)),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("could not query users to promote: %w", err)
+	}
+	for _, u := range promote {
+		err := s.entc.User.UpdateOne(u).
+			SetRole(user.RoleMODERATOR).
+			SetRoleSource(user.RoleSourceTWITCH).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("could not promote user %s: %w", u.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// demote demotes matching moderators that were promoted by a sync.
+func (s *RoleSyncer) demote(ctx context.Context, where predicate.User) error {
+	demote, err := s.entc.User.Query().
+		Where(
+			where,
+			user.RoleEQ(user.RoleMODERATOR),
+			user.RoleSourceEQ(user.RoleSourceTWITCH),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("could not query users to demote: %w", err)
+	}
+	for _, u := range demote {
+		if err := s.entc.User.UpdateOne(u).SetRole(user.RoleUSER).Exec(ctx); err != nil {
+			return fmt.Errorf("could not demote user %s: %w", u.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func syncContext(ctx context.Context) context.Context {
+	ctx = token.NewContextWithSystemCallToken(ctx)
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	return internal.SetRoleChangeSourceCtx(ctx, rolechange.SourceTWITCH_SYNC)
+}
+
+// twitchUsers matches users linked to any of the given Twitch user ids.
+func twitchUsers(twitchIDs []string) predicate.User {
+	twitchIDs = slices.Compact(slices.Sorted(slices.Values(twitchIDs)))
+
+	return user.Or(
+		user.HasIdentitiesWith(
+			identity.ProviderEQ(identity.ProviderTWITCH),
+			identity.SubjectIn(twitchIDs...),
+		),
+		user.And(
+			user.AuthProviderEQ(user.AuthProviderTWITCH),
+			user.ExternalIDIn(twitchIDs...),
+		),
+	)
+}
@@ -0,0 +1,121 @@
+package twitchsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/caliecode/la-clipasa/internal/models"
+	"github.com/caliecode/la-clipasa/internal/utils/cache"
+)
+
+// StreamStatusTTL bounds how often Helix is queried for the broadcaster stream,
+// regardless of the number of viewers.
+const StreamStatusTTL = time.Minute
+
+const (
+	// streamFetchTimeout bounds a fetch shared by concurrent requests.
+	streamFetchTimeout = 10 * time.Second
+	// lastStreamTTL bounds how long the last fetched stream is shown while Helix is unavailable.
+	lastStreamTTL = 30 * time.Minute
+)
+
+const (
+	streamCacheKey     = "twitch-stream"
+	lastStreamCacheKey = "twitch-stream:last"
+)
+
+// StreamFetcher fetches the broadcaster live stream.
+type StreamFetcher interface {
+	// BroadcasterStream returns the live stream, or nil if offline.
+	BroadcasterStream(ctx context.Context) (*models.TwitchStream, error)
+}
+
+// Streams returns the broadcaster live stream, caching it in a store shared by instances.
+// A nil Streams always reports the stream as offline.
+type Streams struct {
+	fetcher StreamFetcher
+	store   cache.Store
+	// group shares a single fetch between concurrent requests.
+	group singleflight.Group
+}
+
+// NewStreams returns a new Streams. The stream is not fetched without a fetcher.
+func NewStreams(fetcher StreamFetcher, store cache.Store) *Streams {
+	return &Streams{
+		fetcher: fetcher,
+		store:   store,
+	}
+}
+
+// streamEntry is a cached fetch result.
+type streamEntry struct {
+	Stream *models.TwitchStream `json:"stream"`
+	Err    string               `json:"err,omitempty"`
+}
+
+func (e streamEntry) result() (*models.TwitchStream, error) {
+	if e.Err != "" {
+		return nil, errors.New(e.Err)
+	}
+
+	return e.Stream, nil
+}
+
+// Invalidate forces the next BroadcasterStream call to fetch the stream in all instances,
+// e.g. after stream online and offline notifications.
+func (s *Streams) Invalidate(ctx context.Context) {
+	if s == nil {
+		return
+	}
+
+	_ = s.store.Delete(ctx, streamCacheKey)
+}
+
+// BroadcasterStream returns the broadcaster live stream, or nil if offline.
+// The stream is cached up to StreamStatusTTL, and the last known stream is
+// returned while Helix is unavailable, up to lastStreamTTL.
+func (s *Streams) BroadcasterStream(ctx context.Context) (*models.TwitchStream, error) {
+	if s == nil || s.fetcher == nil {
+		return nil, nil
+	}
+	if e, ok, err := cache.GetJSON[streamEntry](ctx, s.store, streamCacheKey); err == nil && ok {
+		return e.result()
+	}
+
+	v, _, _ := s.group.Do(streamCacheKey, func() (any, error) {
+		// the fetch is shared, so it must not end with the request that started it
+		ctx := context.WithoutCancel(ctx)
+		e, err := s.fetch(ctx)
+		// failures are cached as well so that an outage does not multiply calls,
+		// except timeouts which say nothing about Helix
+		if !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, context.Canceled) {
+			_ = cache.SetJSON(ctx, s.store, streamCacheKey, e, StreamStatusTTL)
+		}
+
+		return e, nil
+	})
+
+	return v.(streamEntry).result()
+}
+
+// fetch returns the entry to cache and the fetch error, if any.
+func (s *Streams) fetch(ctx context.Context) (streamEntry, error) {
+	fetchCtx, cancel := context.WithTimeout(ctx, streamFetchTimeout)
+	defer cancel()
+
+	stream, err := s.fetcher.BroadcasterStream(fetchCtx)
+	if err != nil {
+		if last, ok, _ := cache.GetJSON[*models.TwitchStream](ctx, s.store, lastStreamCacheKey); ok {
+			return streamEntry{Stream: last}, err
+		}
+
+		return streamEntry{Err: fmt.Sprintf("could not get broadcaster stream: %v", err)}, err
+	}
+	_ = cache.SetJSON(ctx, s.store, lastStreamCacheKey, stream, lastStreamTTL)
+
+	return streamEntry{Stream: stream}, nil
+}
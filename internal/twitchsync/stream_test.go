@@ -0,0 +1,100 @@
+package twitchsync
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/caliecode/la-clipasa/internal/models"
+	"github.com/caliecode/la-clipasa/internal/utils/cache"
+)
+
+type fakeStreamFetcher struct {
+	calls  int
+	stream *models.TwitchStream
+	err    error
+}
+
+func (f *fakeStreamFetcher) BroadcasterStream(ctx context.Context) (*models.TwitchStream, error) {
+	f.calls++
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return f.stream, f.err
+}
+
+func TestBroadcasterStream(t *testing.T) {
+	ctx := context.Background()
+	store := cache.NewMemory(0)
+	fetcher := &fakeStreamFetcher{stream: &models.TwitchStream{Title: "jugando"}}
+	streams := NewStreams(fetcher, store)
+
+	s, err := streams.BroadcasterStream(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "jugando", s.Title)
+
+	fetcher.stream = nil
+	s, err = streams.BroadcasterStream(ctx)
+	require.NoError(t, err)
+	assert.NotNil(t, s, "stream is cached")
+	assert.Equal(t, 1, fetcher.calls)
+
+	// the cache is shared with other instances
+	s, err = NewStreams(fetcher, store).BroadcasterStream(ctx)
+	require.NoError(t, err)
+	assert.NotNil(t, s)
+	assert.Equal(t, 1, fetcher.calls)
+
+	streams.Invalidate(ctx)
+	s, err = streams.BroadcasterStream(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, s)
+
+	// the last known stream is kept while Helix is unavailable
+	fetcher.stream = &models.TwitchStream{Title: "jugando"}
+	streams.Invalidate(ctx)
+	_, err = streams.BroadcasterStream(ctx)
+	require.NoError(t, err)
+	fetcher.err = errors.New("twitch unavailable")
+	streams.Invalidate(ctx)
+	s, err = streams.BroadcasterStream(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, s)
+	assert.Equal(t, "jugando", s.Title)
+	assert.Equal(t, 4, fetcher.calls)
+
+	streams = NewStreams(fetcher, cache.NewMemory(0))
+	_, err = streams.BroadcasterStream(ctx)
+	require.Error(t, err)
+	_, err = streams.BroadcasterStream(ctx)
+	require.Error(t, err)
+	assert.Equal(t, 5, fetcher.calls, "failures are cached")
+
+	// requests ending early do not fail the shared fetch
+	fetcher.err = nil
+	streams = NewStreams(fetcher, cache.NewMemory(0))
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	s, err = streams.BroadcasterStream(canceledCtx)
+	require.NoError(t, err)
+	assert.NotNil(t, s)
+
+	fetcher.err = context.DeadlineExceeded
+	streams = NewStreams(fetcher, cache.NewMemory(0))
+	_, err = streams.BroadcasterStream(ctx)
+	require.Error(t, err)
+	fetcher.err = nil
+	s, err = streams.BroadcasterStream(ctx)
+	require.NoError(t, err)
+	assert.NotNil(t, s, "timeouts are not cached")
+	assert.Equal(t, 8, fetcher.calls)
+
+	var nilStreams *Streams
+	s, err = nilStreams.BroadcasterStream(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, s)
+}
@@ -18,6 +18,17 @@ func BuildAPIURL(subpaths ...string) string {
 	elems = append(elems, cfg.APIVersion)
 	elems = append(elems, subpaths...)
 
+	return buildURL(elems...)
+}
+
+// BuildUIURL returns a fully-qualified frontend URL with the given path elements.
+func BuildUIURL(subpaths ...string) string {
+	return buildURL(append([]string{"ui"}, subpaths...)...)
+}
+
+func buildURL(elems ...string) string {
+	cfg := Config
+
 	path, err := url.JoinPath(
 		"",
 		elems...,
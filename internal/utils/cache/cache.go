@@ -0,0 +1,80 @@
+// Package cache provides key-value caches shared by all instances of the app.
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// Store is a key-value cache. Implementations are safe for concurrent use.
+type Store interface {
+	// Get returns the value of key and whether it was found.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set sets the value of key, expiring after ttl. Zero ttl never expires.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// SetNX sets the value of key, expiring after ttl, only if key is not set.
+	// It reports whether the value was set.
+	SetNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error)
+	// Delete removes keys in all instances.
+	Delete(ctx context.Context, keys ...string) error
+	// DeletePrefix removes all keys starting with prefix in all instances.
+	DeletePrefix(ctx context.Context, prefix string) error
+	// Close releases the resources of the store.
+	Close() error
+}
+
+// GetJSON returns the JSON value of key and whether it was found.
+func GetJSON[T any](ctx context.Context, s Store, key string) (T, bool, error) {
+	var v T
+
+	b, ok, err := s.Get(ctx, key)
+	if err != nil || !ok {
+		return v, false, err
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return v, false, fmt.Errorf("could not unmarshal cached %s: %w", key, err)
+	}
+
+	return v, true, nil
+}
+
+// SetJSON sets the JSON value of key, expiring after ttl.
+func SetJSON(ctx context.Context, s Store, key string, v any, ttl time.Duration) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("could not marshal %s: %w", key, err)
+	}
+
+	return s.Set(ctx, key, b, ttl)
+}
+
+var memoizeGroup singleflight.Group
+
+// Memoize returns the cached result of fn for key, calling fn if not cached.
+// Only one call is in-flight for a given key per instance at a time and errors are not cached.
+// Cache failures fall back to calling fn, so that an unavailable store does not fail requests.
+// Without a store, fn is always called.
+func Memoize[T any](ctx context.Context, s Store, key string, ttl time.Duration, fn func() (T, error)) (T, error) {
+	if s == nil {
+		return fn()
+	}
+	if v, ok, err := GetJSON[T](ctx, s, key); err == nil && ok {
+		return v, nil
+	}
+
+	v, err, _ := memoizeGroup.Do(key, func() (any, error) {
+		v, err := fn()
+		if err != nil {
+			return v, err
+		}
+		_ = SetJSON(ctx, s, key, v, ttl)
+
+		return v, nil
+	})
+
+	return v.(T), err
+}
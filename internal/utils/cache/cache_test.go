@@ -0,0 +1,104 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemory(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	m := NewMemory(2)
+
+	require.NoError(t, m.Set(ctx, "a", []byte("1"), 0))
+	require.NoError(t, m.Set(ctx, "b", []byte("2"), time.Millisecond))
+	time.Sleep(2 * time.Millisecond)
+
+	v, ok, err := m.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("1"), v)
+
+	_, ok, _ = m.Get(ctx, "b")
+	assert.False(t, ok, "expired")
+
+	require.NoError(t, m.Set(ctx, "c", []byte("3"), 0))
+	require.NoError(t, m.Set(ctx, "d", []byte("4"), 0))
+	_, ok, _ = m.Get(ctx, "a")
+	assert.False(t, ok, "least recently used entries are evicted")
+
+	require.NoError(t, m.DeletePrefix(ctx, "c"))
+	_, ok, _ = m.Get(ctx, "c")
+	assert.False(t, ok)
+	_, ok, _ = m.Get(ctx, "d")
+	assert.True(t, ok)
+
+	ok, err = m.SetNX(ctx, "d", []byte("5"), 0)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = m.SetNX(ctx, "e", []byte("6"), time.Millisecond)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	time.Sleep(2 * time.Millisecond)
+	ok, err = m.SetNX(ctx, "e", []byte("7"), 0)
+	require.NoError(t, err)
+	assert.True(t, ok, "expired keys are set")
+}
+
+// failingStore fails all operations.
+type failingStore struct {
+	Memory
+}
+
+func (*failingStore) Get(context.Context, string) ([]byte, bool, error) {
+	return nil, false, errors.New("unavailable")
+}
+
+func (*failingStore) Set(context.Context, string, []byte, time.Duration) error {
+	return errors.New("unavailable")
+}
+
+func TestMemoize(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type result struct {
+		N int `json:"n"`
+	}
+
+	var calls atomic.Int32
+	fn := func() (result, error) {
+		return result{N: int(calls.Add(1))}, nil
+	}
+
+	s := NewMemory(0)
+	for range 3 {
+		v, err := Memoize(ctx, s, "TestMemoize", time.Minute, fn)
+		require.NoError(t, err)
+		assert.Equal(t, 1, v.N)
+	}
+	assert.EqualValues(t, 1, calls.Load())
+
+	_, err := Memoize(ctx, s, "TestMemoize:error", time.Minute, func() (result, error) {
+		return result{}, errors.New("failed")
+	})
+	require.Error(t, err)
+	_, ok, _ := s.Get(ctx, "TestMemoize:error")
+	assert.False(t, ok, "errors are not cached")
+
+	v, err := Memoize(ctx, &failingStore{}, "TestMemoize:unavailable", time.Minute, fn)
+	require.NoError(t, err, "unavailable stores fall back to fn")
+	assert.Equal(t, 2, v.N)
+
+	v, err = Memoize(ctx, FromContext(ctx), "TestMemoize:nil", time.Minute, fn)
+	require.NoError(t, err, "nil stores fall back to fn")
+	assert.Equal(t, 3, v.N)
+}
@@ -0,0 +1,17 @@
+package cache
+
+import "context"
+
+type ctxKeyStore struct{}
+
+// NewContext returns a copy of ctx with the store.
+func NewContext(ctx context.Context, s Store) context.Context {
+	return context.WithValue(ctx, ctxKeyStore{}, s)
+}
+
+// FromContext returns the store of ctx, or nil if there is none.
+func FromContext(ctx context.Context) Store {
+	s, _ := ctx.Value(ctxKeyStore{}).(Store)
+
+	return s
+}
@@ -0,0 +1,109 @@
+package cache
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// DefaultMemorySize is the default maximum number of entries of in-memory stores.
+const DefaultMemorySize = 1000
+
+type memoryEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+func (e memoryEntry) expired() bool {
+	return !e.expiresAt.IsZero() && time.Now().After(e.expiresAt)
+}
+
+// Memory is an in-memory LRU store, local to the instance.
+type Memory struct {
+	entries *lru.Cache[string, memoryEntry]
+	// setNXMu makes SetNX checks and writes atomic.
+	setNXMu sync.Mutex
+}
+
+var _ Store = (*Memory)(nil)
+
+// NewMemory returns an in-memory store of up to size entries, evicting the least recently used.
+func NewMemory(size int) *Memory {
+	if size <= 0 {
+		size = DefaultMemorySize
+	}
+	entries, _ := lru.New[string, memoryEntry](size) // only fails for non-positive sizes
+
+	return &Memory{entries: entries}
+}
+
+// Get returns the value of key and whether it was found.
+func (m *Memory) Get(_ context.Context, key string) ([]byte, bool, error) {
+	e, ok := m.entries.Get(key)
+	if !ok {
+		return nil, false, nil
+	}
+	if e.expired() {
+		m.entries.Remove(key)
+		return nil, false, nil
+	}
+
+	return e.value, true, nil
+}
+
+// Set sets the value of key, expiring after ttl. Zero ttl never expires.
+func (m *Memory) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	e := memoryEntry{value: value}
+	if ttl > 0 {
+		e.expiresAt = time.Now().Add(ttl)
+	}
+	m.entries.Add(key, e)
+
+	return nil
+}
+
+// SetNX sets the value of key, expiring after ttl, only if key is not set.
+// It reports whether the value was set.
+func (m *Memory) SetNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	m.setNXMu.Lock()
+	defer m.setNXMu.Unlock()
+
+	if e, ok := m.entries.Peek(key); ok && !e.expired() {
+		return false, nil
+	}
+
+	return true, m.Set(ctx, key, value, ttl)
+}
+
+// Delete removes keys.
+func (m *Memory) Delete(_ context.Context, keys ...string) error {
+	for _, key := range keys {
+		m.entries.Remove(key)
+	}
+
+	return nil
+}
+
+// DeletePrefix removes all keys starting with prefix.
+func (m *Memory) DeletePrefix(_ context.Context, prefix string) error {
+	for _, key := range m.entries.Keys() {
+		if strings.HasPrefix(key, prefix) {
+			m.entries.Remove(key)
+		}
+	}
+
+	return nil
+}
+
+// Flush removes all keys.
+func (m *Memory) Flush() {
+	m.entries.Purge()
+}
+
+// Close is a no-op.
+func (m *Memory) Close() error {
+	return nil
+}
@@ -0,0 +1,308 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultRedisPoolSize = 10
+	// maxLocalTTL bounds entries cached in memory, in case invalidations are missed.
+	maxLocalTTL = 5 * time.Minute
+	// maxResubscribeBackoff caps the backoff between invalidation subscription attempts.
+	maxResubscribeBackoff = 5 * time.Second
+	// deletePrefixBatchSize is the number of keys scanned and deleted at once.
+	deletePrefixBatchSize = 100
+)
+
+// RedisOptions configures a Redis store.
+type RedisOptions struct {
+	Addr     string
+	Password string
+	DB       int
+	// Namespace prefixes keys and the invalidation channel, to share a server between apps.
+	Namespace string
+	// PoolSize is the maximum number of connections.
+	PoolSize int
+	// LocalSize is the maximum number of entries cached in memory.
+	LocalSize int
+	// Logger logs background errors, if set.
+	Logger *zap.SugaredLogger
+}
+
+// Redis is a store shared by all instances, with entries also cached in memory.
+// Changes are published to the other instances so that they drop their in-memory copies.
+type Redis struct {
+	opts    RedisOptions
+	id      string
+	client  *redis.Client
+	local   *Memory
+	channel string
+
+	pubsub *redis.PubSub
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+var _ Store = (*Redis)(nil)
+
+// invalidation is published to the other instances after changes.
+type invalidation struct {
+	// Source is the store that published the invalidation.
+	Source string   `json:"source"`
+	Keys   []string `json:"keys,omitempty"`
+	Prefix *string  `json:"prefix,omitempty"`
+}
+
+// NewRedis connects to a Redis server and subscribes to invalidations.
+func NewRedis(ctx context.Context, opts RedisOptions) (*Redis, error) {
+	if opts.PoolSize <= 0 {
+		opts.PoolSize = defaultRedisPoolSize
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr:     opts.Addr,
+		Password: opts.Password,
+		DB:       opts.DB,
+		PoolSize: opts.PoolSize,
+	})
+	if err := client.Ping(ctx).Err(); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("could not connect to redis: %w", err)
+	}
+
+	r := &Redis{
+		opts:    opts,
+		id:      uuid.NewString(),
+		client:  client,
+		local:   NewMemory(opts.LocalSize),
+		channel: opts.Namespace + "cache:invalidate",
+		done:    make(chan struct{}),
+	}
+
+	r.pubsub = client.Subscribe(ctx, r.channel)
+	if _, err := r.pubsub.Receive(ctx); err != nil {
+		_ = r.pubsub.Close()
+		_ = client.Close()
+		return nil, fmt.Errorf("could not subscribe to cache invalidations: %w", err)
+	}
+
+	bgCtx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	go r.listen(bgCtx)
+
+	return r, nil
+}
+
+// Get returns the value of key and whether it was found.
+func (r *Redis) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	if v, ok, _ := r.local.Get(ctx, key); ok {
+		return v, true, nil
+	}
+
+	pipe := r.client.Pipeline()
+	get := pipe.Get(ctx, r.key(key))
+	pttl := pipe.PTTL(ctx, r.key(key))
+	if _, err := pipe.Exec(ctx); errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, r.logError("get", err)
+	}
+	v, err := get.Bytes()
+	if err != nil {
+		return nil, false, r.logError("get", err)
+	}
+
+	ttl := maxLocalTTL
+	if d := pttl.Val(); d > 0 {
+		ttl = min(ttl, d)
+	}
+	_ = r.local.Set(ctx, key, v, ttl)
+
+	return v, true, nil
+}
+
+// Set sets the value of key, expiring after ttl. Zero ttl never expires.
+func (r *Redis) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if ttl > 0 {
+		ttl = max(ttl, time.Millisecond)
+	}
+	if err := r.client.Set(ctx, r.key(key), value, ttl).Err(); err != nil {
+		return r.logError("set", err)
+	}
+
+	localTTL := maxLocalTTL
+	if ttl > 0 {
+		localTTL = min(ttl, maxLocalTTL)
+	}
+	_ = r.local.Set(ctx, key, value, localTTL)
+
+	return r.publish(ctx, invalidation{Keys: []string{key}})
+}
+
+// SetNX sets the value of key, expiring after ttl, only if key is not set in any instance.
+// It reports whether the value was set.
+func (r *Redis) SetNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	if ttl > 0 {
+		ttl = max(ttl, time.Millisecond)
+	}
+	ok, err := r.client.SetNX(ctx, r.key(key), value, ttl).Result()
+	if err != nil {
+		return false, r.logError("setnx", err)
+	}
+	if !ok {
+		return false, nil
+	}
+
+	// a previous value may still be cached in memory if key had expired
+	_ = r.local.Delete(ctx, key)
+
+	return true, r.publish(ctx, invalidation{Keys: []string{key}})
+}
+
+// Delete removes keys in all instances.
+func (r *Redis) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	_ = r.local.Delete(ctx, keys...)
+
+	namespaced := make([]string, len(keys))
+	for i, key := range keys {
+		namespaced[i] = r.key(key)
+	}
+	if err := r.client.Del(ctx, namespaced...).Err(); err != nil {
+		return r.logError("delete", err)
+	}
+
+	return r.publish(ctx, invalidation{Keys: keys})
+}
+
+// DeletePrefix removes all keys starting with prefix in all instances.
+func (r *Redis) DeletePrefix(ctx context.Context, prefix string) error {
+	_ = r.local.DeletePrefix(ctx, prefix)
+
+	iter := r.client.Scan(ctx, 0, globEscape(r.key(prefix))+"*", deletePrefixBatchSize).Iterator()
+	batch := make([]string, 0, deletePrefixBatchSize)
+	for iter.Next(ctx) {
+		batch = append(batch, iter.Val())
+		if len(batch) == deletePrefixBatchSize {
+			if err := r.client.Del(ctx, batch...).Err(); err != nil {
+				return r.logError("delete", err)
+			}
+			batch = batch[:0]
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return r.logError("scan", err)
+	}
+	if len(batch) > 0 {
+		if err := r.client.Del(ctx, batch...).Err(); err != nil {
+			return r.logError("delete", err)
+		}
+	}
+
+	return r.publish(ctx, invalidation{Prefix: &prefix})
+}
+
+// Close stops the invalidation subscription and closes the connections.
+func (r *Redis) Close() error {
+	r.cancel()
+	err := r.pubsub.Close()
+	<-r.done
+
+	return errors.Join(err, r.client.Close())
+}
+
+func (r *Redis) key(key string) string {
+	return r.opts.Namespace + key
+}
+
+func (r *Redis) publish(ctx context.Context, inv invalidation) error {
+	inv.Source = r.id
+	msg, err := json.Marshal(inv)
+	if err != nil {
+		return err
+	}
+	if err := r.client.Publish(ctx, r.channel, msg).Err(); err != nil {
+		return r.logError("publish", err)
+	}
+
+	return nil
+}
+
+func (r *Redis) logError(op string, err error) error {
+	err = fmt.Errorf("redis cache %s: %w", op, err)
+	if r.opts.Logger != nil {
+		r.opts.Logger.Warn(err)
+	}
+
+	return err
+}
+
+// listen drops the entries invalidated by other instances until the store is closed.
+// The subscription is restored after connection errors.
+func (r *Redis) listen(ctx context.Context) {
+	defer close(r.done)
+
+	backoff := 100 * time.Millisecond
+	for {
+		msg, err := r.pubsub.Receive(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			// invalidations may be missed while disconnected
+			r.local.Flush()
+			_ = r.logError("subscribe", err)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff = min(2*backoff, maxResubscribeBackoff)
+
+			continue
+		}
+
+		switch msg := msg.(type) {
+		case *redis.Subscription:
+			// invalidations may have been missed while resubscribing
+			r.local.Flush()
+			backoff = 100 * time.Millisecond
+		case *redis.Message:
+			var inv invalidation
+			if err := json.Unmarshal([]byte(msg.Payload), &inv); err != nil || inv.Source == r.id {
+				continue
+			}
+			_ = r.local.Delete(ctx, inv.Keys...)
+			if inv.Prefix != nil {
+				_ = r.local.DeletePrefix(ctx, *inv.Prefix)
+			}
+		}
+	}
+}
+
+// globEscape escapes the glob special characters of SCAN patterns.
+func globEscape(s string) string {
+	var b strings.Builder
+	for _, c := range s {
+		switch c {
+		case '*', '?', '[', ']', '\\':
+			b.WriteByte('\\')
+		}
+		b.WriteRune(c)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,184 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRedis(t *testing.T, srv *miniredis.Miniredis, password string) *Redis {
+	t.Helper()
+
+	r, err := NewRedis(context.Background(), RedisOptions{
+		Addr:      srv.Addr(),
+		Password:  password,
+		DB:        1,
+		Namespace: "test:",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { r.Close() })
+
+	return r
+}
+
+// assertEventuallyCached asserts the value of key in the in-memory cache of r.
+func assertEventuallyCached(t *testing.T, r *Redis, key string, want []byte) {
+	t.Helper()
+
+	assert.Eventually(t, func() bool {
+		v, ok, _ := r.local.Get(context.Background(), key)
+		if want == nil {
+			return !ok
+		}
+		return ok && string(v) == string(want)
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestRedis(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := miniredis.RunT(t)
+	srv.RequireAuth("secret")
+	a := newTestRedis(t, srv, "secret")
+	b := newTestRedis(t, srv, "secret")
+
+	require.NoError(t, a.Set(ctx, "twitch:1", []byte("v1"), time.Minute))
+
+	v, ok, err := b.Get(ctx, "twitch:1")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, []byte("v1"), v)
+	assertEventuallyCached(t, b, "twitch:1", []byte("v1"))
+
+	assert.True(t, srv.DB(1).Exists("test:twitch:1"), "keys are namespaced")
+
+	t.Run("set invalidates other instances", func(t *testing.T) {
+		require.NoError(t, a.Set(ctx, "twitch:1", []byte("v2"), time.Minute))
+		assertEventuallyCached(t, b, "twitch:1", nil)
+
+		v, ok, err := b.Get(ctx, "twitch:1")
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, []byte("v2"), v)
+		assertEventuallyCached(t, a, "twitch:1", []byte("v2"))
+	})
+
+	t.Run("delete invalidates other instances", func(t *testing.T) {
+		require.NoError(t, a.Delete(ctx, "twitch:1"))
+		assertEventuallyCached(t, b, "twitch:1", nil)
+
+		_, ok, err := b.Get(ctx, "twitch:1")
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("delete prefix", func(t *testing.T) {
+		require.NoError(t, a.Set(ctx, "leaderboard:[week]*:1", []byte("1"), 0))
+		require.NoError(t, a.Set(ctx, "leaderboard:[week]*:2", []byte("2"), 0))
+		require.NoError(t, a.Set(ctx, "leaderboard:month:1", []byte("3"), 0))
+		for _, key := range []string{"leaderboard:[week]*:1", "leaderboard:month:1"} {
+			_, ok, err := b.Get(ctx, key)
+			require.NoError(t, err)
+			require.True(t, ok)
+		}
+
+		require.NoError(t, a.DeletePrefix(ctx, "leaderboard:[week]*"))
+		assertEventuallyCached(t, b, "leaderboard:[week]*:1", nil)
+		assertEventuallyCached(t, b, "leaderboard:month:1", []byte("3"))
+
+		_, ok, err := b.Get(ctx, "leaderboard:[week]*:2")
+		require.NoError(t, err)
+		assert.False(t, ok)
+		_, ok, err = b.Get(ctx, "leaderboard:month:1")
+		require.NoError(t, err)
+		assert.True(t, ok, "glob characters of prefixes are escaped")
+	})
+
+	t.Run("set if not set", func(t *testing.T) {
+		ok, err := a.SetNX(ctx, "eventsub:1", []byte("1"), time.Minute)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		ok, err = b.SetNX(ctx, "eventsub:1", []byte("1"), time.Minute)
+		require.NoError(t, err)
+		assert.False(t, ok, "keys are set once across instances")
+
+		srv.FastForward(2 * time.Minute)
+		ok, err = b.SetNX(ctx, "eventsub:1", []byte("1"), time.Minute)
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("expiry", func(t *testing.T) {
+		require.NoError(t, a.Set(ctx, "short", []byte("1"), time.Minute))
+		srv.FastForward(2 * time.Minute)
+		a.local.Flush()
+
+		_, ok, err := a.Get(ctx, "short")
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestRedisEval(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := miniredis.RunT(t)
+	r := newTestRedis(t, srv, "")
+
+	script := NewScript("return redis.call('INCRBY', KEYS[1], ARGV[1])")
+	for i, want := range []int64{2, 4} {
+		v, err := r.Eval(ctx, script, []string{"counter"}, "2")
+		require.NoError(t, err, "call %d", i)
+		assert.Equal(t, want, v)
+	}
+
+	got, err := srv.DB(1).Get("test:counter")
+	require.NoError(t, err)
+	assert.Equal(t, "4", got, "keys are namespaced")
+
+	_, err = r.Eval(ctx, NewScript("return redis.call('UNKNOWN')"), nil)
+	require.Error(t, err)
+}
+
+func TestRedisReconnect(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := miniredis.RunT(t)
+	a := newTestRedis(t, srv, "")
+	b := newTestRedis(t, srv, "")
+
+	require.NoError(t, a.Set(ctx, "k", []byte("v1"), 0))
+	_, _, err := b.Get(ctx, "k")
+	require.NoError(t, err)
+
+	srv.Close()
+	// invalidations published while disconnected are missed
+	require.NoError(t, srv.DB(1).Set("test:k", "v2"))
+	require.NoError(t, srv.Restart())
+
+	assert.Eventually(t, func() bool {
+		return srv.PubSubNumSub("test:cache:invalidate")["test:cache:invalidate"] == 2
+	}, 5*time.Second, 10*time.Millisecond, "subscriptions are restored")
+
+	v, ok, err := b.Get(ctx, "k")
+	require.NoError(t, err, "closed idle connections are replaced")
+	require.True(t, ok)
+	assert.Equal(t, []byte("v2"), v, "cached entries are dropped after reconnecting")
+}
+
+func TestNewRedisUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	srv := miniredis.RunT(t)
+	srv.RequireAuth("secret")
+
+	_, err := NewRedis(context.Background(), RedisOptions{Addr: srv.Addr(), Password: "wrong"})
+	require.ErrorContains(t, err, "WRONGPASS")
+}
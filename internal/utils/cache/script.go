@@ -0,0 +1,37 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Script is a Lua script run atomically by a Redis server.
+type Script struct {
+	script *redis.Script
+}
+
+// NewScript returns a script for src.
+func NewScript(src string) *Script {
+	return &Script{script: redis.NewScript(src)}
+}
+
+// Eval runs a script with the given keys, which are namespaced, and args.
+// Scripts are sent once and afterwards run by their SHA1 digest.
+func (r *Redis) Eval(ctx context.Context, s *Script, keys []string, args ...string) (any, error) {
+	namespaced := make([]string, len(keys))
+	for i, key := range keys {
+		namespaced[i] = r.key(key)
+	}
+	argv := make([]any, len(args))
+	for i, arg := range args {
+		argv[i] = arg
+	}
+
+	reply, err := s.script.Run(ctx, r.client, namespaced, argv...).Result()
+	if err != nil {
+		return nil, r.logError("eval", err)
+	}
+
+	return reply, nil
+}
@@ -0,0 +1,34 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	t.Parallel()
+
+	key := DeriveKey("secret", "test")
+	plaintext := []byte("refresh-token")
+
+	ciphertext, err := Encrypt(key, plaintext)
+	require.NoError(t, err)
+	assert.NotContains(t, string(ciphertext), string(plaintext))
+
+	other, err := Encrypt(key, plaintext)
+	require.NoError(t, err)
+	assert.NotEqual(t, ciphertext, other, "nonces are random")
+
+	got, err := Decrypt(key, ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, got)
+
+	_, err = Decrypt(DeriveKey("secret", "other"), ciphertext)
+	require.Error(t, err)
+
+	ciphertext[len(ciphertext)-1] ^= 1
+	_, err = Decrypt(key, ciphertext)
+	require.Error(t, err)
+}
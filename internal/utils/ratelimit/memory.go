@@ -0,0 +1,74 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// cleanupInterval is how often full buckets are dropped.
+const cleanupInterval = time.Minute
+
+// Memory is a limiter keeping buckets in memory, limiting requests to a single instance.
+type Memory struct {
+	mu          sync.Mutex
+	buckets     map[string]*bucket
+	lastCleanup time.Time
+	now         func() time.Time
+}
+
+var _ Limiter = (*Memory)(nil)
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+	// fullAt is when the bucket is full again and may be dropped.
+	fullAt time.Time
+}
+
+// NewMemory returns an in-memory limiter.
+func NewMemory() *Memory {
+	return &Memory{
+		buckets:     make(map[string]*bucket),
+		lastCleanup: time.Now(),
+		now:         time.Now,
+	}
+}
+
+func (m *Memory) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	return m.AllowN(ctx, key, limit, 1)
+}
+
+func (m *Memory) AllowN(_ context.Context, key string, limit Limit, n int) (Result, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.cleanup(now)
+
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), updatedAt: now}
+		m.buckets[key] = b
+	}
+
+	tokens, allowed := take(limit, b.tokens, now.Sub(b.updatedAt), n)
+	res := newResult(limit, tokens, allowed, n)
+	b.tokens, b.updatedAt, b.fullAt = tokens, now, now.Add(res.Reset)
+
+	return res, nil
+}
+
+// cleanup drops full buckets, which are equivalent to missing ones.
+func (m *Memory) cleanup(now time.Time) {
+	if now.Sub(m.lastCleanup) < cleanupInterval {
+		return
+	}
+	m.lastCleanup = now
+
+	for key, b := range m.buckets {
+		if !now.Before(b.fullAt) {
+			delete(m.buckets, key)
+		}
+	}
+}
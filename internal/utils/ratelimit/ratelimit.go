@@ -0,0 +1,70 @@
+// Package ratelimit implements token bucket rate limits, either per instance or shared by all instances.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// Limit is a token bucket refilled at Rate tokens per second, holding up to Burst tokens.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// PerMinute returns a limit of n requests per minute with the given burst.
+func PerMinute(n, burst int) Limit {
+	return Limit{Rate: float64(n) / 60, Burst: burst}
+}
+
+// Result is the outcome of taking tokens.
+type Result struct {
+	Allowed bool
+	// Limit is the bucket size.
+	Limit int
+	// Remaining is the number of whole tokens left.
+	Remaining int
+	// Reset is the time until the bucket is full again.
+	Reset time.Duration
+	// RetryAfter is the time until the requested tokens are available. It is zero if allowed.
+	RetryAfter time.Duration
+}
+
+// Limiter takes tokens from buckets.
+type Limiter interface {
+	// Allow takes a token from the bucket of key, if available.
+	Allow(ctx context.Context, key string, limit Limit) (Result, error)
+	// AllowN takes n tokens from the bucket of key, if available. Nothing is taken otherwise.
+	AllowN(ctx context.Context, key string, limit Limit, n int) (Result, error)
+}
+
+// take refills a bucket holding tokens after elapsed and takes n tokens from it if available,
+// returning the tokens left.
+func take(limit Limit, tokens float64, elapsed time.Duration, n int) (float64, bool) {
+	tokens = math.Min(float64(limit.Burst), tokens+elapsed.Seconds()*limit.Rate)
+	if tokens < float64(n) {
+		return tokens, false
+	}
+
+	return tokens - float64(n), true
+}
+
+// newResult returns the result of taking n tokens from a bucket now holding tokens.
+func newResult(limit Limit, tokens float64, allowed bool, n int) Result {
+	res := Result{
+		Allowed:   allowed,
+		Limit:     limit.Burst,
+		Remaining: max(int(tokens), 0),
+		Reset:     seconds((float64(limit.Burst) - tokens) / limit.Rate),
+	}
+	if !allowed {
+		res.RetryAfter = seconds((float64(n) - tokens) / limit.Rate)
+	}
+
+	return res
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(math.Ceil(s * float64(time.Second)))
+}
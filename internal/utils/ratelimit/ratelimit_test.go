@@ -0,0 +1,119 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/caliecode/la-clipasa/internal/utils/cache"
+)
+
+func TestMemory(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Now()
+	m := NewMemory()
+	m.now = func() time.Time { return now }
+	limit := Limit{Rate: 2, Burst: 3}
+
+	for i := range limit.Burst {
+		res, err := m.Allow(ctx, "a", limit)
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, 3, res.Limit)
+		assert.Equal(t, 2-i, res.Remaining)
+	}
+
+	res, _ := m.Allow(ctx, "a", limit)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 0, res.Remaining)
+	assert.Equal(t, 500*time.Millisecond, res.RetryAfter)
+	assert.Equal(t, 1500*time.Millisecond, res.Reset)
+
+	res, _ = m.Allow(ctx, "b", limit)
+	assert.True(t, res.Allowed, "buckets are per key")
+
+	now = now.Add(500 * time.Millisecond)
+	res, _ = m.Allow(ctx, "a", limit)
+	assert.True(t, res.Allowed, "tokens are refilled")
+	assert.Zero(t, res.RetryAfter)
+
+	now = now.Add(time.Hour)
+	res, _ = m.Allow(ctx, "a", limit)
+	assert.Equal(t, 2, res.Remaining, "buckets hold up to burst tokens")
+	m.mu.Lock()
+	assert.Len(t, m.buckets, 1, "full buckets are dropped")
+	m.mu.Unlock()
+
+	res, _ = m.AllowN(ctx, "c", limit, 4)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 3, res.Remaining, "nothing is taken if not allowed")
+	assert.Equal(t, 500*time.Millisecond, res.RetryAfter)
+	res, _ = m.AllowN(ctx, "c", limit, 2)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, 1, res.Remaining)
+}
+
+type fakeEvaler struct {
+	reply any
+	err   error
+
+	keys []string
+	args []string
+}
+
+func (e *fakeEvaler) Eval(_ context.Context, _ *cache.Script, keys []string, args ...string) (any, error) {
+	e.keys, e.args = keys, args
+
+	return e.reply, e.err
+}
+
+func TestRedis(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	limit := PerMinute(30, 5)
+
+	e := &fakeEvaler{reply: []any{int64(1), "3.5"}}
+	r := &Redis{redis: e}
+	res, err := r.Allow(ctx, "user:1", limit)
+	require.NoError(t, err)
+	assert.Equal(t, Result{Allowed: true, Limit: 5, Remaining: 3, Reset: 3 * time.Second}, res)
+	assert.Equal(t, []string{"ratelimit:user:1"}, e.keys)
+	assert.Equal(t, []string{"0.5", "5", "1"}, e.args)
+
+	e.reply = []any{int64(0), "0.25"}
+	res, err = r.Allow(ctx, "user:1", limit)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 1500*time.Millisecond, res.RetryAfter)
+
+	e.err = errors.New("unavailable")
+	_, err = r.Allow(ctx, "user:1", limit)
+	require.Error(t, err)
+}
+
+func TestRedisScript(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := miniredis.RunT(t)
+	store, err := cache.NewRedis(ctx, cache.RedisOptions{Addr: srv.Addr()})
+	require.NoError(t, err)
+	t.Cleanup(func() { store.Close() })
+
+	r := NewRedis(store)
+	limit := PerMinute(60, 2)
+	for i, allowed := range []bool{true, true, false} {
+		res, err := r.Allow(ctx, "user:1", limit)
+		require.NoError(t, err)
+		assert.Equal(t, allowed, res.Allowed, "request %d", i)
+	}
+	assert.True(t, srv.Exists("ratelimit:user:1"))
+}
@@ -0,0 +1,79 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/caliecode/la-clipasa/internal/utils/cache"
+)
+
+// tokenBucketScript takes ARGV[3] tokens from the bucket hash at KEYS[1], refilled at ARGV[1] tokens per second
+// up to ARGV[2] tokens, and returns whether it was allowed and the tokens left.
+// The server clock is used so that instances share the same time.
+var tokenBucketScript = cache.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local n = tonumber(ARGV[3])
+local t = redis.call('TIME')
+-- milliseconds, which tostring keeps exact
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(bucket[1]) or burst
+local ts = tonumber(bucket[2]) or now
+tokens = math.min(burst, tokens + math.max(0, now - ts) / 1000 * rate)
+
+local allowed = 0
+if tokens >= n then
+	tokens = tokens - n
+	allowed = 1
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(now))
+redis.call('PEXPIRE', KEYS[1], math.max(1, math.ceil((burst - tokens) / rate * 1000)))
+
+return {allowed, tostring(tokens)}
+`)
+
+// evaler runs Lua scripts.
+type evaler interface {
+	Eval(ctx context.Context, s *cache.Script, keys []string, args ...string) (any, error)
+}
+
+// Redis is a limiter keeping buckets in Redis, limiting requests to all instances.
+type Redis struct {
+	redis evaler
+}
+
+var _ Limiter = (*Redis)(nil)
+
+// NewRedis returns a limiter sharing buckets via a Redis store.
+func NewRedis(store *cache.Redis) *Redis {
+	return &Redis{redis: store}
+}
+
+func (r *Redis) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	return r.AllowN(ctx, key, limit, 1)
+}
+
+func (r *Redis) AllowN(ctx context.Context, key string, limit Limit, n int) (Result, error) {
+	reply, err := r.redis.Eval(ctx, tokenBucketScript, []string{"ratelimit:" + key},
+		strconv.FormatFloat(limit.Rate, 'g', -1, 64), strconv.Itoa(limit.Burst), strconv.Itoa(n))
+	if err != nil {
+		return Result{}, err
+	}
+
+	arr, ok := reply.([]any)
+	if !ok || len(arr) != 2 {
+		return Result{}, fmt.Errorf("ratelimit: unexpected reply: %v", reply)
+	}
+	allowed, _ := arr[0].(int64)
+	s, _ := arr[1].(string)
+	tokens, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return Result{}, fmt.Errorf("ratelimit: unexpected tokens reply: %w", err)
+	}
+
+	return newResult(limit, tokens, allowed == 1, n), nil
+}